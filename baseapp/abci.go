@@ -153,6 +153,13 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 		res = app.endBlocker(app.deliverState.ctx, req)
 	}
 
+	// return the consensus params from the ParamStore, so that the changes made
+	// to them during the block, e.g. by a governance proposal, are applied by
+	// Tendermint from the next block on
+	if res.ConsensusParamUpdates == nil {
+		res.ConsensusParamUpdates = app.GetConsensusParams(app.deliverState.ctx)
+	}
+
 	return
 }
 
@@ -294,6 +301,11 @@ func (app *BaseApp) halt() {
 // Query implements the ABCI interface. It delegates to CommitMultiStore if it
 // implements Queryable.
 func (app *BaseApp) Query(req abci.RequestQuery) abci.ResponseQuery {
+	// the gRPC query services are routed by the full method name
+	if grpcHandler := app.grpcQueryRouter.Route(req.Path); grpcHandler != nil {
+		return handleQueryGRPC(app, grpcHandler, req)
+	}
+
 	path := splitPath(req.Path)
 	if len(path) == 0 {
 		sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "no query path provided"))
@@ -439,35 +451,11 @@ func handleQueryCustom(app *BaseApp, path []string, req abci.RequestQuery) abci.
 		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "no custom querier found for route %s", path[1]))
 	}
 
-	// when a client did not provide a query height, manually inject the latest
-	if req.Height == 0 {
-		req.Height = app.LastBlockHeight()
-	}
-
-	if req.Height <= 1 && req.Prove {
-		return sdkerrors.QueryResult(
-			sdkerrors.Wrap(
-				sdkerrors.ErrInvalidRequest,
-				"cannot query with proof when height <= 1; please provide a valid height",
-			),
-		)
-	}
-
-	cacheMS, err := app.cms.CacheMultiStoreWithVersion(req.Height)
+	ctx, req, err := createQueryContext(app, req)
 	if err != nil {
-		return sdkerrors.QueryResult(
-			sdkerrors.Wrapf(
-				sdkerrors.ErrInvalidRequest,
-				"failed to load state at height %d; %s (latest height: %d)", req.Height, err, app.LastBlockHeight(),
-			),
-		)
+		return sdkerrors.QueryResult(err)
 	}
 
-	// cache wrap the commit-multistore for safety
-	ctx := sdk.NewContext(
-		cacheMS, app.checkState.ctx.BlockHeader(), true, app.logger,
-	).WithMinGasPrices(app.minGasPrices)
-
 	// Passes the rest of the path as an argument to the querier.
 	//
 	// For example, in the path "custom/gov/proposal/test", the gov querier gets
@@ -489,6 +477,66 @@ func handleQueryCustom(app *BaseApp, path []string, req abci.RequestQuery) abci.
 	}
 }
 
+func handleQueryGRPC(app *BaseApp, handler GRPCQueryHandler, req abci.RequestQuery) abci.ResponseQuery {
+	ctx, req, err := createQueryContext(app, req)
+	if err != nil {
+		return sdkerrors.QueryResult(err)
+	}
+
+	res, err := handler(ctx, req)
+	if err != nil {
+		space, code, log := sdkerrors.ABCIInfo(err, false)
+		return abci.ResponseQuery{
+			Code:      code,
+			Codespace: space,
+			Log:       log,
+			Height:    req.Height,
+		}
+	}
+
+	return res
+}
+
+// createQueryContext creates a read-only context of the state at the height of
+// the query, which defaults to the latest height. It returns the query with its
+// height set.
+func createQueryContext(app *BaseApp, req abci.RequestQuery) (sdk.Context, abci.RequestQuery, error) {
+	// when a client did not provide a query height, manually inject the latest
+	if req.Height == 0 {
+		req.Height = app.LastBlockHeight()
+	}
+
+	if req.Height <= 1 && req.Prove {
+		return sdk.Context{}, req, sdkerrors.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"cannot query with proof when height <= 1; please provide a valid height",
+		)
+	}
+
+	if req.Height < 0 || req.Height > app.LastBlockHeight() {
+		return sdk.Context{}, req, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidHeight,
+			"cannot query height %d (latest height: %d)", req.Height, app.LastBlockHeight(),
+		)
+	}
+
+	// the state of the heights below the latest one is missing once pruned
+	cacheMS, err := app.cms.CacheMultiStoreWithVersion(req.Height)
+	if err != nil {
+		return sdk.Context{}, req, sdkerrors.Wrapf(
+			sdkerrors.ErrHeightPruned,
+			"failed to load state at height %d; %s (latest height: %d)", req.Height, err, app.LastBlockHeight(),
+		)
+	}
+
+	// cache wrap the commit-multistore for safety
+	ctx := sdk.NewContext(
+		cacheMS, app.checkState.ctx.BlockHeader(), true, app.logger,
+	).WithMinGasPrices(app.minGasPrices)
+
+	return ctx, req, nil
+}
+
 // splitPath splits a string path using the delimiter '/'.
 //
 // e.g. "this/is/funny" becomes []string{"this", "is", "funny"}
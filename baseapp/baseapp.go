@@ -50,7 +50,10 @@ type BaseApp struct { // nolint: maligned
 	queryRouter sdk.QueryRouter      // router for redirecting query calls
 	txDecoder   sdk.TxDecoder        // unmarshal []byte into sdk.Tx
 
+	grpcQueryRouter *GRPCQueryRouter // router for redirecting gRPC query calls
+
 	anteHandler    sdk.AnteHandler  // ante handler for fee and auth
+	postHandler    sdk.PostHandler  // post handler, run after the messages of a tx succeed
 	initChainer    sdk.InitChainer  // initialize state with validators and state blob
 	beginBlocker   sdk.BeginBlocker // logic to run before any txs
 	endBlocker     sdk.EndBlocker   // logic to run after all txs, and to determine valset changes
@@ -110,6 +113,8 @@ func NewBaseApp(
 		queryRouter:    NewQueryRouter(),
 		txDecoder:      txDecoder,
 		fauxMerkleMode: false,
+
+		grpcQueryRouter: NewGRPCQueryRouter(),
 	}
 
 	for _, option := range options {
@@ -282,6 +287,9 @@ func (app *BaseApp) Router() sdk.Router {
 // QueryRouter returns the QueryRouter of a BaseApp.
 func (app *BaseApp) QueryRouter() sdk.QueryRouter { return app.queryRouter }
 
+// GRPCQueryRouter returns the GRPCQueryRouter of a BaseApp.
+func (app *BaseApp) GRPCQueryRouter() *GRPCQueryRouter { return app.grpcQueryRouter }
+
 // Seal seals a BaseApp. It prohibits any further modifications to a BaseApp.
 func (app *BaseApp) Seal() { app.sealed = true }
 
@@ -582,6 +590,18 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.
 	// and we're in DeliverTx. Note, runMsgs will never return a reference to a
 	// Result if any single message fails or does not have a registered Handler.
 	result, err = app.runMsgs(runMsgCtx, msgs, mode)
+	if err == nil && app.postHandler != nil {
+		// The PostHandler runs on the state of the messages, and its events are
+		// appended to theirs. If it fails, the state changes of the messages are
+		// discarded along with its own.
+		postCtx := runMsgCtx.WithEventManager(sdk.NewEventManager())
+		if _, err := app.postHandler(postCtx, tx, mode == runTxModeSimulate); err != nil {
+			return gInfo, nil, err
+		}
+
+		result.Events = append(result.Events, postCtx.EventManager().ABCIEvents()...)
+	}
+
 	if err == nil && mode == runTxModeDeliver {
 		msCache.Write()
 	}
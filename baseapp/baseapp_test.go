@@ -1336,6 +1336,78 @@ func TestBaseAppAnteHandler(t *testing.T) {
 	app.Commit()
 }
 
+func TestBaseAppPostHandler(t *testing.T) {
+	postKey := []byte("post-key")
+	postOpt := func(bapp *BaseApp) {
+		bapp.SetPostHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			if tx.(txTest).Counter == 2 {
+				return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "post handler failure")
+			}
+
+			store := ctx.KVStore(capKey1)
+			setIntOnStore(store, postKey, getIntFromStore(store, postKey)+1)
+			return ctx, nil
+		})
+	}
+
+	deliverKey := []byte("deliver-key")
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, deliverKey))
+	}
+
+	cdc := codec.New()
+	app := setupBaseApp(t, postOpt, routerOpt)
+
+	app.InitChain(abci.RequestInitChain{})
+	registerTestCodec(cdc)
+
+	header := abci.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	deliver := func(tx *txTest) abci.ResponseDeliverTx {
+		txBytes, err := cdc.MarshalBinaryBare(tx)
+		require.NoError(t, err)
+
+		return app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	}
+
+	// the post handler doesn't run when the message handler fails
+	tx := newTxCounter(0, 0)
+	tx.setFailOnHandler(true)
+	res := deliver(tx)
+	require.False(t, res.IsOK(), fmt.Sprintf("%v", res))
+
+	store := app.getState(runTxModeDeliver).ctx.KVStore(capKey1)
+	require.Equal(t, int64(0), getIntFromStore(store, postKey))
+
+	// the post handler runs after the messages succeed
+	res = deliver(newTxCounter(1, 0))
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+
+	store = app.getState(runTxModeDeliver).ctx.KVStore(capKey1)
+	require.Equal(t, int64(1), getIntFromStore(store, deliverKey))
+	require.Equal(t, int64(1), getIntFromStore(store, postKey))
+
+	// the state changes of the messages are discarded when the post handler fails
+	res = deliver(newTxCounter(2, 1))
+	require.False(t, res.IsOK(), fmt.Sprintf("%v", res))
+
+	store = app.getState(runTxModeDeliver).ctx.KVStore(capKey1)
+	require.Equal(t, int64(1), getIntFromStore(store, deliverKey))
+	require.Equal(t, int64(1), getIntFromStore(store, postKey))
+
+	res = deliver(newTxCounter(3, 1))
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+
+	store = app.getState(runTxModeDeliver).ctx.KVStore(capKey1)
+	require.Equal(t, int64(2), getIntFromStore(store, deliverKey))
+	require.Equal(t, int64(2), getIntFromStore(store, postKey))
+
+	// commit
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+}
+
 func TestGasConsumptionBadTx(t *testing.T) {
 	gasWanted := uint64(5)
 	anteOpt := func(bapp *BaseApp) {
@@ -1465,6 +1537,31 @@ func TestQuery(t *testing.T) {
 	require.Equal(t, value, res.Value)
 }
 
+// Test that the queries at heights newer than the latest one fail with a typed error.
+func TestQueryHeight(t *testing.T) {
+	querierOpt := func(bapp *BaseApp) {
+		bapp.QueryRouter().AddRoute("test", func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+			return []byte("result"), nil
+		})
+	}
+
+	app := setupBaseApp(t, querierOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1}})
+	app.Commit()
+
+	for _, path := range []string{"/custom/test", "/store/key1/key"} {
+		res := app.Query(abci.RequestQuery{Path: path, Data: []byte("key"), Height: 1})
+		require.True(t, res.IsOK(), res.Log)
+		require.Equal(t, int64(1), res.Height)
+
+		res = app.Query(abci.RequestQuery{Path: path, Data: []byte("key"), Height: 2})
+		require.Equal(t, sdkerrors.ErrInvalidHeight.Codespace(), res.Codespace, path)
+		require.Equal(t, sdkerrors.ErrInvalidHeight.ABCICode(), res.Code, path)
+	}
+}
+
 // Test p2p filter queries
 func TestP2PQuery(t *testing.T) {
 	addrPeerFilterOpt := func(bapp *BaseApp) {
@@ -1514,6 +1611,27 @@ func TestGetMaximumBlockGas(t *testing.T) {
 	require.Panics(t, func() { app.getMaximumBlockGas(ctx) })
 }
 
+func TestEndBlockConsensusParamUpdates(t *testing.T) {
+	app := setupBaseApp(t)
+	app.InitChain(abci.RequestInitChain{
+		ConsensusParams: &abci.ConsensusParams{
+			Block: &abci.BlockParams{MaxBytes: 200000, MaxGas: -1},
+		},
+	})
+
+	header := abci.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	// the consensus params changed during the block are returned at its end
+	app.StoreConsensusParams(app.deliverState.ctx, &abci.ConsensusParams{
+		Block: &abci.BlockParams{MaxBytes: 400000, MaxGas: 5000000},
+	})
+
+	res := app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	require.NotNil(t, res.ConsensusParamUpdates)
+	require.Equal(t, &abci.BlockParams{MaxBytes: 400000, MaxGas: 5000000}, res.ConsensusParamUpdates.Block)
+}
+
 // NOTE: represents a new custom router for testing purposes of WithRouter()
 type testCustomRouter struct {
 	routes sync.Map
@@ -0,0 +1,81 @@
+package baseapp
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GRPCQueryHandler defines a function type which handles an ABCI query routed
+// to a gRPC query service method.
+type GRPCQueryHandler = func(ctx sdk.Context, req abci.RequestQuery) (abci.ResponseQuery, error)
+
+// GRPCQueryRouter routes the ABCI queries whose path is the full name of a gRPC
+// method, e.g. "/cosmos_sdk.x.mint.v1.Query/Params", to the gRPC query services
+// registered with it. The request and response of the methods are protobuf
+// encoded in the data and value of the ABCI query.
+type GRPCQueryRouter struct {
+	routes map[string]GRPCQueryHandler
+}
+
+// NewGRPCQueryRouter returns a reference to a new GRPCQueryRouter.
+func NewGRPCQueryRouter() *GRPCQueryRouter {
+	return &GRPCQueryRouter{
+		routes: map[string]GRPCQueryHandler{},
+	}
+}
+
+// Route returns the GRPCQueryHandler for a given gRPC method full name, or nil
+// if no service registered it.
+func (qrt *GRPCQueryRouter) Route(path string) GRPCQueryHandler {
+	return qrt.routes[path]
+}
+
+// RegisterService implements the same method of *grpc.Server, so that the
+// query services can be registered with the GRPCQueryRouter the same way. It
+// adds a route for each of the unary methods of the service description to the
+// handler implementing it, and panics if a method was already registered.
+func (qrt *GRPCQueryRouter) RegisterService(sd *grpc.ServiceDesc, handler interface{}) {
+	for _, method := range sd.Methods {
+		fqName := fmt.Sprintf("/%s/%s", sd.ServiceName, method.MethodName)
+		methodHandler := method.Handler
+
+		if qrt.routes[fqName] != nil {
+			panic(fmt.Sprintf("gRPC query route %s has already been registered", fqName))
+		}
+
+		qrt.routes[fqName] = func(ctx sdk.Context, req abci.RequestQuery) (abci.ResponseQuery, error) {
+			res, err := methodHandler(handler, sdk.WrapSDKContext(ctx), func(i interface{}) error {
+				msg, ok := i.(codec.ProtoMarshaler)
+				if !ok {
+					return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%T is not a protobuf message", i)
+				}
+
+				return msg.Unmarshal(req.Data)
+			}, nil)
+			if err != nil {
+				return abci.ResponseQuery{}, err
+			}
+
+			msg, ok := res.(codec.ProtoMarshaler)
+			if !ok {
+				return abci.ResponseQuery{}, fmt.Errorf("%T is not a protobuf message", res)
+			}
+
+			resBytes, err := msg.Marshal()
+			if err != nil {
+				return abci.ResponseQuery{}, err
+			}
+
+			return abci.ResponseQuery{
+				Height: req.Height,
+				Value:  resBytes,
+			}, nil
+		}
+	}
+}
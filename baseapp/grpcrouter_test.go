@@ -0,0 +1,62 @@
+package baseapp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type echoServer struct{}
+
+func (echoServer) Echo(ctx context.Context, req *abci.RequestEcho) (*abci.ResponseEcho, error) {
+	return &abci.ResponseEcho{Message: req.Message + sdk.UnwrapSDKContext(ctx).ChainID()}, nil
+}
+
+var testServiceDesc = grpc.ServiceDesc{
+	ServiceName: "testpb.EchoService",
+	HandlerType: (*interface{})(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Echo",
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+				in := new(abci.RequestEcho)
+				if err := dec(in); err != nil {
+					return nil, err
+				}
+
+				return srv.(echoServer).Echo(ctx, in)
+			},
+		},
+	},
+}
+
+func TestGRPCQueryRouter(t *testing.T) {
+	qr := NewGRPCQueryRouter()
+	qr.RegisterService(&testServiceDesc, echoServer{})
+
+	require.Nil(t, qr.Route("/testpb.EchoService/Unknown"))
+	handler := qr.Route("/testpb.EchoService/Echo")
+	require.NotNil(t, handler)
+
+	reqBz, err := (&abci.RequestEcho{Message: "hello "}).Marshal()
+	require.NoError(t, err)
+
+	ctx := sdk.NewContext(nil, abci.Header{ChainID: "test-chain"}, true, nil)
+	res, err := handler(ctx, abci.RequestQuery{Data: reqBz, Height: 3})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), res.Height)
+
+	var echo abci.ResponseEcho
+	require.NoError(t, echo.Unmarshal(res.Value))
+	require.Equal(t, "hello test-chain", echo.Message)
+
+	// require panic on duplicate route
+	require.Panics(t, func() {
+		qr.RegisterService(&testServiceDesc, echoServer{})
+	})
+}
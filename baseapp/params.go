@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -39,6 +40,10 @@ func ValidateBlockParams(i interface{}) error {
 		return fmt.Errorf("block maximum bytes must be positive: %d", v.MaxBytes)
 	}
 
+	if v.MaxBytes > tmtypes.MaxBlockSizeBytes {
+		return fmt.Errorf("block maximum bytes must not exceed %d: %d", tmtypes.MaxBlockSizeBytes, v.MaxBytes)
+	}
+
 	if v.MaxGas < -1 {
 		return fmt.Errorf("block maximum gas must be greater than or equal to -1: %d", v.MaxGas)
 	}
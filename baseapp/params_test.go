@@ -19,6 +19,7 @@ func TestValidateBlockParams(t *testing.T) {
 		{abci.BlockParams{}, true},
 		{abci.BlockParams{MaxBytes: -1, MaxGas: -1}, true},
 		{abci.BlockParams{MaxBytes: 2000000, MaxGas: -5}, true},
+		{abci.BlockParams{MaxBytes: 104857601, MaxGas: 300000}, true},
 		{abci.BlockParams{MaxBytes: 2000000, MaxGas: 300000}, false},
 	}
 
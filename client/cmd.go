@@ -1,11 +1,17 @@
 package client
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
 	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/cli"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // ValidateCmd returns unknown command error or Help display if help flag set
@@ -55,3 +61,65 @@ func ValidateCmd(cmd *cobra.Command, args []string) error {
 
 	return cmd.Help()
 }
+
+// ErrorOutput is the output of the failed commands in the JSON output mode, with
+// the ABCI code and codespace of their error, which are the ones of the internal
+// error if it isn't registered.
+type ErrorOutput struct {
+	Error     string `json:"error"`
+	Code      uint32 `json:"code"`
+	Codespace string `json:"codespace"`
+}
+
+// NewErrorOutput returns the ErrorOutput of an error.
+func NewErrorOutput(err error) ErrorOutput {
+	codespace, code, _ := sdkerrors.ABCIInfo(err, false)
+
+	return ErrorOutput{
+		Error:     err.Error(),
+		Code:      code,
+		Codespace: codespace,
+	}
+}
+
+// PrintError prints the error of a command to w, as an ErrorOutput JSON object
+// if the --output flag is json, or else as text, with its stack trace if the
+// --trace flag is set.
+func PrintError(w io.Writer, err error) {
+	switch {
+	case viper.GetString(cli.OutputFlag) == "json":
+		out, _ := json.Marshal(NewErrorOutput(err))
+		fmt.Fprintln(w, string(out))
+
+	case viper.GetBool(cli.TraceFlag):
+		fmt.Fprintf(w, "ERROR: %+v\n", err)
+
+	default:
+		fmt.Fprintf(w, "ERROR: %v\n", err)
+	}
+}
+
+// Execute executes the root command of the executor, as its Execute method,
+// but prints the error of the failed commands with PrintError to the error
+// output of the command, so that their errors are JSON objects as their output
+// in the JSON output mode.
+func Execute(executor cli.Executor) error {
+	executor.SilenceUsage = true
+	executor.SilenceErrors = true
+
+	err := executor.Command.Execute()
+	if err == nil {
+		return nil
+	}
+
+	PrintError(executor.ErrOrStderr(), err)
+
+	// return error code 1 by default, can override it with a special error type
+	exitCode := 1
+	if ec, ok := err.(cli.ExitCoder); ok {
+		exitCode = ec.ExitCode()
+	}
+	executor.Exit(exitCode)
+
+	return err
+}
@@ -1,12 +1,18 @@
 package client_test
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"testing"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/cli"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 func TestValidateCmd(t *testing.T) {
@@ -50,3 +56,44 @@ func TestValidateCmd(t *testing.T) {
 		require.Equal(t, tt.wantErr, err != nil, tt.reason)
 	}
 }
+
+func TestExecute(t *testing.T) {
+	defer viper.Reset()
+
+	cmdErr := sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, "10stake is smaller than 20stake")
+	rootCmd := &cobra.Command{
+		Use:  "root",
+		RunE: func(*cobra.Command, []string) error { return cmdErr },
+	}
+	rootCmd.SetArgs([]string{})
+
+	var exitCode int
+	executor := cli.Executor{Command: rootCmd, Exit: func(code int) { exitCode = code }}
+
+	errOut := new(bytes.Buffer)
+	rootCmd.SetErr(errOut)
+
+	require.Equal(t, cmdErr, client.Execute(executor))
+	require.Equal(t, 1, exitCode)
+	require.Contains(t, errOut.String(), "ERROR: 10stake is smaller than 20stake: insufficient funds")
+
+	// in the JSON output mode, the errors are JSON objects with their codes
+	viper.Set(cli.OutputFlag, "json")
+	errOut.Reset()
+	require.Equal(t, cmdErr, client.Execute(executor))
+
+	var output client.ErrorOutput
+	require.NoError(t, json.Unmarshal(errOut.Bytes(), &output))
+	require.Equal(t, client.ErrorOutput{
+		Error:     "10stake is smaller than 20stake: insufficient funds",
+		Code:      sdkerrors.ErrInsufficientFunds.ABCICode(),
+		Codespace: sdkerrors.RootCodespace,
+	}, output)
+
+	// which are the ones of the internal error for the unregistered errors
+	require.Equal(t, client.ErrorOutput{
+		Error:     "unregistered",
+		Code:      1,
+		Codespace: sdkerrors.UndefinedCodespace,
+	}, client.NewErrorOutput(errors.New("unregistered")))
+}
@@ -34,6 +34,7 @@ var configBoolDefaults = map[string]bool{
 	"trust-node": false,
 	"indent":     false,
 	"offline":    false,
+	"swagger":    true,
 }
 
 // ConfigCmd returns a CLI command to interactively create an application CLI
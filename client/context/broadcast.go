@@ -15,8 +15,14 @@ import (
 // BroadcastTx broadcasts a transactions either synchronously or asynchronously
 // based on the context parameters. The result of the broadcast is parsed into
 // an intermediate structure which is logged if the context has a logger
-// defined.
+// defined. Whatever the mode, the response holds the hash of the tx; the sync
+// and block modes also return its code and raw log, and the block mode the
+// height, gas and events of its execution.
 func (ctx CLIContext) BroadcastTx(txBytes []byte) (res sdk.TxResponse, err error) {
+	if err := flags.ValidateBroadcastMode(ctx.BroadcastMode); err != nil {
+		return sdk.TxResponse{}, err
+	}
+
 	switch ctx.BroadcastMode {
 	case flags.BroadcastSync:
 		res, err = ctx.BroadcastTxSync(txBytes)
@@ -26,9 +32,6 @@ func (ctx CLIContext) BroadcastTx(txBytes []byte) (res sdk.TxResponse, err error
 
 	case flags.BroadcastBlock:
 		res, err = ctx.BroadcastTxCommit(txBytes)
-
-	default:
-		return sdk.TxResponse{}, fmt.Errorf("unsupported return type %s; supported types: sync, async, block", ctx.BroadcastMode)
 	}
 
 	return res, err
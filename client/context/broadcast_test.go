@@ -65,5 +65,12 @@ func TestBroadcastError(t *testing.T) {
 			require.Equal(t, txHash, resp.TxHash)
 		}
 	}
+}
 
+func TestBroadcastUnsupportedMode(t *testing.T) {
+	for _, mode := range []string{"", "commit"} {
+		ctx := CreateContextWithErrorAndMode(nil, mode)
+		_, err := ctx.BroadcastTx([]byte{0xA, 0xB})
+		require.EqualError(t, err, fmt.Sprintf("unsupported broadcast mode %q; supported modes: sync, async, block", mode))
+	}
 }
@@ -66,7 +66,7 @@ func NewCLIContextWithInputAndFrom(input io.Reader, from string) CLIContext {
 		backend = keyring.BackendMemory
 	}
 
-	keyring, err := newKeyringFromFlags(backend, homedir, input, genOnly)
+	keyring, err := newKeyringFromFlags(backend, homedir, input, from, genOnly)
 	if err != nil {
 		panic(fmt.Errorf("couldn't acquire keyring: %v", err))
 	}
@@ -330,40 +330,41 @@ func (ctx CLIContext) PrintOutput(toPrint interface{}) error {
 }
 
 // GetFromFields returns a from account address and Keybase name given either
-// an address or key name. If genOnly is true, only a valid Bech32 cosmos
-// address is returned.
+// an address or key name. If genOnly is true and from is a valid Bech32 cosmos
+// address, only the address is returned. Otherwise the key is looked up in the
+// keyring, which may hold offline and multisig keys that cannot sign but can
+// be used to build unsigned transactions.
 func GetFromFields(kr keyring.Keyring, from string, genOnly bool) (sdk.AccAddress, string, error) {
 	if from == "" {
 		return nil, "", nil
 	}
 
-	if genOnly {
-		addr, err := sdk.AccAddressFromBech32(from)
-		if err != nil {
-			return nil, "", errors.Wrap(err, "must provide a valid Bech32 address in generate-only mode")
-		}
-
+	addr, err := sdk.AccAddressFromBech32(from)
+	if genOnly && err == nil {
 		return addr, "", nil
 	}
 
 	var info keyring.Info
-	if addr, err := sdk.AccAddressFromBech32(from); err == nil {
+	if err == nil {
 		info, err = kr.KeyByAddress(addr)
-		if err != nil {
-			return nil, "", err
-		}
 	} else {
 		info, err = kr.Key(from)
-		if err != nil {
-			return nil, "", err
+	}
+
+	if err != nil {
+		if genOnly {
+			return nil, "", errors.Wrap(err, "must provide a valid Bech32 address or key name in generate-only mode")
 		}
+
+		return nil, "", err
 	}
 
 	return info.GetAddress(), info.GetName(), nil
 }
 
-func newKeyringFromFlags(backend, homedir string, input io.Reader, genOnly bool) (keyring.Keyring, error) {
-	if genOnly {
+func newKeyringFromFlags(backend, homedir string, input io.Reader, from string, genOnly bool) (keyring.Keyring, error) {
+	// generate-only mode doesn't access the keyring, unless the key is given by name
+	if _, err := sdk.AccAddressFromBech32(from); genOnly && (from == "" || err == nil) {
 		return keyring.New(sdk.KeyringServiceName(), keyring.BackendMemory, homedir, input)
 	}
 	return keyring.New(sdk.KeyringServiceName(), backend, homedir, input)
@@ -4,6 +4,11 @@ import (
 	"os"
 	"testing"
 
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/multisig"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -72,6 +77,55 @@ func TestCLIContext_WithKeyring(t *testing.T) {
 	require.Equal(t, kr, ctx.Keyring)
 }
 
+func TestGetFromFields(t *testing.T) {
+	kr := keyring.NewInMemory()
+
+	// offline and multisig keys hold no private key but can be used as signers
+	offlinePub := secp256k1.GenPrivKey().PubKey()
+	offline, err := kr.SavePubKey("offline", offlinePub, hd.Secp256k1Type)
+	require.NoError(t, err)
+
+	multiPub := multisig.NewPubKeyMultisigThreshold(2, []crypto.PubKey{
+		offlinePub, secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey(),
+	})
+	multi, err := kr.SaveMultisig("multi", multiPub)
+	require.NoError(t, err)
+
+	unknownAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	tests := []struct {
+		name             string
+		from             string
+		genOnly          bool
+		expectedFromAddr sdk.AccAddress
+		expectedFromName string
+		expectErr        bool
+	}{
+		{"offline key by name", "offline", false, offline.GetAddress(), "offline", false},
+		{"offline key by address", offline.GetAddress().String(), false, offline.GetAddress(), "offline", false},
+		{"multisig key by name", "multi", false, multi.GetAddress(), "multi", false},
+		{"multisig key by name in generate-only mode", "multi", true, multi.GetAddress(), "multi", false},
+		{"address in generate-only mode", unknownAddr.String(), true, unknownAddr, "", false},
+		{"unknown address", unknownAddr.String(), false, nil, "", true},
+		{"unknown key name in generate-only mode", "unknown", true, nil, "", true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			fromAddr, fromName, err := context.GetFromFields(kr, tt.from, tt.genOnly)
+			if tt.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedFromAddr, fromAddr)
+			require.Equal(t, tt.expectedFromName, fromName)
+		})
+	}
+}
+
 func TestMain(m *testing.M) {
 	viper.Set(flags.FlagKeyringBackend, keyring.BackendMemory)
 	os.Exit(m.Run())
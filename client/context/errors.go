@@ -3,7 +3,10 @@ package context
 import (
 	"fmt"
 
+	abci "github.com/tendermint/tendermint/abci/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // ErrInvalidAccount returns a standardized error reflecting that a given
@@ -20,3 +23,26 @@ func ErrVerifyCommit(height int64) error {
 	return fmt.Errorf(`the height of base truststore in the light client is higher than height %d. 
 Can't verify blockchain proof at this height. Please set --trust-node to true and try again`, height)
 }
+
+// queryError is the error of a failed ABCI query. Its message is the log of the
+// response, and its cause is the registered error of the code of the response, so
+// that e.g. the queries at pruned heights can be told with sdkerrors.ErrHeightPruned.Is.
+type queryError struct {
+	log   string
+	cause error
+}
+
+func newQueryError(res abci.ResponseQuery) error {
+	return queryError{
+		log:   res.Log,
+		cause: sdkerrors.ABCIError(res.Codespace, res.Code, res.Log),
+	}
+}
+
+func (e queryError) Error() string { return e.log }
+
+// Cause returns the registered error of the response code.
+func (e queryError) Cause() error { return e.cause }
+
+// Unwrap implements the standard errors.Unwrap interface.
+func (e queryError) Unwrap() error { return e.cause }
@@ -14,8 +14,10 @@ import (
 	rpcclient "github.com/tendermint/tendermint/rpc/client"
 	tmtypes "github.com/tendermint/tendermint/types"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/rootmulti"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // GetNode returns an RPC client. If the context's client is not defined, an
@@ -42,6 +44,24 @@ func (ctx CLIContext) QueryWithData(path string, data []byte) ([]byte, int64, er
 	return ctx.query(path, data)
 }
 
+// QueryGRPC performs a query to a Tendermint node of the gRPC query service
+// method with the provided full name, e.g. "/cosmos_sdk.x.mint.v1.Query/Params",
+// with the protobuf encoded request. It decodes the response into res and
+// returns the height of the query upon success or an error if the query fails.
+func (ctx CLIContext) QueryGRPC(method string, req, res codec.ProtoMarshaler) (int64, error) {
+	reqBz, err := req.Marshal()
+	if err != nil {
+		return 0, err
+	}
+
+	resBz, height, err := ctx.query(method, reqBz)
+	if err != nil {
+		return height, err
+	}
+
+	return height, res.Unmarshal(resBz)
+}
+
 // QueryStore performs a query to a Tendermint node with the provided key and
 // store name. It returns the result and height of the query upon success
 // or an error if the query fails.
@@ -84,6 +104,10 @@ func (ctx CLIContext) queryABCI(req abci.RequestQuery) (abci.ResponseQuery, erro
 		return abci.ResponseQuery{}, err
 	}
 
+	if ctx.Height < 0 {
+		return abci.ResponseQuery{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidHeight, "negative query height %d", ctx.Height)
+	}
+
 	opts := rpcclient.ABCIQueryOptions{
 		Height: ctx.Height,
 		Prove:  req.Prove || !ctx.TrustNode,
@@ -95,7 +119,7 @@ func (ctx CLIContext) queryABCI(req abci.RequestQuery) (abci.ResponseQuery, erro
 	}
 
 	if !result.Response.IsOK() {
-		return abci.ResponseQuery{}, errors.New(result.Response.Log)
+		return abci.ResponseQuery{}, newQueryError(result.Response)
 	}
 
 	// data from trusted node or subspace query doesn't need verification
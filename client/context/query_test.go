@@ -0,0 +1,59 @@
+package context_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	"github.com/tendermint/tendermint/rpc/client/mock"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// mockQueryClient answers the ABCI queries with the response of their height.
+type mockQueryClient struct {
+	mock.Client
+	responses map[int64]abci.ResponseQuery
+}
+
+func (c mockQueryClient) ABCIQueryWithOptions(
+	_ string, _ tmbytes.HexBytes, opts rpcclient.ABCIQueryOptions,
+) (*ctypes.ResultABCIQuery, error) {
+	return &ctypes.ResultABCIQuery{Response: c.responses[opts.Height]}, nil
+}
+
+func TestQueryHeight(t *testing.T) {
+	client := mockQueryClient{responses: map[int64]abci.ResponseQuery{
+		3: {Value: []byte("value"), Height: 3},
+		2: sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrHeightPruned, "failed to load state at height 2")),
+		5: sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, "cannot query height 5")),
+		4: sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown query path")),
+	}}
+	ctx := context.CLIContext{TrustNode: true}.WithClient(client)
+
+	res, height, err := ctx.WithHeight(3).Query("/custom/test")
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), res)
+	require.Equal(t, int64(3), height)
+
+	// the errors of the queries match the registered errors of their codes, and
+	// hold the log of the response
+	_, _, err = ctx.WithHeight(2).Query("/custom/test")
+	require.True(t, sdkerrors.ErrHeightPruned.Is(err))
+	require.EqualError(t, err, "failed to load state at height 2: height pruned")
+
+	_, _, err = ctx.WithHeight(5).Query("/custom/test")
+	require.True(t, sdkerrors.ErrInvalidHeight.Is(err))
+
+	_, _, err = ctx.WithHeight(4).Query("/custom/test")
+	require.False(t, sdkerrors.ErrHeightPruned.Is(err))
+	require.True(t, sdkerrors.ErrUnknownRequest.Is(err))
+
+	// the negative heights are rejected before querying the node
+	_, _, err = ctx.WithHeight(-1).Query("/custom/test")
+	require.True(t, sdkerrors.ErrInvalidHeight.Is(err))
+}
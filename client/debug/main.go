@@ -3,14 +3,20 @@ package debug
 import (
 	"encoding/base64"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/libs/cli"
+	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -18,6 +24,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/version"
 )
 
+const flagAll = "all"
+
 func Cmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "debug",
@@ -28,26 +36,34 @@ func Cmd(cdc *codec.Codec) *cobra.Command {
 	cmd.AddCommand(PubkeyCmd(cdc))
 	cmd.AddCommand(AddrCmd())
 	cmd.AddCommand(RawBytesCmd())
+	cmd.AddCommand(TxCmd(cdc))
 
 	return cmd
 }
 
-// getPubKeyFromString returns a Tendermint PubKey (PubKeyEd25519) by attempting
-// to decode the pubkey string from hex, base64, and finally bech32. If all
-// encodings fail, an error is returned.
-func getPubKeyFromString(pkstr string) (crypto.PubKey, error) {
-	var pubKey ed25519.PubKeyEd25519
-
-	bz, err := hex.DecodeString(pkstr)
+// decodeBytes decodes the given string from hex, or else from base64.
+func decodeBytes(str string) ([]byte, error) {
+	bz, err := hex.DecodeString(str)
 	if err == nil {
-		copy(pubKey[:], bz)
-		return pubKey, nil
+		return bz, nil
 	}
 
-	bz, err = base64.StdEncoding.DecodeString(pkstr)
+	bz, err = base64.StdEncoding.DecodeString(str)
 	if err == nil {
-		copy(pubKey[:], bz)
-		return pubKey, nil
+		return bz, nil
+	}
+
+	return nil, fmt.Errorf("'%s' invalid; expected hex or base64", str)
+}
+
+// getPubKeyFromString returns a Tendermint PubKey by attempting to decode the
+// pubkey string from hex, base64, and finally bech32. The hex and base64
+// encoded pubkeys are either raw ED25519 or compressed secp256k1 keys, or Amino
+// encoded keys of any type registered on the codec. If all encodings fail, an
+// error is returned.
+func getPubKeyFromString(cdc *codec.Codec, pkstr string) (crypto.PubKey, error) {
+	if bz, err := decodeBytes(pkstr); err == nil {
+		return getPubKeyFromBytes(cdc, bz)
 	}
 
 	pk, err := sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeAccPub, pkstr)
@@ -65,56 +81,86 @@ func getPubKeyFromString(pkstr string) (crypto.PubKey, error) {
 		return pk, nil
 	}
 
-	return nil, fmt.Errorf("pubkey '%s' invalid; expected hex, base64, or bech32", pubKey)
+	return nil, fmt.Errorf("pubkey '%s' invalid; expected hex, base64, or bech32", pkstr)
+}
+
+func getPubKeyFromBytes(cdc *codec.Codec, bz []byte) (crypto.PubKey, error) {
+	switch len(bz) {
+	case ed25519.PubKeyEd25519Size:
+		var pubKey ed25519.PubKeyEd25519
+		copy(pubKey[:], bz)
+		return pubKey, nil
+
+	case secp256k1.PubKeySecp256k1Size:
+		var pubKey secp256k1.PubKeySecp256k1
+		copy(pubKey[:], bz)
+		return pubKey, nil
+	}
+
+	var pubKey crypto.PubKey
+	if err := cdc.UnmarshalBinaryBare(bz, &pubKey); err != nil {
+		return nil, fmt.Errorf("pubkey bytes %X invalid; expected a raw ED25519 or secp256k1 key, or an Amino encoded key: %w", bz, err)
+	}
+
+	return pubKey, nil
 }
 
 func PubkeyCmd(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
 		Use:   "pubkey [pubkey]",
-		Short: "Decode a ED25519 pubkey from hex, base64, or bech32",
-		Long: fmt.Sprintf(`Decode a pubkey from hex, base64, or bech32.
+		Short: "Decode a pubkey from hex, base64, or bech32",
+		Long: fmt.Sprintf(`Decode a pubkey from hex, base64, or bech32, and print all its representations.
+
+The hex and base64 encoded pubkeys are either raw ED25519 or compressed secp256k1 keys, or
+Amino encoded keys of any type, e.g. multisig keys.
 
 Example:
-$ %s debug pubkey TWFuIGlzIGRpc3Rpbmd1aXNoZWQsIG5vdCBvbmx5IGJ5IGhpcyByZWFzb24sIGJ1dCBieSB0aGlz
-$ %s debug pubkey cosmos1e0jnq2sun3dzjh8p2xq95kk0expwmd7shwjpfg
+$ %s debug pubkey RCmTBlAEqh5MSPTdAVgZTAI0m8xmTNluQA6iaZGKjVE=
+$ %s debug pubkey cosmosvalconspub1zcjduepqgs5expjsqj4punzg7nwszkqefsprfx7vvexdjmjqp63xnyv234gs682cm6
 			`, version.ClientName, version.ClientName),
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			pk, err := getPubKeyFromString(args[0])
+			pk, err := getPubKeyFromString(cdc, args[0])
 			if err != nil {
 				return err
 			}
 
-			edPK, ok := pk.(ed25519.PubKeyEd25519)
-			if !ok {
-				return fmt.Errorf("invalid pubkey type; expected ED25519")
-			}
-
-			pubKeyJSONBytes, err := cdc.MarshalJSON(edPK)
+			pubKeyJSONBytes, err := cdc.MarshalJSON(pk)
 			if err != nil {
 				return err
 			}
-			accPub, err := sdk.Bech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, edPK)
+			accPub, err := sdk.Bech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, pk)
 			if err != nil {
 				return err
 			}
-			valPub, err := sdk.Bech32ifyPubKey(sdk.Bech32PubKeyTypeValPub, edPK)
+			valPub, err := sdk.Bech32ifyPubKey(sdk.Bech32PubKeyTypeValPub, pk)
 			if err != nil {
 				return err
 			}
-			consenusPub, err := sdk.Bech32ifyPubKey(sdk.Bech32PubKeyTypeConsPub, edPK)
+			consenusPub, err := sdk.Bech32ifyPubKey(sdk.Bech32PubKeyTypeConsPub, pk)
 			if err != nil {
 				return err
 			}
 
-			cmd.Println("Address:", edPK.Address())
-			cmd.Printf("Hex: %X\n", edPK[:])
-			cmd.Println("JSON (base64):", string(pubKeyJSONBytes))
-			cmd.Println("Bech32 Acc:", accPub)
-			cmd.Println("Bech32 Validator Operator:", valPub)
-			cmd.Println("Bech32 Validator Consensus:", consenusPub)
+			fields := []field{textField("Address", "address", pk.Address().String())}
+			switch pk := pk.(type) {
+			case ed25519.PubKeyEd25519:
+				fields = append(fields, textField("Hex", "hex", fmt.Sprintf("%X", pk[:])))
+			case secp256k1.PubKeySecp256k1:
+				fields = append(fields, textField("Hex", "hex", fmt.Sprintf("%X", pk[:])))
+			}
 
-			return nil
+			return printFields(cmd, append(fields,
+				textField("Amino (hex)", "amino_hex", fmt.Sprintf("%X", pk.Bytes())),
+				textField("Amino (base64)", "amino_base64", base64.StdEncoding.EncodeToString(pk.Bytes())),
+				field{label: "JSON (base64)", key: "json", text: string(pubKeyJSONBytes), value: json.RawMessage(pubKeyJSONBytes)},
+				textField("Bech32 Acc", "bech32_acc", accPub),
+				textField("Bech32 Validator Operator", "bech32_val", valPub),
+				textField("Bech32 Validator Consensus", "bech32_cons", consenusPub),
+				textField("Bech32 Acc Address", "bech32_acc_address", sdk.AccAddress(pk.Address()).String()),
+				textField("Bech32 Validator Operator Address", "bech32_val_address", sdk.ValAddress(pk.Address()).String()),
+				textField("Bech32 Validator Consensus Address", "bech32_cons_address", sdk.ConsAddress(pk.Address()).String()),
+			))
 		},
 	}
 }
@@ -123,7 +169,8 @@ func AddrCmd() *cobra.Command {
 	return &cobra.Command{
 		Use:   "addr [address]",
 		Short: "Convert an address between hex and bech32",
-		Long: fmt.Sprintf(`Convert an address between hex encoding and bech32.
+		Long: fmt.Sprintf(`Convert an address between hex encoding and its account, validator operator and
+consensus bech32 encodings.
 			
 Example:
 $ %s debug addr cosmos1e0jnq2sun3dzjh8p2xq95kk0expwmd7shwjpfg
@@ -145,50 +192,203 @@ $ %s debug addr cosmos1e0jnq2sun3dzjh8p2xq95kk0expwmd7shwjpfg
 					addr, err3 = sdk.ValAddressFromBech32(addrString)
 
 					if err3 != nil {
-						return fmt.Errorf("expected hex or bech32. Got errors: hex: %v, bech32 acc: %v, bech32 val: %v", err, err2, err3)
+						var err4 error
+						addr, err4 = sdk.ConsAddressFromBech32(addrString)
 
+						if err4 != nil {
+							return fmt.Errorf(
+								"expected hex or bech32. Got errors: hex: %v, bech32 acc: %v, bech32 val: %v, bech32 cons: %v",
+								err, err2, err3, err4,
+							)
+						}
 					}
 				}
 			}
 
 			accAddr := sdk.AccAddress(addr)
 			valAddr := sdk.ValAddress(addr)
+			consAddr := sdk.ConsAddress(addr)
 
-			cmd.Println("Address:", addr)
-			cmd.Printf("Address (hex): %X\n", addr)
-			cmd.Printf("Bech32 Acc: %s\n", accAddr)
-			cmd.Printf("Bech32 Val: %s\n", valAddr)
-			return nil
+			return printFields(cmd, []field{
+				{label: "Address", key: "address", text: fmt.Sprint(addr), value: addr},
+				textField("Address (hex)", "hex", fmt.Sprintf("%X", addr)),
+				textField("Bech32 Acc", "bech32_acc", accAddr.String()),
+				textField("Bech32 Val", "bech32_val", valAddr.String()),
+				textField("Bech32 Cons", "bech32_cons", consAddr.String()),
+			})
 		},
 	}
 }
 
 func RawBytesCmd() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "raw-bytes [raw-bytes]",
 		Short: "Convert raw bytes output (eg. [10 21 13 255]) to hex",
 		Long: fmt.Sprintf(`Convert raw-bytes to hex.
-			
+
+The bytes may also be given in hex or base64, e.g. as found in the events or the
+evidence of a block, with the --all flag printing them in all these encodings,
+and as text if they are valid UTF-8.
+
 Example:
 $ %s debug raw-bytes [72 101 108 108 111 44 32 112 108 97 121 103 114 111 117 110 100]
-			`, version.ClientName),
+$ %s debug raw-bytes SGVsbG8sIHBsYXlncm91bmQ= --all
+			`, version.ClientName, version.ClientName),
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			stringBytes := args[0]
-			stringBytes = strings.Trim(stringBytes, "[")
-			stringBytes = strings.Trim(stringBytes, "]")
-			spl := strings.Split(stringBytes, " ")
-
-			byteArray := []byte{}
-			for _, s := range spl {
-				b, err := strconv.Atoi(s)
-				if err != nil {
-					return err
+			byteArray, err := parseRawBytes(args[0])
+			if err != nil {
+				return err
+			}
+
+			all, err := cmd.Flags().GetBool(flagAll)
+			if err != nil {
+				return err
+			}
+			if !all && viper.GetString(cli.OutputFlag) != "json" {
+				cmd.Printf("%X\n", byteArray)
+				return nil
+			}
+
+			fields := []field{textField("Hex", "hex", fmt.Sprintf("%X", byteArray))}
+			if all {
+				fields = append(fields,
+					textField("Base64", "base64", base64.StdEncoding.EncodeToString(byteArray)),
+					field{label: "Bytes", key: "bytes", text: fmt.Sprint(byteArray), value: bytesArray(byteArray)},
+				)
+				if utf8.Valid(byteArray) {
+					fields = append(fields, field{label: "Text", key: "text", text: fmt.Sprintf("%q", byteArray), value: string(byteArray)})
 				}
-				byteArray = append(byteArray, byte(b))
 			}
-			fmt.Printf("%X\n", byteArray)
+
+			return printFields(cmd, fields)
+		},
+	}
+
+	cmd.Flags().Bool(flagAll, false, "Print the bytes in hex, base64, as a byte array, and as text")
+	return cmd
+}
+
+// field is a labeled value of the output of a command, printed as "label: text",
+// or as the value of its key in a JSON object in the JSON output mode.
+type field struct {
+	label string
+	key   string
+	text  string
+	value interface{}
+}
+
+func textField(label, key, text string) field {
+	return field{label: label, key: key, text: text, value: text}
+}
+
+// bytesArray is a byte slice encoded in JSON as an array of numbers, rather
+// than in base64.
+type bytesArray []byte
+
+func (b bytesArray) MarshalJSON() ([]byte, error) {
+	ints := make([]int, len(b))
+	for i, v := range b {
+		ints[i] = int(v)
+	}
+
+	return json.Marshal(ints)
+}
+
+// printFields prints the fields of the output of a command, as text or, if the
+// --output flag is json, as a JSON object.
+func printFields(cmd *cobra.Command, fields []field) error {
+	if viper.GetString(cli.OutputFlag) == "json" {
+		obj := make(map[string]interface{}, len(fields))
+		for _, f := range fields {
+			obj[f.key] = f.value
+		}
+
+		out, err := json.Marshal(obj)
+		if err != nil {
+			return err
+		}
+
+		cmd.Println(string(out))
+		return nil
+	}
+
+	for _, f := range fields {
+		cmd.Printf("%s: %s\n", f.label, f.text)
+	}
+
+	return nil
+}
+
+// parseRawBytes parses the raw bytes output (eg. [10 21 13 255]), or else the
+// hex or base64 encoding of bytes.
+func parseRawBytes(str string) ([]byte, error) {
+	if !strings.HasPrefix(str, "[") {
+		return decodeBytes(str)
+	}
+
+	stringBytes := strings.Trim(str, "[")
+	stringBytes = strings.Trim(stringBytes, "]")
+	spl := strings.Fields(stringBytes)
+
+	byteArray := []byte{}
+	for _, s := range spl {
+		b, err := strconv.ParseUint(s, 10, 8)
+		if err != nil {
+			return nil, err
+		}
+		byteArray = append(byteArray, byte(b))
+	}
+
+	return byteArray, nil
+}
+
+// TxCmd returns the command decoding a tx.
+func TxCmd(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "tx [tx-bytes]",
+		Short: "Decode a hex or base64 encoded tx into JSON",
+		Long: fmt.Sprintf(`Decode an Amino encoded tx, in hex or base64, e.g. as returned by the
+unconfirmed_txs endpoint of Tendermint for the txs of the mempool, and print its hash
+and JSON encoding.
+
+Example:
+$ %s debug tx $(curl -s localhost:26657/unconfirmed_txs | jq -r '.result.txs[0]')
+			`, version.ClientName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBytes, err := decodeBytes(args[0])
+			if err != nil {
+				return err
+			}
+
+			out, err := decodeTx(cdc, txBytes)
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(string(out))
 			return nil
 		},
 	}
 }
+
+// decodedTx is a decoded tx, along with its hash.
+type decodedTx struct {
+	TxHash string `json:"txhash"`
+	Tx     sdk.Tx `json:"tx"`
+}
+
+// decodeTx returns the indented JSON encoding of the given Amino encoded tx,
+// whose type must be registered on the codec, along with its hash.
+func decodeTx(cdc *codec.Codec, txBytes []byte) ([]byte, error) {
+	var tx sdk.Tx
+	if err := cdc.UnmarshalBinaryBare(txBytes, &tx); err != nil {
+		return nil, err
+	}
+
+	return cdc.MarshalJSONIndent(decodedTx{
+		TxHash: fmt.Sprintf("%X", tmtypes.Tx(txBytes).Hash()),
+		Tx:     tx,
+	}, "", "  ")
+}
@@ -0,0 +1,162 @@
+package debug
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/multisig"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/libs/cli"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func makeCodec() *codec.Codec {
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	authtypes.RegisterCodec(cdc)
+	banktypes.RegisterCodec(cdc)
+
+	return cdc
+}
+
+func TestGetPubKeyFromString(t *testing.T) {
+	cdc := makeCodec()
+
+	edPub := ed25519.GenPrivKey().PubKey().(ed25519.PubKeyEd25519)
+	secpPub := secp256k1.GenPrivKey().PubKey().(secp256k1.PubKeySecp256k1)
+	multisigPub := multisig.NewPubKeyMultisigThreshold(1, []crypto.PubKey{edPub, secpPub})
+
+	testCases := []struct {
+		name     string
+		pkstr    string
+		expected crypto.PubKey
+	}{
+		{"raw ED25519 hex", hex.EncodeToString(edPub[:]), edPub},
+		{"raw ED25519 base64", base64.StdEncoding.EncodeToString(edPub[:]), edPub},
+		{"raw secp256k1 hex", hex.EncodeToString(secpPub[:]), secpPub},
+		{"Amino secp256k1 base64", base64.StdEncoding.EncodeToString(secpPub.Bytes()), secpPub},
+		{"Amino multisig hex", hex.EncodeToString(multisigPub.Bytes()), multisigPub},
+		{"bech32 acc", sdk.MustBech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, secpPub), secpPub},
+		{"bech32 cons", sdk.MustBech32ifyPubKey(sdk.Bech32PubKeyTypeConsPub, edPub), edPub},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			pk, err := getPubKeyFromString(cdc, tc.pkstr)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, pk)
+		})
+	}
+
+	_, err := getPubKeyFromString(cdc, "0102")
+	require.Error(t, err)
+	_, err = getPubKeyFromString(cdc, "not a pubkey")
+	require.Error(t, err)
+}
+
+func TestPubkeyCmd(t *testing.T) {
+	cdc := makeCodec()
+	pk := secp256k1.GenPrivKey().PubKey().(secp256k1.PubKeySecp256k1)
+
+	cmd := PubkeyCmd(cdc)
+	out := new(bytes.Buffer)
+	cmd.SetOut(out)
+	cmd.SetArgs([]string{sdk.MustBech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, pk)})
+	require.NoError(t, cmd.Execute())
+
+	require.Contains(t, out.String(), fmt.Sprintf("Hex: %X\n", pk[:]))
+	require.Contains(t, out.String(), fmt.Sprintf("Amino (hex): %X\n", pk.Bytes()))
+	require.Contains(t, out.String(), "Bech32 Validator Consensus: "+sdk.MustBech32ifyPubKey(sdk.Bech32PubKeyTypeConsPub, pk))
+	require.Contains(t, out.String(), "Bech32 Acc Address: "+sdk.AccAddress(pk.Address()).String())
+}
+
+func TestParseRawBytes(t *testing.T) {
+	expected := []byte("Hello")
+
+	for _, str := range []string{"[72 101 108 108 111]", "48656C6C6F", "SGVsbG8="} {
+		bz, err := parseRawBytes(str)
+		require.NoError(t, err, str)
+		require.Equal(t, expected, bz, str)
+	}
+
+	_, err := parseRawBytes("[72 101 256]")
+	require.Error(t, err)
+	_, err = parseRawBytes("not bytes")
+	require.Error(t, err)
+}
+
+func TestRawBytesCmd(t *testing.T) {
+	cmd := RawBytesCmd()
+	out := new(bytes.Buffer)
+	cmd.SetOut(out)
+	cmd.SetArgs([]string{"[72 101 108 108 111]"})
+	require.NoError(t, cmd.Execute())
+	require.Equal(t, "48656C6C6F\n", out.String())
+
+	cmd = RawBytesCmd()
+	out.Reset()
+	cmd.SetOut(out)
+	cmd.SetArgs([]string{"SGVsbG8=", "--all"})
+	require.NoError(t, cmd.Execute())
+	require.Equal(t, "Hex: 48656C6C6F\nBase64: SGVsbG8=\nBytes: [72 101 108 108 111]\nText: \"Hello\"\n", out.String())
+}
+
+func TestRawBytesCmdJSON(t *testing.T) {
+	viper.Set(cli.OutputFlag, "json")
+	defer viper.Set(cli.OutputFlag, "")
+
+	cmd := RawBytesCmd()
+	out := new(bytes.Buffer)
+	cmd.SetOut(out)
+	cmd.SetArgs([]string{"SGVsbG8=", "--all"})
+	require.NoError(t, cmd.Execute())
+	require.JSONEq(t, `{"hex":"48656C6C6F","base64":"SGVsbG8=","bytes":[72,101,108,108,111],"text":"Hello"}`, out.String())
+
+	cmd = RawBytesCmd()
+	out.Reset()
+	cmd.SetOut(out)
+	cmd.SetArgs([]string{"[72 101 108 108 111]"})
+	require.NoError(t, cmd.Execute())
+	require.JSONEq(t, `{"hex":"48656C6C6F"}`, out.String())
+}
+
+func TestDecodeTx(t *testing.T) {
+	cdc := makeCodec()
+
+	addr := sdk.AccAddress([]byte("from________________"))
+	msg := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	stdTx := authtypes.NewStdTx([]sdk.Msg{msg}, authtypes.NewStdFee(200000, nil), nil, "hello")
+	txBytes, err := cdc.MarshalBinaryBare(stdTx)
+	require.NoError(t, err)
+
+	for _, str := range []string{hex.EncodeToString(txBytes), base64.StdEncoding.EncodeToString(txBytes)} {
+		cmd := TxCmd(cdc)
+		out := new(bytes.Buffer)
+		cmd.SetOut(out)
+		cmd.SetArgs([]string{str})
+		require.NoError(t, cmd.Execute())
+
+		var decoded decodedTx
+		require.NoError(t, cdc.UnmarshalJSON([]byte(strings.TrimSpace(out.String())), &decoded))
+		require.Equal(t, fmt.Sprintf("%X", tmtypes.Tx(txBytes).Hash()), decoded.TxHash)
+		require.Equal(t, stdTx, decoded.Tx)
+	}
+
+	_, err = decodeTx(cdc, []byte("not a tx"))
+	require.Error(t, err)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -20,6 +21,7 @@ const (
 	// and the actual run.
 	DefaultGasAdjustment = 1.0
 	DefaultGasLimit      = 200000
+	DefaultWaitTimeout   = time.Minute
 	GasFlagAuto          = "auto"
 
 	// DefaultKeyringBackend
@@ -38,6 +40,18 @@ const (
 	BroadcastAsync = "async"
 )
 
+// ValidateBroadcastMode returns an error if the mode isn't one of the sync,
+// async and block broadcasting modes.
+func ValidateBroadcastMode(mode string) error {
+	switch mode {
+	case BroadcastSync, BroadcastAsync, BroadcastBlock:
+		return nil
+
+	default:
+		return fmt.Errorf("unsupported broadcast mode %q; supported modes: sync, async, block", mode)
+	}
+}
+
 // List of CLI flags
 const (
 	FlagHome               = tmcli.HomeFlag
@@ -54,7 +68,15 @@ const (
 	FlagMemo               = "memo"
 	FlagFees               = "fees"
 	FlagGasPrices          = "gas-prices"
+	FlagFeePayer           = "fee-payer"
+	FlagFeeGranter         = "fee-granter"
+	FlagTip                = "tip"
+	FlagTipper             = "tipper"
+	FlagUnordered          = "unordered"
+	FlagTimeoutDuration    = "timeout-duration"
 	FlagBroadcastMode      = "broadcast-mode"
+	FlagWait               = "wait"
+	FlagWaitTimeout        = "wait-timeout"
 	FlagDryRun             = "dry-run"
 	FlagGenerateOnly       = "generate-only"
 	FlagOffline            = "offline"
@@ -70,6 +92,8 @@ const (
 	FlagPage               = "page"
 	FlagLimit              = "limit"
 	FlagUnsafeCORS         = "unsafe-cors"
+	FlagSwagger            = "swagger"
+	FlagGRPCAddress        = "grpc-address"
 )
 
 // LineBreak can be included in a command list to provide a blank line
@@ -106,19 +130,27 @@ func PostCommands(cmds ...*cobra.Command) []*cobra.Command {
 	for _, c := range cmds {
 		c.Flags().Bool(FlagIndentResponse, false, "Add indent to JSON response")
 		c.Flags().String(FlagFrom, "", "Name or address of private key with which to sign")
-		c.Flags().Uint64P(FlagAccountNumber, "a", 0, "The account number of the signing account (offline mode only)")
-		c.Flags().Uint64P(FlagSequence, "s", 0, "The sequence number of the signing account (offline mode only)")
+		c.Flags().Uint64P(FlagAccountNumber, "a", 0, "The account number of the signing account (offline mode only, queried otherwise)")
+		c.Flags().Uint64P(FlagSequence, "s", 0, "The sequence number of the signing account (offline mode only, queried otherwise)")
 		c.Flags().String(FlagMemo, "", "Memo to send along with transaction")
 		c.Flags().String(FlagFees, "", "Fees to pay along with transaction; eg: 10uatom")
 		c.Flags().String(FlagGasPrices, "", "Gas prices to determine the transaction fee (e.g. 10uatom)")
+		c.Flags().String(FlagFeePayer, "", "Address of the account paying the fees; it must also sign the transaction")
+		c.Flags().String(FlagFeeGranter, "", "Address of the account whose fee allowance pays the fees")
+		c.Flags().String(FlagTip, "", "Tip paid by the tipper to the fee payer for broadcasting the transaction (e.g. 10uatom)")
+		c.Flags().String(FlagTipper, "", "Address of the account paying the tip; it must also sign the transaction")
+		c.Flags().Bool(FlagUnordered, false, "Build an unordered transaction, which carries no sequence number and expires after --timeout-duration")
+		c.Flags().Duration(FlagTimeoutDuration, 5*time.Minute, "Time after which an unordered transaction can no longer be included (unordered only)")
 		c.Flags().String(FlagNode, "tcp://localhost:26657", "<host>:<port> to tendermint rpc interface for this chain")
 		c.Flags().Bool(FlagUseLedger, false, "Use a connected Ledger device")
 		c.Flags().Float64(FlagGasAdjustment, DefaultGasAdjustment, "adjustment factor to be multiplied against the estimate returned by the tx simulation; if the gas limit is set manually this flag is ignored ")
-		c.Flags().StringP(FlagBroadcastMode, "b", BroadcastSync, "Transaction broadcasting mode (sync|async|block)")
+		c.Flags().StringP(FlagBroadcastMode, "b", BroadcastSync, "Transaction broadcasting mode (sync|async|block): wait for CheckTx only, return immediately, or wait for the tx to be committed")
+		c.Flags().Bool(FlagWait, false, "Wait for the transaction to be committed and print its result (sync|async modes only)")
+		c.Flags().Duration(FlagWaitTimeout, DefaultWaitTimeout, "Time to wait for the transaction to be committed (with --wait only)")
 		c.Flags().Bool(FlagTrustNode, true, "Trust connected full node (don't verify proofs for responses)")
-		c.Flags().Bool(FlagDryRun, false, "ignore the --gas flag and perform a simulation of a transaction, but don't broadcast it")
+		c.Flags().Bool(FlagDryRun, false, "ignore the --gas flag and perform a simulation of a transaction to estimate its gas, but don't broadcast it")
 		c.Flags().Bool(FlagGenerateOnly, false, "Build an unsigned transaction and write it to STDOUT (when enabled, the local Keybase is not accessible)")
-		c.Flags().Bool(FlagOffline, false, "Offline mode (does not allow any online functionality")
+		c.Flags().Bool(FlagOffline, false, "Offline mode: sign the transaction with --account-number and --sequence and write it to STDOUT, without querying nor broadcasting (does not allow any online functionality)")
 		c.Flags().BoolP(FlagSkipConfirmation, "y", false, "Skip tx broadcasting prompt confirmation")
 		c.Flags().String(FlagKeyringBackend, DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test)")
 
@@ -147,6 +179,8 @@ func RegisterRestServerFlags(cmd *cobra.Command) *cobra.Command {
 	cmd.Flags().Uint(FlagRPCReadTimeout, 10, "The RPC read timeout (in seconds)")
 	cmd.Flags().Uint(FlagRPCWriteTimeout, 10, "The RPC write timeout (in seconds)")
 	cmd.Flags().Bool(FlagUnsafeCORS, false, "Allows CORS requests from all domains. For development purposes only, use it at your own risk.")
+	cmd.Flags().Bool(FlagSwagger, true, "Serve the Swagger UI, and the OpenAPI spec of the registered routes at /swagger/openapi.json")
+	cmd.Flags().String(FlagGRPCAddress, "", "The address for the gRPC server of the node status to listen on, e.g. localhost:9090, or none to disable it")
 
 	return cmd
 }
@@ -0,0 +1,167 @@
+// Package gateway serves the gRPC query services of the modules over REST, on
+// the routes of the REST server, mirroring the JSON encoding of their protos.
+//
+// Each method of a service is served at its full name, e.g.
+// /cosmos_sdk.x.mint.v1.Query/Params, by a handler forwarding the request to
+// the node as an ABCI query. The request is the JSON encoded body of a POST
+// request, or the query parameters of a GET request, named after the fields of
+// the request message, which must then be scalars. The height parameter sets
+// the height of the query, returned in the BlockHeightHeader of the response.
+package gateway
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gorilla/mux"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+)
+
+// BlockHeightHeader is the header of the responses holding the height of their
+// query.
+const BlockHeightHeader = "X-Cosmos-Block-Height"
+
+// RegisterQueryService registers the REST routes of the methods of the gRPC
+// query service, served by querying the node of the context.
+func RegisterQueryService(ctx context.CLIContext, rtr *mux.Router, sd *grpc.ServiceDesc) {
+	// the request and response types of the methods are the ones of the methods
+	// of the server interface
+	server := reflect.TypeOf(sd.HandlerType).Elem()
+
+	for _, md := range sd.Methods {
+		method, ok := server.MethodByName(md.MethodName)
+		if !ok {
+			panic(fmt.Sprintf("the %s service has no %s method", sd.ServiceName, md.MethodName))
+		}
+
+		fullMethod := fmt.Sprintf("/%s/%s", sd.ServiceName, md.MethodName)
+		handler := queryHandler(ctx, fullMethod, method.Type.In(1).Elem(), method.Type.Out(0).Elem())
+
+		rtr.HandleFunc(fullMethod, handler).Methods("GET", "POST")
+	}
+}
+
+func queryHandler(ctx context.CLIContext, fullMethod string, reqType, resType reflect.Type) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, ctx, r)
+		if !ok {
+			return
+		}
+
+		req := reflect.New(reqType).Interface().(codec.ProtoMarshaler)
+		if err := decodeRequest(r, req); rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		res := reflect.New(resType).Interface().(codec.ProtoMarshaler)
+		height, err := cliCtx.QueryGRPC(fullMethod, req, res)
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		bz, err := codec.ProtoMarshalJSON(res)
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set(BlockHeightHeader, strconv.FormatInt(height, 10))
+		_, _ = w.Write(bz)
+	}
+}
+
+// decodeRequest decodes the JSON body of a POST request, or the query
+// parameters of a GET request, into the request message.
+func decodeRequest(r *http.Request, req codec.ProtoMarshaler) error {
+	var (
+		bz  []byte
+		err error
+	)
+
+	if r.Method == http.MethodPost {
+		bz, err = ioutil.ReadAll(r.Body)
+	} else {
+		bz, err = queryParamsJSON(r, reflect.TypeOf(req).Elem())
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(bz) == 0 {
+		return nil
+	}
+
+	return jsonpb.UnmarshalString(string(bz), req)
+}
+
+// queryParamsJSON returns the JSON object of the query parameters of the
+// request, named after the scalar fields of the request message, either by
+// their proto or JSON name.
+func queryParamsJSON(r *http.Request, msgType reflect.Type) ([]byte, error) {
+	fields := map[string]reflect.StructField{}
+	for i := 0; i < msgType.NumField(); i++ {
+		field := msgType.Field(i)
+
+		for _, opt := range strings.Split(field.Tag.Get("protobuf"), ",") {
+			if strings.HasPrefix(opt, "name=") || strings.HasPrefix(opt, "json=") {
+				fields[opt[5:]] = field
+			}
+		}
+	}
+
+	obj := map[string]json.RawMessage{}
+	for key, values := range r.URL.Query() {
+		// the height is the one of the query, not a field
+		if key == "height" {
+			continue
+		}
+
+		field, ok := fields[key]
+		if !ok {
+			return nil, fmt.Errorf("unknown query parameter %s", key)
+		}
+
+		value, err := scalarJSON(field.Type, values[len(values)-1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid query parameter %s: %w", key, err)
+		}
+
+		obj[key] = value
+	}
+
+	if len(obj) == 0 {
+		return nil, nil
+	}
+
+	return json.Marshal(obj)
+}
+
+// scalarJSON returns the JSON encoding of the query parameter value of a field
+// of the given type: the strings and bytes, base64 encoded as in the protos'
+// JSON, are quoted, while the numbers and booleans are validated by jsonpb.
+func scalarJSON(typ reflect.Type, value string) (json.RawMessage, error) {
+	switch {
+	case typ.Kind() == reflect.String, typ.Kind() == reflect.Slice && typ.Elem().Kind() == reflect.Uint8:
+		return json.Marshal(value)
+
+	case typ.Kind() == reflect.Bool, typ.Kind() >= reflect.Int && typ.Kind() <= reflect.Float64:
+		if !json.Valid([]byte(value)) {
+			return nil, fmt.Errorf("%q is not a %s", value, typ.Kind())
+		}
+
+		return json.RawMessage(value), nil
+
+	default:
+		return nil, fmt.Errorf("%s fields can only be set in the body of POST requests", typ)
+	}
+}
@@ -0,0 +1,115 @@
+package gateway_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	"github.com/tendermint/tendermint/rpc/client/mock"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/gateway"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// mockQueryClient answers the vote queries with the vote of the queried
+// proposal and voter, recording the path and height of the last query.
+type mockQueryClient struct {
+	mock.Client
+	path   *string
+	height *int64
+}
+
+func (c mockQueryClient) ABCIQueryWithOptions(
+	path string, data tmbytes.HexBytes, opts rpcclient.ABCIQueryOptions,
+) (*ctypes.ResultABCIQuery, error) {
+	*c.path = path
+	*c.height = opts.Height
+
+	var req govtypes.QueryVoteRequest
+	if err := req.Unmarshal(data); err != nil {
+		return nil, err
+	}
+
+	res := govtypes.QueryVoteResponse{
+		Vote: govtypes.NewVote(req.ProposalID, req.Voter, govtypes.NewNonSplitVoteOption(govtypes.OptionYes)),
+	}
+	bz, err := res.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ctypes.ResultABCIQuery{Response: abci.ResponseQuery{Value: bz, Height: 12}}, nil
+}
+
+func TestRegisterQueryService(t *testing.T) {
+	var (
+		path   string
+		height int64
+	)
+	ctx := context.CLIContext{TrustNode: true}.WithClient(mockQueryClient{path: &path, height: &height})
+
+	rtr := mux.NewRouter()
+	gateway.RegisterQueryService(ctx, rtr, gov.AppModuleBasic{}.QueryServiceDesc())
+
+	server := httptest.NewServer(rtr)
+	defer server.Close()
+
+	voter := sdk.AccAddress([]byte("voter_______________"))
+	route := server.URL + "/cosmos_sdk.x.gov.v1.Query/Vote"
+
+	testCases := []struct {
+		name   string
+		method string
+		query  string
+		body   string
+		status int
+		height int64
+	}{
+		{"GET request", "GET", "?proposal_id=3&voter=" + voter.String(), "", http.StatusOK, 0},
+		{"GET request by JSON names", "GET", "?proposalId=3&voter=" + voter.String(), "", http.StatusOK, 0},
+		{"GET request at height", "GET", "?proposal_id=3&voter=" + voter.String() + "&height=5", "", http.StatusOK, 5},
+		{"POST request", "POST", "", `{"proposal_id":"3","voter":"` + voter.String() + `"}`, http.StatusOK, 0},
+		{"unknown query parameter", "GET", "?proposal=3", "", http.StatusBadRequest, 0},
+		{"invalid query parameter", "GET", "?proposal_id=three", "", http.StatusBadRequest, 0},
+		{"invalid height", "GET", "?height=-1", "", http.StatusBadRequest, 0},
+		{"invalid body", "POST", "", `{"proposal_id":`, http.StatusBadRequest, 0},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			req, err := http.NewRequest(tc.method, route+tc.query, strings.NewReader(tc.body))
+			require.NoError(t, err)
+
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+
+			body, err := ioutil.ReadAll(res.Body)
+			require.NoError(t, err)
+			require.Equal(t, tc.status, res.StatusCode, string(body))
+
+			if tc.status != http.StatusOK {
+				return
+			}
+
+			require.Equal(t, "/cosmos_sdk.x.gov.v1.Query/Vote", path)
+			require.Equal(t, tc.height, height)
+			require.Equal(t, "12", res.Header.Get(gateway.BlockHeightHeader))
+			require.Contains(t, string(body), `"proposalId":"3"`)
+			require.Contains(t, string(body), `"voter":"`+voter.String()+`"`)
+			require.Contains(t, string(body), `"option":"Yes"`)
+		})
+	}
+}
@@ -36,6 +36,12 @@ const (
 	flagHDPath      = "hd-path"
 	flagKeyAlgo     = "algo"
 
+	flagBip39Passphrase = "bip39-passphrase"
+
+	flagRemoteSigner     = "remote-signer"
+	flagRemoteKeyID      = "remote-key-id"
+	flagRemoteSignerAuth = "remote-signer-auth"
+
 	// DefaultKeyPass contains the default key password for genesis transactions
 	DefaultKeyPass = "12345678"
 )
@@ -51,7 +57,10 @@ and a bip32 HD path to derive a specific account. The key will be stored under t
 and encrypted with the given password. The only input that is required is the encryption password.
 
 If run with -i, it will prompt the user for BIP44 path, BIP39 mnemonic, and passphrase.
-The flag --recover allows one to recover a key from a seed passphrase.
+The flag --recover allows one to recover a key from a seed passphrase, along with
+the BIP39 passphrase it was derived with if --bip39-passphrase is set. Mnemonics
+in English, and in the languages of the BIP39 word lists registered by the
+application, can be recovered.
 If run with --dry-run, a key would be generated (or recovered) but not stored to the
 local keystore.
 Use the --pubkey flag to add arbitrary public keys to the keystore for constructing
@@ -61,6 +70,14 @@ You can add a multisig key by passing the list of key names you want the public
 key to be composed of to the --multisig flag and the minimum number of signatures
 required through --multisig-threshold. The keys are sorted by address, unless
 the flag --nosort is set.
+
+Use the --remote-signer flag to add a reference to the key identified by
+--remote-key-id on an external key management service (KMS), which signs with a
+private key that never leaves it. With --remote-signer-auth, you will be prompted
+for a token authenticating the requests to the KMS, stored alongside the reference.
+
+Public key only and multisig keys cannot sign, but they can be passed by name to
+--from to build unsigned transactions with --generate-only.
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: runAddCmd,
@@ -73,6 +90,7 @@ the flag --nosort is set.
 	cmd.Flags().Bool(flags.FlagUseLedger, false, "Store a local reference to a private key on a Ledger device")
 	cmd.Flags().Bool(flagRecover, false, "Provide seed phrase to recover existing key instead of creating")
 	cmd.Flags().Bool(flagNoBackup, false, "Don't print out seed phrase (if others are watching the terminal)")
+	cmd.Flags().Bool(flagBip39Passphrase, false, "Prompt for a BIP39 passphrase, combined with the mnemonic to derive the seed")
 	cmd.Flags().Bool(flags.FlagDryRun, false, "Perform action, but don't add key to local keystore")
 	cmd.Flags().String(flagHDPath, "", "Manual HD Path derivation (overrides BIP44 config)")
 	cmd.Flags().Uint32(flagCoinType, sdk.GetConfig().GetCoinType(), "coin type number for HD derivation")
@@ -80,6 +98,9 @@ the flag --nosort is set.
 	cmd.Flags().Uint32(flagIndex, 0, "Address index number for HD derivation")
 	cmd.Flags().Bool(flags.FlagIndentResponse, false, "Add indent to JSON response")
 	cmd.Flags().String(flagKeyAlgo, string(hd.Secp256k1Type), "Key signing algorithm to generate keys for")
+	cmd.Flags().String(flagRemoteSigner, "", "Store a local reference to a key held by the remote signer (KMS) at the given URL")
+	cmd.Flags().String(flagRemoteKeyID, "", "Identifier of the key on the remote signer. For use in conjunction with --remote-signer")
+	cmd.Flags().Bool(flagRemoteSignerAuth, false, "Prompt for a token authenticating the requests to the remote signer")
 
 	return cmd
 }
@@ -193,6 +214,28 @@ func RunAddCmd(cmd *cobra.Command, args []string, kb keyring.Keyring, inBuf *buf
 		return nil
 	}
 
+	if endpoint := viper.GetString(flagRemoteSigner); endpoint != "" {
+		keyID := viper.GetString(flagRemoteKeyID)
+		if keyID == "" {
+			return fmt.Errorf("--%s is required with --%s", flagRemoteKeyID, flagRemoteSigner)
+		}
+
+		var token string
+		if viper.GetBool(flagRemoteSignerAuth) {
+			token, err = input.GetPassword("Enter the token authenticating the requests to the remote signer:", inBuf)
+			if err != nil {
+				return err
+			}
+		}
+
+		info, err := kb.SaveRemoteKey(name, endpoint, keyID, token, algo.Name())
+		if err != nil {
+			return err
+		}
+
+		return printCreate(cmd, info, false, "")
+	}
+
 	coinType := uint32(viper.GetInt(flagCoinType))
 	account := uint32(viper.GetInt(flagAccount))
 	index := uint32(viper.GetInt(flagIndex))
@@ -230,7 +273,7 @@ func RunAddCmd(cmd *cobra.Command, args []string, kb keyring.Keyring, inBuf *buf
 			return err
 		}
 
-		if !bip39.IsMnemonicValid(mnemonic) {
+		if _, err := hd.MnemonicLanguage(mnemonic); err != nil {
 			return errors.New("invalid mnemonic")
 		}
 	}
@@ -249,7 +292,7 @@ func RunAddCmd(cmd *cobra.Command, args []string, kb keyring.Keyring, inBuf *buf
 	}
 
 	// override bip39 passphrase
-	if interactive {
+	if interactive || viper.GetBool(flagBip39Passphrase) {
 		bip39Passphrase, err = input.GetString(
 			"Enter your bip39 passphrase. This is combined with the mnemonic to derive the seed. "+
 				"Most users should just hit enter to use the default, \"\"", inBuf)
@@ -33,7 +33,7 @@ private keys stored in a ledger device cannot be deleted with the CLI.
 	}
 
 	cmd.Flags().BoolP(flagYes, "y", false,
-		"Skip confirmation prompt when deleting offline or ledger key references")
+		"Skip confirmation prompt when deleting offline, ledger or remote key references")
 	cmd.Flags().BoolP(flagForce, "f", false,
 		"Remove the key unconditionally without asking for the passphrase. Deprecated.")
 	return cmd
@@ -66,7 +66,7 @@ func runDeleteCmd(cmd *cobra.Command, args []string) error {
 			return err
 		}
 
-		if info.GetType() == keyring.TypeLedger || info.GetType() == keyring.TypeOffline {
+		if info.GetType() == keyring.TypeLedger || info.GetType() == keyring.TypeOffline || info.GetType() == keyring.TypeRemote {
 			cmd.PrintErrln("Public key reference deleted")
 			continue
 		}
@@ -2,6 +2,7 @@ package keys
 
 import (
 	"bufio"
+	"fmt"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -12,15 +13,32 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+const (
+	flagUnarmoredHex = "unarmored-hex"
+	flagUnsafe       = "unsafe"
+)
+
 // ExportKeyCommand exports private keys from the key store.
 func ExportKeyCommand() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "export <name>",
 		Short: "Export private keys",
-		Long:  `Export a private key from the local keybase in ASCII-armored encrypted format.`,
-		Args:  cobra.ExactArgs(1),
-		RunE:  runExportCmd,
+		Long: `Export a private key from the local keybase in ASCII-armored encrypted format.
+
+When both the --unarmored-hex and --unsafe flags are selected, cryptographic
+private key material is exported in an INSECURE fashion that is designed to
+allow users to import their keys in hot wallets. This feature is for advanced
+users only that are confident about how to handle private keys work and are
+FULLY AWARE OF THE RISKS. If you are unsure, you may want to do some research
+and export your keys in ASCII-armored encrypted format.`,
+		Args: cobra.ExactArgs(1),
+		RunE: runExportCmd,
 	}
+
+	cmd.Flags().Bool(flagUnarmoredHex, false, "Export unarmored hex privkey. Requires --unsafe.")
+	cmd.Flags().Bool(flagUnsafe, false, "Enable unsafe operations. This flag must be switched on along with all unsafe operation-specific options.")
+
+	return cmd
 }
 
 func runExportCmd(cmd *cobra.Command, args []string) error {
@@ -30,6 +48,17 @@ func runExportCmd(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	unarmored := viper.GetBool(flagUnarmoredHex)
+	unsafe := viper.GetBool(flagUnsafe)
+
+	switch {
+	case unarmored && unsafe:
+		return exportUnsafeUnarmored(cmd, args[0], buf, kb)
+
+	case unarmored || unsafe:
+		return fmt.Errorf("the flags --%s and --%s must be used together", flagUnsafe, flagUnarmoredHex)
+	}
+
 	encryptPassword, err := input.GetPassword("Enter passphrase to encrypt the exported key:", buf)
 	if err != nil {
 		return err
@@ -43,3 +72,23 @@ func runExportCmd(cmd *cobra.Command, args []string) error {
 	cmd.Println(armored)
 	return nil
 }
+
+func exportUnsafeUnarmored(cmd *cobra.Command, uid string, buf *bufio.Reader, kb keyring.Keyring) error {
+	// confirm the export, on top of the --unsafe flag
+	if yes, err := input.GetConfirmation(
+		"WARNING: The private key will be exported as an unarmored hexadecimal string. USE AT YOUR OWN RISK. Continue?",
+		buf, cmd.ErrOrStderr(),
+	); err != nil {
+		return err
+	} else if !yes {
+		return nil
+	}
+
+	hexPrivKey, err := keyring.NewUnsafe(kb).UnsafeExportPrivKeyHex(uid)
+	if err != nil {
+		return err
+	}
+
+	cmd.Println(hexPrivKey)
+	return nil
+}
@@ -37,4 +37,20 @@ func Test_runExportCmd(t *testing.T) {
 	// Now enter password
 	mockIn.Reset("123456789\n123456789\n")
 	require.NoError(t, runExportCmd(exportKeyCommand, []string{"keyname1"}))
+
+	// the unarmored export requires both flags
+	viper.Set(flagUnarmoredHex, true)
+	t.Cleanup(func() {
+		viper.Set(flagUnarmoredHex, false)
+		viper.Set(flagUnsafe, false)
+	})
+	require.Error(t, runExportCmd(exportKeyCommand, []string{"keyname1"}))
+
+	// and a confirmation
+	viper.Set(flagUnsafe, true)
+	mockIn.Reset("n\n")
+	require.NoError(t, runExportCmd(exportKeyCommand, []string{"keyname1"}))
+
+	mockIn.Reset("y\n")
+	require.NoError(t, runExportCmd(exportKeyCommand, []string{"keyname1"}))
 }
@@ -0,0 +1,65 @@
+package keys
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const flagCount = "count"
+
+// LedgerAddressesCmd lists the addresses derived by a Ledger device.
+func LedgerAddressesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ledger-addresses",
+		Short: "List the addresses derived by a Ledger device",
+		Long: `List the addresses derived by the connected Ledger device for consecutive address
+indexes of an account, e.g. to find the index of an address before adding it with
+'keys add --ledger'. The addresses are not shown on the device.`,
+		Args: cobra.NoArgs,
+		RunE: runLedgerAddressesCmd,
+	}
+
+	cmd.Flags().Uint32(flagCoinType, sdk.GetConfig().GetCoinType(), "coin type number for HD derivation")
+	cmd.Flags().Uint32(flagAccount, 0, "Account number for HD derivation")
+	cmd.Flags().Uint32(flagIndex, 0, "Address index number of the first address")
+	cmd.Flags().Uint32(flagCount, 5, "Number of addresses to list")
+
+	return cmd
+}
+
+func runLedgerAddressesCmd(cmd *cobra.Command, _ []string) error {
+	coinType := uint32(viper.GetInt(flagCoinType))
+	account := uint32(viper.GetInt(flagAccount))
+	index := uint32(viper.GetInt(flagIndex))
+	count := uint32(viper.GetInt(flagCount))
+
+	paths := make([]hd.BIP44Params, count)
+	for i := range paths {
+		paths[i] = *hd.NewFundraiserParams(account, coinType, index+uint32(i))
+	}
+
+	pubKeys, err := crypto.LedgerPubKeys(paths)
+	if err != nil {
+		return err
+	}
+
+	kos := make([]keyring.KeyOutput, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		bechPubKey, err := sdk.Bech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, pubKey)
+		if err != nil {
+			return err
+		}
+
+		kos[i] = keyring.NewKeyOutput(
+			paths[i].String(), keyring.TypeLedger.String(), sdk.AccAddress(pubKey.Address()).String(), bechPubKey,
+		)
+	}
+
+	printKeyOutputs(cmd.OutOrStdout(), kos)
+	return nil
+}
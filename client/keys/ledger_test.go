@@ -0,0 +1,35 @@
+//+build ledger test_ledger_mock
+
+package keys
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/libs/cli"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func Test_runLedgerAddressesCmd(t *testing.T) {
+	cmd := LedgerAddressesCmd()
+	require.NotNil(t, cmd)
+
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+
+	viper.Set(cli.OutputFlag, OutputFormatText)
+	viper.Set(flagCoinType, sdk.CoinType)
+	viper.Set(flagAccount, "0")
+	viper.Set(flagIndex, "0")
+	viper.Set(flagCount, "2")
+	require.NoError(t, runLedgerAddressesCmd(cmd, []string{}))
+
+	require.Contains(t, buf.String(), "44'/118'/0'/0/0")
+	require.Contains(t, buf.String(), "cosmos1w34k53py5v5xyluazqpq65agyajavep2rflq6h")
+	require.Contains(t, buf.String(), "44'/118'/0'/0/1")
+	require.NotContains(t, buf.String(), "44'/118'/0'/0/2")
+}
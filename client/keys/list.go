@@ -3,6 +3,7 @@ package keys
 import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/cli"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
@@ -42,8 +43,23 @@ func runListCmd(cmd *cobra.Command, _ []string) error {
 		return nil
 	}
 
-	for _, info := range infos {
-		cmd.Println(info.GetName())
+	names := make([]string, len(infos))
+	for i, info := range infos {
+		names[i] = info.GetName()
+	}
+
+	if viper.Get(cli.OutputFlag) == OutputFormatJSON {
+		out, err := KeysCdc.MarshalJSON(names)
+		if err != nil {
+			return err
+		}
+
+		cmd.Println(string(out))
+		return nil
+	}
+
+	for _, name := range names {
+		cmd.Println(name)
 	}
 
 	return nil
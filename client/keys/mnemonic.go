@@ -7,6 +7,8 @@ import (
 
 	bip39 "github.com/cosmos/go-bip39"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/cli"
 
 	"github.com/cosmos/cosmos-sdk/client/input"
 )
@@ -70,7 +72,22 @@ func runMnemonicCmd(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	if viper.Get(cli.OutputFlag) == OutputFormatJSON {
+		out, err := KeysCdc.MarshalJSON(mnemonicOutput{Mnemonic: mnemonic})
+		if err != nil {
+			return err
+		}
+
+		cmd.Println(string(out))
+		return nil
+	}
+
 	cmd.Println(mnemonic)
 
 	return nil
 }
+
+// mnemonicOutput is the output of the mnemonic command in the JSON output mode.
+type mnemonicOutput struct {
+	Mnemonic string `json:"mnemonic"`
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/spf13/viper"
 	yaml "gopkg.in/yaml.v2"
 
+	cryptoamino "github.com/tendermint/tendermint/crypto/encoding/amino"
 	"github.com/tendermint/tendermint/libs/bech32"
 	"github.com/tendermint/tendermint/libs/cli"
 
@@ -18,56 +19,152 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-var config = sdk.GetConfig()
-var bech32Prefixes = []string{
-	config.GetBech32AccountAddrPrefix(),
-	config.GetBech32AccountPubPrefix(),
-	config.GetBech32ValidatorAddrPrefix(),
-	config.GetBech32ValidatorPubPrefix(),
-	config.GetBech32ConsensusAddrPrefix(),
-	config.GetBech32ConsensusPubPrefix(),
+// bech32AddrPrefixes returns the Bech32 prefixes of account, validator
+// operator and consensus node addresses. The prefixes are read from the config
+// when parsing, after the application has set them.
+func bech32AddrPrefixes() []string {
+	config := sdk.GetConfig()
+
+	return []string{
+		config.GetBech32AccountAddrPrefix(),
+		config.GetBech32ValidatorAddrPrefix(),
+		config.GetBech32ConsensusAddrPrefix(),
+	}
+}
+
+// bech32PubPrefixes returns the Bech32 prefixes of account, validator operator
+// and consensus node public keys.
+func bech32PubPrefixes() []string {
+	config := sdk.GetConfig()
+
+	return []string{
+		config.GetBech32AccountPubPrefix(),
+		config.GetBech32ValidatorPubPrefix(),
+		config.GetBech32ConsensusPubPrefix(),
+	}
+}
+
+// bech32Prefixes returns all the Bech32 prefixes used by the application.
+func bech32Prefixes() []string {
+	addrPrefixes, pubPrefixes := bech32AddrPrefixes(), bech32PubPrefixes()
+
+	return []string{
+		addrPrefixes[0], pubPrefixes[0],
+		addrPrefixes[1], pubPrefixes[1],
+		addrPrefixes[2], pubPrefixes[2],
+	}
+}
+
+// bech32Type describes the values encoded with the Bech32 prefix.
+func bech32Type(prefix string) string {
+	types := []string{
+		"account address", "account public key",
+		"validator operator address", "validator operator public key",
+		"consensus node address", "consensus node public key",
+	}
+
+	for i, p := range bech32Prefixes() {
+		if p == prefix {
+			return types[i]
+		}
+	}
+
+	return "unknown"
+}
+
+func isOneOf(s string, list []string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+
+	return false
 }
 
 type hexOutput struct {
 	Human string `json:"human"`
 	Bytes string `json:"bytes"`
+	Type  string `json:"type"`
+	// Formats are the encodings of the bytes with the prefixes of their kind,
+	// either addresses or public keys
+	Formats []string `json:"formats"`
+	// Addresses are the encodings of the address of a public key
+	Addresses []string `json:"addresses,omitempty" yaml:"addresses,omitempty"`
 }
 
 func (ho hexOutput) String() string {
-	return fmt.Sprintf("Human readable part: %v\nBytes (hex): %s", ho.Human, ho.Bytes)
+	out := fmt.Sprintf("Human readable part: %v\nBytes (hex): %s\nType: %s\n%s", ho.Human, ho.Bytes, ho.Type, formatList("Bech32 Formats", ho.Formats))
+	if len(ho.Addresses) != 0 {
+		out += "\n" + formatList("Bech32 Addresses", ho.Addresses)
+	}
+
+	return out
 }
 
 func newHexOutput(human string, bs []byte) hexOutput {
-	return hexOutput{Human: human, Bytes: fmt.Sprintf("%X", bs)}
+	out := hexOutput{Human: human, Bytes: fmt.Sprintf("%X", bs), Type: bech32Type(human)}
+
+	switch {
+	case isOneOf(human, bech32AddrPrefixes()):
+		out.Formats = bech32Encodings(bs, bech32AddrPrefixes()...)
+
+	case isOneOf(human, bech32PubPrefixes()):
+		out.Formats = bech32Encodings(bs, bech32PubPrefixes()...)
+
+		if pk, err := cryptoamino.PubKeyFromBytes(bs); err == nil {
+			out.Addresses = bech32Encodings(pk.Address(), bech32AddrPrefixes()...)
+		}
+	}
+
+	return out
 }
 
 type bech32Output struct {
+	Type    string   `json:"type"`
 	Formats []string `json:"formats"`
 }
 
 func newBech32Output(bs []byte) bech32Output {
-	out := bech32Output{Formats: make([]string, len(bech32Prefixes))}
+	out := bech32Output{Type: "unknown", Formats: bech32Encodings(bs, bech32Prefixes()...)}
+
+	if sdk.VerifyAddressFormat(bs) == nil {
+		out.Type = "address"
+	} else if _, err := cryptoamino.PubKeyFromBytes(bs); err == nil {
+		out.Type = "public key"
+	}
 
-	for i, prefix := range bech32Prefixes {
+	return out
+}
+
+func (bo bech32Output) String() string {
+	return fmt.Sprintf("Type: %s\n%s", bo.Type, formatList("Bech32 Formats", bo.Formats))
+}
+
+// bech32Encodings returns the Bech32 encodings of the bytes with each prefix.
+func bech32Encodings(bs []byte, prefixes ...string) []string {
+	encodings := make([]string, len(prefixes))
+
+	for i, prefix := range prefixes {
 		bech32Addr, err := bech32.ConvertAndEncode(prefix, bs)
 		if err != nil {
 			panic(err)
 		}
 
-		out.Formats[i] = bech32Addr
+		encodings[i] = bech32Addr
 	}
 
-	return out
+	return encodings
 }
 
-func (bo bech32Output) String() string {
-	out := make([]string, len(bo.Formats))
+func formatList(title string, items []string) string {
+	out := make([]string, len(items))
 
-	for i, format := range bo.Formats {
-		out[i] = fmt.Sprintf("  - %s", format)
+	for i, item := range items {
+		out[i] = fmt.Sprintf("  - %s", item)
 	}
 
-	return fmt.Sprintf("Bech32 Formats:\n%s", strings.Join(out, "\n"))
+	return fmt.Sprintf("%s:\n%s", title, strings.Join(out, "\n"))
 }
 
 // ParseKeyStringCommand parses an address from hex to bech32 and vice versa.
@@ -77,6 +174,16 @@ func ParseKeyStringCommand() *cobra.Command {
 		Short: "Parse address from hex to bech32 and vice versa",
 		Long: `Convert and print to stdout key addresses and fingerprints from
 hexadecimal into bech32 cosmos prefixed format and vice versa.
+
+The type of the input is classified, and bech32 inputs are converted to the
+other bech32 encodings of their kind, e.g. an account address to the validator
+operator and consensus node addresses of the same bytes, and a public key to its
+addresses.
+
+Note that the consensus node address of a validator is derived from its
+consensus public key, not from its operator key: it is mapped to the validator
+operator address by parsing the validator's consensus public key, as listed by
+the validators query, and comparing the resulting consensus node address.
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: parseKey,
@@ -1,9 +1,15 @@
 package keys
 
 import (
+	"bytes"
 	"testing"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/cli"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestParseKey(t *testing.T) {
@@ -27,3 +33,50 @@ func TestParseKey(t *testing.T) {
 		})
 	}
 }
+
+func TestParseKeyClassifiesInput(t *testing.T) {
+	viper.Set(cli.OutputFlag, OutputFormatJSON)
+	defer viper.Set(cli.OutputFlag, "")
+
+	pk := ed25519.GenPrivKey().PubKey()
+	consPub := sdk.MustBech32ifyPubKey(sdk.Bech32PubKeyTypeConsPub, pk)
+	consAddr := sdk.ConsAddress(pk.Address())
+	valAddr := sdk.ValAddress(pk.Address())
+
+	parse := func(input string) string {
+		cmd := ParseKeyStringCommand()
+		out := &bytes.Buffer{}
+		cmd.SetOut(out)
+
+		require.NoError(t, parseKey(cmd, []string{input}))
+		return out.String()
+	}
+
+	// a consensus node address is converted to the other addresses of the same bytes
+	var ho hexOutput
+	KeysCdc.MustUnmarshalJSON([]byte(parse(consAddr.String())), &ho)
+	require.Equal(t, "consensus node address", ho.Type)
+	require.Equal(t, []string{sdk.AccAddress(consAddr).String(), valAddr.String(), consAddr.String()}, ho.Formats)
+	require.Empty(t, ho.Addresses)
+
+	// a consensus public key is converted to its addresses
+	ho = hexOutput{}
+	KeysCdc.MustUnmarshalJSON([]byte(parse(consPub)), &ho)
+	require.Equal(t, "consensus node public key", ho.Type)
+	require.Contains(t, ho.Formats, consPub)
+	require.Equal(t, consAddr.String(), ho.Addresses[2])
+
+	// hex inputs are classified by their length and encoding
+	var bo bech32Output
+	KeysCdc.MustUnmarshalJSON([]byte(parse("7D48B6858331547A89B47B0B909F1A9783CEF9B6")), &bo)
+	require.Equal(t, "address", bo.Type)
+	require.Len(t, bo.Formats, 6)
+
+	bo = bech32Output{}
+	KeysCdc.MustUnmarshalJSON([]byte(parse("EB5AE9872103497EC092EF901027049E4F39200C60040D3562CD7F104A39F62E6E5A39A818F4")), &bo)
+	require.Equal(t, "public key", bo.Type)
+
+	bo = bech32Output{}
+	KeysCdc.MustUnmarshalJSON([]byte(parse("ABCDEF")), &bo)
+	require.Equal(t, "unknown", bo.Type)
+}
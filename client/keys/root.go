@@ -43,6 +43,7 @@ The pass backend requires GnuPG: https://gnupg.org/
 		ImportKeyCommand(),
 		ListKeysCmd(),
 		ShowKeysCmd(),
+		LedgerAddressesCmd(),
 		flags.LineBreak,
 		DeleteKeyCommand(),
 		ParseKeyStringCommand(),
@@ -66,6 +66,10 @@ func printInfos(w io.Writer, infos []cryptokeyring.Info) {
 		panic(err)
 	}
 
+	printKeyOutputs(w, kos)
+}
+
+func printKeyOutputs(w io.Writer, kos []cryptokeyring.KeyOutput) {
 	switch viper.Get(cli.OutputFlag) {
 	case OutputFormatText:
 		printTextInfos(w, kos)
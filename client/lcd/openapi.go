@@ -0,0 +1,198 @@
+package lcd
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/rakyll/statik/fs"
+
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	// SwaggerPath is the path of the Swagger UI of the routes of the server.
+	SwaggerPath = "/swagger/"
+	// OpenAPIPath is the path of the OpenAPI spec of the routes of the server.
+	OpenAPIPath = SwaggerPath + "openapi.json"
+)
+
+// OpenAPISpec is an OpenAPI 2.0 (Swagger) spec, limited to the fields derived from the
+// routes of a router.
+type OpenAPISpec struct {
+	Swagger string                                 `json:"swagger"`
+	Info    OpenAPIInfo                            `json:"info"`
+	Paths   map[string]map[string]OpenAPIOperation `json:"paths"`
+}
+
+// OpenAPIInfo describes the API of an OpenAPISpec.
+type OpenAPIInfo struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+// OpenAPIOperation is an operation, i.e. a method, of a path of an OpenAPISpec.
+type OpenAPIOperation struct {
+	Tags       []string                   `json:"tags,omitempty"`
+	Parameters []OpenAPIParameter         `json:"parameters,omitempty"`
+	Responses  map[string]OpenAPIResponse `json:"responses"`
+}
+
+// OpenAPIParameter is a parameter of an operation.
+type OpenAPIParameter struct {
+	Name     string `json:"name"`
+	In       string `json:"in"`
+	Required bool   `json:"required"`
+	Type     string `json:"type"`
+}
+
+// OpenAPIResponse is a response of an operation.
+type OpenAPIResponse struct {
+	Description string `json:"description"`
+}
+
+// NewOpenAPISpec returns the OpenAPI spec of the routes of the router, i.e. of their
+// paths and methods. The path variables are documented as string parameters, and the
+// operations are tagged by the first segment of their path, which is usually the name
+// of the module registering them. The routes without methods, such as the file servers,
+// are skipped.
+func NewOpenAPISpec(router *mux.Router) (OpenAPISpec, error) {
+	title := version.Name
+	if title == "" {
+		title = "Cosmos SDK"
+	}
+
+	spec := OpenAPISpec{
+		Swagger: "2.0",
+		Info:    OpenAPIInfo{Title: title + " REST API", Version: version.Version},
+		Paths:   map[string]map[string]OpenAPIOperation{},
+	}
+
+	err := router.Walk(func(route *mux.Route, _ *mux.Router, _ []*mux.Route) error {
+		methods, err := route.GetMethods()
+		if err != nil {
+			// the route matches any method
+			return nil
+		}
+
+		tmpl, err := route.GetPathTemplate()
+		if err != nil {
+			return nil
+		}
+
+		path, params := parsePathTemplate(tmpl)
+		if spec.Paths[path] == nil {
+			spec.Paths[path] = map[string]OpenAPIOperation{}
+		}
+
+		for _, method := range methods {
+			op := OpenAPIOperation{
+				Parameters: params,
+				Responses:  map[string]OpenAPIResponse{"200": {Description: "OK"}},
+			}
+			if segments := strings.Split(strings.Trim(path, "/"), "/"); segments[0] != "" {
+				op.Tags = []string{segments[0]}
+			}
+
+			spec.Paths[path][strings.ToLower(method)] = op
+		}
+
+		return nil
+	})
+
+	return spec, err
+}
+
+// parsePathTemplate returns the path template of a route without the patterns of its
+// variables, and the parameters of these variables, e.g. /blocks/{height} and the
+// height parameter for /blocks/{height:[0-9]+}.
+func parsePathTemplate(tmpl string) (string, []OpenAPIParameter) {
+	var (
+		path   strings.Builder
+		params []OpenAPIParameter
+	)
+
+	for len(tmpl) > 0 {
+		start := strings.IndexByte(tmpl, '{')
+		end := strings.IndexByte(tmpl, '}')
+		if start < 0 || end < start {
+			path.WriteString(tmpl)
+			break
+		}
+
+		name := tmpl[start+1 : end]
+		if i := strings.IndexByte(name, ':'); i >= 0 {
+			name = name[:i]
+		}
+
+		path.WriteString(tmpl[:start] + "{" + name + "}")
+		params = append(params, OpenAPIParameter{Name: name, In: "path", Required: true, Type: "string"})
+		tmpl = tmpl[end+1:]
+	}
+
+	sort.Slice(params, func(i, j int) bool { return params[i].Name < params[j].Name })
+
+	return path.String(), params
+}
+
+// swaggerIndex is the page of the Swagger UI, loading the OpenAPI spec of the server.
+const swaggerIndex = `<!DOCTYPE html>
+<html lang="en">
+  <head>
+    <meta charset="UTF-8">
+    <title>Swagger UI</title>
+    <link rel="stylesheet" type="text/css" href="swagger-ui.css">
+    <link rel="icon" type="image/png" href="favicon-32x32.png" sizes="32x32" />
+  </head>
+
+  <body>
+    <div id="swagger-ui"></div>
+
+    <script src="swagger-ui-bundle.js"> </script>
+    <script src="swagger-ui-standalone-preset.js"> </script>
+    <script>
+    window.onload = function() {
+      window.ui = SwaggerUIBundle({
+        url: "` + OpenAPIPath + `",
+        dom_id: '#swagger-ui',
+        deepLinking: true,
+        presets: [SwaggerUIBundle.presets.apis, SwaggerUIStandalonePreset],
+        layout: "StandaloneLayout"
+      })
+    }
+    </script>
+  </body>
+</html>
+`
+
+// registerOpenAPI serves the OpenAPI spec of the routes registered so far, and the
+// Swagger UI of the embedded statik file system, at SwaggerPath.
+func (rs *RestServer) registerOpenAPI() {
+	statikFS, err := fs.New()
+	if err != nil {
+		panic(err)
+	}
+
+	// the spec documents the routes registered before, not the ones of the UI
+	spec, err := NewOpenAPISpec(rs.Mux)
+	if err != nil {
+		panic(err)
+	}
+
+	bz, err := json.MarshalIndent(spec, "", "  ")
+	if err != nil {
+		panic(err)
+	}
+
+	rs.Mux.Path(OpenAPIPath).Methods("GET").HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(bz)
+	})
+	rs.Mux.Path(SwaggerPath).HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = w.Write([]byte(swaggerIndex))
+	})
+	rs.Mux.PathPrefix(SwaggerPath).Handler(http.StripPrefix(SwaggerPath, http.FileServer(statikFS)))
+}
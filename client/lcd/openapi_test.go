@@ -0,0 +1,71 @@
+package lcd
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOpenAPISpec(t *testing.T) {
+	handler := func(http.ResponseWriter, *http.Request) {}
+
+	r := mux.NewRouter()
+	r.HandleFunc("/bank/balances/{address}", handler).Methods("GET")
+	r.HandleFunc("/bank/accounts/{address}/transfers", handler).Methods("POST")
+	r.HandleFunc("/blocks/{height:[0-9]+}", handler).Methods("GET")
+	r.HandleFunc("/txs", handler).Methods("GET", "POST")
+	r.PathPrefix("/").Handler(http.NotFoundHandler())
+
+	spec, err := NewOpenAPISpec(r)
+	require.NoError(t, err)
+	require.Equal(t, "2.0", spec.Swagger)
+
+	// the routes without methods are skipped
+	require.Len(t, spec.Paths, 4)
+
+	op := spec.Paths["/bank/balances/{address}"]["get"]
+	require.Equal(t, []string{"bank"}, op.Tags)
+	require.Equal(t, []OpenAPIParameter{{Name: "address", In: "path", Required: true, Type: "string"}}, op.Parameters)
+	require.Contains(t, op.Responses, "200")
+
+	require.Contains(t, spec.Paths["/bank/accounts/{address}/transfers"], "post")
+
+	// the patterns of the variables are stripped
+	op = spec.Paths["/blocks/{height}"]["get"]
+	require.Equal(t, "height", op.Parameters[0].Name)
+
+	require.Len(t, spec.Paths["/txs"], 2)
+	require.Empty(t, spec.Paths["/txs"]["post"].Parameters)
+}
+
+func TestRegisterOpenAPI(t *testing.T) {
+	rs := &RestServer{Mux: mux.NewRouter()}
+	rs.Mux.HandleFunc("/txs/{hash}", func(http.ResponseWriter, *http.Request) {}).Methods("GET")
+	rs.registerOpenAPI()
+
+	get := func(path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		rs.Mux.ServeHTTP(w, httptest.NewRequest("GET", path, nil))
+		return w
+	}
+
+	w := get(OpenAPIPath)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	// the spec doesn't document the routes of the UI
+	var spec OpenAPISpec
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &spec))
+	require.Len(t, spec.Paths, 1)
+	require.Contains(t, spec.Paths, "/txs/{hash}")
+
+	w = get(SwaggerPath)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Contains(t, w.Body.String(), OpenAPIPath)
+
+	// the UI's assets are served from the embedded files
+	require.Equal(t, http.StatusOK, get(SwaggerPath+"swagger-ui.css").Code)
+}
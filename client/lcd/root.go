@@ -14,9 +14,12 @@ import (
 	"github.com/spf13/viper"
 	"github.com/tendermint/tendermint/libs/log"
 	rpcserver "github.com/tendermint/tendermint/rpc/lib/server"
+	"google.golang.org/grpc"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/rpc"
+	"github.com/cosmos/cosmos-sdk/client/rpc/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/server"
 
@@ -28,6 +31,9 @@ import (
 type RestServer struct {
 	Mux    *mux.Router
 	CliCtx context.CLIContext
+	// GRPCServer serves the gRPC service of the node status, and the services
+	// registered with it, when started by StartGRPC.
+	GRPCServer *grpc.Server
 
 	log      log.Logger
 	listener net.Listener
@@ -35,15 +41,43 @@ type RestServer struct {
 
 // NewRestServer creates a new rest server instance
 func NewRestServer(cdc *codec.Codec) *RestServer {
+	return NewRestServerWithContext(context.NewCLIContext().WithCodec(cdc))
+}
+
+// NewRestServerWithContext creates a new rest server instance serving the
+// routes with the given context, rather than with one built from the command
+// line flags.
+func NewRestServerWithContext(cliCtx context.CLIContext) *RestServer {
 	r := mux.NewRouter()
-	cliCtx := context.NewCLIContext().WithCodec(cdc)
 	logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout)).With("module", "rest-server")
 
+	grpcServer := grpc.NewServer()
+	types.RegisterServiceServer(grpcServer, rpc.NewNodeService(cliCtx))
+
 	return &RestServer{
-		Mux:    r,
-		CliCtx: cliCtx,
-		log:    logger,
+		Mux:        r,
+		CliCtx:     cliCtx,
+		GRPCServer: grpcServer,
+		log:        logger,
+	}
+}
+
+// StartGRPC starts the gRPC server in the background.
+func (rs *RestServer) StartGRPC(listenAddr string) error {
+	listener, err := net.Listen("tcp", listenAddr)
+	if err != nil {
+		return err
 	}
+
+	rs.log.Info(fmt.Sprintf("Starting gRPC service on %s...", listener.Addr()))
+
+	go func() {
+		if err := rs.GRPCServer.Serve(listener); err != nil {
+			rs.log.Error("error serving gRPC", "err", err)
+		}
+	}()
+
+	return nil
 }
 
 // Start starts the rest server
@@ -90,7 +124,16 @@ func ServeCommand(cdc *codec.Codec, registerRoutesFn func(*RestServer)) *cobra.C
 			rs := NewRestServer(cdc)
 
 			registerRoutesFn(rs)
-			rs.registerSwaggerUI()
+			if viper.GetBool(flags.FlagSwagger) {
+				rs.registerOpenAPI()
+				rs.registerSwaggerUI()
+			}
+
+			if addr := viper.GetString(flags.FlagGRPCAddress); addr != "" {
+				if err := rs.StartGRPC(addr); err != nil {
+					return err
+				}
+			}
 
 			// Start the rest server and return error if one exists
 			err = rs.Start(
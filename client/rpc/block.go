@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/rest"
 
 	tmliteProxy "github.com/tendermint/tendermint/lite/proxy"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 )
 
 //BlockCommand returns the verified block data for a given heights
@@ -35,6 +36,15 @@ func BlockCommand() *cobra.Command {
 }
 
 func getBlock(cliCtx context.CLIContext, height *int64) ([]byte, error) {
+	res, err := getVerifiedBlock(cliCtx, height)
+	if err != nil {
+		return nil, err
+	}
+
+	return marshalBlock(cliCtx, res)
+}
+
+func getVerifiedBlock(cliCtx context.CLIContext, height *int64) (*ctypes.ResultBlock, error) {
 	// get the node
 	node, err := cliCtx.GetNode()
 	if err != nil {
@@ -64,6 +74,10 @@ func getBlock(cliCtx context.CLIContext, height *int64) ([]byte, error) {
 		}
 	}
 
+	return res, nil
+}
+
+func marshalBlock(cliCtx context.CLIContext, res *ctypes.ResultBlock) ([]byte, error) {
 	if cliCtx.Indent {
 		return codec.Cdc.MarshalJSONIndent(res, "", "  ")
 	}
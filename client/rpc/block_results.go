@@ -0,0 +1,296 @@
+package rpc
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	flagSearchDepth = "search-depth"
+
+	// defaultSearchDepth is the default number of latest blocks searched for a
+	// block hash.
+	defaultSearchDepth = 1000
+
+	// blockchainInfoLimit is the maximum number of block metas returned by the
+	// blockchain endpoint of Tendermint.
+	blockchainInfoLimit = 20
+)
+
+// BlockResultsCommand returns the command printing the results of the
+// execution of a block, given its height or hash.
+func BlockResultsCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "block-results [height|hash]",
+		Short: "Get the results of the execution of the block at given height, or of given hash",
+		Long: `Get the results of the execution of the block at given height, or of the given hex
+encoded hash, or of the latest block: the events of its begin and end blockers, the
+results of its txs, decoded by the application codec, and the validator and consensus
+param updates of its end blocker.
+
+The block, and so its txs, is verified unless --trust-node is set, but the results are
+returned by the node as is. A block is looked up by hash among the --search-depth latest
+blocks, as the Tendermint RPC doesn't index the blocks by hash.
+`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			height, err := parseHeightOrHash(cliCtx, args, viper.GetInt64(flagSearchDepth))
+			if err != nil {
+				return err
+			}
+
+			output, err := GetBlockResults(cliCtx, height)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(output)
+		},
+	}
+
+	addBlockFlags(cmd)
+	cmd.Flags().Bool(flags.FlagIndentResponse, false, "indent JSON response")
+	viper.BindPFlag(flags.FlagIndentResponse, cmd.Flags().Lookup(flags.FlagIndentResponse))
+
+	return cmd
+}
+
+// BlockByHashCommand returns the command printing the verified data of the
+// block of a given hash.
+func BlockByHashCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "block-by-hash [hash]",
+		Short: "Get verified data for the block of given hash",
+		Long: `Get verified data for the block of the given hex encoded hash, as the block command.
+
+The block is looked up among the --search-depth latest blocks, as the Tendermint RPC
+doesn't index the blocks by hash.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext()
+
+			hash, err := hex.DecodeString(args[0])
+			if err != nil {
+				return err
+			}
+
+			height, err := GetBlockHeightByHash(cliCtx, hash, viper.GetInt64(flagSearchDepth))
+			if err != nil {
+				return err
+			}
+
+			res, err := getVerifiedBlock(cliCtx, &height)
+			if err != nil {
+				return err
+			}
+
+			// the hash matched by the node must be the one of the verified block
+			if !bytes.Equal(res.Block.Hash(), hash) {
+				return fmt.Errorf("the block at height %d has hash %X, not %X", height, res.Block.Hash(), hash)
+			}
+
+			output, err := marshalBlock(cliCtx, res)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+
+	addBlockFlags(cmd)
+
+	return cmd
+}
+
+func addBlockFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP(flags.FlagNode, "n", "tcp://localhost:26657", "Node to connect to")
+	viper.BindPFlag(flags.FlagNode, cmd.Flags().Lookup(flags.FlagNode))
+	cmd.Flags().Bool(flags.FlagTrustNode, false, "Trust connected full node (don't verify proofs for responses)")
+	viper.BindPFlag(flags.FlagTrustNode, cmd.Flags().Lookup(flags.FlagTrustNode))
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test)")
+	viper.BindPFlag(flags.FlagKeyringBackend, cmd.Flags().Lookup(flags.FlagKeyringBackend))
+	cmd.Flags().Int64(flagSearchDepth, defaultSearchDepth, "Number of latest blocks searched for a block hash, or 0 to search the whole chain")
+	viper.BindPFlag(flagSearchDepth, cmd.Flags().Lookup(flagSearchDepth))
+}
+
+// parseHeightOrHash returns the height of the optional argument, which is
+// either a height or the hex encoded hash of a block, or nil for the latest
+// block.
+func parseHeightOrHash(cliCtx context.CLIContext, args []string, searchDepth int64) (*int64, error) {
+	if len(args) == 0 {
+		return nil, nil
+	}
+
+	if len(args[0]) == 2*tmhash.Size {
+		if hash, err := hex.DecodeString(args[0]); err == nil {
+			height, err := GetBlockHeightByHash(cliCtx, hash, searchDepth)
+			return &height, err
+		}
+	}
+
+	height, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%q is neither a block height nor a block hash", args[0])
+	}
+	if height <= 0 {
+		return nil, nil
+	}
+
+	return &height, nil
+}
+
+// GetBlockHeightByHash returns the height of the block of the given hash,
+// searched among the given number of latest blocks, or the whole chain if it's
+// zero, as the Tendermint RPC doesn't index the blocks by hash.
+func GetBlockHeightByHash(cliCtx context.CLIContext, hash []byte, depth int64) (int64, error) {
+	node, err := cliCtx.GetNode()
+	if err != nil {
+		return 0, err
+	}
+
+	latest, err := GetChainHeight(cliCtx)
+	if err != nil {
+		return 0, err
+	}
+
+	lowest := int64(1)
+	if depth > 0 && latest-depth+1 > lowest {
+		lowest = latest - depth + 1
+	}
+
+	for max := latest; max >= lowest; max -= blockchainInfoLimit {
+		min := max - blockchainInfoLimit + 1
+		if min < lowest {
+			min = lowest
+		}
+
+		info, err := node.BlockchainInfo(min, max)
+		if err != nil {
+			return 0, err
+		}
+
+		for _, meta := range info.BlockMetas {
+			if bytes.Equal(meta.BlockID.Hash, hash) {
+				return meta.Header.Height, nil
+			}
+		}
+	}
+
+	return 0, fmt.Errorf("no block of hash %X among the blocks %d to %d", hash, lowest, latest)
+}
+
+// BlockResultsOutput holds the results of the execution of a block, with its
+// txs decoded and the attributes of its events as strings.
+type BlockResultsOutput struct {
+	Height                int64                   `json:"height" yaml:"height"`
+	TxsResults            []sdk.TxResponse        `json:"txs_results" yaml:"txs_results"`
+	BeginBlockEvents      sdk.StringEvents        `json:"begin_block_events" yaml:"begin_block_events"`
+	EndBlockEvents        sdk.StringEvents        `json:"end_block_events" yaml:"end_block_events"`
+	ValidatorUpdates      []ValidatorUpdateOutput `json:"validator_updates" yaml:"validator_updates"`
+	ConsensusParamUpdates *abci.ConsensusParams   `json:"consensus_param_updates,omitempty" yaml:"consensus_param_updates,omitempty"`
+}
+
+// ValidatorUpdateOutput is a validator update in bech32 format.
+type ValidatorUpdateOutput struct {
+	Address sdk.ConsAddress `json:"address" yaml:"address"`
+	PubKey  string          `json:"pub_key" yaml:"pub_key"`
+	Power   int64           `json:"power" yaml:"power"`
+}
+
+// GetBlockResults returns the results of the execution of the block at the
+// given height, or of the latest block if it's nil. The txs of the block are
+// decoded by the codec of the context, their Tx being nil if they can't be.
+func GetBlockResults(cliCtx context.CLIContext, height *int64) (BlockResultsOutput, error) {
+	node, err := cliCtx.GetNode()
+	if err != nil {
+		return BlockResultsOutput{}, err
+	}
+
+	res, err := node.BlockResults(height)
+	if err != nil {
+		return BlockResultsOutput{}, err
+	}
+
+	// the block of the results, rather than the latest one, which may be newer
+	block, err := getVerifiedBlock(cliCtx, &res.Height)
+	if err != nil {
+		return BlockResultsOutput{}, err
+	}
+
+	if len(block.Block.Txs) != len(res.TxsResults) {
+		return BlockResultsOutput{}, fmt.Errorf(
+			"the block at height %d has %d txs but %d tx results", res.Height, len(block.Block.Txs), len(res.TxsResults),
+		)
+	}
+
+	output := BlockResultsOutput{
+		Height:                res.Height,
+		TxsResults:            make([]sdk.TxResponse, len(res.TxsResults)),
+		BeginBlockEvents:      sdk.StringifyEvents(res.BeginBlockEvents),
+		EndBlockEvents:        sdk.StringifyEvents(res.EndBlockEvents),
+		ValidatorUpdates:      make([]ValidatorUpdateOutput, len(res.ValidatorUpdates)),
+		ConsensusParamUpdates: res.ConsensusParamUpdates,
+	}
+
+	timestamp := block.Block.Time.Format(time.RFC3339)
+	for i, txResult := range res.TxsResults {
+		txBytes := block.Block.Txs[i]
+
+		var tx sdk.Tx
+		if err := cliCtx.Codec.UnmarshalBinaryBare(txBytes, &tx); err != nil {
+			// the txs which can't be decoded are returned without their Tx
+			tx = nil
+		}
+
+		resTx := &ctypes.ResultTx{
+			Hash:     txBytes.Hash(),
+			Height:   res.Height,
+			Index:    uint32(i),
+			TxResult: *txResult,
+			Tx:       txBytes,
+		}
+
+		output.TxsResults[i] = sdk.NewResponseResultTx(resTx, tx, timestamp)
+	}
+
+	for i, update := range res.ValidatorUpdates {
+		pubKey, err := tmtypes.PB2TM.PubKey(update.PubKey)
+		if err != nil {
+			return BlockResultsOutput{}, err
+		}
+
+		bechPubKey, err := sdk.Bech32ifyPubKey(sdk.Bech32PubKeyTypeConsPub, pubKey)
+		if err != nil {
+			return BlockResultsOutput{}, err
+		}
+
+		output.ValidatorUpdates[i] = ValidatorUpdateOutput{
+			Address: sdk.ConsAddress(pubKey.Address()),
+			PubKey:  bechPubKey,
+			Power:   update.Power,
+		}
+	}
+
+	return output, nil
+}
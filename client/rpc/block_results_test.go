@@ -0,0 +1,173 @@
+package rpc_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/kv"
+	"github.com/tendermint/tendermint/rpc/client/mock"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/rpc"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// mockChainClient serves a chain of blocks, each holding the given txs, and
+// counts the blockchain queries.
+type mockChainClient struct {
+	mock.Client
+	blocks  []*tmtypes.Block
+	results *ctypes.ResultBlockResults
+	queries *int
+}
+
+func newMockChainClient(height int64, txs tmtypes.Txs, results *ctypes.ResultBlockResults) mockChainClient {
+	c := mockChainClient{results: results, queries: new(int)}
+	for h := int64(1); h <= height; h++ {
+		c.blocks = append(c.blocks, &tmtypes.Block{
+			Header:     tmtypes.Header{Height: h, Time: time.Unix(h, 0).UTC(), ValidatorsHash: []byte("validators")},
+			Data:       tmtypes.Data{Txs: txs},
+			LastCommit: &tmtypes.Commit{},
+		})
+	}
+
+	return c
+}
+
+func (c mockChainClient) Status() (*ctypes.ResultStatus, error) {
+	return &ctypes.ResultStatus{SyncInfo: ctypes.SyncInfo{LatestBlockHeight: int64(len(c.blocks))}}, nil
+}
+
+func (c mockChainClient) BlockchainInfo(min, max int64) (*ctypes.ResultBlockchainInfo, error) {
+	*c.queries++
+
+	if max-min+1 > 20 {
+		return nil, fmt.Errorf("more than 20 blocks requested: %d to %d", min, max)
+	}
+
+	res := &ctypes.ResultBlockchainInfo{LastHeight: int64(len(c.blocks))}
+	for h := max; h >= min; h-- {
+		block := c.blocks[h-1]
+		res.BlockMetas = append(res.BlockMetas, &tmtypes.BlockMeta{
+			BlockID: tmtypes.BlockID{Hash: block.Hash()},
+			Header:  block.Header,
+		})
+	}
+
+	return res, nil
+}
+
+func (c mockChainClient) Block(height *int64) (*ctypes.ResultBlock, error) {
+	h := int64(len(c.blocks))
+	if height != nil {
+		h = *height
+	}
+
+	return &ctypes.ResultBlock{Block: c.blocks[h-1]}, nil
+}
+
+func (c mockChainClient) BlockResults(height *int64) (*ctypes.ResultBlockResults, error) {
+	res := *c.results
+	res.Height = int64(len(c.blocks))
+	if height != nil {
+		res.Height = *height
+	}
+
+	return &res, nil
+}
+
+func TestGetBlockHeightByHash(t *testing.T) {
+	client := newMockChainClient(50, nil, nil)
+	cliCtx := context.CLIContext{TrustNode: true}.WithClient(client)
+
+	// the blocks are searched from the latest one, 20 at a time
+	height, err := rpc.GetBlockHeightByHash(cliCtx, client.blocks[44].Hash(), 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(45), height)
+	require.Equal(t, 1, *client.queries)
+
+	*client.queries = 0
+	height, err = rpc.GetBlockHeightByHash(cliCtx, client.blocks[0].Hash(), 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), height)
+	require.Equal(t, 3, *client.queries)
+
+	// among the latest blocks of the search depth
+	height, err = rpc.GetBlockHeightByHash(cliCtx, client.blocks[25].Hash(), 25)
+	require.NoError(t, err)
+	require.Equal(t, int64(26), height)
+
+	_, err = rpc.GetBlockHeightByHash(cliCtx, client.blocks[24].Hash(), 25)
+	require.EqualError(t, err, fmt.Sprintf("no block of hash %X among the blocks 26 to 50", client.blocks[24].Hash()))
+
+	_, err = rpc.GetBlockHeightByHash(cliCtx, []byte("unknown"), 0)
+	require.Error(t, err)
+}
+
+func TestGetBlockResults(t *testing.T) {
+	cdc := makeCodec()
+
+	addr := sdk.AccAddress([]byte("from________________"))
+	msg := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	stdTx := authtypes.NewStdTx([]sdk.Msg{msg}, authtypes.NewStdFee(200000, nil), nil, "hello")
+	txBytes, err := cdc.MarshalBinaryBare(stdTx)
+	require.NoError(t, err)
+
+	abciEvents := []abci.Event{{
+		Type:       "message",
+		Attributes: []kv.Pair{{Key: []byte("action"), Value: []byte("send")}},
+	}}
+	valPubKey := ed25519.GenPrivKey().PubKey()
+
+	client := newMockChainClient(3, tmtypes.Txs{txBytes, []byte("invalid")}, &ctypes.ResultBlockResults{
+		TxsResults: []*abci.ResponseDeliverTx{
+			{Code: 0, GasUsed: 100, Events: abciEvents},
+			{Code: 2, Codespace: "sdk", Log: "tx parse error"},
+		},
+		BeginBlockEvents: abciEvents,
+		ValidatorUpdates: []abci.ValidatorUpdate{tmtypes.TM2PB.NewValidatorUpdate(valPubKey, 10)},
+	})
+	cliCtx := context.CLIContext{TrustNode: true}.WithCodec(cdc).WithClient(client)
+
+	height := int64(2)
+	output, err := rpc.GetBlockResults(cliCtx, &height)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), output.Height)
+	require.Equal(t, sdk.StringifyEvents(abciEvents), output.BeginBlockEvents)
+	require.Empty(t, output.EndBlockEvents)
+
+	// the txs are decoded by the codec, when they can be
+	require.Len(t, output.TxsResults, 2)
+	require.Equal(t, fmt.Sprintf("%X", tmtypes.Tx(txBytes).Hash()), output.TxsResults[0].TxHash)
+	require.Equal(t, stdTx, output.TxsResults[0].Tx)
+	require.Equal(t, int64(100), output.TxsResults[0].GasUsed)
+	require.Equal(t, sdk.StringifyEvents(abciEvents), output.TxsResults[0].Events)
+	require.Equal(t, time.Unix(2, 0).UTC().Format(time.RFC3339), output.TxsResults[0].Timestamp)
+	require.Nil(t, output.TxsResults[1].Tx)
+	require.Equal(t, uint32(2), output.TxsResults[1].Code)
+	require.Equal(t, "sdk", output.TxsResults[1].Codespace)
+
+	require.Equal(t, []rpc.ValidatorUpdateOutput{{
+		Address: sdk.ConsAddress(valPubKey.Address()),
+		PubKey:  sdk.MustBech32ifyPubKey(sdk.Bech32PubKeyTypeConsPub, valPubKey),
+		Power:   10,
+	}}, output.ValidatorUpdates)
+
+	// the results of the latest block are the ones of its height
+	output, err = rpc.GetBlockResults(cliCtx, nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), output.Height)
+
+	// and the results must match the txs of the block
+	client.results.TxsResults = client.results.TxsResults[:1]
+	_, err = rpc.GetBlockResults(cliCtx, &height)
+	require.EqualError(t, err, "the block at height 2 has 2 txs but 1 tx results")
+}
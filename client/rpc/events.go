@@ -0,0 +1,327 @@
+package rpc
+
+import (
+	gocontext "context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+
+	"github.com/gorilla/websocket"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+)
+
+// Actions of the EventsRequest messages.
+const (
+	EventsActionSubscribe   = "subscribe"
+	EventsActionUnsubscribe = "unsubscribe"
+)
+
+// eventsCapacity is the number of events of a subscription buffered while the
+// previous ones are written to the connection.
+const eventsCapacity = 100
+
+// EventsRequest is a message sent to the events WebSocket, subscribing to or
+// unsubscribing from the Tendermint events matching the query, e.g.
+// {"action": "subscribe", "query": "tm.event='Tx'"}.
+type EventsRequest struct {
+	Action string `json:"action"`
+	Query  string `json:"query"`
+}
+
+// EventsResponse is a message sent by the events WebSocket: an event of a
+// subscription, or the error of a request or of the decoding of an event. The
+// data of the Tx events is the TxResponse of their tx, and the one of the
+// NewBlock events a BlockEventData, while the data of the other events is the
+// one of Tendermint.
+type EventsResponse struct {
+	Query  string              `json:"query,omitempty"`
+	Events map[string][]string `json:"events,omitempty"`
+	Data   json.RawMessage     `json:"data,omitempty"`
+	Error  string              `json:"error,omitempty"`
+}
+
+// BlockEventData is the data of the NewBlock events, with the txs of the block
+// decoded, and the attributes of the events of its begin and end blockers as
+// strings.
+type BlockEventData struct {
+	Header           tmtypes.Header   `json:"header"`
+	Txs              []sdk.Tx         `json:"txs"`
+	BeginBlockEvents sdk.StringEvents `json:"begin_block_events,omitempty"`
+	EndBlockEvents   sdk.StringEvents `json:"end_block_events,omitempty"`
+}
+
+// upgrader upgrades the connections of the events WebSocket. As for the
+// WebSocket of Tendermint, the connections of any origin are accepted.
+var upgrader = websocket.Upgrader{
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+// subscribers counts the connections of the events WebSocket, to name their
+// subscriber.
+var subscribers uint64
+
+// EventsWebSocketHandlerFn returns the handler of a WebSocket proxying the
+// Tendermint event subscriptions of the node, sending the events with their
+// txs decoded by the decoder, and the attributes of their ABCI events as
+// strings, JSON encoded by the marshaler of the context (or its codec). The
+// clients send EventsRequest messages, and receive EventsResponse messages.
+func EventsWebSocketHandlerFn(cliCtx context.CLIContext, txDecoder sdk.TxDecoder) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		client, stop, err := eventsClient(cliCtx)
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+		defer stop()
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			// the upgrader replied with the error
+			return
+		}
+		defer conn.Close()
+
+		s := &eventsSession{
+			cliCtx:     cliCtx,
+			txDecoder:  txDecoder,
+			client:     client,
+			subscriber: fmt.Sprintf("rest-server-%d", atomic.AddUint64(&subscribers, 1)),
+			conn:       conn,
+			responses:  make(chan EventsResponse, eventsCapacity),
+			cancels:    map[string]func(){},
+		}
+		s.run()
+	}
+}
+
+// eventsClient returns the client subscribing to the events of a connection,
+// and the function releasing it. The HTTP clients hold a single subscription
+// per query, so each connection gets its own HTTP client to the node of the
+// context, while the other clients, e.g. the local ones, are shared.
+func eventsClient(cliCtx context.CLIContext) (rpcclient.EventsClient, func(), error) {
+	if cliCtx.Client == nil {
+		return nil, nil, fmt.Errorf("no RPC client is defined in offline mode")
+	}
+
+	if _, ok := cliCtx.Client.(*rpchttp.HTTP); !ok {
+		return cliCtx.Client, func() {}, nil
+	}
+
+	client, err := rpchttp.New(cliCtx.NodeURI, "/websocket")
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := client.Start(); err != nil {
+		return nil, nil, err
+	}
+
+	return client, func() { _ = client.Stop() }, nil
+}
+
+// eventsSession serves a connection of the events WebSocket. The requests are
+// read by run, while the responses are written by writeResponses, as a
+// connection supports a single reader and a single writer.
+type eventsSession struct {
+	cliCtx     context.CLIContext
+	txDecoder  sdk.TxDecoder
+	client     rpcclient.EventsClient
+	subscriber string
+	conn       *websocket.Conn
+
+	responses chan EventsResponse
+	wg        sync.WaitGroup
+
+	mtx     sync.Mutex
+	cancels map[string]func()
+}
+
+func (s *eventsSession) run() {
+	ctx, cancel := gocontext.WithCancel(gocontext.Background())
+
+	written := make(chan struct{})
+	go func() {
+		s.writeResponses(ctx)
+		close(written)
+	}()
+
+	for {
+		var req EventsRequest
+		if err := s.conn.ReadJSON(&req); err != nil {
+			// the connection is closed, or its messages aren't JSON
+			break
+		}
+
+		if err := s.handleRequest(ctx, req); err != nil {
+			s.send(ctx, EventsResponse{Query: req.Query, Error: err.Error()})
+		}
+	}
+
+	_ = s.client.UnsubscribeAll(gocontext.Background(), s.subscriber)
+
+	cancel()
+	s.wg.Wait()
+	<-written
+}
+
+func (s *eventsSession) handleRequest(ctx gocontext.Context, req EventsRequest) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	switch req.Action {
+	case EventsActionSubscribe:
+		if _, ok := s.cancels[req.Query]; ok {
+			return fmt.Errorf("already subscribed to %q", req.Query)
+		}
+
+		events, err := s.client.Subscribe(ctx, s.subscriber, req.Query, eventsCapacity)
+		if err != nil {
+			return err
+		}
+
+		subCtx, cancel := gocontext.WithCancel(ctx)
+		s.cancels[req.Query] = cancel
+
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.forwardEvents(subCtx, events)
+		}()
+
+		return nil
+
+	case EventsActionUnsubscribe:
+		cancel, ok := s.cancels[req.Query]
+		if !ok {
+			return fmt.Errorf("not subscribed to %q", req.Query)
+		}
+
+		delete(s.cancels, req.Query)
+		cancel()
+
+		return s.client.Unsubscribe(ctx, s.subscriber, req.Query)
+
+	default:
+		return fmt.Errorf("unknown action %q; supported actions: %s, %s",
+			req.Action, EventsActionSubscribe, EventsActionUnsubscribe)
+	}
+}
+
+// forwardEvents sends the events of a subscription, until it's cancelled or
+// its channel is closed.
+func (s *eventsSession) forwardEvents(ctx gocontext.Context, events <-chan ctypes.ResultEvent) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+
+			s.send(ctx, s.decodeEvent(event))
+		}
+	}
+}
+
+func (s *eventsSession) send(ctx gocontext.Context, res EventsResponse) {
+	select {
+	case s.responses <- res:
+	case <-ctx.Done():
+	}
+}
+
+func (s *eventsSession) writeResponses(ctx gocontext.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case res := <-s.responses:
+			if err := s.conn.WriteJSON(res); err != nil {
+				// the connection is closed, which stops the reads as well
+				return
+			}
+		}
+	}
+}
+
+// decodeEvent returns the response of an event. The events whose data can't
+// be decoded are sent with the error and their Tendermint data.
+func (s *eventsSession) decodeEvent(event ctypes.ResultEvent) EventsResponse {
+	res := EventsResponse{Query: event.Query, Events: event.Events}
+
+	data, err := s.decodeEventData(event.Data)
+	if err != nil {
+		res.Error = fmt.Sprintf("failed to decode the event data: %s", err)
+		data = event.Data
+	}
+
+	res.Data, err = s.marshaler().MarshalJSON(data)
+	if err != nil {
+		res.Error = fmt.Sprintf("failed to encode the event data: %s", err)
+	}
+
+	return res
+}
+
+func (s *eventsSession) decodeEventData(data tmtypes.TMEventData) (interface{}, error) {
+	switch data := data.(type) {
+	case tmtypes.EventDataTx:
+		tx, err := s.txDecoder(data.Tx)
+		if err != nil {
+			return nil, err
+		}
+
+		resTx := &ctypes.ResultTx{
+			Hash:     data.Tx.Hash(),
+			Height:   data.Height,
+			Index:    data.Index,
+			TxResult: data.Result,
+			Tx:       data.Tx,
+		}
+
+		return sdk.NewResponseResultTx(resTx, tx, ""), nil
+
+	case tmtypes.EventDataNewBlock:
+		blockData := BlockEventData{
+			Header:           data.Block.Header,
+			Txs:              make([]sdk.Tx, len(data.Block.Txs)),
+			BeginBlockEvents: sdk.StringifyEvents(data.ResultBeginBlock.Events),
+			EndBlockEvents:   sdk.StringifyEvents(data.ResultEndBlock.Events),
+		}
+
+		for i, txBytes := range data.Block.Txs {
+			tx, err := s.txDecoder(txBytes)
+			if err != nil {
+				return nil, err
+			}
+
+			blockData.Txs[i] = tx
+		}
+
+		return blockData, nil
+
+	default:
+		return data, nil
+	}
+}
+
+// TODO: Remove once client-side Protobuf migration has been completed.
+// ref: https://github.com/cosmos/cosmos-sdk/issues/5864
+func (s *eventsSession) marshaler() codec.JSONMarshaler {
+	if s.cliCtx.Marshaler != nil {
+		return s.cliCtx.Marshaler
+	}
+
+	return s.cliCtx.Codec
+}
@@ -0,0 +1,176 @@
+package rpc_test
+
+import (
+	gocontext "context"
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/kv"
+	"github.com/tendermint/tendermint/rpc/client/mock"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/rpc"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// mockEventsClient is a client whose subscriptions are sent on subscribed,
+// and whose unsubscriptions are sent on unsubscribed.
+type mockEventsClient struct {
+	mock.Client
+	subscribed   chan chan ctypes.ResultEvent
+	unsubscribed chan string
+}
+
+func (c mockEventsClient) Subscribe(
+	_ gocontext.Context, _, query string, _ ...int,
+) (<-chan ctypes.ResultEvent, error) {
+	if query == "invalid" {
+		return nil, errInvalidQuery
+	}
+
+	events := make(chan ctypes.ResultEvent)
+	c.subscribed <- events
+
+	return events, nil
+}
+
+func (c mockEventsClient) Unsubscribe(_ gocontext.Context, _, query string) error {
+	c.unsubscribed <- query
+	return nil
+}
+
+func (c mockEventsClient) UnsubscribeAll(gocontext.Context, string) error {
+	c.unsubscribed <- "all"
+	return nil
+}
+
+var errInvalidQuery = errors.New("invalid query")
+
+func makeCodec() *codec.Codec {
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	authtypes.RegisterCodec(cdc)
+	banktypes.RegisterCodec(cdc)
+
+	return cdc
+}
+
+func TestEventsWebSocket(t *testing.T) {
+	cdc := makeCodec()
+	client := mockEventsClient{
+		subscribed:   make(chan chan ctypes.ResultEvent, 1),
+		unsubscribed: make(chan string, 1),
+	}
+	cliCtx := context.CLIContext{}.WithCodec(cdc).WithClient(client)
+
+	server := httptest.NewServer(rpc.EventsWebSocketHandlerFn(cliCtx, authtypes.DefaultTxDecoder(cdc)))
+	defer server.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	require.NoError(t, err)
+
+	addr := sdk.AccAddress([]byte("from________________"))
+	msg := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	stdTx := authtypes.NewStdTx([]sdk.Msg{msg}, authtypes.NewStdFee(200000, nil), nil, "hello")
+	txBytes, err := cdc.MarshalBinaryBare(stdTx)
+	require.NoError(t, err)
+
+	abciEvents := []abci.Event{{
+		Type:       "message",
+		Attributes: []kv.Pair{{Key: []byte("action"), Value: []byte("send")}},
+	}}
+
+	// the txs of the events are decoded, and their attributes are strings
+	query := "tm.event='Tx'"
+	require.NoError(t, conn.WriteJSON(rpc.EventsRequest{Action: rpc.EventsActionSubscribe, Query: query}))
+	events := <-client.subscribed
+
+	events <- ctypes.ResultEvent{
+		Query:  query,
+		Events: map[string][]string{"message.action": {"send"}},
+		Data: tmtypes.EventDataTx{TxResult: tmtypes.TxResult{
+			Height: 5,
+			Tx:     txBytes,
+			Result: abci.ResponseDeliverTx{Events: abciEvents},
+		}},
+	}
+
+	var res rpc.EventsResponse
+	require.NoError(t, conn.ReadJSON(&res))
+	require.Empty(t, res.Error)
+	require.Equal(t, query, res.Query)
+	require.Equal(t, map[string][]string{"message.action": {"send"}}, res.Events)
+
+	var txRes sdk.TxResponse
+	require.NoError(t, cdc.UnmarshalJSON(res.Data, &txRes))
+	require.Equal(t, int64(5), txRes.Height)
+	require.Equal(t, fmt.Sprintf("%X", tmtypes.Tx(txBytes).Hash()), txRes.TxHash)
+	require.Equal(t, stdTx, txRes.Tx)
+	require.Equal(t, sdk.StringifyEvents(abciEvents), txRes.Events)
+
+	// as the ones of the blocks
+	events <- ctypes.ResultEvent{
+		Query: query,
+		Data: tmtypes.EventDataNewBlock{
+			Block:            &tmtypes.Block{Header: tmtypes.Header{Height: 6}, Data: tmtypes.Data{Txs: tmtypes.Txs{txBytes}}},
+			ResultBeginBlock: abci.ResponseBeginBlock{Events: abciEvents},
+		},
+	}
+
+	require.NoError(t, conn.ReadJSON(&res))
+	require.Empty(t, res.Error)
+
+	var blockData rpc.BlockEventData
+	require.NoError(t, cdc.UnmarshalJSON(res.Data, &blockData))
+	require.Equal(t, int64(6), blockData.Header.Height)
+	require.Equal(t, []sdk.Tx{stdTx}, blockData.Txs)
+	require.Equal(t, sdk.StringifyEvents(abciEvents), blockData.BeginBlockEvents)
+	require.Empty(t, blockData.EndBlockEvents)
+
+	// the events which can't be decoded are sent with their Tendermint data
+	events <- ctypes.ResultEvent{
+		Query: query,
+		Data:  tmtypes.EventDataTx{TxResult: tmtypes.TxResult{Height: 7, Tx: []byte("invalid")}},
+	}
+
+	require.NoError(t, conn.ReadJSON(&res))
+	require.Contains(t, res.Error, "failed to decode the event data")
+
+	var data tmtypes.EventDataTx
+	require.NoError(t, cdc.UnmarshalJSON(res.Data, &data))
+	require.Equal(t, int64(7), data.Height)
+
+	// the invalid requests are answered with an error
+	for _, req := range []rpc.EventsRequest{
+		{Action: rpc.EventsActionSubscribe, Query: query},
+		{Action: rpc.EventsActionSubscribe, Query: "invalid"},
+		{Action: rpc.EventsActionUnsubscribe, Query: "tm.event='NewBlock'"},
+		{Action: "publish", Query: query},
+	} {
+		require.NoError(t, conn.WriteJSON(req))
+
+		res = rpc.EventsResponse{}
+		require.NoError(t, conn.ReadJSON(&res))
+		require.NotEmpty(t, res.Error, req)
+		require.Equal(t, req.Query, res.Query)
+	}
+
+	require.NoError(t, conn.WriteJSON(rpc.EventsRequest{Action: rpc.EventsActionUnsubscribe, Query: query}))
+	require.Equal(t, query, <-client.unsubscribed)
+
+	// and the subscriptions are cancelled when the connection is closed
+	require.NoError(t, conn.Close())
+	require.Equal(t, "all", <-client.unsubscribed)
+}
@@ -1,8 +1,10 @@
 package rpc
 
 import (
+	gocontext "context"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -11,6 +13,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/rpc/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/cosmos/cosmos-sdk/version"
@@ -91,9 +94,25 @@ func NodeInfoRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
-// SyncingResponse defines a response type that contains node syncing information.
+// SyncingResponse defines a response type that contains node syncing information:
+// whether the node is catching up, and its latest and earliest retained blocks, e.g.
+// for load balancers and wallets to select the nodes serving the heights they query.
 type SyncingResponse struct {
-	Syncing bool `json:"syncing"`
+	Syncing             bool      `json:"syncing"`
+	LatestBlockHeight   int64     `json:"latest_block_height"`
+	LatestBlockTime     time.Time `json:"latest_block_time"`
+	EarliestBlockHeight int64     `json:"earliest_block_height"`
+	EarliestBlockTime   time.Time `json:"earliest_block_time"`
+}
+
+func newSyncingResponse(syncInfo ctypes.SyncInfo) SyncingResponse {
+	return SyncingResponse{
+		Syncing:             syncInfo.CatchingUp,
+		LatestBlockHeight:   syncInfo.LatestBlockHeight,
+		LatestBlockTime:     syncInfo.LatestBlockTime,
+		EarliestBlockHeight: syncInfo.EarliestBlockHeight,
+		EarliestBlockTime:   syncInfo.EarliestBlockTime,
+	}
 }
 
 // REST handler for node syncing
@@ -104,6 +123,75 @@ func NodeSyncingRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		rest.PostProcessResponseBare(w, cliCtx, SyncingResponse{Syncing: status.SyncInfo.CatchingUp})
+		rest.PostProcessResponseBare(w, cliCtx, newSyncingResponse(status.SyncInfo))
 	}
 }
+
+// nodeService implements the gRPC service of the node status from the status of
+// the node of the context, as the node info and syncing REST handlers.
+type nodeService struct {
+	cliCtx context.CLIContext
+}
+
+var _ types.ServiceServer = nodeService{}
+
+// NewNodeService returns the gRPC service of the status of the node of the context,
+// to be registered with a gRPC server by RegisterServiceServer.
+func NewNodeService(cliCtx context.CLIContext) types.ServiceServer {
+	return nodeService{cliCtx: cliCtx}
+}
+
+// GetNodeInfo implements the Service/GetNodeInfo gRPC method.
+func (s nodeService) GetNodeInfo(gocontext.Context, *types.GetNodeInfoRequest) (*types.GetNodeInfoResponse, error) {
+	status, err := getNodeStatus(s.cliCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeInfo := status.NodeInfo
+	appVersion := version.NewInfo()
+
+	return &types.GetNodeInfoResponse{
+		NodeInfo: types.NodeInfo{
+			Id:           string(nodeInfo.ID()),
+			ListenAddr:   nodeInfo.ListenAddr,
+			Network:      nodeInfo.Network,
+			Version:      nodeInfo.Version,
+			Moniker:      nodeInfo.Moniker,
+			P2PVersion:   uint64(nodeInfo.ProtocolVersion.P2P),
+			BlockVersion: uint64(nodeInfo.ProtocolVersion.Block),
+			AppVersion:   uint64(nodeInfo.ProtocolVersion.App),
+			TxIndex:      nodeInfo.Other.TxIndex,
+			RpcAddress:   nodeInfo.Other.RPCAddress,
+		},
+		ApplicationVersion: types.VersionInfo{
+			Name:             appVersion.Name,
+			ServerName:       appVersion.ServerName,
+			ClientName:       appVersion.ClientName,
+			Version:          appVersion.Version,
+			GitCommit:        appVersion.GitCommit,
+			BuildTags:        appVersion.BuildTags,
+			GoVersion:        appVersion.GoVersion,
+			CosmosSdkVersion: appVersion.CosmosSdkVersion,
+			BuildDeps:        appVersion.BuildDeps,
+		},
+	}, nil
+}
+
+// GetSyncing implements the Service/GetSyncing gRPC method.
+func (s nodeService) GetSyncing(gocontext.Context, *types.GetSyncingRequest) (*types.GetSyncingResponse, error) {
+	status, err := getNodeStatus(s.cliCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := newSyncingResponse(status.SyncInfo)
+
+	return &types.GetSyncingResponse{
+		Syncing:             res.Syncing,
+		LatestBlockHeight:   res.LatestBlockHeight,
+		LatestBlockTime:     res.LatestBlockTime,
+		EarliestBlockHeight: res.EarliestBlockHeight,
+		EarliestBlockTime:   res.EarliestBlockTime,
+	}, nil
+}
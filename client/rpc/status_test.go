@@ -0,0 +1,118 @@
+package rpc_test
+
+import (
+	gocontext "context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/rpc/client/mock"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/rpc"
+	"github.com/cosmos/cosmos-sdk/client/rpc/types"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// mockStatusClient returns the given status.
+type mockStatusClient struct {
+	mock.Client
+	status *ctypes.ResultStatus
+}
+
+func (c mockStatusClient) Status() (*ctypes.ResultStatus, error) {
+	return c.status, nil
+}
+
+func newMockStatusClient() mockStatusClient {
+	return mockStatusClient{status: &ctypes.ResultStatus{
+		NodeInfo: p2p.DefaultNodeInfo{
+			ProtocolVersion: p2p.NewProtocolVersion(7, 10, 0),
+			DefaultNodeID:   "0123456789abcdef0123456789abcdef01234567",
+			ListenAddr:      "tcp://0.0.0.0:26656",
+			Network:         "test-chain",
+			Version:         "0.33.4",
+			Moniker:         "node",
+			Other:           p2p.DefaultNodeInfoOther{TxIndex: "on", RPCAddress: "tcp://0.0.0.0:26657"},
+		},
+		SyncInfo: ctypes.SyncInfo{
+			LatestBlockHeight:   100,
+			LatestBlockTime:     time.Unix(1000, 0).UTC(),
+			EarliestBlockHeight: 20,
+			EarliestBlockTime:   time.Unix(200, 0).UTC(),
+			CatchingUp:          true,
+		},
+	}}
+}
+
+func TestNodeSyncingRequestHandlerFn(t *testing.T) {
+	cdc := makeCodec()
+	cliCtx := context.CLIContext{}.WithCodec(cdc).WithClient(newMockStatusClient())
+
+	rec := httptest.NewRecorder()
+	rpc.NodeSyncingRequestHandlerFn(cliCtx)(rec, httptest.NewRequest(http.MethodGet, "/syncing", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var res rpc.SyncingResponse
+	require.NoError(t, cdc.UnmarshalJSON(rec.Body.Bytes(), &res))
+	require.Equal(t, rpc.SyncingResponse{
+		Syncing:             true,
+		LatestBlockHeight:   100,
+		LatestBlockTime:     time.Unix(1000, 0).UTC(),
+		EarliestBlockHeight: 20,
+		EarliestBlockTime:   time.Unix(200, 0).UTC(),
+	}, res)
+}
+
+func TestNodeService(t *testing.T) {
+	cliCtx := context.CLIContext{}.WithClient(newMockStatusClient())
+
+	server := grpc.NewServer()
+	types.RegisterServiceServer(server, rpc.NewNodeService(cliCtx))
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go server.Serve(listener)
+	defer server.Stop()
+
+	conn, err := grpc.Dial(listener.Addr().String(), grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	client := types.NewServiceClient(conn)
+
+	nodeInfo, err := client.GetNodeInfo(gocontext.Background(), &types.GetNodeInfoRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.NodeInfo{
+		Id:           "0123456789abcdef0123456789abcdef01234567",
+		ListenAddr:   "tcp://0.0.0.0:26656",
+		Network:      "test-chain",
+		Version:      "0.33.4",
+		Moniker:      "node",
+		P2PVersion:   7,
+		BlockVersion: 10,
+		TxIndex:      "on",
+		RpcAddress:   "tcp://0.0.0.0:26657",
+	}, nodeInfo.NodeInfo)
+
+	appVersion := version.NewInfo()
+	require.Equal(t, appVersion.GoVersion, nodeInfo.ApplicationVersion.GoVersion)
+	require.Equal(t, appVersion.CosmosSdkVersion, nodeInfo.ApplicationVersion.CosmosSdkVersion)
+	require.Equal(t, appVersion.BuildDeps, nodeInfo.ApplicationVersion.BuildDeps)
+
+	syncing, err := client.GetSyncing(gocontext.Background(), &types.GetSyncingRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.GetSyncingResponse{
+		Syncing:             true,
+		LatestBlockHeight:   100,
+		LatestBlockTime:     time.Unix(1000, 0).UTC(),
+		EarliestBlockHeight: 20,
+		EarliestBlockTime:   time.Unix(200, 0).UTC(),
+	}, syncing)
+}
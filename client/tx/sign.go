@@ -0,0 +1,217 @@
+package tx
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SignMode defines the mode a signer used to derive the bytes it signed over.
+type SignMode int
+
+const (
+	// SignModeUnspecified is the zero value and is never a valid sign mode.
+	SignModeUnspecified SignMode = iota
+
+	// SignModeDirect signs over the canonical JSON encoding of the transaction's
+	// SignDoc, as returned by ClientTx.CanonicalSignBytes.
+	SignModeDirect
+
+	// SignModeLegacyAminoJSON signs over the legacy amino JSON encoding of the
+	// transaction, the bytes an equivalent StdTx is signed over, as returned by
+	// ClientTx.LegacyAminoJSONSignBytes.
+	SignModeLegacyAminoJSON
+)
+
+// String implements the Stringer interface.
+func (m SignMode) String() string {
+	switch m {
+	case SignModeDirect:
+		return "direct"
+
+	case SignModeLegacyAminoJSON:
+		return "amino-json"
+
+	default:
+		return "unspecified"
+	}
+}
+
+type (
+	// SignerData defines the chain and account specific data a signer commits to
+	// in addition to the transaction contents.
+	SignerData struct {
+		ChainID       string
+		AccountNumber uint64
+		Sequence      uint64
+	}
+
+	// SignatureV2 defines a signature produced out-of-band by a single signer of
+	// a transaction, along with the public key it was produced with. The data
+	// of the signature of a multisig public key holds the signatures of its
+	// members. Signatures collected from several signers are assembled into the
+	// transaction with AssembleTx.
+	//
+	// Signer is the address of the signer, which defaults to the address of the
+	// public key when empty. It must be set for an account whose public key was
+	// changed with a MsgChangePubKey, as its address is no longer the one of
+	// its public key.
+	SignatureV2 struct {
+		PubKey crypto.PubKey
+		Data   SignatureData
+		Signer sdk.AccAddress
+	}
+)
+
+// NewSignerData returns the SignerData defined by the Factory's chain ID,
+// account number and sequence.
+func NewSignerData(txf Factory) SignerData {
+	return SignerData{
+		ChainID:       txf.chainID,
+		AccountNumber: txf.accountNumber,
+		Sequence:      txf.sequence,
+	}
+}
+
+// GetSignBytes returns the bytes a signer must sign over for the given
+// transaction, sign mode and signer data.
+func GetSignBytes(mode SignMode, data SignerData, tx ClientTx) ([]byte, error) {
+	switch mode {
+	case SignModeDirect:
+		return tx.CanonicalSignBytes(data.ChainID, data.AccountNumber, data.Sequence)
+
+	case SignModeLegacyAminoJSON:
+		return tx.LegacyAminoJSONSignBytes(data.ChainID, data.AccountNumber, data.Sequence)
+
+	default:
+		return nil, fmt.Errorf("unsupported sign mode: %s", mode)
+	}
+}
+
+// SignWithKeybase signs the given transaction with the Factory's keybase key of
+// the given name and returns the resulting signature. The transaction's existing
+// signatures are left untouched, so signers can each sign the same unsigned
+// transaction independently.
+func SignWithKeybase(txf Factory, name string, mode SignMode, data SignerData, tx ClientTx) (SignatureV2, error) {
+	if txf.keybase == nil {
+		return SignatureV2{}, fmt.Errorf("keybase must be set prior to signing a transaction")
+	}
+
+	signBytes, err := GetSignBytes(mode, data, tx)
+	if err != nil {
+		return SignatureV2{}, err
+	}
+
+	sigBytes, pubKey, err := txf.keybase.Sign(name, signBytes)
+	if err != nil {
+		return SignatureV2{}, err
+	}
+
+	return SignatureV2{PubKey: pubKey, Data: &SingleSignatureData{SignMode: mode, Signature: sigBytes}}, nil
+}
+
+// SignWithPrivKey signs the given transaction with the provided private key and
+// returns the resulting signature. The transaction's existing signatures are left
+// untouched.
+func SignWithPrivKey(privKey crypto.PrivKey, mode SignMode, data SignerData, tx ClientTx) (SignatureV2, error) {
+	signBytes, err := GetSignBytes(mode, data, tx)
+	if err != nil {
+		return SignatureV2{}, err
+	}
+
+	sigBytes, err := privKey.Sign(signBytes)
+	if err != nil {
+		return SignatureV2{}, err
+	}
+
+	return SignatureV2{PubKey: privKey.PubKey(), Data: &SingleSignatureData{SignMode: mode, Signature: sigBytes}}, nil
+}
+
+// VerifySignature verifies that the given signature is valid over the given
+// transaction and signer data. The signatures of the members of a multisig
+// are each verified over the bytes of their own sign mode.
+func VerifySignature(sig SignatureV2, data SignerData, tx ClientTx) error {
+	if sig.PubKey == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "signature has no public key")
+	}
+
+	return VerifySignatureData(sig.PubKey, sig.Data, func(mode SignMode) ([]byte, error) {
+		return GetSignBytes(mode, data, tx)
+	})
+}
+
+// AssembleTx sets the given signatures on the transaction. Signatures may be
+// provided in any order and are matched to the transaction's signers by their
+// Signer address, or the address of their public key if it is not set. Exactly
+// one signature must be provided for each signer. AssembleTx does not verify the signatures; use VerifySignature for
+// that.
+func AssembleTx(txf Factory, tx ClientTx, sigs ...SignatureV2) error {
+	signers := tx.GetSigners()
+	if len(sigs) != len(signers) {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrUnauthorized, "wrong number of signatures; expected %d, got %d", len(signers), len(sigs),
+		)
+	}
+
+	clientSigs := make([]ClientSignature, len(signers))
+	for _, sig := range sigs {
+		if sig.PubKey == nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "signature has no public key")
+		}
+
+		signer := sig.Signer
+		if signer.Empty() {
+			signer = sdk.AccAddress(sig.PubKey.Address())
+		}
+
+		i := signerIndex(signers, signer)
+		if i < 0 {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "%s is not a signer of the transaction", signer)
+		}
+		if clientSigs[i] != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "duplicate signature for %s", signers[i])
+		}
+
+		sigBytes, err := SignatureDataToBytes(sig.Data)
+		if err != nil {
+			return err
+		}
+
+		clientSig := txf.txGenerator.NewSignature()
+		clientSig.SetSignature(sigBytes)
+
+		if err := clientSig.SetPubKey(sig.PubKey); err != nil {
+			return err
+		}
+
+		clientSigs[i] = clientSig
+	}
+
+	return tx.SetSignatures(clientSigs...)
+}
+
+// BroadcastSignedTx encodes an assembled transaction and broadcasts it to a
+// Tendermint node.
+func BroadcastSignedTx(ctx context.CLIContext, tx ClientTx) (sdk.TxResponse, error) {
+	txBytes, err := tx.Marshal()
+	if err != nil {
+		return sdk.TxResponse{}, err
+	}
+
+	return ctx.BroadcastTx(txBytes)
+}
+
+func signerIndex(signers []sdk.AccAddress, addr sdk.AccAddress) int {
+	for i, signer := range signers {
+		if bytes.Equal(signer, addr) {
+			return i
+		}
+	}
+
+	return -1
+}
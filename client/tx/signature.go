@@ -0,0 +1,184 @@
+package tx
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/multisig"
+	"github.com/tendermint/tendermint/crypto/multisig/bitarray"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+type (
+	// SignatureData defines the data of a signature, either a single signature
+	// or the signatures of the members of a multisig public key.
+	SignatureData interface {
+		isSignatureData()
+	}
+
+	// SingleSignatureData defines a signature produced by a single key, along
+	// with the sign mode it was produced with.
+	SingleSignatureData struct {
+		SignMode  SignMode
+		Signature []byte
+	}
+
+	// MultiSignatureData defines the signatures of the members of a multisig
+	// public key. The bit array has a bit for each member, set if the member
+	// signed, and the signatures are sorted by the index of their member. Each
+	// member may itself be a multisig, or sign with its own sign mode.
+	MultiSignatureData struct {
+		BitArray   *bitarray.CompactBitArray
+		Signatures []SignatureData
+	}
+)
+
+func (*SingleSignatureData) isSignatureData() {}
+func (*MultiSignatureData) isSignatureData()  {}
+
+// NewMultiSignatureData returns an empty MultiSignatureData for a multisig
+// public key of n members.
+func NewMultiSignatureData(n int) *MultiSignatureData {
+	return &MultiSignatureData{
+		BitArray:   bitarray.NewCompactBitArray(n),
+		Signatures: make([]SignatureData, 0, n),
+	}
+}
+
+// AddSignature adds the signature of the member at the given index, replacing
+// the member's existing signature if any.
+func (m *MultiSignatureData) AddSignature(data SignatureData, index int) error {
+	if index < 0 || index >= m.BitArray.Size() {
+		return fmt.Errorf("member index %d out of range [0, %d)", index, m.BitArray.Size())
+	}
+
+	sigIndex := m.BitArray.NumTrueBitsBefore(index)
+	if m.BitArray.GetIndex(index) {
+		m.Signatures[sigIndex] = data
+		return nil
+	}
+
+	m.BitArray.SetIndex(index, true)
+	m.Signatures = append(m.Signatures, nil)
+	copy(m.Signatures[sigIndex+1:], m.Signatures[sigIndex:])
+	m.Signatures[sigIndex] = data
+
+	return nil
+}
+
+// AddSignatureV2 adds the signature of the member of the multisig public key
+// matching the signature's public key, the members of the multisig being given
+// by keys.
+func (m *MultiSignatureData) AddSignatureV2(sig SignatureV2, keys []crypto.PubKey) error {
+	if sig.PubKey == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "signature has no public key")
+	}
+
+	for i, key := range keys {
+		if key.Equals(sig.PubKey) {
+			return m.AddSignature(sig.Data, i)
+		}
+	}
+
+	return sdkerrors.Wrapf(
+		sdkerrors.ErrInvalidPubKey, "%s is not a member of the multisig", sdk.AccAddress(sig.PubKey.Address()),
+	)
+}
+
+// SignatureDataToBytes returns the signature bytes set on a transaction for the
+// given signature data. The signature of a multisig is the amino encoding of
+// the multisig.Multisignature of its members' signature bytes.
+func SignatureDataToBytes(data SignatureData) ([]byte, error) {
+	switch data := data.(type) {
+	case *SingleSignatureData:
+		return data.Signature, nil
+
+	case *MultiSignatureData:
+		sigs := make([][]byte, len(data.Signatures))
+		for i, sig := range data.Signatures {
+			bz, err := SignatureDataToBytes(sig)
+			if err != nil {
+				return nil, err
+			}
+
+			sigs[i] = bz
+		}
+
+		multisignature := multisig.Multisignature{BitArray: data.BitArray, Sigs: sigs}
+		return multisignature.Marshal(), nil
+
+	default:
+		return nil, fmt.Errorf("unexpected signature data type %T", data)
+	}
+}
+
+// VerifySignatureData verifies the signature data against the public key, the
+// bytes signed by each key being returned by getSignBytes for the key's sign
+// mode. The signatures of multisig public keys are verified recursively.
+func VerifySignatureData(
+	pubKey crypto.PubKey, data SignatureData, getSignBytes func(SignMode) ([]byte, error),
+) error {
+
+	switch data := data.(type) {
+	case *SingleSignatureData:
+		signBytes, err := getSignBytes(data.SignMode)
+		if err != nil {
+			return err
+		}
+
+		if !pubKey.VerifyBytes(signBytes, data.Signature) {
+			return sdkerrors.Wrapf(
+				sdkerrors.ErrUnauthorized, "signature verification failed for %s", sdk.AccAddress(pubKey.Address()),
+			)
+		}
+
+		return nil
+
+	case *MultiSignatureData:
+		multiPK, ok := pubKey.(multisig.PubKeyMultisigThreshold)
+		if !ok {
+			return sdkerrors.Wrapf(
+				sdkerrors.ErrInvalidPubKey, "expected a multisig public key for a multisignature, got %T", pubKey,
+			)
+		}
+
+		size := data.BitArray.Size()
+		if size != len(multiPK.PubKeys) {
+			return sdkerrors.Wrapf(
+				sdkerrors.ErrUnauthorized, "bit array size is %d but the multisig has %d members", size, len(multiPK.PubKeys),
+			)
+		}
+
+		signers := data.BitArray.NumTrueBitsBefore(size)
+		if signers != len(data.Signatures) {
+			return sdkerrors.Wrapf(
+				sdkerrors.ErrUnauthorized, "bit array has %d signers but got %d signatures", signers, len(data.Signatures),
+			)
+		}
+		if signers < int(multiPK.K) {
+			return sdkerrors.Wrapf(
+				sdkerrors.ErrUnauthorized, "not enough signatures; expected %d, got %d", multiPK.K, signers,
+			)
+		}
+
+		sigIndex := 0
+		for i := 0; i < size; i++ {
+			if !data.BitArray.GetIndex(i) {
+				continue
+			}
+
+			if err := VerifySignatureData(multiPK.PubKeys[i], data.Signatures[sigIndex], getSignBytes); err != nil {
+				return err
+			}
+
+			sigIndex++
+		}
+
+		return nil
+
+	default:
+		return fmt.Errorf("unexpected signature data type %T", data)
+	}
+}
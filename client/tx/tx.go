@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/gogo/protobuf/jsonpb"
+	"github.com/spf13/viper"
 	"github.com/tendermint/tendermint/crypto"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -52,6 +53,7 @@ type (
 		codec.ProtoMarshaler
 
 		SetMsgs(...sdk.Msg) error
+		GetSigners() []sdk.AccAddress
 		GetSignatures() []sdk.Signature
 		SetSignatures(...ClientSignature) error
 		GetFee() sdk.Fee
@@ -64,17 +66,49 @@ type (
 		// ensures all field names adhere to their proto definition, default values
 		// are omitted, and follows the JSON Canonical Form.
 		CanonicalSignBytes(cid string, num, seq uint64) ([]byte, error)
+
+		// LegacyAminoJSONSignBytes returns the legacy amino JSON bytes to sign
+		// over, given a chain ID, along with an account and sequence number. They
+		// are the bytes an equivalent StdTx is signed over.
+		LegacyAminoJSONSignBytes(cid string, num, seq uint64) ([]byte, error)
 	}
 )
 
-// GenerateOrBroadcastTx will either generate and print and unsigned transaction
-// or sign it and broadcast it returning an error upon failure.
+// GenerateOrBroadcastTx will either generate and print and unsigned transaction,
+// sign it offline and print it, or sign it and broadcast it returning an error
+// upon failure.
 func GenerateOrBroadcastTx(ctx context.CLIContext, txf Factory, msgs ...sdk.Msg) error {
-	if ctx.GenerateOnly {
+	switch {
+	case ctx.GenerateOnly:
 		return GenerateTx(ctx, txf, msgs...)
+
+	case ctx.Offline:
+		return SignTxOffline(ctx, txf, msgs...)
+
+	default:
+		return BroadcastTx(ctx, txf, msgs...)
+	}
+}
+
+// SignTxOffline will generate a transaction, sign it with the key of the
+// context and print it to the writer specified by ctx.Output, without any
+// query: the account and sequence numbers are the ones of the Factory, and its
+// gas can't be simulated.
+func SignTxOffline(ctx context.CLIContext, txf Factory, msgs ...sdk.Msg) error {
+	if txf.SimulateAndExecute() || ctx.Simulate {
+		return errors.New("cannot estimate gas in offline mode")
+	}
+
+	tx, err := BuildUnsignedTx(txf, msgs...)
+	if err != nil {
+		return err
+	}
+
+	if _, err := Sign(txf, ctx.GetFromName(), clientkeys.DefaultKeyPass, tx); err != nil {
+		return err
 	}
 
-	return BroadcastTx(ctx, txf, msgs...)
+	return ctx.Println(tx)
 }
 
 // GenerateTx will generate an unsigned transaction and print it to the writer
@@ -120,7 +154,14 @@ func BroadcastTx(ctx context.CLIContext, txf Factory, msgs ...sdk.Msg) error {
 		}
 
 		txf = txf.WithGas(adjusted)
-		_, _ = fmt.Fprintf(os.Stderr, "%s\n", GasEstimateResponse{GasEstimate: txf.Gas()})
+		gasEst := GasEstimateResponse{GasEstimate: txf.Gas()}
+
+		// the estimate of a dry run is its output, a JSON object in the JSON output mode
+		if ctx.Simulate && ctx.OutputFormat == "json" {
+			return ctx.Println(gasEst)
+		}
+
+		_, _ = fmt.Fprintf(os.Stderr, "%s\n", gasEst)
 	}
 
 	if ctx.Simulate {
@@ -160,6 +201,16 @@ func BroadcastTx(ctx context.CLIContext, txf Factory, msgs ...sdk.Msg) error {
 		return err
 	}
 
+	// the block mode already waited for the tx, and a rejected tx is never committed
+	if viper.GetBool(flags.FlagWait) && ctx.BroadcastMode != flags.BroadcastBlock && res.Code == 0 {
+		resTx, err := WaitTx(ctx, res.TxHash, viper.GetDuration(flags.FlagWaitTimeout))
+		if err != nil {
+			return err
+		}
+
+		res = sdk.NewResponseResultTx(resTx, nil, "")
+	}
+
 	return ctx.Println(res)
 }
 
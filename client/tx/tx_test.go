@@ -5,11 +5,15 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/multisig"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
 
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/std"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 )
 
@@ -104,3 +108,157 @@ func TestBuildUnsignedTx(t *testing.T) {
 	require.NotNil(t, tx)
 	require.Equal(t, []sdk.Signature{}, tx.GetSignatures())
 }
+
+func TestMultiSignerTx(t *testing.T) {
+	priv1, priv2 := secp256k1.GenPrivKey(), secp256k1.GenPrivKey()
+	addr1, addr2 := sdk.AccAddress(priv1.PubKey().Address()), sdk.AccAddress(priv2.PubKey().Address())
+
+	txf := tx.Factory{}.
+		WithTxGenerator(std.TxGenerator{}).
+		WithFees("50stake").
+		WithChainID("test-chain")
+
+	msg1 := bank.NewMsgSend(addr1, sdk.AccAddress("to"), sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	msg2 := bank.NewMsgSend(addr2, sdk.AccAddress("to"), sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+
+	unsignedTx, err := tx.BuildUnsignedTx(txf, msg1, msg2)
+	require.NoError(t, err)
+
+	data1 := tx.NewSignerData(txf.WithAccountNumber(1).WithSequence(3))
+	data2 := tx.NewSignerData(txf.WithAccountNumber(2).WithSequence(7))
+
+	sig1, err := tx.SignWithPrivKey(priv1, tx.SignModeDirect, data1, unsignedTx)
+	require.NoError(t, err)
+	sig2, err := tx.SignWithPrivKey(priv2, tx.SignModeLegacyAminoJSON, data2, unsignedTx)
+	require.NoError(t, err)
+
+	// the legacy amino JSON sign bytes are the ones of the equivalent StdTx
+	stdSignBytes := auth.StdSignBytes(
+		"test-chain", 2, 7, auth.NewStdFee(0, sdk.NewCoins(sdk.NewInt64Coin("stake", 50))),
+		[]sdk.Msg{msg1, msg2}, "",
+	)
+	require.True(t, priv2.PubKey().VerifyBytes(stdSignBytes, sig2.Data.(*tx.SingleSignatureData).Signature))
+
+	require.NoError(t, tx.VerifySignature(sig1, data1, unsignedTx))
+	require.NoError(t, tx.VerifySignature(sig2, data2, unsignedTx))
+	require.Error(t, tx.VerifySignature(sig1, data2, unsignedTx))
+
+	_, err = tx.SignWithPrivKey(priv1, tx.SignModeUnspecified, data1, unsignedTx)
+	require.Error(t, err)
+
+	// signatures are matched to signers regardless of the order they're given in
+	require.Error(t, tx.AssembleTx(txf, unsignedTx, sig2))
+	require.Error(t, tx.AssembleTx(txf, unsignedTx, sig2, sig2))
+	require.NoError(t, tx.AssembleTx(txf, unsignedTx, sig2, sig1))
+
+	bz, err := unsignedTx.Marshal()
+	require.NoError(t, err)
+
+	signedTx := &std.Transaction{}
+	require.NoError(t, signedTx.Unmarshal(bz))
+
+	sigs := signedTx.GetSignatures()
+	require.Len(t, sigs, 2)
+	require.Equal(t, priv1.PubKey(), sigs[0].GetPubKey())
+	require.Equal(t, sig1.Data.(*tx.SingleSignatureData).Signature, sigs[0].GetSignature())
+	require.Equal(t, priv2.PubKey(), sigs[1].GetPubKey())
+	require.Equal(t, sig2.Data.(*tx.SingleSignatureData).Signature, sigs[1].GetSignature())
+}
+
+func TestRotatedSignerTx(t *testing.T) {
+	// the account of addr had its public key changed to the one of rotated
+	priv, rotated := secp256k1.GenPrivKey(), secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(priv.PubKey().Address())
+
+	txf := tx.Factory{}.
+		WithTxGenerator(std.TxGenerator{}).
+		WithFees("50stake").
+		WithChainID("test-chain")
+
+	msg := bank.NewMsgSend(addr, sdk.AccAddress("to"), sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	unsignedTx, err := tx.BuildUnsignedTx(txf, msg)
+	require.NoError(t, err)
+
+	data := tx.NewSignerData(txf.WithAccountNumber(1).WithSequence(3))
+	sig, err := tx.SignWithPrivKey(rotated, tx.SignModeDirect, data, unsignedTx)
+	require.NoError(t, err)
+	require.NoError(t, tx.VerifySignature(sig, data, unsignedTx))
+
+	// the address of the rotated public key is not a signer of the transaction
+	require.Error(t, tx.AssembleTx(txf, unsignedTx, sig))
+
+	sig.Signer = sdk.AccAddress("other")
+	require.Error(t, tx.AssembleTx(txf, unsignedTx, sig))
+
+	sig.Signer = addr
+	require.NoError(t, tx.AssembleTx(txf, unsignedTx, sig))
+
+	sigs := unsignedTx.GetSignatures()
+	require.Len(t, sigs, 1)
+	require.Equal(t, rotated.PubKey(), sigs[0].GetPubKey())
+	require.Equal(t, sig.Data.(*tx.SingleSignatureData).Signature, sigs[0].GetSignature())
+}
+
+func TestNestedMultisigTx(t *testing.T) {
+	priv1, priv2, priv3, priv4 := secp256k1.GenPrivKey(), secp256k1.GenPrivKey(), secp256k1.GenPrivKey(), secp256k1.GenPrivKey()
+
+	// a 2 of 3 multisig whose second member is a 2 of 2 multisig
+	nestedPK := multisig.NewPubKeyMultisigThreshold(2, []crypto.PubKey{priv2.PubKey(), priv3.PubKey()})
+	nestedKeys := nestedPK.(multisig.PubKeyMultisigThreshold).PubKeys
+	multisigPK := multisig.NewPubKeyMultisigThreshold(2, []crypto.PubKey{priv1.PubKey(), nestedPK, priv4.PubKey()})
+	multisigKeys := multisigPK.(multisig.PubKeyMultisigThreshold).PubKeys
+	multisigAddr := sdk.AccAddress(multisigPK.Address())
+
+	txf := tx.Factory{}.
+		WithTxGenerator(std.TxGenerator{}).
+		WithFees("50stake").
+		WithChainID("test-chain")
+
+	msg := bank.NewMsgSend(multisigAddr, sdk.AccAddress("to"), sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	unsignedTx, err := tx.BuildUnsignedTx(txf, msg)
+	require.NoError(t, err)
+
+	data := tx.NewSignerData(txf.WithAccountNumber(1).WithSequence(3))
+
+	var sigs [3]tx.SignatureV2
+	for i, priv := range []crypto.PrivKey{priv1, priv2, priv3} {
+		sigs[i], err = tx.SignWithPrivKey(priv, tx.SignModeDirect, data, unsignedTx)
+		require.NoError(t, err)
+	}
+
+	// the signatures may be added in any order
+	nestedData := tx.NewMultiSignatureData(len(nestedKeys))
+	require.NoError(t, nestedData.AddSignatureV2(sigs[2], nestedKeys))
+	require.Error(t, nestedData.AddSignatureV2(sigs[0], nestedKeys))
+
+	multisigData := tx.NewMultiSignatureData(len(multisigKeys))
+	require.NoError(t, multisigData.AddSignatureV2(tx.SignatureV2{PubKey: nestedPK, Data: nestedData}, multisigKeys))
+	require.NoError(t, multisigData.AddSignatureV2(sigs[0], multisigKeys))
+
+	// the nested multisig lacks a signature
+	multisigSig := tx.SignatureV2{PubKey: multisigPK, Data: multisigData}
+	require.Error(t, tx.VerifySignature(multisigSig, data, unsignedTx))
+
+	require.NoError(t, nestedData.AddSignatureV2(sigs[1], nestedKeys))
+	require.NoError(t, tx.VerifySignature(multisigSig, data, unsignedTx))
+	require.Error(t, tx.VerifySignature(multisigSig, tx.NewSignerData(txf.WithAccountNumber(2)), unsignedTx))
+
+	// replacing a signature keeps the bit array in sync
+	require.NoError(t, multisigData.AddSignatureV2(sigs[0], multisigKeys))
+	require.Len(t, multisigData.Signatures, 2)
+	require.Equal(t, nestedData, multisigData.Signatures[1])
+
+	require.NoError(t, tx.AssembleTx(txf, unsignedTx, multisigSig))
+
+	bz, err := unsignedTx.Marshal()
+	require.NoError(t, err)
+
+	signedTx := &std.Transaction{}
+	require.NoError(t, signedTx.Unmarshal(bz))
+
+	// the assembled multisignature is verified by the multisig public key
+	signBytes, err := unsignedTx.CanonicalSignBytes("test-chain", 1, 3)
+	require.NoError(t, err)
+	require.Len(t, signedTx.GetSignatures(), 1)
+	require.True(t, multisigPK.VerifyBytes(signBytes, signedTx.GetSignatures()[0].GetSignature()))
+}
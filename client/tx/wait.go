@@ -0,0 +1,52 @@
+package tx
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+)
+
+// WaitTxPollInterval is the interval at which WaitTx queries the node for the
+// tx it waits for.
+var WaitTxPollInterval = time.Second
+
+// WaitTx waits for the tx of the given hex encoded hash to be committed, and
+// returns its result, without verifying its proof. The node is polled for the
+// tx since it may have been broadcast to another node, or before the call. An
+// error is returned if the tx isn't committed before the timeout, or if the
+// node can't be queried.
+func WaitTx(ctx context.CLIContext, hashHexStr string, timeout time.Duration) (*ctypes.ResultTx, error) {
+	hash, err := hex.DecodeString(hashHexStr)
+	if err != nil {
+		return nil, err
+	}
+
+	node, err := ctx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	deadline := time.Now().Add(timeout)
+	for {
+		resTx, err := node.Tx(hash, false)
+		if err == nil {
+			return resTx, nil
+		}
+
+		// the node doesn't tell apart the txs not committed yet from the unknown txs
+		if !strings.Contains(err.Error(), "not found") {
+			return nil, err
+		}
+
+		if time.Now().Add(WaitTxPollInterval).After(deadline) {
+			return nil, fmt.Errorf("timed out after %s waiting for tx %s to be committed", timeout, hashHexStr)
+		}
+
+		time.Sleep(WaitTxPollInterval)
+	}
+}
@@ -0,0 +1,68 @@
+package tx_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/rpc/client/mock"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+)
+
+// mockTxClient is a client whose tx is committed after the given number of
+// queries for it.
+type mockTxClient struct {
+	mock.Client
+	queries   *int
+	committed int
+	err       error
+}
+
+func (c mockTxClient) Tx(hash []byte, prove bool) (*ctypes.ResultTx, error) {
+	*c.queries++
+
+	if c.err != nil {
+		return nil, c.err
+	}
+	if *c.queries < c.committed {
+		return nil, fmt.Errorf("tx (%X) not found", hash)
+	}
+
+	return &ctypes.ResultTx{Hash: hash, Height: 10}, nil
+}
+
+func TestWaitTx(t *testing.T) {
+	defer func(interval time.Duration) { tx.WaitTxPollInterval = interval }(tx.WaitTxPollInterval)
+	tx.WaitTxPollInterval = time.Millisecond
+
+	hash := "0A0B"
+
+	// the node is polled until the tx is committed
+	var queries int
+	ctx := context.CLIContext{}.WithClient(mockTxClient{queries: &queries, committed: 3})
+	resTx, err := tx.WaitTx(ctx, hash, time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), resTx.Height)
+	require.Equal(t, 3, queries)
+
+	// or until the timeout
+	queries = 0
+	ctx = context.CLIContext{}.WithClient(mockTxClient{queries: &queries, committed: 1000})
+	_, err = tx.WaitTx(ctx, hash, 10*time.Millisecond)
+	require.EqualError(t, err, "timed out after 10ms waiting for tx 0A0B to be committed")
+
+	// and the other errors are returned right away
+	queries = 0
+	ctx = context.CLIContext{}.WithClient(mockTxClient{queries: &queries, err: errors.New("connection refused")})
+	_, err = tx.WaitTx(ctx, hash, time.Minute)
+	require.EqualError(t, err, "connection refused")
+	require.Equal(t, 1, queries)
+
+	_, err = tx.WaitTx(ctx, "not hex", time.Minute)
+	require.Error(t, err)
+}
@@ -8,6 +8,9 @@ import (
 	amino "github.com/tendermint/go-amino"
 	cryptoamino "github.com/tendermint/tendermint/crypto/encoding/amino"
 	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/bls"
+	"github.com/cosmos/cosmos-sdk/crypto/secp256r1"
 )
 
 // Cdc defines a global generic sealed Amino codec to be used throughout sdk. It
@@ -31,9 +34,12 @@ func New() *Codec {
 }
 
 // RegisterCrypto registers all crypto dependency types with the provided Amino
-// codec.
+// codec, including the experimental BLS keys, which apps must still enable in
+// the ante handler.
 func RegisterCrypto(cdc *Codec) {
 	cryptoamino.RegisterAmino(cdc)
+	secp256r1.RegisterAmino(cdc)
+	bls.RegisterAmino(cdc)
 }
 
 // RegisterEvidences registers Tendermint evidence types with the provided Amino
@@ -0,0 +1,272 @@
+// Package bls implements experimental BLS keys, whose signatures of distinct
+// messages can be aggregated into a single signature verified at once.
+//
+// The package is meant for chains prototyping aggregated validator or user
+// signatures: neither its key and signature encodings nor its security are
+// stable, and it must not be used in production. The keys are registered with
+// the codecs, so that they can be decoded, but the ante handler rejects them
+// unless the app opts in with ante.NewBLSSigVerificationGasConsumer.
+//
+// The keys are on the BLS12-381 curve, implemented by github.com/kilic/bls12-381.
+// The signatures are in G1 and the public keys in G2, as in the "minimal
+// signature size" variant of the IETF BLS draft, with its basic scheme: the
+// messages are hashed to G1 by the hash_to_curve suite of the draft, and the
+// aggregated messages must be distinct.
+package bls
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/subtle"
+	"fmt"
+	"io"
+	"math/big"
+
+	bls12381 "github.com/kilic/bls12-381"
+	amino "github.com/tendermint/go-amino"
+	"github.com/tendermint/tendermint/crypto"
+	cryptoamino "github.com/tendermint/tendermint/crypto/encoding/amino"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+const (
+	PrivKeyAminoName = "cosmos-sdk/PrivKeyBLS"
+	PubKeyAminoName  = "cosmos-sdk/PubKeyBLS"
+
+	// PrivKeySize is the size of the private key scalar.
+	PrivKeySize = 32
+	// PubKeySize is the size of a compressed G2 point.
+	PubKeySize = 96
+	// SignatureSize is the size of a compressed G1 point.
+	SignatureSize = 48
+
+	// hashDomain is the domain separation tag of the hashes of the messages to
+	// G1, the one of the basic scheme of the IETF BLS draft.
+	hashDomain = "BLS_SIG_BLS12381G1_XMD:SHA-256_SSWU_RO_NUL_"
+)
+
+var cdc = amino.NewCodec()
+
+func init() {
+	RegisterAmino(cdc)
+
+	// register the keys with the Tendermint crypto codec for them to be decoded
+	// by the PubKeyFromBytes and PrivKeyFromBytes helpers
+	cryptoamino.RegisterKeyType(PubKeyBLS{}, PubKeyAminoName)
+	cryptoamino.RegisterKeyType(PrivKeyBLS{}, PrivKeyAminoName)
+}
+
+// RegisterAmino registers the BLS keys in the given (amino) codec.
+func RegisterAmino(cdc *amino.Codec) {
+	cdc.RegisterConcrete(PubKeyBLS{}, PubKeyAminoName, nil)
+	cdc.RegisterConcrete(PrivKeyBLS{}, PrivKeyAminoName, nil)
+}
+
+//-------------------------------------
+
+var _ crypto.PrivKey = PrivKeyBLS{}
+
+// PrivKeyBLS implements crypto.PrivKey. It is the big-endian encoding of the
+// private key scalar, in [1, r) where r is the order of G1 and G2.
+type PrivKeyBLS [PrivKeySize]byte
+
+// GenPrivKey generates a new BLS private key using OS randomness.
+func GenPrivKey() PrivKeyBLS {
+	return genPrivKey(crypto.CReader())
+}
+
+// genPrivKey generates a new BLS private key using the provided reader.
+func genPrivKey(reader io.Reader) PrivKeyBLS {
+	order := bls12381.NewG1().Q()
+	for {
+		k, err := rand.Int(reader, order)
+		if err != nil {
+			panic(err)
+		}
+
+		if k.Sign() != 0 {
+			// left-pad the scalar to the 32 bytes of the key
+			var privKey PrivKeyBLS
+			bz := k.Bytes()
+			copy(privKey[len(privKey)-len(bz):], bz)
+
+			return privKey
+		}
+	}
+}
+
+// Bytes marshals the private key using amino encoding.
+func (privKey PrivKeyBLS) Bytes() []byte {
+	return cdc.MustMarshalBinaryBare(privKey)
+}
+
+// Sign returns the signature of the message: its hash to G1 multiplied by the
+// private key scalar. BLS signatures are deterministic.
+func (privKey PrivKeyBLS) Sign(msg []byte) ([]byte, error) {
+	g1 := bls12381.NewG1()
+
+	point, err := g1.HashToCurve(msg, []byte(hashDomain))
+	if err != nil {
+		return nil, err
+	}
+
+	return g1.ToCompressed(g1.MulScalarBig(g1.New(), point, privKey.scalar())), nil
+}
+
+// PubKey returns the public key of the private key, the G2 generator multiplied
+// by the private key scalar.
+func (privKey PrivKeyBLS) PubKey() crypto.PubKey {
+	g2 := bls12381.NewG2()
+
+	var pubKey PubKeyBLS
+	copy(pubKey[:], g2.ToCompressed(g2.MulScalarBig(g2.New(), g2.One(), privKey.scalar())))
+
+	return pubKey
+}
+
+// Equals runs in constant time based on the length of the keys.
+func (privKey PrivKeyBLS) Equals(other crypto.PrivKey) bool {
+	if otherBLS, ok := other.(PrivKeyBLS); ok {
+		return subtle.ConstantTimeCompare(privKey[:], otherBLS[:]) == 1
+	}
+
+	return false
+}
+
+func (privKey PrivKeyBLS) scalar() *big.Int {
+	return new(big.Int).SetBytes(privKey[:])
+}
+
+//-------------------------------------
+
+var _ crypto.PubKey = PubKeyBLS{}
+
+// PubKeyBLS implements crypto.PubKey. It is the compressed encoding of a G2
+// point, as in the zcash serialization of BLS12-381.
+type PubKeyBLS [PubKeySize]byte
+
+// Address returns the first 20 bytes of the SHA-256 hash of the public key.
+func (pubKey PubKeyBLS) Address() crypto.Address {
+	return crypto.Address(tmhash.SumTruncated(pubKey[:]))
+}
+
+// Bytes marshals the public key using amino encoding.
+func (pubKey PubKeyBLS) Bytes() []byte {
+	return cdc.MustMarshalBinaryBare(pubKey)
+}
+
+// VerifyBytes verifies the signature of the message, checking that
+// e(sig, g2) = e(H(msg), pubKey).
+func (pubKey PubKeyBLS) VerifyBytes(msg []byte, sig []byte) bool {
+	return VerifyAggregateSignature([]PubKeyBLS{pubKey}, [][]byte{msg}, sig)
+}
+
+func (pubKey PubKeyBLS) String() string {
+	return fmt.Sprintf("PubKeyBLS{%X}", pubKey[:])
+}
+
+// Equals returns true if the other public key is the same BLS key.
+func (pubKey PubKeyBLS) Equals(other crypto.PubKey) bool {
+	if otherBLS, ok := other.(PubKeyBLS); ok {
+		return bytes.Equal(pubKey[:], otherBLS[:])
+	}
+
+	return false
+}
+
+// point returns the G2 point of the public key, or false if it's not a point of
+// the prime order subgroup G2, or if it's the point at infinity.
+func (pubKey PubKeyBLS) point(g2 *bls12381.G2) (*bls12381.PointG2, bool) {
+	// FromCompressed checks that the point is in the subgroup
+	point, err := g2.FromCompressed(pubKey[:])
+	if err != nil || g2.IsZero(point) {
+		return nil, false
+	}
+
+	return point, true
+}
+
+//-------------------------------------
+
+// AggregateSignatures returns the aggregate of the signatures, to be verified
+// with VerifyAggregateSignature. It returns an error if a signature isn't
+// a valid G1 point.
+func AggregateSignatures(sigs [][]byte) ([]byte, error) {
+	if len(sigs) == 0 {
+		return nil, fmt.Errorf("no signatures to aggregate")
+	}
+
+	g1 := bls12381.NewG1()
+	aggSig := g1.Zero()
+	for i, sig := range sigs {
+		point, ok := unmarshalSignature(g1, sig)
+		if !ok {
+			return nil, fmt.Errorf("invalid signature %d", i)
+		}
+
+		g1.Add(aggSig, aggSig, point)
+	}
+
+	return g1.ToCompressed(aggSig), nil
+}
+
+// VerifyAggregateSignature verifies the aggregate of the signatures of the
+// messages by the public keys of the same index, checking that
+// e(aggSig, g2) = ∏ e(H(msg_i), pubKey_i).
+//
+// The messages must be distinct: keys aren't required to come with a proof of
+// possession, so aggregates of signatures of the same message would allow
+// rogue key attacks. It returns false if they aren't.
+func VerifyAggregateSignature(pubKeys []PubKeyBLS, msgs [][]byte, aggSig []byte) bool {
+	if len(pubKeys) == 0 || len(pubKeys) != len(msgs) {
+		return false
+	}
+
+	engine := bls12381.NewEngine()
+
+	sig, ok := unmarshalSignature(engine.G1, aggSig)
+	if !ok {
+		return false
+	}
+
+	// the product of the pairings must be one, the pairing of the signature
+	// being inverted
+	engine.AddPairInv(sig, engine.G2.One())
+
+	seen := make(map[string]bool, len(msgs))
+	for i, pubKey := range pubKeys {
+		if seen[string(msgs[i])] {
+			return false
+		}
+		seen[string(msgs[i])] = true
+
+		point, ok := pubKey.point(engine.G2)
+		if !ok {
+			return false
+		}
+
+		hash, err := engine.G1.HashToCurve(msgs[i], []byte(hashDomain))
+		if err != nil {
+			return false
+		}
+
+		engine.AddPair(hash, point)
+	}
+
+	return engine.Check()
+}
+
+// unmarshalSignature returns the G1 point of the signature, or false if it's
+// not a point of the prime order subgroup, or the point at infinity.
+func unmarshalSignature(g1 *bls12381.G1, sig []byte) (*bls12381.PointG1, bool) {
+	if len(sig) != SignatureSize {
+		return nil, false
+	}
+
+	point, err := g1.FromCompressed(sig)
+	if err != nil || g1.IsZero(point) {
+		return nil, false
+	}
+
+	return point, true
+}
@@ -0,0 +1,120 @@
+package bls_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	cryptoamino "github.com/tendermint/tendermint/crypto/encoding/amino"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/bls"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	priv := bls.GenPrivKey()
+	pub := priv.PubKey()
+	msg := []byte("hello world")
+
+	sig, err := priv.Sign(msg)
+	require.NoError(t, err)
+	require.Len(t, sig, bls.SignatureSize)
+	require.True(t, pub.VerifyBytes(msg, sig))
+
+	// the signatures are deterministic
+	sig2, err := priv.Sign(msg)
+	require.NoError(t, err)
+	require.Equal(t, sig, sig2)
+
+	// the signature doesn't verify another message or key
+	require.False(t, pub.VerifyBytes([]byte("hello"), sig))
+	require.False(t, bls.GenPrivKey().PubKey().VerifyBytes(msg, sig))
+
+	// nor a corrupted signature
+	sig[7] ^= byte(0x01)
+	require.False(t, pub.VerifyBytes(msg, sig))
+	require.False(t, pub.VerifyBytes(msg, sig[:32]))
+	require.False(t, pub.VerifyBytes(msg, make([]byte, bls.SignatureSize)))
+}
+
+func TestVerifyRejectsInvalidPubKeys(t *testing.T) {
+	msg := []byte("hello world")
+
+	// the point at infinity would verify the point at infinity for any message
+	var infinity bls.PubKeyBLS
+	require.False(t, infinity.VerifyBytes(msg, make([]byte, bls.SignatureSize)))
+
+	priv := bls.GenPrivKey()
+	sig, err := priv.Sign(msg)
+	require.NoError(t, err)
+
+	pub := priv.PubKey().(bls.PubKeyBLS)
+	pub[50] ^= byte(0x01)
+	require.False(t, pub.VerifyBytes(msg, sig))
+}
+
+func TestAggregateSignatures(t *testing.T) {
+	privs := []bls.PrivKeyBLS{bls.GenPrivKey(), bls.GenPrivKey(), bls.GenPrivKey()}
+	msgs := [][]byte{[]byte("message 0"), []byte("message 1"), []byte("message 2")}
+
+	pubs := make([]bls.PubKeyBLS, len(privs))
+	sigs := make([][]byte, len(privs))
+	for i, priv := range privs {
+		pubs[i] = priv.PubKey().(bls.PubKeyBLS)
+
+		sig, err := priv.Sign(msgs[i])
+		require.NoError(t, err)
+		sigs[i] = sig
+	}
+
+	aggSig, err := bls.AggregateSignatures(sigs)
+	require.NoError(t, err)
+	require.Len(t, aggSig, bls.SignatureSize)
+	require.True(t, bls.VerifyAggregateSignature(pubs, msgs, aggSig))
+
+	// the keys must sign the messages of the same index
+	require.False(t, bls.VerifyAggregateSignature(pubs, [][]byte{msgs[1], msgs[0], msgs[2]}, aggSig))
+	require.False(t, bls.VerifyAggregateSignature(pubs[:2], msgs[:2], aggSig))
+	require.False(t, bls.VerifyAggregateSignature(pubs, msgs[:2], aggSig))
+	require.False(t, bls.VerifyAggregateSignature(nil, nil, aggSig))
+
+	// and the messages must be distinct
+	sig, err := privs[1].Sign(msgs[0])
+	require.NoError(t, err)
+	aggSig, err = bls.AggregateSignatures([][]byte{sigs[0], sig})
+	require.NoError(t, err)
+	require.False(t, bls.VerifyAggregateSignature(pubs[:2], [][]byte{msgs[0], msgs[0]}, aggSig))
+
+	_, err = bls.AggregateSignatures(nil)
+	require.Error(t, err)
+	_, err = bls.AggregateSignatures([][]byte{sigs[0], sigs[1][:32]})
+	require.Error(t, err)
+}
+
+func TestPubKeyEquals(t *testing.T) {
+	priv := bls.GenPrivKey()
+
+	require.True(t, priv.Equals(priv))
+	require.True(t, priv.PubKey().Equals(priv.PubKey()))
+	require.False(t, priv.Equals(bls.GenPrivKey()))
+	require.False(t, priv.PubKey().Equals(bls.GenPrivKey().PubKey()))
+}
+
+func TestAminoRegistration(t *testing.T) {
+	priv := bls.GenPrivKey()
+	pub := priv.PubKey()
+
+	// the keys are decoded by the Tendermint helpers
+	decodedPub, err := cryptoamino.PubKeyFromBytes(pub.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, pub, decodedPub)
+
+	decodedPriv, err := cryptoamino.PrivKeyFromBytes(priv.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, priv, decodedPriv)
+
+	// and by the SDK codec
+	var pubKey crypto.PubKey
+	require.NoError(t, codec.Cdc.UnmarshalBinaryBare(pub.Bytes(), &pubKey))
+	require.Equal(t, pub, pubKey)
+}
@@ -1,7 +1,6 @@
 package hd
 
 import (
-	"github.com/cosmos/go-bip39"
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 )
@@ -44,7 +43,7 @@ func (s secp256k1Algo) Name() PubKeyType {
 // Derive derives and returns the secp256k1 private key for the given seed and HD path.
 func (s secp256k1Algo) Derive() DeriveFn {
 	return func(mnemonic string, bip39Passphrase, hdPath string) ([]byte, error) {
-		seed, err := bip39.NewSeedWithErrorChecking(mnemonic, bip39Passphrase)
+		seed, err := NewSeedFromMnemonic(mnemonic, bip39Passphrase)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,132 @@
+package hd
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/cosmos/go-bip39"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	// English is the language of the English BIP39 word list, the only one
+	// registered by default.
+	English = "english"
+
+	// wordListSize is the number of words of a BIP39 word list.
+	wordListSize = 2048
+	// wordBits is the number of bits encoded by each word of a mnemonic.
+	wordBits = 11
+)
+
+var (
+	// ErrInvalidMnemonic is returned for mnemonics whose words aren't of a
+	// registered word list, or whose checksum is invalid. Its message is the
+	// one of the errors of the bip39 package.
+	ErrInvalidMnemonic = errors.New("Invalid mnemonic") // nolint: golint, stylecheck
+
+	wordListsMtx sync.RWMutex
+	// wordLists maps the languages of the registered word lists to the index
+	// of each of their words.
+	wordLists = map[string]map[string]int{}
+)
+
+func init() {
+	RegisterWordList(English, bip39.EnglishWordList)
+}
+
+// RegisterWordList registers the BIP39 word list of a language, so that keys
+// can be derived from the mnemonics of its words. Only the English word list is
+// bundled; applications may register the other word lists of the BIP39 spec.
+// It panics if the word list doesn't have 2048 distinct words or if a word list
+// is already registered for the language.
+func RegisterWordList(language string, words []string) {
+	if len(words) != wordListSize {
+		panic(fmt.Sprintf("the %s word list has %d words instead of %d", language, len(words), wordListSize))
+	}
+
+	index := make(map[string]int, wordListSize)
+	for i, word := range words {
+		index[norm.NFKD.String(word)] = i
+	}
+
+	if len(index) != wordListSize {
+		panic(fmt.Sprintf("the %s word list has duplicate words", language))
+	}
+
+	wordListsMtx.Lock()
+	defer wordListsMtx.Unlock()
+
+	if _, ok := wordLists[language]; ok {
+		panic(fmt.Sprintf("a word list is already registered for %s", language))
+	}
+
+	wordLists[language] = index
+}
+
+// MnemonicLanguage returns the language of the registered word list the words
+// of the mnemonic are from. It returns ErrInvalidMnemonic if there is none, or
+// if the checksum of the mnemonic is invalid.
+func MnemonicLanguage(mnemonic string) (string, error) {
+	words := strings.Fields(norm.NFKD.String(mnemonic))
+
+	wordListsMtx.RLock()
+	defer wordListsMtx.RUnlock()
+
+	for language, index := range wordLists {
+		if validMnemonic(words, index) {
+			return language, nil
+		}
+	}
+
+	return "", ErrInvalidMnemonic
+}
+
+// NewSeedFromMnemonic returns the BIP39 seed of the mnemonic and passphrase. It
+// returns an error if the mnemonic is invalid in every registered language.
+// Following the BIP39 spec, the mnemonic is NFKD normalized, which leaves the
+// English mnemonics unchanged.
+func NewSeedFromMnemonic(mnemonic, bip39Passphrase string) ([]byte, error) {
+	if _, err := MnemonicLanguage(mnemonic); err != nil {
+		return nil, err
+	}
+
+	return bip39.NewSeed(norm.NFKD.String(mnemonic), bip39Passphrase), nil
+}
+
+// validMnemonic returns whether the words are from the word list and encode
+// entropy followed by its valid checksum.
+func validMnemonic(words []string, index map[string]int) bool {
+	// the mnemonics encode 128 to 256 bits of entropy, by steps of 32 bits
+	if len(words) < 12 || len(words) > 24 || len(words)%3 != 0 {
+		return false
+	}
+
+	bits := new(big.Int)
+	for _, word := range words {
+		i, ok := index[word]
+		if !ok {
+			return false
+		}
+
+		bits.Lsh(bits, wordBits)
+		bits.Or(bits, big.NewInt(int64(i)))
+	}
+
+	// the checksum is the first bit of the entropy hash for each 32 bits of entropy
+	checksumBits := uint(len(words) * wordBits / 33)
+	entropySize := (uint(len(words)*wordBits) - checksumBits) / 8
+
+	checksum := new(big.Int).And(bits, big.NewInt(1<<checksumBits-1)).Uint64()
+	// left-pad the entropy, whose leading zero bytes are dropped by Bytes
+	entropy := make([]byte, entropySize)
+	bz := new(big.Int).Rsh(bits, checksumBits).Bytes()
+	copy(entropy[len(entropy)-len(bz):], bz)
+
+	hash := sha256.Sum256(entropy)
+	return uint64(hash[0]>>(8-checksumBits)) == checksum
+}
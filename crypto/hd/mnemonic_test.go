@@ -0,0 +1,110 @@
+package hd_test
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	bip39 "github.com/cosmos/go-bip39"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/tests"
+)
+
+// testWordList returns a word list of accented words, given in the NFC form.
+func testWordList() []string {
+	words := make([]string, 2048)
+	for i := range words {
+		words[i] = fmt.Sprintf("moté%d", i)
+	}
+
+	return words
+}
+
+// encodeMnemonic encodes the entropy as a mnemonic of the words.
+func encodeMnemonic(entropy []byte, words []string) string {
+	hash := sha256.Sum256(entropy)
+	checksumBits := uint(len(entropy) / 4)
+
+	bits := new(big.Int).SetBytes(entropy)
+	bits.Lsh(bits, checksumBits)
+	bits.Or(bits, big.NewInt(int64(hash[0]>>(8-checksumBits))))
+
+	n := (len(entropy)*8 + int(checksumBits)) / 11
+	mnemonic := make([]string, n)
+	for i := n - 1; i >= 0; i-- {
+		mnemonic[i] = words[new(big.Int).And(bits, big.NewInt(2047)).Int64()]
+		bits.Rsh(bits, 11)
+	}
+
+	return strings.Join(mnemonic, " ")
+}
+
+func TestMnemonicLanguage(t *testing.T) {
+	language, err := hd.MnemonicLanguage(tests.TestMnemonic)
+	require.NoError(t, err)
+	require.Equal(t, hd.English, language)
+
+	for bitSize := 128; bitSize <= 256; bitSize += 32 {
+		entropy, err := bip39.NewEntropy(bitSize)
+		require.NoError(t, err)
+
+		mnemonic, err := bip39.NewMnemonic(entropy)
+		require.NoError(t, err)
+		require.Equal(t, mnemonic, encodeMnemonic(entropy, bip39.EnglishWordList))
+
+		language, err := hd.MnemonicLanguage(mnemonic)
+		require.NoError(t, err)
+		require.Equal(t, hd.English, language)
+	}
+
+	// the checksum is verified
+	_, err = hd.MnemonicLanguage("malarkey pair crucial catch public canyon evil outer stage ten gym tornado")
+	require.Equal(t, hd.ErrInvalidMnemonic, err)
+
+	_, err = hd.MnemonicLanguage("")
+	require.Equal(t, hd.ErrInvalidMnemonic, err)
+	_, err = hd.MnemonicLanguage(strings.Join(strings.Fields(tests.TestMnemonic)[:11], " "))
+	require.Equal(t, hd.ErrInvalidMnemonic, err)
+}
+
+func TestRegisterWordList(t *testing.T) {
+	words := testWordList()
+	mnemonic := encodeMnemonic([]byte("0123456789abcdef0123456789abcdef"), words)
+
+	_, err := hd.MnemonicLanguage(mnemonic)
+	require.Equal(t, hd.ErrInvalidMnemonic, err)
+
+	hd.RegisterWordList("test", words)
+	require.Panics(t, func() { hd.RegisterWordList("test", words) })
+	require.Panics(t, func() { hd.RegisterWordList("short", words[:2047]) })
+	require.Panics(t, func() { hd.RegisterWordList("duplicates", append(words[:2047:2047], words[0])) })
+
+	// the mnemonic is recognized whatever its normalization form
+	for _, m := range []string{mnemonic, norm.NFKD.String(mnemonic)} {
+		language, err := hd.MnemonicLanguage(m)
+		require.NoError(t, err)
+		require.Equal(t, "test", language)
+
+		seed, err := hd.NewSeedFromMnemonic(m, "passphrase")
+		require.NoError(t, err)
+		require.Equal(t, bip39.NewSeed(norm.NFKD.String(mnemonic), "passphrase"), seed)
+	}
+
+	// and keys are derived from it
+	_, err = hd.Secp256k1.Derive()(mnemonic, "", hd.CreateHDPath(118, 0, 0).String())
+	require.NoError(t, err)
+}
+
+func TestNewSeedFromMnemonicEnglish(t *testing.T) {
+	seed, err := hd.NewSeedFromMnemonic(tests.TestMnemonic, "passphrase")
+	require.NoError(t, err)
+
+	expected, err := bip39.NewSeedWithErrorChecking(tests.TestMnemonic, "passphrase")
+	require.NoError(t, err)
+	require.Equal(t, expected, seed)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/secp256r1"
 )
 
 // CryptoCdc defines the codec required for keys and info
@@ -13,6 +14,7 @@ var CryptoCdc *codec.Codec
 func init() {
 	CryptoCdc = codec.New()
 	cryptoAmino.RegisterAmino(CryptoCdc)
+	secp256r1.RegisterAmino(CryptoCdc)
 	RegisterCodec(CryptoCdc)
 	CryptoCdc.Seal()
 }
@@ -25,4 +27,5 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(ledgerInfo{}, "crypto/keys/ledgerInfo", nil)
 	cdc.RegisterConcrete(offlineInfo{}, "crypto/keys/offlineInfo", nil)
 	cdc.RegisterConcrete(multiInfo{}, "crypto/keys/multiInfo", nil)
+	cdc.RegisterConcrete(remoteInfo{}, "crypto/keys/remoteInfo", nil)
 }
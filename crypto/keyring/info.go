@@ -31,23 +31,38 @@ var (
 	_ Info = &ledgerInfo{}
 	_ Info = &offlineInfo{}
 	_ Info = &multiInfo{}
+	_ Info = &remoteInfo{}
 )
 
 // localInfo is the public information about a locally stored key
-// Note: Algo must be last field in struct for backwards amino compatibility
+// Note: Algo must come after the original fields, and new fields after Algo,
+// for backwards amino compatibility
 type localInfo struct {
 	Name         string        `json:"name"`
 	PubKey       crypto.PubKey `json:"pubkey"`
 	PrivKeyArmor string        `json:"privkey.armor"`
 	Algo         hd.PubKeyType `json:"algo"`
-}
-
-func newLocalInfo(name string, pub crypto.PubKey, privArmor string, algo hd.PubKeyType) Info {
+	// Path is the HD path the key was derived with, empty if unknown
+	Path string `json:"path,omitempty"`
+	// MnemonicLanguage is the language of the mnemonic the key was derived
+	// from, empty if unknown
+	MnemonicLanguage string `json:"mnemonic_language,omitempty"`
+	// Bip39Passphrase is true if the key was derived with a BIP39 passphrase,
+	// which is then needed along with the mnemonic to recover the key
+	Bip39Passphrase bool `json:"bip39_passphrase,omitempty"`
+}
+
+func newLocalInfo(
+	name string, pub crypto.PubKey, privArmor string, algo hd.PubKeyType, path, mnemonicLanguage string, bip39Passphrase bool,
+) Info {
 	return &localInfo{
-		Name:         name,
-		PubKey:       pub,
-		PrivKeyArmor: privArmor,
-		Algo:         algo,
+		Name:             name,
+		PubKey:           pub,
+		PrivKeyArmor:     privArmor,
+		Algo:             algo,
+		Path:             path,
+		MnemonicLanguage: mnemonicLanguage,
+		Bip39Passphrase:  bip39Passphrase,
 	}
 }
 
@@ -76,9 +91,14 @@ func (i localInfo) GetAlgo() hd.PubKeyType {
 	return i.Algo
 }
 
-// GetType implements Info interface
+// GetPath implements Info interface. It returns an error if the key wasn't
+// derived with a BIP44 path or if its path is unknown.
 func (i localInfo) GetPath() (*hd.BIP44Params, error) {
-	return nil, fmt.Errorf("BIP44 Paths are not available for this type")
+	if i.Path == "" {
+		return nil, fmt.Errorf("BIP44 Path is not available for this key")
+	}
+
+	return hd.NewParamsFromPath(i.Path)
 }
 
 // ledgerInfo is the public information about a Ledger key
@@ -176,6 +196,60 @@ func (i offlineInfo) GetPath() (*hd.BIP44Params, error) {
 	return nil, fmt.Errorf("BIP44 Paths are not available for this type")
 }
 
+// remoteInfo is the public information about a key held by a remote signer
+type remoteInfo struct {
+	Name   string        `json:"name"`
+	PubKey crypto.PubKey `json:"pubkey"`
+	Algo   hd.PubKeyType `json:"algo"`
+	// Endpoint is the address of the remote signer
+	Endpoint string `json:"endpoint"`
+	// KeyID identifies the key on the remote signer
+	KeyID string `json:"key_id"`
+	// Token authenticates the requests to the remote signer, if not empty
+	Token string `json:"token,omitempty"`
+}
+
+func newRemoteInfo(name string, pub crypto.PubKey, algo hd.PubKeyType, endpoint, keyID, token string) Info {
+	return &remoteInfo{
+		Name:     name,
+		PubKey:   pub,
+		Algo:     algo,
+		Endpoint: endpoint,
+		KeyID:    keyID,
+		Token:    token,
+	}
+}
+
+// GetType implements Info interface
+func (i remoteInfo) GetType() KeyType {
+	return TypeRemote
+}
+
+// GetName implements Info interface
+func (i remoteInfo) GetName() string {
+	return i.Name
+}
+
+// GetPubKey implements Info interface
+func (i remoteInfo) GetPubKey() crypto.PubKey {
+	return i.PubKey
+}
+
+// GetAlgo implements Info interface
+func (i remoteInfo) GetAlgo() hd.PubKeyType {
+	return i.Algo
+}
+
+// GetAddress implements Info interface
+func (i remoteInfo) GetAddress() types.AccAddress {
+	return i.PubKey.Address().Bytes()
+}
+
+// GetPath implements Info interface
+func (i remoteInfo) GetPath() (*hd.BIP44Params, error) {
+	return nil, fmt.Errorf("BIP44 Paths are not available for this type")
+}
+
 type multisigPubKeyInfo struct {
 	PubKey crypto.PubKey `json:"pubkey"`
 	Weight uint          `json:"weight"`
@@ -76,6 +76,11 @@ type Keyring interface {
 	// SaveMultisig stores and returns a new multsig (offline) key reference.
 	SaveMultisig(uid string, pubkey tmcrypto.PubKey) (Info, error)
 
+	// SaveRemoteKey retrieves a public key reference from a remote signer and
+	// persists it. The key is identified by keyID on the remote signer at the
+	// given endpoint, which requests are authenticated with the token.
+	SaveRemoteKey(uid, endpoint, keyID, token string, algo hd.PubKeyType) (Info, error)
+
 	Signer
 
 	Importer
@@ -117,6 +122,10 @@ type Option func(options *Options)
 type Options struct {
 	SupportedAlgos       SigningAlgoList
 	SupportedAlgosLedger SigningAlgoList
+
+	// LedgerDerivation overrides the discovery of the connected Ledger device,
+	// e.g. with a mock device in tests
+	LedgerDerivation func() (crypto.LedgerSECP256K1, error)
 }
 
 // NewInMemory creates a transient keyring useful for testing
@@ -177,6 +186,10 @@ func newKeystore(kr keyring.Keyring, opts ...Option) keystore {
 		optionFn(&options)
 	}
 
+	if options.LedgerDerivation != nil {
+		crypto.SetDiscoverLedger(options.LedgerDerivation)
+	}
+
 	return keystore{kr, options}
 }
 
@@ -237,7 +250,7 @@ func (ks keystore) ExportPrivateKeyObject(uid string) (tmcrypto.PrivKey, error)
 			return nil, err
 		}
 
-	case ledgerInfo, offlineInfo, multiInfo:
+	case ledgerInfo, offlineInfo, multiInfo, remoteInfo:
 		return nil, errors.New("only works on local private keys")
 	}
 
@@ -263,7 +276,7 @@ func (ks keystore) ImportPrivKey(uid, armor, passphrase string) error {
 		return errors.Wrap(err, "failed to decrypt private key")
 	}
 
-	_, err = ks.writeLocalKey(uid, privKey, hd.PubKeyType(algo))
+	_, err = ks.writeLocalKey(uid, privKey, hd.PubKeyType(algo), "", "", false)
 	if err != nil {
 		return err
 	}
@@ -316,6 +329,9 @@ func (ks keystore) Sign(uid string, msg []byte) ([]byte, tmcrypto.PubKey, error)
 	case ledgerInfo:
 		return SignWithLedger(info, msg)
 
+	case remoteInfo:
+		return signWithRemote(i, msg)
+
 	case offlineInfo, multiInfo:
 		return nil, info.GetPubKey(), errors.New("cannot sign with offline keys")
 	}
@@ -365,6 +381,15 @@ func (ks keystore) SaveMultisig(uid string, pubkey tmcrypto.PubKey) (Info, error
 	return ks.writeMultisigKey(uid, pubkey)
 }
 
+func (ks keystore) SaveRemoteKey(uid, endpoint, keyID, token string, algo hd.PubKeyType) (Info, error) {
+	pub, err := NewRemoteSigner(endpoint, keyID, token).PubKey()
+	if err != nil {
+		return nil, err
+	}
+
+	return ks.writeRemoteKey(uid, pub, algo, endpoint, keyID, token)
+}
+
 func (ks keystore) SavePubKey(uid string, pubkey tmcrypto.PubKey, algo hd.PubKeyType) (Info, error) {
 	return ks.writeOfflineKey(uid, pubkey, algo)
 }
@@ -495,7 +520,10 @@ func (ks keystore) NewAccount(uid string, mnemonic string, bip39Passphrase strin
 
 	privKey := algo.Generate()(derivedPriv)
 
-	return ks.writeLocalKey(uid, privKey, algo.Name())
+	// the language is unknown if the mnemonic was accepted by a custom derivation
+	language, _ := hd.MnemonicLanguage(mnemonic)
+
+	return ks.writeLocalKey(uid, privKey, algo.Name(), hdPath, language, bip39Passphrase != "")
 }
 
 func (ks keystore) isSupportedSigningAlgo(algo SignatureAlgo) bool {
@@ -517,10 +545,16 @@ func (ks keystore) Key(uid string) (Info, error) {
 	return unmarshalInfo(bs.Data)
 }
 
-// SignWithLedger signs a binary message with the ledger device referenced by an Info object
-// and returns the signed bytes and the public key. It returns an error if the device could
-// not be queried or it returned an error.
+// SignWithLedger signs an amino-JSON encoded message with the ledger device referenced by an
+// Info object and returns the signed bytes and the public key. It returns an error if the
+// device could not be queried or it returned an error.
 func SignWithLedger(info Info, msg []byte) (sig []byte, pub tmcrypto.PubKey, err error) {
+	return SignWithLedgerMode(info, msg, crypto.LedgerSignModeAminoJSON)
+}
+
+// SignWithLedgerMode signs a message encoded in the sign mode with the ledger device
+// referenced by an Info object and returns the signed bytes and the public key.
+func SignWithLedgerMode(info Info, msg []byte, mode crypto.LedgerSignMode) (sig []byte, pub tmcrypto.PubKey, err error) {
 	switch info.(type) {
 	case *ledgerInfo, ledgerInfo:
 	default:
@@ -537,7 +571,7 @@ func SignWithLedger(info Info, msg []byte) (sig []byte, pub tmcrypto.PubKey, err
 		return
 	}
 
-	sig, err = priv.Sign(msg)
+	sig, err = priv.(crypto.PrivKeyLedgerSecp256k1).SignWithMode(msg, mode)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -670,11 +704,13 @@ func newRealPrompt(dir string, buf io.Reader) func(string) (string, error) {
 	}
 }
 
-func (ks keystore) writeLocalKey(name string, priv tmcrypto.PrivKey, algo hd.PubKeyType) (Info, error) {
+func (ks keystore) writeLocalKey(
+	name string, priv tmcrypto.PrivKey, algo hd.PubKeyType, hdPath, mnemonicLanguage string, bip39Passphrase bool,
+) (Info, error) {
 	// encrypt private key using keyring
 	pub := priv.PubKey()
 
-	info := newLocalInfo(name, pub, string(priv.Bytes()), algo)
+	info := newLocalInfo(name, pub, string(priv.Bytes()), algo, hdPath, mnemonicLanguage, bip39Passphrase)
 	if err := ks.writeInfo(info); err != nil {
 		return nil, err
 	}
@@ -742,6 +778,15 @@ func (ks keystore) writeOfflineKey(name string, pub tmcrypto.PubKey, algo hd.Pub
 	return info, nil
 }
 
+func (ks keystore) writeRemoteKey(name string, pub tmcrypto.PubKey, algo hd.PubKeyType, endpoint, keyID, token string) (Info, error) {
+	info := newRemoteInfo(name, pub, algo, endpoint, keyID, token)
+	if err := ks.writeInfo(info); err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
 func (ks keystore) writeMultisigKey(name string, pub tmcrypto.PubKey) (Info, error) {
 	info := NewMultiInfo(name, pub)
 	err := ks.writeInfo(info)
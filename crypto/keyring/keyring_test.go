@@ -408,6 +408,26 @@ func TestInMemoryCreateAccountInvalidMnemonic(t *testing.T) {
 	require.Equal(t, "Invalid mnemonic", err.Error())
 }
 
+func TestInMemoryCreateAccountMnemonicMetadata(t *testing.T) {
+	kb := NewInMemory()
+	hdPath := hd.CreateHDPath(118, 0, 0).String()
+
+	info, err := kb.NewAccount("no_passphrase", tests.TestMnemonic, "", hdPath, hd.Secp256k1)
+	require.NoError(t, err)
+	require.Equal(t, hd.English, info.(*localInfo).MnemonicLanguage)
+	require.False(t, info.(*localInfo).Bip39Passphrase)
+
+	info, err = kb.NewAccount("passphrase", tests.TestMnemonic, "passphrase", hdPath, hd.Secp256k1)
+	require.NoError(t, err)
+	require.True(t, info.(*localInfo).Bip39Passphrase)
+
+	// the metadata is persisted
+	info, err = kb.Key("passphrase")
+	require.NoError(t, err)
+	require.Equal(t, hd.English, info.(localInfo).MnemonicLanguage)
+	require.True(t, info.(localInfo).Bip39Passphrase)
+}
+
 // TestInMemoryKeyManagement makes sure we can manipulate these keys well
 func TestInMemoryKeyManagement(t *testing.T) {
 	// make the storage with reasonable defaults
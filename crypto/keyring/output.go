@@ -12,11 +12,12 @@ type KeyOutput struct {
 	Address   string                 `json:"address" yaml:"address"`
 	PubKey    string                 `json:"pubkey" yaml:"pubkey"`
 	Mnemonic  string                 `json:"mnemonic,omitempty" yaml:"mnemonic"`
+	Path      string                 `json:"path,omitempty" yaml:"path,omitempty"`
 	Threshold uint                   `json:"threshold,omitempty" yaml:"threshold"`
 	PubKeys   []multisigPubKeyOutput `json:"pubkeys,omitempty" yaml:"pubkeys"`
 }
 
-// NewKeyOutput creates a default KeyOutput instance without Mnemonic, Threshold and PubKeys
+// NewKeyOutput creates a default KeyOutput instance without Mnemonic, Path, Threshold and PubKeys
 func NewKeyOutput(name, keyType, address, pubkey string) KeyOutput {
 	return KeyOutput{
 		Name:    name,
@@ -73,8 +74,9 @@ func Bech32ValKeyOutput(keyInfo Info) (KeyOutput, error) {
 }
 
 // Bech32KeyOutput create a KeyOutput in with "acc" Bech32 prefixes. If the
-// public key is a multisig public key, then the threshold and constituent
-// public keys will be added.
+// key was derived with a BIP44 path, the path will be added. If the public key
+// is a multisig public key, then the threshold and constituent public keys will
+// be added.
 func Bech32KeyOutput(keyInfo Info) (KeyOutput, error) {
 	accAddr := sdk.AccAddress(keyInfo.GetPubKey().Address().Bytes())
 	bechPubKey, err := sdk.Bech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, keyInfo.GetPubKey())
@@ -83,6 +85,9 @@ func Bech32KeyOutput(keyInfo Info) (KeyOutput, error) {
 	}
 
 	ko := NewKeyOutput(keyInfo.GetName(), keyInfo.GetType().String(), accAddr.String(), bechPubKey)
+	if path, err := keyInfo.GetPath(); err == nil {
+		ko.Path = path.String()
+	}
 
 	if mInfo, ok := keyInfo.(*multiInfo); ok {
 		pubKeys := make([]multisigPubKeyOutput, len(mInfo.PubKeys))
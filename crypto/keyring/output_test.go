@@ -8,6 +8,8 @@ import (
 	"github.com/tendermint/tendermint/crypto/multisig"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/tests"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -29,3 +31,22 @@ func TestBech32KeysOutput(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, expectedOutput, outputs[0])
 }
+
+func TestBech32KeyOutputPath(t *testing.T) {
+	kb := NewInMemory()
+
+	info, err := kb.NewAccount("key", tests.TestMnemonic, "", hd.CreateHDPath(60, 1, 2).String(), hd.Secp256k1)
+	require.NoError(t, err)
+
+	output, err := Bech32KeyOutput(info)
+	require.NoError(t, err)
+	require.Equal(t, "44'/60'/1'/0/2", output.Path)
+
+	// the path is kept in the keyring
+	info, err = kb.Key("key")
+	require.NoError(t, err)
+
+	output, err = Bech32KeyOutput(info)
+	require.NoError(t, err)
+	require.Equal(t, "44'/60'/1'/0/2", output.Path)
+}
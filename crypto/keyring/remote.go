@@ -0,0 +1,153 @@
+package keyring
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+	cryptoAmino "github.com/tendermint/tendermint/crypto/encoding/amino"
+)
+
+// remoteSignerTimeout is the timeout of the requests to a remote signer.
+const remoteSignerTimeout = 30 * time.Second
+
+// RemoteSigner signs messages with a private key held by an external key
+// management service (KMS), so that the private key is never loaded on the
+// machine building and broadcasting transactions.
+type RemoteSigner interface {
+	// PubKey returns the public key of the remote key.
+	PubKey() (tmcrypto.PubKey, error)
+
+	// Sign signs the message with the remote key.
+	Sign(msg []byte) ([]byte, error)
+}
+
+// NewRemoteSigner returns the RemoteSigner of the key identified by keyID on
+// the KMS at the given endpoint, authenticating with the given token if it is
+// not empty. It defaults to NewHTTPRemoteSigner and may be replaced to
+// integrate with a KMS exposing another interface, e.g. gRPC.
+var NewRemoteSigner = func(endpoint, keyID, token string) RemoteSigner {
+	return NewHTTPRemoteSigner(endpoint, keyID, token)
+}
+
+// httpRemoteSigner is a RemoteSigner of a KMS exposing an HTTP interface:
+//
+//	GET  <endpoint>/keys/<key id>       returns {"pub_key": "<base64 amino encoded public key>"}
+//	POST <endpoint>/keys/<key id>/sign  takes {"msg": "<base64 message>"} and returns {"signature": "<base64 signature>"}
+//
+// The token, if any, is sent as a bearer token in the Authorization header.
+type httpRemoteSigner struct {
+	endpoint string
+	keyID    string
+	token    string
+	client   *http.Client
+}
+
+type (
+	remotePubKeyResponse struct {
+		PubKey []byte `json:"pub_key"`
+	}
+
+	remoteSignRequest struct {
+		Msg []byte `json:"msg"`
+	}
+
+	remoteSignResponse struct {
+		Signature []byte `json:"signature"`
+	}
+)
+
+// NewHTTPRemoteSigner returns the RemoteSigner of a key on a KMS exposing an
+// HTTP interface.
+func NewHTTPRemoteSigner(endpoint, keyID, token string) RemoteSigner {
+	return httpRemoteSigner{
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		keyID:    keyID,
+		token:    token,
+		client:   &http.Client{Timeout: remoteSignerTimeout},
+	}
+}
+
+// PubKey implements RemoteSigner.
+func (s httpRemoteSigner) PubKey() (tmcrypto.PubKey, error) {
+	var res remotePubKeyResponse
+	if err := s.do(http.MethodGet, s.keyURL(), nil, &res); err != nil {
+		return nil, err
+	}
+
+	return cryptoAmino.PubKeyFromBytes(res.PubKey)
+}
+
+// Sign implements RemoteSigner.
+func (s httpRemoteSigner) Sign(msg []byte) ([]byte, error) {
+	var res remoteSignResponse
+	if err := s.do(http.MethodPost, s.keyURL()+"/sign", remoteSignRequest{Msg: msg}, &res); err != nil {
+		return nil, err
+	}
+
+	return res.Signature, nil
+}
+
+func (s httpRemoteSigner) keyURL() string {
+	return fmt.Sprintf("%s/keys/%s", s.endpoint, url.PathEscape(s.keyID))
+}
+
+func (s httpRemoteSigner) do(method, reqURL string, body, res interface{}) error {
+	var reqBody bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&reqBody).Encode(body); err != nil {
+			return err
+		}
+	}
+
+	req, err := http.NewRequest(method, reqURL, &reqBody)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if s.token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.token)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to reach the remote signer")
+	}
+	defer resp.Body.Close()
+
+	bz, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("remote signer returned %s: %s", resp.Status, strings.TrimSpace(string(bz)))
+	}
+
+	return json.Unmarshal(bz, res)
+}
+
+// signWithRemote signs a message with the remote signer referenced by an Info
+// object and returns the signed bytes and the public key. The signature is
+// verified against the public key stored in the keyring, so that a remote
+// signer signing with another key is detected before broadcasting.
+func signWithRemote(info remoteInfo, msg []byte) ([]byte, tmcrypto.PubKey, error) {
+	sig, err := NewRemoteSigner(info.Endpoint, info.KeyID, info.Token).Sign(msg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !info.PubKey.VerifyBytes(msg, sig) {
+		return nil, nil, errors.New("the remote signer returned an invalid signature")
+	}
+
+	return sig, info.PubKey, nil
+}
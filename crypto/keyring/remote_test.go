@@ -0,0 +1,107 @@
+package keyring
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+)
+
+const (
+	remoteKeyID = "validator/key-1"
+	remoteToken = "s3cr3t-t0k3n"
+)
+
+// newTestRemoteSigner returns an HTTP server holding priv under remoteKeyID,
+// signing with signer if not nil.
+func newTestRemoteSigner(t *testing.T, priv tmcrypto.PrivKey, signer tmcrypto.PrivKey) *httptest.Server {
+	if signer == nil {
+		signer = priv
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/keys/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+remoteToken {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/keys/" + remoteKeyID:
+			require.NoError(t, json.NewEncoder(w).Encode(remotePubKeyResponse{PubKey: priv.PubKey().Bytes()}))
+
+		case "/keys/" + remoteKeyID + "/sign":
+			var req remoteSignRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+			sig, err := signer.Sign(req.Msg)
+			require.NoError(t, err)
+			require.NoError(t, json.NewEncoder(w).Encode(remoteSignResponse{Signature: sig}))
+
+		default:
+			http.NotFound(w, r)
+		}
+	})
+
+	return httptest.NewServer(mux)
+}
+
+func TestRemoteKey(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+	server := newTestRemoteSigner(t, priv, nil)
+	defer server.Close()
+
+	kr := NewInMemory()
+
+	// the requests must be authenticated, and the key exist on the remote signer
+	_, err := kr.SaveRemoteKey("remote", server.URL, remoteKeyID, "", hd.Secp256k1Type)
+	require.Error(t, err)
+	_, err = kr.SaveRemoteKey("remote", server.URL, "unknown", remoteToken, hd.Secp256k1Type)
+	require.Error(t, err)
+
+	info, err := kr.SaveRemoteKey("remote", server.URL, remoteKeyID, remoteToken, hd.Secp256k1Type)
+	require.NoError(t, err)
+	require.Equal(t, TypeRemote, info.GetType())
+	require.Equal(t, "remote", info.GetType().String())
+	require.Equal(t, priv.PubKey(), info.GetPubKey())
+
+	// the reference is persisted
+	info, err = kr.Key("remote")
+	require.NoError(t, err)
+	require.Equal(t, priv.PubKey(), info.GetPubKey())
+
+	msg := []byte("message")
+	sig, pub, err := kr.Sign("remote", msg)
+	require.NoError(t, err)
+	require.Equal(t, priv.PubKey(), pub)
+	require.True(t, pub.VerifyBytes(msg, sig))
+
+	sig, pub, err = kr.SignByAddress(info.GetAddress(), msg)
+	require.NoError(t, err)
+	require.True(t, pub.VerifyBytes(msg, sig))
+
+	// the private key cannot be exported
+	_, err = kr.ExportPrivKeyArmor("remote", "passphrase")
+	require.Error(t, err)
+}
+
+func TestRemoteKeyInvalidSignature(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+
+	// the remote signer signs with another key than the stored one
+	server := newTestRemoteSigner(t, priv, secp256k1.GenPrivKey())
+	defer server.Close()
+
+	kr := NewInMemory()
+	_, err := kr.SaveRemoteKey("remote", server.URL, remoteKeyID, remoteToken, hd.Secp256k1Type)
+	require.NoError(t, err)
+
+	_, _, err = kr.Sign("remote", []byte("message"))
+	require.EqualError(t, err, "the remote signer returned an invalid signature")
+}
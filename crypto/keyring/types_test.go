@@ -42,3 +42,30 @@ func Test_writeReadLedgerInfo(t *testing.T) {
 
 	assert.Equal(t, path, restoredPath)
 }
+
+func Test_writeReadLocalInfo(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+
+	lInfo := newLocalInfo("some_name", priv.PubKey(), string(priv.Bytes()), hd.Secp256k1Type, "44'/60'/2'/0/3", hd.English, true)
+	assert.Equal(t, TypeLocal, lInfo.GetType())
+
+	path, err := lInfo.GetPath()
+	assert.NoError(t, err)
+	assert.Equal(t, *hd.NewFundraiserParams(2, 60, 3), *path)
+
+	// Serialize and restore
+	restoredInfo, err := unmarshalInfo(marshalInfo(lInfo))
+	assert.NoError(t, err)
+
+	restoredPath, err := restoredInfo.GetPath()
+	assert.NoError(t, err)
+	assert.Equal(t, path, restoredPath)
+
+	// the path of the keys stored without one is unknown
+	restoredInfo, err = unmarshalInfo(marshalInfo(newLocalInfo("some_name", priv.PubKey(), string(priv.Bytes()), hd.Secp256k1Type, "", "", false)))
+	assert.NoError(t, err)
+	assert.Equal(t, lInfo.GetPubKey(), restoredInfo.GetPubKey())
+
+	_, err = restoredInfo.GetPath()
+	assert.Error(t, err)
+}
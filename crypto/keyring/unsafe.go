@@ -0,0 +1,54 @@
+package keyring
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/crypto/sr25519"
+
+	"github.com/cosmos/cosmos-sdk/crypto/secp256r1"
+)
+
+// UnsafeKeyring exposes unsafe operations such as unsafe unarmored export in
+// addition to those that are made available by the Keyring interface.
+type UnsafeKeyring interface {
+	Keyring
+
+	// UnsafeExportPrivKeyHex exports the private key of a local key as an
+	// unarmored and unencrypted hex string.
+	UnsafeExportPrivKeyHex(uid string) (string, error)
+}
+
+// NewUnsafe returns a new keyring that provides support for unsafe operations.
+// It panics if the keyring wasn't returned by New or NewInMemory.
+func NewUnsafe(kr Keyring) UnsafeKeyring {
+	return unsafeKeystore{kr.(keystore)}
+}
+
+// unsafeKeystore is a wrapper around keystore that provides unsafe export functions.
+type unsafeKeystore struct {
+	keystore
+}
+
+// UnsafeExportPrivKeyHex exports private keys in unarmored hexadecimal format.
+func (ks unsafeKeystore) UnsafeExportPrivKeyHex(uid string) (privkey string, err error) {
+	priv, err := ks.ExportPrivateKeyObject(uid)
+	if err != nil {
+		return "", err
+	}
+
+	switch priv := priv.(type) {
+	case secp256k1.PrivKeySecp256k1:
+		return hex.EncodeToString(priv[:]), nil
+	case secp256r1.PrivKeySecp256r1:
+		return hex.EncodeToString(priv[:]), nil
+	case ed25519.PrivKeyEd25519:
+		return hex.EncodeToString(priv[:]), nil
+	case sr25519.PrivKeySr25519:
+		return hex.EncodeToString(priv[:]), nil
+	default:
+		return "", fmt.Errorf("unsupported private key type %T", priv)
+	}
+}
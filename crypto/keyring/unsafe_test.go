@@ -0,0 +1,41 @@
+package keyring
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestUnsafeExportPrivKeyHex(t *testing.T) {
+	kr := NewUnsafe(NewInMemory())
+	uid := "theKey"
+
+	_, err := kr.UnsafeExportPrivKeyHex(uid)
+	require.Error(t, err)
+
+	info, _, err := kr.NewMnemonic(uid, English, sdk.FullFundraiserPath, hd.Secp256k1)
+	require.NoError(t, err)
+
+	privKey, err := kr.UnsafeExportPrivKeyHex(uid)
+	require.NoError(t, err)
+
+	bz, err := hex.DecodeString(privKey)
+	require.NoError(t, err)
+	require.Len(t, bz, 32)
+
+	var priv secp256k1.PrivKeySecp256k1
+	copy(priv[:], bz)
+	require.Equal(t, info.GetPubKey(), priv.PubKey())
+
+	// the private keys of offline keys cannot be exported
+	_, err = kr.SavePubKey("offline", secp256k1.GenPrivKey().PubKey(), hd.Secp256k1Type)
+	require.NoError(t, err)
+
+	_, err = kr.UnsafeExportPrivKeyHex("offline")
+	require.Error(t, err)
+}
@@ -110,6 +110,16 @@ func (mock LedgerSECP256K1Mock) SignSECP256K1(derivationPath []uint32, message [
 	return sig2.Serialize(), nil
 }
 
+// SignSECP256K1WithMode mocks a ledger device supporting the textual sign mode,
+// signing the message whatever its encoding
+func (mock LedgerSECP256K1Mock) SignSECP256K1WithMode(derivationPath []uint32, message []byte, mode LedgerSignMode) ([]byte, error) {
+	if mode != LedgerSignModeAminoJSON && mode != LedgerSignModeTextual {
+		return nil, fmt.Errorf("invalid sign mode %d", mode)
+	}
+
+	return mock.SignSECP256K1(derivationPath, message)
+}
+
 // ShowAddressSECP256K1 shows the address for the corresponding bip32 derivation path
 func (mock LedgerSECP256K1Mock) ShowAddressSECP256K1(bip32Path []uint32, hrp string) error {
 	fmt.Printf("Request to show address for %v at %v", hrp, bip32Path)
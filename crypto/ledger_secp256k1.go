@@ -20,6 +20,18 @@ var (
 	discoverLedger discoverLedgerFn
 )
 
+// LedgerSignMode defines the encoding of the payloads signed by a Ledger device.
+type LedgerSignMode byte
+
+const (
+	// LedgerSignModeAminoJSON signs amino-JSON encoded payloads, the sign
+	// bytes of the StdTx.
+	LedgerSignModeAminoJSON LedgerSignMode = 0
+	// LedgerSignModeTextual signs human-readable textual payloads, only
+	// supported by the Ledger APIs implementing LedgerSECP256K1SignModes.
+	LedgerSignModeTextual LedgerSignMode = 1
+)
+
 type (
 	// discoverLedgerFn defines a Ledger discovery function that returns a
 	// connected device or an error upon failure. Its allows a method to avoid CGO
@@ -37,6 +49,13 @@ type (
 		SignSECP256K1([]uint32, []byte) ([]byte, error)
 	}
 
+	// LedgerSECP256K1SignModes reflects an interface a Ledger API supporting
+	// other payloads than amino-JSON ones may implement
+	LedgerSECP256K1SignModes interface {
+		// Signs a message encoded in the sign mode (requires user confirmation)
+		SignSECP256K1WithMode([]uint32, []byte, LedgerSignMode) ([]byte, error)
+	}
+
 	// PrivKeyLedgerSecp256k1 implements PrivKey, calling the ledger nano we
 	// cache the PubKey from the first call to use it later.
 	PrivKeyLedgerSecp256k1 struct {
@@ -48,6 +67,12 @@ type (
 	}
 )
 
+// SetDiscoverLedger overrides the function discovering the connected Ledger
+// device, e.g. to derive the keys of a mock device in tests.
+func SetDiscoverLedger(fn func() (LedgerSECP256K1, error)) {
+	discoverLedger = fn
+}
+
 // NewPrivKeyLedgerSecp256k1Unsafe will generate a new key and store the public key for later use.
 //
 // This function is marked as unsafe as it will retrieve a pubkey without user verification.
@@ -90,15 +115,43 @@ func (pkl PrivKeyLedgerSecp256k1) PubKey() tmcrypto.PubKey {
 	return pkl.CachedPubKey
 }
 
-// Sign returns a secp256k1 signature for the corresponding message
+// Sign returns a secp256k1 signature for the corresponding amino-JSON encoded
+// message
 func (pkl PrivKeyLedgerSecp256k1) Sign(message []byte) ([]byte, error) {
+	return pkl.SignWithMode(message, LedgerSignModeAminoJSON)
+}
+
+// SignWithMode returns a secp256k1 signature for the corresponding message
+// encoded in the sign mode
+func (pkl PrivKeyLedgerSecp256k1) SignWithMode(message []byte, mode LedgerSignMode) ([]byte, error) {
 	device, err := getLedgerDevice()
 	if err != nil {
 		return nil, err
 	}
 	defer warnIfErrors(device.Close)
 
-	return sign(device, pkl, message)
+	return sign(device, pkl, message, mode)
+}
+
+// LedgerPubKeys returns the public keys derived by a ledger device for the
+// paths, without user confirmation. It can be used to list the addresses of a
+// device but never to create new accounts/keys.
+func LedgerPubKeys(paths []hd.BIP44Params) ([]tmcrypto.PubKey, error) {
+	device, err := getLedgerDevice()
+	if err != nil {
+		return nil, err
+	}
+	defer warnIfErrors(device.Close)
+
+	pubKeys := make([]tmcrypto.PubKey, len(paths))
+	for i, path := range paths {
+		pubKeys[i], err = getPubKeyUnsafe(device, path)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return pubKeys, nil
 }
 
 // LedgerShowAddress triggers a ledger device to show the corresponding address.
@@ -210,13 +263,21 @@ func validateKey(device LedgerSECP256K1, pkl PrivKeyLedgerSecp256k1) error {
 // Communication is checked on NewPrivKeyLedger and PrivKeyFromBytes, returning
 // an error, so this should only trigger if the private key is held in memory
 // for a while before use.
-func sign(device LedgerSECP256K1, pkl PrivKeyLedgerSecp256k1, msg []byte) ([]byte, error) {
+func sign(device LedgerSECP256K1, pkl PrivKeyLedgerSecp256k1, msg []byte, mode LedgerSignMode) ([]byte, error) {
 	err := validateKey(device, pkl)
 	if err != nil {
 		return nil, err
 	}
 
-	sig, err := device.SignSECP256K1(pkl.Path.DerivationPath(), msg)
+	var sig []byte
+	switch modeDevice, ok := device.(LedgerSECP256K1SignModes); {
+	case ok:
+		sig, err = modeDevice.SignSECP256K1WithMode(pkl.Path.DerivationPath(), msg, mode)
+	case mode == LedgerSignModeAminoJSON:
+		sig, err = device.SignSECP256K1(pkl.Path.DerivationPath(), msg)
+	default:
+		return nil, fmt.Errorf("sign mode %d is not supported by the Ledger device", mode)
+	}
 	if err != nil {
 		return nil, err
 	}
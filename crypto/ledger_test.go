@@ -224,6 +224,59 @@ func TestSignaturesHD(t *testing.T) {
 	}
 }
 
+// aminoOnlyLedger wraps a Ledger API without the sign modes it may support
+type aminoOnlyLedger struct {
+	LedgerSECP256K1
+}
+
+func TestSignaturesSignModes(t *testing.T) {
+	msg := getFakeTx(0)
+	path := *hd.NewFundraiserParams(0, sdk.CoinType, 0)
+
+	priv, err := NewPrivKeyLedgerSecp256k1Unsafe(path)
+	require.NoError(t, err)
+	pkl := priv.(PrivKeyLedgerSecp256k1)
+
+	sig, err := pkl.SignWithMode(msg, LedgerSignModeTextual)
+	require.NoError(t, err)
+	require.True(t, pkl.PubKey().VerifyBytes(msg, sig))
+
+	// a device without sign modes only signs amino-JSON payloads
+	discover := discoverLedger
+	t.Cleanup(func() { SetDiscoverLedger(discover) })
+	SetDiscoverLedger(func() (LedgerSECP256K1, error) {
+		device, err := discover()
+		return aminoOnlyLedger{device}, err
+	})
+
+	_, err = pkl.SignWithMode(msg, LedgerSignModeTextual)
+	require.Error(t, err)
+
+	sig, err = pkl.SignWithMode(msg, LedgerSignModeAminoJSON)
+	require.NoError(t, err)
+	require.True(t, pkl.PubKey().VerifyBytes(msg, sig))
+}
+
+func TestLedgerPubKeys(t *testing.T) {
+	paths := make([]hd.BIP44Params, 3)
+	for i := range paths {
+		paths[i] = *hd.NewFundraiserParams(0, sdk.CoinType, uint32(i))
+	}
+
+	pubKeys, err := LedgerPubKeys(paths)
+	require.NoError(t, err)
+	require.Len(t, pubKeys, len(paths))
+
+	for i, path := range paths {
+		priv, err := NewPrivKeyLedgerSecp256k1Unsafe(path)
+		require.NoError(t, err)
+		require.Equal(t, priv.PubKey(), pubKeys[i])
+	}
+
+	_, err = LedgerPubKeys([]hd.BIP44Params{*hd.NewParams(44, 555, 0, false, 0)})
+	require.Error(t, err)
+}
+
 func TestRealLedgerSecp256k1(t *testing.T) {
 	msg := getFakeTx(50)
 	path := *hd.NewFundraiserParams(0, sdk.CoinType, 0)
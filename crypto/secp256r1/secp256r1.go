@@ -0,0 +1,194 @@
+// Package secp256r1 implements the NIST P-256 (secp256r1) ECDSA keys, the curve
+// supported by the secure enclaves of mobile devices and by passkeys (WebAuthn).
+package secp256r1
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"crypto/subtle"
+	"fmt"
+	"io"
+	"math/big"
+
+	amino "github.com/tendermint/go-amino"
+	"github.com/tendermint/tendermint/crypto"
+	cryptoamino "github.com/tendermint/tendermint/crypto/encoding/amino"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+const (
+	PrivKeyAminoName = "cosmos-sdk/PrivKeySecp256r1"
+	PubKeyAminoName  = "cosmos-sdk/PubKeySecp256r1"
+
+	// PrivKeySize is the size of the private key scalar.
+	PrivKeySize = 32
+	// PubKeySize is comprised of 32 bytes for the x-coordinate, plus one byte
+	// for the parity of the y-coordinate.
+	PubKeySize = 33
+	// SignatureSize is the size of a signature, the 32 bytes of r followed by
+	// the 32 bytes of s.
+	SignatureSize = 64
+)
+
+var (
+	cdc = amino.NewCodec()
+
+	curve     = elliptic.P256()
+	halfOrder = new(big.Int).Rsh(curve.Params().N, 1)
+)
+
+func init() {
+	RegisterAmino(cdc)
+
+	// register the keys with the Tendermint crypto codec for them to be decoded
+	// by the PubKeyFromBytes and PrivKeyFromBytes helpers
+	cryptoamino.RegisterKeyType(PubKeySecp256r1{}, PubKeyAminoName)
+	cryptoamino.RegisterKeyType(PrivKeySecp256r1{}, PrivKeyAminoName)
+}
+
+// RegisterAmino registers the secp256r1 keys in the given (amino) codec.
+func RegisterAmino(cdc *amino.Codec) {
+	cdc.RegisterConcrete(PubKeySecp256r1{}, PubKeyAminoName, nil)
+	cdc.RegisterConcrete(PrivKeySecp256r1{}, PrivKeyAminoName, nil)
+}
+
+//-------------------------------------
+
+var _ crypto.PrivKey = PrivKeySecp256r1{}
+
+// PrivKeySecp256r1 implements crypto.PrivKey. It is the big-endian encoding of
+// the private key scalar.
+type PrivKeySecp256r1 [PrivKeySize]byte
+
+// GenPrivKey generates a new secp256r1 private key using OS randomness.
+func GenPrivKey() PrivKeySecp256r1 {
+	return genPrivKey(crypto.CReader())
+}
+
+// genPrivKey generates a new secp256r1 private key using the provided reader.
+func genPrivKey(rand io.Reader) PrivKeySecp256r1 {
+	key, err := ecdsa.GenerateKey(curve, rand)
+	if err != nil {
+		panic(err)
+	}
+
+	// left-pad the scalar to the 32 bytes of the key
+	var privKey PrivKeySecp256r1
+	bz := key.D.Bytes()
+	copy(privKey[len(privKey)-len(bz):], bz)
+
+	return privKey
+}
+
+// Bytes marshals the private key using amino encoding.
+func (privKey PrivKeySecp256r1) Bytes() []byte {
+	return cdc.MustMarshalBinaryBare(privKey)
+}
+
+// Sign signs the SHA-256 hash of the message. The signature is the 64 bytes of
+// r and s, s being normalized to the lower half of the curve order.
+func (privKey PrivKeySecp256r1) Sign(msg []byte) ([]byte, error) {
+	hash := sha256.Sum256(msg)
+
+	r, s, err := ecdsa.Sign(crypto.CReader(), privKey.toECDSA(), hash[:])
+	if err != nil {
+		return nil, err
+	}
+
+	if s.Cmp(halfOrder) > 0 {
+		s.Sub(curve.Params().N, s)
+	}
+
+	// left-pad r and s to 32 bytes each
+	sig := make([]byte, SignatureSize)
+	rBz, sBz := r.Bytes(), s.Bytes()
+	copy(sig[32-len(rBz):32], rBz)
+	copy(sig[SignatureSize-len(sBz):], sBz)
+
+	return sig, nil
+}
+
+// PubKey returns the compressed public key of the private key.
+func (privKey PrivKeySecp256r1) PubKey() crypto.PubKey {
+	key := privKey.toECDSA()
+
+	var pubKey PubKeySecp256r1
+	copy(pubKey[:], elliptic.MarshalCompressed(curve, key.X, key.Y))
+
+	return pubKey
+}
+
+// Equals runs in constant time based on the length of the keys.
+func (privKey PrivKeySecp256r1) Equals(other crypto.PrivKey) bool {
+	if otherR1, ok := other.(PrivKeySecp256r1); ok {
+		return subtle.ConstantTimeCompare(privKey[:], otherR1[:]) == 1
+	}
+
+	return false
+}
+
+func (privKey PrivKeySecp256r1) toECDSA() *ecdsa.PrivateKey {
+	key := &ecdsa.PrivateKey{D: new(big.Int).SetBytes(privKey[:])}
+	key.Curve = curve
+	key.X, key.Y = curve.ScalarBaseMult(privKey[:])
+
+	return key
+}
+
+//-------------------------------------
+
+var _ crypto.PubKey = PubKeySecp256r1{}
+
+// PubKeySecp256r1 implements crypto.PubKey. It is the compressed form of the
+// public key: a 0x02 or 0x03 byte for the parity of the y-coordinate, followed
+// by the x-coordinate.
+type PubKeySecp256r1 [PubKeySize]byte
+
+// Address returns the first 20 bytes of the SHA-256 hash of the public key.
+func (pubKey PubKeySecp256r1) Address() crypto.Address {
+	return crypto.Address(tmhash.SumTruncated(pubKey[:]))
+}
+
+// Bytes marshals the public key using amino encoding.
+func (pubKey PubKeySecp256r1) Bytes() []byte {
+	return cdc.MustMarshalBinaryBare(pubKey)
+}
+
+// VerifyBytes verifies a signature of the SHA-256 hash of the message. To
+// prevent their malleability, signatures with an s in the upper half of the
+// curve order are rejected.
+func (pubKey PubKeySecp256r1) VerifyBytes(msg []byte, sig []byte) bool {
+	if len(sig) != SignatureSize {
+		return false
+	}
+
+	x, y := elliptic.UnmarshalCompressed(curve, pubKey[:])
+	if x == nil {
+		return false
+	}
+
+	r := new(big.Int).SetBytes(sig[:32])
+	s := new(big.Int).SetBytes(sig[32:])
+	if s.Cmp(halfOrder) > 0 {
+		return false
+	}
+
+	hash := sha256.Sum256(msg)
+
+	return ecdsa.Verify(&ecdsa.PublicKey{Curve: curve, X: x, Y: y}, hash[:], r, s)
+}
+
+func (pubKey PubKeySecp256r1) String() string {
+	return fmt.Sprintf("PubKeySecp256r1{%X}", pubKey[:])
+}
+
+// Equals returns true if the other public key is the same secp256r1 key.
+func (pubKey PubKeySecp256r1) Equals(other crypto.PubKey) bool {
+	if otherR1, ok := other.(PubKeySecp256r1); ok {
+		return bytes.Equal(pubKey[:], otherR1[:])
+	}
+
+	return false
+}
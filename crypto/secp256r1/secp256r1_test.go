@@ -0,0 +1,91 @@
+package secp256r1_test
+
+import (
+	"crypto/elliptic"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	cryptoamino "github.com/tendermint/tendermint/crypto/encoding/amino"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/secp256r1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	priv := secp256r1.GenPrivKey()
+	pub := priv.PubKey()
+	msg := []byte("hello world")
+
+	sig, err := priv.Sign(msg)
+	require.NoError(t, err)
+	require.Len(t, sig, secp256r1.SignatureSize)
+	require.True(t, pub.VerifyBytes(msg, sig))
+
+	// the signature doesn't verify another message or key
+	require.False(t, pub.VerifyBytes([]byte("hello"), sig))
+	require.False(t, secp256r1.GenPrivKey().PubKey().VerifyBytes(msg, sig))
+
+	// nor a corrupted signature
+	sig[7] ^= byte(0x01)
+	require.False(t, pub.VerifyBytes(msg, sig))
+	require.False(t, pub.VerifyBytes(msg, sig[:32]))
+}
+
+func TestVerifyRejectsMalleableSignatures(t *testing.T) {
+	priv := secp256r1.GenPrivKey()
+	pub := priv.PubKey()
+	msg := []byte("hello world")
+
+	sig, err := priv.Sign(msg)
+	require.NoError(t, err)
+
+	// (r, n - s) is an equally valid ECDSA signature, but with a high s
+	n := elliptic.P256().Params().N
+	s := new(big.Int).SetBytes(sig[32:])
+
+	malleated := make([]byte, secp256r1.SignatureSize)
+	copy(malleated, sig[:32])
+	highS := new(big.Int).Sub(n, s).Bytes()
+	copy(malleated[len(malleated)-len(highS):], highS)
+	require.False(t, pub.VerifyBytes(msg, malleated))
+}
+
+func TestPubKeyEquals(t *testing.T) {
+	priv := secp256r1.GenPrivKey()
+
+	require.True(t, priv.Equals(priv))
+	require.True(t, priv.PubKey().Equals(priv.PubKey()))
+	require.False(t, priv.PubKey().Equals(secp256r1.GenPrivKey().PubKey()))
+	require.False(t, priv.PubKey().Equals(secp256k1.GenPrivKey().PubKey()))
+	require.Len(t, priv.PubKey().Address(), 20)
+}
+
+func TestAminoEncoding(t *testing.T) {
+	priv := secp256r1.GenPrivKey()
+	pub := priv.PubKey()
+
+	// the keys are decoded by the Tendermint crypto codec
+	decodedPub, err := cryptoamino.PubKeyFromBytes(pub.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, pub, decodedPub)
+
+	decodedPriv, err := cryptoamino.PrivKeyFromBytes(priv.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, priv, decodedPriv)
+
+	// and by the SDK codecs
+	var pk crypto.PubKey
+	require.NoError(t, codec.Cdc.UnmarshalBinaryBare(pub.Bytes(), &pk))
+	require.Equal(t, pub, pk)
+
+	bech32Pub, err := sdk.Bech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, pub)
+	require.NoError(t, err)
+
+	pk, err = sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeAccPub, bech32Pub)
+	require.NoError(t, err)
+	require.Equal(t, pub, pk)
+}
@@ -0,0 +1,25 @@
+// Package fuzz defines the fuzzing entry points of the decode paths exposed to
+// untrusted input: the decoding of the txs and msgs received by the nodes,
+// the parsing of Bech32 addresses and public keys, of decimals and integers,
+// and of the Merkle proofs of IBC.
+//
+// The entry points follow the go-fuzz convention: they take the input as bytes
+// and return 1 if it was decoded, so that the fuzzer gives it priority in the
+// corpus, and 0 otherwise. They panic when decoding breaks one of the
+// invariants they check, e.g. when a value doesn't round trip through its
+// string representation.
+//
+// An entry point is fuzzed with go-fuzz, e.g. the tx decoder:
+//
+//	go-fuzz-build -func FuzzTxDecoder github.com/cosmos/cosmos-sdk/fuzz
+//	go-fuzz -bin fuzz-fuzz.zip -workdir /tmp/fuzz/txdecoder
+package fuzz
+
+import (
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/std"
+)
+
+// cdc is the codec of the simulation app, which registers the txs, msgs and
+// proofs of all its modules.
+var cdc = std.MakeCodec(simapp.ModuleBasics)
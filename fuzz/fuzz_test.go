@@ -0,0 +1,86 @@
+package fuzz
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+)
+
+// requireFuzz requires the entry point to return the expected result on each
+// input without panicking.
+func requireFuzz(t *testing.T, fuzz func([]byte) int, expected int, inputs ...[]byte) {
+	for _, input := range inputs {
+		require.NotPanics(t, func() {
+			require.Equal(t, expected, fuzz(input), "%q", input)
+		}, "%q", input)
+	}
+}
+
+func TestFuzzTx(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	var msg sdk.Msg = banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+
+	stdTx := authtypes.NewStdTx([]sdk.Msg{msg}, authtypes.NewStdFee(200000, nil), nil, "memo")
+	sig, err := priv.Sign(authtypes.StdSignBytes("chain", 0, 0, stdTx.Fee, stdTx.Msgs, stdTx.Memo))
+	require.NoError(t, err)
+	signedTx := authtypes.NewStdTx(stdTx.Msgs, stdTx.Fee, []authtypes.StdSignature{{PubKey: priv.PubKey().Bytes(), Signature: sig}}, stdTx.Memo)
+
+	requireFuzz(t, FuzzTxDecoder, 1, cdc.MustMarshalBinaryBare(stdTx), cdc.MustMarshalBinaryBare(signedTx))
+	requireFuzz(t, FuzzTxDecoder, 0, nil, []byte("not a tx"))
+
+	requireFuzz(t, FuzzTxJSONDecoder, 1, cdc.MustMarshalJSON(stdTx), cdc.MustMarshalJSON(signedTx))
+	requireFuzz(t, FuzzTxJSONDecoder, 0, nil, []byte("{"))
+
+	requireFuzz(t, FuzzMsgDecoder, 1, cdc.MustMarshalBinaryBare(&msg))
+	requireFuzz(t, FuzzMsgDecoder, 0, nil, []byte("not a msg"))
+}
+
+func TestFuzzBech32(t *testing.T) {
+	pk := secp256k1.GenPrivKey().PubKey()
+	addr := pk.Address()
+
+	requireFuzz(t, FuzzBech32, 1,
+		[]byte(sdk.AccAddress(addr).String()),
+		[]byte(sdk.ValAddress(addr).String()),
+		[]byte(sdk.ConsAddress(addr).String()),
+		[]byte(sdk.MustBech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, pk)),
+	)
+	requireFuzz(t, FuzzBech32, 0, nil, []byte(" "), []byte("cosmos1"), []byte("not an address"))
+}
+
+func TestFuzzDecAndInt(t *testing.T) {
+	requireFuzz(t, FuzzDec, 1, []byte("0"), []byte("-1.5"), []byte("0.5"), []byte("123456789.123456789012345678"))
+	requireFuzz(t, FuzzDec, 0, nil, []byte("1.2.3"), []byte("1.1234567890123456789"), []byte("one"))
+
+	requireFuzz(t, FuzzInt, 1, []byte("0"), []byte("-10"), []byte("57896044618658097711785492504343953926634992332820282019728792003956564819967"))
+	requireFuzz(t, FuzzInt, 0, nil, []byte("1.5"), []byte("ten"),
+		[]byte("57896044618658097711785492504343953926634992332820282019728792003956564819968"))
+}
+
+func TestFuzzMerkleProof(t *testing.T) {
+	store := rootmulti.NewStore(dbm.NewMemDB())
+	storeKey := storetypes.NewKVStoreKey("ibc")
+	store.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, store.LoadVersion(0))
+
+	store.GetCommitStore(storeKey).(*iavl.Store).Set([]byte("key"), []byte("value"))
+	store.Commit()
+
+	res := store.Query(abci.RequestQuery{Path: "/ibc/key", Data: []byte("key"), Prove: true})
+	require.NotNil(t, res.Proof)
+
+	requireFuzz(t, FuzzMerkleProof, 1, cdc.MustMarshalBinaryBare(commitmenttypes.MerkleProof{Proof: res.Proof}))
+	requireFuzz(t, FuzzMerkleProof, 0, nil, cdc.MustMarshalBinaryBare(commitmenttypes.MerkleProof{}), []byte("not a proof"))
+}
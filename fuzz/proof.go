@@ -0,0 +1,34 @@
+package fuzz
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+)
+
+var (
+	proofRoot = commitmenttypes.NewMerkleRoot([]byte("root"))
+	proofPath = commitmenttypes.NewMerklePath([]string{"ibc", "key"})
+)
+
+// FuzzMerkleProof fuzzes the decoding of the Merkle proofs of the IBC msgs,
+// then the decoding and execution of their proof operators by the proof
+// runtime of the multistore.
+func FuzzMerkleProof(data []byte) int {
+	var proof commitmenttypes.MerkleProof
+	if err := cdc.UnmarshalBinaryBare(data, &proof); err != nil {
+		return 0
+	}
+
+	if err := proof.ValidateBasic(); err != nil {
+		return 0
+	}
+
+	if _, err := rootmulti.DefaultProofRuntime().DecodeProof(proof.Proof); err != nil {
+		return 0
+	}
+
+	_ = proof.VerifyMembership(proofRoot, proofPath, []byte("value"))
+	_ = proof.VerifyNonMembership(proofRoot, proofPath)
+
+	return 1
+}
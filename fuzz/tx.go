@@ -0,0 +1,72 @@
+package fuzz
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// FuzzTxDecoder fuzzes the decoding of the binary encoded txs of CheckTx and
+// DeliverTx, then the checks run on them before the ante handler.
+func FuzzTxDecoder(data []byte) int {
+	tx, err := authtypes.DefaultTxDecoder(cdc)(data)
+	if err != nil {
+		return 0
+	}
+
+	validateTx(tx)
+	return 1
+}
+
+// FuzzTxJSONDecoder fuzzes the decoding of the JSON encoded txs broadcast and
+// signed by the REST API and the CLI.
+func FuzzTxJSONDecoder(data []byte) int {
+	var tx authtypes.StdTx
+	if err := cdc.UnmarshalJSON(data, &tx); err != nil {
+		return 0
+	}
+
+	validateTx(tx)
+	return 1
+}
+
+// FuzzMsgDecoder fuzzes the decoding of the binary encoded msgs, and the
+// methods run on the msgs by the ante handler.
+func FuzzMsgDecoder(data []byte) int {
+	var msg sdk.Msg
+	if err := cdc.UnmarshalBinaryBare(data, &msg); err != nil {
+		return 0
+	}
+
+	validateMsg(msg)
+	return 1
+}
+
+// validateTx runs the stateless checks of baseapp on the tx, and, if they pass,
+// gets its signers.
+func validateTx(tx sdk.Tx) {
+	if err := tx.ValidateBasic(); err != nil {
+		return
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		validateMsg(msg)
+	}
+
+	if stdTx, ok := tx.(authtypes.StdTx); ok {
+		_ = stdTx.GetSigners()
+		_ = stdTx.GetPubKeys()
+	}
+}
+
+// validateMsg runs the stateless checks of the msg, and, if they pass, gets its
+// signers and sign bytes.
+func validateMsg(msg sdk.Msg) {
+	if msg == nil || msg.ValidateBasic() != nil {
+		return
+	}
+
+	_ = msg.Route()
+	_ = msg.Type()
+	_ = msg.GetSigners()
+	_ = msg.GetSignBytes()
+}
@@ -0,0 +1,100 @@
+package fuzz
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FuzzBech32 fuzzes the parsing of the Bech32 account, validator and consensus
+// addresses and public keys, checking that the parsed addresses round trip.
+func FuzzBech32(data []byte) int {
+	str := string(data)
+
+	// blank strings are parsed to empty addresses
+	if len(strings.TrimSpace(str)) == 0 {
+		return 0
+	}
+
+	res := 0
+
+	if addr, err := sdk.AccAddressFromBech32(str); err == nil {
+		roundTripAddress(addr, func(s string) (sdk.Address, error) { return sdk.AccAddressFromBech32(s) })
+		res = 1
+	}
+
+	if addr, err := sdk.ValAddressFromBech32(str); err == nil {
+		roundTripAddress(addr, func(s string) (sdk.Address, error) { return sdk.ValAddressFromBech32(s) })
+		res = 1
+	}
+
+	if addr, err := sdk.ConsAddressFromBech32(str); err == nil {
+		roundTripAddress(addr, func(s string) (sdk.Address, error) { return sdk.ConsAddressFromBech32(s) })
+		res = 1
+	}
+
+	for _, pkt := range []sdk.Bech32PubKeyType{
+		sdk.Bech32PubKeyTypeAccPub, sdk.Bech32PubKeyTypeValPub, sdk.Bech32PubKeyTypeConsPub,
+	} {
+		if _, err := sdk.GetPubKeyFromBech32(pkt, str); err == nil {
+			res = 1
+		}
+	}
+
+	return res
+}
+
+// roundTripAddress panics if the Bech32 string of the address isn't parsed back
+// to the address.
+func roundTripAddress(addr sdk.Address, parse func(string) (sdk.Address, error)) {
+	parsed, err := parse(addr.String())
+	if err != nil {
+		panic(fmt.Sprintf("failed to parse the Bech32 string %s of the address %X: %s", addr, addr.Bytes(), err))
+	}
+
+	if !bytes.Equal(parsed.Bytes(), addr.Bytes()) {
+		panic(fmt.Sprintf("the Bech32 string %s of the address %X is parsed to %X", addr, addr.Bytes(), parsed.Bytes()))
+	}
+}
+
+// FuzzDec fuzzes the parsing of decimals, checking that the parsed decimals
+// round trip.
+func FuzzDec(data []byte) int {
+	dec, err := sdk.NewDecFromStr(string(data))
+	if err != nil {
+		return 0
+	}
+
+	parsed, err := sdk.NewDecFromStr(dec.String())
+	if err != nil {
+		panic(fmt.Sprintf("failed to parse the string %s of the decimal parsed from %q: %s", dec, data, err))
+	}
+
+	if !parsed.Equal(dec) {
+		panic(fmt.Sprintf("the string %s of the decimal parsed from %q is parsed to %s", dec, data, parsed))
+	}
+
+	return 1
+}
+
+// FuzzInt fuzzes the parsing of integers, checking that the parsed integers
+// round trip.
+func FuzzInt(data []byte) int {
+	i, ok := sdk.NewIntFromString(string(data))
+	if !ok {
+		return 0
+	}
+
+	parsed, ok := sdk.NewIntFromString(i.String())
+	if !ok {
+		panic(fmt.Sprintf("failed to parse the string %s of the integer parsed from %q", i, data))
+	}
+
+	if !parsed.Equal(i) {
+		panic(fmt.Sprintf("the string %s of the integer parsed from %q is parsed to %s", i, data, parsed))
+	}
+
+	return 1
+}
@@ -12,7 +12,9 @@ require (
 	github.com/golang/protobuf v1.4.0
 	github.com/gorilla/handlers v1.4.2
 	github.com/gorilla/mux v1.7.4
+	github.com/gorilla/websocket v1.4.2
 	github.com/hashicorp/golang-lru v0.5.4
+	github.com/kilic/bls12-381 v0.1.0
 	github.com/mattn/go-isatty v0.0.12
 	github.com/otiai10/copy v1.1.1
 	github.com/pelletier/go-toml v1.7.0
@@ -31,6 +33,8 @@ require (
 	github.com/tendermint/iavl v0.13.3
 	github.com/tendermint/tendermint v0.33.4
 	github.com/tendermint/tm-db v0.5.1
+	golang.org/x/text v0.3.2
+	google.golang.org/grpc v1.28.1
 	gopkg.in/yaml.v2 v2.2.8
 )
 
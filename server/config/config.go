@@ -35,6 +35,10 @@ type BaseConfig struct {
 	// InterBlockCache enables inter-block caching.
 	InterBlockCache bool `mapstructure:"inter-block-cache"`
 
+	// PprofListenAddress is the address at which the node serves the pprof
+	// endpoints, which are disabled if it is empty.
+	PprofListenAddress string `mapstructure:"pprof-laddr"`
+
 	Pruning              string `mapstructure:"pruning"`
 	PruningKeepEvery     string `mapstructure:"pruning-keep-every"`
 	PruningSnapshotEvery string `mapstructure:"pruning-snapshot-every"`
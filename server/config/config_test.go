@@ -11,6 +11,7 @@ import (
 func TestDefaultConfig(t *testing.T) {
 	cfg := DefaultConfig()
 	require.True(t, cfg.GetMinGasPrices().IsZero())
+	require.Empty(t, cfg.PprofListenAddress)
 }
 
 func TestSetMinimumFees(t *testing.T) {
@@ -34,6 +34,12 @@ halt-time = {{ .BaseConfig.HaltTime }}
 # InterBlockCache enables inter-block caching.
 inter-block-cache = {{ .BaseConfig.InterBlockCache }}
 
+# PprofListenAddress is the address at which the node serves the pprof endpoints
+# (e.g. "localhost:6061"), which are disabled if it is empty. Unlike the
+# prof_laddr of config.toml, they are also served when the node runs without
+# Tendermint in process.
+pprof-laddr = "{{ .BaseConfig.PprofListenAddress }}"
+
 # Pruning sets the pruning strategy: syncable, nothing, everything, custom
 # syncable: only those states not needed for state syncing will be deleted (keeps last 100 + every 10000th)
 # nothing: all historic states will be saved, nothing will be deleted (i.e. archiving node)
@@ -4,6 +4,9 @@ package server
 
 import (
 	"fmt"
+	"net"
+	"net/http"
+	httppprof "net/http/pprof"
 	"os"
 	"runtime/pprof"
 
@@ -27,6 +30,7 @@ const (
 	flagPruningKeepEvery     = "pruning-keep-every"
 	flagPruningSnapshotEvery = "pruning-snapshot-every"
 	flagCPUProfile           = "cpu-profile"
+	flagPprofListenAddress   = "pprof-laddr"
 	FlagMinGasPrices         = "minimum-gas-prices"
 	FlagHaltHeight           = "halt-height"
 	FlagHaltTime             = "halt-time"
@@ -58,7 +62,8 @@ node will attempt to gracefully shutdown and the block will not be committed. In
 will not be able to commit subsequent blocks.
 
 For profiling and benchmarking purposes, CPU profiling can be enabled via the '--cpu-profile' flag
-which accepts a path for the resulting pprof file.
+which accepts a path for the resulting pprof file. The pprof endpoints can be served by the node at the
+address set by the '--pprof-laddr' flag or the 'pprof-laddr' option of app.toml.
 `,
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			_, err := GetPruningOptionsFromFlags()
@@ -93,6 +98,7 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Uint64(FlagHaltTime, 0, "Minimum block time (in Unix seconds) at which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().Bool(FlagInterBlockCache, true, "Enable inter-block caching")
 	cmd.Flags().String(flagCPUProfile, "", "Enable CPU profiling and write to the provided file")
+	cmd.Flags().String(flagPprofListenAddress, "", "Serve the pprof endpoints at the provided address (e.g. localhost:6061)")
 
 	viper.BindPFlag(flagPruning, cmd.Flags().Lookup(flagPruning))
 	viper.BindPFlag(flagPruningKeepEvery, cmd.Flags().Lookup(flagPruningKeepEvery))
@@ -126,6 +132,11 @@ func startStandAlone(ctx *Context, appCreator AppCreator) error {
 
 	svr.SetLogger(ctx.Logger.With("module", "abci-server"))
 
+	pprofServer, err := startPprofServer(ctx, viper.GetString(flagPprofListenAddress))
+	if err != nil {
+		return err
+	}
+
 	err = svr.Start()
 	if err != nil {
 		tmos.Exit(err.Error())
@@ -133,6 +144,10 @@ func startStandAlone(ctx *Context, appCreator AppCreator) error {
 
 	tmos.TrapSignal(ctx.Logger, func() {
 		// cleanup
+		if pprofServer != nil {
+			_ = pprofServer.Close()
+		}
+
 		err = svr.Stop()
 		if err != nil {
 			tmos.Exit(err.Error())
@@ -204,11 +219,20 @@ func startInProcess(ctx *Context, appCreator AppCreator) error {
 		}
 	}
 
+	pprofServer, err := startPprofServer(ctx, viper.GetString(flagPprofListenAddress))
+	if err != nil {
+		return err
+	}
+
 	TrapSignal(func() {
 		if tmNode.IsRunning() {
 			_ = tmNode.Stop()
 		}
 
+		if pprofServer != nil {
+			_ = pprofServer.Close()
+		}
+
 		if cpuProfileCleanup != nil {
 			cpuProfileCleanup()
 		}
@@ -219,3 +243,34 @@ func startInProcess(ctx *Context, appCreator AppCreator) error {
 	// run forever (the node will not be returned)
 	select {}
 }
+
+// startPprofServer serves the pprof endpoints at the given address, returning
+// the server, or nil if the address is empty.
+func startPprofServer(ctx *Context, addr string) (*http.Server, error) {
+	if addr == "" {
+		return nil, nil
+	}
+
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen for the pprof endpoints at %s: %w", addr, err)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", httppprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", httppprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", httppprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", httppprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", httppprof.Trace)
+
+	pprofServer := &http.Server{Handler: mux}
+
+	ctx.Logger.Info("starting the pprof endpoints", "address", listener.Addr().String())
+	go func() {
+		if err := pprofServer.Serve(listener); err != nil && err != http.ErrServerClosed {
+			ctx.Logger.Error("the pprof endpoints stopped", "err", err)
+		}
+	}()
+
+	return pprofServer, nil
+}
@@ -2,6 +2,7 @@ package server
 
 import (
 	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/spf13/viper"
@@ -83,3 +84,29 @@ func TestPruningOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestStartPprofServer(t *testing.T) {
+	ctx := NewDefaultContext()
+
+	pprofServer, err := startPprofServer(ctx, "")
+	require.NoError(t, err)
+	require.Nil(t, pprofServer)
+
+	_, port, err := FreeTCPAddr()
+	require.NoError(t, err)
+	addr := "127.0.0.1:" + port
+
+	pprofServer, err = startPprofServer(ctx, addr)
+	require.NoError(t, err)
+	require.NotNil(t, pprofServer)
+	defer pprofServer.Close()
+
+	res, err := http.Get("http://" + addr + "/debug/pprof/cmdline")
+	require.NoError(t, err)
+	res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	// the address is already in use
+	_, err = startPprofServer(ctx, addr)
+	require.Error(t, err)
+}
@@ -3,12 +3,19 @@ package simapp
 import (
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/log"
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 )
@@ -36,13 +43,13 @@ func TestSimAppExport(t *testing.T) {
 	require.NoError(t, err, "ExportAppStateAndValidators should not have an error")
 }
 
-// ensure that black listed addresses are properly set in bank keeper
-func TestBlackListedAddrs(t *testing.T) {
+// ensure that blocked addresses are properly set in bank keeper
+func TestBlockedAddrs(t *testing.T) {
 	db := dbm.NewMemDB()
 	app := NewSimApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, DefaultNodeHome, 0)
 
 	for acc := range maccPerms {
-		require.Equal(t, !allowedReceivingModAcc[acc], app.BankKeeper.BlacklistedAddr(app.AccountKeeper.GetModuleAddress(acc)))
+		require.Equal(t, !allowedReceivingModAcc[acc], app.BankKeeper.BlockedAddr(app.AccountKeeper.GetModuleAddress(acc)))
 	}
 }
 
@@ -50,3 +57,62 @@ func TestGetMaccPerms(t *testing.T) {
 	dup := GetMaccPerms()
 	require.Equal(t, maccPerms, dup, "duplicated module account permissions differed from actual module account permissions")
 }
+
+func TestUpgradeWithoutStoredVersionMap(t *testing.T) {
+	db := dbm.NewMemDB()
+	app := NewSimApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, DefaultNodeHome, 0)
+
+	stateBytes, err := codec.MarshalJSONIndent(app.Codec(), NewDefaultGenesisState())
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{Validators: []abci.ValidatorUpdate{}, AppStateBytes: stateBytes})
+
+	// remove the stored module versions, as for a chain started before they
+	// were stored
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 10, Time: time.Now()})
+	store := prefix.NewStore(ctx.KVStore(app.GetKey(upgradetypes.StoreKey)), []byte{upgradetypes.VersionMapByte})
+	iterator := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	require.Empty(t, app.UpgradeKeeper.GetModuleVersionMap(ctx))
+
+	stakingParams := app.StakingKeeper.GetParams(ctx)
+	stakingParams.MaxValidators = 7
+	app.StakingKeeper.SetParams(ctx, stakingParams)
+
+	distrParams := app.DistrKeeper.GetParams(ctx)
+	distrParams.AutoCompoundBlockGasLimit = 5
+	app.DistrKeeper.SetParams(ctx, distrParams)
+
+	require.NoError(t, app.UpgradeKeeper.ScheduleUpgrade(ctx, upgradetypes.Plan{Name: "test", Height: 11}))
+	ctx = ctx.WithBlockHeight(11)
+
+	// the modules aren't all initialized again from the empty version map
+	app.UpgradeKeeper.SetUpgradeHandler("test", func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		require.Empty(t, fromVM)
+		return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+	})
+	require.Panics(t, func() { upgrade.BeginBlocker(app.UpgradeKeeper, ctx, abci.RequestBeginBlock{}) })
+	require.Equal(t, uint32(7), app.StakingKeeper.MaxValidators(ctx))
+
+	// the handler provides the versions before the upgrade, the distribution
+	// module being migrated from its first version
+	app.UpgradeKeeper.SetUpgradeHandler("test", func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		if len(fromVM) == 0 {
+			fromVM = app.mm.GetVersionMap()
+			fromVM[distrtypes.ModuleName] = 1
+		}
+
+		return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+	})
+	upgrade.BeginBlocker(app.UpgradeKeeper, ctx, abci.RequestBeginBlock{})
+
+	require.Equal(t, app.mm.GetVersionMap(), app.UpgradeKeeper.GetModuleVersionMap(ctx))
+	require.Equal(t, uint32(7), app.StakingKeeper.MaxValidators(ctx))
+	require.Equal(t, distrtypes.DefaultAutoCompoundBlockGasLimit, app.DistrKeeper.GetAutoCompoundBlockGasLimit(ctx))
+}
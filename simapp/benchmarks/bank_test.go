@@ -0,0 +1,22 @@
+package benchmarks
+
+import (
+	"testing"
+
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+)
+
+func BenchmarkBankSend(b *testing.B) {
+	app, priv := setup(b)
+
+	from := sdk.AccAddress(priv.PubKey().Address())
+	to := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	msg := bank.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+
+	txs := simapp.GenSequenceOfTxs([]sdk.Msg{msg}, []uint64{0}, []uint64{0}, b.N, priv)
+	benchmarkTxs(b, app, txs)
+}
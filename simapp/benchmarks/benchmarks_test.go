@@ -0,0 +1,96 @@
+package benchmarks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+)
+
+// txsPerBlock is the number of txs delivered in each block of the benchmarks.
+const txsPerBlock = 100
+
+// setup returns a simulation app with a genesis account funded in the bond
+// denom, and the key of the account, whose account number is 0.
+func setup(b *testing.B) (*simapp.SimApp, crypto.PrivKey) {
+	priv := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(priv.PubKey().Address())
+
+	app := simapp.SetupWithGenesisAccounts(
+		[]authexported.GenesisAccount{&auth.BaseAccount{Address: addr}},
+		bank.Balance{Address: addr, Coins: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000_000_000_000))},
+	)
+
+	// lift the gas limit of the blocks, which hold txsPerBlock txs, then end the
+	// block begun by the setup, so that the benchmarks begin their own
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: app.LastBlockHeight() + 1})
+
+	consensusParams := *simapp.DefaultConsensusParams
+	blockParams := *consensusParams.Block
+	blockParams.MaxGas = -1
+	consensusParams.Block = &blockParams
+	app.StoreConsensusParams(ctx, &consensusParams)
+
+	app.EndBlock(abci.RequestEndBlock{Height: ctx.BlockHeight()})
+	app.Commit()
+
+	return app, priv
+}
+
+// deliverBlock checks then delivers the txs in a block, requiring them to
+// succeed.
+func deliverBlock(b *testing.B, app *simapp.SimApp, txs []auth.StdTx) {
+	height := app.LastBlockHeight() + 1
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: height}})
+
+	for _, tx := range txs {
+		_, _, err := app.Check(tx)
+		require.NoError(b, err)
+
+		_, _, err = app.Deliver(tx)
+		require.NoError(b, err)
+	}
+
+	app.EndBlock(abci.RequestEndBlock{Height: height})
+	app.Commit()
+}
+
+// inBlock runs the function with the context of a block, whose writes are
+// committed.
+func inBlock(app *simapp.SimApp, f func(ctx sdk.Context)) {
+	header := abci.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	f(app.BaseApp.NewContext(false, header))
+
+	app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	app.Commit()
+}
+
+// benchmarkTxs delivers the txs in blocks of txsPerBlock txs, and reports the
+// tx throughput.
+func benchmarkTxs(b *testing.B, app *simapp.SimApp, txs []auth.StdTx) {
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	start := time.Now()
+	for i := 0; i < len(txs); i += txsPerBlock {
+		end := i + txsPerBlock
+		if end > len(txs) {
+			end = len(txs)
+		}
+
+		deliverBlock(b, app, txs[i:end])
+	}
+
+	b.ReportMetric(float64(len(txs))/time.Since(start).Seconds(), "txs/s")
+}
@@ -0,0 +1,24 @@
+/*
+Package benchmarks measures the tx throughput of the simulation app through
+the full baseapp path: the txs of the benchmarks are checked then delivered in
+blocks, which are begun, ended and committed as by Tendermint, so that the
+ante handler, the msg handlers, the begin and end blockers and the commits of
+the stores are all part of the measures.
+
+The benchmarks cover bank sends, staking delegations and the receipt of ICS-20
+transfer packets, whose proofs are verified against the consensus state of a
+counterparty chain. Along with the time per tx, they report the throughput in
+txs per second:
+
+	go test ./simapp/benchmarks -run=^$ -bench=. -benchtime=1000x
+
+Comparing their results between two releases, e.g. with benchstat, measures
+the performance regressions, which the CPU and memory profiles of the
+benchmarks help to locate:
+
+	go test ./simapp/benchmarks -run=^$ -bench=BankSend -cpuprofile cpu.out -memprofile mem.out
+
+A running node serves the same profiles at the pprof endpoints enabled by the
+pprof-laddr option of app.toml.
+*/
+package benchmarks
@@ -0,0 +1,156 @@
+package benchmarks
+
+import (
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	connectionexported "github.com/cosmos/cosmos-sdk/x/ibc/03-connection/exported"
+	connectiontypes "github.com/cosmos/cosmos-sdk/x/ibc/03-connection/types"
+	channelexported "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/exported"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
+	transfertypes "github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
+)
+
+// the identifiers of the client, connection and channel of the benchmarked app
+// to the counterparty chain, and of the channel of the counterparty chain
+const (
+	counterpartyChainID   = "counterparty"
+	clientID              = "counterpartyclient"
+	connectionID          = "counterpartyconnection"
+	channelID             = "counterpartychannel"
+	counterpartyChannelID = "benchmarkchannel"
+
+	trustingPeriod = 14 * 24 * time.Hour
+	ubdPeriod      = 21 * 24 * time.Hour
+	maxClockDrift  = 10 * time.Second
+)
+
+func BenchmarkIBCRecvPacket(b *testing.B) {
+	app, priv := setup(b)
+	relayer := sdk.AccAddress(priv.PubKey().Address())
+
+	// the counterparty chain sends the transfer packets back to the benchmarked
+	// app, which mints the vouchers of the packets to the relayer
+	denom := transfertypes.GetDenomPrefix(transfertypes.PortID, channelID) + sdk.DefaultBondDenom
+	data := transfertypes.NewFungibleTokenPacketData(
+		sdk.NewCoins(sdk.NewInt64Coin(denom, 1)), relayer.String(), relayer.String(),
+	).GetBytes()
+
+	packets := make([]channeltypes.Packet, b.N)
+	for i := range packets {
+		packets[i] = channeltypes.NewPacket(
+			data, uint64(i+1), transfertypes.PortID, counterpartyChannelID, transfertypes.PortID, channelID, math.MaxUint64, 0,
+		)
+	}
+
+	proofs, header, consensusState := commitPackets(b, packets)
+	openChannel(b, app, header, consensusState)
+
+	// each tx relays a packet
+	txs := make([]auth.StdTx, b.N)
+	for i, packet := range packets {
+		txs[i] = helpers.GenTx(
+			[]sdk.Msg{channeltypes.NewMsgPacket(packet, proofs[i], consensusState.Height, relayer)},
+			sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)}, helpers.DefaultGenTxGas, "",
+			[]uint64{0}, []uint64{uint64(i)}, priv,
+		)
+	}
+
+	benchmarkTxs(b, app, txs)
+
+	// the transfers of the packets succeeded
+	ctx := app.BaseApp.NewContext(true, abci.Header{})
+	require.Equal(b, int64(b.N), app.BankKeeper.GetBalance(ctx, relayer, denom).Amount.Int64())
+}
+
+// commitPackets commits the packets on a counterparty chain, returning their
+// proofs at the height of the commit, and the header and consensus state of the
+// chain at this height.
+func commitPackets(
+	b *testing.B, packets []channeltypes.Packet,
+) ([]commitmenttypes.MerkleProof, ibctmtypes.Header, ibctmtypes.ConsensusState) {
+	// the proofs can't be queried at the height of the genesis
+	counterparty := simapp.Setup(false)
+	counterparty.Commit()
+
+	inBlock(counterparty, func(ctx sdk.Context) {
+		for _, packet := range packets {
+			counterparty.IBCKeeper.ChannelKeeper.SetPacketCommitment(
+				ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence, channeltypes.CommitPacket(packet),
+			)
+		}
+	})
+
+	proofs := make([]commitmenttypes.MerkleProof, len(packets))
+	for i, packet := range packets {
+		res := counterparty.Query(abci.RequestQuery{
+			Path:  fmt.Sprintf("store/%s/key", ibctypes.StoreKey),
+			Data:  []byte(ibctypes.PacketCommitmentPath(packet.SourcePort, packet.SourceChannel, packet.Sequence)),
+			Prove: true,
+		})
+		require.NotNil(b, res.Proof, res.Log)
+
+		proofs[i] = commitmenttypes.MerkleProof{Proof: res.Proof}
+	}
+
+	privVal := tmtypes.NewMockPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(b, err)
+
+	header := ibctmtypes.CreateTestHeader(
+		counterpartyChainID, counterparty.LastBlockHeight(), time.Now().UTC(),
+		tmtypes.NewValidatorSet([]*tmtypes.Validator{tmtypes.NewValidator(pubKey, 1)}),
+		[]tmtypes.PrivValidator{privVal},
+	)
+
+	consensusState := header.ConsensusState()
+	consensusState.Root = commitmenttypes.NewMerkleRoot(counterparty.LastCommitID().Hash)
+
+	return proofs, header, consensusState
+}
+
+// openChannel creates the client of the counterparty chain at its header and
+// consensus state, and the open connection and channel to the chain, the
+// channel being owned by the transfer module.
+func openChannel(b *testing.B, app *simapp.SimApp, header ibctmtypes.Header, consensusState ibctmtypes.ConsensusState) {
+	inBlock(app, func(ctx sdk.Context) {
+		clientState, err := ibctmtypes.Initialize(clientID, trustingPeriod, ubdPeriod, maxClockDrift, header)
+		require.NoError(b, err)
+
+		_, err = app.IBCKeeper.ClientKeeper.CreateClient(ctx, clientState, consensusState)
+		require.NoError(b, err)
+
+		app.IBCKeeper.ConnectionKeeper.SetConnection(ctx, connectionID, connectiontypes.ConnectionEnd{
+			State:    connectionexported.OPEN,
+			ClientID: clientID,
+			Counterparty: connectiontypes.NewCounterparty(
+				clientID, connectionID, app.IBCKeeper.ConnectionKeeper.GetCommitmentPrefix(),
+			),
+			Versions: connectiontypes.GetCompatibleVersions(),
+		})
+
+		app.IBCKeeper.ChannelKeeper.SetChannel(ctx, transfertypes.PortID, channelID, channeltypes.NewChannel(
+			channelexported.OPEN, channelexported.UNORDERED,
+			channeltypes.NewCounterparty(transfertypes.PortID, counterpartyChannelID),
+			[]string{connectionID}, transfertypes.Version,
+		))
+
+		capName := ibctypes.ChannelCapabilityPath(transfertypes.PortID, channelID)
+		chanCap, err := app.ScopedIBCKeeper.NewCapability(ctx, capName)
+		require.NoError(b, err)
+		require.NoError(b, app.ScopedTransferKeeper.ClaimCapability(ctx, chanCap, capName))
+	})
+}
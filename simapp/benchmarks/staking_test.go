@@ -0,0 +1,33 @@
+package benchmarks
+
+import (
+	"testing"
+
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+func BenchmarkStakingDelegate(b *testing.B) {
+	app, priv := setup(b)
+
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	valAddr := sdk.ValAddress(addr)
+
+	// the account creates the validator it delegates to
+	createValidator := staking.NewMsgCreateValidator(
+		valAddr, ed25519.GenPrivKey().PubKey(),
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)),
+		staking.NewDescription("validator", "", "", "", ""),
+		staking.NewCommissionRates(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 2)),
+		sdk.OneInt(),
+	)
+	deliverBlock(b, app, simapp.GenSequenceOfTxs([]sdk.Msg{createValidator}, []uint64{0}, []uint64{0}, 1, priv))
+
+	delegate := staking.NewMsgDelegate(addr, valAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))
+
+	txs := simapp.GenSequenceOfTxs([]sdk.Msg{delegate}, []uint64{0}, []uint64{1}, b.N, priv)
+	benchmarkTxs(b, app, txs)
+}
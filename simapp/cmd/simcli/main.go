@@ -69,7 +69,7 @@ func main() {
 		flags.LineBreak,
 		lcd.ServeCommand(cdc, registerRoutes),
 		flags.LineBreak,
-		keys.Commands(),
+		keysCmd(cdc),
 		flags.LineBreak,
 		flags.NewCompletionCmd(rootCmd, true),
 	)
@@ -77,13 +77,26 @@ func main() {
 	// Add flags and prefix all env exposed with GA
 	executor := cli.PrepareMainCmd(rootCmd, "GA", simapp.DefaultCLIHome)
 
-	err := executor.Execute()
+	err := client.Execute(executor)
 	if err != nil {
 		fmt.Printf("Failed executing CLI command: %s, exiting...\n", err)
 		os.Exit(1)
 	}
 }
 
+func keysCmd(cdc *amino.Codec) *cobra.Command {
+	keysCmd := keys.Commands()
+	keysCmd.AddCommand(
+		flags.LineBreak,
+		authcmd.GetSignArbitraryCommand(cdc),
+		authcmd.GetVerifyArbitraryCommand(cdc),
+		authcmd.GetSignFileCommand(cdc),
+		authcmd.GetVerifyFileCommand(cdc),
+	)
+
+	return keysCmd
+}
+
 func queryCmd(cdc *amino.Codec) *cobra.Command {
 	queryCmd := &cobra.Command{
 		Use:                        "query",
@@ -99,8 +112,11 @@ func queryCmd(cdc *amino.Codec) *cobra.Command {
 		flags.LineBreak,
 		rpc.ValidatorCommand(cdc),
 		rpc.BlockCommand(),
+		rpc.BlockByHashCommand(),
+		rpc.BlockResultsCommand(cdc),
 		authcmd.QueryTxsByEventsCmd(cdc),
 		authcmd.QueryTxCmd(cdc),
+		authcmd.WaitTxCmd(cdc),
 		flags.LineBreak,
 	)
 
@@ -124,6 +140,7 @@ func txCmd(cdc *amino.Codec) *cobra.Command {
 		flags.LineBreak,
 		authcmd.GetSignCommand(cdc),
 		authcmd.GetMultiSignCommand(cdc),
+		authcmd.GetMultiSignBatchCommand(cdc),
 		flags.LineBreak,
 		authcmd.GetBroadcastCommand(cdc),
 		authcmd.GetEncodeCommand(cdc),
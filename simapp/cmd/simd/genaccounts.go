@@ -2,8 +2,10 @@ package main
 
 import (
 	"bufio"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
 
 	"github.com/cosmos/cosmos-sdk/std"
 
@@ -25,12 +27,46 @@ import (
 )
 
 const (
-	flagClientHome   = "home-client"
-	flagVestingStart = "vesting-start-time"
-	flagVestingEnd   = "vesting-end-time"
-	flagVestingAmt   = "vesting-amount"
+	flagClientHome     = "home-client"
+	flagVestingStart   = "vesting-start-time"
+	flagVestingEnd     = "vesting-end-time"
+	flagVestingAmt     = "vesting-amount"
+	flagVestingPeriods = "vesting-periods"
 )
 
+// vestingPeriodInput defines a single vesting period as read from the file
+// supplied via the --vesting-periods flag.
+type vestingPeriodInput struct {
+	Length int64  `json:"length"`
+	Amount string `json:"amount"`
+}
+
+// parseVestingPeriods reads a JSON list of vesting periods, each having a
+// length in seconds and a comma-separated list of coins, from the given file.
+func parseVestingPeriods(path string) (authvesting.Periods, error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var inputs []vestingPeriodInput
+	if err := json.Unmarshal(bz, &inputs); err != nil {
+		return nil, err
+	}
+
+	periods := make(authvesting.Periods, len(inputs))
+	for i, input := range inputs {
+		amount, err := sdk.ParseCoins(input.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse amount of vesting period %d: %w", i, err)
+		}
+
+		periods[i] = authvesting.NewPeriod(input.Length, amount)
+	}
+
+	return periods, nil
+}
+
 // AddGenesisAccountCmd returns add-genesis-account cobra Command.
 func AddGenesisAccountCmd(
 	ctx *server.Context, depCdc *amino.Codec, cdc *std.Codec, defaultNodeHome, defaultClientHome string,
@@ -43,6 +79,12 @@ func AddGenesisAccountCmd(
 the account address or key name and a list of initial coins. If a key name is given,
 the address will be looked up in the local Keybase. The list of initial tokens must
 contain valid denominations. Accounts may optionally be supplied with vesting parameters.
+
+A periodic vesting account is created when --vesting-periods is given a JSON file
+containing a list of periods, each with a length in seconds and an amount of coins,
+e.g. [{"length": 2592000, "amount": "100stake"}]. The schedule starts at
+--vesting-start-time, which cannot be before the genesis time, and the vesting
+amount is the sum of all period amounts.
 `,
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -83,6 +125,22 @@ contain valid denominations. Accounts may optionally be supplied with vesting pa
 				return fmt.Errorf("failed to parse vesting amount: %w", err)
 			}
 
+			var vestingPeriods authvesting.Periods
+			if periodsFile := viper.GetString(flagVestingPeriods); periodsFile != "" {
+				vestingPeriods, err = parseVestingPeriods(periodsFile)
+				if err != nil {
+					return fmt.Errorf("failed to parse vesting periods: %w", err)
+				}
+
+				periodsAmt := vestingPeriods.TotalAmount()
+				if !vestingAmt.IsZero() && !vestingAmt.IsEqual(periodsAmt) {
+					return errors.New("vesting amount must equal the sum of all vesting period amounts")
+				}
+
+				vestingAmt = periodsAmt
+				vestingEnd = vestingStart + vestingPeriods.TotalLength()
+			}
+
 			// create concrete account type based on input parameters
 			var genAccount authexported.GenesisAccount
 
@@ -97,6 +155,9 @@ contain valid denominations. Accounts may optionally be supplied with vesting pa
 				}
 
 				switch {
+				case len(vestingPeriods) > 0:
+					genAccount = authvesting.NewPeriodicVestingAccountRaw(baseVestingAccount, vestingStart, vestingPeriods)
+
 				case vestingStart != 0 && vestingEnd != 0:
 					genAccount = authvesting.NewContinuousVestingAccountRaw(baseVestingAccount, vestingStart)
 
@@ -120,6 +181,14 @@ contain valid denominations. Accounts may optionally be supplied with vesting pa
 				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
 			}
 
+			// the periods would otherwise start vesting before the chain does,
+			// e.g. at the unix epoch if the start time is not set
+			if len(vestingPeriods) > 0 && vestingStart < genDoc.GenesisTime.Unix() {
+				return fmt.Errorf(
+					"vesting start time %d is before the genesis time %d", vestingStart, genDoc.GenesisTime.Unix(),
+				)
+			}
+
 			authGenState := auth.GetGenesisStateFromAppState(cdc, appState)
 
 			if authGenState.Accounts.Contains(addr) {
@@ -165,6 +234,7 @@ contain valid denominations. Accounts may optionally be supplied with vesting pa
 	cmd.Flags().String(flagVestingAmt, "", "amount of coins for vesting accounts")
 	cmd.Flags().Uint64(flagVestingStart, 0, "schedule start time (unix epoch) for vesting accounts")
 	cmd.Flags().Uint64(flagVestingEnd, 0, "schedule end time (unix epoch) for vesting accounts")
+	cmd.Flags().String(flagVestingPeriods, "", "path to a JSON file of vesting periods for periodic vesting accounts")
 
 	return cmd
 }
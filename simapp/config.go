@@ -27,6 +27,9 @@ var (
 	FlagVerboseValue     bool
 	FlagPeriodValue      uint
 	FlagGenesisTimeValue int64
+
+	FlagNumSeedsValue          int
+	FlagTimesToRunPerSeedValue int
 )
 
 // GetSimulatorFlags gets the values of all the available simulation flags
@@ -52,6 +55,10 @@ func GetSimulatorFlags() {
 	flag.BoolVar(&FlagVerboseValue, "Verbose", false, "verbose log output")
 	flag.UintVar(&FlagPeriodValue, "Period", 0, "run slow invariants only once every period assertions")
 	flag.Int64Var(&FlagGenesisTimeValue, "GenesisTime", 0, "override genesis UNIX time instead of using a random UNIX time")
+
+	// determinism flags
+	flag.IntVar(&FlagNumSeedsValue, "NumSeeds", 3, "number of random seeds of the non-determinism simulation")
+	flag.IntVar(&FlagTimesToRunPerSeedValue, "TimesToRunPerSeed", 5, "number of times the non-determinism simulation is run per seed")
 }
 
 // NewConfigFromFlags creates a simulation from the retrieved values of the flags.
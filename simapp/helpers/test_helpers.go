@@ -15,6 +15,12 @@ import (
 const (
 	DefaultGenTxGas = 1000000
 	SimAppChainID   = "simulation-app"
+
+	// SimTxGas is the gas of the transactions of the simulation operations. The
+	// fee payers, balances and rewards of a simulation accumulate the share token
+	// denoms of the tokenized delegations, which are charged for in every read
+	// and write of their coins.
+	SimTxGas = 10 * DefaultGenTxGas
 )
 
 // GenTx generates a signed mock transaction.
@@ -254,9 +254,10 @@ func TestAppStateDeterminism(t *testing.T) {
 	config.AllInvariants = false
 	config.ChainID = helpers.SimAppChainID
 
-	numSeeds := 3
-	numTimesToRunPerSeed := 5
+	numSeeds := FlagNumSeedsValue
+	numTimesToRunPerSeed := FlagTimesToRunPerSeedValue
 	appHashList := make([]json.RawMessage, numTimesToRunPerSeed)
+	appStateList := make([]json.RawMessage, numTimesToRunPerSeed)
 
 	for i := 0; i < numSeeds; i++ {
 		config.Seed = rand.Int63()
@@ -291,11 +292,22 @@ func TestAppStateDeterminism(t *testing.T) {
 			appHash := app.LastCommitID().Hash
 			appHashList[j] = appHash
 
+			// the exported genesis must be deterministic as well, for the chains
+			// restarted from it to agree on their state
+			appState, _, _, err := app.ExportAppStateAndValidators(false, []string{})
+			require.NoError(t, err)
+			appStateList[j] = appState
+
 			if j != 0 {
 				require.Equal(
 					t, string(appHashList[0]), string(appHashList[j]),
 					"non-determinism in seed %d: %d/%d, attempt: %d/%d\n", config.Seed, i+1, numSeeds, j+1, numTimesToRunPerSeed,
 				)
+				require.Equal(
+					t, string(appStateList[0]), string(appStateList[j]),
+					"non-determinism of the exported app state in seed %d: %d/%d, attempt: %d/%d\n",
+					config.Seed, i+1, numSeeds, j+1, numTimesToRunPerSeed,
+				)
 			}
 		}
 	}
@@ -18,6 +18,7 @@ import (
 	bam "github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
@@ -85,7 +86,7 @@ func SetupWithGenesisAccounts(genAccs []authexported.GenesisAccount, balances ..
 		totalSupply = totalSupply.Add(b.Coins...)
 	}
 
-	bankGenesis := bank.NewGenesisState(bank.DefaultGenesisState().SendEnabled, balances, totalSupply)
+	bankGenesis := bank.NewGenesisState(bank.DefaultGenesisState().Params, balances, totalSupply, []bank.Metadata{})
 	genesisState[bank.ModuleName] = app.Codec().MustMarshalJSON(bankGenesis)
 
 	stateBytes, err := codec.MarshalJSONIndent(app.Codec(), genesisState)
@@ -142,6 +143,12 @@ func createIncrementalAccounts(accNum int) []sdk.AccAddress {
 	return addresses
 }
 
+// createDeterministicAccounts is a strategy used by addTestAddrs() in order to generate the addresses of the
+// deterministic test keys of the testutil package, which are stable across runs.
+func createDeterministicAccounts(accNum int) []sdk.AccAddress {
+	return testutil.Addrs(accNum)
+}
+
 // AddTestAddrsFromPubKeys adds the addresses into the SimApp providing only the public keys.
 func AddTestAddrsFromPubKeys(app *SimApp, ctx sdk.Context, pubKeys []crypto.PubKey, accAmt sdk.Int) {
 	initCoins := sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), accAmt))
@@ -173,6 +180,12 @@ func AddTestAddrsIncremental(app *SimApp, ctx sdk.Context, accNum int, accAmt sd
 	return addTestAddrs(app, ctx, accNum, accAmt, createIncrementalAccounts)
 }
 
+// AddTestAddrsDeterministic constructs and returns accNum amount of accounts with an
+// initial balance of accAmt, whose keys are the ones of testutil.NewKeyring(accNum)
+func AddTestAddrsDeterministic(app *SimApp, ctx sdk.Context, accNum int, accAmt sdk.Int) []sdk.AccAddress {
+	return addTestAddrs(app, ctx, accNum, accAmt, createDeterministicAccounts)
+}
+
 func addTestAddrs(app *SimApp, ctx sdk.Context, accNum int, accAmt sdk.Int, strategy GenerateAccountStrategy) []sdk.AccAddress {
 	testAddrs := strategy(accNum)
 
@@ -162,6 +162,12 @@ func (c *Codec) MarshalProposal(p gov.Proposal) ([]byte, error) {
 		return nil, err
 	}
 
+	messages, err := newMessages(p.Messages)
+	if err != nil {
+		return nil, err
+	}
+	proposal.Messages = messages
+
 	return c.Marshaler.MarshalBinaryBare(proposal)
 }
 
@@ -177,6 +183,7 @@ func (c *Codec) UnmarshalProposal(bz []byte) (gov.Proposal, error) {
 	return gov.Proposal{
 		Content:      proposal.Content.GetContent(),
 		ProposalBase: proposal.ProposalBase,
+		Messages:     getMsgs(proposal.Messages),
 	}, nil
 }
 
@@ -4,6 +4,7 @@
 package std
 
 import (
+	bytes "bytes"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types10 "github.com/cosmos/cosmos-sdk/types"
@@ -51,6 +52,7 @@ type Account struct {
 	//	*Account_DelayedVestingAccount
 	//	*Account_PeriodicVestingAccount
 	//	*Account_ModuleAccount
+	//	*Account_ClawbackVestingAccount
 	Sum isAccount_Sum `protobuf_oneof:"sum"`
 }
 
@@ -108,12 +110,16 @@ type Account_PeriodicVestingAccount struct {
 type Account_ModuleAccount struct {
 	ModuleAccount *types.ModuleAccount `protobuf:"bytes,5,opt,name=module_account,json=moduleAccount,proto3,oneof" json:"module_account,omitempty"`
 }
+type Account_ClawbackVestingAccount struct {
+	ClawbackVestingAccount *types1.ClawbackVestingAccount `protobuf:"bytes,6,opt,name=clawback_vesting_account,json=clawbackVestingAccount,proto3,oneof" json:"clawback_vesting_account,omitempty"`
+}
 
 func (*Account_BaseAccount) isAccount_Sum()              {}
 func (*Account_ContinuousVestingAccount) isAccount_Sum() {}
 func (*Account_DelayedVestingAccount) isAccount_Sum()    {}
 func (*Account_PeriodicVestingAccount) isAccount_Sum()   {}
 func (*Account_ModuleAccount) isAccount_Sum()            {}
+func (*Account_ClawbackVestingAccount) isAccount_Sum()   {}
 
 func (m *Account) GetSum() isAccount_Sum {
 	if m != nil {
@@ -157,6 +163,13 @@ func (m *Account) GetModuleAccount() *types.ModuleAccount {
 	return nil
 }
 
+func (m *Account) GetClawbackVestingAccount() *types1.ClawbackVestingAccount {
+	if x, ok := m.GetSum().(*Account_ClawbackVestingAccount); ok {
+		return x.ClawbackVestingAccount
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Account) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -165,6 +178,7 @@ func (*Account) XXX_OneofWrappers() []interface{} {
 		(*Account_DelayedVestingAccount)(nil),
 		(*Account_PeriodicVestingAccount)(nil),
 		(*Account_ModuleAccount)(nil),
+		(*Account_ClawbackVestingAccount)(nil),
 	}
 }
 
@@ -366,6 +380,9 @@ var xxx_messageInfo_MsgSubmitEvidence proto.InternalMessageInfo
 type MsgSubmitProposal struct {
 	types4.MsgSubmitProposalBase `protobuf:"bytes,1,opt,name=base,proto3,embedded=base" json:"base"`
 	Content                      *Content `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// messages defines the messages executed, with the governance module
+	// account as signer, once the proposal passes.
+	Messages []Message `protobuf:"bytes,3,rep,name=messages,proto3" json:"messages"`
 }
 
 func (m *MsgSubmitProposal) Reset()         { *m = MsgSubmitProposal{} }
@@ -405,7 +422,8 @@ var xxx_messageInfo_MsgSubmitProposal proto.InternalMessageInfo
 // proposals.
 type Proposal struct {
 	types4.ProposalBase `protobuf:"bytes,1,opt,name=base,proto3,embedded=base" json:"base"`
-	Content             Content `protobuf:"bytes,2,opt,name=content,proto3" json:"content"`
+	Content             Content   `protobuf:"bytes,2,opt,name=content,proto3" json:"content"`
+	Messages            []Message `protobuf:"bytes,3,rep,name=messages,proto3" json:"messages"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
@@ -448,6 +466,13 @@ func (m *Proposal) GetContent() Content {
 	return Content{}
 }
 
+func (m *Proposal) GetMessages() []Message {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
 // Content defines the application-level allowed Content to be included in a
 // governance proposal.
 type Content struct {
@@ -459,6 +484,7 @@ type Content struct {
 	//	*Content_SoftwareUpgrade
 	//	*Content_CancelSoftwareUpgrade
 	//	*Content_CommunityPoolSpend
+	//	*Content_SetSendEnabled
 	Sum isContent_Sum `protobuf_oneof:"sum"`
 }
 
@@ -517,12 +543,16 @@ type Content_CancelSoftwareUpgrade struct {
 type Content_CommunityPoolSpend struct {
 	CommunityPoolSpend *types6.CommunityPoolSpendProposal `protobuf:"bytes,5,opt,name=community_pool_spend,json=communityPoolSpend,proto3,oneof" json:"community_pool_spend,omitempty"`
 }
+type Content_SetSendEnabled struct {
+	SetSendEnabled *types2.SetSendEnabledProposal `protobuf:"bytes,6,opt,name=set_send_enabled,json=setSendEnabled,proto3,oneof" json:"set_send_enabled,omitempty"`
+}
 
 func (*Content_Text) isContent_Sum()                  {}
 func (*Content_ParameterChange) isContent_Sum()       {}
 func (*Content_SoftwareUpgrade) isContent_Sum()       {}
 func (*Content_CancelSoftwareUpgrade) isContent_Sum() {}
 func (*Content_CommunityPoolSpend) isContent_Sum()    {}
+func (*Content_SetSendEnabled) isContent_Sum()        {}
 
 func (m *Content) GetSum() isContent_Sum {
 	if m != nil {
@@ -566,6 +596,13 @@ func (m *Content) GetCommunityPoolSpend() *types6.CommunityPoolSpendProposal {
 	return nil
 }
 
+func (m *Content) GetSetSendEnabled() *types2.SetSendEnabledProposal {
+	if x, ok := m.GetSum().(*Content_SetSendEnabled); ok {
+		return x.SetSendEnabled
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Content) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -574,6 +611,7 @@ func (*Content) XXX_OneofWrappers() []interface{} {
 		(*Content_SoftwareUpgrade)(nil),
 		(*Content_CancelSoftwareUpgrade)(nil),
 		(*Content_CommunityPoolSpend)(nil),
+		(*Content_SetSendEnabled)(nil),
 	}
 }
 
@@ -641,6 +679,15 @@ type Message struct {
 	//	*Message_MsgDelegate
 	//	*Message_MsgBeginRedelegate
 	//	*Message_MsgUndelegate
+	//	*Message_MsgCreateVestingAccount
+	//	*Message_MsgCreateClawbackVestingAccount
+	//	*Message_MsgClawback
+	//	*Message_MsgChangePubKey
+	//	*Message_MsgCancelUnbondingDelegation
+	//	*Message_MsgTokenizeShares
+	//	*Message_MsgRedeemTokensForShares
+	//	*Message_MsgWithdrawAllRewards
+	//	*Message_MsgSetAutoCompound
 	Sum isMessage_Sum `protobuf_oneof:"sum"`
 }
 
@@ -679,6 +726,7 @@ var xxx_messageInfo_Message proto.InternalMessageInfo
 
 type isMessage_Sum interface {
 	isMessage_Sum()
+	Equal(interface{}) bool
 	MarshalTo([]byte) (int, error)
 	Size() int
 }
@@ -734,24 +782,60 @@ type Message_MsgBeginRedelegate struct {
 type Message_MsgUndelegate struct {
 	MsgUndelegate *types9.MsgUndelegate `protobuf:"bytes,17,opt,name=msg_undelegate,json=msgUndelegate,proto3,oneof" json:"msg_undelegate,omitempty"`
 }
-
-func (*Message_MsgSend) isMessage_Sum()                        {}
-func (*Message_MsgMultiSend) isMessage_Sum()                   {}
-func (*Message_MsgVerifyInvariant) isMessage_Sum()             {}
-func (*Message_MsgSetWithdrawAddress) isMessage_Sum()          {}
-func (*Message_MsgWithdrawDelegatorReward) isMessage_Sum()     {}
-func (*Message_MsgWithdrawValidatorCommission) isMessage_Sum() {}
-func (*Message_MsgFundCommunityPool) isMessage_Sum()           {}
-func (*Message_MsgSubmitEvidence) isMessage_Sum()              {}
-func (*Message_MsgSubmitProposal) isMessage_Sum()              {}
-func (*Message_MsgVote) isMessage_Sum()                        {}
-func (*Message_MsgDeposit) isMessage_Sum()                     {}
-func (*Message_MsgUnjail) isMessage_Sum()                      {}
-func (*Message_MsgCreateValidator) isMessage_Sum()             {}
-func (*Message_MsgEditValidator) isMessage_Sum()               {}
-func (*Message_MsgDelegate) isMessage_Sum()                    {}
-func (*Message_MsgBeginRedelegate) isMessage_Sum()             {}
-func (*Message_MsgUndelegate) isMessage_Sum()                  {}
+type Message_MsgCreateVestingAccount struct {
+	MsgCreateVestingAccount *types1.MsgCreateVestingAccount `protobuf:"bytes,18,opt,name=msg_create_vesting_account,json=msgCreateVestingAccount,proto3,oneof" json:"msg_create_vesting_account,omitempty"`
+}
+type Message_MsgCreateClawbackVestingAccount struct {
+	MsgCreateClawbackVestingAccount *types1.MsgCreateClawbackVestingAccount `protobuf:"bytes,19,opt,name=msg_create_clawback_vesting_account,json=msgCreateClawbackVestingAccount,proto3,oneof" json:"msg_create_clawback_vesting_account,omitempty"`
+}
+type Message_MsgClawback struct {
+	MsgClawback *types1.MsgClawback `protobuf:"bytes,20,opt,name=msg_clawback,json=msgClawback,proto3,oneof" json:"msg_clawback,omitempty"`
+}
+type Message_MsgChangePubKey struct {
+	MsgChangePubKey *types.MsgChangePubKey `protobuf:"bytes,21,opt,name=msg_change_pub_key,json=msgChangePubKey,proto3,oneof" json:"msg_change_pub_key,omitempty"`
+}
+type Message_MsgCancelUnbondingDelegation struct {
+	MsgCancelUnbondingDelegation *types9.MsgCancelUnbondingDelegation `protobuf:"bytes,22,opt,name=msg_cancel_unbonding_delegation,json=msgCancelUnbondingDelegation,proto3,oneof" json:"msg_cancel_unbonding_delegation,omitempty"`
+}
+type Message_MsgTokenizeShares struct {
+	MsgTokenizeShares *types9.MsgTokenizeShares `protobuf:"bytes,23,opt,name=msg_tokenize_shares,json=msgTokenizeShares,proto3,oneof" json:"msg_tokenize_shares,omitempty"`
+}
+type Message_MsgRedeemTokensForShares struct {
+	MsgRedeemTokensForShares *types9.MsgRedeemTokensForShares `protobuf:"bytes,24,opt,name=msg_redeem_tokens_for_shares,json=msgRedeemTokensForShares,proto3,oneof" json:"msg_redeem_tokens_for_shares,omitempty"`
+}
+type Message_MsgWithdrawAllRewards struct {
+	MsgWithdrawAllRewards *types6.MsgWithdrawAllRewards `protobuf:"bytes,25,opt,name=msg_withdraw_all_rewards,json=msgWithdrawAllRewards,proto3,oneof" json:"msg_withdraw_all_rewards,omitempty"`
+}
+type Message_MsgSetAutoCompound struct {
+	MsgSetAutoCompound *types6.MsgSetAutoCompound `protobuf:"bytes,26,opt,name=msg_set_auto_compound,json=msgSetAutoCompound,proto3,oneof" json:"msg_set_auto_compound,omitempty"`
+}
+
+func (*Message_MsgSend) isMessage_Sum()                         {}
+func (*Message_MsgMultiSend) isMessage_Sum()                    {}
+func (*Message_MsgVerifyInvariant) isMessage_Sum()              {}
+func (*Message_MsgSetWithdrawAddress) isMessage_Sum()           {}
+func (*Message_MsgWithdrawDelegatorReward) isMessage_Sum()      {}
+func (*Message_MsgWithdrawValidatorCommission) isMessage_Sum()  {}
+func (*Message_MsgFundCommunityPool) isMessage_Sum()            {}
+func (*Message_MsgSubmitEvidence) isMessage_Sum()               {}
+func (*Message_MsgSubmitProposal) isMessage_Sum()               {}
+func (*Message_MsgVote) isMessage_Sum()                         {}
+func (*Message_MsgDeposit) isMessage_Sum()                      {}
+func (*Message_MsgUnjail) isMessage_Sum()                       {}
+func (*Message_MsgCreateValidator) isMessage_Sum()              {}
+func (*Message_MsgEditValidator) isMessage_Sum()                {}
+func (*Message_MsgDelegate) isMessage_Sum()                     {}
+func (*Message_MsgBeginRedelegate) isMessage_Sum()              {}
+func (*Message_MsgUndelegate) isMessage_Sum()                   {}
+func (*Message_MsgCreateVestingAccount) isMessage_Sum()         {}
+func (*Message_MsgCreateClawbackVestingAccount) isMessage_Sum() {}
+func (*Message_MsgClawback) isMessage_Sum()                     {}
+func (*Message_MsgChangePubKey) isMessage_Sum()                 {}
+func (*Message_MsgCancelUnbondingDelegation) isMessage_Sum()    {}
+func (*Message_MsgTokenizeShares) isMessage_Sum()               {}
+func (*Message_MsgRedeemTokensForShares) isMessage_Sum()        {}
+func (*Message_MsgWithdrawAllRewards) isMessage_Sum()           {}
+func (*Message_MsgSetAutoCompound) isMessage_Sum()              {}
 
 func (m *Message) GetSum() isMessage_Sum {
 	if m != nil {
@@ -879,6 +963,69 @@ func (m *Message) GetMsgUndelegate() *types9.MsgUndelegate {
 	return nil
 }
 
+func (m *Message) GetMsgCreateVestingAccount() *types1.MsgCreateVestingAccount {
+	if x, ok := m.GetSum().(*Message_MsgCreateVestingAccount); ok {
+		return x.MsgCreateVestingAccount
+	}
+	return nil
+}
+
+func (m *Message) GetMsgCreateClawbackVestingAccount() *types1.MsgCreateClawbackVestingAccount {
+	if x, ok := m.GetSum().(*Message_MsgCreateClawbackVestingAccount); ok {
+		return x.MsgCreateClawbackVestingAccount
+	}
+	return nil
+}
+
+func (m *Message) GetMsgClawback() *types1.MsgClawback {
+	if x, ok := m.GetSum().(*Message_MsgClawback); ok {
+		return x.MsgClawback
+	}
+	return nil
+}
+
+func (m *Message) GetMsgChangePubKey() *types.MsgChangePubKey {
+	if x, ok := m.GetSum().(*Message_MsgChangePubKey); ok {
+		return x.MsgChangePubKey
+	}
+	return nil
+}
+
+func (m *Message) GetMsgCancelUnbondingDelegation() *types9.MsgCancelUnbondingDelegation {
+	if x, ok := m.GetSum().(*Message_MsgCancelUnbondingDelegation); ok {
+		return x.MsgCancelUnbondingDelegation
+	}
+	return nil
+}
+
+func (m *Message) GetMsgTokenizeShares() *types9.MsgTokenizeShares {
+	if x, ok := m.GetSum().(*Message_MsgTokenizeShares); ok {
+		return x.MsgTokenizeShares
+	}
+	return nil
+}
+
+func (m *Message) GetMsgRedeemTokensForShares() *types9.MsgRedeemTokensForShares {
+	if x, ok := m.GetSum().(*Message_MsgRedeemTokensForShares); ok {
+		return x.MsgRedeemTokensForShares
+	}
+	return nil
+}
+
+func (m *Message) GetMsgWithdrawAllRewards() *types6.MsgWithdrawAllRewards {
+	if x, ok := m.GetSum().(*Message_MsgWithdrawAllRewards); ok {
+		return x.MsgWithdrawAllRewards
+	}
+	return nil
+}
+
+func (m *Message) GetMsgSetAutoCompound() *types6.MsgSetAutoCompound {
+	if x, ok := m.GetSum().(*Message_MsgSetAutoCompound); ok {
+		return x.MsgSetAutoCompound
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Message) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -899,6 +1046,15 @@ func (*Message) XXX_OneofWrappers() []interface{} {
 		(*Message_MsgDelegate)(nil),
 		(*Message_MsgBeginRedelegate)(nil),
 		(*Message_MsgUndelegate)(nil),
+		(*Message_MsgCreateVestingAccount)(nil),
+		(*Message_MsgCreateClawbackVestingAccount)(nil),
+		(*Message_MsgClawback)(nil),
+		(*Message_MsgChangePubKey)(nil),
+		(*Message_MsgCancelUnbondingDelegation)(nil),
+		(*Message_MsgTokenizeShares)(nil),
+		(*Message_MsgRedeemTokensForShares)(nil),
+		(*Message_MsgWithdrawAllRewards)(nil),
+		(*Message_MsgSetAutoCompound)(nil),
 	}
 }
 
@@ -951,10 +1107,16 @@ func (m *SignDoc) GetMsgs() []Message {
 
 // StdFee includes the amount of coins paid in fees and the maximum
 // gas to be used by the transaction. The ratio yields an effective "gasprice",
-// which must be above some miminum to be accepted into the mempool.
+// which must be above some miminum to be accepted into the mempool. An optional
+// payer may be set to pay the fees instead of the first signer, and an optional
+// granter may be set to pay the fees out of a fee allowance granted to the payer.
+// An optional tip may be set to compensate the fee payer.
 type StdFee struct {
-	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
-	Gas    uint64                                   `protobuf:"varint,2,opt,name=gas,proto3" json:"gas,omitempty"`
+	Amount  github_com_cosmos_cosmos_sdk_types.Coins      `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	Gas     uint64                                        `protobuf:"varint,2,opt,name=gas,proto3" json:"gas,omitempty"`
+	Payer   github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=payer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"payer,omitempty"`
+	Granter github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,opt,name=granter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"granter,omitempty"`
+	Tip     *Tip                                          `protobuf:"bytes,5,opt,name=tip,proto3" json:"tip,omitempty"`
 }
 
 func (m *StdFee) Reset()         { *m = StdFee{} }
@@ -990,6 +1152,47 @@ func (m *StdFee) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_StdFee proto.InternalMessageInfo
 
+// Tip defines an amount of coins paid by the tipper to the fee payer of a
+// transaction in exchange for broadcasting it. The tipper must sign the
+// transaction.
+type Tip struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coins      `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	Tipper github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=tipper,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"tipper,omitempty"`
+}
+
+func (m *Tip) Reset()         { *m = Tip{} }
+func (m *Tip) String() string { return proto.CompactTextString(m) }
+func (*Tip) ProtoMessage()    {}
+func (*Tip) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ff851c3a98ef46f7, []int{11}
+}
+func (m *Tip) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Tip) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Tip.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Tip) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Tip.Merge(m, src)
+}
+func (m *Tip) XXX_Size() int {
+	return m.Size()
+}
+func (m *Tip) XXX_DiscardUnknown() {
+	xxx_messageInfo_Tip.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Tip proto.InternalMessageInfo
+
 // StdSignature defines a signature structure that contains the signature of a
 // transaction and an optional public key.
 type StdSignature struct {
@@ -1001,7 +1204,7 @@ func (m *StdSignature) Reset()         { *m = StdSignature{} }
 func (m *StdSignature) String() string { return proto.CompactTextString(m) }
 func (*StdSignature) ProtoMessage()    {}
 func (*StdSignature) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff851c3a98ef46f7, []int{11}
+	return fileDescriptor_ff851c3a98ef46f7, []int{12}
 }
 func (m *StdSignature) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1042,7 +1245,7 @@ func (m *StdTxBase) Reset()         { *m = StdTxBase{} }
 func (m *StdTxBase) String() string { return proto.CompactTextString(m) }
 func (*StdTxBase) ProtoMessage()    {}
 func (*StdTxBase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff851c3a98ef46f7, []int{12}
+	return fileDescriptor_ff851c3a98ef46f7, []int{13}
 }
 func (m *StdTxBase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1106,7 +1309,7 @@ func (m *StdSignDocBase) Reset()         { *m = StdSignDocBase{} }
 func (m *StdSignDocBase) String() string { return proto.CompactTextString(m) }
 func (*StdSignDocBase) ProtoMessage()    {}
 func (*StdSignDocBase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ff851c3a98ef46f7, []int{13}
+	return fileDescriptor_ff851c3a98ef46f7, []int{14}
 }
 func (m *StdSignDocBase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1182,6 +1385,7 @@ func init() {
 	proto.RegisterType((*Message)(nil), "cosmos_sdk.std.v1.Message")
 	proto.RegisterType((*SignDoc)(nil), "cosmos_sdk.std.v1.SignDoc")
 	proto.RegisterType((*StdFee)(nil), "cosmos_sdk.std.v1.StdFee")
+	proto.RegisterType((*Tip)(nil), "cosmos_sdk.std.v1.Tip")
 	proto.RegisterType((*StdSignature)(nil), "cosmos_sdk.std.v1.StdSignature")
 	proto.RegisterType((*StdTxBase)(nil), "cosmos_sdk.std.v1.StdTxBase")
 	proto.RegisterType((*StdSignDocBase)(nil), "cosmos_sdk.std.v1.StdSignDocBase")
@@ -1190,117 +1394,142 @@ func init() {
 func init() { proto.RegisterFile("std/codec.proto", fileDescriptor_ff851c3a98ef46f7) }
 
 var fileDescriptor_ff851c3a98ef46f7 = []byte{
-	// 1746 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcf, 0x6f, 0x1b, 0xc7,
-	0x15, 0xde, 0x8d, 0x68, 0x91, 0x1a, 0xd1, 0xb2, 0x34, 0xb1, 0x6b, 0x46, 0x71, 0x48, 0x9b, 0x29,
-	0x8c, 0xd4, 0xa9, 0xc9, 0x38, 0x4e, 0x93, 0x9a, 0xe8, 0x2f, 0x93, 0xb2, 0x40, 0xb5, 0x51, 0x6b,
-	0xac, 0x6c, 0x15, 0x2d, 0xda, 0x2e, 0x86, 0xbb, 0xe3, 0xd5, 0x54, 0x9c, 0x9d, 0xcd, 0xce, 0x2c,
-	0x45, 0x16, 0xe8, 0xa9, 0x45, 0xd1, 0x1c, 0x0a, 0xf4, 0xda, 0x43, 0x81, 0xb4, 0x40, 0x2f, 0x3d,
-	0xe7, 0x94, 0xbf, 0x20, 0xc8, 0xc9, 0xc7, 0x9e, 0xd4, 0x42, 0xbe, 0x14, 0x39, 0x15, 0xfe, 0x0b,
-	0x8a, 0x99, 0x9d, 0x5d, 0xee, 0x92, 0x4b, 0x4a, 0x05, 0x7a, 0x21, 0x76, 0xe6, 0xbd, 0xef, 0x7b,
-	0xdf, 0xce, 0xbc, 0x37, 0x6f, 0x96, 0xe0, 0x0a, 0x17, 0x6e, 0xdb, 0x61, 0x2e, 0x76, 0x5a, 0x41,
-	0xc8, 0x04, 0x83, 0x5b, 0x0e, 0xe3, 0x94, 0x71, 0x9b, 0xbb, 0xc7, 0x2d, 0x2e, 0xdc, 0xd6, 0xe8,
-	0xde, 0xf6, 0xdb, 0xe2, 0x88, 0x84, 0xae, 0x1d, 0xa0, 0x50, 0x4c, 0xda, 0xca, 0xab, 0x1d, 0x3b,
-	0xdd, 0xcd, 0x0e, 0x62, 0xfc, 0xf6, 0xed, 0x79, 0x67, 0x8f, 0x79, 0x6c, 0xfa, 0xa4, 0xfd, 0xb6,
-	0xc4, 0x24, 0xc0, 0xbc, 0xad, 0x7e, 0xf5, 0x54, 0x6d, 0xdc, 0x46, 0x91, 0x38, 0x6a, 0xcf, 0x5b,
-	0x6e, 0x6a, 0xcb, 0x08, 0x73, 0x41, 0x7c, 0xaf, 0x5d, 0x88, 0x1d, 0x20, 0xff, 0xb8, 0xc0, 0xb2,
-	0x3d, 0x6e, 0x3b, 0x21, 0xe1, 0x84, 0x17, 0xf3, 0xba, 0x84, 0x8b, 0x90, 0x0c, 0x22, 0x41, 0x98,
-	0x5f, 0xe0, 0x71, 0x63, 0xdc, 0xc6, 0x23, 0xe2, 0x62, 0xdf, 0xc1, 0x05, 0xd6, 0xeb, 0xe3, 0xb6,
-	0xc7, 0x46, 0xc5, 0x30, 0x3e, 0x44, 0xfc, 0xa8, 0x58, 0xec, 0xeb, 0xe3, 0x36, 0x17, 0xe8, 0xb8,
-	0xd8, 0xf8, 0xe6, 0xb8, 0x1d, 0xa0, 0x10, 0xd1, 0x44, 0x6f, 0x10, 0xb2, 0x80, 0x71, 0x34, 0x9c,
-	0x65, 0x88, 0x02, 0x2f, 0x44, 0x6e, 0x81, 0xaa, 0xe6, 0x67, 0x25, 0x50, 0x7e, 0xe8, 0x38, 0x2c,
-	0xf2, 0x05, 0xdc, 0x05, 0xd5, 0x01, 0xe2, 0xd8, 0x46, 0xf1, 0xb8, 0x66, 0xde, 0x34, 0xdf, 0x5a,
-	0x7f, 0xf7, 0x56, 0x2b, 0xb3, 0xcb, 0xe3, 0x96, 0x5c, 0xdb, 0xd6, 0xe8, 0x5e, 0xab, 0x8b, 0x38,
-	0xd6, 0xc0, 0xbe, 0x61, 0xad, 0x0f, 0xa6, 0x43, 0x38, 0x02, 0xdb, 0x0e, 0xf3, 0x05, 0xf1, 0x23,
-	0x16, 0x71, 0x5b, 0xef, 0x43, 0xca, 0xfa, 0x8a, 0x62, 0x7d, 0xbf, 0x88, 0x35, 0xf6, 0x94, 0xec,
-	0xbd, 0x14, 0x7f, 0x18, 0x4f, 0x4e, 0x43, 0xd5, 0x9c, 0x05, 0x36, 0x48, 0xc1, 0x75, 0x17, 0x0f,
-	0xd1, 0x04, 0xbb, 0x73, 0x41, 0x57, 0x54, 0xd0, 0xfb, 0xcb, 0x83, 0xee, 0xc4, 0xe0, 0xb9, 0x88,
-	0xd7, 0xdc, 0x22, 0x03, 0x0c, 0x40, 0x2d, 0xc0, 0x21, 0x61, 0x2e, 0x71, 0xe6, 0xe2, 0x95, 0x54,
-	0xbc, 0xf7, 0x96, 0xc7, 0x7b, 0xac, 0xd1, 0x73, 0x01, 0xbf, 0x12, 0x14, 0x5a, 0xe0, 0x87, 0x60,
-	0x83, 0x32, 0x37, 0x1a, 0x4e, 0xb7, 0xe8, 0x92, 0x8a, 0xf3, 0x66, 0xf1, 0x16, 0xed, 0x2b, 0xdf,
-	0x29, 0xed, 0x65, 0x9a, 0x9d, 0xe8, 0x3c, 0xf8, 0xe2, 0xd3, 0xbb, 0xdf, 0xb8, 0xe3, 0x11, 0x71,
-	0x14, 0x0d, 0x5a, 0x0e, 0xa3, 0xba, 0x36, 0x93, 0x7a, 0xe5, 0xee, 0x71, 0x5b, 0x97, 0x12, 0x1e,
-	0x07, 0x2c, 0x14, 0xd8, 0x6d, 0x69, 0x68, 0xf7, 0x12, 0x58, 0xe1, 0x11, 0x6d, 0xfe, 0xce, 0x04,
-	0xab, 0x07, 0x51, 0x10, 0x0c, 0x27, 0xf0, 0x7d, 0xb0, 0xca, 0xd5, 0x93, 0xce, 0x9a, 0x1b, 0x79,
-	0x49, 0xb2, 0xde, 0xa4, 0xa4, 0xd8, 0xbb, 0x6f, 0x58, 0xda, 0xbb, 0xf3, 0xed, 0x7f, 0x7f, 0xd2,
-	0x30, 0x2f, 0x22, 0x44, 0x55, 0x6c, 0x2a, 0x24, 0xe6, 0xd9, 0x4b, 0x84, 0xfc, 0xd5, 0x04, 0x95,
-	0x47, 0xba, 0xf4, 0xe0, 0x87, 0xa0, 0x8a, 0x3f, 0x8a, 0xc8, 0x88, 0x39, 0x48, 0x16, 0xaa, 0x16,
-	0x74, 0x3b, 0x2f, 0x28, 0x29, 0x54, 0x29, 0xea, 0x51, 0xc6, 0xbb, 0x6f, 0x58, 0x39, 0x74, 0xe7,
-	0xa1, 0x16, 0xf8, 0xe0, 0x1c, 0x7d, 0x69, 0xe5, 0xa7, 0x1a, 0x13, 0x41, 0x89, 0xc8, 0xbf, 0x99,
-	0x60, 0x6b, 0x9f, 0x7b, 0x07, 0xd1, 0x80, 0x12, 0x91, 0xaa, 0xdd, 0x07, 0x25, 0x59, 0x3b, 0x5a,
-	0x65, 0x7b, 0xb1, 0xca, 0x39, 0xa8, 0xac, 0xc0, 0x6e, 0xe5, 0xf3, 0xd3, 0x86, 0xf1, 0xfc, 0xb4,
-	0x61, 0x5a, 0x8a, 0x06, 0x7e, 0x00, 0x2a, 0x09, 0x48, 0x57, 0xda, 0xeb, 0xad, 0xb9, 0x53, 0x3a,
-	0x95, 0x66, 0xa5, 0xce, 0x9d, 0xca, 0xef, 0x3f, 0x69, 0x18, 0xf2, 0x5d, 0x9b, 0x7f, 0xce, 0xea,
-	0x7c, 0xac, 0x4f, 0x14, 0xd8, 0xcf, 0xe9, 0xbc, 0x93, 0xd7, 0xe9, 0xb1, 0x51, 0x4e, 0x62, 0x82,
-	0x2a, 0x94, 0xf8, 0x1e, 0x28, 0xcb, 0x12, 0xc6, 0xe9, 0x59, 0xb0, 0x5d, 0xa0, 0xb0, 0x17, 0x7b,
-	0x58, 0x89, 0x6b, 0x46, 0xdf, 0x1f, 0x4c, 0x50, 0x49, 0x65, 0x7d, 0x37, 0x27, 0xeb, 0x56, 0xa1,
-	0xac, 0xa5, 0x6a, 0x3a, 0xff, 0x83, 0x9a, 0x6e, 0x49, 0x82, 0xa7, 0x9a, 0x4a, 0x4a, 0xcf, 0x5f,
-	0x4a, 0xa0, 0xac, 0x1d, 0xe0, 0x07, 0xa0, 0x24, 0xf0, 0x58, 0x2c, 0x95, 0xf3, 0x04, 0x8f, 0xd3,
-	0x05, 0xea, 0x1b, 0x96, 0x02, 0xc0, 0x9f, 0x81, 0x4d, 0x75, 0x92, 0x63, 0x81, 0x43, 0xdb, 0x39,
-	0x42, 0xbe, 0x97, 0xec, 0xdf, 0x4c, 0x4a, 0xc4, 0xe7, 0xbd, 0x7a, 0xad, 0xc4, 0xbf, 0xa7, 0xdc,
-	0x33, 0x94, 0x57, 0x82, 0xbc, 0x09, 0xfe, 0x1c, 0x6c, 0x72, 0xf6, 0x4c, 0x9c, 0xa0, 0x10, 0xdb,
-	0xba, 0x17, 0xe8, 0x23, 0xf1, 0x9d, 0x3c, 0xbb, 0x36, 0xaa, 0x52, 0xd5, 0x80, 0xa7, 0xf1, 0x54,
-	0x96, 0x9e, 0xe7, 0x4d, 0x30, 0x00, 0xd7, 0x1d, 0xe4, 0x3b, 0x78, 0x68, 0xcf, 0x45, 0x29, 0x15,
-	0x9d, 0xf6, 0x99, 0x28, 0x3d, 0x85, 0x5b, 0x1c, 0xeb, 0x9a, 0x53, 0xe4, 0x00, 0x87, 0xe0, 0xaa,
-	0xc3, 0x28, 0x8d, 0x7c, 0x22, 0x26, 0x76, 0xc0, 0xd8, 0xd0, 0xe6, 0x01, 0xf6, 0x5d, 0x7d, 0x1e,
-	0x7e, 0x33, 0x1f, 0x2e, 0xdb, 0xb6, 0xe3, 0xdd, 0xd4, 0xc8, 0xc7, 0x8c, 0x0d, 0x0f, 0x24, 0x2e,
-	0x13, 0x10, 0x3a, 0x73, 0xd6, 0xce, 0x03, 0x7d, 0x06, 0xdc, 0x3b, 0xef, 0x90, 0x4a, 0x1b, 0x7c,
-	0x9a, 0x31, 0xba, 0xf6, 0x3f, 0x36, 0xc1, 0xfa, 0x93, 0x10, 0xf9, 0x1c, 0x39, 0x52, 0x05, 0xfc,
-	0x4e, 0x2e, 0x6d, 0x6f, 0x14, 0xa4, 0xdc, 0x81, 0x70, 0x9f, 0x8c, 0x55, 0xc6, 0x56, 0x93, 0x8c,
-	0xfd, 0x52, 0x26, 0x5f, 0x52, 0x43, 0x25, 0xca, 0x3d, 0x5e, 0x7b, 0xe5, 0xe6, 0xca, 0x82, 0x94,
-	0xdd, 0xc7, 0x9c, 0x23, 0x0f, 0xeb, 0x94, 0x55, 0xde, 0x9d, 0x92, 0xac, 0xa1, 0xe6, 0x67, 0x55,
-	0x50, 0xd6, 0x56, 0xd8, 0x01, 0x15, 0xca, 0x3d, 0x9b, 0xcb, 0xb5, 0x8b, 0xb5, 0xbc, 0x51, 0x7c,
-	0x70, 0xcb, 0xd2, 0xc6, 0xbe, 0xdb, 0x37, 0xac, 0x32, 0x8d, 0x1f, 0xe1, 0xf7, 0xc1, 0x86, 0xc4,
-	0xd2, 0x68, 0x28, 0x48, 0xcc, 0x10, 0x27, 0x6c, 0x73, 0x21, 0xc3, 0xbe, 0x74, 0xd5, 0x34, 0x55,
-	0x9a, 0x19, 0xc3, 0x5f, 0x80, 0xab, 0x92, 0x6b, 0x84, 0x43, 0xf2, 0x6c, 0x62, 0x13, 0x7f, 0x84,
-	0x42, 0x82, 0xd2, 0xbe, 0x3d, 0x73, 0xda, 0xc4, 0x57, 0x34, 0xcd, 0x79, 0xa8, 0x20, 0x7b, 0x09,
-	0x42, 0xee, 0x20, 0x9d, 0x9b, 0x85, 0x3e, 0xa8, 0xc5, 0xef, 0x29, 0xec, 0x13, 0x22, 0x8e, 0xdc,
-	0x10, 0x9d, 0xd8, 0xc8, 0x75, 0x43, 0xcc, 0xb9, 0x4e, 0xd1, 0xfb, 0xcb, 0x73, 0x46, 0xbd, 0xbf,
-	0xf8, 0xb1, 0xc6, 0x3e, 0x8c, 0xa1, 0x32, 0x3f, 0x69, 0x91, 0x01, 0xfe, 0x1a, 0xbc, 0x21, 0xe3,
-	0xa5, 0xb1, 0x5c, 0x3c, 0xc4, 0x1e, 0x12, 0x2c, 0xb4, 0x43, 0x7c, 0x82, 0xc2, 0x0b, 0x26, 0xea,
-	0x3e, 0xf7, 0x12, 0xe2, 0x9d, 0x84, 0xc0, 0x52, 0xf8, 0xbe, 0x61, 0x6d, 0xd3, 0x85, 0x56, 0xf8,
-	0xb1, 0x09, 0x6e, 0xe5, 0xe2, 0x8f, 0xd0, 0x90, 0xb8, 0x2a, 0xbe, 0x4c, 0x6f, 0xc2, 0xb9, 0x6c,
-	0x8c, 0xab, 0x4a, 0xc3, 0xb7, 0x2e, 0xac, 0xe1, 0x30, 0x21, 0xe9, 0xa5, 0x1c, 0x7d, 0xc3, 0xaa,
-	0xd3, 0xa5, 0x1e, 0xf0, 0x18, 0x5c, 0x97, 0x52, 0x9e, 0x45, 0xbe, 0x6b, 0xe7, 0x6b, 0xb6, 0x56,
-	0x56, 0x02, 0xde, 0x3d, 0x57, 0xc0, 0x6e, 0xe4, 0xbb, 0xb9, 0xa2, 0xed, 0x1b, 0x96, 0xcc, 0x97,
-	0xb9, 0x79, 0x78, 0x08, 0x5e, 0x55, 0xfb, 0xac, 0xba, 0x90, 0x9d, 0x76, 0xc2, 0x8a, 0x0a, 0xf4,
-	0xd5, 0xa2, 0x32, 0x99, 0xed, 0xaa, 0x7d, 0xc3, 0xda, 0xa2, 0x73, 0x5d, 0x3a, 0xcf, 0x9b, 0x5c,
-	0xb3, 0x6b, 0x6b, 0xe7, 0xf3, 0x66, 0x8e, 0x96, 0x29, 0x6f, 0xda, 0xbe, 0x1e, 0xc4, 0xf5, 0x37,
-	0x62, 0x02, 0xd7, 0x40, 0xd1, 0xc5, 0x69, 0xda, 0x59, 0x0f, 0x99, 0xc0, 0xba, 0xfc, 0xe4, 0x23,
-	0xec, 0x82, 0x75, 0x09, 0x75, 0x71, 0xc0, 0x38, 0x11, 0xb5, 0x75, 0x85, 0x6e, 0x2c, 0x42, 0xef,
-	0xc4, 0x6e, 0x7d, 0xc3, 0x02, 0x34, 0x1d, 0xc1, 0x1d, 0x20, 0x47, 0x76, 0xe4, 0xff, 0x12, 0x91,
-	0x61, 0xad, 0x5a, 0x74, 0x99, 0x4c, 0x3e, 0x4d, 0x34, 0xcf, 0x53, 0xe5, 0xda, 0x37, 0xac, 0x35,
-	0x9a, 0x0c, 0xa0, 0x1d, 0x17, 0xaf, 0x13, 0x62, 0x24, 0xf0, 0x34, 0xd5, 0x6a, 0x97, 0x15, 0xdf,
-	0xdb, 0x33, 0x7c, 0xf1, 0xc7, 0x8c, 0xa6, 0xeb, 0x29, 0x4c, 0x9a, 0x36, 0xba, 0x7a, 0x67, 0x66,
-	0xe1, 0x4f, 0x80, 0x9c, 0xb5, 0xb1, 0x4b, 0x44, 0x86, 0x7e, 0x43, 0xd1, 0x7f, 0x6d, 0x19, 0xfd,
-	0x23, 0x97, 0x88, 0x2c, 0xf9, 0x26, 0x9d, 0x99, 0x83, 0x7b, 0xa0, 0x1a, 0xaf, 0xa2, 0x2a, 0x20,
-	0x5c, 0xbb, 0x32, 0xbf, 0xa3, 0xb3, 0xa4, 0xba, 0xd8, 0xe4, 0x66, 0xac, 0xd3, 0xe9, 0x30, 0x59,
-	0x86, 0x01, 0xf6, 0x88, 0x6f, 0x87, 0x38, 0xa5, 0xdc, 0x3c, 0x7f, 0x19, 0xba, 0x12, 0x63, 0xa5,
-	0x10, 0xbd, 0x0c, 0x33, 0xb3, 0xf0, 0x47, 0xf1, 0x81, 0x1b, 0xf9, 0x29, 0xf5, 0x56, 0xd1, 0xd5,
-	0x36, 0x4f, 0xfd, 0xd4, 0xcf, 0xb0, 0x5e, 0xa6, 0xd9, 0x89, 0xce, 0x9d, 0x2f, 0x3e, 0xbd, 0x7b,
-	0x7b, 0x69, 0x4b, 0x8b, 0x9b, 0x99, 0x54, 0xa8, 0x1b, 0xd9, 0x6f, 0x4d, 0x50, 0x3e, 0x20, 0x9e,
-	0xbf, 0xc3, 0x1c, 0xd8, 0x5b, 0x7c, 0xf7, 0x9a, 0x36, 0x31, 0xed, 0xfc, 0xff, 0xed, 0x64, 0xcd,
-	0xdf, 0xc8, 0x2f, 0x0f, 0xe1, 0xee, 0x62, 0x79, 0xb7, 0x59, 0x45, 0x54, 0x7f, 0xaf, 0x4a, 0x8a,
-	0x57, 0xb3, 0x14, 0xaa, 0xdb, 0x13, 0xbf, 0xfb, 0x8e, 0xc4, 0xfe, 0xfd, 0x9f, 0x8d, 0xb7, 0x2e,
-	0xf0, 0xb6, 0x12, 0xc0, 0x2d, 0x4d, 0x0a, 0x37, 0xc1, 0x8a, 0x87, 0xb8, 0x6a, 0x6d, 0x25, 0x4b,
-	0x3e, 0x66, 0x6e, 0xa2, 0xbf, 0x02, 0x55, 0xfd, 0x86, 0x48, 0x44, 0x21, 0x86, 0xbb, 0xa0, 0x1c,
-	0x44, 0x03, 0xfb, 0x18, 0xc7, 0x5f, 0x41, 0xd5, 0xee, 0xdd, 0x2f, 0x4f, 0x1b, 0x57, 0x83, 0x68,
-	0x30, 0x24, 0x8e, 0x9c, 0xfd, 0x3a, 0xa3, 0x44, 0x60, 0x1a, 0x88, 0xc9, 0xcb, 0xd3, 0xc6, 0xd6,
-	0x04, 0xd1, 0x61, 0xa7, 0x39, 0xb5, 0x36, 0xad, 0xd5, 0x20, 0x1a, 0xfc, 0x00, 0x4f, 0xe0, 0x0d,
-	0xb0, 0xc6, 0x13, 0x52, 0x15, 0xb9, 0x6a, 0x4d, 0x27, 0x74, 0x17, 0xff, 0x93, 0x09, 0xd6, 0xd2,
-	0x3b, 0x02, 0xbc, 0x07, 0x56, 0x9e, 0xe1, 0x64, 0x27, 0x5e, 0x2b, 0xde, 0x89, 0x5d, 0x9c, 0xac,
-	0xa1, 0xf4, 0x85, 0x8f, 0x00, 0x48, 0x39, 0x93, 0xe5, 0x6f, 0x2c, 0xde, 0x43, 0xe5, 0xa7, 0xf1,
-	0x19, 0x20, 0x84, 0xa0, 0x44, 0x31, 0x65, 0xaa, 0x53, 0xaf, 0x59, 0xea, 0xb9, 0xf9, 0x1f, 0x13,
-	0x6c, 0xe4, 0xb7, 0x5e, 0x1e, 0x74, 0xce, 0x11, 0x22, 0xbe, 0x4d, 0xe2, 0x8b, 0xc6, 0x5a, 0xb7,
-	0x7e, 0x76, 0xda, 0x28, 0xf7, 0xe4, 0xdc, 0xde, 0xce, 0xcb, 0xd3, 0xc6, 0x95, 0x78, 0x39, 0x12,
-	0xa7, 0xa6, 0x55, 0x56, 0x8f, 0x7b, 0x2e, 0xfc, 0x1e, 0xd8, 0xd0, 0x9f, 0xbb, 0xb6, 0x1f, 0xd1,
-	0x01, 0x0e, 0xe3, 0xcd, 0xe8, 0xbe, 0xf6, 0xf2, 0xb4, 0x71, 0x2d, 0x46, 0xe5, 0xed, 0x4d, 0xeb,
-	0xb2, 0x9e, 0xf8, 0xa1, 0x1a, 0xc3, 0x6d, 0x50, 0xe1, 0xf8, 0xa3, 0x48, 0xb5, 0x82, 0x15, 0xb5,
-	0x91, 0xe9, 0x38, 0xd5, 0x5f, 0x9a, 0xea, 0x4f, 0x56, 0xf3, 0xd2, 0xc5, 0x57, 0xb3, 0xdb, 0xf9,
-	0xfc, 0xac, 0x6e, 0x3e, 0x3f, 0xab, 0x9b, 0xff, 0x3a, 0xab, 0x9b, 0x7f, 0x7c, 0x51, 0x37, 0x9e,
-	0xbf, 0xa8, 0x1b, 0xff, 0x78, 0x51, 0x37, 0x7e, 0x7a, 0x73, 0x69, 0xca, 0x71, 0xe1, 0x0e, 0x56,
-	0xd5, 0x5f, 0x31, 0xf7, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x66, 0xa7, 0xb1, 0x54, 0x60, 0x13,
-	0x00, 0x00,
+	// 2154 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
+	0x15, 0x26, 0x2d, 0x5a, 0x94, 0x46, 0xb2, 0x2c, 0x4d, 0x64, 0x6b, 0xad, 0x38, 0xa2, 0x4d, 0xb7,
+	0x86, 0x9b, 0xc4, 0x64, 0x94, 0xdf, 0x9a, 0x48, 0xda, 0x9a, 0x94, 0x55, 0xaa, 0x89, 0x52, 0x63,
+	0x25, 0xbb, 0x3f, 0x48, 0xbb, 0x18, 0xee, 0x8e, 0xa9, 0xad, 0x76, 0x76, 0x36, 0x3b, 0xb3, 0x14,
+	0x19, 0xa0, 0x3d, 0x15, 0x45, 0x73, 0x28, 0xd0, 0x43, 0x2f, 0xbd, 0x05, 0x05, 0x7a, 0x29, 0x7a,
+	0xec, 0xa5, 0xe7, 0x5e, 0x82, 0xf6, 0xe2, 0x63, 0x81, 0x02, 0x6a, 0x61, 0x5f, 0x8a, 0x9c, 0x0a,
+	0x1f, 0xdb, 0x4b, 0x31, 0x3f, 0xbb, 0xdc, 0x25, 0x97, 0x14, 0x1b, 0xa4, 0x17, 0x69, 0x67, 0xde,
+	0xfb, 0xbe, 0xf7, 0x66, 0xe6, 0xbd, 0x37, 0x6f, 0x24, 0x70, 0x91, 0x71, 0xa7, 0x6e, 0x53, 0x07,
+	0xdb, 0xb5, 0x20, 0xa4, 0x9c, 0xc2, 0x35, 0x9b, 0x32, 0x42, 0x99, 0xc5, 0x9c, 0xe3, 0x1a, 0xe3,
+	0x4e, 0xad, 0xb7, 0xbd, 0xf9, 0x12, 0x3f, 0x72, 0x43, 0xc7, 0x0a, 0x50, 0xc8, 0x07, 0x75, 0xa9,
+	0x55, 0x57, 0x4a, 0xb7, 0xd3, 0x03, 0x85, 0xdf, 0xbc, 0x39, 0xae, 0xdc, 0xa5, 0x5d, 0x3a, 0xfc,
+	0xd2, 0x7a, 0x6b, 0x7c, 0x10, 0x60, 0x56, 0x97, 0x3f, 0xf5, 0x94, 0xd1, 0xaf, 0xa3, 0x88, 0x1f,
+	0xd5, 0xc7, 0x25, 0xd7, 0xb4, 0xa4, 0x87, 0x19, 0x77, 0xfd, 0x6e, 0x3d, 0x17, 0xdb, 0x41, 0xfe,
+	0x71, 0x8e, 0x64, 0xb3, 0x5f, 0xb7, 0x43, 0x97, 0xb9, 0x2c, 0x9f, 0xd7, 0x71, 0x19, 0x0f, 0xdd,
+	0x4e, 0xc4, 0x5d, 0xea, 0xe7, 0x68, 0x5c, 0xed, 0xd7, 0x71, 0xcf, 0x75, 0xb0, 0x6f, 0xe3, 0x1c,
+	0xe9, 0x46, 0xbf, 0xde, 0xa5, 0xbd, 0x7c, 0x18, 0xf3, 0x10, 0x3b, 0xca, 0x77, 0xf6, 0xf9, 0x7e,
+	0x9d, 0x71, 0x74, 0x9c, 0x2f, 0xbc, 0xd1, 0xaf, 0x07, 0x28, 0x44, 0x24, 0xf6, 0x37, 0x08, 0x69,
+	0x40, 0x19, 0xf2, 0x46, 0x19, 0xa2, 0xa0, 0x1b, 0x22, 0x27, 0xc7, 0xab, 0xea, 0xef, 0xcf, 0x83,
+	0xf2, 0x5d, 0xdb, 0xa6, 0x91, 0xcf, 0xe1, 0x2e, 0x58, 0xee, 0x20, 0x86, 0x2d, 0xa4, 0xc6, 0x46,
+	0xf1, 0x5a, 0xf1, 0xd6, 0xd2, 0xab, 0xd7, 0x6b, 0xa9, 0x53, 0xee, 0xd7, 0xc4, 0xde, 0xd6, 0x7a,
+	0xdb, 0xb5, 0x26, 0x62, 0x58, 0x03, 0xdb, 0x05, 0x73, 0xa9, 0x33, 0x1c, 0xc2, 0x1e, 0xd8, 0xb4,
+	0xa9, 0xcf, 0x5d, 0x3f, 0xa2, 0x11, 0xb3, 0xf4, 0x39, 0x24, 0xac, 0xe7, 0x24, 0xeb, 0x9b, 0x79,
+	0xac, 0x4a, 0x53, 0xb0, 0xb7, 0x12, 0xfc, 0x43, 0x35, 0x39, 0x34, 0x65, 0xd8, 0x13, 0x64, 0x90,
+	0x80, 0x0d, 0x07, 0x7b, 0x68, 0x80, 0x9d, 0x31, 0xa3, 0x73, 0xd2, 0xe8, 0x6b, 0xd3, 0x8d, 0xee,
+	0x28, 0xf0, 0x98, 0xc5, 0x4b, 0x4e, 0x9e, 0x00, 0x06, 0xc0, 0x08, 0x70, 0xe8, 0x52, 0xc7, 0xb5,
+	0xc7, 0xec, 0x95, 0xa4, 0xbd, 0xd7, 0xa7, 0xdb, 0xbb, 0xaf, 0xd1, 0x63, 0x06, 0x2f, 0x07, 0xb9,
+	0x12, 0xf8, 0x1e, 0x58, 0x21, 0xd4, 0x89, 0xbc, 0xe1, 0x11, 0x9d, 0x97, 0x76, 0x6e, 0xe4, 0x1f,
+	0xd1, 0xbe, 0xd4, 0x1d, 0xd2, 0x5e, 0x20, 0xe9, 0x09, 0xe1, 0xbf, 0xed, 0xa1, 0x93, 0x0e, 0xb2,
+	0x8f, 0xc7, 0xfc, 0x9f, 0x9f, 0xc5, 0xff, 0x96, 0x46, 0x8f, 0xfb, 0x6f, 0xe7, 0x4a, 0x1a, 0x77,
+	0xfe, 0xfc, 0x87, 0xdb, 0x6f, 0xbc, 0xd8, 0x75, 0xf9, 0x51, 0xd4, 0xa9, 0xd9, 0x94, 0xe8, 0x6a,
+	0xa0, 0x7f, 0xdd, 0x66, 0xce, 0x71, 0x5d, 0x27, 0x2f, 0xee, 0x07, 0x34, 0xe4, 0xd8, 0xa9, 0x69,
+	0x68, 0xf3, 0x3c, 0x98, 0x63, 0x11, 0xa9, 0xfe, 0xac, 0x08, 0xe6, 0x0f, 0xa2, 0x20, 0xf0, 0x06,
+	0xf0, 0x4d, 0x30, 0xcf, 0xe4, 0x97, 0x8e, 0xd3, 0xab, 0x59, 0x67, 0x45, 0x86, 0x0b, 0x27, 0x95,
+	0x76, 0xbb, 0x60, 0x6a, 0xed, 0xc6, 0x3b, 0xff, 0xfc, 0xa4, 0x52, 0x9c, 0xc5, 0x11, 0x59, 0x23,
+	0x12, 0x47, 0x14, 0xcf, 0x5e, 0xec, 0xc8, 0x6f, 0x8a, 0x60, 0xe1, 0x9e, 0x4e, 0x76, 0xf8, 0x1e,
+	0x58, 0xc6, 0x1f, 0x46, 0x6e, 0x8f, 0xda, 0x48, 0x94, 0x06, 0xed, 0xd0, 0xcd, 0xac, 0x43, 0x71,
+	0x69, 0x10, 0x4e, 0xdd, 0x4b, 0x69, 0xb7, 0x0b, 0x66, 0x06, 0xdd, 0xb8, 0xab, 0x1d, 0xbc, 0x73,
+	0x86, 0x7f, 0x49, 0xad, 0x49, 0x7c, 0x8c, 0x1d, 0x8a, 0x9d, 0xfc, 0x6d, 0x11, 0xac, 0xed, 0xb3,
+	0xee, 0x41, 0xd4, 0x21, 0x2e, 0x4f, 0xbc, 0xdd, 0x07, 0x25, 0x91, 0xad, 0xda, 0xcb, 0xfa, 0x64,
+	0x2f, 0xc7, 0xa0, 0x22, 0xe7, 0x9b, 0x0b, 0x9f, 0x9e, 0x56, 0x0a, 0x8f, 0x4f, 0x2b, 0x45, 0x53,
+	0xd2, 0xc0, 0xb7, 0xc0, 0x42, 0x0c, 0xd2, 0xb9, 0xfd, 0x7c, 0x6d, 0xec, 0x5e, 0x48, 0x5c, 0x33,
+	0x13, 0xe5, 0xc6, 0xc2, 0xcf, 0x3f, 0xa9, 0x14, 0xc4, 0x5a, 0xab, 0x7f, 0x4b, 0xfb, 0x79, 0x5f,
+	0xd7, 0x30, 0xd8, 0xce, 0xf8, 0xf9, 0x62, 0xd6, 0xcf, 0x2e, 0xed, 0x65, 0x5c, 0x8c, 0x51, 0xb9,
+	0x2e, 0xbe, 0x0e, 0xca, 0xa2, 0x68, 0xe0, 0xa4, 0xfa, 0x6c, 0xe6, 0x78, 0xd8, 0x52, 0x1a, 0x66,
+	0xac, 0x0a, 0xdf, 0x06, 0x0b, 0x04, 0x33, 0x86, 0xba, 0x98, 0x19, 0x73, 0xd7, 0xe6, 0x26, 0xc0,
+	0xf6, 0x95, 0x4a, 0xb3, 0x24, 0x6c, 0x9a, 0x09, 0x22, 0xb5, 0xba, 0xbf, 0x14, 0xc1, 0x42, 0xb2,
+	0xa8, 0xaf, 0x67, 0x16, 0x75, 0x3d, 0x77, 0x51, 0x53, 0xd7, 0xd2, 0xf8, 0x1f, 0xd6, 0xa2, 0x9d,
+	0xfa, 0x82, 0x56, 0x54, 0x92, 0xab, 0xf9, 0x4f, 0x09, 0x94, 0x35, 0x3d, 0x7c, 0x0b, 0x94, 0x38,
+	0xee, 0xf3, 0xa9, 0x8b, 0x39, 0xc4, 0xfd, 0xe4, 0x70, 0xda, 0x05, 0x53, 0x02, 0xe0, 0x07, 0x60,
+	0x55, 0xde, 0x5b, 0x98, 0xe3, 0xd0, 0xb2, 0x8f, 0x90, 0xdf, 0x8d, 0x63, 0x67, 0x24, 0x1c, 0xa5,
+	0x16, 0x93, 0x9b, 0x12, 0xeb, 0xb7, 0xa4, 0x7a, 0x8a, 0xf2, 0x62, 0x90, 0x15, 0xc1, 0x1f, 0x80,
+	0x55, 0x46, 0x1f, 0xf1, 0x13, 0x14, 0x62, 0x4b, 0xdf, 0x7c, 0xfa, 0x02, 0x78, 0x25, 0xcb, 0xae,
+	0x85, 0xb2, 0x4c, 0x68, 0xc0, 0x03, 0x35, 0x95, 0xa6, 0x67, 0x59, 0x11, 0x0c, 0xc0, 0x86, 0x8d,
+	0x7c, 0x1b, 0x7b, 0xd6, 0x98, 0x95, 0x52, 0xde, 0xdd, 0x96, 0xb2, 0xd2, 0x92, 0xb8, 0xc9, 0xb6,
+	0x2e, 0xd9, 0x79, 0x0a, 0xd0, 0x03, 0xeb, 0x36, 0x25, 0x24, 0xf2, 0x5d, 0x3e, 0xb0, 0x02, 0x4a,
+	0x3d, 0x8b, 0x05, 0xd8, 0x77, 0x74, 0xf5, 0xff, 0x6a, 0xd6, 0x5c, 0xba, 0x49, 0x51, 0xb1, 0xa0,
+	0x91, 0xf7, 0x29, 0xf5, 0x0e, 0x04, 0x2e, 0x65, 0x10, 0xda, 0x63, 0x52, 0xf8, 0x5d, 0xb0, 0xca,
+	0x30, 0xb7, 0x18, 0xf6, 0x1d, 0x0b, 0xfb, 0xa8, 0xe3, 0x61, 0x47, 0xdf, 0x07, 0x2f, 0x4f, 0x28,
+	0xb1, 0x98, 0x1f, 0x60, 0xdf, 0xb9, 0xa7, 0x74, 0x53, 0xec, 0x2b, 0x2c, 0x23, 0x69, 0xdc, 0xd1,
+	0x95, 0x6d, 0xfb, 0xac, 0xd2, 0x9b, 0x34, 0x4a, 0x49, 0x24, 0xeb, 0x8a, 0xf6, 0x71, 0x11, 0x2c,
+	0x1d, 0x86, 0xc8, 0x67, 0xc8, 0x16, 0xeb, 0x83, 0x5f, 0xcb, 0xa4, 0xd3, 0xd5, 0x9c, 0x68, 0x3e,
+	0xe0, 0xce, 0x61, 0x5f, 0x66, 0xd2, 0x72, 0x9c, 0x49, 0x9f, 0x89, 0xb8, 0x8e, 0x2b, 0x43, 0x89,
+	0xb0, 0x2e, 0x33, 0xce, 0xcd, 0x98, 0x0d, 0x52, 0xbb, 0x51, 0x12, 0xb9, 0x5d, 0xfd, 0xd5, 0x3a,
+	0x28, 0x6b, 0x29, 0x6c, 0x80, 0x05, 0xc2, 0xba, 0x72, 0xcf, 0xb4, 0x2f, 0x2f, 0xe4, 0xef, 0x95,
+	0x28, 0x58, 0xd8, 0x77, 0xda, 0x05, 0xb3, 0x4c, 0xd4, 0x27, 0xfc, 0x16, 0x58, 0x11, 0x58, 0x12,
+	0x79, 0xdc, 0x55, 0x0c, 0x2a, 0x15, 0xaa, 0x13, 0x19, 0xf6, 0x85, 0xaa, 0xa6, 0x59, 0x26, 0xa9,
+	0x31, 0xfc, 0x21, 0x58, 0x17, 0x5c, 0x3d, 0x1c, 0xba, 0x8f, 0x06, 0x96, 0xeb, 0xf7, 0x50, 0xe8,
+	0xa2, 0xa4, 0xff, 0x19, 0xa9, 0xa1, 0xaa, 0xd5, 0xd5, 0x9c, 0x0f, 0x25, 0x64, 0x2f, 0x46, 0x88,
+	0xd8, 0x20, 0x63, 0xb3, 0xd0, 0x07, 0x86, 0x5a, 0x27, 0xb7, 0x4e, 0x5c, 0x7e, 0xe4, 0x84, 0xe8,
+	0xc4, 0x42, 0x8e, 0x13, 0x62, 0xc6, 0x8c, 0x52, 0x5e, 0x8f, 0x35, 0x1a, 0x8d, 0x72, 0xfd, 0xfc,
+	0x3b, 0x1a, 0x7b, 0x57, 0x41, 0x45, 0xe4, 0x93, 0x3c, 0x01, 0xfc, 0x31, 0x78, 0x41, 0xd8, 0x4b,
+	0x6c, 0x39, 0xd8, 0xc3, 0x5d, 0xc4, 0x69, 0x68, 0x85, 0xf8, 0x04, 0x85, 0x33, 0xa6, 0xc0, 0x3e,
+	0xeb, 0xc6, 0xc4, 0x3b, 0x31, 0x81, 0x29, 0xf1, 0xed, 0x82, 0xb9, 0x49, 0x26, 0x4a, 0xe1, 0xc7,
+	0x45, 0x70, 0x3d, 0x63, 0xbf, 0x87, 0x3c, 0xd7, 0x91, 0xf6, 0x45, 0xe2, 0xb8, 0x8c, 0x89, 0xeb,
+	0x5e, 0x25, 0xc7, 0xdb, 0x33, 0xfb, 0xf0, 0x30, 0x26, 0x69, 0x25, 0x1c, 0xed, 0x82, 0xb9, 0x45,
+	0xa6, 0x6a, 0xc0, 0x63, 0xb0, 0x21, 0x5c, 0x79, 0x14, 0xf9, 0x8e, 0x95, 0xad, 0x06, 0x46, 0x59,
+	0x3a, 0xf0, 0xea, 0x99, 0x0e, 0xec, 0x46, 0xbe, 0x93, 0x29, 0x07, 0xed, 0x82, 0xb9, 0x4e, 0x72,
+	0xe6, 0xe1, 0x43, 0xf0, 0x9c, 0x3c, 0x67, 0x79, 0xb7, 0x5a, 0xc9, 0xfd, 0xbe, 0x20, 0x0d, 0x7d,
+	0x29, 0x2f, 0x4d, 0x46, 0x7b, 0x85, 0x76, 0xc1, 0x5c, 0x23, 0xa3, 0x93, 0x23, 0xbc, 0xf1, 0x73,
+	0xc5, 0x58, 0x3c, 0x9b, 0x37, 0x55, 0x56, 0xd6, 0xc8, 0xe8, 0x24, 0xbc, 0xa3, 0xf2, 0xaf, 0x47,
+	0x39, 0x36, 0x40, 0x5e, 0x3b, 0x38, 0xec, 0x17, 0x1e, 0x52, 0x8e, 0x75, 0xfa, 0x89, 0x4f, 0xd8,
+	0x04, 0x4b, 0x02, 0xea, 0xe0, 0x80, 0x32, 0x97, 0x1b, 0x4b, 0x12, 0x5d, 0x99, 0x84, 0xde, 0x51,
+	0x6a, 0xed, 0x82, 0x09, 0x48, 0x32, 0x82, 0x3b, 0x40, 0x8c, 0xac, 0xc8, 0xff, 0x11, 0x72, 0x3d,
+	0x63, 0x39, 0xaf, 0x29, 0x8f, 0x9f, 0x78, 0x9a, 0xe7, 0x81, 0x54, 0x6d, 0x17, 0xcc, 0x45, 0x12,
+	0x0f, 0xa0, 0xa5, 0x92, 0xd7, 0x0e, 0x31, 0xe2, 0x78, 0x18, 0x6a, 0xc6, 0x05, 0xc9, 0xf7, 0xd2,
+	0x08, 0x9f, 0x7a, 0x14, 0x6a, 0xba, 0x96, 0xc4, 0x24, 0x61, 0xa3, 0xb3, 0x77, 0x64, 0x16, 0x7e,
+	0x0f, 0x88, 0x59, 0x0b, 0x3b, 0x2e, 0x4f, 0xd1, 0xaf, 0x48, 0xfa, 0xaf, 0x4c, 0xa3, 0xbf, 0xe7,
+	0xb8, 0x3c, 0x4d, 0xbe, 0x4a, 0x46, 0xe6, 0xe0, 0x1e, 0x58, 0x56, 0xbb, 0x28, 0x13, 0x08, 0x1b,
+	0x17, 0xc7, 0x4f, 0x74, 0x94, 0x54, 0x27, 0x9b, 0x38, 0x8c, 0x25, 0x32, 0x1c, 0xc6, 0xdb, 0xd0,
+	0xc1, 0x5d, 0xd7, 0xb7, 0x42, 0x9c, 0x50, 0xae, 0x9e, 0xbd, 0x0d, 0x4d, 0x81, 0x31, 0x13, 0x88,
+	0xde, 0x86, 0x91, 0x59, 0xf8, 0x6d, 0x55, 0x70, 0x23, 0x3f, 0xa1, 0x5e, 0xcb, 0x6b, 0xd8, 0xb3,
+	0xd4, 0x0f, 0xfc, 0x14, 0xeb, 0x05, 0x92, 0x9e, 0x80, 0x1c, 0x6c, 0xa6, 0x0f, 0x6e, 0xe4, 0x2d,
+	0x05, 0x25, 0xf9, 0x1b, 0xd3, 0xdf, 0x52, 0xc3, 0x33, 0x1c, 0x7d, 0x4c, 0x6d, 0x90, 0x7c, 0x11,
+	0xfc, 0x45, 0x11, 0xdc, 0x48, 0x99, 0x9d, 0xf8, 0x96, 0x7b, 0x4e, 0xda, 0x7f, 0x67, 0x46, 0xfb,
+	0x13, 0x1f, 0x75, 0x15, 0x32, 0x5d, 0x05, 0xbe, 0xaf, 0x42, 0x20, 0xf6, 0xc3, 0x58, 0xcf, 0x8b,
+	0xab, 0x3c, 0xbb, 0x1a, 0xa0, 0xe3, 0x20, 0x1e, 0xc2, 0x43, 0x15, 0xad, 0xaa, 0x3d, 0xb4, 0x82,
+	0xa8, 0x63, 0x1d, 0xe3, 0x81, 0x71, 0x49, 0xb2, 0x7e, 0x79, 0xc2, 0x8b, 0x97, 0x75, 0x75, 0x7b,
+	0x18, 0x75, 0xde, 0xc5, 0xe2, 0xd5, 0x77, 0x91, 0x64, 0xa7, 0xe0, 0x4f, 0x40, 0x45, 0xb2, 0xaa,
+	0x0e, 0x2e, 0xf2, 0x3b, 0xd4, 0x77, 0xc4, 0x6e, 0xe9, 0xc3, 0x14, 0xf5, 0xfc, 0x72, 0xde, 0x81,
+	0x8d, 0xe4, 0x9b, 0x84, 0x3f, 0x88, 0xd1, 0x3b, 0x09, 0xb8, 0x5d, 0x30, 0xaf, 0x92, 0x29, 0x72,
+	0xf8, 0x81, 0xaa, 0x80, 0x9c, 0x1e, 0x63, 0xdf, 0xfd, 0x08, 0x5b, 0xec, 0x08, 0x85, 0x98, 0x19,
+	0x1b, 0x79, 0x17, 0x74, 0xd6, 0xe6, 0xa1, 0x86, 0x1c, 0x48, 0x84, 0xae, 0x83, 0xd9, 0x49, 0xc8,
+	0x80, 0xb0, 0x2e, 0xb3, 0x06, 0x13, 0x65, 0x84, 0x59, 0x8f, 0x68, 0x18, 0x9b, 0x31, 0xa4, 0x99,
+	0xed, 0x69, 0x66, 0x4c, 0x89, 0x95, 0xbc, 0x6c, 0x97, 0x86, 0x89, 0x35, 0x83, 0x4c, 0x90, 0xc5,
+	0x4d, 0xc1, 0xb0, 0x21, 0xf0, 0x3c, 0x7d, 0x3d, 0x33, 0xe3, 0xca, 0x8c, 0x4d, 0x41, 0x72, 0xf1,
+	0x7b, 0x9e, 0xba, 0x7b, 0xe3, 0xa6, 0x60, 0x5c, 0x00, 0x31, 0xb8, 0x14, 0x37, 0x21, 0x28, 0xe2,
+	0x54, 0xdc, 0x86, 0x01, 0x8d, 0x7c, 0xc7, 0xd8, 0xcc, 0x6b, 0xf2, 0xf3, 0x3b, 0x90, 0xbb, 0x11,
+	0xa7, 0x2d, 0x8d, 0xd3, 0x65, 0x62, 0x64, 0xb6, 0x51, 0xd3, 0xdd, 0xea, 0xcd, 0xa9, 0xcd, 0xaa,
+	0x6a, 0x53, 0x45, 0xed, 0xd1, 0x2d, 0xea, 0x4f, 0x8b, 0xa0, 0x7c, 0xe0, 0x76, 0xfd, 0x1d, 0x6a,
+	0xc3, 0xd6, 0xe4, 0xd7, 0xde, 0xb0, 0x3d, 0xd5, 0xca, 0x5f, 0x6c, 0x8f, 0x5a, 0xfd, 0xd3, 0x39,
+	0x30, 0x7f, 0xc0, 0x9d, 0x5d, 0x2c, 0xde, 0x43, 0xf3, 0x88, 0xe8, 0xbf, 0xe8, 0x09, 0x8a, 0xe7,
+	0xd2, 0x14, 0xf2, 0x85, 0xe0, 0xfa, 0xcd, 0x57, 0x04, 0xf6, 0x77, 0x7f, 0xaf, 0xdc, 0x9a, 0x61,
+	0xb5, 0x02, 0xc0, 0x4c, 0x4d, 0x0a, 0x57, 0xc1, 0x5c, 0x17, 0x31, 0xd9, 0xb4, 0x96, 0x4c, 0xf1,
+	0x09, 0xbf, 0x09, 0xce, 0x07, 0x68, 0x80, 0x43, 0xd9, 0x76, 0x2e, 0x37, 0xb7, 0xff, 0x7d, 0x5a,
+	0xb9, 0x3d, 0x03, 0xed, 0x5d, 0xdb, 0xd6, 0x7d, 0x9f, 0xa9, 0xf0, 0xf0, 0x5d, 0x50, 0xee, 0x86,
+	0xc8, 0xe7, 0x38, 0x34, 0x4a, 0x9f, 0x97, 0x2a, 0x66, 0x80, 0xb7, 0xc0, 0x1c, 0x77, 0x03, 0xdd,
+	0x31, 0x5e, 0xce, 0xd9, 0xc6, 0x43, 0x37, 0x30, 0x85, 0x4a, 0xea, 0xed, 0xfe, 0xc7, 0x22, 0x98,
+	0x3b, 0x74, 0x83, 0xff, 0xf7, 0x16, 0xee, 0x81, 0x79, 0xee, 0x06, 0x01, 0x0e, 0x8d, 0x73, 0x9f,
+	0x77, 0x99, 0x9a, 0x20, 0xe5, 0xfb, 0x47, 0x60, 0x59, 0x47, 0x17, 0xe2, 0x51, 0x88, 0xe1, 0x2e,
+	0x28, 0xc7, 0x45, 0xb4, 0x28, 0xad, 0xdc, 0xfe, 0xec, 0xb4, 0xb2, 0x1e, 0x44, 0x1d, 0xcf, 0xb5,
+	0xc5, 0xec, 0xcb, 0x94, 0xb8, 0x1c, 0x93, 0x80, 0x0f, 0x9e, 0x9d, 0x56, 0xd6, 0x06, 0x88, 0x78,
+	0x8d, 0xea, 0x50, 0x5a, 0x35, 0xe7, 0x03, 0x55, 0x41, 0xaf, 0x82, 0x45, 0x16, 0x93, 0x2a, 0x7f,
+	0xcd, 0xe1, 0x84, 0x7e, 0x1b, 0xfd, 0xba, 0x08, 0x16, 0x93, 0x97, 0x17, 0xdc, 0x06, 0x73, 0x8f,
+	0x70, 0x9c, 0x05, 0x57, 0xf2, 0xb3, 0x60, 0x17, 0xc7, 0xf1, 0x2b, 0x74, 0xe1, 0x3d, 0x00, 0x12,
+	0xce, 0x38, 0xf4, 0x2b, 0x93, 0xf3, 0x47, 0xea, 0x69, 0x7c, 0x0a, 0x08, 0x21, 0x28, 0x11, 0x4c,
+	0xa8, 0x0c, 0xc4, 0x45, 0x53, 0x7e, 0x57, 0xff, 0x55, 0x04, 0x2b, 0xd9, 0xb4, 0x13, 0xed, 0xa3,
+	0x7d, 0x84, 0x5c, 0xdf, 0x72, 0xd5, 0xf3, 0x6d, 0xb1, 0xb9, 0xf5, 0xe4, 0xb4, 0x52, 0x6e, 0x89,
+	0xb9, 0xbd, 0x9d, 0x67, 0xa7, 0x95, 0x8b, 0x6a, 0x3b, 0x62, 0xa5, 0xaa, 0x59, 0x96, 0x9f, 0x7b,
+	0x0e, 0xfc, 0x06, 0x58, 0xd1, 0x17, 0xad, 0xe5, 0x47, 0xa4, 0xa3, 0x8f, 0xb0, 0xd4, 0xbc, 0xf2,
+	0xec, 0xb4, 0x72, 0x49, 0xa1, 0xb2, 0xf2, 0xaa, 0x79, 0x41, 0x4f, 0xbc, 0x2f, 0xc7, 0x70, 0x13,
+	0x2c, 0x30, 0xfc, 0x61, 0x24, 0x1b, 0xec, 0x39, 0x99, 0x44, 0xc9, 0x38, 0xf1, 0xbf, 0x34, 0xf4,
+	0x3f, 0xde, 0xcd, 0xf3, 0xb3, 0xef, 0x66, 0xb3, 0xf1, 0xe9, 0x93, 0xad, 0xe2, 0xe3, 0x27, 0x5b,
+	0xc5, 0x7f, 0x3c, 0xd9, 0x2a, 0xfe, 0xf2, 0xe9, 0x56, 0xe1, 0xf1, 0xd3, 0xad, 0xc2, 0x5f, 0x9f,
+	0x6e, 0x15, 0xbe, 0x7f, 0x6d, 0x6a, 0x94, 0x31, 0xee, 0x74, 0xe6, 0xe5, 0x3f, 0x0a, 0x5e, 0xfb,
+	0xef, 0x00, 0xb5, 0xbb, 0x1c, 0xe2, 0xfe, 0x19, 0x00, 0x00,
 }
 
 func (this *Supply) Equal(that interface{}) bool {
@@ -1463,6 +1692,14 @@ func (this *MsgSubmitProposal) Equal(that interface{}) bool {
 	if !this.Content.Equal(that1.Content) {
 		return false
 	}
+	if len(this.Messages) != len(that1.Messages) {
+		return false
+	}
+	for i := range this.Messages {
+		if !this.Messages[i].Equal(&that1.Messages[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *Proposal) Equal(that interface{}) bool {
@@ -1490,6 +1727,14 @@ func (this *Proposal) Equal(that interface{}) bool {
 	if !this.Content.Equal(&that1.Content) {
 		return false
 	}
+	if len(this.Messages) != len(that1.Messages) {
+		return false
+	}
+	for i := range this.Messages {
+		if !this.Messages[i].Equal(&that1.Messages[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *Content) Equal(that interface{}) bool {
@@ -1642,14 +1887,14 @@ func (this *Content_CommunityPoolSpend) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *StdFee) Equal(that interface{}) bool {
+func (this *Content_SetSendEnabled) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*StdFee)
+	that1, ok := that.(*Content_SetSendEnabled)
 	if !ok {
-		that2, ok := that.(StdFee)
+		that2, ok := that.(Content_SetSendEnabled)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1661,1024 +1906,1171 @@ func (this *StdFee) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.Amount) != len(that1.Amount) {
+	if !this.SetSendEnabled.Equal(that1.SetSendEnabled) {
 		return false
 	}
-	for i := range this.Amount {
-		if !this.Amount[i].Equal(&that1.Amount[i]) {
+	return true
+}
+func (this *Message) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Message)
+	if !ok {
+		that2, ok := that.(Message)
+		if ok {
+			that1 = &that2
+		} else {
 			return false
 		}
 	}
-	if this.Gas != that1.Gas {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.Sum == nil {
+		if this.Sum != nil {
+			return false
+		}
+	} else if this.Sum == nil {
+		return false
+	} else if !this.Sum.Equal(that1.Sum) {
 		return false
 	}
 	return true
 }
-func (this *Account) GetAccount() github_com_cosmos_cosmos_sdk_x_auth_exported.Account {
-	if x := this.GetBaseAccount(); x != nil {
-		return x
-	}
-	if x := this.GetContinuousVestingAccount(); x != nil {
-		return x
+func (this *Message_MsgSend) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if x := this.GetDelayedVestingAccount(); x != nil {
-		return x
+
+	that1, ok := that.(*Message_MsgSend)
+	if !ok {
+		that2, ok := that.(Message_MsgSend)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if x := this.GetPeriodicVestingAccount(); x != nil {
-		return x
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if x := this.GetModuleAccount(); x != nil {
-		return x
+	if !this.MsgSend.Equal(that1.MsgSend) {
+		return false
 	}
-	return nil
+	return true
 }
-
-func (this *Account) SetAccount(value github_com_cosmos_cosmos_sdk_x_auth_exported.Account) error {
-	if value == nil {
-		this.Sum = nil
-		return nil
-	}
-	switch vt := value.(type) {
-	case *types.BaseAccount:
-		this.Sum = &Account_BaseAccount{vt}
-		return nil
-	case *types1.ContinuousVestingAccount:
-		this.Sum = &Account_ContinuousVestingAccount{vt}
-		return nil
-	case *types1.DelayedVestingAccount:
-		this.Sum = &Account_DelayedVestingAccount{vt}
-		return nil
-	case *types1.PeriodicVestingAccount:
-		this.Sum = &Account_PeriodicVestingAccount{vt}
-		return nil
-	case *types.ModuleAccount:
-		this.Sum = &Account_ModuleAccount{vt}
-		return nil
+func (this *Message_MsgMultiSend) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return fmt.Errorf("can't encode value of type %T as message Account", value)
-}
 
-func (this *Supply) GetSupplyI() github_com_cosmos_cosmos_sdk_x_bank_exported.SupplyI {
-	if x := this.GetSupply(); x != nil {
-		return x
+	that1, ok := that.(*Message_MsgMultiSend)
+	if !ok {
+		that2, ok := that.(Message_MsgMultiSend)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return nil
-}
-
-func (this *Supply) SetSupplyI(value github_com_cosmos_cosmos_sdk_x_bank_exported.SupplyI) error {
-	if value == nil {
-		this.Sum = nil
-		return nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	switch vt := value.(type) {
-	case *types2.Supply:
-		this.Sum = &Supply_Supply{vt}
-		return nil
+	if !this.MsgMultiSend.Equal(that1.MsgMultiSend) {
+		return false
 	}
-	return fmt.Errorf("can't encode value of type %T as message Supply", value)
+	return true
 }
-
-func (this *Evidence) GetEvidence() github_com_cosmos_cosmos_sdk_x_evidence_exported.Evidence {
-	if x := this.GetEquivocation(); x != nil {
-		return x
+func (this *Message_MsgVerifyInvariant) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return nil
-}
 
-func (this *Evidence) SetEvidence(value github_com_cosmos_cosmos_sdk_x_evidence_exported.Evidence) error {
-	if value == nil {
-		this.Sum = nil
-		return nil
+	that1, ok := that.(*Message_MsgVerifyInvariant)
+	if !ok {
+		that2, ok := that.(Message_MsgVerifyInvariant)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	switch vt := value.(type) {
-	case *types3.Equivocation:
-		this.Sum = &Evidence_Equivocation{vt}
-		return nil
-	case types3.Equivocation:
-		this.Sum = &Evidence_Equivocation{&vt}
-		return nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return fmt.Errorf("can't encode value of type %T as message Evidence", value)
+	if !this.MsgVerifyInvariant.Equal(that1.MsgVerifyInvariant) {
+		return false
+	}
+	return true
 }
+func (this *Message_MsgSetWithdrawAddress) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (this *Content) GetContent() github_com_cosmos_cosmos_sdk_x_gov_types.Content {
-	if x := this.GetText(); x != nil {
-		return x
+	that1, ok := that.(*Message_MsgSetWithdrawAddress)
+	if !ok {
+		that2, ok := that.(Message_MsgSetWithdrawAddress)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if x := this.GetParameterChange(); x != nil {
-		return x
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if x := this.GetSoftwareUpgrade(); x != nil {
-		return x
+	if !this.MsgSetWithdrawAddress.Equal(that1.MsgSetWithdrawAddress) {
+		return false
 	}
-	if x := this.GetCancelSoftwareUpgrade(); x != nil {
-		return x
+	return true
+}
+func (this *Message_MsgWithdrawDelegatorReward) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if x := this.GetCommunityPoolSpend(); x != nil {
-		return x
+
+	that1, ok := that.(*Message_MsgWithdrawDelegatorReward)
+	if !ok {
+		that2, ok := that.(Message_MsgWithdrawDelegatorReward)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MsgWithdrawDelegatorReward.Equal(that1.MsgWithdrawDelegatorReward) {
+		return false
+	}
+	return true
 }
+func (this *Message_MsgWithdrawValidatorCommission) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (this *Content) SetContent(value github_com_cosmos_cosmos_sdk_x_gov_types.Content) error {
-	if value == nil {
-		this.Sum = nil
-		return nil
+	that1, ok := that.(*Message_MsgWithdrawValidatorCommission)
+	if !ok {
+		that2, ok := that.(Message_MsgWithdrawValidatorCommission)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	switch vt := value.(type) {
-	case *types4.TextProposal:
-		this.Sum = &Content_Text{vt}
-		return nil
-	case *proposal.ParameterChangeProposal:
-		this.Sum = &Content_ParameterChange{vt}
-		return nil
-	case *types5.SoftwareUpgradeProposal:
-		this.Sum = &Content_SoftwareUpgrade{vt}
-		return nil
-	case *types5.CancelSoftwareUpgradeProposal:
-		this.Sum = &Content_CancelSoftwareUpgrade{vt}
-		return nil
-	case *types6.CommunityPoolSpendProposal:
-		this.Sum = &Content_CommunityPoolSpend{vt}
-		return nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return fmt.Errorf("can't encode value of type %T as message Content", value)
+	if !this.MsgWithdrawValidatorCommission.Equal(that1.MsgWithdrawValidatorCommission) {
+		return false
+	}
+	return true
 }
+func (this *Message_MsgFundCommunityPool) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (this *Message) GetMsg() github_com_cosmos_cosmos_sdk_types.Msg {
-	if x := this.GetMsgSend(); x != nil {
-		return x
+	that1, ok := that.(*Message_MsgFundCommunityPool)
+	if !ok {
+		that2, ok := that.(Message_MsgFundCommunityPool)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if x := this.GetMsgMultiSend(); x != nil {
-		return x
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if x := this.GetMsgVerifyInvariant(); x != nil {
-		return x
+	if !this.MsgFundCommunityPool.Equal(that1.MsgFundCommunityPool) {
+		return false
 	}
-	if x := this.GetMsgSetWithdrawAddress(); x != nil {
-		return x
+	return true
+}
+func (this *Message_MsgSubmitEvidence) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if x := this.GetMsgWithdrawDelegatorReward(); x != nil {
-		return x
+
+	that1, ok := that.(*Message_MsgSubmitEvidence)
+	if !ok {
+		that2, ok := that.(Message_MsgSubmitEvidence)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if x := this.GetMsgWithdrawValidatorCommission(); x != nil {
-		return x
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if x := this.GetMsgFundCommunityPool(); x != nil {
-		return x
+	if !this.MsgSubmitEvidence.Equal(that1.MsgSubmitEvidence) {
+		return false
 	}
-	if x := this.GetMsgSubmitEvidence(); x != nil {
-		return x
+	return true
+}
+func (this *Message_MsgSubmitProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if x := this.GetMsgSubmitProposal(); x != nil {
-		return x
+
+	that1, ok := that.(*Message_MsgSubmitProposal)
+	if !ok {
+		that2, ok := that.(Message_MsgSubmitProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if x := this.GetMsgVote(); x != nil {
-		return x
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if x := this.GetMsgDeposit(); x != nil {
-		return x
+	if !this.MsgSubmitProposal.Equal(that1.MsgSubmitProposal) {
+		return false
 	}
-	if x := this.GetMsgUnjail(); x != nil {
-		return x
+	return true
+}
+func (this *Message_MsgVote) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if x := this.GetMsgCreateValidator(); x != nil {
-		return x
+
+	that1, ok := that.(*Message_MsgVote)
+	if !ok {
+		that2, ok := that.(Message_MsgVote)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if x := this.GetMsgEditValidator(); x != nil {
-		return x
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if x := this.GetMsgDelegate(); x != nil {
-		return x
+	if !this.MsgVote.Equal(that1.MsgVote) {
+		return false
 	}
-	if x := this.GetMsgBeginRedelegate(); x != nil {
-		return x
+	return true
+}
+func (this *Message_MsgDeposit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if x := this.GetMsgUndelegate(); x != nil {
-		return x
+
+	that1, ok := that.(*Message_MsgDeposit)
+	if !ok {
+		that2, ok := that.(Message_MsgDeposit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MsgDeposit.Equal(that1.MsgDeposit) {
+		return false
+	}
+	return true
 }
+func (this *Message_MsgUnjail) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (this *Message) SetMsg(value github_com_cosmos_cosmos_sdk_types.Msg) error {
-	if value == nil {
-		this.Sum = nil
-		return nil
+	that1, ok := that.(*Message_MsgUnjail)
+	if !ok {
+		that2, ok := that.(Message_MsgUnjail)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	switch vt := value.(type) {
-	case *types2.MsgSend:
-		this.Sum = &Message_MsgSend{vt}
-		return nil
-	case types2.MsgSend:
-		this.Sum = &Message_MsgSend{&vt}
-		return nil
-	case *types2.MsgMultiSend:
-		this.Sum = &Message_MsgMultiSend{vt}
-		return nil
-	case types2.MsgMultiSend:
-		this.Sum = &Message_MsgMultiSend{&vt}
-		return nil
-	case *types7.MsgVerifyInvariant:
-		this.Sum = &Message_MsgVerifyInvariant{vt}
-		return nil
-	case types7.MsgVerifyInvariant:
-		this.Sum = &Message_MsgVerifyInvariant{&vt}
-		return nil
-	case *types6.MsgSetWithdrawAddress:
-		this.Sum = &Message_MsgSetWithdrawAddress{vt}
-		return nil
-	case types6.MsgSetWithdrawAddress:
-		this.Sum = &Message_MsgSetWithdrawAddress{&vt}
-		return nil
-	case *types6.MsgWithdrawDelegatorReward:
-		this.Sum = &Message_MsgWithdrawDelegatorReward{vt}
-		return nil
-	case types6.MsgWithdrawDelegatorReward:
-		this.Sum = &Message_MsgWithdrawDelegatorReward{&vt}
-		return nil
-	case *types6.MsgWithdrawValidatorCommission:
-		this.Sum = &Message_MsgWithdrawValidatorCommission{vt}
-		return nil
-	case types6.MsgWithdrawValidatorCommission:
-		this.Sum = &Message_MsgWithdrawValidatorCommission{&vt}
-		return nil
-	case *types6.MsgFundCommunityPool:
-		this.Sum = &Message_MsgFundCommunityPool{vt}
-		return nil
-	case types6.MsgFundCommunityPool:
-		this.Sum = &Message_MsgFundCommunityPool{&vt}
-		return nil
-	case *MsgSubmitEvidence:
-		this.Sum = &Message_MsgSubmitEvidence{vt}
-		return nil
-	case MsgSubmitEvidence:
-		this.Sum = &Message_MsgSubmitEvidence{&vt}
-		return nil
-	case *MsgSubmitProposal:
-		this.Sum = &Message_MsgSubmitProposal{vt}
-		return nil
-	case MsgSubmitProposal:
-		this.Sum = &Message_MsgSubmitProposal{&vt}
-		return nil
-	case *types4.MsgVote:
-		this.Sum = &Message_MsgVote{vt}
-		return nil
-	case types4.MsgVote:
-		this.Sum = &Message_MsgVote{&vt}
-		return nil
-	case *types4.MsgDeposit:
-		this.Sum = &Message_MsgDeposit{vt}
-		return nil
-	case types4.MsgDeposit:
-		this.Sum = &Message_MsgDeposit{&vt}
-		return nil
-	case *types8.MsgUnjail:
-		this.Sum = &Message_MsgUnjail{vt}
-		return nil
-	case types8.MsgUnjail:
-		this.Sum = &Message_MsgUnjail{&vt}
-		return nil
-	case *types9.MsgCreateValidator:
-		this.Sum = &Message_MsgCreateValidator{vt}
-		return nil
-	case types9.MsgCreateValidator:
-		this.Sum = &Message_MsgCreateValidator{&vt}
-		return nil
-	case *types9.MsgEditValidator:
-		this.Sum = &Message_MsgEditValidator{vt}
-		return nil
-	case types9.MsgEditValidator:
-		this.Sum = &Message_MsgEditValidator{&vt}
-		return nil
-	case *types9.MsgDelegate:
-		this.Sum = &Message_MsgDelegate{vt}
-		return nil
-	case types9.MsgDelegate:
-		this.Sum = &Message_MsgDelegate{&vt}
-		return nil
-	case *types9.MsgBeginRedelegate:
-		this.Sum = &Message_MsgBeginRedelegate{vt}
-		return nil
-	case types9.MsgBeginRedelegate:
-		this.Sum = &Message_MsgBeginRedelegate{&vt}
-		return nil
-	case *types9.MsgUndelegate:
-		this.Sum = &Message_MsgUndelegate{vt}
-		return nil
-	case types9.MsgUndelegate:
-		this.Sum = &Message_MsgUndelegate{&vt}
-		return nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return fmt.Errorf("can't encode value of type %T as message Message", value)
-}
-
-func (m *Account) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !this.MsgUnjail.Equal(that1.MsgUnjail) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Account) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *Message_MsgCreateValidator) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Account) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Sum != nil {
-		{
-			size := m.Sum.Size()
-			i -= size
-			if _, err := m.Sum.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+	that1, ok := that.(*Message_MsgCreateValidator)
+	if !ok {
+		that2, ok := that.(Message_MsgCreateValidator)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Account_BaseAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MsgCreateValidator.Equal(that1.MsgCreateValidator) {
+		return false
+	}
+	return true
 }
+func (this *Message_MsgEditValidator) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Account_BaseAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.BaseAccount != nil {
-		{
-			size, err := m.BaseAccount.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCodec(dAtA, i, uint64(size))
+	that1, ok := that.(*Message_MsgEditValidator)
+	if !ok {
+		that2, ok := that.(Message_MsgEditValidator)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Account_ContinuousVestingAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MsgEditValidator.Equal(that1.MsgEditValidator) {
+		return false
+	}
+	return true
 }
+func (this *Message_MsgDelegate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Account_ContinuousVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.ContinuousVestingAccount != nil {
-		{
-			size, err := m.ContinuousVestingAccount.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCodec(dAtA, i, uint64(size))
+	that1, ok := that.(*Message_MsgDelegate)
+	if !ok {
+		that2, ok := that.(Message_MsgDelegate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Account_DelayedVestingAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MsgDelegate.Equal(that1.MsgDelegate) {
+		return false
+	}
+	return true
 }
+func (this *Message_MsgBeginRedelegate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Account_DelayedVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.DelayedVestingAccount != nil {
-		{
-			size, err := m.DelayedVestingAccount.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCodec(dAtA, i, uint64(size))
+	that1, ok := that.(*Message_MsgBeginRedelegate)
+	if !ok {
+		that2, ok := that.(Message_MsgBeginRedelegate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Account_PeriodicVestingAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MsgBeginRedelegate.Equal(that1.MsgBeginRedelegate) {
+		return false
+	}
+	return true
 }
+func (this *Message_MsgUndelegate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Account_PeriodicVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.PeriodicVestingAccount != nil {
-		{
-			size, err := m.PeriodicVestingAccount.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCodec(dAtA, i, uint64(size))
+	that1, ok := that.(*Message_MsgUndelegate)
+	if !ok {
+		that2, ok := that.(Message_MsgUndelegate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x22
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Account_ModuleAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MsgUndelegate.Equal(that1.MsgUndelegate) {
+		return false
+	}
+	return true
 }
+func (this *Message_MsgCreateVestingAccount) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Account_ModuleAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.ModuleAccount != nil {
-		{
-			size, err := m.ModuleAccount.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCodec(dAtA, i, uint64(size))
+	that1, ok := that.(*Message_MsgCreateVestingAccount)
+	if !ok {
+		that2, ok := that.(Message_MsgCreateVestingAccount)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x2a
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Supply) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Supply) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if !this.MsgCreateVestingAccount.Equal(that1.MsgCreateVestingAccount) {
+		return false
+	}
+	return true
 }
+func (this *Message_MsgCreateClawbackVestingAccount) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Supply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Sum != nil {
-		{
-			size := m.Sum.Size()
-			i -= size
-			if _, err := m.Sum.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+	that1, ok := that.(*Message_MsgCreateClawbackVestingAccount)
+	if !ok {
+		that2, ok := that.(Message_MsgCreateClawbackVestingAccount)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Supply_Supply) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MsgCreateClawbackVestingAccount.Equal(that1.MsgCreateClawbackVestingAccount) {
+		return false
+	}
+	return true
 }
+func (this *Message_MsgClawback) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Supply_Supply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Supply != nil {
-		{
-			size, err := m.Supply.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCodec(dAtA, i, uint64(size))
+	that1, ok := that.(*Message_MsgClawback)
+	if !ok {
+		that2, ok := that.(Message_MsgClawback)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Evidence) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Evidence) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if !this.MsgClawback.Equal(that1.MsgClawback) {
+		return false
+	}
+	return true
 }
+func (this *Message_MsgChangePubKey) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Evidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Sum != nil {
-		{
-			size := m.Sum.Size()
-			i -= size
-			if _, err := m.Sum.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+	that1, ok := that.(*Message_MsgChangePubKey)
+	if !ok {
+		that2, ok := that.(Message_MsgChangePubKey)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Evidence_Equivocation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MsgChangePubKey.Equal(that1.MsgChangePubKey) {
+		return false
+	}
+	return true
 }
+func (this *Message_MsgCancelUnbondingDelegation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Evidence_Equivocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Equivocation != nil {
-		{
-			size, err := m.Equivocation.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCodec(dAtA, i, uint64(size))
+	that1, ok := that.(*Message_MsgCancelUnbondingDelegation)
+	if !ok {
+		that2, ok := that.(Message_MsgCancelUnbondingDelegation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
-func (m *MsgSubmitEvidence) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgSubmitEvidence) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if !this.MsgCancelUnbondingDelegation.Equal(that1.MsgCancelUnbondingDelegation) {
+		return false
+	}
+	return true
 }
+func (this *Message_MsgTokenizeShares) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgSubmitEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Evidence != nil {
-		{
-			size, err := m.Evidence.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCodec(dAtA, i, uint64(size))
+	that1, ok := that.(*Message_MsgTokenizeShares)
+	if !ok {
+		that2, ok := that.(Message_MsgTokenizeShares)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	{
-		size, err := m.MsgSubmitEvidenceBase.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintCodec(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgSubmitProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !this.MsgTokenizeShares.Equal(that1.MsgTokenizeShares) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgSubmitProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *Message_MsgRedeemTokensForShares) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Content != nil {
-		{
-			size, err := m.Content.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCodec(dAtA, i, uint64(size))
+	that1, ok := that.(*Message_MsgRedeemTokensForShares)
+	if !ok {
+		that2, ok := that.(Message_MsgRedeemTokensForShares)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	{
-		size, err := m.MsgSubmitProposalBase.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintCodec(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if !this.MsgRedeemTokensForShares.Equal(that1.MsgRedeemTokensForShares) {
+		return false
+	}
+	return true
 }
+func (this *Message_MsgWithdrawAllRewards) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Proposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*Message_MsgWithdrawAllRewards)
+	if !ok {
+		that2, ok := that.(Message_MsgWithdrawAllRewards)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MsgWithdrawAllRewards.Equal(that1.MsgWithdrawAllRewards) {
+		return false
+	}
+	return true
 }
+func (this *Message_MsgSetAutoCompound) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*Message_MsgSetAutoCompound)
+	if !ok {
+		that2, ok := that.(Message_MsgSetAutoCompound)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.MsgSetAutoCompound.Equal(that1.MsgSetAutoCompound) {
+		return false
+	}
+	return true
 }
+func (this *StdFee) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Content.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*StdFee)
+	if !ok {
+		that2, ok := that.(StdFee)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i -= size
-		i = encodeVarintCodec(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.ProposalBase.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
 		}
-		i -= size
-		i = encodeVarintCodec(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if this.Gas != that1.Gas {
+		return false
+	}
+	if !bytes.Equal(this.Payer, that1.Payer) {
+		return false
+	}
+	if !bytes.Equal(this.Granter, that1.Granter) {
+		return false
+	}
+	if !this.Tip.Equal(that1.Tip) {
+		return false
+	}
+	return true
 }
+func (this *Tip) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Content) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*Tip)
+	if !ok {
+		that2, ok := that.(Tip)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.Tipper, that1.Tipper) {
+		return false
+	}
+	return true
 }
-
-func (m *Content) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *Account) GetAccount() github_com_cosmos_cosmos_sdk_x_auth_exported.Account {
+	if x := this.GetBaseAccount(); x != nil {
+		return x
+	}
+	if x := this.GetContinuousVestingAccount(); x != nil {
+		return x
+	}
+	if x := this.GetDelayedVestingAccount(); x != nil {
+		return x
+	}
+	if x := this.GetPeriodicVestingAccount(); x != nil {
+		return x
+	}
+	if x := this.GetModuleAccount(); x != nil {
+		return x
+	}
+	if x := this.GetClawbackVestingAccount(); x != nil {
+		return x
+	}
+	return nil
 }
 
-func (m *Content) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Sum != nil {
-		{
-			size := m.Sum.Size()
-			i -= size
-			if _, err := m.Sum.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+func (this *Account) SetAccount(value github_com_cosmos_cosmos_sdk_x_auth_exported.Account) error {
+	if value == nil {
+		this.Sum = nil
+		return nil
 	}
-	return len(dAtA) - i, nil
+	switch vt := value.(type) {
+	case *types.BaseAccount:
+		this.Sum = &Account_BaseAccount{vt}
+		return nil
+	case *types1.ContinuousVestingAccount:
+		this.Sum = &Account_ContinuousVestingAccount{vt}
+		return nil
+	case *types1.DelayedVestingAccount:
+		this.Sum = &Account_DelayedVestingAccount{vt}
+		return nil
+	case *types1.PeriodicVestingAccount:
+		this.Sum = &Account_PeriodicVestingAccount{vt}
+		return nil
+	case *types.ModuleAccount:
+		this.Sum = &Account_ModuleAccount{vt}
+		return nil
+	case *types1.ClawbackVestingAccount:
+		this.Sum = &Account_ClawbackVestingAccount{vt}
+		return nil
+	}
+	return fmt.Errorf("can't encode value of type %T as message Account", value)
 }
 
-func (m *Content_Text) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (this *Supply) GetSupplyI() github_com_cosmos_cosmos_sdk_x_bank_exported.SupplyI {
+	if x := this.GetSupply(); x != nil {
+		return x
+	}
+	return nil
 }
 
-func (m *Content_Text) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Text != nil {
-		{
-			size, err := m.Text.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCodec(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (this *Supply) SetSupplyI(value github_com_cosmos_cosmos_sdk_x_bank_exported.SupplyI) error {
+	if value == nil {
+		this.Sum = nil
+		return nil
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Content_ParameterChange) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	switch vt := value.(type) {
+	case *types2.Supply:
+		this.Sum = &Supply_Supply{vt}
+		return nil
+	}
+	return fmt.Errorf("can't encode value of type %T as message Supply", value)
 }
 
-func (m *Content_ParameterChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.ParameterChange != nil {
-		{
-			size, err := m.ParameterChange.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCodec(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (this *Evidence) GetEvidence() github_com_cosmos_cosmos_sdk_x_evidence_exported.Evidence {
+	if x := this.GetEquivocation(); x != nil {
+		return x
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Content_SoftwareUpgrade) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return nil
 }
 
-func (m *Content_SoftwareUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.SoftwareUpgrade != nil {
-		{
-			size, err := m.SoftwareUpgrade.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCodec(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+func (this *Evidence) SetEvidence(value github_com_cosmos_cosmos_sdk_x_evidence_exported.Evidence) error {
+	if value == nil {
+		this.Sum = nil
+		return nil
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Content_CancelSoftwareUpgrade) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Content_CancelSoftwareUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.CancelSoftwareUpgrade != nil {
-		{
-			size, err := m.CancelSoftwareUpgrade.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCodec(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+	switch vt := value.(type) {
+	case *types3.Equivocation:
+		this.Sum = &Evidence_Equivocation{vt}
+		return nil
+	case types3.Equivocation:
+		this.Sum = &Evidence_Equivocation{&vt}
+		return nil
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Content_CommunityPoolSpend) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return fmt.Errorf("can't encode value of type %T as message Evidence", value)
 }
 
-func (m *Content_CommunityPoolSpend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.CommunityPoolSpend != nil {
-		{
-			size, err := m.CommunityPoolSpend.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCodec(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
+func (this *Content) GetContent() github_com_cosmos_cosmos_sdk_x_gov_types.Content {
+	if x := this.GetText(); x != nil {
+		return x
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Transaction) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if x := this.GetParameterChange(); x != nil {
+		return x
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Transaction) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Transaction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Msgs) > 0 {
-		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintCodec(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if x := this.GetSoftwareUpgrade(); x != nil {
+		return x
 	}
-	{
-		size, err := m.StdTxBase.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintCodec(dAtA, i, uint64(size))
+	if x := this.GetCancelSoftwareUpgrade(); x != nil {
+		return x
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *Message) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if x := this.GetCommunityPoolSpend(); x != nil {
+		return x
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Message) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Sum != nil {
-		{
-			size := m.Sum.Size()
-			i -= size
-			if _, err := m.Sum.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+	if x := this.GetSetSendEnabled(); x != nil {
+		return x
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Message_MsgSend) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return nil
 }
 
-func (m *Message_MsgSend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.MsgSend != nil {
-		{
-			size, err := m.MsgSend.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCodec(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (this *Content) SetContent(value github_com_cosmos_cosmos_sdk_x_gov_types.Content) error {
+	if value == nil {
+		this.Sum = nil
+		return nil
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Message_MsgMultiSend) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Message_MsgMultiSend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.MsgMultiSend != nil {
-		{
-			size, err := m.MsgMultiSend.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCodec(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	switch vt := value.(type) {
+	case *types4.TextProposal:
+		this.Sum = &Content_Text{vt}
+		return nil
+	case *proposal.ParameterChangeProposal:
+		this.Sum = &Content_ParameterChange{vt}
+		return nil
+	case *types5.SoftwareUpgradeProposal:
+		this.Sum = &Content_SoftwareUpgrade{vt}
+		return nil
+	case *types5.CancelSoftwareUpgradeProposal:
+		this.Sum = &Content_CancelSoftwareUpgrade{vt}
+		return nil
+	case *types6.CommunityPoolSpendProposal:
+		this.Sum = &Content_CommunityPoolSpend{vt}
+		return nil
+	case *types2.SetSendEnabledProposal:
+		this.Sum = &Content_SetSendEnabled{vt}
+		return nil
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Message_MsgVerifyInvariant) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return fmt.Errorf("can't encode value of type %T as message Content", value)
 }
 
-func (m *Message_MsgVerifyInvariant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.MsgVerifyInvariant != nil {
-		{
-			size, err := m.MsgVerifyInvariant.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCodec(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+func (this *Message) GetMsg() github_com_cosmos_cosmos_sdk_types.Msg {
+	if x := this.GetMsgSend(); x != nil {
+		return x
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Message_MsgSetWithdrawAddress) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Message_MsgSetWithdrawAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.MsgSetWithdrawAddress != nil {
-		{
-			size, err := m.MsgSetWithdrawAddress.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCodec(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+	if x := this.GetMsgMultiSend(); x != nil {
+		return x
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Message_MsgWithdrawDelegatorReward) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Message_MsgWithdrawDelegatorReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.MsgWithdrawDelegatorReward != nil {
-		{
-			size, err := m.MsgWithdrawDelegatorReward.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
+	if x := this.GetMsgVerifyInvariant(); x != nil {
+		return x
+	}
+	if x := this.GetMsgSetWithdrawAddress(); x != nil {
+		return x
+	}
+	if x := this.GetMsgWithdrawDelegatorReward(); x != nil {
+		return x
+	}
+	if x := this.GetMsgWithdrawValidatorCommission(); x != nil {
+		return x
+	}
+	if x := this.GetMsgFundCommunityPool(); x != nil {
+		return x
+	}
+	if x := this.GetMsgSubmitEvidence(); x != nil {
+		return x
+	}
+	if x := this.GetMsgSubmitProposal(); x != nil {
+		return x
+	}
+	if x := this.GetMsgVote(); x != nil {
+		return x
+	}
+	if x := this.GetMsgDeposit(); x != nil {
+		return x
+	}
+	if x := this.GetMsgUnjail(); x != nil {
+		return x
+	}
+	if x := this.GetMsgCreateValidator(); x != nil {
+		return x
+	}
+	if x := this.GetMsgEditValidator(); x != nil {
+		return x
+	}
+	if x := this.GetMsgDelegate(); x != nil {
+		return x
+	}
+	if x := this.GetMsgBeginRedelegate(); x != nil {
+		return x
+	}
+	if x := this.GetMsgUndelegate(); x != nil {
+		return x
+	}
+	if x := this.GetMsgCreateVestingAccount(); x != nil {
+		return x
+	}
+	if x := this.GetMsgCreateClawbackVestingAccount(); x != nil {
+		return x
+	}
+	if x := this.GetMsgClawback(); x != nil {
+		return x
+	}
+	if x := this.GetMsgChangePubKey(); x != nil {
+		return x
+	}
+	if x := this.GetMsgCancelUnbondingDelegation(); x != nil {
+		return x
+	}
+	if x := this.GetMsgTokenizeShares(); x != nil {
+		return x
+	}
+	if x := this.GetMsgRedeemTokensForShares(); x != nil {
+		return x
+	}
+	if x := this.GetMsgWithdrawAllRewards(); x != nil {
+		return x
+	}
+	if x := this.GetMsgSetAutoCompound(); x != nil {
+		return x
+	}
+	return nil
+}
+
+func (this *Message) SetMsg(value github_com_cosmos_cosmos_sdk_types.Msg) error {
+	if value == nil {
+		this.Sum = nil
+		return nil
+	}
+	switch vt := value.(type) {
+	case *types2.MsgSend:
+		this.Sum = &Message_MsgSend{vt}
+		return nil
+	case types2.MsgSend:
+		this.Sum = &Message_MsgSend{&vt}
+		return nil
+	case *types2.MsgMultiSend:
+		this.Sum = &Message_MsgMultiSend{vt}
+		return nil
+	case types2.MsgMultiSend:
+		this.Sum = &Message_MsgMultiSend{&vt}
+		return nil
+	case *types7.MsgVerifyInvariant:
+		this.Sum = &Message_MsgVerifyInvariant{vt}
+		return nil
+	case types7.MsgVerifyInvariant:
+		this.Sum = &Message_MsgVerifyInvariant{&vt}
+		return nil
+	case *types6.MsgSetWithdrawAddress:
+		this.Sum = &Message_MsgSetWithdrawAddress{vt}
+		return nil
+	case types6.MsgSetWithdrawAddress:
+		this.Sum = &Message_MsgSetWithdrawAddress{&vt}
+		return nil
+	case *types6.MsgWithdrawDelegatorReward:
+		this.Sum = &Message_MsgWithdrawDelegatorReward{vt}
+		return nil
+	case types6.MsgWithdrawDelegatorReward:
+		this.Sum = &Message_MsgWithdrawDelegatorReward{&vt}
+		return nil
+	case *types6.MsgWithdrawValidatorCommission:
+		this.Sum = &Message_MsgWithdrawValidatorCommission{vt}
+		return nil
+	case types6.MsgWithdrawValidatorCommission:
+		this.Sum = &Message_MsgWithdrawValidatorCommission{&vt}
+		return nil
+	case *types6.MsgFundCommunityPool:
+		this.Sum = &Message_MsgFundCommunityPool{vt}
+		return nil
+	case types6.MsgFundCommunityPool:
+		this.Sum = &Message_MsgFundCommunityPool{&vt}
+		return nil
+	case *MsgSubmitEvidence:
+		this.Sum = &Message_MsgSubmitEvidence{vt}
+		return nil
+	case MsgSubmitEvidence:
+		this.Sum = &Message_MsgSubmitEvidence{&vt}
+		return nil
+	case *MsgSubmitProposal:
+		this.Sum = &Message_MsgSubmitProposal{vt}
+		return nil
+	case MsgSubmitProposal:
+		this.Sum = &Message_MsgSubmitProposal{&vt}
+		return nil
+	case *types4.MsgVote:
+		this.Sum = &Message_MsgVote{vt}
+		return nil
+	case types4.MsgVote:
+		this.Sum = &Message_MsgVote{&vt}
+		return nil
+	case *types4.MsgDeposit:
+		this.Sum = &Message_MsgDeposit{vt}
+		return nil
+	case types4.MsgDeposit:
+		this.Sum = &Message_MsgDeposit{&vt}
+		return nil
+	case *types8.MsgUnjail:
+		this.Sum = &Message_MsgUnjail{vt}
+		return nil
+	case types8.MsgUnjail:
+		this.Sum = &Message_MsgUnjail{&vt}
+		return nil
+	case *types9.MsgCreateValidator:
+		this.Sum = &Message_MsgCreateValidator{vt}
+		return nil
+	case types9.MsgCreateValidator:
+		this.Sum = &Message_MsgCreateValidator{&vt}
+		return nil
+	case *types9.MsgEditValidator:
+		this.Sum = &Message_MsgEditValidator{vt}
+		return nil
+	case types9.MsgEditValidator:
+		this.Sum = &Message_MsgEditValidator{&vt}
+		return nil
+	case *types9.MsgDelegate:
+		this.Sum = &Message_MsgDelegate{vt}
+		return nil
+	case types9.MsgDelegate:
+		this.Sum = &Message_MsgDelegate{&vt}
+		return nil
+	case *types9.MsgBeginRedelegate:
+		this.Sum = &Message_MsgBeginRedelegate{vt}
+		return nil
+	case types9.MsgBeginRedelegate:
+		this.Sum = &Message_MsgBeginRedelegate{&vt}
+		return nil
+	case *types9.MsgUndelegate:
+		this.Sum = &Message_MsgUndelegate{vt}
+		return nil
+	case types9.MsgUndelegate:
+		this.Sum = &Message_MsgUndelegate{&vt}
+		return nil
+	case *types1.MsgCreateVestingAccount:
+		this.Sum = &Message_MsgCreateVestingAccount{vt}
+		return nil
+	case types1.MsgCreateVestingAccount:
+		this.Sum = &Message_MsgCreateVestingAccount{&vt}
+		return nil
+	case *types1.MsgCreateClawbackVestingAccount:
+		this.Sum = &Message_MsgCreateClawbackVestingAccount{vt}
+		return nil
+	case types1.MsgCreateClawbackVestingAccount:
+		this.Sum = &Message_MsgCreateClawbackVestingAccount{&vt}
+		return nil
+	case *types1.MsgClawback:
+		this.Sum = &Message_MsgClawback{vt}
+		return nil
+	case types1.MsgClawback:
+		this.Sum = &Message_MsgClawback{&vt}
+		return nil
+	case *types.MsgChangePubKey:
+		this.Sum = &Message_MsgChangePubKey{vt}
+		return nil
+	case types.MsgChangePubKey:
+		this.Sum = &Message_MsgChangePubKey{&vt}
+		return nil
+	case *types9.MsgCancelUnbondingDelegation:
+		this.Sum = &Message_MsgCancelUnbondingDelegation{vt}
+		return nil
+	case types9.MsgCancelUnbondingDelegation:
+		this.Sum = &Message_MsgCancelUnbondingDelegation{&vt}
+		return nil
+	case *types9.MsgTokenizeShares:
+		this.Sum = &Message_MsgTokenizeShares{vt}
+		return nil
+	case types9.MsgTokenizeShares:
+		this.Sum = &Message_MsgTokenizeShares{&vt}
+		return nil
+	case *types9.MsgRedeemTokensForShares:
+		this.Sum = &Message_MsgRedeemTokensForShares{vt}
+		return nil
+	case types9.MsgRedeemTokensForShares:
+		this.Sum = &Message_MsgRedeemTokensForShares{&vt}
+		return nil
+	case *types6.MsgWithdrawAllRewards:
+		this.Sum = &Message_MsgWithdrawAllRewards{vt}
+		return nil
+	case types6.MsgWithdrawAllRewards:
+		this.Sum = &Message_MsgWithdrawAllRewards{&vt}
+		return nil
+	case *types6.MsgSetAutoCompound:
+		this.Sum = &Message_MsgSetAutoCompound{vt}
+		return nil
+	case types6.MsgSetAutoCompound:
+		this.Sum = &Message_MsgSetAutoCompound{&vt}
+		return nil
+	}
+	return fmt.Errorf("can't encode value of type %T as message Message", value)
+}
+
+func (m *Account) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Account) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Account) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sum != nil {
+		{
+			size := m.Sum.Size()
 			i -= size
-			i = encodeVarintCodec(dAtA, i, uint64(size))
+			if _, err := m.Sum.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
 		}
-		i--
-		dAtA[i] = 0x2a
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Message_MsgWithdrawValidatorCommission) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *Account_BaseAccount) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Message_MsgWithdrawValidatorCommission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Account_BaseAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.MsgWithdrawValidatorCommission != nil {
+	if m.BaseAccount != nil {
 		{
-			size, err := m.MsgWithdrawValidatorCommission.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.BaseAccount.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2686,20 +3078,20 @@ func (m *Message_MsgWithdrawValidatorCommission) MarshalToSizedBuffer(dAtA []byt
 			i = encodeVarintCodec(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Message_MsgFundCommunityPool) MarshalTo(dAtA []byte) (int, error) {
+func (m *Account_ContinuousVestingAccount) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Message_MsgFundCommunityPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Account_ContinuousVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.MsgFundCommunityPool != nil {
+	if m.ContinuousVestingAccount != nil {
 		{
-			size, err := m.MsgFundCommunityPool.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ContinuousVestingAccount.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2707,20 +3099,20 @@ func (m *Message_MsgFundCommunityPool) MarshalToSizedBuffer(dAtA []byte) (int, e
 			i = encodeVarintCodec(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x12
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Message_MsgSubmitEvidence) MarshalTo(dAtA []byte) (int, error) {
+func (m *Account_DelayedVestingAccount) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Message_MsgSubmitEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Account_DelayedVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.MsgSubmitEvidence != nil {
+	if m.DelayedVestingAccount != nil {
 		{
-			size, err := m.MsgSubmitEvidence.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.DelayedVestingAccount.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2728,20 +3120,20 @@ func (m *Message_MsgSubmitEvidence) MarshalToSizedBuffer(dAtA []byte) (int, erro
 			i = encodeVarintCodec(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x42
+		dAtA[i] = 0x1a
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Message_MsgSubmitProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *Account_PeriodicVestingAccount) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Message_MsgSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Account_PeriodicVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.MsgSubmitProposal != nil {
+	if m.PeriodicVestingAccount != nil {
 		{
-			size, err := m.MsgSubmitProposal.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.PeriodicVestingAccount.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2749,20 +3141,20 @@ func (m *Message_MsgSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, erro
 			i = encodeVarintCodec(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x4a
+		dAtA[i] = 0x22
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Message_MsgVote) MarshalTo(dAtA []byte) (int, error) {
+func (m *Account_ModuleAccount) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Message_MsgVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Account_ModuleAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.MsgVote != nil {
+	if m.ModuleAccount != nil {
 		{
-			size, err := m.MsgVote.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ModuleAccount.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2770,20 +3162,20 @@ func (m *Message_MsgVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintCodec(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x52
+		dAtA[i] = 0x2a
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Message_MsgDeposit) MarshalTo(dAtA []byte) (int, error) {
+func (m *Account_ClawbackVestingAccount) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Message_MsgDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Account_ClawbackVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.MsgDeposit != nil {
+	if m.ClawbackVestingAccount != nil {
 		{
-			size, err := m.MsgDeposit.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ClawbackVestingAccount.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2791,62 +3183,52 @@ func (m *Message_MsgDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintCodec(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x5a
+		dAtA[i] = 0x32
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Message_MsgUnjail) MarshalTo(dAtA []byte) (int, error) {
+func (m *Supply) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Message_MsgUnjail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.MsgUnjail != nil {
-		{
-			size, err := m.MsgUnjail.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCodec(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x62
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return dAtA[:n], nil
 }
-func (m *Message_MsgCreateValidator) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *Supply) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Message_MsgCreateValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Supply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.MsgCreateValidator != nil {
+	_ = i
+	var l int
+	_ = l
+	if m.Sum != nil {
 		{
-			size, err := m.MsgCreateValidator.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
+			size := m.Sum.Size()
+			i -= size
+			if _, err := m.Sum.MarshalTo(dAtA[i:]); err != nil {
 				return 0, err
 			}
-			i -= size
-			i = encodeVarintCodec(dAtA, i, uint64(size))
 		}
-		i--
-		dAtA[i] = 0x6a
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Message_MsgEditValidator) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *Supply_Supply) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Message_MsgEditValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Supply_Supply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.MsgEditValidator != nil {
+	if m.Supply != nil {
 		{
-			size, err := m.MsgEditValidator.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Supply.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2854,41 +3236,52 @@ func (m *Message_MsgEditValidator) MarshalToSizedBuffer(dAtA []byte) (int, error
 			i = encodeVarintCodec(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x72
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Message_MsgDelegate) MarshalTo(dAtA []byte) (int, error) {
+func (m *Evidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Evidence) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Message_MsgDelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Evidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.MsgDelegate != nil {
+	_ = i
+	var l int
+	_ = l
+	if m.Sum != nil {
 		{
-			size, err := m.MsgDelegate.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
+			size := m.Sum.Size()
+			i -= size
+			if _, err := m.Sum.MarshalTo(dAtA[i:]); err != nil {
 				return 0, err
 			}
-			i -= size
-			i = encodeVarintCodec(dAtA, i, uint64(size))
 		}
-		i--
-		dAtA[i] = 0x7a
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Message_MsgBeginRedelegate) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *Evidence_Equivocation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Message_MsgBeginRedelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Evidence_Equivocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.MsgBeginRedelegate != nil {
+	if m.Equivocation != nil {
 		{
-			size, err := m.MsgBeginRedelegate.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Equivocation.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2896,22 +3289,33 @@ func (m *Message_MsgBeginRedelegate) MarshalToSizedBuffer(dAtA []byte) (int, err
 			i = encodeVarintCodec(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Message_MsgUndelegate) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitEvidence) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Message_MsgUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.MsgUndelegate != nil {
+	_ = i
+	var l int
+	_ = l
+	if m.Evidence != nil {
 		{
-			size, err := m.MsgUndelegate.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Evidence.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2919,13 +3323,22 @@ func (m *Message_MsgUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintCodec(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x8a
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.MsgSubmitEvidenceBase.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCodec(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
-func (m *SignDoc) Marshal() (dAtA []byte, err error) {
+
+func (m *MsgSubmitProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2935,20 +3348,20 @@ func (m *SignDoc) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SignDoc) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitProposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SignDoc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Msgs) > 0 {
-		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2956,11 +3369,23 @@ func (m *SignDoc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintCodec(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Content != nil {
+		{
+			size, err := m.Content.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
 	{
-		size, err := m.StdSignDocBase.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.MsgSubmitProposalBase.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2972,7 +3397,7 @@ func (m *SignDoc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *StdFee) Marshal() (dAtA []byte, err error) {
+func (m *Proposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2982,25 +3407,20 @@ func (m *StdFee) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StdFee) MarshalTo(dAtA []byte) (int, error) {
+func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StdFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Gas != 0 {
-		i = encodeVarintCodec(dAtA, i, uint64(m.Gas))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3008,13 +3428,33 @@ func (m *StdFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintCodec(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Content.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCodec(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ProposalBase.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintCodec(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *StdSignature) Marshal() (dAtA []byte, err error) {
+func (m *Content) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3024,64 +3464,178 @@ func (m *StdSignature) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StdSignature) MarshalTo(dAtA []byte) (int, error) {
+func (m *Content) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StdSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Content) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.PubKey) > 0 {
-		i -= len(m.PubKey)
-		copy(dAtA[i:], m.PubKey)
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.PubKey)))
-		i--
-		dAtA[i] = 0xa
+	if m.Sum != nil {
+		{
+			size := m.Sum.Size()
+			i -= size
+			if _, err := m.Sum.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *StdTxBase) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *StdTxBase) MarshalTo(dAtA []byte) (int, error) {
+func (m *Content_Text) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StdTxBase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Content_Text) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Memo) > 0 {
-		i -= len(m.Memo)
-		copy(dAtA[i:], m.Memo)
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Memo)))
+	if m.Text != nil {
+		{
+			size, err := m.Text.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Content_ParameterChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Content_ParameterChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ParameterChange != nil {
+		{
+			size, err := m.ParameterChange.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Content_SoftwareUpgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Content_SoftwareUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SoftwareUpgrade != nil {
+		{
+			size, err := m.SoftwareUpgrade.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Signatures) > 0 {
-		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+	return len(dAtA) - i, nil
+}
+func (m *Content_CancelSoftwareUpgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Content_CancelSoftwareUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CancelSoftwareUpgrade != nil {
+		{
+			size, err := m.CancelSoftwareUpgrade.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Content_CommunityPoolSpend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Content_CommunityPoolSpend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CommunityPoolSpend != nil {
+		{
+			size, err := m.CommunityPoolSpend.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Content_SetSendEnabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Content_SetSendEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SetSendEnabled != nil {
+		{
+			size, err := m.SetSendEnabled.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Transaction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Transaction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Transaction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3093,7 +3647,7 @@ func (m *StdTxBase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	{
-		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.StdTxBase.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -3105,7 +3659,7 @@ func (m *StdTxBase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *StdSignDocBase) Marshal() (dAtA []byte, err error) {
+func (m *Message) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3115,637 +3669,2379 @@ func (m *StdSignDocBase) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StdSignDocBase) MarshalTo(dAtA []byte) (int, error) {
+func (m *Message) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StdSignDocBase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Sum != nil {
+		{
+			size := m.Sum.Size()
+			i -= size
+			if _, err := m.Sum.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
 		}
-		i -= size
-		i = encodeVarintCodec(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.Memo) > 0 {
-		i -= len(m.Memo)
-		copy(dAtA[i:], m.Memo)
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Memo)))
-		i--
-		dAtA[i] = 0x22
 	}
-	if m.Sequence != 0 {
-		i = encodeVarintCodec(dAtA, i, uint64(m.Sequence))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.AccountNumber != 0 {
-		i = encodeVarintCodec(dAtA, i, uint64(m.AccountNumber))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.ChainID) > 0 {
-		i -= len(m.ChainID)
-		copy(dAtA[i:], m.ChainID)
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.ChainID)))
+	return len(dAtA) - i, nil
+}
+
+func (m *Message_MsgSend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_MsgSend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MsgSend != nil {
+		{
+			size, err := m.MsgSend.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Message_MsgMultiSend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
 
-func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
-	offset -= sovCodec(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Message_MsgMultiSend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MsgMultiSend != nil {
+		{
+			size, err := m.MsgMultiSend.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return len(dAtA) - i, nil
 }
-func (m *Account) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Sum != nil {
-		n += m.Sum.Size()
-	}
-	return n
+func (m *Message_MsgVerifyInvariant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Account_BaseAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BaseAccount != nil {
-		l = m.BaseAccount.Size()
-		n += 1 + l + sovCodec(uint64(l))
+func (m *Message_MsgVerifyInvariant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MsgVerifyInvariant != nil {
+		{
+			size, err := m.MsgVerifyInvariant.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Account_ContinuousVestingAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ContinuousVestingAccount != nil {
-		l = m.ContinuousVestingAccount.Size()
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	return n
+func (m *Message_MsgSetWithdrawAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *Account_DelayedVestingAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.DelayedVestingAccount != nil {
-		l = m.DelayedVestingAccount.Size()
-		n += 1 + l + sovCodec(uint64(l))
+
+func (m *Message_MsgSetWithdrawAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MsgSetWithdrawAddress != nil {
+		{
+			size, err := m.MsgSetWithdrawAddress.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Account_PeriodicVestingAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PeriodicVestingAccount != nil {
-		l = m.PeriodicVestingAccount.Size()
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	return n
+func (m *Message_MsgWithdrawDelegatorReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *Account_ModuleAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ModuleAccount != nil {
-		l = m.ModuleAccount.Size()
-		n += 1 + l + sovCodec(uint64(l))
+
+func (m *Message_MsgWithdrawDelegatorReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MsgWithdrawDelegatorReward != nil {
+		{
+			size, err := m.MsgWithdrawDelegatorReward.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Supply) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Sum != nil {
-		n += m.Sum.Size()
-	}
-	return n
+func (m *Message_MsgWithdrawValidatorCommission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Supply_Supply) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Supply != nil {
-		l = m.Supply.Size()
-		n += 1 + l + sovCodec(uint64(l))
+func (m *Message_MsgWithdrawValidatorCommission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MsgWithdrawValidatorCommission != nil {
+		{
+			size, err := m.MsgWithdrawValidatorCommission.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Evidence) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Sum != nil {
-		n += m.Sum.Size()
-	}
-	return n
+func (m *Message_MsgFundCommunityPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Evidence_Equivocation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Equivocation != nil {
-		l = m.Equivocation.Size()
-		n += 1 + l + sovCodec(uint64(l))
+func (m *Message_MsgFundCommunityPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MsgFundCommunityPool != nil {
+		{
+			size, err := m.MsgFundCommunityPool.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *MsgSubmitEvidence) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.MsgSubmitEvidenceBase.Size()
-	n += 1 + l + sovCodec(uint64(l))
-	if m.Evidence != nil {
-		l = m.Evidence.Size()
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	return n
+func (m *Message_MsgSubmitEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubmitProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.MsgSubmitProposalBase.Size()
-	n += 1 + l + sovCodec(uint64(l))
-	if m.Content != nil {
-		l = m.Content.Size()
-		n += 1 + l + sovCodec(uint64(l))
+func (m *Message_MsgSubmitEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MsgSubmitEvidence != nil {
+		{
+			size, err := m.MsgSubmitEvidence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-
-func (m *Proposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ProposalBase.Size()
-	n += 1 + l + sovCodec(uint64(l))
-	l = m.Content.Size()
-	n += 1 + l + sovCodec(uint64(l))
-	return n
+func (m *Message_MsgSubmitProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Content) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Sum != nil {
-		n += m.Sum.Size()
+func (m *Message_MsgSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MsgSubmitProposal != nil {
+		{
+			size, err := m.MsgSubmitProposal.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *Message_MsgVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Content_Text) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Text != nil {
-		l = m.Text.Size()
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	return n
-}
-func (m *Content_ParameterChange) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ParameterChange != nil {
-		l = m.ParameterChange.Size()
-		n += 1 + l + sovCodec(uint64(l))
+func (m *Message_MsgVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MsgVote != nil {
+		{
+			size, err := m.MsgVote.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Content_SoftwareUpgrade) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SoftwareUpgrade != nil {
-		l = m.SoftwareUpgrade.Size()
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	return n
+func (m *Message_MsgDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *Content_CancelSoftwareUpgrade) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CancelSoftwareUpgrade != nil {
-		l = m.CancelSoftwareUpgrade.Size()
-		n += 1 + l + sovCodec(uint64(l))
+
+func (m *Message_MsgDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MsgDeposit != nil {
+		{
+			size, err := m.MsgDeposit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Content_CommunityPoolSpend) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CommunityPoolSpend != nil {
-		l = m.CommunityPoolSpend.Size()
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	return n
+func (m *Message_MsgUnjail) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *Transaction) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.StdTxBase.Size()
-	n += 1 + l + sovCodec(uint64(l))
-	if len(m.Msgs) > 0 {
-		for _, e := range m.Msgs {
-			l = e.Size()
-			n += 1 + l + sovCodec(uint64(l))
+
+func (m *Message_MsgUnjail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MsgUnjail != nil {
+		{
+			size, err := m.MsgUnjail.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x62
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *Message_MsgCreateValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Message) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Sum != nil {
-		n += m.Sum.Size()
+func (m *Message_MsgCreateValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MsgCreateValidator != nil {
+		{
+			size, err := m.MsgCreateValidator.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *Message_MsgEditValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Message_MsgSend) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MsgSend != nil {
-		l = m.MsgSend.Size()
-		n += 1 + l + sovCodec(uint64(l))
+func (m *Message_MsgEditValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MsgEditValidator != nil {
+		{
+			size, err := m.MsgEditValidator.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Message_MsgMultiSend) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MsgMultiSend != nil {
-		l = m.MsgMultiSend.Size()
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	return n
+func (m *Message_MsgDelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *Message_MsgVerifyInvariant) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MsgVerifyInvariant != nil {
-		l = m.MsgVerifyInvariant.Size()
-		n += 1 + l + sovCodec(uint64(l))
+
+func (m *Message_MsgDelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MsgDelegate != nil {
+		{
+			size, err := m.MsgDelegate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Message_MsgSetWithdrawAddress) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MsgSetWithdrawAddress != nil {
-		l = m.MsgSetWithdrawAddress.Size()
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	return n
+func (m *Message_MsgBeginRedelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *Message_MsgWithdrawDelegatorReward) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MsgWithdrawDelegatorReward != nil {
-		l = m.MsgWithdrawDelegatorReward.Size()
-		n += 1 + l + sovCodec(uint64(l))
+
+func (m *Message_MsgBeginRedelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MsgBeginRedelegate != nil {
+		{
+			size, err := m.MsgBeginRedelegate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Message_MsgWithdrawValidatorCommission) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MsgWithdrawValidatorCommission != nil {
-		l = m.MsgWithdrawValidatorCommission.Size()
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	return n
-}
-func (m *Message_MsgFundCommunityPool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MsgFundCommunityPool != nil {
-		l = m.MsgFundCommunityPool.Size()
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	return n
+func (m *Message_MsgUndelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *Message_MsgSubmitEvidence) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MsgSubmitEvidence != nil {
-		l = m.MsgSubmitEvidence.Size()
-		n += 1 + l + sovCodec(uint64(l))
+
+func (m *Message_MsgUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MsgUndelegate != nil {
+		{
+			size, err := m.MsgUndelegate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Message_MsgSubmitProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MsgSubmitProposal != nil {
-		l = m.MsgSubmitProposal.Size()
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	return n
+func (m *Message_MsgCreateVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *Message_MsgVote) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MsgVote != nil {
-		l = m.MsgVote.Size()
-		n += 1 + l + sovCodec(uint64(l))
+
+func (m *Message_MsgCreateVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MsgCreateVestingAccount != nil {
+		{
+			size, err := m.MsgCreateVestingAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Message_MsgDeposit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MsgDeposit != nil {
-		l = m.MsgDeposit.Size()
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	return n
+func (m *Message_MsgCreateClawbackVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *Message_MsgUnjail) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MsgUnjail != nil {
-		l = m.MsgUnjail.Size()
-		n += 1 + l + sovCodec(uint64(l))
+
+func (m *Message_MsgCreateClawbackVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MsgCreateClawbackVestingAccount != nil {
+		{
+			size, err := m.MsgCreateClawbackVestingAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Message_MsgCreateValidator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MsgCreateValidator != nil {
-		l = m.MsgCreateValidator.Size()
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	return n
+func (m *Message_MsgClawback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *Message_MsgEditValidator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MsgEditValidator != nil {
-		l = m.MsgEditValidator.Size()
-		n += 1 + l + sovCodec(uint64(l))
+
+func (m *Message_MsgClawback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MsgClawback != nil {
+		{
+			size, err := m.MsgClawback.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Message_MsgDelegate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MsgDelegate != nil {
-		l = m.MsgDelegate.Size()
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	return n
+func (m *Message_MsgChangePubKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *Message_MsgBeginRedelegate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MsgBeginRedelegate != nil {
-		l = m.MsgBeginRedelegate.Size()
-		n += 2 + l + sovCodec(uint64(l))
+
+func (m *Message_MsgChangePubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MsgChangePubKey != nil {
+		{
+			size, err := m.MsgChangePubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Message_MsgUndelegate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MsgUndelegate != nil {
-		l = m.MsgUndelegate.Size()
-		n += 2 + l + sovCodec(uint64(l))
-	}
-	return n
+func (m *Message_MsgCancelUnbondingDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *SignDoc) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.StdSignDocBase.Size()
-	n += 1 + l + sovCodec(uint64(l))
-	if len(m.Msgs) > 0 {
-		for _, e := range m.Msgs {
-			l = e.Size()
-			n += 1 + l + sovCodec(uint64(l))
+
+func (m *Message_MsgCancelUnbondingDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MsgCancelUnbondingDelegation != nil {
+		{
+			size, err := m.MsgCancelUnbondingDelegation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *Message_MsgTokenizeShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StdFee) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovCodec(uint64(l))
+func (m *Message_MsgTokenizeShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MsgTokenizeShares != nil {
+		{
+			size, err := m.MsgTokenizeShares.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
 	}
-	if m.Gas != 0 {
-		n += 1 + sovCodec(uint64(m.Gas))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
-
-func (m *StdSignature) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Message_MsgRedeemTokensForShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_MsgRedeemTokensForShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MsgRedeemTokensForShares != nil {
+		{
+			size, err := m.MsgRedeemTokensForShares.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_MsgWithdrawAllRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_MsgWithdrawAllRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MsgWithdrawAllRewards != nil {
+		{
+			size, err := m.MsgWithdrawAllRewards.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Message_MsgSetAutoCompound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Message_MsgSetAutoCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MsgSetAutoCompound != nil {
+		{
+			size, err := m.MsgSetAutoCompound.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *SignDoc) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SignDoc) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignDoc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PubKey)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCodec(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	{
+		size, err := m.StdSignDocBase.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCodec(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *StdTxBase) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StdFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *StdFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StdFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Fee.Size()
-	n += 1 + l + sovCodec(uint64(l))
-	if len(m.Signatures) > 0 {
-		for _, e := range m.Signatures {
-			l = e.Size()
-			n += 1 + l + sovCodec(uint64(l))
+	if m.Tip != nil {
+		{
+			size, err := m.Tip.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCodec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Gas != 0 {
+		i = encodeVarintCodec(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCodec(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Tip) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Tip) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Tip) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tipper) > 0 {
+		i -= len(m.Tipper)
+		copy(dAtA[i:], m.Tipper)
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Tipper)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCodec(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StdSignature) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StdSignature) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StdSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StdTxBase) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StdTxBase) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StdTxBase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCodec(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCodec(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *StdSignDocBase) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StdSignDocBase) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StdSignDocBase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCodec(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintCodec(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AccountNumber != 0 {
+		i = encodeVarintCodec(dAtA, i, uint64(m.AccountNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCodec(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Account) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sum != nil {
+		n += m.Sum.Size()
+	}
+	return n
+}
+
+func (m *Account_BaseAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseAccount != nil {
+		l = m.BaseAccount.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Account_ContinuousVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContinuousVestingAccount != nil {
+		l = m.ContinuousVestingAccount.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Account_DelayedVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DelayedVestingAccount != nil {
+		l = m.DelayedVestingAccount.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Account_PeriodicVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PeriodicVestingAccount != nil {
+		l = m.PeriodicVestingAccount.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Account_ModuleAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ModuleAccount != nil {
+		l = m.ModuleAccount.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Account_ClawbackVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClawbackVestingAccount != nil {
+		l = m.ClawbackVestingAccount.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Supply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sum != nil {
+		n += m.Sum.Size()
+	}
+	return n
+}
+
+func (m *Supply_Supply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Supply != nil {
+		l = m.Supply.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Evidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sum != nil {
+		n += m.Sum.Size()
+	}
+	return n
+}
+
+func (m *Evidence_Equivocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Equivocation != nil {
+		l = m.Equivocation.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *MsgSubmitEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MsgSubmitEvidenceBase.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	if m.Evidence != nil {
+		l = m.Evidence.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MsgSubmitProposalBase.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	if m.Content != nil {
+		l = m.Content.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Proposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ProposalBase.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	l = m.Content.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Content) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sum != nil {
+		n += m.Sum.Size()
+	}
+	return n
+}
+
+func (m *Content_Text) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Text != nil {
+		l = m.Text.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Content_ParameterChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParameterChange != nil {
+		l = m.ParameterChange.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Content_SoftwareUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SoftwareUpgrade != nil {
+		l = m.SoftwareUpgrade.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Content_CancelSoftwareUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CancelSoftwareUpgrade != nil {
+		l = m.CancelSoftwareUpgrade.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Content_CommunityPoolSpend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CommunityPoolSpend != nil {
+		l = m.CommunityPoolSpend.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Content_SetSendEnabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SetSendEnabled != nil {
+		l = m.SetSendEnabled.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Transaction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StdTxBase.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Message) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sum != nil {
+		n += m.Sum.Size()
+	}
+	return n
+}
+
+func (m *Message_MsgSend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgSend != nil {
+		l = m.MsgSend.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Message_MsgMultiSend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgMultiSend != nil {
+		l = m.MsgMultiSend.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Message_MsgVerifyInvariant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgVerifyInvariant != nil {
+		l = m.MsgVerifyInvariant.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Message_MsgSetWithdrawAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgSetWithdrawAddress != nil {
+		l = m.MsgSetWithdrawAddress.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Message_MsgWithdrawDelegatorReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgWithdrawDelegatorReward != nil {
+		l = m.MsgWithdrawDelegatorReward.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Message_MsgWithdrawValidatorCommission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgWithdrawValidatorCommission != nil {
+		l = m.MsgWithdrawValidatorCommission.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Message_MsgFundCommunityPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgFundCommunityPool != nil {
+		l = m.MsgFundCommunityPool.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Message_MsgSubmitEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgSubmitEvidence != nil {
+		l = m.MsgSubmitEvidence.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Message_MsgSubmitProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgSubmitProposal != nil {
+		l = m.MsgSubmitProposal.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Message_MsgVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgVote != nil {
+		l = m.MsgVote.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Message_MsgDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgDeposit != nil {
+		l = m.MsgDeposit.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Message_MsgUnjail) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgUnjail != nil {
+		l = m.MsgUnjail.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Message_MsgCreateValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgCreateValidator != nil {
+		l = m.MsgCreateValidator.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Message_MsgEditValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgEditValidator != nil {
+		l = m.MsgEditValidator.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Message_MsgDelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgDelegate != nil {
+		l = m.MsgDelegate.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Message_MsgBeginRedelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgBeginRedelegate != nil {
+		l = m.MsgBeginRedelegate.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Message_MsgUndelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgUndelegate != nil {
+		l = m.MsgUndelegate.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Message_MsgCreateVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgCreateVestingAccount != nil {
+		l = m.MsgCreateVestingAccount.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Message_MsgCreateClawbackVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgCreateClawbackVestingAccount != nil {
+		l = m.MsgCreateClawbackVestingAccount.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Message_MsgClawback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgClawback != nil {
+		l = m.MsgClawback.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Message_MsgChangePubKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgChangePubKey != nil {
+		l = m.MsgChangePubKey.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Message_MsgCancelUnbondingDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgCancelUnbondingDelegation != nil {
+		l = m.MsgCancelUnbondingDelegation.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Message_MsgTokenizeShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgTokenizeShares != nil {
+		l = m.MsgTokenizeShares.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Message_MsgRedeemTokensForShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgRedeemTokensForShares != nil {
+		l = m.MsgRedeemTokensForShares.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Message_MsgWithdrawAllRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgWithdrawAllRewards != nil {
+		l = m.MsgWithdrawAllRewards.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Message_MsgSetAutoCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgSetAutoCompound != nil {
+		l = m.MsgSetAutoCompound.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *SignDoc) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StdSignDocBase.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StdFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if m.Gas != 0 {
+		n += 1 + sovCodec(uint64(m.Gas))
+	}
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Tip != nil {
+		l = m.Tip.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *Tip) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	l = len(m.Tipper)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *StdSignature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *StdTxBase) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Fee.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *StdSignDocBase) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.AccountNumber != 0 {
+		n += 1 + sovCodec(uint64(m.AccountNumber))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovCodec(uint64(m.Sequence))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	return n
+}
+
+func sovCodec(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCodec(x uint64) (n int) {
+	return sovCodec(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Account) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Account: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Account: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &types.BaseAccount{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Account_BaseAccount{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinuousVestingAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &types1.ContinuousVestingAccount{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Account_ContinuousVestingAccount{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayedVestingAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &types1.DelayedVestingAccount{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Account_DelayedVestingAccount{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodicVestingAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &types1.PeriodicVestingAccount{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Account_PeriodicVestingAccount{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &types.ModuleAccount{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Account_ModuleAccount{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawbackVestingAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &types1.ClawbackVestingAccount{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Account_ClawbackVestingAccount{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Supply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Supply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Supply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &types2.Supply{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Supply_Supply{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Evidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Evidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Evidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Equivocation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &types3.Equivocation{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Evidence_Equivocation{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgSubmitEvidenceBase", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MsgSubmitEvidenceBase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Evidence == nil {
+				m.Evidence = &Evidence{}
+			}
+			if err := m.Evidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgSubmitProposalBase", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MsgSubmitProposalBase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Content == nil {
+				m.Content = &Content{}
+			}
+			if err := m.Content.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, Message{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = len(m.Memo)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	return n
-}
 
-func (m *StdSignDocBase) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainID)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	if m.AccountNumber != 0 {
-		n += 1 + sovCodec(uint64(m.AccountNumber))
-	}
-	if m.Sequence != 0 {
-		n += 1 + sovCodec(uint64(m.Sequence))
-	}
-	l = len(m.Memo)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.Fee.Size()
-	n += 1 + l + sovCodec(uint64(l))
-	return n
-}
-
-func sovCodec(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozCodec(x uint64) (n int) {
-	return sovCodec(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Account) Unmarshal(dAtA []byte) error {
+func (m *Proposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3768,15 +6064,15 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Account: wiretype end group for non-group")
+			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Account: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalBase", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3803,15 +6099,133 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types.BaseAccount{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ProposalBase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Account_BaseAccount{v}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContinuousVestingAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Content.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, Message{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Content) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Content: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Content: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3838,15 +6252,15 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types1.ContinuousVestingAccount{}
+			v := &types4.TextProposal{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Account_ContinuousVestingAccount{v}
+			m.Sum = &Content_Text{v}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelayedVestingAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParameterChange", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3873,15 +6287,15 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types1.DelayedVestingAccount{}
+			v := &proposal.ParameterChangeProposal{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Account_DelayedVestingAccount{v}
+			m.Sum = &Content_ParameterChange{v}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeriodicVestingAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SoftwareUpgrade", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3908,15 +6322,15 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types1.PeriodicVestingAccount{}
+			v := &types5.SoftwareUpgradeProposal{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Account_PeriodicVestingAccount{v}
+			m.Sum = &Content_SoftwareUpgrade{v}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelSoftwareUpgrade", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3943,68 +6357,15 @@ func (m *Account) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types.ModuleAccount{}
+			v := &types5.CancelSoftwareUpgradeProposal{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Account_ModuleAccount{v}
+			m.Sum = &Content_CancelSoftwareUpgrade{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCodec(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Supply) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCodec
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Supply: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Supply: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolSpend", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4031,68 +6392,15 @@ func (m *Supply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types2.Supply{}
+			v := &types6.CommunityPoolSpendProposal{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Supply_Supply{v}
+			m.Sum = &Content_CommunityPoolSpend{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCodec(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Evidence) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCodec
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Evidence: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Evidence: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Equivocation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SetSendEnabled", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4119,11 +6427,11 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types3.Equivocation{}
+			v := &types2.SetSendEnabledProposal{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Evidence_Equivocation{v}
+			m.Sum = &Content_SetSendEnabled{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4149,7 +6457,7 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitEvidence) Unmarshal(dAtA []byte) error {
+func (m *Transaction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4172,15 +6480,15 @@ func (m *MsgSubmitEvidence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitEvidence: wiretype end group for non-group")
+			return fmt.Errorf("proto: Transaction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Transaction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgSubmitEvidenceBase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StdTxBase", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4207,13 +6515,13 @@ func (m *MsgSubmitEvidence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MsgSubmitEvidenceBase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StdTxBase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4240,10 +6548,8 @@ func (m *MsgSubmitEvidence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Evidence == nil {
-				m.Evidence = &Evidence{}
-			}
-			if err := m.Evidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Msgs = append(m.Msgs, Message{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4271,7 +6577,7 @@ func (m *MsgSubmitEvidence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
+func (m *Message) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4294,15 +6600,15 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: Message: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Message: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgSubmitProposalBase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgSend", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4329,13 +6635,15 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MsgSubmitProposalBase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &types2.MsgSend{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Sum = &Message_MsgSend{v}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgMultiSend", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4357,74 +6665,20 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 			}
 			postIndex := iNdEx + msglen
 			if postIndex < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Content == nil {
-				m.Content = &Content{}
-			}
-			if err := m.Content.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCodec(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Proposal) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCodec
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &types2.MsgMultiSend{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Message_MsgMultiSend{v}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalBase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgVerifyInvariant", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4451,13 +6705,15 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProposalBase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &types7.MsgVerifyInvariant{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Sum = &Message_MsgVerifyInvariant{v}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgSetWithdrawAddress", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4484,66 +6740,15 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Content.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &types6.MsgSetWithdrawAddress{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Sum = &Message_MsgSetWithdrawAddress{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCodec(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Content) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCodec
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Content: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Content: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgWithdrawDelegatorReward", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4570,15 +6775,15 @@ func (m *Content) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types4.TextProposal{}
+			v := &types6.MsgWithdrawDelegatorReward{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Content_Text{v}
+			m.Sum = &Message_MsgWithdrawDelegatorReward{v}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParameterChange", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgWithdrawValidatorCommission", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4605,15 +6810,15 @@ func (m *Content) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &proposal.ParameterChangeProposal{}
+			v := &types6.MsgWithdrawValidatorCommission{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Content_ParameterChange{v}
+			m.Sum = &Message_MsgWithdrawValidatorCommission{v}
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SoftwareUpgrade", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgFundCommunityPool", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4640,15 +6845,15 @@ func (m *Content) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types5.SoftwareUpgradeProposal{}
+			v := &types6.MsgFundCommunityPool{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Content_SoftwareUpgrade{v}
+			m.Sum = &Message_MsgFundCommunityPool{v}
 			iNdEx = postIndex
-		case 4:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CancelSoftwareUpgrade", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgSubmitEvidence", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4675,15 +6880,15 @@ func (m *Content) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types5.CancelSoftwareUpgradeProposal{}
+			v := &MsgSubmitEvidence{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Content_CancelSoftwareUpgrade{v}
+			m.Sum = &Message_MsgSubmitEvidence{v}
 			iNdEx = postIndex
-		case 5:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolSpend", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgSubmitProposal", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4710,68 +6915,15 @@ func (m *Content) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types6.CommunityPoolSpendProposal{}
+			v := &MsgSubmitProposal{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Content_CommunityPoolSpend{v}
+			m.Sum = &Message_MsgSubmitProposal{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCodec(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Transaction) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCodec
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Transaction: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Transaction: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StdTxBase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgVote", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4798,13 +6950,15 @@ func (m *Transaction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StdTxBase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &types4.MsgVote{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Sum = &Message_MsgVote{v}
 			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgDeposit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4831,67 +6985,15 @@ func (m *Transaction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msgs = append(m.Msgs, Message{})
-			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &types4.MsgDeposit{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Sum = &Message_MsgDeposit{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCodec(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Message) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCodec
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Message: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Message: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgSend", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgUnjail", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4918,15 +7020,15 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types2.MsgSend{}
+			v := &types8.MsgUnjail{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Message_MsgSend{v}
+			m.Sum = &Message_MsgUnjail{v}
 			iNdEx = postIndex
-		case 2:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgMultiSend", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgCreateValidator", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4953,15 +7055,15 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types2.MsgMultiSend{}
+			v := &types9.MsgCreateValidator{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Message_MsgMultiSend{v}
+			m.Sum = &Message_MsgCreateValidator{v}
 			iNdEx = postIndex
-		case 3:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgVerifyInvariant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgEditValidator", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4988,15 +7090,15 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types7.MsgVerifyInvariant{}
+			v := &types9.MsgEditValidator{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Message_MsgVerifyInvariant{v}
+			m.Sum = &Message_MsgEditValidator{v}
 			iNdEx = postIndex
-		case 4:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgSetWithdrawAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgDelegate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5023,15 +7125,15 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types6.MsgSetWithdrawAddress{}
+			v := &types9.MsgDelegate{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Message_MsgSetWithdrawAddress{v}
+			m.Sum = &Message_MsgDelegate{v}
 			iNdEx = postIndex
-		case 5:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgWithdrawDelegatorReward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgBeginRedelegate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5058,15 +7160,15 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types6.MsgWithdrawDelegatorReward{}
+			v := &types9.MsgBeginRedelegate{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Message_MsgWithdrawDelegatorReward{v}
+			m.Sum = &Message_MsgBeginRedelegate{v}
 			iNdEx = postIndex
-		case 6:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgWithdrawValidatorCommission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgUndelegate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5093,15 +7195,15 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types6.MsgWithdrawValidatorCommission{}
+			v := &types9.MsgUndelegate{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Message_MsgWithdrawValidatorCommission{v}
+			m.Sum = &Message_MsgUndelegate{v}
 			iNdEx = postIndex
-		case 7:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgFundCommunityPool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgCreateVestingAccount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5128,15 +7230,15 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types6.MsgFundCommunityPool{}
+			v := &types1.MsgCreateVestingAccount{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Message_MsgFundCommunityPool{v}
+			m.Sum = &Message_MsgCreateVestingAccount{v}
 			iNdEx = postIndex
-		case 8:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgSubmitEvidence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgCreateClawbackVestingAccount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5163,15 +7265,15 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MsgSubmitEvidence{}
+			v := &types1.MsgCreateClawbackVestingAccount{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Message_MsgSubmitEvidence{v}
+			m.Sum = &Message_MsgCreateClawbackVestingAccount{v}
 			iNdEx = postIndex
-		case 9:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgSubmitProposal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgClawback", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5198,15 +7300,15 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MsgSubmitProposal{}
+			v := &types1.MsgClawback{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Message_MsgSubmitProposal{v}
+			m.Sum = &Message_MsgClawback{v}
 			iNdEx = postIndex
-		case 10:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgVote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgChangePubKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5233,15 +7335,15 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types4.MsgVote{}
+			v := &types.MsgChangePubKey{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Message_MsgVote{v}
+			m.Sum = &Message_MsgChangePubKey{v}
 			iNdEx = postIndex
-		case 11:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgCancelUnbondingDelegation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5268,15 +7370,15 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types4.MsgDeposit{}
+			v := &types9.MsgCancelUnbondingDelegation{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Message_MsgDeposit{v}
+			m.Sum = &Message_MsgCancelUnbondingDelegation{v}
 			iNdEx = postIndex
-		case 12:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgUnjail", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTokenizeShares", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5303,15 +7405,15 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types8.MsgUnjail{}
+			v := &types9.MsgTokenizeShares{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Message_MsgUnjail{v}
+			m.Sum = &Message_MsgTokenizeShares{v}
 			iNdEx = postIndex
-		case 13:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgCreateValidator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgRedeemTokensForShares", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5338,15 +7440,15 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types9.MsgCreateValidator{}
+			v := &types9.MsgRedeemTokensForShares{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Message_MsgCreateValidator{v}
+			m.Sum = &Message_MsgRedeemTokensForShares{v}
 			iNdEx = postIndex
-		case 14:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgEditValidator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgWithdrawAllRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5373,15 +7475,15 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types9.MsgEditValidator{}
+			v := &types6.MsgWithdrawAllRewards{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Message_MsgEditValidator{v}
+			m.Sum = &Message_MsgWithdrawAllRewards{v}
 			iNdEx = postIndex
-		case 15:
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgDelegate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgSetAutoCompound", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5408,15 +7510,68 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types9.MsgDelegate{}
+			v := &types6.MsgSetAutoCompound{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Message_MsgDelegate{v}
+			m.Sum = &Message_MsgSetAutoCompound{v}
 			iNdEx = postIndex
-		case 16:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignDoc) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignDoc: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignDoc: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgBeginRedelegate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StdSignDocBase", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5443,15 +7598,13 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types9.MsgBeginRedelegate{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StdSignDocBase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Message_MsgBeginRedelegate{v}
 			iNdEx = postIndex
-		case 17:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgUndelegate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5478,11 +7631,10 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &types9.MsgUndelegate{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Msgs = append(m.Msgs, Message{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Message_MsgUndelegate{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5508,7 +7660,7 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignDoc) Unmarshal(dAtA []byte) error {
+func (m *StdFee) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5531,15 +7683,15 @@ func (m *SignDoc) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignDoc: wiretype end group for non-group")
+			return fmt.Errorf("proto: StdFee: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignDoc: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StdFee: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StdSignDocBase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5566,13 +7718,101 @@ func (m *SignDoc) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StdSignDocBase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types10.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payer = append(m.Payer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payer == nil {
+				m.Payer = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = append(m.Granter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Granter == nil {
+				m.Granter = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tip", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5599,8 +7839,10 @@ func (m *SignDoc) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msgs = append(m.Msgs, Message{})
-			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Tip == nil {
+				m.Tip = &Tip{}
+			}
+			if err := m.Tip.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5628,7 +7870,7 @@ func (m *SignDoc) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StdFee) Unmarshal(dAtA []byte) error {
+func (m *Tip) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5651,10 +7893,10 @@ func (m *StdFee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StdFee: wiretype end group for non-group")
+			return fmt.Errorf("proto: Tip: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StdFee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Tip: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5692,10 +7934,10 @@ func (m *StdFee) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tipper", wireType)
 			}
-			m.Gas = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -5705,11 +7947,26 @@ func (m *StdFee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Gas |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tipper = append(m.Tipper[:0], dAtA[iNdEx:postIndex]...)
+			if m.Tipper == nil {
+				m.Tipper = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
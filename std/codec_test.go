@@ -0,0 +1,41 @@
+package std_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+)
+
+func TestMakeCodecVestingTypes(t *testing.T) {
+	addr := sdk.AccAddress("addr")
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	// the vesting accounts are registered without the vesting module
+	cdc := std.MakeCodec(module.NewBasicManager(auth.AppModuleBasic{}))
+
+	var acc authexported.Account = vesting.NewDelayedVestingAccount(auth.NewBaseAccountWithAddress(addr), coins, 100)
+	bz, err := cdc.MarshalBinaryBare(acc)
+	require.NoError(t, err)
+
+	var decoded authexported.Account
+	require.NoError(t, cdc.UnmarshalBinaryBare(bz, &decoded))
+	require.Equal(t, acc, decoded)
+
+	// and the vesting messages along with the vesting module
+	cdc = std.MakeCodec(module.NewBasicManager(auth.AppModuleBasic{}, vesting.AppModuleBasic{}))
+
+	var msg sdk.Msg = vesting.NewMsgCreateVestingAccount(addr, addr, coins, 100, true)
+	bz, err = cdc.MarshalBinaryBare(msg)
+	require.NoError(t, err)
+
+	var decodedMsg sdk.Msg
+	require.NoError(t, cdc.UnmarshalBinaryBare(bz, &decodedMsg))
+	require.Equal(t, msg, decodedMsg)
+}
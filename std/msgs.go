@@ -1,6 +1,8 @@
 package std
 
 import (
+	"reflect"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/evidence"
@@ -30,7 +32,7 @@ func NewMsgSubmitEvidence(evidenceI eviexported.Evidence, s sdk.AccAddress) (Msg
 // MsgSubmitEvidence.
 func (msg MsgSubmitEvidence) ValidateBasic() error {
 	if err := msg.MsgSubmitEvidenceBase.ValidateBasic(); err != nil {
-		return nil
+		return err
 	}
 	if msg.Evidence == nil {
 		return sdkerrors.Wrap(evidence.ErrInvalidEvidence, "missing evidence")
@@ -74,6 +76,9 @@ func (msg MsgSubmitProposal) ValidateBasic() error {
 	if err := msg.Content.GetContent().ValidateBasic(); err != nil {
 		return err
 	}
+	if err := gov.ValidateProposalMessages(msg.GetMessages()); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -89,3 +94,56 @@ func (msg *MsgSubmitProposal) SetContent(content gov.Content) error {
 	msg.Content = stdContent
 	return nil
 }
+
+// GetMessages returns the messages executed once the proposal passes.
+func (msg *MsgSubmitProposal) GetMessages() []sdk.Msg { return getMsgs(msg.Messages) }
+
+// SetMessages sets the messages executed once the proposal passes. It will
+// overwrite any existing messages set.
+func (msg *MsgSubmitProposal) SetMessages(sdkMsgs []sdk.Msg) error {
+	messages, err := newMessages(sdkMsgs)
+	if err != nil {
+		return err
+	}
+
+	msg.Messages = messages
+	return nil
+}
+
+// newMessages returns the application-level Messages of the given sdk.Msgs.
+func newMessages(sdkMsgs []sdk.Msg) ([]Message, error) {
+	if len(sdkMsgs) == 0 {
+		return nil, nil
+	}
+
+	messages := make([]Message, len(sdkMsgs))
+	for i, sdkMsg := range sdkMsgs {
+		if err := messages[i].SetMsg(sdkMsg); err != nil {
+			return nil, err
+		}
+	}
+
+	return messages, nil
+}
+
+// getMsgs returns the sdk.Msgs of the given application-level Messages. The
+// messages are returned by value when their values implement sdk.Msg, as the
+// module handlers expect them.
+func getMsgs(messages []Message) []sdk.Msg {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	sdkMsgs := make([]sdk.Msg, len(messages))
+	for i, m := range messages {
+		sdkMsgs[i] = m.GetMsg()
+
+		if v := reflect.ValueOf(sdkMsgs[i]); v.Kind() == reflect.Ptr && !v.IsNil() {
+			if msg, ok := v.Elem().Interface().(sdk.Msg); ok {
+				sdkMsgs[i] = msg
+			}
+		}
+	}
+
+	return sdkMsgs
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/std"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/evidence"
 )
 
@@ -27,6 +28,11 @@ func TestNewMsgSubmitEvidence(t *testing.T) {
 	require.Equal(t, msg.GetEvidence(), &e)
 	require.Equal(t, msg.GetSubmitter(), s)
 	require.NoError(t, msg.ValidateBasic())
+
+	// a missing submitter fails the basic validation
+	msg, err = std.NewMsgSubmitEvidence(e, nil)
+	require.NoError(t, err)
+	require.Error(t, msg.ValidateBasic())
 }
 
 type invalidProposal struct {
@@ -70,4 +76,19 @@ func TestMsgSubmitProposal(t *testing.T) {
 	err = msg.SetContent(invalidProposal{})
 	require.Error(t, err)
 
+	//
+	// test proposal messages
+	//
+
+	msg, err = std.NewMsgSubmitProposal(c, d, p)
+	require.NoError(t, err)
+	require.Empty(t, msg.GetMessages())
+
+	msgs := []sdk.Msg{bank.NewMsgSend(p, sdk.AccAddress("bar"), d)}
+	require.NoError(t, msg.SetMessages(msgs))
+	require.Equal(t, msgs, msg.GetMessages())
+	require.NoError(t, msg.ValidateBasic())
+
+	require.NoError(t, msg.SetMessages([]sdk.Msg{bank.NewMsgSend(p, nil, d)}))
+	require.Error(t, msg.ValidateBasic())
 }
@@ -1,10 +1,10 @@
 package std
 
 import (
-	"github.com/tendermint/go-amino"
 	"github.com/tendermint/tendermint/crypto"
 
 	clientx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth"
@@ -60,8 +60,8 @@ func (tx Transaction) GetMsgs() []sdk.Msg {
 
 // GetSigners returns the addresses that must sign the transaction. Addresses are
 // returned in a deterministic order. They are accumulated from the GetSigners
-// method for each Msg in the order they appear in tx.GetMsgs(). Duplicate addresses
-// will be omitted.
+// method for each Msg in the order they appear in tx.GetMsgs(), followed by the
+// fee payer and the tipper if they are set. Duplicate addresses will be omitted.
 func (tx Transaction) GetSigners() []sdk.AccAddress {
 	var signers []sdk.AccAddress
 	seen := map[string]bool{}
@@ -75,9 +75,54 @@ func (tx Transaction) GetSigners() []sdk.AccAddress {
 		}
 	}
 
+	if payer := tx.Fee.Payer; !payer.Empty() && !seen[payer.String()] {
+		signers = append(signers, payer)
+		seen[payer.String()] = true
+	}
+
+	if tipper := tx.GetTipper(); !tipper.Empty() && !seen[tipper.String()] {
+		signers = append(signers, tipper)
+	}
+
 	return signers
 }
 
+// FeePayer returns the address that pays the transaction's fees. It is the fee
+// payer if one is set, otherwise the first signer.
+func (tx Transaction) FeePayer() sdk.AccAddress {
+	if !tx.Fee.Payer.Empty() {
+		return tx.Fee.Payer
+	}
+
+	if signers := tx.GetSigners(); len(signers) > 0 {
+		return signers[0]
+	}
+
+	return sdk.AccAddress{}
+}
+
+// FeeGranter returns the address, if any, whose fee allowance pays the
+// transaction's fees.
+func (tx Transaction) FeeGranter() sdk.AccAddress {
+	return tx.Fee.Granter
+}
+
+// GetTip returns the amount tipped to the fee payer, if any.
+func (tx Transaction) GetTip() sdk.Coins {
+	if tx.Fee.Tip == nil {
+		return nil
+	}
+	return tx.Fee.Tip.Amount
+}
+
+// GetTipper returns the address paying the tip, if any.
+func (tx Transaction) GetTipper() sdk.AccAddress {
+	if tx.Fee.Tip == nil {
+		return nil
+	}
+	return tx.Fee.Tip.Tipper
+}
+
 // ValidateBasic does a simple and lightweight validation check that doesn't
 // require access to any other information.
 func (tx Transaction) ValidateBasic() error {
@@ -93,6 +138,11 @@ func (tx Transaction) ValidateBasic() error {
 			sdkerrors.ErrInsufficientFee, "invalid fee provided: %s", tx.Fee.Amount,
 		)
 	}
+	if tip := tx.Fee.Tip; tip != nil {
+		if err := auth.NewTip(tip.Amount, tip.Tipper).Validate(); err != nil {
+			return err
+		}
+	}
 	if len(stdSigs) == 0 {
 		return sdkerrors.ErrNoSignatures
 	}
@@ -153,7 +203,14 @@ func (tx Transaction) GetFee() sdk.Fee {
 
 // SetFee sets the transaction's fee. It will overwrite any existing fee set.
 func (tx *Transaction) SetFee(fee clientx.ClientFee) error {
-	tx.Fee = NewStdFee(fee.GetGas(), fee.GetAmount())
+	stdFee := NewStdFee(fee.GetGas(), fee.GetAmount())
+	if f, ok := fee.(*StdFee); ok {
+		stdFee.Payer = f.Payer
+		stdFee.Granter = f.Granter
+		stdFee.Tip = f.Tip
+	}
+
+	tx.Fee = stdFee
 	return nil
 }
 
@@ -175,6 +232,20 @@ func (tx Transaction) CanonicalSignBytes(cid string, num, seq uint64) ([]byte, e
 	return NewSignDoc(num, seq, cid, tx.Memo, tx.Fee, tx.Msgs...).CanonicalSignBytes()
 }
 
+// LegacyAminoJSONSignBytes returns the legacy amino JSON bytes to sign over for
+// the Transaction given a chain ID, account number and account sequence. They
+// are the bytes an x/auth StdTx with the same contents is signed over.
+func (tx Transaction) LegacyAminoJSONSignBytes(cid string, num, seq uint64) ([]byte, error) {
+	fee := auth.NewStdFee(tx.Fee.Gas, tx.Fee.Amount)
+	fee.Payer = tx.Fee.Payer
+	fee.Granter = tx.Fee.Granter
+	if tx.Fee.Tip != nil {
+		fee.Tip = auth.NewTip(tx.Fee.Tip.Amount, tx.Fee.Tip.Tipper)
+	}
+
+	return auth.StdSignBytes(cid, num, seq, fee, tx.GetMsgs(), tx.Memo), nil
+}
+
 func NewSignDoc(num, seq uint64, cid, memo string, fee StdFee, msgs ...Message) *SignDoc {
 	return &SignDoc{
 		StdSignDocBase: NewStdSignDocBase(num, seq, cid, memo, fee),
@@ -241,13 +312,21 @@ func (m *StdFee) SetAmount(amount sdk.Coins) {
 	m.Amount = amount
 }
 
+func (m StdFee) GetPayer() sdk.AccAddress {
+	return m.Payer
+}
+
+func (m StdFee) GetGranter() sdk.AccAddress {
+	return m.Granter
+}
+
 func (m StdSignature) GetPubKey() crypto.PubKey {
 	var pk crypto.PubKey
 	if len(m.PubKey) == 0 {
 		return nil
 	}
 
-	amino.MustUnmarshalBinaryBare(m.PubKey, &pk)
+	codec.Cdc.MustUnmarshalBinaryBare(m.PubKey, &pk)
 	return pk
 }
 
@@ -34,4 +34,12 @@ func TestTransaction(t *testing.T) {
 	bz, err := tx.CanonicalSignBytes("chain-test", 1, 21)
 	require.NoError(t, err)
 	require.Equal(t, signDocJSON, string(bz))
+
+	// an explicit fee payer must also sign and pays the fees
+	payer := sdk.AccAddress("payer")
+	f.Payer = payer
+	require.NoError(t, tx.SetFee(&f))
+	require.Equal(t, tx.GetSigners(), []sdk.AccAddress{acc1, payer})
+	require.Equal(t, tx.FeePayer(), payer)
+	require.True(t, tx.FeeGranter().Empty())
 }
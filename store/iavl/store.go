@@ -247,6 +247,18 @@ func getHeight(tree Tree, req abci.RequestQuery) int64 {
 	return height
 }
 
+// versionError returns the error of a query at a height whose version doesn't
+// exist, either because it is newer than the latest version, or pruned.
+func versionError(tree Tree, height int64) error {
+	if height < 0 || height > tree.Version() {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidHeight, "%s: height %d, latest height %d", iavl.ErrVersionDoesNotExist, height, tree.Version(),
+		)
+	}
+
+	return sdkerrors.Wrapf(sdkerrors.ErrHeightPruned, "%s: height %d", iavl.ErrVersionDoesNotExist, height)
+}
+
 // Query implements ABCI interface, allows queries
 //
 // by default we will return from (latest height -1),
@@ -271,7 +283,7 @@ func (st *Store) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 
 		res.Key = key
 		if !st.VersionExists(res.Height) {
-			res.Log = iavl.ErrVersionDoesNotExist.Error()
+			res.Codespace, res.Code, res.Log = sdkerrors.ABCIInfo(versionError(tree, res.Height), false)
 			break
 		}
 
@@ -11,6 +11,7 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/store/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var (
@@ -590,6 +591,20 @@ func TestIAVLStoreQuery(t *testing.T) {
 	qres = iavlStore.Query(query0)
 	require.Equal(t, uint32(0), qres.Code)
 	require.Equal(t, v1, qres.Value)
+
+	// the queries of versions newer than the latest one fail
+	query.Height = cid.Version + 1
+	qres = iavlStore.Query(query)
+	require.Equal(t, sdkerrors.ErrInvalidHeight.ABCICode(), qres.Code)
+	require.Equal(t, cid.Version+1, qres.Height)
+	require.Nil(t, qres.Value)
+
+	// as the ones of pruned versions
+	require.NoError(t, tree.DeleteVersion(1))
+	query.Height = 1
+	qres = iavlStore.Query(query)
+	require.Equal(t, sdkerrors.ErrHeightPruned.ABCICode(), qres.Code)
+	require.Nil(t, qres.Value)
 }
 
 func BenchmarkIAVLIteratorNext(b *testing.B) {
@@ -17,7 +17,9 @@ func KVStoreReversePrefixIterator(kvs KVStore, prefix []byte) Iterator {
 }
 
 // DiffKVStores compares two KVstores and returns all the key/value pairs
-// that differ from one another. It also skips value comparison for a set of provided prefixes.
+// that differ from one another. The keys matching one of the provided prefixes
+// are skipped in both stores, so that a different number of such keys does not
+// shift the comparison of the remaining ones.
 func DiffKVStores(a KVStore, b KVStore, prefixesToSkip [][]byte) (kvAs, kvBs []tmkv.Pair) {
 	iterA := a.Iterator(nil, nil)
 
@@ -28,6 +30,9 @@ func DiffKVStores(a KVStore, b KVStore, prefixesToSkip [][]byte) (kvAs, kvBs []t
 	defer iterB.Close()
 
 	for {
+		skipPrefixed(iterA, prefixesToSkip)
+		skipPrefixed(iterB, prefixesToSkip)
+
 		if !iterA.Valid() && !iterB.Valid() {
 			return kvAs, kvBs
 		}
@@ -45,19 +50,27 @@ func DiffKVStores(a KVStore, b KVStore, prefixesToSkip [][]byte) (kvAs, kvBs []t
 			iterB.Next()
 		}
 
-		compareValue := true
+		if !bytes.Equal(kvA.Key, kvB.Key) || !bytes.Equal(kvA.Value, kvB.Value) {
+			kvAs = append(kvAs, kvA)
+			kvBs = append(kvBs, kvB)
+		}
+	}
+}
 
-		for _, prefix := range prefixesToSkip {
-			// Skip value comparison if we matched a prefix
-			if bytes.HasPrefix(kvA.Key, prefix) || bytes.HasPrefix(kvB.Key, prefix) {
-				compareValue = false
+// skipPrefixed advances the iterator past the keys matching one of the
+// prefixes.
+func skipPrefixed(iter Iterator, prefixes [][]byte) {
+	for ; iter.Valid(); iter.Next() {
+		skip := false
+		for _, prefix := range prefixes {
+			if bytes.HasPrefix(iter.Key(), prefix) {
+				skip = true
 				break
 			}
 		}
 
-		if compareValue && (!bytes.Equal(kvA.Key, kvB.Key) || !bytes.Equal(kvA.Value, kvB.Value)) {
-			kvAs = append(kvAs, kvA)
-			kvBs = append(kvBs, kvB)
+		if !skip {
+			return
 		}
 	}
 }
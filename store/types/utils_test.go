@@ -68,6 +68,16 @@ func TestDiffKVStores(t *testing.T) {
 	kvAs, kvBs = types.DiffKVStores(store1, store2, [][]byte{prefix})
 	require.Equal(t, 0, len(kvAs))
 	require.Equal(t, len(kvAs), len(kvBs))
+
+	// A skipped prefix holding more keys in one of the stores doesn't shift the
+	// comparison of the following keys.
+	k3 := []byte("z3")
+	store1.Set(append(prefix, k2...), v1)
+	store1.Set(k3, v1)
+	store2.Set(k3, v1)
+	kvAs, kvBs = types.DiffKVStores(store1, store2, [][]byte{prefix})
+	require.Equal(t, 0, len(kvAs))
+	require.Equal(t, len(kvAs), len(kvBs))
 }
 
 func TestPrefixEndBytes(t *testing.T) {
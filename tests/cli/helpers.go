@@ -20,14 +20,14 @@ import (
 
 var (
 	StartCoins = sdk.NewCoins(
-		sdk.NewCoin(Fee2Denom, sdk.TokensFromConsensusPower(1000000)),
-		sdk.NewCoin(FeeDenom, sdk.TokensFromConsensusPower(1000000)),
-		sdk.NewCoin(FooDenom, sdk.TokensFromConsensusPower(1000)),
-		sdk.NewCoin(Denom, sdk.TokensFromConsensusPower(150)),
+		sdk.NewCoin(Fee2Denom, sdk.TokensFromConsensusPower(1000000, sdk.DefaultPowerReduction)),
+		sdk.NewCoin(FeeDenom, sdk.TokensFromConsensusPower(1000000, sdk.DefaultPowerReduction)),
+		sdk.NewCoin(FooDenom, sdk.TokensFromConsensusPower(1000, sdk.DefaultPowerReduction)),
+		sdk.NewCoin(Denom, sdk.TokensFromConsensusPower(150, sdk.DefaultPowerReduction)),
 	)
 
 	VestingCoins = sdk.NewCoins(
-		sdk.NewCoin(FeeDenom, sdk.TokensFromConsensusPower(500000)),
+		sdk.NewCoin(FeeDenom, sdk.TokensFromConsensusPower(500000, sdk.DefaultPowerReduction)),
 	)
 )
 
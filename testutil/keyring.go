@@ -0,0 +1,77 @@
+// Package testutil provides deterministic fixtures for tests: keys, and the
+// keyrings holding them, whose addresses are stable across runs.
+package testutil
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/cosmos/go-bip39"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// keySeed is hashed along with the index of a test key to get its entropy.
+const keySeed = "cosmos-sdk/testutil"
+
+// KeyName returns the name of the i-th test key in the keyrings returned by
+// NewKeyring.
+func KeyName(i int) string {
+	return fmt.Sprintf("key%d", i)
+}
+
+// Mnemonic returns the deterministic 24 words mnemonic of the i-th test key.
+func Mnemonic(i int) string {
+	entropy := sha256.Sum256([]byte(fmt.Sprintf("%s/%d", keySeed, i)))
+
+	mnemonic, err := bip39.NewMnemonic(entropy[:])
+	if err != nil {
+		panic(err)
+	}
+
+	return mnemonic
+}
+
+// PrivKey returns the secp256k1 private key of the i-th test key, derived from
+// its mnemonic with the default HD path.
+func PrivKey(i int) crypto.PrivKey {
+	derivedPriv, err := hd.Secp256k1.Derive()(Mnemonic(i), keyring.DefaultBIP39Passphrase, sdk.FullFundraiserPath)
+	if err != nil {
+		panic(err)
+	}
+
+	return hd.Secp256k1.Generate()(derivedPriv)
+}
+
+// Addrs returns the addresses of the first n test keys.
+func Addrs(n int) []sdk.AccAddress {
+	addrs := make([]sdk.AccAddress, n)
+	for i := range addrs {
+		addrs[i] = sdk.AccAddress(PrivKey(i).PubKey().Address())
+	}
+
+	return addrs
+}
+
+// NewKeyring returns an in-memory keyring holding the first n test keys, named
+// by KeyName, and their infos.
+func NewKeyring(n int) (keyring.Keyring, []keyring.Info) {
+	kr := keyring.NewInMemory()
+
+	infos := make([]keyring.Info, n)
+	for i := range infos {
+		info, err := kr.NewAccount(
+			KeyName(i), Mnemonic(i), keyring.DefaultBIP39Passphrase, sdk.FullFundraiserPath, hd.Secp256k1,
+		)
+		if err != nil {
+			panic(err)
+		}
+
+		infos[i] = info
+	}
+
+	return kr, infos
+}
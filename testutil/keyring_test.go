@@ -0,0 +1,43 @@
+package testutil_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+)
+
+func TestKeysAreDeterministic(t *testing.T) {
+	addrs := testutil.Addrs(2)
+
+	// the addresses must not change across runs, nor across releases
+	require.Equal(t, "cosmos14600jka6pph9x8hzqev7kxf7jg0scgv3vx0myw", addrs[0].String())
+	require.Equal(t, "cosmos1rtr9aevj4665qta9pr0fvn2ksqhsngrj3gdx22", addrs[1].String())
+	require.Equal(t, addrs, testutil.Addrs(2))
+	require.Equal(t, testutil.Mnemonic(1), testutil.Mnemonic(1))
+	require.NotEqual(t, testutil.Mnemonic(0), testutil.Mnemonic(1))
+}
+
+func TestNewKeyring(t *testing.T) {
+	kr, infos := testutil.NewKeyring(3)
+	require.Len(t, infos, 3)
+
+	addrs := testutil.Addrs(3)
+	for i, info := range infos {
+		require.Equal(t, testutil.KeyName(i), info.GetName())
+		require.Equal(t, addrs[i], info.GetAddress())
+		require.Equal(t, testutil.PrivKey(i).PubKey(), info.GetPubKey())
+
+		key, err := kr.Key(testutil.KeyName(i))
+		require.NoError(t, err)
+		require.Equal(t, addrs[i], key.GetAddress())
+	}
+
+	// the keys sign as the private keys returned by PrivKey
+	msg := []byte("message")
+	sig, pub, err := kr.Sign(testutil.KeyName(0), msg)
+	require.NoError(t, err)
+	require.Equal(t, testutil.PrivKey(0).PubKey(), pub)
+	require.True(t, pub.VerifyBytes(msg, sig))
+}
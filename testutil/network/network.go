@@ -0,0 +1,271 @@
+// Package network spins up in-process networks of validators for integration
+// tests, so that the CLI, REST and gRPC clients of the modules can be tested
+// against a real chain without external binaries.
+//
+// Each validator runs the application built by the AppConstructor of the
+// network config along with a Tendermint node, the validators reaching
+// consensus over P2P connections on random local ports. As the Tendermint RPC
+// server of a node is a process wide singleton, only the first validator
+// serves the RPC, and the REST API and gRPC servers built on top of it: the
+// client contexts of all the validators query the chain through it.
+//
+// A test creates a network with New and stops it with Cleanup:
+//
+//	network := network.New(t, network.DefaultConfig())
+//	defer network.Cleanup()
+//
+//	_, err := network.WaitForHeight(2)
+//	require.NoError(t, err)
+package network
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/libs/log"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	"github.com/tendermint/tendermint/node"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/lcd"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// AppConstructor builds the application run by a validator of the network.
+type AppConstructor func(val Validator) abci.Application
+
+// Config defines the parameters of a network: the application run by its
+// validators, its genesis state and the tokens of its validators.
+type Config struct {
+	Codec          *codec.Codec
+	AppConstructor AppConstructor
+	ModuleBasics   module.BasicManager
+	// GenesisState is the genesis state of the application, to which the
+	// accounts, balances and gentxs of the validators are added.
+	GenesisState  map[string]json.RawMessage
+	TimeoutCommit time.Duration
+	ChainID       string
+	NumValidators int
+	BondDenom     string
+	MinGasPrices  string
+	// AccountTokens is the balance of the validators in their own token, named
+	// after their moniker, e.g. node0token.
+	AccountTokens sdk.Int
+	// StakingTokens is the balance of the validators in the bond denom, of which
+	// they bond BondedTokens at genesis.
+	StakingTokens sdk.Int
+	BondedTokens  sdk.Int
+	// CleanupDir sets whether the base directory of the network is removed by
+	// Cleanup.
+	CleanupDir bool
+}
+
+// DefaultConfig returns the config of a network of 2 validators running the
+// simulation app.
+func DefaultConfig() Config {
+	return Config{
+		Codec: std.MakeCodec(simapp.ModuleBasics),
+		AppConstructor: func(val Validator) abci.Application {
+			return simapp.NewSimApp(
+				log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, val.Dir, 0,
+				baseapp.SetMinGasPrices(val.MinGasPrices),
+			)
+		},
+		ModuleBasics:  simapp.ModuleBasics,
+		GenesisState:  simapp.NewDefaultGenesisState(),
+		TimeoutCommit: 2 * time.Second,
+		ChainID:       "chain-" + tmrand.Str(6),
+		NumValidators: 2,
+		BondDenom:     sdk.DefaultBondDenom,
+		MinGasPrices:  fmt.Sprintf("0.000006%s", sdk.DefaultBondDenom),
+		AccountTokens: sdk.TokensFromConsensusPower(1000, sdk.DefaultPowerReduction),
+		StakingTokens: sdk.TokensFromConsensusPower(500, sdk.DefaultPowerReduction),
+		BondedTokens:  sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction),
+		CleanupDir:    true,
+	}
+}
+
+// Network is an in-process network of validators.
+type Network struct {
+	T          *testing.T
+	BaseDir    string
+	Validators []*Validator
+	Config     Config
+}
+
+// Validator is a validator of a network, along with the clients and servers
+// of its node. The RPCAddress, APIAddress and GRPCAddress, and the RPCClient
+// are only set on the first validator, whose RPC all the client contexts use.
+type Validator struct {
+	Moniker      string
+	Dir          string
+	MinGasPrices string
+	NodeID       string
+	PubKey       crypto.PubKey
+	Address      sdk.AccAddress
+	ValAddress   sdk.ValAddress
+	// ClientCtx is a context querying the network and signing with the key of
+	// the validator, broadcasting its txs in block mode.
+	ClientCtx   context.CLIContext
+	RPCClient   rpcclient.Client
+	P2PAddress  string
+	RPCAddress  string
+	APIAddress  string
+	GRPCAddress string
+
+	tmNode      *node.Node
+	api         *lcd.RestServer
+	apiListener net.Listener
+}
+
+// New creates and starts a network of the given config, failing the test if
+// it can't. The network must be stopped by Cleanup.
+func New(t *testing.T, cfg Config) *Network {
+	baseDir, err := ioutil.TempDir("", cfg.ChainID)
+	require.NoError(t, err)
+
+	t.Logf("starting the network of chain %s in %s", cfg.ChainID, baseDir)
+
+	network := &Network{
+		T:          t,
+		BaseDir:    baseDir,
+		Validators: make([]*Validator, cfg.NumValidators),
+		Config:     cfg,
+	}
+
+	if err := network.start(); err != nil {
+		network.Cleanup()
+		require.NoError(t, err)
+	}
+
+	t.Log("started the network")
+
+	return network
+}
+
+// LatestHeight returns the latest height of the network.
+func (n *Network) LatestHeight() (int64, error) {
+	status, err := n.Validators[0].RPCClient.Status()
+	if err != nil {
+		return 0, err
+	}
+
+	return status.SyncInfo.LatestBlockHeight, nil
+}
+
+// WaitForHeight waits for the network to reach the given height, for at most
+// 10 seconds, returning its latest height.
+func (n *Network) WaitForHeight(h int64) (int64, error) {
+	return n.WaitForHeightWithTimeout(h, 10*time.Second)
+}
+
+// WaitForHeightWithTimeout waits for the network to reach the given height,
+// for at most the given timeout, returning its latest height.
+func (n *Network) WaitForHeightWithTimeout(h int64, timeout time.Duration) (int64, error) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	deadline := time.After(timeout)
+
+	var latest int64
+	for {
+		select {
+		case <-deadline:
+			return latest, fmt.Errorf("timeout exceeded waiting for height %d, at height %d", h, latest)
+
+		case <-ticker.C:
+			// the node may not serve the RPC yet
+			height, err := n.LatestHeight()
+			if err == nil {
+				latest = height
+			}
+
+			if latest >= h {
+				return latest, nil
+			}
+		}
+	}
+}
+
+// WaitForNextBlock waits for the network to produce the block following its
+// latest one.
+func (n *Network) WaitForNextBlock() error {
+	latest, err := n.LatestHeight()
+	if err != nil {
+		return err
+	}
+
+	_, err = n.WaitForHeight(latest + 1)
+	return err
+}
+
+// Cleanup stops the servers and nodes of the validators, and removes the base
+// directory of the network if the config says so.
+func (n *Network) Cleanup() {
+	n.T.Log("cleaning up the network")
+
+	for _, val := range n.Validators {
+		if val == nil {
+			continue
+		}
+
+		if val.api != nil {
+			val.api.GRPCServer.Stop()
+			_ = val.apiListener.Close()
+		}
+
+		if val.tmNode != nil && val.tmNode.IsRunning() {
+			_ = val.tmNode.Stop()
+			val.tmNode.Wait()
+		}
+	}
+
+	if n.Config.CleanupDir {
+		_ = os.RemoveAll(n.BaseDir)
+	}
+}
+
+// BroadcastMsgs signs the messages with the key of the validator, paying fees
+// at its minimum gas prices, and broadcasts them in a tx.
+func (val Validator) BroadcastMsgs(msgs ...sdk.Msg) (sdk.TxResponse, error) {
+	gasPrices, err := sdk.ParseDecCoins(val.MinGasPrices)
+	if err != nil {
+		return sdk.TxResponse{}, err
+	}
+
+	accNum, seq, err := authtypes.NewAccountRetriever(std.NewAppCodec(val.ClientCtx.Codec), val.ClientCtx).
+		GetAccountNumberSequence(val.Address)
+	if err != nil {
+		return sdk.TxResponse{}, err
+	}
+
+	txBldr := authtypes.NewTxBuilder(
+		authclient.GetTxEncoder(val.ClientCtx.Codec), accNum, seq, flags.DefaultGasLimit, 0,
+		false, val.ClientCtx.ChainID, "", nil, gasPrices,
+	).WithKeybase(val.ClientCtx.Keyring)
+
+	txBytes, err := txBldr.BuildAndSign(val.ClientCtx.FromName, "", msgs)
+	if err != nil {
+		return sdk.TxResponse{}, err
+	}
+
+	return val.ClientCtx.BroadcastTx(txBytes)
+}
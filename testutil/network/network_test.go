@@ -0,0 +1,87 @@
+package network_test
+
+import (
+	gocontext "context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/client/rpc/types"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestNetwork(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping the in-process network in short mode")
+	}
+
+	cfg := network.DefaultConfig()
+	net := network.New(t, cfg)
+	defer net.Cleanup()
+
+	height, err := net.WaitForHeight(2)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, height, int64(2))
+
+	val0, val1 := net.Validators[0], net.Validators[1]
+
+	// getJSON decodes the result of a query of the REST API
+	getJSON := func(path string, ptr interface{}) {
+		res, err := http.Get(net.Validators[0].APIAddress + path)
+		require.NoError(t, err)
+		defer res.Body.Close()
+
+		bz, err := ioutil.ReadAll(res.Body)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, res.StatusCode, string(bz))
+
+		var resp rest.ResponseWithHeight
+		require.NoError(t, cfg.Codec.UnmarshalJSON(bz, &resp))
+		require.NoError(t, cfg.Codec.UnmarshalJSON(resp.Result, ptr))
+	}
+
+	// both validators are bonded by their gentxs
+	var validators []stakingtypes.Validator
+	getJSON("/staking/validators", &validators)
+	require.Len(t, validators, 2)
+
+	// the txs of a validator are signed with its key
+	amount := sdk.NewCoins(sdk.NewCoin("node0token", sdk.NewInt(10)))
+	res, err := val0.BroadcastMsgs(banktypes.NewMsgSend(val0.Address, val1.Address, amount))
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), res.Code, res.RawLog)
+
+	var balances sdk.Coins
+	getJSON("/bank/balances/"+val1.Address.String(), &balances)
+	require.Equal(t, sdk.NewInt(10), balances.AmountOf("node0token"))
+	require.Equal(t, cfg.AccountTokens, balances.AmountOf("node1token"))
+
+	// the gRPC query services are served by the gateway
+	mintRes, err := http.Get(val0.APIAddress + "/cosmos_sdk.x.mint.v1.Query/Params")
+	require.NoError(t, err)
+	defer mintRes.Body.Close()
+	require.Equal(t, http.StatusOK, mintRes.StatusCode)
+
+	var mintParams map[string]json.RawMessage
+	require.NoError(t, json.NewDecoder(mintRes.Body).Decode(&mintParams))
+	require.Contains(t, mintParams, "params")
+
+	// and the node status by the gRPC server
+	conn, err := grpc.Dial(val0.GRPCAddress, grpc.WithInsecure())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	syncing, err := types.NewServiceClient(conn).GetSyncing(gocontext.Background(), &types.GetSyncingRequest{})
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, syncing.LatestBlockHeight, height)
+
+	require.NoError(t, net.WaitForNextBlock())
+}
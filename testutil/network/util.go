@@ -0,0 +1,309 @@
+package network
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	tmcfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/libs/log"
+	tmos "github.com/tendermint/tendermint/libs/os"
+	"github.com/tendermint/tendermint/node"
+	"github.com/tendermint/tendermint/p2p"
+	pvm "github.com/tendermint/tendermint/privval"
+	"github.com/tendermint/tendermint/proxy"
+	rpcserver "github.com/tendermint/tendermint/rpc/lib/server"
+	tmtypes "github.com/tendermint/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/lcd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/std"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	authrest "github.com/cosmos/cosmos-sdk/x/auth/client/rest"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// start initializes the files and the genesis of the validators, then starts
+// their nodes, and the servers of the first one.
+func (n *Network) start() error {
+	kr, infos := testutil.NewKeyring(n.Config.NumValidators)
+	tmConfigs := make([]*tmcfg.Config, n.Config.NumValidators)
+	genTxs := make([]authtypes.StdTx, n.Config.NumValidators)
+
+	var rpcAddr string
+	for i := range n.Validators {
+		tmConfig, val, err := n.initValidator(i, infos[i])
+		if err != nil {
+			return err
+		}
+
+		// only the first node serves the RPC, as the RPC server of Tendermint
+		// is process wide
+		if i == 0 {
+			rpcAddr = tmConfig.RPC.ListenAddress
+		}
+
+		val.ClientCtx = context.CLIContext{
+			ChainID:       n.Config.ChainID,
+			Keyring:       kr,
+			HomeDir:       val.Dir,
+			Output:        os.Stdout,
+			From:          infos[i].GetName(),
+			FromName:      infos[i].GetName(),
+			FromAddress:   val.Address,
+			BroadcastMode: flags.BroadcastBlock,
+			TrustNode:     true,
+		}.WithCodec(n.Config.Codec).WithMarshaler(std.NewAppCodec(n.Config.Codec)).WithNodeURI(rpcAddr)
+
+		genTxs[i], err = n.genTx(kr, val)
+		if err != nil {
+			return err
+		}
+
+		tmConfigs[i] = tmConfig
+		n.Validators[i] = val
+	}
+
+	genDoc, err := n.genesisDoc(genTxs)
+	if err != nil {
+		return err
+	}
+
+	for i, val := range n.Validators {
+		tmConfig := tmConfigs[i]
+		tmConfig.P2P.PersistentPeers = n.persistentPeers(val)
+
+		if err := genDoc.SaveAs(tmConfig.GenesisFile()); err != nil {
+			return err
+		}
+
+		if err := n.startValidator(tmConfig, val); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// initValidator creates the home directory, the node key and the private
+// validator key of the i-th validator, and the Tendermint config of its node.
+func (n *Network) initValidator(i int, info keyring.Info) (*tmcfg.Config, *Validator, error) {
+	moniker := fmt.Sprintf("node%d", i)
+	dir := filepath.Join(n.BaseDir, moniker)
+
+	if err := tmos.EnsureDir(filepath.Join(dir, "config"), 0755); err != nil {
+		return nil, nil, err
+	}
+
+	tmConfig := tmcfg.DefaultConfig()
+	tmConfig.SetRoot(dir)
+	tmConfig.Moniker = moniker
+	tmConfig.Consensus.TimeoutCommit = n.Config.TimeoutCommit
+	tmConfig.P2P.AddrBookStrict = false
+	tmConfig.P2P.AllowDuplicateIP = true
+	tmConfig.RPC.ListenAddress = ""
+
+	val := &Validator{
+		Moniker:      moniker,
+		Dir:          dir,
+		MinGasPrices: n.Config.MinGasPrices,
+		Address:      info.GetAddress(),
+		ValAddress:   sdk.ValAddress(info.GetAddress()),
+	}
+
+	p2pAddr, _, err := server.FreeTCPAddr()
+	if err != nil {
+		return nil, nil, err
+	}
+	tmConfig.P2P.ListenAddress = p2pAddr
+	val.P2PAddress = p2pAddr
+
+	if i == 0 {
+		if val.RPCAddress, _, err = server.FreeTCPAddr(); err != nil {
+			return nil, nil, err
+		}
+		tmConfig.RPC.ListenAddress = val.RPCAddress
+
+		_, apiPort, err := server.FreeTCPAddr()
+		if err != nil {
+			return nil, nil, err
+		}
+		val.APIAddress = fmt.Sprintf("http://127.0.0.1:%s", apiPort)
+
+		_, grpcPort, err := server.FreeTCPAddr()
+		if err != nil {
+			return nil, nil, err
+		}
+		val.GRPCAddress = fmt.Sprintf("127.0.0.1:%s", grpcPort)
+	}
+
+	val.NodeID, val.PubKey, err = genutil.InitializeNodeValidatorFiles(tmConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return tmConfig, val, nil
+}
+
+// genTx returns the signed gentx creating the validator, bonding its bonded
+// tokens.
+func (n *Network) genTx(kr keyring.Keyring, val *Validator) (authtypes.StdTx, error) {
+	msg := stakingtypes.NewMsgCreateValidator(
+		val.ValAddress,
+		val.PubKey,
+		sdk.NewCoin(n.Config.BondDenom, n.Config.BondedTokens),
+		stakingtypes.NewDescription(val.Moniker, "", "", "", ""),
+		stakingtypes.NewCommissionRates(sdk.OneDec(), sdk.OneDec(), sdk.OneDec()),
+		sdk.OneInt(),
+	)
+
+	// the gentxs are signed with the account number 0 and sequence 0 of the
+	// genesis accounts
+	memo := fmt.Sprintf("%s@%s", val.NodeID, strings.TrimPrefix(val.P2PAddress, "tcp://"))
+	txBldr := authtypes.NewTxBuilder(
+		authclient.GetTxEncoder(n.Config.Codec), 0, 0, flags.DefaultGasLimit, 0, false, n.Config.ChainID, memo, nil, nil,
+	).WithKeybase(kr)
+
+	stdTx := authtypes.NewStdTx([]sdk.Msg{msg}, authtypes.NewStdFee(flags.DefaultGasLimit, nil), nil, memo)
+	return txBldr.SignStdTx(val.ClientCtx.FromName, "", stdTx, false)
+}
+
+// genesisDoc returns the genesis of the network: the genesis state of the
+// config, holding the accounts, the balances and the gentxs of the validators.
+func (n *Network) genesisDoc(genTxs []authtypes.StdTx) (*tmtypes.GenesisDoc, error) {
+	cdc := n.Config.Codec
+	appState := make(map[string]json.RawMessage, len(n.Config.GenesisState))
+	for name, state := range n.Config.GenesisState {
+		appState[name] = state
+	}
+
+	var authGenState authtypes.GenesisState
+	cdc.MustUnmarshalJSON(appState[authtypes.ModuleName], &authGenState)
+
+	var bankGenState banktypes.GenesisState
+	cdc.MustUnmarshalJSON(appState[banktypes.ModuleName], &bankGenState)
+
+	for _, val := range n.Validators {
+		authGenState.Accounts = append(authGenState.Accounts, authexported.GenesisAccount(
+			authtypes.NewBaseAccount(val.Address, nil, 0, 0),
+		))
+
+		bankGenState.Balances = append(bankGenState.Balances, banktypes.Balance{
+			Address: val.Address,
+			Coins: sdk.NewCoins(
+				sdk.NewCoin(fmt.Sprintf("%stoken", val.Moniker), n.Config.AccountTokens),
+				sdk.NewCoin(n.Config.BondDenom, n.Config.StakingTokens),
+			),
+		})
+	}
+
+	appState[authtypes.ModuleName] = cdc.MustMarshalJSON(authGenState)
+	appState[banktypes.ModuleName] = cdc.MustMarshalJSON(bankGenState)
+
+	var stakingGenState stakingtypes.GenesisState
+	cdc.MustUnmarshalJSON(appState[stakingtypes.ModuleName], &stakingGenState)
+	stakingGenState.Params.BondDenom = n.Config.BondDenom
+	appState[stakingtypes.ModuleName] = cdc.MustMarshalJSON(stakingGenState)
+
+	appState[genutiltypes.ModuleName] = cdc.MustMarshalJSON(genutiltypes.NewGenesisStateFromStdTx(genTxs))
+
+	if err := n.Config.ModuleBasics.ValidateGenesis(cdc, appState); err != nil {
+		return nil, err
+	}
+
+	appStateJSON, err := json.MarshalIndent(appState, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	genDoc := &tmtypes.GenesisDoc{
+		ChainID:     n.Config.ChainID,
+		GenesisTime: tmtime.Now(),
+		AppState:    appStateJSON,
+	}
+
+	return genDoc, genDoc.ValidateAndComplete()
+}
+
+// persistentPeers returns the P2P addresses of the nodes of the validators
+// other than the given one.
+func (n *Network) persistentPeers(val *Validator) string {
+	var peers []string
+	for _, peer := range n.Validators {
+		if peer == val {
+			continue
+		}
+
+		peers = append(peers, fmt.Sprintf("%s@%s", peer.NodeID, strings.TrimPrefix(peer.P2PAddress, "tcp://")))
+	}
+
+	return strings.Join(peers, ",")
+}
+
+// startValidator starts the node of the validator, and the REST API and gRPC
+// servers if it has their addresses.
+func (n *Network) startValidator(tmConfig *tmcfg.Config, val *Validator) error {
+	nodeKey, err := p2p.LoadOrGenNodeKey(tmConfig.NodeKeyFile())
+	if err != nil {
+		return err
+	}
+
+	val.tmNode, err = node.NewNode(
+		tmConfig,
+		pvm.LoadOrGenFilePV(tmConfig.PrivValidatorKeyFile(), tmConfig.PrivValidatorStateFile()),
+		nodeKey,
+		proxy.NewLocalClientCreator(n.Config.AppConstructor(*val)),
+		node.DefaultGenesisDocProviderFunc(tmConfig),
+		node.DefaultDBProvider,
+		node.DefaultMetricsProvider(tmConfig.Instrumentation),
+		log.NewNopLogger(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := val.tmNode.Start(); err != nil {
+		return err
+	}
+
+	if val.RPCAddress == "" {
+		return nil
+	}
+
+	val.RPCClient = val.ClientCtx.Client
+
+	val.api = lcd.NewRestServerWithContext(val.ClientCtx)
+	client.RegisterRoutes(val.api.CliCtx, val.api.Mux)
+	authrest.RegisterTxRoutes(val.api.CliCtx, val.api.Mux)
+	n.Config.ModuleBasics.RegisterRESTRoutes(val.api.CliCtx, val.api.Mux)
+
+	if err := val.api.StartGRPC(val.GRPCAddress); err != nil {
+		return err
+	}
+
+	apiConfig := rpcserver.DefaultConfig()
+	val.apiListener, err = rpcserver.Listen("tcp://"+strings.TrimPrefix(val.APIAddress, "http://"), apiConfig)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		_ = rpcserver.StartHTTPServer(val.apiListener, val.api.Mux, log.NewNopLogger(), apiConfig)
+	}()
+
+	return nil
+}
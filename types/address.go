@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"strings"
 
+	lru "github.com/hashicorp/golang-lru"
 	"github.com/tendermint/tendermint/crypto"
 	tmamino "github.com/tendermint/tendermint/crypto/encoding/amino"
 	yaml "gopkg.in/yaml.v2"
@@ -68,6 +69,41 @@ const (
 	Bech32PrefixConsPub = Bech32MainPrefix + PrefixValidator + PrefixConsensus + PrefixPublic
 )
 
+// bech32AddrCacheSize is the number of Bech32 encoded addresses kept in the
+// cache, which amounts to a few megabytes of memory.
+const bech32AddrCacheSize = 50000
+
+// bech32AddrCache memoizes the Bech32 encoding of addresses, which otherwise
+// dominates the CPU profiles of nodes serving address-heavy queries.
+var bech32AddrCache *lru.Cache
+
+func init() {
+	var err error
+	if bech32AddrCache, err = lru.New(bech32AddrCacheSize); err != nil {
+		panic(err)
+	}
+}
+
+// bech32ifyAddressCached returns the Bech32 encoding of the address bytes with
+// the given prefix. Entries are keyed by both the prefix and the bytes so that
+// updating the prefixes in the config never returns stale encodings.
+func bech32ifyAddressCached(prefix string, bz []byte) string {
+	// a Bech32 prefix only holds printable characters, so the NUL separator
+	// makes the key unambiguous
+	key := prefix + "\x00" + string(bz)
+	if bech32Addr, ok := bech32AddrCache.Get(key); ok {
+		return bech32Addr.(string)
+	}
+
+	bech32Addr, err := bech32.ConvertAndEncode(prefix, bz)
+	if err != nil {
+		panic(err)
+	}
+
+	bech32AddrCache.Add(key, bech32Addr)
+	return bech32Addr
+}
+
 // Address is a common interface for different types of addresses used by the SDK
 type Address interface {
 	Equals(Address) bool
@@ -224,14 +260,7 @@ func (aa AccAddress) String() string {
 		return ""
 	}
 
-	bech32PrefixAccAddr := GetConfig().GetBech32AccountAddrPrefix()
-
-	bech32Addr, err := bech32.ConvertAndEncode(bech32PrefixAccAddr, aa.Bytes())
-	if err != nil {
-		panic(err)
-	}
-
-	return bech32Addr
+	return bech32ifyAddressCached(GetConfig().GetBech32AccountAddrPrefix(), aa.Bytes())
 }
 
 // Format implements the fmt.Formatter interface.
@@ -371,14 +400,7 @@ func (va ValAddress) String() string {
 		return ""
 	}
 
-	bech32PrefixValAddr := GetConfig().GetBech32ValidatorAddrPrefix()
-
-	bech32Addr, err := bech32.ConvertAndEncode(bech32PrefixValAddr, va.Bytes())
-	if err != nil {
-		panic(err)
-	}
-
-	return bech32Addr
+	return bech32ifyAddressCached(GetConfig().GetBech32ValidatorAddrPrefix(), va.Bytes())
 }
 
 // Format implements the fmt.Formatter interface.
@@ -523,14 +545,7 @@ func (ca ConsAddress) String() string {
 		return ""
 	}
 
-	bech32PrefixConsAddr := GetConfig().GetBech32ConsensusAddrPrefix()
-
-	bech32Addr, err := bech32.ConvertAndEncode(bech32PrefixConsAddr, ca.Bytes())
-	if err != nil {
-		panic(err)
-	}
-
-	return bech32Addr
+	return bech32ifyAddressCached(GetConfig().GetBech32ConsensusAddrPrefix(), ca.Bytes())
 }
 
 // Bech32ifyAddressBytes returns a bech32 representation of address bytes.
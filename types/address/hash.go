@@ -0,0 +1,60 @@
+// Package address defines deterministic derivation schemes for addresses that
+// are not derived from a public key, such as module accounts.
+//
+// Derived addresses are computed as Hash(typ, key), the hash of the hash of an
+// address type concatenated with a key, truncated to sdk.AddrLen bytes. Hashing
+// the type first ensures addresses of different types, and addresses derived
+// from public keys, cannot collide.
+package address
+
+import (
+	"crypto/sha256"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// moduleType is the address type of module and sub-module accounts.
+const moduleType = "module"
+
+// Hash creates an address of the given type from the given key.
+func Hash(typ string, key []byte) []byte {
+	hasher := sha256.New()
+	typHash := sha256.Sum256([]byte(typ))
+
+	// the hasher never returns an error
+	_, _ = hasher.Write(typHash[:])
+	_, _ = hasher.Write(key)
+
+	return hasher.Sum(nil)[:sdk.AddrLen]
+}
+
+// Module creates the address of a module account. Without derivation keys it
+// returns the address of the module's main account, which is kept compatible
+// with the addresses of existing module accounts. Otherwise, the address of a
+// sub-module account is derived from the first key, and each following key
+// derives a sub-account of the previous one with Derive.
+//
+// Module names must not contain the zero byte, which is used to separate the
+// module name from the first derivation key.
+func Module(moduleName string, derivationKeys ...[]byte) []byte {
+	if len(derivationKeys) == 0 {
+		return crypto.AddressHash([]byte(moduleName))
+	}
+
+	key := append([]byte(moduleName), 0)
+	addr := Hash(moduleType, append(key, derivationKeys[0]...))
+
+	for _, k := range derivationKeys[1:] {
+		addr = Derive(addr, k)
+	}
+
+	return addr
+}
+
+// Derive creates the address of a sub-account of the given address from the
+// given key.
+func Derive(address []byte, key []byte) []byte {
+	return Hash(string(address), key)
+}
@@ -0,0 +1,35 @@
+package address_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+func TestHash(t *testing.T) {
+	addr := address.Hash("type", []byte("key"))
+	require.Len(t, addr, sdk.AddrLen)
+	require.NoError(t, sdk.VerifyAddressFormat(addr))
+
+	require.Equal(t, addr, address.Hash("type", []byte("key")))
+	require.NotEqual(t, addr, address.Hash("type2", []byte("key")))
+	require.NotEqual(t, addr, address.Hash("type", []byte("key2")))
+}
+
+func TestModule(t *testing.T) {
+	// main module accounts keep their address
+	require.Equal(t, []byte(crypto.AddressHash([]byte("transfer"))), address.Module("transfer"))
+
+	sub := address.Module("transfer", []byte("port"))
+	require.Len(t, sub, sdk.AddrLen)
+	require.NotEqual(t, address.Module("transfer"), sub)
+	require.NotEqual(t, address.Module("transfer2", []byte("port")), sub)
+
+	// keys are not simply concatenated
+	require.NotEqual(t, address.Module("transfer", []byte("ab"), []byte("c")), address.Module("transfer", []byte("a"), []byte("bc")))
+	require.Equal(t, address.Derive(sub, []byte("channel")), address.Module("transfer", []byte("port"), []byte("channel")))
+}
@@ -47,3 +47,17 @@ func BenchmarkGetPubKeyFromBech32(b *testing.B) {
 		require.Equal(b, pk, pk2)
 	}
 }
+
+func BenchmarkAccAddressString(b *testing.B) {
+	var pk ed25519.PubKeyEd25519
+	rng := rand.New(rand.NewSource(time.Now().Unix()))
+	rng.Read(pk[:])
+
+	addr := types.AccAddress(pk.Address())
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = addr.String()
+	}
+}
@@ -297,6 +297,26 @@ func TestConfiguredPrefix(t *testing.T) {
 	}
 }
 
+func TestAddressStringCachedPerPrefix(t *testing.T) {
+	config := types.GetConfig()
+	config.SetBech32PrefixForAccount(types.Bech32PrefixAccAddr, types.Bech32PrefixAccPub)
+
+	var pub ed25519.PubKeyEd25519
+	rand.Read(pub[:])
+	acc := types.AccAddress(pub.Address())
+
+	bech32Acc := acc.String()
+	require.Equal(t, bech32Acc, acc.String())
+	require.True(t, strings.HasPrefix(bech32Acc, types.Bech32PrefixAccAddr))
+
+	// the cached encoding isn't returned once the prefix changes
+	config.SetBech32PrefixForAccount("other", "otherpub")
+	require.True(t, strings.HasPrefix(acc.String(), "other1"), acc.String())
+
+	config.SetBech32PrefixForAccount(types.Bech32PrefixAccAddr, types.Bech32PrefixAccPub)
+	require.Equal(t, bech32Acc, acc.String())
+}
+
 func TestAddressInterface(t *testing.T) {
 	var pub ed25519.PubKeyEd25519
 	rand.Read(pub[:])
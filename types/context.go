@@ -225,3 +225,22 @@ func (c Context) CacheContext() (cc Context, writeCache func()) {
 	cc = c.WithMultiStore(cms).WithEventManager(NewEventManager())
 	return cc, cms.Write
 }
+
+// ContextKey defines a type alias for a stdlib Context key.
+type ContextKey string
+
+// SdkContextKey is the key in the context.Context which holds the sdk.Context.
+const SdkContextKey ContextKey = "sdk-context"
+
+// WrapSDKContext returns a stdlib context.Context holding the provided
+// sdk.Context, e.g. to call a gRPC service method. It can be unwrapped with
+// UnwrapSDKContext.
+func WrapSDKContext(ctx Context) context.Context {
+	return context.WithValue(ctx.ctx, SdkContextKey, ctx)
+}
+
+// UnwrapSDKContext retrieves the sdk.Context from a stdlib context.Context
+// returned by WrapSDKContext. It panics if the context holds none.
+func UnwrapSDKContext(ctx context.Context) Context {
+	return ctx.Value(SdkContextKey).(Context)
+}
@@ -231,3 +231,11 @@ func TestContextHeaderClone(t *testing.T) {
 		})
 	}
 }
+
+func TestUnwrapSDKContext(t *testing.T) {
+	sdkCtx := types.NewContext(nil, abci.Header{Height: 3}, false, nil)
+	ctx := types.WrapSDKContext(sdkCtx)
+
+	require.Equal(t, sdkCtx, types.UnwrapSDKContext(ctx))
+	require.Panics(t, func() { types.UnwrapSDKContext(context.Background()) })
+}
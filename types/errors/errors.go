@@ -94,6 +94,14 @@ var (
 	// ErrorInvalidGasAdjustment defines an error for an invalid gas adjustment
 	ErrorInvalidGasAdjustment = Register(RootCodespace, 25, "invalid gas adjustment")
 
+	// ErrInvalidHeight defines an error for a query height which is negative or
+	// newer than the latest height.
+	ErrInvalidHeight = Register(RootCodespace, 26, "invalid height")
+
+	// ErrHeightPruned defines an error for a query height whose state is no
+	// longer retained by the node.
+	ErrHeightPruned = Register(RootCodespace, 27, "height pruned")
+
 	// ErrPanic is only set when we recover from a panic, so we know to
 	// redact potentially sensitive system info
 	ErrPanic = Register(UndefinedCodespace, 111222, "panic")
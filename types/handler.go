@@ -7,6 +7,11 @@ type Handler func(ctx Context, msg Msg) (*Result, error)
 // If newCtx.IsZero(), ctx is used instead.
 type AnteHandler func(ctx Context, tx Tx, simulate bool) (newCtx Context, err error)
 
+// PostHandler runs after the messages of a transaction are successfully handled,
+// on the same cache-wrapped state, so that its state changes are only committed
+// along with those of the messages. If newCtx.IsZero(), ctx is used instead.
+type PostHandler func(ctx Context, tx Tx, simulate bool) (newCtx Context, err error)
+
 // AnteDecorator wraps the next AnteHandler to perform custom pre- and post-processing.
 type AnteDecorator interface {
 	AnteHandle(ctx Context, tx Tx, simulate bool, next AnteHandler) (newCtx Context, err error)
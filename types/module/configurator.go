@@ -0,0 +1,82 @@
+package module
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MigrationHandler is the in-place store migration of a module from one
+// consensus version to the next.
+type MigrationHandler func(sdk.Context) error
+
+// VersionMap is a map of module name to the consensus version of the module.
+type VersionMap map[string]uint64
+
+// Configurator is the interface the application modules register their
+// in-place store migrations with.
+type Configurator interface {
+	// RegisterMigration registers the in-place store migration of a module
+	// from the consensus version forVersion to forVersion+1.
+	RegisterMigration(moduleName string, forVersion uint64, handler MigrationHandler) error
+}
+
+type configurator struct {
+	cdc codec.JSONMarshaler
+
+	// migrations is a map of module name to consensus version to migration
+	migrations map[string]map[uint64]MigrationHandler
+}
+
+// NewConfigurator returns a new Configurator. The codec is used to initialize
+// the genesis state of the modules added by an upgrade.
+func NewConfigurator(cdc codec.JSONMarshaler) Configurator {
+	return configurator{
+		cdc:        cdc,
+		migrations: make(map[string]map[uint64]MigrationHandler),
+	}
+}
+
+var _ Configurator = configurator{}
+
+// RegisterMigration implements the Configurator interface.
+func (c configurator) RegisterMigration(moduleName string, forVersion uint64, handler MigrationHandler) error {
+	if forVersion == 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "module %s has no migration from version 0", moduleName)
+	}
+
+	if c.migrations[moduleName] == nil {
+		c.migrations[moduleName] = make(map[uint64]MigrationHandler)
+	}
+
+	if c.migrations[moduleName][forVersion] != nil {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "another migration for module %s and version %d already exists", moduleName, forVersion,
+		)
+	}
+
+	c.migrations[moduleName][forVersion] = handler
+
+	return nil
+}
+
+// runModuleMigrations runs the migrations of a module from the consensus
+// version fromVersion up to toVersion, in order.
+func (c configurator) runModuleMigrations(ctx sdk.Context, moduleName string, fromVersion, toVersion uint64) error {
+	for version := fromVersion; version < toVersion; version++ {
+		migrate, found := c.migrations[moduleName][version]
+		if !found {
+			return fmt.Errorf("no migration registered for module %s from version %d", moduleName, version)
+		}
+
+		ctx.Logger().Info(fmt.Sprintf("migrating module %s from version %d to version %d", moduleName, version, version+1))
+
+		if err := migrate(ctx); err != nil {
+			return sdkerrors.Wrapf(err, "failed to migrate module %s from version %d", moduleName, version)
+		}
+	}
+
+	return nil
+}
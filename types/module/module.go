@@ -30,12 +30,17 @@ package module
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
 
 	"github.com/gorilla/mux"
 	"github.com/spf13/cobra"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/gateway"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -96,10 +101,15 @@ func (bm BasicManager) ValidateGenesis(cdc codec.JSONMarshaler, genesis map[stri
 	return nil
 }
 
-// RegisterRESTRoutes registers all module rest routes
+// RegisterRESTRoutes registers all module rest routes, and the gRPC gateway
+// routes of the modules implementing AppModuleBasicGRPCGateway.
 func (bm BasicManager) RegisterRESTRoutes(ctx context.CLIContext, rtr *mux.Router) {
 	for _, b := range bm {
 		b.RegisterRESTRoutes(ctx, rtr)
+
+		if gw, ok := b.(AppModuleBasicGRPCGateway); ok {
+			gateway.RegisterQueryService(ctx, rtr, gw.QueryServiceDesc())
+		}
 	}
 }
 
@@ -149,6 +159,39 @@ type AppModule interface {
 	EndBlock(sdk.Context, abci.RequestEndBlock) []abci.ValidatorUpdate
 }
 
+// GRPCServer is the interface of the servers the gRPC query services are
+// registered with, implemented both by the baseapp GRPCQueryRouter and by
+// *grpc.Server.
+type GRPCServer interface {
+	RegisterService(sd *grpc.ServiceDesc, ss interface{})
+}
+
+// AppModuleQueryService is the interface of the application modules exposing a
+// gRPC query service.
+type AppModuleQueryService interface {
+	RegisterQueryService(GRPCServer)
+}
+
+// AppModuleBasicGRPCGateway is the interface of the basic application modules
+// whose gRPC query service is served over REST by the gRPC gateway, along with
+// their REST routes.
+type AppModuleBasicGRPCGateway interface {
+	QueryServiceDesc() *grpc.ServiceDesc
+}
+
+// AppModuleMigrations is the interface of the application modules with
+// in-place store migrations. The modules which don't implement it are at the
+// consensus version 1.
+type AppModuleMigrations interface {
+	// ConsensusVersion is the version of the state of the module, bumped along
+	// with each state-breaking change of the module.
+	ConsensusVersion() uint64
+
+	// RegisterMigrations registers a migration from each previous consensus
+	// version of the module to the next one.
+	RegisterMigrations(Configurator)
+}
+
 //___________________________
 
 // GenesisOnlyAppModule is an AppModule that only has import/export functionality
@@ -256,6 +299,105 @@ func (m *Manager) RegisterRoutes(router sdk.Router, queryRouter sdk.QueryRouter)
 	}
 }
 
+// RegisterQueryServices registers the gRPC query services of the modules
+// implementing AppModuleQueryService with the provided server.
+func (m *Manager) RegisterQueryServices(server GRPCServer) {
+	for _, module := range m.Modules {
+		if qs, ok := module.(AppModuleQueryService); ok {
+			qs.RegisterQueryService(server)
+		}
+	}
+}
+
+// RegisterMigrations registers the in-place store migrations of the modules
+// implementing AppModuleMigrations with the provided Configurator.
+func (m *Manager) RegisterMigrations(cfg Configurator) {
+	for _, module := range m.Modules {
+		if mm, ok := module.(AppModuleMigrations); ok {
+			mm.RegisterMigrations(cfg)
+		}
+	}
+}
+
+// GetVersionMap returns the current consensus version of each module.
+func (m *Manager) GetVersionMap() VersionMap {
+	vm := make(VersionMap, len(m.Modules))
+	for name, module := range m.Modules {
+		vm[name] = consensusVersion(module)
+	}
+
+	return vm
+}
+
+// RunMigrations runs the in-place store migrations of each module whose
+// consensus version was bumped since fromVM, from the version of the module in
+// fromVM up to its current version. The modules missing from fromVM are new
+// modules: their genesis state is initialized with their default genesis
+// instead. The modules are migrated in alphabetical order and the first
+// failing migration aborts the process. It is meant to be called from an
+// x/upgrade handler, using the Configurator the migrations were registered
+// with, and returns the updated VersionMap.
+//
+// An empty fromVM is rejected, rather than initializing every module again:
+// the upgrade handler of a chain which did not store its module versions, e.g.
+// a chain started before they were stored, must provide them explicitly. A
+// module cannot be downgraded to a version lower than the one in fromVM.
+func (m *Manager) RunMigrations(ctx sdk.Context, cfg Configurator, fromVM VersionMap) (VersionMap, error) {
+	c, ok := cfg.(configurator)
+	if !ok {
+		return nil, fmt.Errorf("expected a configurator created by NewConfigurator, got %T", cfg)
+	}
+
+	if len(fromVM) == 0 {
+		return nil, errors.New("the consensus versions of the modules before the upgrade must be provided")
+	}
+
+	moduleNames := make([]string, 0, len(m.Modules))
+	for name := range m.Modules {
+		moduleNames = append(moduleNames, name)
+	}
+	sort.Strings(moduleNames)
+
+	updatedVM := make(VersionMap, len(m.Modules))
+	for _, moduleName := range moduleNames {
+		module := m.Modules[moduleName]
+		toVersion := consensusVersion(module)
+
+		fromVersion, exists := fromVM[moduleName]
+		switch {
+		case !exists:
+			ctx.Logger().Info(fmt.Sprintf("adding a new module: %s", moduleName))
+
+			if valUpdates := module.InitGenesis(ctx, c.cdc, module.DefaultGenesis(c.cdc)); len(valUpdates) > 0 {
+				return nil, fmt.Errorf("module %s cannot update the validator set when added by an upgrade", moduleName)
+			}
+
+		case fromVersion > toVersion:
+			return nil, fmt.Errorf(
+				"module %s cannot be downgraded from version %d to version %d", moduleName, fromVersion, toVersion,
+			)
+
+		default:
+			if err := c.runModuleMigrations(ctx, moduleName, fromVersion, toVersion); err != nil {
+				return nil, err
+			}
+		}
+
+		updatedVM[moduleName] = toVersion
+	}
+
+	return updatedVM, nil
+}
+
+// consensusVersion returns the consensus version of a module.
+func consensusVersion(module AppModule) uint64 {
+	if mm, ok := module.(AppModuleMigrations); ok {
+		return mm.ConsensusVersion()
+	}
+
+	return 1
+}
+
 // InitGenesis performs init genesis functionality for modules
 func (m *Manager) InitGenesis(ctx sdk.Context, cdc codec.JSONMarshaler, genesisData map[string]json.RawMessage) abci.ResponseInitChain {
 	var validatorUpdates []abci.ValidatorUpdate
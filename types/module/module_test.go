@@ -3,6 +3,7 @@ package module_test
 import (
 	"encoding/json"
 	"errors"
+	"math/rand"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -10,12 +11,14 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/tests/mocks"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/types/simulation"
 )
 
 var errFoo = errors.New("dummy")
@@ -249,3 +252,137 @@ func TestManager_EndBlock(t *testing.T) {
 	mockAppModule2.EXPECT().EndBlock(gomock.Any(), gomock.Eq(req)).Times(1).Return([]abci.ValidatorUpdate{{}})
 	require.Panics(t, func() { mm.EndBlock(sdk.Context{}, req) })
 }
+
+// migratedAppModule is an AppModule at the consensus version 3, with a
+// migration from each previous version.
+type migratedAppModule struct {
+	*mocks.MockAppModule
+
+	migrated []uint64
+}
+
+func (am *migratedAppModule) ConsensusVersion() uint64 { return 3 }
+
+func (am *migratedAppModule) RegisterMigrations(configurator module.Configurator) {
+	for _, version := range []uint64{1, 2} {
+		version := version
+		err := configurator.RegisterMigration("module2", version, func(sdk.Context) error {
+			am.migrated = append(am.migrated, version)
+			return nil
+		})
+		if err != nil {
+			panic(err)
+		}
+	}
+}
+
+func TestManager_RunMigrations(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	mockAppModule1 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule2 := &migratedAppModule{MockAppModule: mocks.NewMockAppModule(mockCtrl)}
+	mockAppModule3 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule1.EXPECT().Name().Times(2).Return("module1")
+	mockAppModule2.EXPECT().Name().Times(2).Return("module2")
+	mockAppModule3.EXPECT().Name().Times(2).Return("module3")
+	mm := module.NewManager(mockAppModule1, mockAppModule2, mockAppModule3)
+	require.Equal(t, module.VersionMap{"module1": 1, "module2": 3, "module3": 1}, mm.GetVersionMap())
+
+	cdc, ctx := codec.New(), sdk.Context{}.WithLogger(log.NewNopLogger())
+	configurator := module.NewConfigurator(cdc)
+	mm.RegisterMigrations(configurator)
+
+	// a migration can't be registered twice
+	require.Error(t, configurator.RegisterMigration("module2", 1, func(sdk.Context) error { return nil }))
+	require.Error(t, configurator.RegisterMigration("module2", 0, func(sdk.Context) error { return nil }))
+
+	// module2 is migrated from its stored version and module3, which is new,
+	// is initialized with its default genesis
+	genesis := json.RawMessage(`{"key": "value"}`)
+	mockAppModule3.EXPECT().DefaultGenesis(gomock.Eq(cdc)).Times(1).Return(genesis)
+	mockAppModule3.EXPECT().InitGenesis(gomock.Eq(ctx), gomock.Eq(cdc), gomock.Eq(genesis)).Times(1).Return(nil)
+
+	vm, err := mm.RunMigrations(ctx, configurator, module.VersionMap{"module1": 1, "module2": 1})
+	require.NoError(t, err)
+	require.Equal(t, module.VersionMap{"module1": 1, "module2": 3, "module3": 1}, vm)
+	require.Equal(t, []uint64{1, 2}, mockAppModule2.migrated)
+
+	// the migrations aren't run again once the modules are up to date
+	vm, err = mm.RunMigrations(ctx, configurator, vm)
+	require.NoError(t, err)
+	require.Equal(t, mm.GetVersionMap(), vm)
+	require.Equal(t, []uint64{1, 2}, mockAppModule2.migrated)
+
+	// a missing migration aborts the migrations
+	_, err = mm.RunMigrations(ctx, configurator, module.VersionMap{"module1": 0, "module2": 3, "module3": 1})
+	require.Error(t, err)
+
+	// the modules can't be downgraded
+	_, err = mm.RunMigrations(ctx, configurator, module.VersionMap{"module1": 1, "module2": 4, "module3": 1})
+	require.Error(t, err)
+
+	// an empty version map doesn't initialize every module again
+	_, err = mm.RunMigrations(ctx, configurator, module.VersionMap{})
+	require.Error(t, err)
+
+	// the configurator must be created by NewConfigurator
+	_, err = mm.RunMigrations(ctx, nil, vm)
+	require.Error(t, err)
+}
+
+type simulatedAppModule struct {
+	*mocks.MockAppModule
+}
+
+func (simulatedAppModule) GenerateGenesisState(*module.SimulationState) {}
+
+func (simulatedAppModule) ProposalContents(module.SimulationState) []simulation.WeightedProposalContent {
+	return nil
+}
+
+func (simulatedAppModule) RandomizedParams(*rand.Rand) []simulation.ParamChange { return nil }
+
+func (simulatedAppModule) RegisterStoreDecoder(sdk.StoreDecoderRegistry) {}
+
+func (simulatedAppModule) WeightedOperations(module.SimulationState) []simulation.WeightedOperation {
+	return nil
+}
+
+func TestNewSimulationManagerFromAppModules(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+
+	mockAppModule1 := mocks.NewMockAppModule(mockCtrl)
+	mockAppModule2 := simulatedAppModule{mocks.NewMockAppModule(mockCtrl)}
+	mockAppModule3 := simulatedAppModule{mocks.NewMockAppModule(mockCtrl)}
+	mockAppModule4 := simulatedAppModule{mocks.NewMockAppModule(mockCtrl)}
+	mockAppModule1.EXPECT().Name().Times(2).Return("module1")
+	mockAppModule2.EXPECT().Name().Times(2).Return("module2")
+	mockAppModule3.EXPECT().Name().Times(2).Return("module3")
+	mockAppModule4.EXPECT().Name().Times(2).Return("module4")
+	mm := module.NewManager(mockAppModule4, mockAppModule1, mockAppModule2, mockAppModule3)
+	mm.SetOrderInitGenesis("module3", "module1", "module2")
+
+	// the simulated modules are ordered as their genesis initialization, then
+	// by name
+	sm := module.NewSimulationManagerFromAppModules(mm, nil)
+	requireSimulatedModules(t, []simulatedAppModule{mockAppModule3, mockAppModule2, mockAppModule4}, sm)
+
+	// and the overridden modules are replaced, even if they aren't simulated
+	overrideModule := simulatedAppModule{mocks.NewMockAppModule(mockCtrl)}
+	sm = module.NewSimulationManagerFromAppModules(mm, map[string]module.AppModuleSimulation{
+		"module1": overrideModule,
+		"module2": overrideModule,
+	})
+	requireSimulatedModules(t, []simulatedAppModule{mockAppModule3, overrideModule, overrideModule, mockAppModule4}, sm)
+}
+
+// requireSimulatedModules compares the mocks of the modules, as the mocks of
+// distinct modules are deeply equal.
+func requireSimulatedModules(t *testing.T, expected []simulatedAppModule, sm *module.SimulationManager) {
+	require.Len(t, sm.Modules, len(expected))
+	for i, simModule := range sm.Modules {
+		require.True(t, expected[i].MockAppModule == simModule.(simulatedAppModule).MockAppModule, "module %d", i)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 
 	"math/rand"
+	"sort"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -47,6 +48,48 @@ func NewSimulationManager(modules ...AppModuleSimulation) *SimulationManager {
 	}
 }
 
+// NewSimulationManagerFromAppModules creates a new SimulationManager of all the
+// modules of the module Manager implementing AppModuleSimulation, so that an
+// app composed of modules simulates all of them. The overrideModules replace
+// the modules of the same name, e.g. the ones built with other keepers.
+//
+// The modules are ordered as their genesis initialization, followed by the
+// ones it doesn't list in the order of their names, for deterministic
+// simulations.
+func NewSimulationManagerFromAppModules(
+	manager *Manager, overrideModules map[string]AppModuleSimulation,
+) *SimulationManager {
+	names := make([]string, 0, len(manager.Modules))
+	ordered := make(map[string]bool, len(manager.OrderInitGenesis))
+	for _, name := range manager.OrderInitGenesis {
+		if _, ok := manager.Modules[name]; ok && !ordered[name] {
+			names = append(names, name)
+			ordered[name] = true
+		}
+	}
+
+	var unordered []string
+	for name := range manager.Modules {
+		if !ordered[name] {
+			unordered = append(unordered, name)
+		}
+	}
+
+	sort.Strings(unordered)
+	names = append(names, unordered...)
+
+	modules := make([]AppModuleSimulation, 0, len(names))
+	for _, name := range names {
+		if module, ok := overrideModules[name]; ok {
+			modules = append(modules, module)
+		} else if module, ok := manager.Modules[name].(AppModuleSimulation); ok {
+			modules = append(modules, module)
+		}
+	}
+
+	return NewSimulationManager(modules...)
+}
+
 // GetProposalContents returns each module's proposal content generator function
 // with their default operation weight and key.
 func (sm *SimulationManager) GetProposalContents(simState SimulationState) []simulation.WeightedProposalContent {
@@ -11,6 +11,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 
+	abci "github.com/tendermint/tendermint/abci/types"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 )
 
@@ -81,6 +82,7 @@ type TxResponse struct {
 	GasUsed   int64           `json:"gas_used,omitempty"`
 	Tx        Tx              `json:"tx,omitempty"`
 	Timestamp string          `json:"timestamp,omitempty"`
+	Events    StringEvents    `json:"events,omitempty"`
 }
 
 // NewResponseResultTx returns a TxResponse given a ResultTx from tendermint
@@ -104,6 +106,7 @@ func NewResponseResultTx(res *ctypes.ResultTx, tx Tx, timestamp string) TxRespon
 		GasUsed:   res.TxResult.GasUsed,
 		Tx:        tx,
 		Timestamp: timestamp,
+		Events:    stringifyTxEvents(res.TxResult.Events),
 	}
 }
 
@@ -144,6 +147,7 @@ func newTxResponseCheckTx(res *ctypes.ResultBroadcastTxCommit) TxResponse {
 		Info:      res.CheckTx.Info,
 		GasWanted: res.CheckTx.GasWanted,
 		GasUsed:   res.CheckTx.GasUsed,
+		Events:    stringifyTxEvents(res.CheckTx.Events),
 	}
 }
 
@@ -170,6 +174,7 @@ func newTxResponseDeliverTx(res *ctypes.ResultBroadcastTxCommit) TxResponse {
 		Info:      res.DeliverTx.Info,
 		GasWanted: res.DeliverTx.GasWanted,
 		GasUsed:   res.DeliverTx.GasUsed,
+		Events:    stringifyTxEvents(res.DeliverTx.Events),
 	}
 }
 
@@ -182,14 +187,25 @@ func NewResponseFormatBroadcastTx(res *ctypes.ResultBroadcastTx) TxResponse {
 	parsedLogs, _ := ParseABCILogs(res.Log)
 
 	return TxResponse{
-		Code:   res.Code,
-		Data:   res.Data.String(),
-		RawLog: res.Log,
-		Logs:   parsedLogs,
-		TxHash: res.Hash.String(),
+		Codespace: res.Codespace,
+		Code:      res.Code,
+		Data:      res.Data.String(),
+		RawLog:    res.Log,
+		Logs:      parsedLogs,
+		TxHash:    res.Hash.String(),
 	}
 }
 
+// stringifyTxEvents returns the events emitted by a tx, or nil if it emitted
+// none so that they are omitted from the responses.
+func stringifyTxEvents(events []abci.Event) StringEvents {
+	if len(events) == 0 {
+		return nil
+	}
+
+	return StringifyEvents(events)
+}
+
 func (r TxResponse) String() string {
 	var sb strings.Builder
 	sb.WriteString("Response:\n")
@@ -227,6 +243,9 @@ func (r TxResponse) String() string {
 	if r.Timestamp != "" {
 		sb.WriteString(fmt.Sprintf("  Timestamp: %s\n", r.Timestamp))
 	}
+	if r.Events != nil {
+		sb.WriteString(fmt.Sprintf("  Events:\n%s\n", r.Events))
+	}
 
 	return strings.TrimSpace(sb.String())
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/bytes"
+	"github.com/tendermint/tendermint/libs/kv"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -72,6 +73,9 @@ func TestResponseResultTx(t *testing.T) {
 		Info:      "info",
 		GasWanted: 100,
 		GasUsed:   90,
+		Events: []abci.Event{
+			{Type: "message", Attributes: []kv.Pair{{Key: []byte("sender"), Value: []byte("addr")}}},
+		},
 	}
 	resultTx := &ctypes.ResultTx{
 		Hash:     bytes.HexBytes([]byte("test")),
@@ -93,6 +97,9 @@ func TestResponseResultTx(t *testing.T) {
 		GasUsed:   90,
 		Tx:        sdk.Tx(nil),
 		Timestamp: "timestamp",
+		Events: sdk.StringEvents{
+			{Type: "message", Attributes: []sdk.Attribute{{Key: "sender", Value: "addr"}}},
+		},
 	}
 
 	require.Equal(t, want, sdk.NewResponseResultTx(resultTx, sdk.Tx(nil), "timestamp"))
@@ -108,22 +115,27 @@ func TestResponseResultTx(t *testing.T) {
   GasWanted: 100
   GasUsed: 90
   Codespace: codespace
-  Timestamp: timestamp`, sdk.NewResponseResultTx(resultTx, sdk.Tx(nil), "timestamp").String())
+  Timestamp: timestamp
+  Events:
+		- message
+			- sender: addr`, sdk.NewResponseResultTx(resultTx, sdk.Tx(nil), "timestamp").String())
 	require.True(t, sdk.TxResponse{}.Empty())
 	require.False(t, want.Empty())
 
 	resultBroadcastTx := &ctypes.ResultBroadcastTx{
-		Code: 1,
-		Data: []byte("data"),
-		Log:  `[]`,
-		Hash: bytes.HexBytes([]byte("test")),
+		Code:      1,
+		Data:      []byte("data"),
+		Log:       `[]`,
+		Codespace: "codespace",
+		Hash:      bytes.HexBytes([]byte("test")),
 	}
 	require.Equal(t, sdk.TxResponse{
-		Code:   1,
-		Data:   "64617461",
-		RawLog: `[]`,
-		Logs:   logs,
-		TxHash: "74657374",
+		Codespace: "codespace",
+		Code:      1,
+		Data:      "64617461",
+		RawLog:    `[]`,
+		Logs:      logs,
+		TxHash:    "74657374",
 	}, sdk.NewResponseFormatBroadcastTx(resultBroadcastTx))
 	require.Equal(t, sdk.TxResponse{}, sdk.NewResponseFormatBroadcastTx(nil))
 }
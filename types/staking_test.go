@@ -19,6 +19,6 @@ func TestBondStatus(t *testing.T) {
 }
 
 func TestTokensToConsensusPower(t *testing.T) {
-	require.Equal(t, int64(0), sdk.TokensToConsensusPower(sdk.NewInt(999_999)))
-	require.Equal(t, int64(1), sdk.TokensToConsensusPower(sdk.NewInt(1_000_000)))
+	require.Equal(t, int64(0), sdk.TokensToConsensusPower(sdk.NewInt(999_999), sdk.DefaultPowerReduction))
+	require.Equal(t, int64(1), sdk.TokensToConsensusPower(sdk.NewInt(1_000_000), sdk.DefaultPowerReduction))
 }
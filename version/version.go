@@ -20,8 +20,13 @@ package version
 import (
 	"fmt"
 	"runtime"
+	"runtime/debug"
 )
 
+// sdkModulePath is the path of the Go module of the SDK, whose version is the
+// one of the dependencies of the binary.
+const sdkModulePath = "github.com/cosmos/cosmos-sdk"
+
 var (
 	// application's name
 	Name = ""
@@ -46,18 +51,63 @@ type Info struct {
 	GitCommit  string `json:"commit" yaml:"commit"`
 	BuildTags  string `json:"build_tags" yaml:"build_tags"`
 	GoVersion  string `json:"go" yaml:"go"`
+	// the version of the SDK and of the other modules the binary was built with,
+	// as recorded by the Go toolchain
+	CosmosSdkVersion string   `json:"cosmos_sdk_version" yaml:"cosmos_sdk_version"`
+	BuildDeps        []string `json:"build_deps" yaml:"build_deps"`
 }
 
 func NewInfo() Info {
+	sdkVersion, buildDeps := depsFromBuildInfo()
+
 	return Info{
-		Name:       Name,
-		ServerName: ServerName,
-		ClientName: ClientName,
-		Version:    Version,
-		GitCommit:  Commit,
-		BuildTags:  BuildTags,
-		GoVersion:  fmt.Sprintf("go version %s %s/%s", runtime.Version(), runtime.GOOS, runtime.GOARCH),
+		Name:             Name,
+		ServerName:       ServerName,
+		ClientName:       ClientName,
+		Version:          Version,
+		GitCommit:        Commit,
+		BuildTags:        BuildTags,
+		GoVersion:        fmt.Sprintf("go version %s %s/%s", runtime.Version(), runtime.GOOS, runtime.GOARCH),
+		CosmosSdkVersion: sdkVersion,
+		BuildDeps:        buildDeps,
+	}
+}
+
+// depsFromBuildInfo returns the version of the SDK and the module@version
+// dependencies of the binary, with their replacements, or nothing if the binary
+// was built without module support.
+func depsFromBuildInfo() (sdkVersion string, deps []string) {
+	buildInfo, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "", nil
+	}
+
+	// the SDK is the main module of its own binaries
+	if buildInfo.Main.Path == sdkModulePath {
+		sdkVersion = buildInfo.Main.Version
 	}
+
+	for _, dep := range buildInfo.Deps {
+		if dep.Path == sdkModulePath {
+			sdkVersion = dep.Version
+			if dep.Replace != nil {
+				sdkVersion = dep.Replace.Version
+			}
+		}
+
+		deps = append(deps, formatBuildDep(dep))
+	}
+
+	return sdkVersion, deps
+}
+
+func formatBuildDep(dep *debug.Module) string {
+	s := fmt.Sprintf("%s@%s", dep.Path, dep.Version)
+	if dep.Replace != nil {
+		s = fmt.Sprintf("%s => %s", s, formatBuildDep(dep.Replace))
+	}
+
+	return s
 }
 
 func (vi Info) String() string {
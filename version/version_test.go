@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"runtime"
+	"runtime/debug"
 	"testing"
 
 	"github.com/spf13/viper"
@@ -40,6 +41,14 @@ go version go1.14 linux/amd64`
 	require.Equal(t, want, info.String())
 }
 
+func Test_formatBuildDep(t *testing.T) {
+	dep := &debug.Module{Path: "github.com/tendermint/tendermint", Version: "v0.33.4"}
+	require.Equal(t, "github.com/tendermint/tendermint@v0.33.4", formatBuildDep(dep))
+
+	dep.Replace = &debug.Module{Path: "github.com/fork/tendermint", Version: "v0.33.5"}
+	require.Equal(t, "github.com/tendermint/tendermint@v0.33.4 => github.com/fork/tendermint@v0.33.5", formatBuildDep(dep))
+}
+
 func Test_runVersionCmd(t *testing.T) {
 	require.NotNil(t, Cmd)
 	_, mockOut, _ := tests.ApplyMockIO(Cmd)
@@ -10,22 +10,25 @@ import (
 // nolint
 
 const (
-	ModuleName                    = types.ModuleName
-	StoreKey                      = types.StoreKey
-	FeeCollectorName              = types.FeeCollectorName
-	QuerierRoute                  = types.QuerierRoute
-	DefaultParamspace             = types.DefaultParamspace
-	DefaultMaxMemoCharacters      = types.DefaultMaxMemoCharacters
-	DefaultTxSigLimit             = types.DefaultTxSigLimit
-	DefaultTxSizeCostPerByte      = types.DefaultTxSizeCostPerByte
-	DefaultSigVerifyCostED25519   = types.DefaultSigVerifyCostED25519
-	DefaultSigVerifyCostSecp256k1 = types.DefaultSigVerifyCostSecp256k1
-	QueryAccount                  = types.QueryAccount
-	QueryParams                   = types.QueryParams
-	MaxGasWanted                  = types.MaxGasWanted
-	Minter                        = types.Minter
-	Burner                        = types.Burner
-	Staking                       = types.Staking
+	ModuleName                         = types.ModuleName
+	StoreKey                           = types.StoreKey
+	FeeCollectorName                   = types.FeeCollectorName
+	QuerierRoute                       = types.QuerierRoute
+	RouterKey                          = types.RouterKey
+	DefaultParamspace                  = types.DefaultParamspace
+	DefaultMaxMemoCharacters           = types.DefaultMaxMemoCharacters
+	DefaultTxSigLimit                  = types.DefaultTxSigLimit
+	DefaultTxSizeCostPerByte           = types.DefaultTxSizeCostPerByte
+	DefaultSigVerifyCostED25519        = types.DefaultSigVerifyCostED25519
+	DefaultSigVerifyCostSecp256k1      = types.DefaultSigVerifyCostSecp256k1
+	DefaultSigVerifyCostMultisigSubSig = types.DefaultSigVerifyCostMultisigSubSig
+	DefaultSigVerifyCostSecp256r1      = types.DefaultSigVerifyCostSecp256r1
+	QueryAccount                       = types.QueryAccount
+	QueryParams                        = types.QueryParams
+	MaxGasWanted                       = types.MaxGasWanted
+	Minter                             = types.Minter
+	Burner                             = types.Burner
+	Staking                            = types.Staking
 )
 
 var (
@@ -54,6 +57,11 @@ var (
 	NewStdTx                          = types.NewStdTx
 	CountSubKeys                      = types.CountSubKeys
 	NewStdFee                         = types.NewStdFee
+	NewTip                            = types.NewTip
+	NewMsgChangePubKey                = types.NewMsgChangePubKey
+	NewExtensionOption                = types.NewExtensionOption
+	NewExtensionOptionsRegistry       = types.NewExtensionOptionsRegistry
+	ValidateExtensionOptions          = types.ValidateExtensionOptions
 	StdSignBytes                      = types.StdSignBytes
 	DefaultTxDecoder                  = types.DefaultTxDecoder
 	DefaultTxEncoder                  = types.DefaultTxEncoder
@@ -68,14 +76,16 @@ var (
 	NewModuleAccount                  = types.NewModuleAccount
 
 	// variable aliases
-	ModuleCdc                 = types.ModuleCdc
-	AddressStoreKeyPrefix     = types.AddressStoreKeyPrefix
-	GlobalAccountNumberKey    = types.GlobalAccountNumberKey
-	KeyMaxMemoCharacters      = types.KeyMaxMemoCharacters
-	KeyTxSigLimit             = types.KeyTxSigLimit
-	KeyTxSizeCostPerByte      = types.KeyTxSizeCostPerByte
-	KeySigVerifyCostED25519   = types.KeySigVerifyCostED25519
-	KeySigVerifyCostSecp256k1 = types.KeySigVerifyCostSecp256k1
+	ModuleCdc                      = types.ModuleCdc
+	AddressStoreKeyPrefix          = types.AddressStoreKeyPrefix
+	GlobalAccountNumberKey         = types.GlobalAccountNumberKey
+	KeyMaxMemoCharacters           = types.KeyMaxMemoCharacters
+	KeyTxSigLimit                  = types.KeyTxSigLimit
+	KeyTxSizeCostPerByte           = types.KeyTxSizeCostPerByte
+	KeySigVerifyCostED25519        = types.KeySigVerifyCostED25519
+	KeySigVerifyCostSecp256k1      = types.KeySigVerifyCostSecp256k1
+	KeySigVerifyCostMultisigSubSig = types.KeySigVerifyCostMultisigSubSig
+	KeySigVerifyCostSecp256r1      = types.KeySigVerifyCostSecp256r1
 )
 
 type (
@@ -90,6 +100,10 @@ type (
 	StdSignMsg                       = types.StdSignMsg
 	StdTx                            = types.StdTx
 	StdFee                           = types.StdFee
+	Tip                              = types.Tip
+	MsgChangePubKey                  = types.MsgChangePubKey
+	ExtensionOption                  = types.ExtensionOption
+	ExtensionOptionsRegistry         = types.ExtensionOptionsRegistry
 	StdSignDoc                       = types.StdSignDoc
 	StdSignature                     = types.StdSignature
 	TxBuilder                        = types.TxBuilder
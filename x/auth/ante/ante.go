@@ -8,24 +8,46 @@ import (
 )
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
-// numbers, checks signatures & account numbers, and deducts fees from the first
-// signer.
+// numbers, checks signatures & account numbers and deducts fees from the fee
+// payer or fee granter. The tip of a tx is transferred by the PostHandler
+// returned by NewTipPostHandler.
+// The feegrantKeeper may be nil, in which case transactions specifying a fee
+// granter are rejected. Transactions carrying critical extension options not
+// accepted by extOptsRegistry are rejected.
 func NewAnteHandler(
-	ak AccountKeeper, bankKeeper types.BankKeeper, ibcKeeper ibckeeper.Keeper,
-	sigGasConsumer SignatureVerificationGasConsumer,
+	ak AccountKeeper, bankKeeper types.BankKeeper, feegrantKeeper FeegrantKeeper, ibcKeeper ibckeeper.Keeper,
+	extOptsRegistry types.ExtensionOptionsRegistry, sigGasConsumer SignatureVerificationGasConsumer,
 ) sdk.AnteHandler {
 	return sdk.ChainAnteDecorators(
+		NewDefaultAnteDecorators(ak, bankKeeper, feegrantKeeper, ibcKeeper, extOptsRegistry, sigGasConsumer)...,
+	)
+}
+
+// NewDefaultAnteDecorators returns the ordered list of AnteDecorators used by
+// NewAnteHandler. Applications that need custom checks can insert their own
+// decorators into the returned list and pass it to sdk.ChainAnteDecorators
+// instead of re-assembling the whole chain.
+//
+// NOTE: SetUpContextDecorator must remain the first decorator, as it sets up the
+// gas meter used by all subsequent decorators.
+func NewDefaultAnteDecorators(
+	ak AccountKeeper, bankKeeper types.BankKeeper, feegrantKeeper FeegrantKeeper, ibcKeeper ibckeeper.Keeper,
+	extOptsRegistry types.ExtensionOptionsRegistry, sigGasConsumer SignatureVerificationGasConsumer,
+) []sdk.AnteDecorator {
+	return []sdk.AnteDecorator{
 		NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		NewMempoolFeeDecorator(),
 		NewValidateBasicDecorator(),
+		NewExtensionOptionsDecorator(extOptsRegistry),
+		NewUnorderedTxDecorator(ak, DefaultMaxUnorderedTTL),
 		NewValidateMemoDecorator(ak),
 		NewConsumeGasForTxSizeDecorator(ak),
 		NewSetPubKeyDecorator(ak), // SetPubKeyDecorator must be called before all signature verification decorators
 		NewValidateSigCountDecorator(ak),
-		NewDeductFeeDecorator(ak, bankKeeper),
+		NewDeductFeeDecorator(ak, bankKeeper, feegrantKeeper),
 		NewSigGasConsumeDecorator(ak, sigGasConsumer),
 		NewSigVerificationDecorator(ak),
 		NewIncrementSequenceDecorator(ak),
 		ibcante.NewProofVerificationDecorator(ibcKeeper.ClientKeeper, ibcKeeper.ChannelKeeper), // innermost AnteDecorator
-	)
+	}
 }
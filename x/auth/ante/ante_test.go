@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"math/rand"
 	"strings"
 	"testing"
 
@@ -14,6 +13,7 @@ import (
 	"github.com/tendermint/tendermint/crypto/multisig"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 
+	"github.com/cosmos/cosmos-sdk/crypto/secp256r1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
@@ -38,7 +38,7 @@ func TestSimulateGasCost(t *testing.T) {
 	// setup
 	app, ctx := createTestApp(true)
 	ctx = ctx.WithBlockHeight(1)
-	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, *app.IBCKeeper, ante.DefaultSigVerificationGasConsumer)
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, nil, *app.IBCKeeper, types.NewExtensionOptionsRegistry(), ante.DefaultSigVerificationGasConsumer)
 
 	// keys and addresses
 	priv1, _, addr1 := types.KeyTestPubAddr()
@@ -92,7 +92,7 @@ func TestSimulateGasCost(t *testing.T) {
 func TestAnteHandlerSigErrors(t *testing.T) {
 	// setup
 	app, ctx := createTestApp(true)
-	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, *app.IBCKeeper, ante.DefaultSigVerificationGasConsumer)
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, nil, *app.IBCKeeper, types.NewExtensionOptionsRegistry(), ante.DefaultSigVerificationGasConsumer)
 
 	// keys and addresses
 	priv1, _, addr1 := types.KeyTestPubAddr()
@@ -142,7 +142,7 @@ func TestAnteHandlerAccountNumbers(t *testing.T) {
 	// setup
 	app, ctx := createTestApp(false)
 	ctx = ctx.WithBlockHeight(1)
-	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, *app.IBCKeeper, ante.DefaultSigVerificationGasConsumer)
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, nil, *app.IBCKeeper, types.NewExtensionOptionsRegistry(), ante.DefaultSigVerificationGasConsumer)
 
 	// keys and addresses
 	priv1, _, addr1 := types.KeyTestPubAddr()
@@ -201,7 +201,7 @@ func TestAnteHandlerAccountNumbersAtBlockHeightZero(t *testing.T) {
 	// setup
 	app, ctx := createTestApp(false)
 	ctx = ctx.WithBlockHeight(0)
-	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, *app.IBCKeeper, ante.DefaultSigVerificationGasConsumer)
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, nil, *app.IBCKeeper, types.NewExtensionOptionsRegistry(), ante.DefaultSigVerificationGasConsumer)
 
 	// keys and addresses
 	priv1, _, addr1 := types.KeyTestPubAddr()
@@ -259,7 +259,7 @@ func TestAnteHandlerSequences(t *testing.T) {
 	// setup
 	app, ctx := createTestApp(false)
 	ctx = ctx.WithBlockHeight(1)
-	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, *app.IBCKeeper, ante.DefaultSigVerificationGasConsumer)
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, nil, *app.IBCKeeper, types.NewExtensionOptionsRegistry(), ante.DefaultSigVerificationGasConsumer)
 
 	// keys and addresses
 	priv1, _, addr1 := types.KeyTestPubAddr()
@@ -335,7 +335,7 @@ func TestAnteHandlerSequences(t *testing.T) {
 func TestAnteHandlerFees(t *testing.T) {
 	// setup
 	app, ctx := createTestApp(true)
-	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, *app.IBCKeeper, ante.DefaultSigVerificationGasConsumer)
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, nil, *app.IBCKeeper, types.NewExtensionOptionsRegistry(), ante.DefaultSigVerificationGasConsumer)
 
 	// keys and addresses
 	priv1, _, addr1 := types.KeyTestPubAddr()
@@ -372,12 +372,46 @@ func TestAnteHandlerFees(t *testing.T) {
 	require.True(sdk.IntEq(t, app.BankKeeper.GetAllBalances(ctx, addr1).AmountOf("atom"), sdk.NewInt(0)))
 }
 
+// Test that secp256r1 keys can sign for their accounts.
+func TestAnteHandlerSecp256r1(t *testing.T) {
+	// setup
+	app, ctx := createTestApp(true)
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, nil, *app.IBCKeeper, types.NewExtensionOptionsRegistry(), ante.DefaultSigVerificationGasConsumer)
+
+	// keys and addresses
+	priv1 := secp256r1.GenPrivKey()
+	addr1 := sdk.AccAddress(priv1.PubKey().Address())
+	priv2 := secp256r1.GenPrivKey()
+
+	// set the accounts
+	acc1 := app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	app.AccountKeeper.SetAccount(ctx, acc1)
+	app.BankKeeper.SetBalances(ctx, addr1, types.NewTestCoins())
+
+	// msg and signatures
+	msg := types.NewTestMsg(addr1)
+	fee := types.NewTestStdFee()
+	msgs := []sdk.Msg{msg}
+
+	// a signature of another key is rejected
+	tx := types.NewTestTx(ctx, msgs, []crypto.PrivKey{priv2}, []uint64{0}, []uint64{0}, fee)
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdkerrors.ErrInvalidPubKey)
+
+	tx = types.NewTestTx(ctx, msgs, []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}, fee)
+	newCtx, err := anteHandler(ctx, tx, false)
+	require.NoError(t, err)
+
+	// the pubkey is set and the secp256r1 verification cost charged
+	require.Equal(t, priv1.PubKey(), app.AccountKeeper.GetAccount(ctx, addr1).GetPubKey())
+	require.True(t, newCtx.GasMeter().GasConsumed() >= types.DefaultSigVerifyCostSecp256r1)
+}
+
 // Test logic around memo gas consumption.
 func TestAnteHandlerMemoGas(t *testing.T) {
 	// setup
 	app, ctx := createTestApp(true)
 	ctx = ctx.WithBlockHeight(1)
-	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, *app.IBCKeeper, ante.DefaultSigVerificationGasConsumer)
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, nil, *app.IBCKeeper, types.NewExtensionOptionsRegistry(), ante.DefaultSigVerificationGasConsumer)
 
 	// keys and addresses
 	priv1, _, addr1 := types.KeyTestPubAddr()
@@ -417,7 +451,7 @@ func TestAnteHandlerMultiSigner(t *testing.T) {
 	// setup
 	app, ctx := createTestApp(false)
 	ctx = ctx.WithBlockHeight(1)
-	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, *app.IBCKeeper, ante.DefaultSigVerificationGasConsumer)
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, nil, *app.IBCKeeper, types.NewExtensionOptionsRegistry(), ante.DefaultSigVerificationGasConsumer)
 
 	// keys and addresses
 	priv1, _, addr1 := types.KeyTestPubAddr()
@@ -467,7 +501,7 @@ func TestAnteHandlerBadSignBytes(t *testing.T) {
 	// setup
 	app, ctx := createTestApp(true)
 	ctx = ctx.WithBlockHeight(1)
-	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, *app.IBCKeeper, ante.DefaultSigVerificationGasConsumer)
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, nil, *app.IBCKeeper, types.NewExtensionOptionsRegistry(), ante.DefaultSigVerificationGasConsumer)
 
 	// keys and addresses
 	priv1, _, addr1 := types.KeyTestPubAddr()
@@ -544,7 +578,7 @@ func TestAnteHandlerSetPubKey(t *testing.T) {
 	// setup
 	app, ctx := createTestApp(true)
 	ctx = ctx.WithBlockHeight(1)
-	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, *app.IBCKeeper, ante.DefaultSigVerificationGasConsumer)
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, nil, *app.IBCKeeper, types.NewExtensionOptionsRegistry(), ante.DefaultSigVerificationGasConsumer)
 
 	// keys and addresses
 	priv1, _, addr1 := types.KeyTestPubAddr()
@@ -596,12 +630,8 @@ func generatePubKeysAndSignatures(n int, msg []byte, _ bool) (pubkeys []crypto.P
 	pubkeys = make([]crypto.PubKey, n)
 	signatures = make([][]byte, n)
 	for i := 0; i < n; i++ {
-		var privkey crypto.PrivKey
-		if rand.Int63()%2 == 0 {
-			privkey = ed25519.GenPrivKey()
-		} else {
-			privkey = secp256k1.GenPrivKey()
-		}
+		// the ed25519 keys are unsupported, nested in multisigs included
+		privkey := secp256k1.GenPrivKey()
 		pubkeys[i] = privkey.PubKey()
 		signatures[i], _ = privkey.Sign(msg)
 	}
@@ -662,7 +692,7 @@ func TestAnteHandlerSigLimitExceeded(t *testing.T) {
 	// setup
 	app, ctx := createTestApp(true)
 	ctx = ctx.WithBlockHeight(1)
-	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, *app.IBCKeeper, ante.DefaultSigVerificationGasConsumer)
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, nil, *app.IBCKeeper, types.NewExtensionOptionsRegistry(), ante.DefaultSigVerificationGasConsumer)
 
 	// keys and addresses
 	priv1, _, addr1 := types.KeyTestPubAddr()
@@ -703,7 +733,7 @@ func TestCustomSignatureVerificationGasConsumer(t *testing.T) {
 	app, ctx := createTestApp(true)
 	ctx = ctx.WithBlockHeight(1)
 	// setup an ante handler that only accepts PubKeyEd25519
-	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, *app.IBCKeeper, func(meter sdk.GasMeter, sig []byte, pubkey crypto.PubKey, params types.Params) error {
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, nil, *app.IBCKeeper, types.NewExtensionOptionsRegistry(), func(meter sdk.GasMeter, sig []byte, pubkey crypto.PubKey, params types.Params) error {
 		switch pubkey := pubkey.(type) {
 		case ed25519.PubKeyEd25519:
 			meter.ConsumeGas(params.SigVerifyCostED25519, "ante verify: ed25519")
@@ -744,6 +774,42 @@ func TestCustomSignatureVerificationGasConsumer(t *testing.T) {
 	checkValidTx(t, anteHandler, ctx, tx, false)
 }
 
+type countingDecorator struct {
+	count *int
+}
+
+func (cd countingDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	*cd.count++
+	return next(ctx, tx, simulate)
+}
+
+// Test that custom decorators can be inserted into the default decorator chain
+func TestCustomAnteDecorators(t *testing.T) {
+	// setup
+	app, ctx := createTestApp(true)
+	ctx = ctx.WithBlockHeight(1)
+
+	var count int
+	decorators := ante.NewDefaultAnteDecorators(app.AccountKeeper, app.BankKeeper, nil, *app.IBCKeeper, types.NewExtensionOptionsRegistry(), ante.DefaultSigVerificationGasConsumer)
+	decorators = append(decorators[:1], append([]sdk.AnteDecorator{countingDecorator{&count}}, decorators[1:]...)...)
+	anteHandler := sdk.ChainAnteDecorators(decorators...)
+
+	priv1, _, addr1 := types.KeyTestPubAddr()
+	acc1 := app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	app.AccountKeeper.SetAccount(ctx, acc1)
+	app.BankKeeper.SetBalances(ctx, addr1, types.NewTestCoins())
+
+	msgs := []sdk.Msg{types.NewTestMsg(addr1)}
+	privs, accnums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx := types.NewTestTx(ctx, msgs, privs, accnums, seqs, types.NewTestStdFee())
+	checkValidTx(t, anteHandler, ctx, tx, false)
+	require.Equal(t, 1, count)
+
+	// the default decorators still run after the custom one (replay protection)
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdkerrors.ErrUnauthorized)
+	require.Equal(t, 2, count)
+}
+
 func TestAnteHandlerReCheck(t *testing.T) {
 	// setup
 	app, ctx := createTestApp(true)
@@ -761,7 +827,7 @@ func TestAnteHandlerReCheck(t *testing.T) {
 	app.AccountKeeper.SetAccount(ctx, acc1)
 	app.BankKeeper.SetBalances(ctx, addr1, types.NewTestCoins())
 
-	antehandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, *app.IBCKeeper, ante.DefaultSigVerificationGasConsumer)
+	antehandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, nil, *app.IBCKeeper, types.NewExtensionOptionsRegistry(), ante.DefaultSigVerificationGasConsumer)
 
 	// test that operations skipped on recheck do not run
 
@@ -790,9 +856,9 @@ func TestAnteHandlerReCheck(t *testing.T) {
 		name   string
 		params types.Params
 	}{
-		{"memo size check", types.NewParams(1, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte, types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1)},
-		{"txsize check", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, 10000000, types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1)},
-		{"sig verify cost check", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte, types.DefaultSigVerifyCostED25519, 100000000)},
+		{"memo size check", types.NewParams(1, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte, types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, types.DefaultSigVerifyCostMultisigSubSig, types.DefaultPubKeyChangeCost, types.DefaultSigVerifyCostSecp256r1)},
+		{"txsize check", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, 10000000, types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1, types.DefaultSigVerifyCostMultisigSubSig, types.DefaultPubKeyChangeCost, types.DefaultSigVerifyCostSecp256r1)},
+		{"sig verify cost check", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, types.DefaultTxSizeCostPerByte, types.DefaultSigVerifyCostED25519, 100000000, types.DefaultSigVerifyCostMultisigSubSig, types.DefaultPubKeyChangeCost, types.DefaultSigVerifyCostSecp256r1)},
 	}
 	for _, tc := range testCases {
 		// set testcase parameters
@@ -824,3 +890,43 @@ func TestAnteHandlerReCheck(t *testing.T) {
 	_, err = antehandler(ctx, tx, false)
 	require.NotNil(t, err, "antehandler on recheck did not fail once feePayer no longer has sufficient funds")
 }
+
+func TestAnteHandlerExtensionOptions(t *testing.T) {
+	app, ctx := createTestApp(false)
+	ctx = ctx.WithBlockHeight(1)
+
+	priv1, _, addr1 := types.KeyTestPubAddr()
+	acc1 := app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	app.AccountKeeper.SetAccount(ctx, acc1)
+	app.BankKeeper.SetBalances(ctx, addr1, types.NewTestCoins())
+
+	registry := types.NewExtensionOptionsRegistry("/test.Accepted")
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, nil, *app.IBCKeeper, registry, ante.DefaultSigVerificationGasConsumer)
+
+	msgs := []sdk.Msg{types.NewTestMsg(addr1)}
+	fee := types.NewTestStdFee()
+
+	newTx := func(seq uint64, opts ...types.ExtensionOption) sdk.Tx {
+		signMsg := types.StdSignMsg{
+			ChainID:          ctx.ChainID(),
+			AccountNumber:    acc1.GetAccountNumber(),
+			Sequence:         seq,
+			Fee:              fee,
+			Msgs:             msgs,
+			ExtensionOptions: opts,
+		}
+
+		sig, err := priv1.Sign(signMsg.Bytes())
+		require.NoError(t, err)
+
+		return signMsg.StdTx([]types.StdSignature{{PubKey: priv1.PubKey().Bytes(), Signature: sig}})
+	}
+
+	// unknown critical extension options are rejected
+	tx := newTx(0, types.NewExtensionOption("/test.Unknown", nil, true))
+	checkInvalidTx(t, anteHandler, ctx, tx, false, types.ErrUnknownExtensionOption)
+
+	// accepted and unknown non-critical extension options are allowed
+	tx = newTx(0, types.NewExtensionOption("/test.Accepted", nil, true), types.NewExtensionOption("/test.Unknown", nil, false))
+	checkValidTx(t, anteHandler, ctx, tx, false)
+}
@@ -12,7 +12,8 @@ import (
 )
 
 var (
-	_ TxWithMemo = (*types.StdTx)(nil) // assert StdTx implements TxWithMemo
+	_ TxWithMemo             = (*types.StdTx)(nil) // assert StdTx implements TxWithMemo
+	_ TxWithExtensionOptions = (*types.StdTx)(nil) // assert StdTx implements TxWithExtensionOptions
 )
 
 // ValidateBasicDecorator will call tx.ValidateBasic and return any non-nil error.
@@ -76,6 +77,39 @@ func (vmd ValidateMemoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 	return next(ctx, tx, simulate)
 }
 
+// Tx must have GetExtensionOptions() method to use ExtensionOptionsDecorator
+type TxWithExtensionOptions interface {
+	sdk.Tx
+	GetExtensionOptions() []types.ExtensionOption
+}
+
+// ExtensionOptionsDecorator rejects transactions carrying a critical extension
+// option that isn't accepted by the given registry. Unknown non-critical extension
+// options are ignored. Txs that don't implement TxWithExtensionOptions are passed
+// through, as they cannot carry extension options.
+type ExtensionOptionsDecorator struct {
+	registry types.ExtensionOptionsRegistry
+}
+
+func NewExtensionOptionsDecorator(registry types.ExtensionOptionsRegistry) ExtensionOptionsDecorator {
+	return ExtensionOptionsDecorator{
+		registry: registry,
+	}
+}
+
+func (eod ExtensionOptionsDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	extTx, ok := tx.(TxWithExtensionOptions)
+	if !ok {
+		return next(ctx, tx, simulate)
+	}
+
+	if err := eod.registry.CheckExtensionOptions(extTx.GetExtensionOptions()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
 // ConsumeTxSizeGasDecorator will take in parameters and consume gas proportional
 // to the size of tx before calling next AnteHandler. Note, the gas costs will be
 // slightly over estimated due to the fact that any given signing account may need
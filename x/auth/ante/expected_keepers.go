@@ -13,4 +13,12 @@ type AccountKeeper interface {
 	GetAccount(ctx sdk.Context, addr sdk.AccAddress) exported.Account
 	SetAccount(ctx sdk.Context, acc exported.Account)
 	GetModuleAddress(moduleName string) sdk.AccAddress
+	ContainsUnorderedTx(ctx sdk.Context, txHash []byte) bool
+	AddUnorderedTx(ctx sdk.Context, txHash []byte, timeout uint64)
+}
+
+// FeegrantKeeper defines the expected fee grant keeper used to pay the fees of
+// a transaction from a granter's fee allowance.
+type FeegrantKeeper interface {
+	UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
 }
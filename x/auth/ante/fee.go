@@ -19,6 +19,15 @@ type FeeTx interface {
 	GetGas() uint64
 	GetFee() sdk.Coins
 	FeePayer() sdk.AccAddress
+	FeeGranter() sdk.AccAddress
+}
+
+// TipTx defines the interface to be implemented by Tx to pay tips with the post
+// handler returned by NewTipPostHandler
+type TipTx interface {
+	FeeTx
+	GetTip() sdk.Coins
+	GetTipper() sdk.AccAddress
 }
 
 // MempoolFeeDecorator will check if the transaction's fee is at least as large
@@ -66,19 +75,24 @@ func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 	return next(ctx, tx, simulate)
 }
 
-// DeductFeeDecorator deducts fees from the first signer of the tx
-// If the first signer does not have the funds to pay for the fees, return with InsufficientFunds error
+// DeductFeeDecorator deducts fees from the fee payer of the tx, which is the
+// first signer unless an explicit payer is set. If a fee granter is set, the
+// fees are deducted from the granter instead, provided the granter has granted
+// a sufficient fee allowance to the payer.
+// If the account paying the fees does not have the funds to pay for the fees, return with InsufficientFunds error
 // Call next AnteHandler if fees successfully deducted
 // CONTRACT: Tx must implement FeeTx interface to use DeductFeeDecorator
 type DeductFeeDecorator struct {
-	ak         AccountKeeper
-	bankKeeper types.BankKeeper
+	ak             AccountKeeper
+	bankKeeper     types.BankKeeper
+	feegrantKeeper FeegrantKeeper
 }
 
-func NewDeductFeeDecorator(ak AccountKeeper, bk types.BankKeeper) DeductFeeDecorator {
+func NewDeductFeeDecorator(ak AccountKeeper, bk types.BankKeeper, fk FeegrantKeeper) DeductFeeDecorator {
 	return DeductFeeDecorator{
-		ak:         ak,
-		bankKeeper: bk,
+		ak:             ak,
+		bankKeeper:     bk,
+		feegrantKeeper: fk,
 	}
 }
 
@@ -93,15 +107,32 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 	}
 
 	feePayer := feeTx.FeePayer()
-	feePayerAcc := dfd.ak.GetAccount(ctx, feePayer)
+	feeGranter := feeTx.FeeGranter()
+	deductFeesFrom := feePayer
+
+	// if a fee granter is set, deduct the fees from the granter's account
+	// provided it granted a sufficient fee allowance to the payer
+	if !feeGranter.Empty() && !feeGranter.Equals(feePayer) {
+		if dfd.feegrantKeeper == nil {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "fee grants are not enabled")
+		}
+
+		err := dfd.feegrantKeeper.UseGrantedFees(ctx, feeGranter, feePayer, feeTx.GetFee(), tx.GetMsgs())
+		if err != nil {
+			return ctx, sdkerrors.Wrapf(err, "%s not allowed to pay fees for %s", feeGranter, feePayer)
+		}
 
-	if feePayerAcc == nil {
-		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "fee payer address: %s does not exist", feePayer)
+		deductFeesFrom = feeGranter
+	}
+
+	deductFeesFromAcc := dfd.ak.GetAccount(ctx, deductFeesFrom)
+	if deductFeesFromAcc == nil {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "fee payer address: %s does not exist", deductFeesFrom)
 	}
 
 	// deduct the fees
 	if !feeTx.GetFee().IsZero() {
-		err = DeductFees(dfd.bankKeeper, ctx, feePayerAcc, feeTx.GetFee())
+		err = DeductFees(dfd.bankKeeper, ctx, deductFeesFromAcc, feeTx.GetFee())
 		if err != nil {
 			return ctx, err
 		}
@@ -123,3 +154,40 @@ func DeductFees(bankKeeper types.BankKeeper, ctx sdk.Context, acc exported.Accou
 
 	return nil
 }
+
+// NewTipPostHandler returns a PostHandler which transfers the tip of a tx, if
+// any, from the tipper to the fee payer. This allows a relayer to broadcast, and
+// pay the fees of, a tx signed by the tipper in exchange for the tip.
+// As a PostHandler, it runs once the signatures of the tx have been verified by
+// the AnteHandler and its messages have succeeded, so that the tip is only paid
+// for a tx which has been executed.
+// If the tipper does not have the funds to pay the tip, return with InsufficientFunds error
+// CONTRACT: Tx must implement TipTx interface to use the tip PostHandler
+func NewTipPostHandler(bk types.BankKeeper) sdk.PostHandler {
+	return func(ctx sdk.Context, tx sdk.Tx, _ bool) (sdk.Context, error) {
+		tipTx, ok := tx.(TipTx)
+		if !ok {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a TipTx")
+		}
+
+		tip := tipTx.GetTip()
+		if tip.IsZero() {
+			return ctx, nil
+		}
+
+		if !tip.IsValid() {
+			return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid tip amount: %s", tip)
+		}
+
+		tipper := tipTx.GetTipper()
+		if tipper.Empty() {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing tipper address")
+		}
+
+		if err := bk.SendCoins(ctx, tipper, tipTx.FeePayer(), tip); err != nil {
+			return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
+		}
+
+		return ctx, nil
+	}
+}
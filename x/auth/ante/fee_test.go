@@ -81,7 +81,7 @@ func TestDeductFees(t *testing.T) {
 	app.AccountKeeper.SetAccount(ctx, acc)
 	app.BankKeeper.SetBalances(ctx, addr1, sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(10))))
 
-	dfd := ante.NewDeductFeeDecorator(app.AccountKeeper, app.BankKeeper)
+	dfd := ante.NewDeductFeeDecorator(app.AccountKeeper, app.BankKeeper, nil)
 	antehandler := sdk.ChainAnteDecorators(dfd)
 
 	_, err := antehandler(ctx, tx, false)
@@ -96,3 +96,89 @@ func TestDeductFees(t *testing.T) {
 
 	require.Nil(t, err, "Tx errored after account has been set with sufficient funds")
 }
+
+func TestDeductFeesFeePayer(t *testing.T) {
+	// setup
+	app, ctx := createTestApp(true)
+
+	// keys and addresses
+	priv1, _, addr1 := types.KeyTestPubAddr()
+	priv2, _, addr2 := types.KeyTestPubAddr()
+
+	// msg and signatures
+	msg1 := types.NewTestMsg(addr1)
+	fee := types.NewTestStdFee()
+	fee.Payer = addr2
+
+	msgs := []sdk.Msg{msg1}
+
+	privs, accNums, seqs := []crypto.PrivKey{priv1, priv2}, []uint64{0, 1}, []uint64{0, 0}
+	tx := types.NewTestTx(ctx, msgs, privs, accNums, seqs, fee)
+
+	acc1 := app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	app.AccountKeeper.SetAccount(ctx, acc1)
+	app.BankKeeper.SetBalances(ctx, addr1, sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(200))))
+
+	acc2 := app.AccountKeeper.NewAccountWithAddress(ctx, addr2)
+	app.AccountKeeper.SetAccount(ctx, acc2)
+	app.BankKeeper.SetBalances(ctx, addr2, sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(200))))
+
+	dfd := ante.NewDeductFeeDecorator(app.AccountKeeper, app.BankKeeper, nil)
+	antehandler := sdk.ChainAnteDecorators(dfd)
+
+	_, err := antehandler(ctx, tx, false)
+	require.Nil(t, err)
+
+	// fees are deducted from the payer rather than the first signer
+	require.Equal(t, sdk.NewInt(200), app.BankKeeper.GetBalance(ctx, addr1, "atom").Amount)
+	require.Equal(t, sdk.NewInt(50), app.BankKeeper.GetBalance(ctx, addr2, "atom").Amount)
+
+	// fee grants are rejected without a fee grant keeper
+	fee.Granter = addr1
+	tx = types.NewTestTx(ctx, msgs, privs, accNums, seqs, fee)
+
+	_, err = antehandler(ctx, tx, false)
+	require.Error(t, err)
+}
+
+func TestTipPostHandler(t *testing.T) {
+	// setup
+	app, ctx := createTestApp(true)
+
+	// keys and addresses
+	priv1, _, addr1 := types.KeyTestPubAddr()
+	priv2, _, addr2 := types.KeyTestPubAddr()
+
+	// msg and signatures
+	msg1 := types.NewTestMsg(addr1)
+	fee := types.NewTestStdFee()
+	fee.Tip = types.NewTip(sdk.NewCoins(sdk.NewInt64Coin("atom", 100)), addr2)
+
+	msgs := []sdk.Msg{msg1}
+
+	privs, accNums, seqs := []crypto.PrivKey{priv1, priv2}, []uint64{0, 1}, []uint64{0, 0}
+	tx := types.NewTestTx(ctx, msgs, privs, accNums, seqs, fee)
+
+	acc1 := app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	app.AccountKeeper.SetAccount(ctx, acc1)
+
+	// set tipper with insufficient funds
+	acc2 := app.AccountKeeper.NewAccountWithAddress(ctx, addr2)
+	app.AccountKeeper.SetAccount(ctx, acc2)
+	app.BankKeeper.SetBalances(ctx, addr2, sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(50))))
+
+	posthandler := ante.NewTipPostHandler(app.BankKeeper)
+
+	_, err := posthandler(ctx, tx, false)
+	require.Error(t, err, "Tx did not error when tipper had insufficient funds")
+
+	// set tipper with sufficient funds
+	app.BankKeeper.SetBalances(ctx, addr2, sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(200))))
+
+	_, err = posthandler(ctx, tx, false)
+	require.Nil(t, err)
+
+	// the tip is transferred from the tipper to the fee payer
+	require.Equal(t, sdk.NewInt(100), app.BankKeeper.GetBalance(ctx, addr1, "atom").Amount)
+	require.Equal(t, sdk.NewInt(100), app.BankKeeper.GetBalance(ctx, addr2, "atom").Amount)
+}
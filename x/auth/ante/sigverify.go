@@ -10,6 +10,7 @@ import (
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/secp256r1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/exported"
@@ -74,20 +75,25 @@ func (spkd SetPubKeyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 			}
 			pk = simSecp256k1Pubkey
 		}
-		// Only make check if simulate=false
-		if !simulate && !bytes.Equal(pk.Address(), signers[i]) {
-			return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey,
-				"pubKey does not match signer address %s with signer index: %d", signers[i], i)
-		}
-
 		acc, err := GetSignerAcc(ctx, spkd.ak, signers[i])
 		if err != nil {
 			return ctx, err
 		}
-		// account already has pubkey set,no need to reset
-		if acc.GetPubKey() != nil {
+		// account already has pubkey set,no need to reset. The pubkey is checked
+		// against the account's pubkey rather than the address, as it no longer
+		// matches the address once rotated with MsgChangePubKey.
+		if accPk := acc.GetPubKey(); accPk != nil {
+			if !simulate && !accPk.Equals(pk) {
+				return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey,
+					"pubKey does not match the pubKey of signer %s with signer index: %d", signers[i], i)
+			}
 			continue
 		}
+		// Only make check if simulate=false
+		if !simulate && !bytes.Equal(pk.Address(), signers[i]) {
+			return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey,
+				"pubKey does not match signer address %s with signer index: %d", signers[i], i)
+		}
 		err = acc.SetPubKey(pk)
 		if err != nil {
 			return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
@@ -223,7 +229,8 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 // NOTE: Since CheckTx and DeliverTx state are managed separately, subsequent and
 // sequential txs orginating from the same account cannot be handled correctly in
 // a reliable way unless sequence numbers are managed and tracked manually by a
-// client. It is recommended to instead use multiple messages in a tx.
+// client. It is recommended to instead use multiple messages in a tx, or
+// unordered txs, which do not increment the sequence of their signers.
 type IncrementSequenceDecorator struct {
 	ak AccountKeeper
 }
@@ -240,6 +247,11 @@ func (isd IncrementSequenceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, sim
 		return next(ctx, tx, simulate)
 	}
 
+	// unordered txs are replay protected by the UnorderedTxDecorator instead
+	if unorderedTx, ok := tx.(UnorderedTx); ok && unorderedTx.GetUnordered() {
+		return next(ctx, tx, simulate)
+	}
+
 	sigTx, ok := tx.(SigVerifiableTx)
 	if !ok {
 		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
@@ -309,32 +321,57 @@ func DefaultSigVerificationGasConsumer(
 		meter.ConsumeGas(params.SigVerifyCostSecp256k1, "ante verify: secp256k1")
 		return nil
 
+	case secp256r1.PubKeySecp256r1:
+		meter.ConsumeGas(params.SigVerifyCostSecp256r1, "ante verify: secp256r1")
+		return nil
+
 	case multisig.PubKeyMultisigThreshold:
 		var multisignature multisig.Multisignature
-		codec.Cdc.MustUnmarshalBinaryBare(sig, &multisignature)
+		if err := codec.Cdc.UnmarshalBinaryBare(sig, &multisignature); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error())
+		}
 
-		ConsumeMultisignatureVerificationGas(meter, multisignature, pubkey, params)
-		return nil
+		return ConsumeMultisignatureVerificationGas(meter, multisignature, pubkey, params)
 
 	default:
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "unrecognized public key type: %T", pubkey)
 	}
 }
 
-// ConsumeMultisignatureVerificationGas consumes gas from a GasMeter for verifying a multisig pubkey signature
+// ConsumeMultisignatureVerificationGas consumes gas from a GasMeter for verifying a multisig pubkey signature. Each
+// sub-signature costs SigVerifyCostMultisigSubSig on top of the verification cost of its public key, the members
+// of the multisig being multisigs themselves or keys of the types accepted by DefaultSigVerificationGasConsumer. It
+// returns an error if the bit array of the multisignature doesn't match the members of the multisig or its
+// signatures, or if the gas consumer of a signing member, nested multisigs included, returns an error.
 func ConsumeMultisignatureVerificationGas(
 	meter sdk.GasMeter, sig multisig.Multisignature, pubkey multisig.PubKeyMultisigThreshold, params types.Params,
-) {
+) error {
 
 	size := sig.BitArray.Size()
+	if size != len(pubkey.PubKeys) {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrUnauthorized, "bit array size is %d but the multisig has %d members", size, len(pubkey.PubKeys),
+		)
+	}
+	if signers := sig.BitArray.NumTrueBitsBefore(size); signers != len(sig.Sigs) {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrUnauthorized, "bit array has %d signers but got %d signatures", signers, len(sig.Sigs),
+		)
+	}
+
 	sigIndex := 0
 
 	for i := 0; i < size; i++ {
 		if sig.BitArray.GetIndex(i) {
-			DefaultSigVerificationGasConsumer(meter, sig.Sigs[sigIndex], pubkey.PubKeys[i], params)
+			meter.ConsumeGas(params.SigVerifyCostMultisigSubSig, "ante verify: multisig sub-signature")
+			if err := DefaultSigVerificationGasConsumer(meter, sig.Sigs[sigIndex], pubkey.PubKeys[i], params); err != nil {
+				return err
+			}
 			sigIndex++
 		}
 	}
+
+	return nil
 }
 
 // GetSignerAcc returns an account for a given address that is expected to sign
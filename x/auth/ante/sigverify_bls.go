@@ -0,0 +1,28 @@
+package ante
+
+import (
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/crypto/bls"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// DefaultSigVerifyCostBLS is the suggested cost of verifying a signature of the
+// experimental BLS keys, which takes two pairings.
+const DefaultSigVerifyCostBLS uint64 = 6000
+
+// NewBLSSigVerificationGasConsumer returns a SignatureVerificationGasConsumer
+// accepting the experimental BLS keys, which it charges cost, and delegating the
+// other keys to next. The BLS keys are rejected by the default consumer: an app
+// enables them by passing the returned consumer to NewAnteHandler.
+func NewBLSSigVerificationGasConsumer(cost uint64, next SignatureVerificationGasConsumer) SignatureVerificationGasConsumer {
+	return func(meter sdk.GasMeter, sig []byte, pubkey crypto.PubKey, params types.Params) error {
+		if _, ok := pubkey.(bls.PubKeyBLS); ok {
+			meter.ConsumeGas(cost, "ante verify: bls")
+			return nil
+		}
+
+		return next(meter, sig, pubkey, params)
+	}
+}
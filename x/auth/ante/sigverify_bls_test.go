@@ -0,0 +1,56 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/crypto/bls"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// Test that BLS keys can sign for their accounts once enabled by the app.
+func TestAnteHandlerBLS(t *testing.T) {
+	// setup
+	app, ctx := createTestApp(true)
+
+	// keys and addresses
+	priv1 := bls.GenPrivKey()
+	addr1 := sdk.AccAddress(priv1.PubKey().Address())
+	priv2 := bls.GenPrivKey()
+
+	// set the accounts
+	acc1 := app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	app.AccountKeeper.SetAccount(ctx, acc1)
+	app.BankKeeper.SetBalances(ctx, addr1, types.NewTestCoins())
+
+	// msg and signatures
+	msg := types.NewTestMsg(addr1)
+	fee := types.NewTestStdFee()
+	msgs := []sdk.Msg{msg}
+	tx := types.NewTestTx(ctx, msgs, []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}, fee)
+
+	// the default consumer rejects the BLS keys
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, nil, *app.IBCKeeper, types.NewExtensionOptionsRegistry(), ante.DefaultSigVerificationGasConsumer)
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdkerrors.ErrInvalidPubKey)
+
+	anteHandler = ante.NewAnteHandler(
+		app.AccountKeeper, app.BankKeeper, nil, *app.IBCKeeper, types.NewExtensionOptionsRegistry(),
+		ante.NewBLSSigVerificationGasConsumer(ante.DefaultSigVerifyCostBLS, ante.DefaultSigVerificationGasConsumer),
+	)
+
+	// a signature of another key is rejected
+	invalidTx := types.NewTestTx(ctx, msgs, []crypto.PrivKey{priv2}, []uint64{0}, []uint64{0}, fee)
+	checkInvalidTx(t, anteHandler, ctx, invalidTx, false, sdkerrors.ErrInvalidPubKey)
+
+	newCtx, err := anteHandler(ctx, tx, false)
+	require.NoError(t, err)
+
+	// the pubkey is set and the BLS verification cost charged
+	require.Equal(t, priv1.PubKey(), app.AccountKeeper.GetAccount(ctx, addr1).GetPubKey())
+	require.True(t, newCtx.GasMeter().GasConsumed() >= ante.DefaultSigVerifyCostBLS)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/tendermint/tendermint/crypto/multisig"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 
+	"github.com/cosmos/cosmos-sdk/crypto/secp256r1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -57,6 +58,8 @@ func TestSetPubKey(t *testing.T) {
 
 func TestConsumeSignatureVerificationGas(t *testing.T) {
 	params := types.DefaultParams()
+	subSigParams := types.DefaultParams()
+	subSigParams.SigVerifyCostMultisigSubSig = 100
 	msg := []byte{1, 2, 3, 4}
 
 	pkSet1, sigSet1 := generatePubKeysAndSignatures(5, msg, false)
@@ -68,6 +71,33 @@ func TestConsumeSignatureVerificationGas(t *testing.T) {
 		require.NoError(t, err)
 	}
 
+	// a multisig whose first member is the first multisig
+	pkSet2, sigSet2 := generatePubKeysAndSignatures(2, msg, false)
+	pkSet2 = append([]crypto.PubKey{multisigKey1}, pkSet2...)
+	sigSet2 = append([][]byte{multisignature1.Marshal()}, sigSet2...)
+	multisigKey2 := multisig.NewPubKeyMultisigThreshold(2, pkSet2)
+	multisignature2 := multisig.NewMultisig(len(pkSet2))
+	expectedCost2 := expectedCost1 + expectedGasCostByKeys(pkSet2[1:])
+	for i := 0; i < len(pkSet2); i++ {
+		err := multisignature2.AddSignatureFromPubKey(sigSet2[i], pkSet2[i], pkSet2)
+		require.NoError(t, err)
+	}
+
+	// a multisignature with more bits than members
+	oversizedMultisignature := multisig.NewMultisig(len(pkSet1) + 1)
+	oversizedMultisignature.AddSignature(sigSet1[0], len(pkSet1))
+
+	// a multisig whose nested multisignature has more bits than members
+	invalidNestedMultisignature := multisig.NewMultisig(len(pkSet2))
+	invalidNestedMultisignature.AddSignature(oversizedMultisignature.Marshal(), 0)
+	invalidNestedMultisignature.AddSignature(sigSet2[1], 1)
+
+	// a multisig with an unsupported ed25519 member
+	pkSet3 := []crypto.PubKey{ed25519.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey()}
+	multisigKey3 := multisig.NewPubKeyMultisigThreshold(1, pkSet3)
+	ed25519Multisignature := multisig.NewMultisig(len(pkSet3))
+	ed25519Multisignature.AddSignature(msg, 0)
+
 	type args struct {
 		meter  sdk.GasMeter
 		sig    []byte
@@ -82,7 +112,14 @@ func TestConsumeSignatureVerificationGas(t *testing.T) {
 	}{
 		{"PubKeyEd25519", args{sdk.NewInfiniteGasMeter(), nil, ed25519.GenPrivKey().PubKey(), params}, types.DefaultSigVerifyCostED25519, true},
 		{"PubKeySecp256k1", args{sdk.NewInfiniteGasMeter(), nil, secp256k1.GenPrivKey().PubKey(), params}, types.DefaultSigVerifyCostSecp256k1, false},
+		{"PubKeySecp256r1", args{sdk.NewInfiniteGasMeter(), nil, secp256r1.GenPrivKey().PubKey(), params}, types.DefaultSigVerifyCostSecp256r1, false},
 		{"Multisig", args{sdk.NewInfiniteGasMeter(), multisignature1.Marshal(), multisigKey1, params}, expectedCost1, false},
+		{"Multisig with sub-signature cost", args{sdk.NewInfiniteGasMeter(), multisignature1.Marshal(), multisigKey1, subSigParams}, expectedCost1 + 100*uint64(len(pkSet1)), false},
+		{"Nested multisig", args{sdk.NewInfiniteGasMeter(), multisignature2.Marshal(), multisigKey2, params}, expectedCost2, false},
+		{"Multisig with oversized bit array", args{sdk.NewInfiniteGasMeter(), oversizedMultisignature.Marshal(), multisigKey1, params}, 0, true},
+		{"Nested multisig with oversized bit array", args{sdk.NewInfiniteGasMeter(), invalidNestedMultisignature.Marshal(), multisigKey2, params}, 0, true},
+		{"Multisig with ed25519 member", args{sdk.NewInfiniteGasMeter(), ed25519Multisignature.Marshal(), multisigKey3, params}, 0, true},
+		{"Multisig with malformed signature", args{sdk.NewInfiniteGasMeter(), []byte{0xff}, multisigKey1, params}, 0, true},
 		{"unknown key", args{sdk.NewInfiniteGasMeter(), nil, nil, params}, 0, true},
 	}
 	for _, tt := range tests {
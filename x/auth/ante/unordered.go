@@ -0,0 +1,110 @@
+package ante
+
+import (
+	"crypto/sha256"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DefaultMaxUnorderedTTL defines the default maximum time, relative to the
+// block time, an unordered tx may set as its timeout.
+const DefaultMaxUnorderedTTL = 10 * time.Minute
+
+// UnorderedTx defines the interface to be implemented by Tx to use the
+// UnorderedTxDecorator
+type UnorderedTx interface {
+	sdk.Tx
+	GetUnordered() bool
+	GetTimeoutTimestamp() uint64
+}
+
+// UnorderedTxDecorator rejects unordered txs that have timed out, whose timeout
+// is further than maxTTL from the block time, or that were already included.
+// Unordered txs are deduplicated by the hash of their signed content and
+// signatures, which is tracked until their timeout, after which they can no
+// longer be included. Unordered txs
+// are signed without a sequence number and do not increment the sequence of
+// their signers, so that they can be signed concurrently.
+// CONTRACT: Tx must implement UnorderedTx interface to use UnorderedTxDecorator
+type UnorderedTxDecorator struct {
+	ak     AccountKeeper
+	maxTTL time.Duration
+}
+
+func NewUnorderedTxDecorator(ak AccountKeeper, maxTTL time.Duration) UnorderedTxDecorator {
+	return UnorderedTxDecorator{
+		ak:     ak,
+		maxTTL: maxTTL,
+	}
+}
+
+func (utd UnorderedTxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	unorderedTx, ok := tx.(UnorderedTx)
+	if !ok || !unorderedTx.GetUnordered() {
+		return next(ctx, tx, simulate)
+	}
+
+	blockTime := ctx.BlockTime()
+	timeout := time.Unix(int64(unorderedTx.GetTimeoutTimestamp()), 0)
+
+	if !timeout.After(blockTime) {
+		return ctx, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "unordered transaction has timed out; timeout: %s, block time: %s", timeout, blockTime,
+		)
+	}
+	if timeout.After(blockTime.Add(utd.maxTTL)) {
+		return ctx, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "unordered transaction timeout exceeds the maximum TTL of %s", utd.maxTTL,
+		)
+	}
+
+	txHash, err := utd.unorderedTxHash(ctx, tx)
+	if err != nil {
+		return ctx, err
+	}
+
+	if utd.ak.ContainsUnorderedTx(ctx, txHash) {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unordered transaction %X has already been included", txHash)
+	}
+
+	if !simulate {
+		utd.ak.AddUnorderedTx(ctx, txHash, unorderedTx.GetTimeoutTimestamp())
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// unorderedTxHash returns the hash deduplicating an unordered tx. It is computed
+// over the sign bytes of its first signer and its signatures rather than over
+// the tx bytes, which also encode the signer pubkeys. As the pubkeys are not
+// signed, hashing them would let a tx be replayed with its pubkeys added or
+// removed.
+func (utd UnorderedTxDecorator) unorderedTxHash(ctx sdk.Context, tx sdk.Tx) ([]byte, error) {
+	sigTx, ok := tx.(SigVerifiableTx)
+	if !ok {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	signers := sigTx.GetSigners()
+	if len(signers) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrNoSignatures, "unordered transaction has no signers")
+	}
+
+	// The sign bytes of the other signers only differ by their account number,
+	// which their signatures commit to.
+	acc, err := GetSignerAcc(ctx, utd.ak, signers[0])
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.New()
+	hash.Write(sigTx.GetSignBytes(ctx, acc))
+	for _, sig := range sigTx.GetSignatures() {
+		hash.Write(sdk.Uint64ToBigEndian(uint64(len(sig))))
+		hash.Write(sig)
+	}
+
+	return hash.Sum(nil), nil
+}
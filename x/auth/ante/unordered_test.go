@@ -0,0 +1,84 @@
+package ante_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestUnorderedTxs(t *testing.T) {
+	// setup
+	app, ctx := createTestApp(false)
+	blockTime := time.Unix(1000, 0)
+	ctx = ctx.WithBlockHeight(1).WithBlockTime(blockTime)
+	anteHandler := ante.NewAnteHandler(app.AccountKeeper, app.BankKeeper, nil, *app.IBCKeeper, types.NewExtensionOptionsRegistry(), ante.DefaultSigVerificationGasConsumer)
+
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
+	cdc.RegisterConcrete(sdk.TestMsg{}, "cosmos-sdk/Test", nil)
+	txEncoder := types.DefaultTxEncoder(cdc)
+
+	// keys and addresses
+	priv1, _, addr1 := types.KeyTestPubAddr()
+	acc1 := app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	app.AccountKeeper.SetAccount(ctx, acc1)
+	app.BankKeeper.SetBalances(ctx, addr1, types.NewTestCoins())
+
+	newUnorderedTx := func(memo string, timeout time.Time) (sdk.Context, types.StdTx) {
+		tx := types.NewStdTx([]sdk.Msg{types.NewTestMsg(addr1)}, types.NewTestStdFee(), nil, memo)
+		tx.Unordered = true
+		tx.TimeoutTimestamp = uint64(timeout.Unix())
+
+		sig, err := priv1.Sign(tx.GetSignBytes(ctx, app.AccountKeeper.GetAccount(ctx, addr1)))
+		require.NoError(t, err)
+		tx.Signatures = []types.StdSignature{{PubKey: priv1.PubKey().Bytes(), Signature: sig}}
+
+		bz, err := txEncoder(tx)
+		require.NoError(t, err)
+
+		return ctx.WithTxBytes(bz), tx
+	}
+
+	// unordered txs are valid and do not increment the sequence
+	txCtx, tx := newUnorderedTx("first", blockTime.Add(time.Minute))
+	checkValidTx(t, anteHandler, txCtx, tx, false)
+	require.Equal(t, uint64(0), app.AccountKeeper.GetAccount(ctx, addr1).GetSequence())
+
+	// a different unordered tx with the same sequence is valid as well
+	txCtx2, tx2 := newUnorderedTx("second", blockTime.Add(time.Minute))
+	checkValidTx(t, anteHandler, txCtx2, tx2, false)
+
+	// replaying an unordered tx fails
+	checkInvalidTx(t, anteHandler, txCtx, tx, false, sdkerrors.ErrInvalidRequest)
+
+	// replaying an unordered tx re-encoded without its unsigned pubkey fails,
+	// the pubkey being set on the account
+	replayTx := tx
+	replayTx.Signatures = []types.StdSignature{{Signature: tx.Signatures[0].Signature}}
+	bz, err := txEncoder(replayTx)
+	require.NoError(t, err)
+	require.NotEqual(t, txCtx.TxBytes(), bz)
+	checkInvalidTx(t, anteHandler, ctx.WithTxBytes(bz), replayTx, false, sdkerrors.ErrInvalidRequest)
+
+	// timed out unordered txs are rejected
+	txCtx, tx = newUnorderedTx("timed out", blockTime)
+	checkInvalidTx(t, anteHandler, txCtx, tx, false, sdkerrors.ErrInvalidRequest)
+
+	// unordered txs with a timeout beyond the maximum TTL are rejected
+	txCtx, tx = newUnorderedTx("too far", blockTime.Add(ante.DefaultMaxUnorderedTTL+time.Second))
+	checkInvalidTx(t, anteHandler, txCtx, tx, false, sdkerrors.ErrInvalidRequest)
+
+	// unordered txs cannot be altered into ordered ones
+	txCtx, tx = newUnorderedTx("ordered", blockTime.Add(time.Minute))
+	tx.Unordered = false
+	tx.TimeoutTimestamp = 0
+	checkInvalidTx(t, anteHandler, txCtx, tx, false, sdkerrors.ErrUnauthorized)
+}
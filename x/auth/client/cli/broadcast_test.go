@@ -41,5 +41,5 @@ func TestGetBroadcastCommand_WithoutOfflineFlag(t *testing.T) {
 	err = cmd.RunE(cmd, []string{txFileName})
 
 	// We test it tries to broadcast but we set unsupported tx to get the error.
-	require.EqualError(t, err, "unsupported return type ; supported types: sync, async, block")
+	require.EqualError(t, err, `unsupported broadcast mode ""; supported modes: sync, async, block`)
 }
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -16,11 +17,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/cosmos/cosmos-sdk/version"
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/auth/exported"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 )
 
 const (
-	flagEvents = "events"
+	flagEvents  = "events"
+	flagTimeout = "timeout"
 
 	eventFormat = "{eventType}.{eventAttribute}={value}"
 )
@@ -37,6 +41,10 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 
 	cmd.AddCommand(
 		GetAccountCmd(cdc),
+		GetAccountsCmd(cdc),
+		GetModuleAccountsCmd(cdc),
+		GetAccountAddressByIDCmd(cdc),
+		GetVestingScheduleCmd(cdc),
 		QueryParamsCmd(cdc),
 	)
 
@@ -102,6 +110,166 @@ func GetAccountCmd(cdc *codec.Codec) *cobra.Command {
 	return flags.GetCommands(cmd)[0]
 }
 
+// GetAccountsCmd returns a query command that will display all the accounts,
+// paginated.
+func GetAccountsCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accounts",
+		Short: "Query all the accounts",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the accounts, paginated.
+
+Example:
+$ %s query auth accounts --page=2 --limit=50
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := types.NewQueryAccountsParams(viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit))
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAccounts)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var accounts []exported.Account
+			if err := cdc.UnmarshalJSON(res, &accounts); err != nil {
+				return fmt.Errorf("failed to unmarshal accounts: %w", err)
+			}
+
+			return cliCtx.PrintOutput(accounts)
+		},
+	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of accounts to to query for")
+	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of accounts to query for")
+
+	return flags.GetCommands(cmd)[0]
+}
+
+// GetModuleAccountsCmd returns a query command that will display all the
+// module accounts.
+func GetModuleAccountsCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "module-accounts",
+		Short: "Query all the module accounts",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryModuleAccounts)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var accounts []exported.ModuleAccountI
+			if err := cdc.UnmarshalJSON(res, &accounts); err != nil {
+				return fmt.Errorf("failed to unmarshal module accounts: %w", err)
+			}
+
+			return cliCtx.PrintOutput(accounts)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
+
+// GetAccountAddressByIDCmd returns a query command that will display the
+// address of the account with the given account number.
+func GetAccountAddressByIDCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "address-by-acc-num [account_number]",
+		Short: "Query the address of an account by its account number",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid account number %s: %w", args[0], err)
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryAccountAddressByIDParams(id))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAccountAddressByID)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var addr sdk.AccAddress
+			if err := cdc.UnmarshalJSON(res, &addr); err != nil {
+				return fmt.Errorf("failed to unmarshal address: %w", err)
+			}
+
+			return cliCtx.PrintOutput(addr)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
+
+// GetVestingScheduleCmd returns a query command that will display the vesting
+// schedule of a vesting account at a given address as of the latest block,
+// including any vesting periods that have yet to elapse.
+func GetVestingScheduleCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vesting-schedule [address]",
+		Short: "Query the remaining vesting schedule of a vesting account",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the vesting schedule of a vesting account. The vested and still
+vesting coins are computed as of the latest block time and, for periodic vesting
+accounts, all periods that have not fully elapsed are listed.
+
+Example:
+$ %s query auth vesting-schedule cosmos1...
+`, version.ClientName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryAccountParams(addr))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryVestingSchedule)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var schedule vestingtypes.VestingSchedule
+			if err := cdc.UnmarshalJSON(res, &schedule); err != nil {
+				return fmt.Errorf("failed to unmarshal vesting schedule: %w", err)
+			}
+
+			return cliCtx.PrintOutput(schedule)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
+
 // QueryTxsByEventsCmd returns a command to search through transactions by events.
 func QueryTxsByEventsCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -220,3 +388,40 @@ func QueryTxCmd(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// WaitTxCmd implements the command waiting for a tx to be committed.
+func WaitTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wait-tx [hash]",
+		Short: "Wait for a transaction to be committed in a block, and query it",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Wait for a transaction broadcast with the sync or async mode to be committed
+in a block, for at most --%s, and print it as the tx command does.
+
+Example:
+$ %s query wait-tx <hash> --%s 30s
+`, flagTimeout, version.ClientName, flagTimeout),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			output, err := authclient.WaitTx(cliCtx, args[0], viper.GetDuration(flagTimeout))
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(output)
+		},
+	}
+
+	cmd.Flags().Duration(flagTimeout, flags.DefaultWaitTimeout, "Time to wait for the transaction to be committed")
+	cmd.Flags().StringP(flags.FlagNode, "n", "tcp://localhost:26657", "Node to connect to")
+	viper.BindPFlag(flags.FlagNode, cmd.Flags().Lookup(flags.FlagNode))
+	cmd.Flags().Bool(flags.FlagTrustNode, false, "Trust connected full node (don't verify proofs for responses)")
+	viper.BindPFlag(flags.FlagTrustNode, cmd.Flags().Lookup(flags.FlagTrustNode))
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test)")
+	viper.BindPFlag(flags.FlagKeyringBackend, cmd.Flags().Lookup(flags.FlagKeyringBackend))
+
+	return cmd
+}
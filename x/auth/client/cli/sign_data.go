@@ -0,0 +1,293 @@
+package cli
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+const (
+	flagDataFile      = "data-file"
+	flagSignatureFile = "signature-file"
+
+	nodeFlagUsage = "<host>:<port> to Tendermint RPC interface for this chain, to verify the signature with the " +
+		"public key of the signer's account, which may have been changed with a MsgChangePubKey, instead of the " +
+		"one of its address"
+)
+
+// GetSignArbitraryCommand returns the command to sign arbitrary data off-chain.
+// It is meant to be added to the keys command of an application.
+func GetSignArbitraryCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-arbitrary [name_or_address] [data]",
+		Short: "Sign arbitrary data off-chain to prove ownership of an address",
+		Long: `Sign arbitrary data with the given key, as specified by ADR 036, and print the
+resulting signature. The data is signed within a transaction that can never be valid on
+any chain and must not be broadcast. If --data-file is set, the data is read from the
+given file instead of the [data] argument.
+`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			data, err := readSignData(args)
+			if err != nil {
+				return err
+			}
+
+			kb, err := keyring.New(sdk.KeyringServiceName(), viper.GetString(flags.FlagKeyringBackend), viper.GetString(flags.FlagHome), cmd.InOrStdin())
+			if err != nil {
+				return err
+			}
+
+			signer, name, err := context.GetFromFields(kb, args[0], false)
+			if err != nil {
+				return err
+			}
+
+			sig, err := types.MakeSignature(kb, name, "", types.NewSignDataSignMsg(signer, data))
+			if err != nil {
+				return err
+			}
+
+			var bz []byte
+			if viper.GetBool(flags.FlagIndentResponse) {
+				bz, err = cdc.MarshalJSONIndent(sig, "", "  ")
+			} else {
+				bz, err = cdc.MarshalJSON(sig)
+			}
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(string(bz))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagDataFile, "", "Read the data to sign from the given file")
+	cmd.Flags().Bool(flags.FlagIndentResponse, false, "Add indent to JSON response")
+	return cmd
+}
+
+// GetVerifyArbitraryCommand returns the command to verify a signature over
+// arbitrary data produced by the sign-arbitrary command.
+func GetVerifyArbitraryCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-arbitrary [address] [signature_file] [data]",
+		Short: "Verify a signature over arbitrary data produced by sign-arbitrary",
+		Long: `Verify that the signature read from [signature_file] was produced by [address] over
+the given arbitrary data, as specified by ADR 036. If --data-file is set, the data is read
+from the given file instead of the [data] argument.
+`,
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			signer, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			stdSig, err := readAndUnmarshalStdSignature(cdc, args[1])
+			if err != nil {
+				return err
+			}
+
+			data, err := readSignData(args[1:])
+			if err != nil {
+				return err
+			}
+
+			accPubKey, err := signerAccountPubKey(cmd, cdc, signer)
+			if err != nil {
+				return err
+			}
+
+			if err := types.VerifySignData(signer, data, stdSig.GetPubKey(), accPubKey, stdSig.Signature); err != nil {
+				return err
+			}
+
+			cmd.Printf("signature by %s is valid\n", signer)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagDataFile, "", "Read the signed data from the given file")
+	cmd.Flags().String(flags.FlagNode, "", nodeFlagUsage)
+	return cmd
+}
+
+// signerAccountPubKey returns the public key stored on the account of the
+// signer, queried from the node set by --node, or nil if no node is set or the
+// account has no public key yet.
+func signerAccountPubKey(cmd *cobra.Command, cdc *codec.Codec, signer sdk.AccAddress) (crypto.PubKey, error) {
+	node, _ := cmd.Flags().GetString(flags.FlagNode)
+	if node == "" {
+		return nil, nil
+	}
+
+	cliCtx := context.NewCLIContext().WithCodec(cdc).WithNodeURI(node)
+
+	acc, err := types.NewAccountRetriever(authclient.Codec, cliCtx).GetAccount(signer)
+	if err != nil {
+		return nil, err
+	}
+
+	return acc.GetPubKey(), nil
+}
+
+// readSignData returns the data to sign or verify, read either from the file
+// given by --data-file or from the argument following args[0].
+func readSignData(args []string) ([]byte, error) {
+	if file := viper.GetString(flagDataFile); file != "" {
+		return ioutil.ReadFile(file)
+	}
+
+	if len(args) < 2 {
+		return nil, fmt.Errorf("either the data argument or --%s must be provided", flagDataFile)
+	}
+
+	return []byte(args[1]), nil
+}
+
+// GetSignFileCommand returns the command to produce a detached signature of a
+// file off-chain. It is meant to be added to the keys command of an application.
+func GetSignFileCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-file [name_or_address] [file]",
+		Short: "Produce a detached signature of a file with an account key",
+		Long: `Sign the given file, e.g. a genesis file or a binary, with the given key and write
+the detached signature to [file].sig, or to the --signature-file file. As with the
+sign-arbitrary command, the data signed as specified by ADR 036 is the hex-encoded
+SHA-256 digest of the file, as printed by sha256sum, so that the signature can also be
+verified with verify-arbitrary.
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			kb, err := keyring.New(sdk.KeyringServiceName(), viper.GetString(flags.FlagKeyringBackend), viper.GetString(flags.FlagHome), cmd.InOrStdin())
+			if err != nil {
+				return err
+			}
+
+			signer, name, err := context.GetFromFields(kb, args[0], false)
+			if err != nil {
+				return err
+			}
+
+			sig, err := signFile(kb, name, signer, args[1])
+			if err != nil {
+				return err
+			}
+
+			sigFile := viper.GetString(flagSignatureFile)
+			if sigFile == "" {
+				sigFile = args[1] + ".sig"
+			}
+
+			if err := ioutil.WriteFile(sigFile, cdc.MustMarshalJSON(sig), 0644); err != nil {
+				return err
+			}
+
+			cmd.PrintErrf("signature by %s written to %s\n", signer, sigFile)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagSignatureFile, "", "Write the signature to the given file instead of [file].sig")
+	return cmd
+}
+
+// GetVerifyFileCommand returns the command to verify a detached signature of a
+// file produced by the sign-file command.
+func GetVerifyFileCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-file [address] [file]",
+		Short: "Verify a detached signature of a file produced by sign-file",
+		Long: `Verify that the detached signature read from [file].sig, or from the --signature-file
+file, was produced by [address] over the given file.
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			signer, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			sigFile := viper.GetString(flagSignatureFile)
+			if sigFile == "" {
+				sigFile = args[1] + ".sig"
+			}
+
+			stdSig, err := readAndUnmarshalStdSignature(cdc, sigFile)
+			if err != nil {
+				return err
+			}
+
+			accPubKey, err := signerAccountPubKey(cmd, cdc, signer)
+			if err != nil {
+				return err
+			}
+
+			if err := verifyFile(signer, args[1], stdSig, accPubKey); err != nil {
+				return err
+			}
+
+			cmd.Printf("signature of %s by %s is valid\n", args[1], signer)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagSignatureFile, "", "Read the signature from the given file instead of [file].sig")
+	cmd.Flags().String(flags.FlagNode, "", nodeFlagUsage)
+	return cmd
+}
+
+// signFile signs the digest of the file with the named key of the signer.
+func signFile(kb keyring.Keyring, name string, signer sdk.AccAddress, path string) (types.StdSignature, error) {
+	digest, err := fileDigest(path)
+	if err != nil {
+		return types.StdSignature{}, err
+	}
+
+	return types.MakeSignature(kb, name, "", types.NewSignDataSignMsg(signer, digest))
+}
+
+// verifyFile verifies that the signature was produced by the signer, whose
+// account has the given public key if any, over the digest of the file.
+func verifyFile(signer sdk.AccAddress, path string, stdSig types.StdSignature, accPubKey crypto.PubKey) error {
+	digest, err := fileDigest(path)
+	if err != nil {
+		return err
+	}
+
+	return types.VerifySignData(signer, digest, stdSig.GetPubKey(), accPubKey, stdSig.Signature)
+}
+
+// fileDigest returns the hex-encoded SHA-256 digest of the file, which is
+// read as a stream not to load large binaries in memory.
+func fileDigest(path string) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return nil, err
+	}
+
+	return []byte(hex.EncodeToString(hash.Sum(nil))), nil
+}
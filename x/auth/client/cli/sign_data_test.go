@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/tests"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestSignAndVerifyFile(t *testing.T) {
+	dir, cleanup := tests.NewTestCaseDir(t)
+	defer cleanup()
+
+	kb := keyring.NewInMemory()
+	info, err := kb.NewAccount("signer", tests.TestMnemonic, "", sdk.FullFundraiserPath, hd.Secp256k1)
+	require.NoError(t, err)
+
+	content := []byte(`{"chain_id":"test-chain"}`)
+	path := filepath.Join(dir, "genesis.json")
+	require.NoError(t, ioutil.WriteFile(path, content, 0600))
+
+	sig, err := signFile(kb, "signer", info.GetAddress(), path)
+	require.NoError(t, err)
+	require.NoError(t, verifyFile(info.GetAddress(), path, sig, nil))
+
+	// the signed data is the digest of the file, as printed by sha256sum
+	digest := sha256.Sum256(content)
+	require.NoError(t, types.VerifySignData(info.GetAddress(), []byte(hex.EncodeToString(digest[:])), sig.GetPubKey(), nil, sig.Signature))
+
+	// the signature is invalid for another signer or another content
+	other, err := kb.NewAccount("other", tests.TestMnemonic, "", hd.CreateHDPath(118, 0, 1).String(), hd.Secp256k1)
+	require.NoError(t, err)
+	require.Error(t, verifyFile(other.GetAddress(), path, sig, nil))
+
+	// the signer's account whose public key was changed to the one of other
+	rotatedSig, err := signFile(kb, "other", info.GetAddress(), path)
+	require.NoError(t, err)
+	require.Error(t, verifyFile(info.GetAddress(), path, rotatedSig, nil))
+	require.NoError(t, verifyFile(info.GetAddress(), path, rotatedSig, other.GetPubKey()))
+	require.Error(t, verifyFile(info.GetAddress(), path, sig, other.GetPubKey()))
+
+	require.NoError(t, ioutil.WriteFile(path, append(content, ' '), 0600))
+	require.Error(t, verifyFile(info.GetAddress(), path, sig, nil))
+
+	_, err = signFile(kb, "signer", info.GetAddress(), filepath.Join(dir, "missing"))
+	require.Error(t, err)
+}
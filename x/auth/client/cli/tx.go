@@ -1,10 +1,16 @@
 package cli
 
 import (
+	"bufio"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -19,7 +25,42 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	}
 	txCmd.AddCommand(
 		GetMultiSignCommand(cdc),
+		GetMultiSignBatchCommand(cdc),
 		GetSignCommand(cdc),
+		GetChangePubKeyCommand(cdc),
 	)
 	return txCmd
 }
+
+// GetChangePubKeyCommand returns a CLI command handler for creating a
+// MsgChangePubKey transaction.
+func GetChangePubKeyCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "change-pubkey [pubkey]",
+		Short: "Rotate the public key of the sender account",
+		Long: `Rotate the public key of the sender account to the given bech32 encoded
+account public key. The account keeps its address, account number, sequence and
+balances. The transaction is signed with the current key, after which transactions
+must be signed with the new key.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := types.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			pubKey, err := sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeAccPub, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgChangePubKey(cliCtx.GetFromAddress(), pubKey)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return flags.PostCommands(cmd)[0]
+}
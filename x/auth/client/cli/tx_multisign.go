@@ -2,6 +2,7 @@ package cli
 
 import (
 	"bufio"
+	"bytes"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -39,17 +40,28 @@ $ %s multisign transaction.json k1k2k3 k1sig.json k2sig.json k3sig.json
 If the flag --signature-only flag is on, it outputs a JSON representation
 of the generated signature only.
 
+The members of a multisig key may be multisig keys themselves, whose signature
+is generated with the --signature-only flag and passed as any other signature.
+When generating the signature of such a nested multisig key, the --multisig flag
+must be set to the address of the account the transaction is signed for:
+
+$ %s multisign transaction.json k2k3 k2sig.json k3sig.json --multisig=<k1(k2k3)k4 address> --signature-only
+
 The --offline flag makes sure that the client will not reach out to an external node.
 Thus account number or sequence number lookups will not be performed and it is
 recommended to set such parameters manually.
 `,
-				version.ClientName,
+				version.ClientName, version.ClientName,
 			),
 		),
 		RunE: makeMultiSignCmd(cdc),
 		Args: cobra.MinimumNArgs(3),
 	}
 
+	cmd.Flags().String(
+		flagMultisig, "",
+		"Address of the multisig account on behalf of which the transaction shall be signed, if the multisig key is nested in it",
+	)
 	cmd.Flags().Bool(flagSigOnly, false, "Print only the generated signature, then exit")
 	cmd.Flags().String(flagOutfile, "", "The document will be written to the given file instead of STDOUT")
 
@@ -65,56 +77,26 @@ func makeMultiSignCmd(cdc *codec.Codec) func(cmd *cobra.Command, args []string)
 		}
 
 		inBuf := bufio.NewReader(cmd.InOrStdin())
-		kb, err := keyring.New(sdk.KeyringServiceName(),
-			viper.GetString(flags.FlagKeyringBackend), viper.GetString(flags.FlagHome), inBuf)
-		if err != nil {
-			return
-		}
-
-		multisigInfo, err := kb.Key(args[1])
-		if err != nil {
-			return
-		}
-		if multisigInfo.GetType() != keyring.TypeMulti {
-			return fmt.Errorf("%q must be of type %s: %s", args[1], keyring.TypeMulti, multisigInfo.GetType())
-		}
-
-		multisigPub := multisigInfo.GetPubKey().(multisig.PubKeyMultisigThreshold)
-		multisigSig := multisig.NewMultisig(len(multisigPub.PubKeys))
 		cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
-		txBldr := types.NewTxBuilderFromCLI(inBuf)
-
-		if !cliCtx.Offline {
-			accnum, seq, err := types.NewAccountRetriever(client.Codec, cliCtx).GetAccountNumberSequence(multisigInfo.GetAddress())
-			if err != nil {
-				return err
-			}
 
-			txBldr = txBldr.WithAccountNumber(accnum).WithSequence(seq)
+		multisigPub, txBldr, err := getMultisigSigner(cliCtx, inBuf, args[1])
+		if err != nil {
+			return err
 		}
 
 		// read each signature and add it to the multisig if valid
+		stdSigs := make([]types.StdSignature, len(args)-2)
 		for i := 2; i < len(args); i++ {
-			stdSig, err := readAndUnmarshalStdSignature(cdc, args[i])
+			stdSigs[i-2], err = readAndUnmarshalStdSignature(cdc, args[i])
 			if err != nil {
 				return err
 			}
-
-			// Validate each signature
-			sigBytes := types.StdSignBytes(
-				txBldr.ChainID(), txBldr.AccountNumber(), txBldr.Sequence(),
-				stdTx.Fee, stdTx.GetMsgs(), stdTx.GetMemo(),
-			)
-			if ok := stdSig.GetPubKey().VerifyBytes(sigBytes, stdSig.Signature); !ok {
-				return fmt.Errorf("couldn't verify signature")
-			}
-			if err := multisigSig.AddSignatureFromPubKey(stdSig.Signature, stdSig.GetPubKey(), multisigPub.PubKeys); err != nil {
-				return err
-			}
 		}
 
-		newStdSig := types.StdSignature{Signature: cdc.MustMarshalBinaryBare(multisigSig), PubKey: multisigPub.Bytes()}
-		newTx := types.NewStdTx(stdTx.GetMsgs(), stdTx.Fee, []types.StdSignature{newStdSig}, stdTx.GetMemo())
+		newTx, err := multisignStdTx(cdc, txBldr, multisigPub, stdTx, stdSigs)
+		if err != nil {
+			return err
+		}
 
 		sigOnly := viper.GetBool(flagSigOnly)
 		var json []byte
@@ -151,6 +133,223 @@ func makeMultiSignCmd(cdc *codec.Codec) func(cmd *cobra.Command, args []string)
 	}
 }
 
+// GetMultiSignBatchCommand returns the multisign-batch command.
+func GetMultiSignBatchCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multisign-batch [file] [name] [[signature-file]...]",
+		Short: "Generate multisig signatures for a batch of transactions generated offline",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Sign a batch of newline-delimited transactions that require multisig signatures.
+
+Read the newline-delimited signatures of each member of the multisig key [name] from
+the [signature-file] files, as printed by 'sign --batch --multisig', generate the multisig
+signature of each transaction read from [file], and print the signed transactions, one
+per line.
+
+Example:
+$ %s tx sign --batch transactions.json --multisig=<k1k2k3 address> --from=k1 > k1sigs.json
+$ %s tx sign --batch transactions.json --multisig=<k1k2k3 address> --from=k2 > k2sigs.json
+$ %s tx multisign-batch transactions.json k1k2k3 k1sigs.json k2sigs.json
+
+Each signature file must hold a signature per transaction, in the same order. The
+transactions are signed with sequentially incremented sequence numbers, starting at the
+multisig account's current sequence (or the one given by --sequence), so the command
+fails if a signature was made over another sequence number than the one of its
+transaction.
+
+If the flag --signature-only flag is on, it outputs the generated signatures only.
+
+The --offline flag makes sure that the client will not reach out to an external node.
+Thus account number or sequence number lookups will not be performed and it is
+recommended to set such parameters manually.
+`,
+				version.ClientName, version.ClientName, version.ClientName,
+			),
+		),
+		RunE: makeMultiSignBatchCmd(cdc),
+		Args: cobra.MinimumNArgs(3),
+	}
+
+	cmd.Flags().String(
+		flagMultisig, "",
+		"Address of the multisig account on behalf of which the transactions shall be signed, if the multisig key is nested in it",
+	)
+	cmd.Flags().Bool(flagSigOnly, false, "Print only the generated signatures, then exit")
+	cmd.Flags().String(flagOutfile, "", "The document will be written to the given file instead of STDOUT")
+
+	// Add the flags here and return the command
+	return flags.PostCommands(cmd)[0]
+}
+
+func makeMultiSignBatchCmd(cdc *codec.Codec) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		stdTxs, err := client.ReadStdTxsFromFile(cdc, args[0])
+		if err != nil {
+			return err
+		}
+
+		inBuf := bufio.NewReader(cmd.InOrStdin())
+		cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+		multisigPub, txBldr, err := getMultisigSigner(cliCtx, inBuf, args[1])
+		if err != nil {
+			return err
+		}
+
+		sigBatches := make([][]types.StdSignature, len(args)-2)
+		for i := 2; i < len(args); i++ {
+			sigBatches[i-2], err = readAndUnmarshalStdSignatures(cdc, args[i])
+			if err != nil {
+				return err
+			}
+		}
+
+		signedTxs, err := multisignStdTxBatch(cdc, txBldr, multisigPub, stdTxs, sigBatches)
+		if err != nil {
+			return err
+		}
+
+		var b bytes.Buffer
+		for i, signedTx := range signedTxs {
+			json, err := getSignatureJSON(cdc, signedTx, false, viper.GetBool(flagSigOnly))
+			if err != nil {
+				return err
+			}
+
+			if i > 0 {
+				b.WriteByte('\n')
+			}
+			b.Write(json)
+		}
+
+		return writeSignOutput(b.Bytes())
+	}
+}
+
+// getMultisigSigner returns the public key of the multisig key of the given
+// name, and a TxBuilder set with the account and sequence numbers of the
+// account it signs for, which are queried unless offline.
+func getMultisigSigner(
+	cliCtx context.CLIContext, inBuf *bufio.Reader, name string,
+) (multisigPub multisig.PubKeyMultisigThreshold, txBldr types.TxBuilder, err error) {
+
+	kb, err := keyring.New(sdk.KeyringServiceName(),
+		viper.GetString(flags.FlagKeyringBackend), viper.GetString(flags.FlagHome), inBuf)
+	if err != nil {
+		return
+	}
+
+	multisigInfo, err := kb.Key(name)
+	if err != nil {
+		return
+	}
+	if multisigInfo.GetType() != keyring.TypeMulti {
+		err = fmt.Errorf("%q must be of type %s: %s", name, keyring.TypeMulti, multisigInfo.GetType())
+		return
+	}
+
+	multisigPub = multisigInfo.GetPubKey().(multisig.PubKeyMultisigThreshold)
+	txBldr = types.NewTxBuilderFromCLI(inBuf)
+
+	// the signatures of a nested multisig key are over the account number and
+	// sequence of the account it signs for
+	signerAddr := multisigInfo.GetAddress()
+	if multisigAddrStr := viper.GetString(flagMultisig); multisigAddrStr != "" {
+		signerAddr, err = sdk.AccAddressFromBech32(multisigAddrStr)
+		if err != nil {
+			return
+		}
+	}
+
+	if !cliCtx.Offline {
+		accnum, seq, err := types.NewAccountRetriever(client.Codec, cliCtx).GetAccountNumberSequence(signerAddr)
+		if err != nil {
+			return multisigPub, txBldr, err
+		}
+
+		txBldr = txBldr.WithAccountNumber(accnum).WithSequence(seq)
+	}
+
+	return multisigPub, txBldr, nil
+}
+
+// multisignStdTx returns a copy of the tx signed by the multisig key, from the
+// signatures of its members over the account number and sequence of the
+// TxBuilder.
+func multisignStdTx(
+	cdc *codec.Codec, txBldr types.TxBuilder, multisigPub multisig.PubKeyMultisigThreshold,
+	stdTx types.StdTx, stdSigs []types.StdSignature,
+) (types.StdTx, error) {
+
+	multisigSig := multisig.NewMultisig(len(multisigPub.PubKeys))
+	sigBytes := types.StdSignMsg{
+		ChainID:          txBldr.ChainID(),
+		AccountNumber:    txBldr.AccountNumber(),
+		Sequence:         txBldr.Sequence(),
+		Fee:              stdTx.Fee,
+		Msgs:             stdTx.GetMsgs(),
+		Memo:             stdTx.GetMemo(),
+		Unordered:        stdTx.Unordered,
+		TimeoutTimestamp: stdTx.TimeoutTimestamp,
+		ExtensionOptions: stdTx.ExtensionOptions,
+	}.Bytes()
+
+	for _, stdSig := range stdSigs {
+		// Validate each signature
+		if ok := stdSig.GetPubKey().VerifyBytes(sigBytes, stdSig.Signature); !ok {
+			return stdTx, fmt.Errorf("couldn't verify signature")
+		}
+		if err := multisigSig.AddSignatureFromPubKey(stdSig.Signature, stdSig.GetPubKey(), multisigPub.PubKeys); err != nil {
+			return stdTx, err
+		}
+	}
+
+	newStdSig := types.StdSignature{Signature: cdc.MustMarshalBinaryBare(multisigSig), PubKey: multisigPub.Bytes()}
+	newTx := stdTx
+	newTx.Signatures = []types.StdSignature{newStdSig}
+
+	return newTx, nil
+}
+
+// multisignStdTxBatch returns copies of the txs signed by the multisig key,
+// from the batches of signatures of its members, holding a signature per tx.
+// The ordered txs are signed with sequentially incremented sequence numbers,
+// starting at the sequence of the TxBuilder, as by SignStdTxBatch.
+func multisignStdTxBatch(
+	cdc *codec.Codec, txBldr types.TxBuilder, multisigPub multisig.PubKeyMultisigThreshold,
+	stdTxs []types.StdTx, sigBatches [][]types.StdSignature,
+) ([]types.StdTx, error) {
+
+	for i, sigs := range sigBatches {
+		if len(sigs) != len(stdTxs) {
+			return nil, fmt.Errorf("signature batch %d holds %d signatures, expected one per tx: %d", i, len(sigs), len(stdTxs))
+		}
+	}
+
+	signedStdTxs := make([]types.StdTx, len(stdTxs))
+	sequence := txBldr.Sequence()
+
+	for i, stdTx := range stdTxs {
+		stdSigs := make([]types.StdSignature, len(sigBatches))
+		for j, sigs := range sigBatches {
+			stdSigs[j] = sigs[i]
+		}
+
+		var err error
+		signedStdTxs[i], err = multisignStdTx(cdc, txBldr.WithSequence(sequence), multisigPub, stdTx, stdSigs)
+		if err != nil {
+			return nil, fmt.Errorf("tx %d with sequence %d: %w", i, sequence, err)
+		}
+
+		// unordered txs don't use, nor increment, the account sequence
+		if !stdTx.Unordered {
+			sequence++
+		}
+	}
+
+	return signedStdTxs, nil
+}
+
 func readAndUnmarshalStdSignature(cdc *codec.Codec, filename string) (stdSig types.StdSignature, err error) {
 	var bytes []byte
 	if bytes, err = ioutil.ReadFile(filename); err != nil {
@@ -161,3 +360,29 @@ func readAndUnmarshalStdSignature(cdc *codec.Codec, filename string) (stdSig typ
 	}
 	return
 }
+
+// readAndUnmarshalStdSignatures reads the newline-delimited JSON encoded
+// signatures of the given file. Empty lines are skipped.
+func readAndUnmarshalStdSignatures(cdc *codec.Codec, filename string) ([]types.StdSignature, error) {
+	bz, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var stdSigs []types.StdSignature
+	for i, line := range strings.Split(string(bz), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		var stdSig types.StdSignature
+		if err := cdc.UnmarshalJSON([]byte(line), &stdSig); err != nil {
+			return nil, fmt.Errorf("failed to decode signature on line %d of %s: %w", i+1, filename, err)
+		}
+
+		stdSigs = append(stdSigs, stdSig)
+	}
+
+	return stdSigs, nil
+}
@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/multisig"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/tests"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestMultisignStdTxBatch(t *testing.T) {
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	types.RegisterCodec(cdc)
+	cdc.RegisterConcrete(&sdk.TestMsg{}, "cosmos-sdk/Test", nil)
+
+	kb := keyring.NewInMemory()
+	names := []string{"k1", "k2", "k3"}
+	pubKeys := make([]crypto.PubKey, len(names))
+	for i, name := range names {
+		info, err := kb.NewAccount(name, tests.TestMnemonic, "", hd.CreateHDPath(118, 0, uint32(i)).String(), hd.Secp256k1)
+		require.NoError(t, err)
+		pubKeys[i] = info.GetPubKey()
+	}
+
+	multisigPub := multisig.NewPubKeyMultisigThreshold(2, pubKeys).(multisig.PubKeyMultisigThreshold)
+	multisigAddr := sdk.AccAddress(multisigPub.Address())
+
+	fee := types.NewStdFee(50000, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	stdTxs := make([]types.StdTx, 3)
+	for i := range stdTxs {
+		stdTxs[i] = types.NewStdTx([]sdk.Msg{sdk.NewTestMsg(multisigAddr)}, fee, nil, string(rune('a'+i)))
+	}
+	// the unordered txs don't increment the sequence
+	stdTxs[1].Unordered = true
+
+	txBldr := types.NewTxBuilder(
+		client.GetTxEncoder(cdc), 3, 7, 0, 0, false, "test-chain", "", nil, nil,
+	).WithKeybase(kb)
+
+	// the members sign the batch as with sign --batch --multisig
+	signBatch := func(name string, txBldr types.TxBuilder) []types.StdSignature {
+		signedTxs, err := client.SignStdTxBatchWithSignerAddress(
+			txBldr, context.CLIContext{}, multisigAddr, name, stdTxs, true,
+		)
+		require.NoError(t, err)
+
+		sigs := make([]types.StdSignature, len(signedTxs))
+		for i, signedTx := range signedTxs {
+			require.Len(t, signedTx.Signatures, 1)
+			sigs[i] = signedTx.Signatures[0]
+		}
+
+		return sigs
+	}
+	sigs1 := signBatch("k1", txBldr)
+	sigs3 := signBatch("k3", txBldr)
+
+	signedTxs, err := multisignStdTxBatch(cdc, txBldr, multisigPub, stdTxs, [][]types.StdSignature{sigs1, sigs3})
+	require.NoError(t, err)
+	require.Len(t, signedTxs, len(stdTxs))
+
+	sequences := []uint64{7, 7, 8}
+	for i, signedTx := range signedTxs {
+		require.Equal(t, stdTxs[i].Memo, signedTx.Memo)
+		require.Len(t, signedTx.Signatures, 1)
+
+		signBytes := types.StdSignMsg{
+			ChainID: "test-chain", AccountNumber: 3, Sequence: sequences[i],
+			Fee: signedTx.Fee, Msgs: signedTx.Msgs, Memo: signedTx.Memo, Unordered: signedTx.Unordered,
+		}.Bytes()
+		require.True(t, multisigPub.VerifyBytes(signBytes, signedTx.Signatures[0].Signature), "tx %d", i)
+	}
+
+	// the signatures must be over the sequences of their txs
+	sigs2 := signBatch("k2", txBldr.WithSequence(8))
+	_, err = multisignStdTxBatch(cdc, txBldr, multisigPub, stdTxs, [][]types.StdSignature{sigs1, sigs2})
+	require.EqualError(t, err, "tx 0 with sequence 7: couldn't verify signature")
+
+	_, err = multisignStdTxBatch(cdc, txBldr, multisigPub, stdTxs, [][]types.StdSignature{sigs1, {sigs3[1], sigs3[0], sigs3[2]}})
+	require.EqualError(t, err, "tx 0 with sequence 7: couldn't verify signature")
+
+	// and each batch must hold a signature per tx
+	_, err = multisignStdTxBatch(cdc, txBldr, multisigPub, stdTxs, [][]types.StdSignature{sigs1, sigs3[:2]})
+	require.EqualError(t, err, "signature batch 1 holds 2 signatures, expected one per tx: 3")
+}
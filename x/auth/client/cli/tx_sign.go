@@ -2,6 +2,7 @@ package cli
 
 import (
 	"bufio"
+	"bytes"
 	"fmt"
 	"os"
 	"strings"
@@ -24,6 +25,7 @@ const (
 	flagValidateSigs = "validate-signatures"
 	flagSigOnly      = "signature-only"
 	flagOutfile      = "output-document"
+	flagBatch        = "batch"
 )
 
 // GetSignCommand returns the transaction sign command.
@@ -51,6 +53,14 @@ the transaction to fail.
 The --multisig=<multisig_key> flag generates a signature on behalf of a multisig account
 key. It implies --signature-only. Full multisig signed transactions may eventually
 be generated via the 'multisign' command.
+
+The --batch flag makes the command read a batch of newline-delimited transactions
+from [file] and sign them all in one go, printing one signed transaction per line.
+The transactions are signed with sequentially incremented sequence numbers, starting
+at the signer's current sequence (or the one given by --sequence), so that they can
+be broadcast in order. It cannot be combined with --validate-signatures. Combined with
+--multisig, it prints the signatures of the transactions on behalf of the multisig account,
+one per line, which the 'multisign-batch' command combines into multisig signatures.
 `,
 		PreRun: preSignCmd,
 		RunE:   makeSignCmd(codec),
@@ -71,6 +81,7 @@ be generated via the 'multisign' command.
 	)
 	cmd.Flags().Bool(flagSigOnly, false, "Print only the generated signature, then exit")
 	cmd.Flags().String(flagOutfile, "", "The document will be written to the given file instead of STDOUT")
+	cmd.Flags().Bool(flagBatch, false, "Sign a batch of newline-delimited transactions read from [file]")
 	cmd = flags.PostCommands(cmd)[0]
 	cmd.MarkFlagRequired(flags.FlagFrom)
 
@@ -88,6 +99,10 @@ func preSignCmd(cmd *cobra.Command, _ []string) {
 
 func makeSignCmd(cdc *codec.Codec) func(cmd *cobra.Command, args []string) error {
 	return func(cmd *cobra.Command, args []string) error {
+		if viper.GetBool(flagBatch) {
+			return signBatch(cmd, cdc, args[0])
+		}
+
 		stdTx, err := client.ReadStdTxFromFile(cdc, args[0])
 		if err != nil {
 			return err
@@ -98,7 +113,20 @@ func makeSignCmd(cdc *codec.Codec) func(cmd *cobra.Command, args []string) error
 		txBldr := types.NewTxBuilderFromCLI(inBuf)
 
 		if viper.GetBool(flagValidateSigs) {
-			if !printAndValidateSigs(cliCtx, txBldr.ChainID(), stdTx, cliCtx.Offline) {
+			validation, err := validateSigs(cliCtx, txBldr.ChainID(), stdTx, cliCtx.Offline)
+			if err != nil {
+				return err
+			}
+
+			if cliCtx.OutputFormat == "json" {
+				if err := cliCtx.PrintOutput(validation); err != nil {
+					return err
+				}
+			} else {
+				printSigsValidation(validation)
+			}
+
+			if !validation.Valid {
 				return fmt.Errorf("signatures validation failed")
 			}
 
@@ -135,23 +163,85 @@ func makeSignCmd(cdc *codec.Codec) func(cmd *cobra.Command, args []string) error
 			return err
 		}
 
-		if viper.GetString(flagOutfile) == "" {
-			fmt.Printf("%s\n", json)
-			return nil
+		return writeSignOutput(json)
+	}
+}
+
+// signBatch signs all the newline-delimited transactions of the given file
+// and prints them, one compact JSON encoded transaction per line.
+func signBatch(cmd *cobra.Command, cdc *codec.Codec, filename string) error {
+	if viper.GetBool(flagValidateSigs) {
+		return fmt.Errorf("--%s cannot be used with --%s", flagBatch, flagValidateSigs)
+	}
+
+	stdTxs, err := client.ReadStdTxsFromFile(cdc, filename)
+	if err != nil {
+		return err
+	}
+
+	inBuf := bufio.NewReader(cmd.InOrStdin())
+	cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+	txBldr := types.NewTxBuilderFromCLI(inBuf)
+
+	var signedTxs []types.StdTx
+	generateSignatureOnly := viper.GetBool(flagSigOnly)
+
+	if multisigAddrStr := viper.GetString(flagMultisig); multisigAddrStr != "" {
+		var multisigAddr sdk.AccAddress
+
+		multisigAddr, err = sdk.AccAddressFromBech32(multisigAddrStr)
+		if err != nil {
+			return err
 		}
 
-		fp, err := os.OpenFile(
-			viper.GetString(flagOutfile), os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644,
+		signedTxs, err = client.SignStdTxBatchWithSignerAddress(
+			txBldr, cliCtx, multisigAddr, cliCtx.GetFromName(), stdTxs, cliCtx.Offline,
 		)
+		generateSignatureOnly = true
+	} else {
+		appendSig := viper.GetBool(flagAppend) && !generateSignatureOnly
+		signedTxs, err = client.SignStdTxBatch(txBldr, cliCtx, cliCtx.GetFromName(), stdTxs, appendSig, cliCtx.Offline)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	var b bytes.Buffer
+	for i, signedTx := range signedTxs {
+		json, err := getSignatureJSON(cdc, signedTx, false, generateSignatureOnly)
 		if err != nil {
 			return err
 		}
 
-		defer fp.Close()
-		fmt.Fprintf(fp, "%s\n", json)
+		if i > 0 {
+			b.WriteByte('\n')
+		}
+		b.Write(json)
+	}
 
+	return writeSignOutput(b.Bytes())
+}
+
+// writeSignOutput writes the given output to the --output-document file, or
+// prints it if none is set.
+func writeSignOutput(output []byte) error {
+	if viper.GetString(flagOutfile) == "" {
+		fmt.Printf("%s\n", output)
 		return nil
 	}
+
+	fp, err := os.OpenFile(
+		viper.GetString(flagOutfile), os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644,
+	)
+	if err != nil {
+		return err
+	}
+
+	defer fp.Close()
+	fmt.Fprintf(fp, "%s\n", output)
+
+	return nil
 }
 
 func getSignatureJSON(cdc *codec.Codec, newTx types.StdTx, indent, generateSignatureOnly bool) ([]byte, error) {
@@ -175,61 +265,83 @@ func getSignatureJSON(cdc *codec.Codec, newTx types.StdTx, indent, generateSigna
 	}
 }
 
-// printAndValidateSigs will validate the signatures of a given transaction over
-// its expected signers. In addition, if offline has not been supplied, the
-// signature is verified over the transaction sign bytes.
-func printAndValidateSigs(
-	cliCtx context.CLIContext, chainID string, stdTx types.StdTx, offline bool,
-) bool {
+// sigsValidation is the validation of the signatures of a transaction over its
+// expected signers.
+type sigsValidation struct {
+	Signers    []sdk.AccAddress `json:"signers" yaml:"signers"`
+	Signatures []sigValidation  `json:"signatures" yaml:"signatures"`
+	Valid      bool             `json:"valid" yaml:"valid"`
+}
 
-	fmt.Println("Signers:")
+// sigValidation is the validation of a signature, whose error is empty if it's
+// valid.
+type sigValidation struct {
+	Address  sdk.AccAddress      `json:"address" yaml:"address"`
+	Error    string              `json:"error,omitempty" yaml:"error,omitempty"`
+	Multisig *multisigValidation `json:"multisig,omitempty" yaml:"multisig,omitempty"`
+}
 
-	signers := stdTx.GetSigners()
-	for i, signer := range signers {
-		fmt.Printf("  %v: %v\n", i, signer.String())
-	}
+// multisigValidation holds the threshold of a multisig and the members which
+// signed.
+type multisigValidation struct {
+	Threshold uint             `json:"threshold" yaml:"threshold"`
+	Members   int              `json:"members" yaml:"members"`
+	Signers   []multisigMember `json:"signers" yaml:"signers"`
+}
 
-	success := true
-	sigs := stdTx.Signatures
+// multisigMember is a member of a multisig, given its index.
+type multisigMember struct {
+	Index   int            `json:"index" yaml:"index"`
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+}
 
-	fmt.Println("")
-	fmt.Println("Signatures:")
+// validateSigs will validate the signatures of a given transaction over its
+// expected signers. In addition, if offline has not been supplied, the
+// signature is verified over the transaction sign bytes.
+func validateSigs(
+	cliCtx context.CLIContext, chainID string, stdTx types.StdTx, offline bool,
+) (sigsValidation, error) {
+	signers := stdTx.GetSigners()
+	sigs := stdTx.Signatures
 
-	if len(sigs) != len(signers) {
-		success = false
+	validation := sigsValidation{
+		Signers:    signers,
+		Signatures: make([]sigValidation, len(sigs)),
+		Valid:      len(sigs) == len(signers),
 	}
 
 	for i, sig := range sigs {
 		sigAddr := sdk.AccAddress(sig.GetPubKey().Address())
-		sigSanity := "OK"
-
-		var (
-			multiSigHeader string
-			multiSigMsg    string
-		)
+		sigValidation := sigValidation{Address: sigAddr}
 
 		if i >= len(signers) || !sigAddr.Equals(signers[i]) {
-			sigSanity = "ERROR: signature does not match its respective signer"
-			success = false
+			sigValidation.Error = "signature does not match its respective signer"
+			validation.Valid = false
 		}
 
 		// Validate the actual signature over the transaction bytes since we can
 		// reach out to a full node to query accounts.
-		if !offline && success {
+		if !offline && validation.Valid {
 			acc, err := types.NewAccountRetriever(client.Codec, cliCtx).GetAccount(sigAddr)
 			if err != nil {
-				fmt.Printf("failed to get account: %s\n", sigAddr)
-				return false
+				return sigsValidation{}, fmt.Errorf("failed to get account: %s", sigAddr)
 			}
 
-			sigBytes := types.StdSignBytes(
-				chainID, acc.GetAccountNumber(), acc.GetSequence(),
-				stdTx.Fee, stdTx.GetMsgs(), stdTx.GetMemo(),
-			)
+			sigBytes := types.StdSignMsg{
+				ChainID:          chainID,
+				AccountNumber:    acc.GetAccountNumber(),
+				Sequence:         acc.GetSequence(),
+				Fee:              stdTx.Fee,
+				Msgs:             stdTx.GetMsgs(),
+				Memo:             stdTx.GetMemo(),
+				Unordered:        stdTx.Unordered,
+				TimeoutTimestamp: stdTx.TimeoutTimestamp,
+				ExtensionOptions: stdTx.ExtensionOptions,
+			}.Bytes()
 
 			if ok := sig.GetPubKey().VerifyBytes(sigBytes, sig.Signature); !ok {
-				sigSanity = "ERROR: signature invalid"
-				success = false
+				sigValidation.Error = "signature invalid"
+				validation.Valid = false
 			}
 		}
 
@@ -238,23 +350,64 @@ func printAndValidateSigs(
 			var multiSig multisig.Multisignature
 			cliCtx.Codec.MustUnmarshalBinaryBare(sig.Signature, &multiSig)
 
-			var b strings.Builder
-			b.WriteString("\n  MultiSig Signatures:\n")
+			sigValidation.Multisig = &multisigValidation{
+				Threshold: multiPK.K,
+				Members:   len(multiPK.PubKeys),
+			}
 
 			for i := 0; i < multiSig.BitArray.Size(); i++ {
 				if multiSig.BitArray.GetIndex(i) {
-					addr := sdk.AccAddress(multiPK.PubKeys[i].Address().Bytes())
-					b.WriteString(fmt.Sprintf("    %d: %s (weight: %d)\n", i, addr, 1))
+					sigValidation.Multisig.Signers = append(sigValidation.Multisig.Signers, multisigMember{
+						Index:   i,
+						Address: sdk.AccAddress(multiPK.PubKeys[i].Address().Bytes()),
+					})
 				}
 			}
+		}
+
+		validation.Signatures[i] = sigValidation
+	}
+
+	return validation, nil
+}
+
+// printSigsValidation prints the validation of the signatures of a transaction
+// as text.
+func printSigsValidation(validation sigsValidation) {
+	fmt.Println("Signers:")
+
+	for i, signer := range validation.Signers {
+		fmt.Printf("  %v: %v\n", i, signer.String())
+	}
+
+	fmt.Println("")
+	fmt.Println("Signatures:")
+
+	for i, sig := range validation.Signatures {
+		sigSanity := "OK"
+		if sig.Error != "" {
+			sigSanity = "ERROR: " + sig.Error
+		}
+
+		var (
+			multiSigHeader string
+			multiSigMsg    string
+		)
+
+		if sig.Multisig != nil {
+			var b strings.Builder
+			b.WriteString("\n  MultiSig Signatures:\n")
+
+			for _, member := range sig.Multisig.Signers {
+				b.WriteString(fmt.Sprintf("    %d: %s (weight: %d)\n", member.Index, member.Address, 1))
+			}
 
-			multiSigHeader = fmt.Sprintf(" [multisig threshold: %d/%d]", multiPK.K, len(multiPK.PubKeys))
+			multiSigHeader = fmt.Sprintf(" [multisig threshold: %d/%d]", sig.Multisig.Threshold, sig.Multisig.Members)
 			multiSigMsg = b.String()
 		}
 
-		fmt.Printf("  %d: %s\t\t\t[%s]%s%s\n", i, sigAddr.String(), sigSanity, multiSigHeader, multiSigMsg)
+		fmt.Printf("  %d: %s\t\t\t[%s]%s%s\n", i, sig.Address.String(), sigSanity, multiSigHeader, multiSigMsg)
 	}
 
 	fmt.Println("")
-	return success
 }
@@ -9,6 +9,7 @@ import (
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -109,6 +110,16 @@ func QueryTx(cliCtx context.CLIContext, hashHexStr string) (sdk.TxResponse, erro
 	return out, nil
 }
 
+// WaitTx waits for the tx of the given hash to be committed, for at most the
+// timeout, and returns it as QueryTx does.
+func WaitTx(cliCtx context.CLIContext, hashHexStr string, timeout time.Duration) (sdk.TxResponse, error) {
+	if _, err := tx.WaitTx(cliCtx, hashHexStr, timeout); err != nil {
+		return sdk.TxResponse{}, err
+	}
+
+	return QueryTx(cliCtx, hashHexStr)
+}
+
 // formatTxResults parses the indexed txs into a slice of TxResponse objects.
 func formatTxResults(cdc *codec.Codec, resTxs []*ctypes.ResultTx, resBlocks map[int64]*ctypes.ResultBlock) ([]sdk.TxResponse, error) {
 	var err error
@@ -5,11 +5,13 @@ import (
 	"net/http"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
-// BroadcastReq defines a tx broadcasting request.
+// BroadcastReq defines a tx broadcasting request. Its mode is sync, async or
+// block, sync if empty.
 type BroadcastReq struct {
 	Tx   types.StdTx `json:"tx" yaml:"tx"`
 	Mode string      `json:"mode" yaml:"mode"`
@@ -36,6 +38,14 @@ func BroadcastTxRequest(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
+		// the mode defaults to sync, as for the CLI
+		if req.Mode == "" {
+			req.Mode = flags.BroadcastSync
+		}
+		if err := flags.ValidateBroadcastMode(req.Mode); rest.CheckBadRequestError(w, err) {
+			return
+		}
+
 		cliCtx = cliCtx.WithBroadcastMode(req.Mode)
 
 		res, err := cliCtx.BroadcastTx(txBytes)
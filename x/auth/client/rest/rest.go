@@ -4,6 +4,8 @@ import (
 	"github.com/gorilla/mux"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/rpc"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 // REST query and parameter values
@@ -13,10 +15,26 @@ const (
 
 // RegisterRoutes registers the auth module REST routes.
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, storeName string) {
+	r.HandleFunc(
+		"/auth/accounts", QueryAccountsRequestHandlerFn(cliCtx),
+	).Methods(MethodGet)
+
 	r.HandleFunc(
 		"/auth/accounts/{address}", QueryAccountRequestHandlerFn(storeName, cliCtx),
 	).Methods(MethodGet)
 
+	r.HandleFunc(
+		"/auth/accounts/{address}/vesting_schedule", QueryVestingScheduleRequestHandlerFn(cliCtx),
+	).Methods(MethodGet)
+
+	r.HandleFunc(
+		"/auth/module_accounts", QueryModuleAccountsRequestHandlerFn(cliCtx),
+	).Methods(MethodGet)
+
+	r.HandleFunc(
+		"/auth/address_by_id/{id}", QueryAccountAddressByIDRequestHandlerFn(cliCtx),
+	).Methods(MethodGet)
+
 	r.HandleFunc(
 		"/auth/params",
 		queryParamsHandler(cliCtx),
@@ -30,4 +48,5 @@ func RegisterTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/txs", BroadcastTxRequest(cliCtx)).Methods("POST")
 	r.HandleFunc("/txs/encode", EncodeTxRequestHandlerFn(cliCtx)).Methods("POST")
 	r.HandleFunc("/txs/decode", DecodeTxRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/websocket", rpc.EventsWebSocketHandlerFn(cliCtx, types.DefaultTxDecoder(cliCtx.Codec))).Methods("GET")
 }
@@ -42,14 +42,53 @@ func (gr GasEstimateResponse) String() string {
 
 // GenerateOrBroadcastMsgs creates a StdTx given a series of messages. If
 // the provided context has generate-only enabled, the tx will only be printed
-// to STDOUT in a fully offline manner. Otherwise, the tx will be signed and
-// broadcasted.
+// to STDOUT in a fully offline manner. In offline mode, the tx is signed with
+// the account and sequence numbers of the TxBuilder and printed. Otherwise, the
+// tx will be signed and broadcasted, or only simulated in dry-run mode.
 func GenerateOrBroadcastMsgs(cliCtx context.CLIContext, txBldr authtypes.TxBuilder, msgs []sdk.Msg) error {
-	if cliCtx.GenerateOnly {
+	switch {
+	case cliCtx.GenerateOnly:
 		return PrintUnsignedStdTx(txBldr, cliCtx, msgs)
+
+	case cliCtx.Offline:
+		return PrintSignedStdTxOffline(txBldr, cliCtx, msgs)
+
+	default:
+		return CompleteAndBroadcastTxCLI(txBldr, cliCtx, msgs)
+	}
+}
+
+// PrintSignedStdTxOffline builds a StdTx, signs it with the key of the context
+// and prints it to the output of the context, without any query: the account
+// and sequence numbers are the ones of the TxBuilder, and its gas can't be
+// estimated.
+func PrintSignedStdTxOffline(txBldr authtypes.TxBuilder, cliCtx context.CLIContext, msgs []sdk.Msg) error {
+	if txBldr.SimulateAndExecute() || cliCtx.Simulate {
+		return errors.New("cannot estimate gas in offline mode")
+	}
+
+	stdSignMsg, err := txBldr.BuildSignMsg(msgs)
+	if err != nil {
+		return err
 	}
 
-	return CompleteAndBroadcastTxCLI(txBldr, cliCtx, msgs)
+	stdTx, err := txBldr.SignStdTx(cliCtx.GetFromName(), keys.DefaultKeyPass, stdSignMsg.StdTx(nil), false)
+	if err != nil {
+		return err
+	}
+
+	var json []byte
+	if viper.GetBool(flags.FlagIndentResponse) {
+		json, err = cliCtx.Codec.MarshalJSONIndent(stdTx, "", "  ")
+	} else {
+		json, err = cliCtx.Codec.MarshalJSON(stdTx)
+	}
+	if err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintf(cliCtx.Output, "%s\n", json)
+	return nil
 }
 
 // CompleteAndBroadcastTxCLI implements a utility function that facilitates
@@ -72,6 +111,12 @@ func CompleteAndBroadcastTxCLI(txBldr authtypes.TxBuilder, cliCtx context.CLICon
 		}
 
 		gasEst := GasEstimateResponse{GasEstimate: txBldr.Gas()}
+
+		// the estimate of a dry run is its output, a JSON object in the JSON output mode
+		if cliCtx.Simulate && cliCtx.OutputFormat == "json" {
+			return cliCtx.PrintOutput(gasEst)
+		}
+
 		_, _ = fmt.Fprintf(os.Stderr, "%s\n", gasEst.String())
 	}
 
@@ -117,6 +162,14 @@ func CompleteAndBroadcastTxCLI(txBldr authtypes.TxBuilder, cliCtx context.CLICon
 		return err
 	}
 
+	// the block mode already waited for the tx, and a rejected tx is never committed
+	if viper.GetBool(flags.FlagWait) && cliCtx.BroadcastMode != flags.BroadcastBlock && res.Code == 0 {
+		res, err = WaitTx(cliCtx, res.TxHash, viper.GetDuration(flags.FlagWaitTimeout))
+		if err != nil {
+			return err
+		}
+	}
+
 	return cliCtx.PrintOutput(res)
 }
 
@@ -207,6 +260,72 @@ func SignStdTx(
 	return txBldr.SignStdTx(name, keys.DefaultKeyPass, stdTx, appendSig)
 }
 
+// SignStdTxBatch signs a batch of StdTxs with the key of the given name and
+// returns signed copies of them. Ordered txs are signed with sequentially
+// incremented sequence numbers, starting at the sequence of the TxBuilder, so
+// that they can all be broadcast in order. Unless offline is true, the account
+// and sequence numbers are queried once for the whole batch. All the txs are
+// signed with the TxBuilder's keybase, so that it must only be unlocked once.
+func SignStdTxBatch(
+	txBldr authtypes.TxBuilder, cliCtx context.CLIContext, name string,
+	stdTxs []authtypes.StdTx, appendSig bool, offline bool,
+) ([]authtypes.StdTx, error) {
+
+	info, err := txBldr.Keybase().Key(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return signStdTxBatch(txBldr, cliCtx, sdk.AccAddress(info.GetPubKey().Address()), name, stdTxs, appendSig, offline)
+}
+
+// SignStdTxBatchWithSignerAddress signs a batch of StdTxs as SignStdTxBatch,
+// but on behalf of a foreign account, e.g. a multisig account, whose account
+// and sequence numbers are used. The signatures are not appended, so that the
+// signed copies of the txs only hold the signature of the key.
+func SignStdTxBatchWithSignerAddress(
+	txBldr authtypes.TxBuilder, cliCtx context.CLIContext,
+	addr sdk.AccAddress, name string, stdTxs []authtypes.StdTx, offline bool,
+) ([]authtypes.StdTx, error) {
+
+	return signStdTxBatch(txBldr, cliCtx, addr, name, stdTxs, false, offline)
+}
+
+func signStdTxBatch(
+	txBldr authtypes.TxBuilder, cliCtx context.CLIContext, addr sdk.AccAddress, name string,
+	stdTxs []authtypes.StdTx, appendSig bool, offline bool,
+) ([]authtypes.StdTx, error) {
+
+	var err error
+	if !offline {
+		txBldr, err = populateAccountFromState(txBldr, cliCtx, addr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	signedStdTxs := make([]authtypes.StdTx, len(stdTxs))
+	sequence := txBldr.Sequence()
+
+	for i, stdTx := range stdTxs {
+		if !isTxSigner(addr, stdTx.GetSigners()) {
+			return nil, fmt.Errorf("%s: %s (tx %d)", sdkerrors.ErrorInvalidSigner, name, i)
+		}
+
+		signedStdTxs[i], err = txBldr.WithSequence(sequence).SignStdTx(name, keys.DefaultKeyPass, stdTx, appendSig)
+		if err != nil {
+			return nil, err
+		}
+
+		// unordered txs don't use, nor increment, the account sequence
+		if !stdTx.Unordered {
+			sequence++
+		}
+	}
+
+	return signedStdTxs, nil
+}
+
 // SignStdTxWithSignerAddress attaches a signature to a StdTx and returns a copy of a it.
 // Don't perform online validation or lookups if offline is true, else
 // populate account and sequence numbers from a foreign account.
@@ -251,6 +370,43 @@ func ReadStdTxFromFile(cdc *codec.Codec, filename string) (stdTx authtypes.StdTx
 	return
 }
 
+// ReadStdTxsFromFile reads a batch of newline-delimited JSON encoded StdTxs
+// from the given file, or from STDIN if the filename is "-". Empty lines are
+// skipped.
+func ReadStdTxsFromFile(cdc *codec.Codec, filename string) ([]authtypes.StdTx, error) {
+	var (
+		bz  []byte
+		err error
+	)
+
+	if filename == "-" {
+		bz, err = ioutil.ReadAll(os.Stdin)
+	} else {
+		bz, err = ioutil.ReadFile(filename)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	var stdTxs []authtypes.StdTx
+	for i, line := range strings.Split(string(bz), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		var stdTx authtypes.StdTx
+		if err := cdc.UnmarshalJSON([]byte(line), &stdTx); err != nil {
+			return nil, fmt.Errorf("failed to decode tx on line %d: %w", i+1, err)
+		}
+
+		stdTxs = append(stdTxs, stdTx)
+	}
+
+	return stdTxs, nil
+}
+
 func populateAccountFromState(
 	txBldr authtypes.TxBuilder, cliCtx context.CLIContext, addr sdk.AccAddress,
 ) (authtypes.TxBuilder, error) {
@@ -346,7 +502,7 @@ func buildUnsignedStdTxOffline(txBldr authtypes.TxBuilder, cliCtx context.CLICon
 		return stdTx, err
 	}
 
-	return authtypes.NewStdTx(stdSignMsg.Msgs, stdSignMsg.Fee, nil, stdSignMsg.Memo), nil
+	return stdSignMsg.StdTx(nil), nil
 }
 
 func isTxSigner(user sdk.AccAddress, signers []sdk.AccAddress) bool {
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"io/ioutil"
@@ -11,7 +12,9 @@ import (
 
 	"github.com/tendermint/tendermint/crypto/ed25519"
 
+	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -134,6 +137,36 @@ func TestReadStdTxFromFile(t *testing.T) {
 	require.Equal(t, decodedTx.Memo, "foomemo")
 }
 
+func TestReadStdTxsFromFile(t *testing.T) {
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+
+	// Build a batch of test transactions
+	fee := authtypes.NewStdFee(50000, sdk.Coins{sdk.NewInt64Coin("atom", 150)})
+	stdTx1 := authtypes.NewStdTx([]sdk.Msg{}, fee, []authtypes.StdSignature{}, "foomemo1")
+	stdTx2 := authtypes.NewStdTx([]sdk.Msg{}, fee, []authtypes.StdSignature{}, "foomemo2")
+
+	// Write them to the file, one per line, with a trailing empty line
+	encodedTx1, _ := cdc.MarshalJSON(stdTx1)
+	encodedTx2, _ := cdc.MarshalJSON(stdTx2)
+	jsonTxFile := writeToNewTempFile(t, string(encodedTx1)+"\n"+string(encodedTx2)+"\n\n")
+	defer os.Remove(jsonTxFile.Name())
+
+	// Read them back
+	decodedTxs, err := ReadStdTxsFromFile(cdc, jsonTxFile.Name())
+	require.NoError(t, err)
+	require.Len(t, decodedTxs, 2)
+	require.Equal(t, "foomemo1", decodedTxs[0].Memo)
+	require.Equal(t, "foomemo2", decodedTxs[1].Memo)
+
+	// A malformed line fails the whole batch
+	badTxFile := writeToNewTempFile(t, string(encodedTx1)+"\nnot a tx\n")
+	defer os.Remove(badTxFile.Name())
+
+	_, err = ReadStdTxsFromFile(cdc, badTxFile.Name())
+	require.Error(t, err)
+}
+
 func compareEncoders(t *testing.T, expected sdk.TxEncoder, actual sdk.TxEncoder) {
 	msgs := []sdk.Msg{sdk.NewTestMsg(addr)}
 	tx := authtypes.NewStdTx(msgs, authtypes.StdFee{}, []authtypes.StdSignature{}, "")
@@ -155,6 +188,42 @@ func writeToNewTempFile(t *testing.T, data string) *os.File {
 	return fp
 }
 
+func TestPrintSignedStdTxOffline(t *testing.T) {
+	// the test messages are pointers
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	authtypes.RegisterCodec(cdc)
+	cdc.RegisterConcrete(&sdk.TestMsg{}, "cosmos-sdk/Test", nil)
+
+	kr, infos := testutil.NewKeyring(1)
+	msgs := []sdk.Msg{authtypes.NewTestMsg(infos[0].GetAddress())}
+
+	txBldr := authtypes.NewTxBuilder(
+		GetTxEncoder(cdc), 3, 7, 200000, 1.0, false, "test-chain", "memo", nil, nil,
+	).WithKeybase(kr)
+
+	var out bytes.Buffer
+	cliCtx := context.CLIContext{}.WithCodec(cdc).WithOutput(&out).WithFromName(testutil.KeyName(0))
+	cliCtx.Offline = true
+	require.NoError(t, PrintSignedStdTxOffline(txBldr, cliCtx, msgs))
+
+	var stdTx authtypes.StdTx
+	require.NoError(t, cdc.UnmarshalJSON(out.Bytes(), &stdTx))
+	require.Len(t, stdTx.Signatures, 1)
+
+	// the tx is signed with the account and sequence numbers of the TxBuilder
+	signBytes := authtypes.StdSignBytes("test-chain", 3, 7, stdTx.Fee, msgs, "memo")
+	require.True(t, infos[0].GetPubKey().VerifyBytes(signBytes, stdTx.Signatures[0].Signature))
+
+	// and the gas can't be estimated offline
+	txBldr = authtypes.NewTxBuilder(
+		GetTxEncoder(cdc), 3, 7, 0, 1.0, true, "test-chain", "memo", nil, nil,
+	).WithKeybase(kr)
+	require.EqualError(t, PrintSignedStdTxOffline(txBldr, cliCtx, msgs), "cannot estimate gas in offline mode")
+	require.EqualError(t, PrintSignedStdTxOffline(txBldr, cliCtx.WithSimulation(true), msgs), "cannot estimate gas in offline mode")
+}
+
 func makeCodec() *codec.Codec {
 	var cdc = codec.New()
 	sdk.RegisterCodec(cdc)
@@ -0,0 +1,74 @@
+package auth
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// NewHandler returns a handler for x/auth type messages.
+func NewHandler(ak keeper.AccountKeeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case types.MsgChangePubKey:
+			return handleMsgChangePubKey(ctx, ak, msg)
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
+		}
+	}
+}
+
+// handleMsgChangePubKey rotates the public key of an account. As the account is
+// looked up by address, its account number, sequence and balances are kept. The
+// message is signed with the current key, after which transactions must be signed
+// with the new key.
+func handleMsgChangePubKey(ctx sdk.Context, ak keeper.AccountKeeper, msg types.MsgChangePubKey) (*sdk.Result, error) {
+	pubKey, err := msg.DecodePubKey()
+	if err != nil {
+		return nil, err
+	}
+
+	acc := ak.GetAccount(ctx, msg.Address)
+	if acc == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", msg.Address)
+	}
+
+	if _, ok := acc.(exported.ModuleAccountI); ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "cannot change the public key of module account %s", msg.Address)
+	}
+
+	// rotating a key is rare and permanently changes how the account is
+	// authenticated, so it is charged on top of the regular tx costs
+	ctx.GasMeter().ConsumeGas(ak.GetParams(ctx).PubKeyChangeCost, "pubkey change")
+
+	if err := acc.SetPubKey(pubKey); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+	}
+
+	ak.SetAccount(ctx, acc)
+
+	pubKeyStr, err := sdk.Bech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeChangePubKey,
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.Address.String()),
+			sdk.NewAttribute(types.AttributeKeyPubKey, pubKeyStr),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
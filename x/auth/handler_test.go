@@ -0,0 +1,77 @@
+package auth_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestInvalidMsg(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	handler := auth.NewHandler(app.AccountKeeper)
+
+	res, err := handler(ctx, sdk.NewTestMsg())
+	require.Error(t, err)
+	require.Nil(t, res)
+}
+
+func TestHandleMsgChangePubKey(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1, ChainID: "test-chain"})
+	handler := auth.NewHandler(app.AccountKeeper)
+
+	oldPriv, newPriv := secp256k1.GenPrivKey(), secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(oldPriv.PubKey().Address())
+
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	require.NoError(t, acc.SetPubKey(oldPriv.PubKey()))
+	app.AccountKeeper.SetAccount(ctx, acc)
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, addr, types.NewTestCoins()))
+
+	// unknown and module accounts cannot rotate their key
+	_, err := handler(ctx, types.NewMsgChangePubKey(sdk.AccAddress("unknown"), newPriv.PubKey()))
+	require.Error(t, err)
+
+	// GetModuleAccount creates the module account if it doesn't exist yet
+	moduleAddr := app.AccountKeeper.GetModuleAccount(ctx, auth.FeeCollectorName).GetAddress()
+	_, err = handler(ctx, types.NewMsgChangePubKey(moduleAddr, newPriv.PubKey()))
+	require.Error(t, err)
+
+	gasBefore := ctx.GasMeter().GasConsumed()
+	res, err := handler(ctx, types.NewMsgChangePubKey(addr, newPriv.PubKey()))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	require.GreaterOrEqual(t, ctx.GasMeter().GasConsumed()-gasBefore, types.DefaultPubKeyChangeCost)
+	require.Equal(t, types.EventTypeChangePubKey, res.Events[0].Type)
+
+	rotated := app.AccountKeeper.GetAccount(ctx, addr)
+	require.Equal(t, newPriv.PubKey(), rotated.GetPubKey())
+	require.Equal(t, acc.GetAccountNumber(), rotated.GetAccountNumber())
+	require.Equal(t, types.NewTestCoins(), app.BankKeeper.GetAllBalances(ctx, addr))
+
+	// transactions must now be signed with the new key
+	anteHandler := ante.NewAnteHandler(
+		app.AccountKeeper, app.BankKeeper, nil, *app.IBCKeeper, types.NewExtensionOptionsRegistry(),
+		ante.DefaultSigVerificationGasConsumer,
+	)
+	msgs := []sdk.Msg{types.NewTestMsg(addr)}
+	accNums, seqs := []uint64{rotated.GetAccountNumber()}, []uint64{rotated.GetSequence()}
+
+	tx := types.NewTestTx(ctx, msgs, []crypto.PrivKey{oldPriv}, accNums, seqs, types.NewTestStdFee())
+	_, err = anteHandler(ctx, tx, false)
+	require.Error(t, err)
+
+	tx = types.NewTestTx(ctx, msgs, []crypto.PrivKey{newPriv}, accNums, seqs, types.NewTestStdFee())
+	_, err = anteHandler(ctx, tx, false)
+	require.NoError(t, err)
+}
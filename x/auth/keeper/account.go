@@ -58,6 +58,12 @@ func (ak AccountKeeper) SetAccount(ctx sdk.Context, acc exported.Account) {
 	}
 
 	store.Set(types.AddressStoreKey(addr), bz)
+
+	// index the address by the account number, once per account
+	numberKey := types.AccountNumberStoreKey(acc.GetAccountNumber())
+	if !store.Has(numberKey) {
+		store.Set(numberKey, addr.Bytes())
+	}
 }
 
 // RemoveAccount removes an account for the account mapper store.
@@ -66,6 +72,19 @@ func (ak AccountKeeper) RemoveAccount(ctx sdk.Context, acc exported.Account) {
 	addr := acc.GetAddress()
 	store := ctx.KVStore(ak.key)
 	store.Delete(types.AddressStoreKey(addr))
+	store.Delete(types.AccountNumberStoreKey(acc.GetAccountNumber()))
+}
+
+// GetAccountAddressByID returns the address of the account with the given
+// account number, or nil if there is none.
+func (ak AccountKeeper) GetAccountAddressByID(ctx sdk.Context, accNumber uint64) sdk.AccAddress {
+	store := ctx.KVStore(ak.key)
+	bz := store.Get(types.AccountNumberStoreKey(accNumber))
+	if bz == nil {
+		return nil
+	}
+
+	return sdk.AccAddress(bz)
 }
 
 // IterateAccounts iterates over all the stored accounts and performs a callback function
@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+var _ types.QueryServer = AccountKeeper{}
+
+// defaultQueryLimit is the number of accounts of a page whose limit is not set.
+const defaultQueryLimit = 100
+
+// Account returns the account of an address, encoded with the account codec.
+func (ak AccountKeeper) Account(c context.Context, req *types.QueryAccountRequest) (*types.QueryAccountResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+	if req.Address.Empty() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "empty address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	bz := ctx.KVStore(ak.key).Get(types.AddressStoreKey(req.Address))
+	if bz == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", req.Address)
+	}
+
+	return &types.QueryAccountResponse{Account: bz}, nil
+}
+
+// Accounts returns the paginated accounts, encoded with the account codec, along
+// with the total number of accounts.
+func (ak AccountKeeper) Accounts(c context.Context, req *types.QueryAccountsRequest) (*types.QueryAccountsResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	page, limit := req.Page, req.Limit
+	if page == 0 {
+		page = 1
+	}
+	if limit == 0 {
+		limit = defaultQueryLimit
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(ak.key)
+
+	iterator := sdk.KVStorePrefixIteratorPaginated(store, types.AddressStoreKeyPrefix, uint(page), uint(limit))
+	defer iterator.Close()
+
+	var accounts [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		accounts = append(accounts, iterator.Value())
+	}
+
+	var total uint64
+	countIterator := sdk.KVStorePrefixIterator(store, types.AddressStoreKeyPrefix)
+	defer countIterator.Close()
+	for ; countIterator.Valid(); countIterator.Next() {
+		total++
+	}
+
+	return &types.QueryAccountsResponse{Accounts: accounts, Total: total}, nil
+}
+
+// ModuleAccounts returns the module accounts, encoded with the account codec.
+func (ak AccountKeeper) ModuleAccounts(c context.Context, req *types.QueryModuleAccountsRequest) (*types.QueryModuleAccountsResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var (
+		accounts [][]byte
+		err      error
+	)
+	ak.IterateAccounts(ctx, func(acc exported.Account) (stop bool) {
+		if _, ok := acc.(exported.ModuleAccountI); !ok {
+			return false
+		}
+
+		var bz []byte
+		if bz, err = ak.cdc.MarshalAccount(acc); err != nil {
+			return true
+		}
+
+		accounts = append(accounts, bz)
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryModuleAccountsResponse{Accounts: accounts}, nil
+}
+
+// AccountAddressByID returns the address of the account of an account number.
+func (ak AccountKeeper) AccountAddressByID(c context.Context, req *types.QueryAccountAddressByIDRequest) (*types.QueryAccountAddressByIDResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	address := ak.GetAccountAddressByID(ctx, req.ID)
+	if address.Empty() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "account with number %d does not exist", req.ID)
+	}
+
+	return &types.QueryAccountAddressByIDResponse{Address: address}, nil
+}
@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestGRPCQueries(t *testing.T) {
+	app, ctx := createTestApp(true)
+	c := sdk.WrapSDKContext(ctx)
+	cdc := std.NewAppCodec(app.Codec())
+
+	_, err := app.AccountKeeper.Account(c, nil)
+	require.Error(t, err)
+	_, err = app.AccountKeeper.Account(c, &types.QueryAccountRequest{})
+	require.Error(t, err)
+
+	addr1 := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	_, err = app.AccountKeeper.Account(c, &types.QueryAccountRequest{Address: addr1})
+	require.Error(t, err)
+
+	acc1 := app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	app.AccountKeeper.SetAccount(ctx, acc1)
+	acc2 := app.AccountKeeper.NewAccountWithAddress(ctx, addr2)
+	app.AccountKeeper.SetAccount(ctx, acc2)
+	macc := app.AccountKeeper.GetModuleAccount(ctx, types.FeeCollectorName)
+
+	account, err := app.AccountKeeper.Account(c, &types.QueryAccountRequest{Address: addr1})
+	require.NoError(t, err)
+	res, err := cdc.UnmarshalAccount(account.Account)
+	require.NoError(t, err)
+	require.Equal(t, acc1, res)
+
+	// the accounts are paginated by their addresses, along with their total
+	total := uint64(len(app.AccountKeeper.GetAllAccounts(ctx)))
+
+	accounts, err := app.AccountKeeper.Accounts(c, &types.QueryAccountsRequest{})
+	require.NoError(t, err)
+	require.Len(t, accounts.Accounts, int(total))
+	require.Equal(t, total, accounts.Total)
+
+	accounts, err = app.AccountKeeper.Accounts(c, &types.QueryAccountsRequest{Page: 2, Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, accounts.Accounts, 1)
+	require.Equal(t, total, accounts.Total)
+
+	accounts, err = app.AccountKeeper.Accounts(c, &types.QueryAccountsRequest{Page: total + 1, Limit: 1})
+	require.NoError(t, err)
+	require.Empty(t, accounts.Accounts)
+	require.Equal(t, total, accounts.Total)
+
+	moduleAccounts, err := app.AccountKeeper.ModuleAccounts(c, &types.QueryModuleAccountsRequest{})
+	require.NoError(t, err)
+
+	var found bool
+	for _, bz := range moduleAccounts.Accounts {
+		acc, err := cdc.UnmarshalAccount(bz)
+		require.NoError(t, err)
+		require.Implements(t, (*exported.ModuleAccountI)(nil), acc)
+		require.NotEqual(t, addr1, acc.GetAddress())
+		require.NotEqual(t, addr2, acc.GetAddress())
+		found = found || acc.GetAddress().Equals(macc.GetAddress())
+	}
+	require.True(t, found)
+
+	address, err := app.AccountKeeper.AccountAddressByID(c, &types.QueryAccountAddressByIDRequest{ID: acc2.GetAccountNumber()})
+	require.NoError(t, err)
+	require.Equal(t, addr2, address.Address)
+
+	_, err = app.AccountKeeper.AccountAddressByID(c, &types.QueryAccountAddressByIDRequest{ID: 1000})
+	require.Error(t, err)
+}
+
+func TestGRPCQueryRoutes(t *testing.T) {
+	app, ctx := createTestApp(true)
+
+	addr := sdk.AccAddress([]byte("addr"))
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	app.AccountKeeper.SetAccount(ctx, acc)
+
+	// the service is registered with the app's router under the method names
+	handler := app.GRPCQueryRouter().Route(types.QueryAccountMethod)
+	require.NotNil(t, handler)
+
+	reqBz, err := (&types.QueryAccountRequest{Address: addr}).Marshal()
+	require.NoError(t, err)
+
+	res, err := handler(ctx, abci.RequestQuery{Path: types.QueryAccountMethod, Data: reqBz})
+	require.NoError(t, err)
+
+	var account types.QueryAccountResponse
+	require.NoError(t, account.Unmarshal(res.Value))
+	decoded, err := std.NewAppCodec(app.Codec()).UnmarshalAccount(account.Account)
+	require.NoError(t, err)
+	require.Equal(t, acc, decoded)
+
+	require.NotNil(t, app.GRPCQueryRouter().Route(types.QueryAccountsMethod))
+	require.NotNil(t, app.GRPCQueryRouter().Route(types.QueryModuleAccountsMethod))
+	require.NotNil(t, app.GRPCQueryRouter().Route(types.QueryAccountAddressByIDMethod))
+}
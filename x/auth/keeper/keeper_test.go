@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/std"
 
@@ -125,3 +126,27 @@ func TestSupply_ValidatePermissions(t *testing.T) {
 	err = app.AccountKeeper.ValidatePermissions(otherAcc)
 	require.Error(t, err)
 }
+
+func TestUnorderedTxs(t *testing.T) {
+	app, ctx := createTestApp(true)
+	txHash1 := []byte("tx hash 1")
+	txHash2 := []byte("tx hash 2")
+
+	require.False(t, app.AccountKeeper.ContainsUnorderedTx(ctx, txHash1))
+
+	app.AccountKeeper.AddUnorderedTx(ctx, txHash1, 100)
+	app.AccountKeeper.AddUnorderedTx(ctx, txHash2, 200)
+	require.True(t, app.AccountKeeper.ContainsUnorderedTx(ctx, txHash1))
+	require.True(t, app.AccountKeeper.ContainsUnorderedTx(ctx, txHash2))
+
+	// txs are kept until they time out
+	app.AccountKeeper.RemoveExpiredUnorderedTxs(ctx.WithBlockTime(time.Unix(99, 0)))
+	require.True(t, app.AccountKeeper.ContainsUnorderedTx(ctx, txHash1))
+
+	app.AccountKeeper.RemoveExpiredUnorderedTxs(ctx.WithBlockTime(time.Unix(100, 0)))
+	require.False(t, app.AccountKeeper.ContainsUnorderedTx(ctx, txHash1))
+	require.True(t, app.AccountKeeper.ContainsUnorderedTx(ctx, txHash2))
+
+	app.AccountKeeper.RemoveExpiredUnorderedTxs(ctx.WithBlockTime(time.Unix(300, 0)))
+	require.False(t, app.AccountKeeper.ContainsUnorderedTx(ctx, txHash2))
+}
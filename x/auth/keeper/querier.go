@@ -6,7 +6,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/exported"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 )
 
 // NewQuerier creates a querier for auth REST endpoints
@@ -16,9 +19,21 @@ func NewQuerier(k AccountKeeper) sdk.Querier {
 		case types.QueryAccount:
 			return queryAccount(ctx, req, k)
 
+		case types.QueryAccounts:
+			return queryAccounts(ctx, req, k)
+
+		case types.QueryModuleAccounts:
+			return queryModuleAccounts(ctx, k)
+
+		case types.QueryAccountAddressByID:
+			return queryAccountAddressByID(ctx, req, k)
+
 		case types.QueryParams:
 			return queryParams(ctx, k)
 
+		case types.QueryVestingSchedule:
+			return queryVestingSchedule(ctx, req, k)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query path: %s", path[0])
 		}
@@ -44,6 +59,97 @@ func queryAccount(ctx sdk.Context, req abci.RequestQuery, k AccountKeeper) ([]by
 	return bz, nil
 }
 
+func queryAccounts(ctx sdk.Context, req abci.RequestQuery, k AccountKeeper) ([]byte, error) {
+	var params types.QueryAccountsParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	page, limit := params.Page, params.Limit
+	if limit == 0 {
+		limit = 100
+	}
+
+	accounts := []exported.Account{}
+	if page > 0 && limit > 0 {
+		iterator := sdk.KVStorePrefixIteratorPaginated(
+			ctx.KVStore(k.key), types.AddressStoreKeyPrefix, uint(page), uint(limit),
+		)
+		defer iterator.Close()
+
+		for ; iterator.Valid(); iterator.Next() {
+			accounts = append(accounts, k.decodeAccount(iterator.Value()))
+		}
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, accounts)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryModuleAccounts(ctx sdk.Context, k AccountKeeper) ([]byte, error) {
+	accounts := []exported.ModuleAccountI{}
+	k.IterateAccounts(ctx, func(acc exported.Account) (stop bool) {
+		if macc, ok := acc.(exported.ModuleAccountI); ok {
+			accounts = append(accounts, macc)
+		}
+		return false
+	})
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, accounts)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryAccountAddressByID(ctx sdk.Context, req abci.RequestQuery, k AccountKeeper) ([]byte, error) {
+	var params types.QueryAccountAddressByIDParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	address := k.GetAccountAddressByID(ctx, params.ID)
+	if address.Empty() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "account with number %d does not exist", params.ID)
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, address)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryVestingSchedule(ctx sdk.Context, req abci.RequestQuery, k AccountKeeper) ([]byte, error) {
+	var params types.QueryAccountParams
+	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	account := k.GetAccount(ctx, params.Address)
+	if account == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", params.Address)
+	}
+
+	vacc, ok := account.(vestexported.VestingAccount)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s is not a vesting account", params.Address)
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, vestingtypes.NewVestingSchedule(vacc, ctx.BlockTime()))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
 func queryParams(ctx sdk.Context, k AccountKeeper) ([]byte, error) {
 	params := k.GetParams(ctx)
 
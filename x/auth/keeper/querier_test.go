@@ -3,14 +3,17 @@ package keeper_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/exported"
 	keep "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 )
 
 func TestQueryAccount(t *testing.T) {
@@ -61,3 +64,155 @@ func TestQueryAccount(t *testing.T) {
 	err2 := cdc.UnmarshalJSON(res, &account)
 	require.Nil(t, err2)
 }
+
+func TestQueryVestingSchedule(t *testing.T) {
+	app, ctx := createTestApp(true)
+	cdc := app.Codec()
+
+	now := time.Now()
+	ctx = ctx.WithBlockTime(now)
+
+	path := []string{types.QueryVestingSchedule}
+	querier := keep.NewQuerier(app.AccountKeeper)
+
+	_, _, addr := types.KeyTestPubAddr()
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryVestingSchedule),
+		Data: cdc.MustMarshalJSON(types.NewQueryAccountParams(addr)),
+	}
+
+	// account does not exist
+	res, err := querier(ctx, path, req)
+	require.Error(t, err)
+	require.Nil(t, res)
+
+	// account is not a vesting account
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr))
+	res, err = querier(ctx, path, req)
+	require.Error(t, err)
+	require.Nil(t, res)
+
+	periods := vestingtypes.Periods{
+		vestingtypes.NewPeriod(60, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))),
+		vestingtypes.NewPeriod(60, sdk.NewCoins(sdk.NewInt64Coin("stake", 20))),
+	}
+	bacc := types.NewBaseAccountWithAddress(addr)
+	pva := vestingtypes.NewPeriodicVestingAccount(bacc, periods.TotalAmount(), now.Add(-90*time.Second).Unix(), periods)
+	app.AccountKeeper.SetAccount(ctx, pva)
+
+	res, err = querier(ctx, path, req)
+	require.NoError(t, err)
+
+	var schedule vestingtypes.VestingSchedule
+	require.NoError(t, cdc.UnmarshalJSON(res, &schedule))
+	require.Equal(t, addr, schedule.Address)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), schedule.VestedCoins)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 20)), schedule.VestingCoins)
+	require.Equal(t, periods[1:], schedule.RemainingPeriods)
+}
+
+func TestQueryAccounts(t *testing.T) {
+	app, ctx := createTestApp(true)
+	cdc := app.Codec()
+
+	path := []string{types.QueryAccounts}
+	querier := keep.NewQuerier(app.AccountKeeper)
+
+	numAccs := len(app.AccountKeeper.GetAllAccounts(ctx))
+	for i := 0; i < 3; i++ {
+		_, _, addr := types.KeyTestPubAddr()
+		app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr))
+	}
+	numAccs += 3
+
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAccounts),
+		Data: cdc.MustMarshalJSON(types.NewQueryAccountsParams(1, 0)),
+	}
+	res, err := querier(ctx, path, req)
+	require.NoError(t, err)
+
+	var accounts []exported.Account
+	require.NoError(t, cdc.UnmarshalJSON(res, &accounts))
+	require.Len(t, accounts, numAccs)
+
+	// paginated
+	req.Data = cdc.MustMarshalJSON(types.NewQueryAccountsParams(2, 2))
+	res, err = querier(ctx, path, req)
+	require.NoError(t, err)
+	require.NoError(t, cdc.UnmarshalJSON(res, &accounts))
+	require.Len(t, accounts, numAccs-2)
+	require.Equal(t, app.AccountKeeper.GetAllAccounts(ctx)[2:], accounts)
+
+	// out of range
+	req.Data = cdc.MustMarshalJSON(types.NewQueryAccountsParams(numAccs+1, 1))
+	res, err = querier(ctx, path, req)
+	require.NoError(t, err)
+	require.NoError(t, cdc.UnmarshalJSON(res, &accounts))
+	require.Empty(t, accounts)
+}
+
+func TestQueryModuleAccounts(t *testing.T) {
+	app, ctx := createTestApp(true)
+	cdc := app.Codec()
+
+	path := []string{types.QueryModuleAccounts}
+	querier := keep.NewQuerier(app.AccountKeeper)
+
+	_, _, addr := types.KeyTestPubAddr()
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr))
+	macc := app.AccountKeeper.GetModuleAccount(ctx, types.FeeCollectorName)
+
+	res, err := querier(ctx, path, abci.RequestQuery{})
+	require.NoError(t, err)
+
+	var accounts []exported.ModuleAccountI
+	require.NoError(t, cdc.UnmarshalJSON(res, &accounts))
+	require.NotEmpty(t, accounts)
+
+	var found bool
+	for _, acc := range accounts {
+		require.NotEqual(t, addr, acc.GetAddress())
+		if acc.GetName() == types.FeeCollectorName {
+			require.Equal(t, macc.GetAddress(), acc.GetAddress())
+			found = true
+		}
+	}
+	require.True(t, found)
+}
+
+func TestQueryAccountAddressByID(t *testing.T) {
+	app, ctx := createTestApp(true)
+	cdc := app.Codec()
+
+	path := []string{types.QueryAccountAddressByID}
+	querier := keep.NewQuerier(app.AccountKeeper)
+
+	_, _, addr := types.KeyTestPubAddr()
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	app.AccountKeeper.SetAccount(ctx, acc)
+
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAccountAddressByID),
+		Data: cdc.MustMarshalJSON(types.NewQueryAccountAddressByIDParams(acc.GetAccountNumber())),
+	}
+	res, err := querier(ctx, path, req)
+	require.NoError(t, err)
+
+	var resAddr sdk.AccAddress
+	require.NoError(t, cdc.UnmarshalJSON(res, &resAddr))
+	require.Equal(t, addr, resAddr)
+
+	// unknown account number
+	req.Data = cdc.MustMarshalJSON(types.NewQueryAccountAddressByIDParams(acc.GetAccountNumber() + 1))
+	res, err = querier(ctx, path, req)
+	require.Error(t, err)
+	require.Nil(t, res)
+
+	// removed account
+	app.AccountKeeper.RemoveAccount(ctx, acc)
+	req.Data = cdc.MustMarshalJSON(types.NewQueryAccountAddressByIDParams(acc.GetAccountNumber()))
+	res, err = querier(ctx, path, req)
+	require.Error(t, err)
+	require.Nil(t, res)
+}
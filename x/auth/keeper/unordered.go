@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// ContainsUnorderedTx returns true if an unordered tx with the given hash was
+// included and has not yet timed out.
+func (ak AccountKeeper) ContainsUnorderedTx(ctx sdk.Context, txHash []byte) bool {
+	store := ctx.KVStore(ak.key)
+	return store.Has(types.UnorderedTxKey(txHash))
+}
+
+// AddUnorderedTx records an included unordered tx until its timeout, given in
+// unix seconds.
+func (ak AccountKeeper) AddUnorderedTx(ctx sdk.Context, txHash []byte, timeout uint64) {
+	store := ctx.KVStore(ak.key)
+	store.Set(types.UnorderedTxKey(txHash), sdk.Uint64ToBigEndian(timeout))
+	store.Set(types.UnorderedTxQueueKey(timeout, txHash), []byte{})
+}
+
+// RemoveExpiredUnorderedTxs removes all the unordered txs that timed out as of
+// the current block time. Once a tx has timed out it can no longer be included,
+// so it no longer needs to be tracked.
+func (ak AccountKeeper) RemoveExpiredUnorderedTxs(ctx sdk.Context) {
+	store := ctx.KVStore(ak.key)
+	blockTime := uint64(ctx.BlockTime().Unix())
+
+	iterator := store.Iterator(
+		types.UnorderedTxQueueKeyPrefix,
+		sdk.PrefixEndBytes(types.UnorderedTxQueueTimeKey(blockTime)),
+	)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	prefixLen := len(types.UnorderedTxQueueTimeKey(0))
+	for _, key := range keys {
+		store.Delete(key)
+		store.Delete(types.UnorderedTxKey(key[prefixLen:]))
+	}
+}
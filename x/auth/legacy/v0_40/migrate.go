@@ -0,0 +1,72 @@
+package v040
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/x/auth/exported"
+	v038auth "github.com/cosmos/cosmos-sdk/x/auth/legacy/v0_38"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// Migrate accepts exported x/auth genesis state from v0.39 and migrates it to
+// v0.40 x/auth genesis state. The migration includes:
+//
+// - Setting the params which did not exist before to their defaults.
+// - Migrating the accounts to their v0.40 types, which encode the public key
+// as bytes and nest the base account into the vesting and module accounts.
+func Migrate(authGenState v038auth.GenesisState) types.GenesisState {
+	defaultParams := types.DefaultParams()
+	params := types.NewParams(
+		authGenState.Params.MaxMemoCharacters,
+		authGenState.Params.TxSigLimit,
+		authGenState.Params.TxSizeCostPerByte,
+		authGenState.Params.SigVerifyCostED25519,
+		authGenState.Params.SigVerifyCostSecp256k1,
+		defaultParams.SigVerifyCostMultisigSubSig,
+		defaultParams.PubKeyChangeCost,
+		defaultParams.SigVerifyCostSecp256r1,
+	)
+
+	accounts := make(exported.GenesisAccounts, len(authGenState.Accounts))
+	for i, account := range authGenState.Accounts {
+		accounts[i] = migrateAccount(account)
+	}
+
+	return types.NewGenesisState(params, types.SanitizeGenesisAccounts(accounts))
+}
+
+func migrateAccount(account v038auth.GenesisAccount) exported.GenesisAccount {
+	switch acc := account.(type) {
+	case *v038auth.BaseAccount:
+		return migrateBaseAccount(acc)
+
+	case *v038auth.ModuleAccount:
+		return types.NewModuleAccount(migrateBaseAccount(acc.BaseAccount), acc.Name, acc.Permissions...)
+
+	case *v038auth.ContinuousVestingAccount:
+		return vestingtypes.NewContinuousVestingAccountRaw(
+			migrateBaseVestingAccount(acc.BaseVestingAccount), acc.StartTime,
+		)
+
+	case *v038auth.DelayedVestingAccount:
+		return vestingtypes.NewDelayedVestingAccountRaw(migrateBaseVestingAccount(acc.BaseVestingAccount))
+
+	default:
+		panic(fmt.Sprintf("failed to migrate account of type %T", account))
+	}
+}
+
+func migrateBaseAccount(acc *v038auth.BaseAccount) *types.BaseAccount {
+	return types.NewBaseAccount(acc.Address, acc.PubKey, acc.AccountNumber, acc.Sequence)
+}
+
+func migrateBaseVestingAccount(bva *v038auth.BaseVestingAccount) *vestingtypes.BaseVestingAccount {
+	return &vestingtypes.BaseVestingAccount{
+		BaseAccount:      migrateBaseAccount(bva.BaseAccount),
+		OriginalVesting:  bva.OriginalVesting,
+		DelegatedFree:    bva.DelegatedFree,
+		DelegatedVesting: bva.DelegatedVesting,
+		EndTime:          bva.EndTime,
+	}
+}
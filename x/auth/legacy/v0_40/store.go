@@ -0,0 +1,39 @@
+package v040
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateStore performs an in-place store migration of the x/auth state of a
+// chain upgrading from v0.39. The migration includes:
+//
+// - Indexing the address of each account by its account number.
+// - Setting the SigVerifyCostMultisigSubSig, PubKeyChangeCost and
+// SigVerifyCostSecp256r1 parameters to their defaults.
+//
+// It is meant to be called from an x/upgrade handler. The storeKey must be the
+// auth module's store key, cdc the codec its accounts are encoded with and
+// paramSpace the auth module's subspace with its key table set.
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc types.Codec, paramSpace paramtypes.Subspace) error {
+	paramSpace.Set(ctx, types.KeySigVerifyCostMultisigSubSig, types.DefaultSigVerifyCostMultisigSubSig)
+	paramSpace.Set(ctx, types.KeyPubKeyChangeCost, types.DefaultPubKeyChangeCost)
+	paramSpace.Set(ctx, types.KeySigVerifyCostSecp256r1, types.DefaultSigVerifyCostSecp256r1)
+
+	store := ctx.KVStore(storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.AddressStoreKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		acc, err := cdc.UnmarshalAccount(iterator.Value())
+		if err != nil {
+			return err
+		}
+
+		store.Set(types.AccountNumberStoreKey(acc.GetAccountNumber()), acc.GetAddress().Bytes())
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package v040_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/std"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	v040auth "github.com/cosmos/cosmos-sdk/x/auth/legacy/v0_40"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	storeKey := app.GetKey(types.StoreKey)
+
+	_, _, addr := types.KeyTestPubAddr()
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	app.AccountKeeper.SetAccount(ctx, acc)
+
+	// remove the account number index, which did not exist in v0.39
+	store := ctx.KVStore(storeKey)
+	store.Delete(types.AccountNumberStoreKey(acc.GetAccountNumber()))
+	require.Nil(t, app.AccountKeeper.GetAccountAddressByID(ctx, acc.GetAccountNumber()))
+
+	// remove the params which did not exist in v0.39
+	params := app.AccountKeeper.GetParams(ctx)
+	paramStore := prefix.NewStore(ctx.KVStore(app.GetKey(paramtypes.StoreKey)), []byte(types.ModuleName+"/"))
+	paramStore.Delete(types.KeySigVerifyCostMultisigSubSig)
+	paramStore.Delete(types.KeyPubKeyChangeCost)
+	paramStore.Delete(types.KeySigVerifyCostSecp256r1)
+	require.Panics(t, func() { app.AccountKeeper.GetParams(ctx) })
+
+	paramSpace := app.GetSubspace(types.ModuleName)
+	require.NoError(t, v040auth.MigrateStore(ctx, storeKey, std.NewAppCodec(app.Codec()), paramSpace))
+	require.Equal(t, addr, app.AccountKeeper.GetAccountAddressByID(ctx, acc.GetAccountNumber()))
+	require.Equal(t, params, app.AccountKeeper.GetParams(ctx))
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/spf13/cobra"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -16,14 +17,17 @@ import (
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	"github.com/cosmos/cosmos-sdk/x/auth/simulation"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 var (
-	_ module.AppModule           = AppModule{}
-	_ module.AppModuleBasic      = AppModuleBasic{}
-	_ module.AppModuleSimulation = AppModule{}
+	_ module.AppModule             = AppModule{}
+	_ module.AppModuleBasic        = AppModuleBasic{}
+	_ module.AppModuleSimulation   = AppModule{}
+	_ module.AppModuleMigrations   = AppModule{}
+	_ module.AppModuleQueryService = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the auth module.
@@ -62,6 +66,12 @@ func (AppModuleBasic) RegisterRESTRoutes(ctx context.CLIContext, rtr *mux.Router
 	rest.RegisterRoutes(ctx, rtr, types.StoreKey)
 }
 
+// QueryServiceDesc returns the description of the gRPC query service of the
+// auth module, served over REST by the gRPC gateway.
+func (AppModuleBasic) QueryServiceDesc() *grpc.ServiceDesc {
+	return types.QueryServiceDesc()
+}
+
 // GetTxCmd returns the root tx command for the auth module.
 func (AppModuleBasic) GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	return cli.GetTxCmd(cdc)
@@ -97,11 +107,23 @@ func (AppModule) Name() string {
 // RegisterInvariants performs a no-op.
 func (AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
 
+// ConsensusVersion returns the consensus version of the auth module.
+func (AppModule) ConsensusVersion() uint64 { return 2 }
+
+// RegisterMigrations registers the auth module in-place store migrations.
+func (am AppModule) RegisterMigrations(configurator module.Configurator) {
+	if err := configurator.RegisterMigration(types.ModuleName, 1, keeper.NewMigrator(am.accountKeeper).Migrate1to2); err != nil {
+		panic(err)
+	}
+}
+
 // Route returns the message routing key for the auth module.
-func (AppModule) Route() string { return "" }
+func (AppModule) Route() string { return types.RouterKey }
 
 // NewHandler returns an sdk.Handler for the auth module.
-func (AppModule) NewHandler() sdk.Handler { return nil }
+func (am AppModule) NewHandler() sdk.Handler {
+	return NewHandler(am.accountKeeper)
+}
 
 // QuerierRoute returns the auth module's querier route name.
 func (AppModule) QuerierRoute() string {
@@ -113,6 +135,11 @@ func (am AppModule) NewQuerierHandler() sdk.Querier {
 	return NewQuerier(am.accountKeeper)
 }
 
+// RegisterQueryService registers the gRPC query service of the auth module.
+func (am AppModule) RegisterQueryService(server module.GRPCServer) {
+	types.RegisterQueryService(server, am.accountKeeper)
+}
+
 // InitGenesis performs genesis initialization for the auth module. It returns
 // no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONMarshaler, data json.RawMessage) []abci.ValidatorUpdate {
@@ -129,8 +156,11 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONMarshaler) json
 	return cdc.MustMarshalJSON(gs)
 }
 
-// BeginBlock returns the begin blocker for the auth module.
-func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
+// BeginBlock returns the begin blocker for the auth module. It removes the
+// unordered txs that have timed out.
+func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
+	am.accountKeeper.RemoveExpiredUnorderedTxs(ctx)
+}
 
 // EndBlock returns the end blocker for the auth module. It returns no validator
 // updates.
@@ -7,6 +7,7 @@ import (
 	gogotypes "github.com/gogo/protobuf/types"
 	tmkv "github.com/tendermint/tendermint/libs/kv"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -28,6 +29,18 @@ func NewDecodeStore(cdc types.Codec) func(kvA, kvB tmkv.Pair) string {
 
 			return fmt.Sprintf("%v\n%v", accA, accB)
 
+		case bytes.Equal(kvA.Key[:1], types.UnorderedTxKeyPrefix):
+			timeoutA := sdk.BigEndianToUint64(kvA.Value)
+			timeoutB := sdk.BigEndianToUint64(kvB.Value)
+
+			return fmt.Sprintf("UnorderedTxTimeoutA: %d\nUnorderedTxTimeoutB: %d", timeoutA, timeoutB)
+
+		case bytes.Equal(kvA.Key[:1], types.UnorderedTxQueueKeyPrefix):
+			return fmt.Sprintf("%X\n%X", kvA.Key, kvB.Key)
+
+		case bytes.Equal(kvA.Key[:1], types.AccountNumberStoreKeyPrefix):
+			return fmt.Sprintf("%v\n%v", sdk.AccAddress(kvA.Value), sdk.AccAddress(kvB.Value))
+
 		case bytes.Equal(kvA.Key, types.GlobalAccountNumberKey):
 			var globalAccNumberA, globalAccNumberB gogotypes.UInt64Value
 			cdc.MustUnmarshalBinaryBare(kvA.Value, &globalAccNumberA)
@@ -22,6 +22,10 @@ const (
 	TxSizeCostPerByte      = "tx_size_cost_per_byte"
 	SigVerifyCostED25519   = "sig_verify_cost_ed25519"
 	SigVerifyCostSECP256K1 = "sig_verify_cost_secp256k1"
+
+	SigVerifyCostMultisigSubSig = "sig_verify_cost_multisig_sub_sig"
+	PubKeyChangeCost            = "pub_key_change_cost"
+	SigVerifyCostSECP256R1      = "sig_verify_cost_secp256r1"
 )
 
 // GenMaxMemoChars randomized MaxMemoChars
@@ -52,6 +56,21 @@ func GenSigVerifyCostSECP256K1(r *rand.Rand) uint64 {
 	return uint64(simulation.RandIntBetween(r, 500, 1000))
 }
 
+// GenSigVerifyCostMultisigSubSig randomized SigVerifyCostMultisigSubSig
+func GenSigVerifyCostMultisigSubSig(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 0, 100))
+}
+
+// GenPubKeyChangeCost randomized PubKeyChangeCost
+func GenPubKeyChangeCost(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 1000, 10000))
+}
+
+// GenSigVerifyCostSECP256R1 randomized SigVerifyCostSECP256R1
+func GenSigVerifyCostSECP256R1(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 500, 1500))
+}
+
 // RandomizedGenState generates a random GenesisState for auth
 func RandomizedGenState(simState *module.SimulationState) {
 	var maxMemoChars uint64
@@ -84,8 +103,26 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { sigVerifyCostSECP256K1 = GenSigVerifyCostSECP256K1(r) },
 	)
 
+	var sigVerifyCostMultisigSubSig uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SigVerifyCostMultisigSubSig, &sigVerifyCostMultisigSubSig, simState.Rand,
+		func(r *rand.Rand) { sigVerifyCostMultisigSubSig = GenSigVerifyCostMultisigSubSig(r) },
+	)
+
+	var pubKeyChangeCost uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, PubKeyChangeCost, &pubKeyChangeCost, simState.Rand,
+		func(r *rand.Rand) { pubKeyChangeCost = GenPubKeyChangeCost(r) },
+	)
+
+	var sigVerifyCostSECP256R1 uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SigVerifyCostSECP256R1, &sigVerifyCostSECP256R1, simState.Rand,
+		func(r *rand.Rand) { sigVerifyCostSECP256R1 = GenSigVerifyCostSECP256R1(r) },
+	)
+
 	params := types.NewParams(maxMemoChars, txSigLimit, txSizeCostPerByte,
-		sigVerifyCostED25519, sigVerifyCostSECP256K1)
+		sigVerifyCostED25519, sigVerifyCostSECP256K1, sigVerifyCostMultisigSubSig, pubKeyChangeCost, sigVerifyCostSECP256R1)
 	genesisAccs := RandomGenesisAccounts(simState)
 
 	authGenesis := types.NewGenesisState(params, genesisAccs)
@@ -117,10 +154,15 @@ func RandomGenesisAccounts(simState *module.SimulationState) (genesisAccs export
 
 			bva := vestingtypes.NewBaseVestingAccount(bacc, initialVesting, endTime)
 
-			if simState.Rand.Intn(100) < 50 {
+			switch simState.Rand.Intn(3) {
+			case 0:
 				gacc = vestingtypes.NewContinuousVestingAccountRaw(bva, startTime)
-			} else {
+			case 1:
 				gacc = vestingtypes.NewDelayedVestingAccountRaw(bva)
+			default:
+				gacc = vestingtypes.NewPeriodicVestingAccountRaw(
+					bva, startTime, RandomVestingPeriods(simState.Rand, initialVesting, endTime-startTime),
+				)
 			}
 		}
 
@@ -129,3 +171,35 @@ func RandomGenesisAccounts(simState *module.SimulationState) (genesisAccs export
 
 	return genesisAccs
 }
+
+// RandomVestingPeriods splits the given vesting amount into a random number of
+// periods whose lengths add up to the provided total length.
+func RandomVestingPeriods(r *rand.Rand, amount sdk.Coins, totalLength int64) vestingtypes.Periods {
+	numPeriods := int64(simulation.RandIntBetween(r, 1, 5))
+	if numPeriods > totalLength {
+		numPeriods = totalLength
+	}
+
+	periods := make(vestingtypes.Periods, numPeriods)
+	remainingAmt, remainingLength := amount, totalLength
+
+	for i := int64(0); i < numPeriods-1; i++ {
+		length := remainingLength / (numPeriods - i)
+
+		var periodAmt sdk.Coins
+		for _, coin := range remainingAmt {
+			portion := coin.Amount.QuoRaw(numPeriods - i)
+			if portion.IsPositive() {
+				periodAmt = periodAmt.Add(sdk.NewCoin(coin.Denom, portion))
+			}
+		}
+
+		periods[i] = vestingtypes.NewPeriod(length, periodAmt)
+		remainingAmt = remainingAmt.Sub(periodAmt)
+		remainingLength -= length
+	}
+
+	periods[numPeriods-1] = vestingtypes.NewPeriod(remainingLength, remainingAmt)
+
+	return periods
+}
@@ -16,6 +16,8 @@ const (
 	keyMaxMemoCharacters = "MaxMemoCharacters"
 	keyTxSigLimit        = "TxSigLimit"
 	keyTxSizeCostPerByte = "TxSizeCostPerByte"
+
+	keySigVerifyCostMultisigSubSig = "SigVerifyCostMultisigSubSig"
 )
 
 // ParamChanges defines the parameters that can be modified by param change proposals
@@ -37,5 +39,10 @@ func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 				return fmt.Sprintf("\"%d\"", GenTxSizeCostPerByte(r))
 			},
 		),
+		simulation.NewSimParamChange(types.ModuleName, keySigVerifyCostMultisigSubSig,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenSigVerifyCostMultisigSubSig(r))
+			},
+		),
 	}
 }
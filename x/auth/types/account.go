@@ -11,6 +11,7 @@ import (
 	yaml "gopkg.in/yaml.v2"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/cosmos/cosmos-sdk/x/auth/exported"
 )
 
@@ -153,7 +154,7 @@ func (acc BaseAccount) MarshalYAML() (interface{}, error) {
 
 // NewModuleAddress creates an AccAddress from the hash of the module's name
 func NewModuleAddress(name string) sdk.AccAddress {
-	return sdk.AccAddress(crypto.AddressHash([]byte(name)))
+	return sdk.AccAddress(address.Module(name))
 }
 
 // NewEmptyModuleAccount creates a empty ModuleAccount from a string
@@ -26,6 +26,8 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(&BaseAccount{}, "cosmos-sdk/Account", nil)
 	cdc.RegisterConcrete(&ModuleAccount{}, "cosmos-sdk/ModuleAccount", nil)
 	cdc.RegisterConcrete(StdTx{}, "cosmos-sdk/StdTx", nil)
+	cdc.RegisterConcrete(MsgSignData{}, "sign/MsgSignData", nil)
+	cdc.RegisterConcrete(MsgChangePubKey{}, "cosmos-sdk/MsgChangePubKey", nil)
 }
 
 // RegisterKeyTypeCodec registers an external concrete type defined in
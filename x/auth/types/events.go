@@ -0,0 +1,11 @@
+package types
+
+// auth module event types
+const (
+	EventTypeChangePubKey = "change_pubkey"
+
+	AttributeKeyAccount = "account"
+	AttributeKeyPubKey  = "pubkey"
+
+	AttributeValueCategory = ModuleName
+)
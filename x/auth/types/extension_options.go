@@ -0,0 +1,79 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ExtensionOption defines an optional extension of a transaction, identified by
+// the type URL of its value. Extension options allow new transaction features to
+// be introduced without changing the transaction format. Nodes that do not accept
+// a critical extension option reject the transaction, while unknown non-critical
+// extension options are ignored.
+type ExtensionOption struct {
+	TypeURL  string `json:"type_url" yaml:"type_url"`
+	Value    []byte `json:"value" yaml:"value"`
+	Critical bool   `json:"critical,omitempty" yaml:"critical,omitempty"`
+}
+
+// NewExtensionOption returns a new ExtensionOption.
+func NewExtensionOption(typeURL string, value []byte, critical bool) ExtensionOption {
+	return ExtensionOption{
+		TypeURL:  typeURL,
+		Value:    value,
+		Critical: critical,
+	}
+}
+
+// ValidateExtensionOptions performs a basic validation of a transaction's
+// extension options, i.e. that each has a type URL and that no type URL is
+// used twice.
+func ValidateExtensionOptions(opts []ExtensionOption) error {
+	seen := make(map[string]bool, len(opts))
+	for _, opt := range opts {
+		if opt.TypeURL == "" {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "extension option type URL cannot be empty")
+		}
+		if seen[opt.TypeURL] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate extension option %s", opt.TypeURL)
+		}
+
+		seen[opt.TypeURL] = true
+	}
+
+	return nil
+}
+
+// ExtensionOptionsRegistry defines the set of extension options, by type URL,
+// an application accepts in transactions.
+type ExtensionOptionsRegistry struct {
+	accepted map[string]bool
+}
+
+// NewExtensionOptionsRegistry returns an ExtensionOptionsRegistry accepting
+// the extension options with the given type URLs.
+func NewExtensionOptionsRegistry(typeURLs ...string) ExtensionOptionsRegistry {
+	accepted := make(map[string]bool, len(typeURLs))
+	for _, typeURL := range typeURLs {
+		accepted[typeURL] = true
+	}
+
+	return ExtensionOptionsRegistry{accepted: accepted}
+}
+
+// IsAccepted returns true if the extension option with the given type URL is
+// accepted by the application.
+func (r ExtensionOptionsRegistry) IsAccepted(typeURL string) bool {
+	return r.accepted[typeURL]
+}
+
+// CheckExtensionOptions returns an error if any of the given extension options
+// is critical and not accepted by the application.
+func (r ExtensionOptionsRegistry) CheckExtensionOptions(opts []ExtensionOption) error {
+	for _, opt := range opts {
+		if opt.Critical && !r.IsAccepted(opt.TypeURL) {
+			return sdkerrors.Wrapf(ErrUnknownExtensionOption, "critical extension option %s is not accepted", opt.TypeURL)
+		}
+	}
+
+	return nil
+}
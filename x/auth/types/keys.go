@@ -16,12 +16,24 @@ const (
 
 	// QuerierRoute is the querier route for auth
 	QuerierRoute = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
 )
 
 var (
 	// AddressStoreKeyPrefix prefix for account-by-address store
 	AddressStoreKeyPrefix = []byte{0x01}
 
+	// UnorderedTxKeyPrefix prefix for the timeout of included unordered txs by tx hash
+	UnorderedTxKeyPrefix = []byte{0x02}
+
+	// UnorderedTxQueueKeyPrefix prefix for included unordered txs ordered by timeout
+	UnorderedTxQueueKeyPrefix = []byte{0x03}
+
+	// AccountNumberStoreKeyPrefix prefix for the account address by account number index
+	AccountNumberStoreKeyPrefix = []byte{0x04}
+
 	// param key for global account number
 	GlobalAccountNumberKey = []byte("globalAccountNumber")
 )
@@ -30,3 +42,27 @@ var (
 func AddressStoreKey(addr sdk.AccAddress) []byte {
 	return append(AddressStoreKeyPrefix, addr.Bytes()...)
 }
+
+// AccountNumberStoreKey returns the key used to index the address of an account
+// by its account number
+func AccountNumberStoreKey(accNumber uint64) []byte {
+	return append(AccountNumberStoreKeyPrefix, sdk.Uint64ToBigEndian(accNumber)...)
+}
+
+// UnorderedTxKey returns the key used to store the timeout of an included
+// unordered tx by its hash
+func UnorderedTxKey(txHash []byte) []byte {
+	return append(UnorderedTxKeyPrefix, txHash...)
+}
+
+// UnorderedTxQueueTimeKey returns the prefix of the keys of the unordered txs
+// timing out at the given unix time
+func UnorderedTxQueueTimeKey(timeout uint64) []byte {
+	return append(UnorderedTxQueueKeyPrefix, sdk.Uint64ToBigEndian(timeout)...)
+}
+
+// UnorderedTxQueueKey returns the key used to queue an included unordered tx
+// by its timeout
+func UnorderedTxQueueKey(timeout uint64, txHash []byte) []byte {
+	return append(UnorderedTxQueueTimeKey(timeout), txHash...)
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// auth message types
+const (
+	TypeMsgChangePubKey = "change_pubkey"
+)
+
+var _ sdk.Msg = MsgChangePubKey{}
+
+// NewMsgChangePubKey returns a new MsgChangePubKey rotating the public key of
+// the account with the given address to pubKey.
+func NewMsgChangePubKey(address sdk.AccAddress, pubKey crypto.PubKey) MsgChangePubKey {
+	var pkBz []byte
+	if pubKey != nil {
+		pkBz = pubKey.Bytes()
+	}
+
+	return MsgChangePubKey{
+		Address: address,
+		PubKey:  pkBz,
+	}
+}
+
+// Route returns the message route for a MsgChangePubKey.
+func (msg MsgChangePubKey) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgChangePubKey.
+func (msg MsgChangePubKey) Type() string { return TypeMsgChangePubKey }
+
+// ValidateBasic Implements Msg.
+func (msg MsgChangePubKey) ValidateBasic() error {
+	if msg.Address.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing address")
+	}
+
+	if _, err := msg.DecodePubKey(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgChangePubKey.
+func (msg MsgChangePubKey) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners returns the expected signers for a MsgChangePubKey.
+func (msg MsgChangePubKey) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Address}
+}
+
+// DecodePubKey returns the new public key of the MsgChangePubKey, or an error
+// if it is missing or cannot be decoded.
+func (msg MsgChangePubKey) DecodePubKey() (crypto.PubKey, error) {
+	if len(msg.PubKey) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "missing public key")
+	}
+
+	var pk crypto.PubKey
+	if err := amino.UnmarshalBinaryBare(msg.PubKey, &pk); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+	}
+
+	return pk, nil
+}
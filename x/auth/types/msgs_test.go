@@ -0,0 +1,29 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMsgChangePubKey(t *testing.T) {
+	pubKey := secp256k1.GenPrivKey().PubKey()
+
+	msg := NewMsgChangePubKey(addr, pubKey)
+	require.Equal(t, RouterKey, msg.Route())
+	require.Equal(t, TypeMsgChangePubKey, msg.Type())
+	require.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+	require.NotPanics(t, func() { msg.GetSignBytes() })
+	require.NoError(t, msg.ValidateBasic())
+
+	decoded, err := msg.DecodePubKey()
+	require.NoError(t, err)
+	require.Equal(t, pubKey, decoded)
+
+	require.Error(t, NewMsgChangePubKey(nil, pubKey).ValidateBasic())
+	require.Error(t, NewMsgChangePubKey(addr, nil).ValidateBasic())
+	require.Error(t, MsgChangePubKey{Address: addr, PubKey: []byte("invalid")}.ValidateBasic())
+}
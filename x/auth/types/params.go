@@ -18,6 +18,10 @@ const (
 	DefaultTxSizeCostPerByte      uint64 = 10
 	DefaultSigVerifyCostED25519   uint64 = 590
 	DefaultSigVerifyCostSecp256k1 uint64 = 1000
+
+	DefaultSigVerifyCostMultisigSubSig uint64 = 0
+	DefaultPubKeyChangeCost            uint64 = 5000
+	DefaultSigVerifyCostSecp256r1      uint64 = 1500
 )
 
 // Parameter keys
@@ -27,20 +31,28 @@ var (
 	KeyTxSizeCostPerByte      = []byte("TxSizeCostPerByte")
 	KeySigVerifyCostED25519   = []byte("SigVerifyCostED25519")
 	KeySigVerifyCostSecp256k1 = []byte("SigVerifyCostSecp256k1")
+
+	KeySigVerifyCostMultisigSubSig = []byte("SigVerifyCostMultisigSubSig")
+	KeyPubKeyChangeCost            = []byte("PubKeyChangeCost")
+	KeySigVerifyCostSecp256r1      = []byte("SigVerifyCostSecp256r1")
 )
 
 var _ paramtypes.ParamSet = &Params{}
 
 // NewParams creates a new Params object
 func NewParams(
-	maxMemoCharacters, txSigLimit, txSizeCostPerByte, sigVerifyCostED25519, sigVerifyCostSecp256k1 uint64,
+	maxMemoCharacters, txSigLimit, txSizeCostPerByte, sigVerifyCostED25519, sigVerifyCostSecp256k1,
+	sigVerifyCostMultisigSubSig, pubKeyChangeCost, sigVerifyCostSecp256r1 uint64,
 ) Params {
 	return Params{
-		MaxMemoCharacters:      maxMemoCharacters,
-		TxSigLimit:             txSigLimit,
-		TxSizeCostPerByte:      txSizeCostPerByte,
-		SigVerifyCostED25519:   sigVerifyCostED25519,
-		SigVerifyCostSecp256k1: sigVerifyCostSecp256k1,
+		MaxMemoCharacters:           maxMemoCharacters,
+		TxSigLimit:                  txSigLimit,
+		TxSizeCostPerByte:           txSizeCostPerByte,
+		SigVerifyCostED25519:        sigVerifyCostED25519,
+		SigVerifyCostSecp256k1:      sigVerifyCostSecp256k1,
+		SigVerifyCostMultisigSubSig: sigVerifyCostMultisigSubSig,
+		PubKeyChangeCost:            pubKeyChangeCost,
+		SigVerifyCostSecp256r1:      sigVerifyCostSecp256r1,
 	}
 }
 
@@ -59,17 +71,23 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyTxSizeCostPerByte, &p.TxSizeCostPerByte, validateTxSizeCostPerByte),
 		paramtypes.NewParamSetPair(KeySigVerifyCostED25519, &p.SigVerifyCostED25519, validateSigVerifyCostED25519),
 		paramtypes.NewParamSetPair(KeySigVerifyCostSecp256k1, &p.SigVerifyCostSecp256k1, validateSigVerifyCostSecp256k1),
+		paramtypes.NewParamSetPair(KeySigVerifyCostMultisigSubSig, &p.SigVerifyCostMultisigSubSig, validateSigVerifyCostMultisigSubSig),
+		paramtypes.NewParamSetPair(KeyPubKeyChangeCost, &p.PubKeyChangeCost, validatePubKeyChangeCost),
+		paramtypes.NewParamSetPair(KeySigVerifyCostSecp256r1, &p.SigVerifyCostSecp256r1, validateSigVerifyCostSecp256r1),
 	}
 }
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
 	return Params{
-		MaxMemoCharacters:      DefaultMaxMemoCharacters,
-		TxSigLimit:             DefaultTxSigLimit,
-		TxSizeCostPerByte:      DefaultTxSizeCostPerByte,
-		SigVerifyCostED25519:   DefaultSigVerifyCostED25519,
-		SigVerifyCostSecp256k1: DefaultSigVerifyCostSecp256k1,
+		MaxMemoCharacters:           DefaultMaxMemoCharacters,
+		TxSigLimit:                  DefaultTxSigLimit,
+		TxSizeCostPerByte:           DefaultTxSizeCostPerByte,
+		SigVerifyCostED25519:        DefaultSigVerifyCostED25519,
+		SigVerifyCostSecp256k1:      DefaultSigVerifyCostSecp256k1,
+		SigVerifyCostMultisigSubSig: DefaultSigVerifyCostMultisigSubSig,
+		PubKeyChangeCost:            DefaultPubKeyChangeCost,
+		SigVerifyCostSecp256r1:      DefaultSigVerifyCostSecp256r1,
 	}
 }
 
@@ -118,6 +136,42 @@ func validateSigVerifyCostSecp256k1(i interface{}) error {
 	return nil
 }
 
+func validateSigVerifyCostSecp256r1(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("invalid secp256r1 signature verification cost: %d", v)
+	}
+
+	return nil
+}
+
+// validateSigVerifyCostMultisigSubSig accepts a zero cost, as it's charged on
+// top of the verification cost of each sub-signature.
+func validateSigVerifyCostMultisigSubSig(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validatePubKeyChangeCost(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("invalid public key change cost: %d", v)
+	}
+
+	return nil
+}
+
 func validateMaxMemoCharacters(i interface{}) error {
 	v, ok := i.(uint64)
 	if !ok {
@@ -155,6 +209,15 @@ func (p Params) Validate() error {
 	if err := validateSigVerifyCostSecp256k1(p.SigVerifyCostSecp256k1); err != nil {
 		return err
 	}
+	if err := validateSigVerifyCostSecp256r1(p.SigVerifyCostSecp256r1); err != nil {
+		return err
+	}
+	if err := validateSigVerifyCostMultisigSubSig(p.SigVerifyCostMultisigSubSig); err != nil {
+		return err
+	}
+	if err := validatePubKeyChangeCost(p.PubKeyChangeCost); err != nil {
+		return err
+	}
 	if err := validateMaxMemoCharacters(p.MaxMemoCharacters); err != nil {
 		return err
 	}
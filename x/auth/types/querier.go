@@ -6,8 +6,12 @@ import (
 
 // query endpoints supported by the auth Querier
 const (
-	QueryAccount = "account"
-	QueryParams  = "params"
+	QueryAccount            = "account"
+	QueryAccounts           = "accounts"
+	QueryModuleAccounts     = "module_accounts"
+	QueryAccountAddressByID = "account_address_by_id"
+	QueryParams             = "params"
+	QueryVestingSchedule    = "vesting_schedule"
 )
 
 // QueryAccountParams defines the params for querying accounts.
@@ -19,3 +23,26 @@ type QueryAccountParams struct {
 func NewQueryAccountParams(addr sdk.AccAddress) QueryAccountParams {
 	return QueryAccountParams{Address: addr}
 }
+
+// QueryAccountsParams defines the params for querying all accounts.
+type QueryAccountsParams struct {
+	Page  int `json:"page" yaml:"page"`
+	Limit int `json:"limit" yaml:"limit"`
+}
+
+// NewQueryAccountsParams creates a new instance of QueryAccountsParams.
+func NewQueryAccountsParams(page, limit int) QueryAccountsParams {
+	return QueryAccountsParams{Page: page, Limit: limit}
+}
+
+// QueryAccountAddressByIDParams defines the params for querying the address of
+// an account by its account number.
+type QueryAccountAddressByIDParams struct {
+	ID uint64 `json:"id" yaml:"id"`
+}
+
+// NewQueryAccountAddressByIDParams creates a new instance of
+// QueryAccountAddressByIDParams.
+func NewQueryAccountAddressByIDParams(id uint64) QueryAccountAddressByIDParams {
+	return QueryAccountAddressByIDParams{ID: id}
+}
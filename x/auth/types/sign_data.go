@@ -0,0 +1,96 @@
+package types
+
+import (
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Off-chain signing of arbitrary data as specified by ADR 036. The data is
+// wrapped in a MsgSignData and signed as a StdTx with an empty chain ID, zero
+// account number and sequence, no fee and no memo, so that the signature can
+// never be replayed as a valid transaction on any chain.
+const (
+	RouterKeySignData = "sign"
+	TypeMsgSignData   = "signData"
+)
+
+var _ sdk.Msg = MsgSignData{}
+
+// MsgSignData defines an arbitrary, off-chain message signed by the signer. It
+// must never be broadcast.
+type MsgSignData struct {
+	Signer sdk.AccAddress `json:"signer" yaml:"signer"`
+	Data   []byte         `json:"data" yaml:"data"`
+}
+
+// NewMsgSignData returns a new MsgSignData.
+func NewMsgSignData(signer sdk.AccAddress, data []byte) MsgSignData {
+	return MsgSignData{Signer: signer, Data: data}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgSignData) Route() string { return RouterKeySignData }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgSignData) Type() string { return TypeMsgSignData }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgSignData) ValidateBasic() error {
+	if msg.Signer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing signer address")
+	}
+	if len(msg.Data) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "data cannot be empty")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgSignData) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgSignData) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// NewSignDataSignMsg returns the StdSignMsg the signer signs over to sign the
+// given arbitrary data off-chain.
+func NewSignDataSignMsg(signer sdk.AccAddress, data []byte) StdSignMsg {
+	return StdSignMsg{
+		Fee:  NewStdFee(0, sdk.NewCoins()),
+		Msgs: []sdk.Msg{NewMsgSignData(signer, data)},
+	}
+}
+
+// VerifySignData verifies that the signature was produced by the signer over
+// the given arbitrary data, using the provided public key of the signer. The
+// public key must be accPubKey, the one stored on the account of the signer,
+// which may have been changed with a MsgChangePubKey, or the one of the
+// signer's address if accPubKey is nil, e.g. for an account which is unknown
+// or has not signed any transaction yet.
+func VerifySignData(signer sdk.AccAddress, data []byte, pubKey, accPubKey crypto.PubKey, sig []byte) error {
+	msg := NewMsgSignData(signer, data)
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if pubKey == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "missing public key")
+	}
+	switch {
+	case accPubKey != nil && !pubKey.Equals(accPubKey):
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "public key does not match the account of signer %s", signer)
+	case accPubKey == nil && !signer.Equals(sdk.AccAddress(pubKey.Address())):
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "public key does not match signer address %s", signer)
+	}
+	if !pubKey.VerifyBytes(NewSignDataSignMsg(signer, data).Bytes(), sig) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "signature verification failed")
+	}
+
+	return nil
+}
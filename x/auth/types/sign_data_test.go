@@ -0,0 +1,57 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMsgSignDataValidateBasic(t *testing.T) {
+	require.NoError(t, NewMsgSignData(addr, []byte("data")).ValidateBasic())
+	require.Error(t, NewMsgSignData(nil, []byte("data")).ValidateBasic())
+	require.Error(t, NewMsgSignData(addr, nil).ValidateBasic())
+}
+
+func TestMsgSignDataGetSignBytes(t *testing.T) {
+	signer := sdk.AccAddress([]byte("signer"))
+	msg := NewSignDataSignMsg(signer, []byte("data"))
+
+	expected := `{"account_number":"0","chain_id":"","fee":{"amount":[],"gas":"0"},"memo":"","msgs":[{"type":"sign/MsgSignData","value":{"data":"ZGF0YQ==","signer":"cosmos1wd5kwmn9wgr5dmap"}}],"sequence":"0"}`
+	require.Equal(t, expected, string(msg.Bytes()))
+}
+
+func TestVerifySignData(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+	signer := sdk.AccAddress(priv.PubKey().Address())
+	data := []byte("proof of ownership")
+
+	sig, err := priv.Sign(NewSignDataSignMsg(signer, data).Bytes())
+	require.NoError(t, err)
+	require.NoError(t, VerifySignData(signer, data, priv.PubKey(), nil, sig))
+
+	// wrong data
+	require.Error(t, VerifySignData(signer, []byte("other data"), priv.PubKey(), nil, sig))
+
+	// public key not matching the signer
+	other := secp256k1.GenPrivKey().PubKey()
+	require.Error(t, VerifySignData(signer, data, other, nil, sig))
+	require.Error(t, VerifySignData(sdk.AccAddress(other.Address()), data, other, nil, sig))
+
+	// missing public key
+	require.Error(t, VerifySignData(signer, data, nil, nil, sig))
+
+	// the public key of an account changed with a MsgChangePubKey
+	rotated := secp256k1.GenPrivKey()
+	sig, err = rotated.Sign(NewSignDataSignMsg(signer, data).Bytes())
+	require.NoError(t, err)
+	require.NoError(t, VerifySignData(signer, data, rotated.PubKey(), rotated.PubKey(), sig))
+	require.Error(t, VerifySignData(signer, data, rotated.PubKey(), nil, sig))
+
+	// the public key of the signer's address once the account's one was changed
+	sig, err = priv.Sign(NewSignDataSignMsg(signer, data).Bytes())
+	require.NoError(t, err)
+	require.Error(t, VerifySignData(signer, data, priv.PubKey(), rotated.PubKey(), sig))
+}
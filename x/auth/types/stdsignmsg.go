@@ -8,15 +8,42 @@ import (
 // a Msg with the other requirements for a StdSignDoc before
 // it is signed. For use in the CLI.
 type StdSignMsg struct {
-	ChainID       string    `json:"chain_id" yaml:"chain_id"`
-	AccountNumber uint64    `json:"account_number" yaml:"account_number"`
-	Sequence      uint64    `json:"sequence" yaml:"sequence"`
-	Fee           StdFee    `json:"fee" yaml:"fee"`
-	Msgs          []sdk.Msg `json:"msgs" yaml:"msgs"`
-	Memo          string    `json:"memo" yaml:"memo"`
+	ChainID          string    `json:"chain_id" yaml:"chain_id"`
+	AccountNumber    uint64    `json:"account_number" yaml:"account_number"`
+	Sequence         uint64    `json:"sequence" yaml:"sequence"`
+	Fee              StdFee    `json:"fee" yaml:"fee"`
+	Msgs             []sdk.Msg `json:"msgs" yaml:"msgs"`
+	Memo             string    `json:"memo" yaml:"memo"`
+	Unordered        bool      `json:"unordered,omitempty" yaml:"unordered,omitempty"`
+	TimeoutTimestamp uint64    `json:"timeout_timestamp,omitempty" yaml:"timeout_timestamp,omitempty"`
+
+	ExtensionOptions []ExtensionOption `json:"extension_options,omitempty" yaml:"extension_options,omitempty"`
 }
 
 // get message bytes
 func (msg StdSignMsg) Bytes() []byte {
-	return StdSignBytes(msg.ChainID, msg.AccountNumber, msg.Sequence, msg.Fee, msg.Msgs, msg.Memo)
+	doc := StdSignDoc{
+		AccountNumber:    msg.AccountNumber,
+		ChainID:          msg.ChainID,
+		ExtensionOptions: msg.ExtensionOptions,
+	}
+
+	if msg.Unordered {
+		doc.Unordered = true
+		doc.TimeoutTimestamp = msg.TimeoutTimestamp
+	} else {
+		doc.Sequence = msg.Sequence
+	}
+
+	return stdSignBytes(doc, msg.Fee, msg.Msgs, msg.Memo)
+}
+
+// StdTx returns a StdTx for the sign message with the given signatures.
+func (msg StdSignMsg) StdTx(sigs []StdSignature) StdTx {
+	tx := NewStdTx(msg.Msgs, msg.Fee, sigs, msg.Memo)
+	tx.Unordered = msg.Unordered
+	tx.TimeoutTimestamp = msg.TimeoutTimestamp
+	tx.ExtensionOptions = msg.ExtensionOptions
+
+	return tx
 }
@@ -20,9 +20,47 @@ const MaxGasWanted = uint64((1 << 63) - 1)
 // Deprecated: StdFee includes the amount of coins paid in fees and the maximum
 // gas to be used by the transaction. The ratio yields an effective "gasprice",
 // which must be above some miminum to be accepted into the mempool.
+//
+// The fee is paid by the first signer unless a Payer is set, in which case the
+// payer must also sign the transaction. If a Granter is set, the fee is
+// deducted from the granter's account through a fee allowance it granted to
+// the payer. An optional Tip may be attached to compensate the fee payer for
+// broadcasting the transaction on behalf of the tipper.
 type StdFee struct {
-	Amount sdk.Coins `json:"amount" yaml:"amount"`
-	Gas    uint64    `json:"gas" yaml:"gas"`
+	Amount  sdk.Coins      `json:"amount" yaml:"amount"`
+	Gas     uint64         `json:"gas" yaml:"gas"`
+	Payer   sdk.AccAddress `json:"payer,omitempty" yaml:"payer,omitempty"`
+	Granter sdk.AccAddress `json:"granter,omitempty" yaml:"granter,omitempty"`
+	Tip     *Tip           `json:"tip,omitempty" yaml:"tip,omitempty"`
+}
+
+// Tip defines an amount of coins paid by the tipper to the fee payer of a
+// transaction. It allows a user to have a transaction broadcast (and its fees
+// paid) by another account, e.g. a relayer, in exchange for the tip. The
+// tipper must sign the transaction.
+type Tip struct {
+	Amount sdk.Coins      `json:"amount" yaml:"amount"`
+	Tipper sdk.AccAddress `json:"tipper" yaml:"tipper"`
+}
+
+// NewTip returns a new instance of Tip.
+func NewTip(amount sdk.Coins, tipper sdk.AccAddress) *Tip {
+	return &Tip{
+		Amount: amount,
+		Tipper: tipper,
+	}
+}
+
+// Validate performs a basic validation of the tip.
+func (tip Tip) Validate() error {
+	if tip.Tipper.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing tipper address")
+	}
+	if !tip.Amount.IsValid() || tip.Amount.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid tip amount: %s", tip.Amount)
+	}
+
+	return nil
 }
 
 // Deprecated: NewStdFee returns a new instance of StdFee
@@ -43,6 +81,21 @@ func (fee StdFee) GetAmount() sdk.Coins {
 	return fee.Amount
 }
 
+// GetPayer returns the fee's explicit payer, if any.
+func (fee StdFee) GetPayer() sdk.AccAddress {
+	return fee.Payer
+}
+
+// GetGranter returns the fee's granter, if any.
+func (fee StdFee) GetGranter() sdk.AccAddress {
+	return fee.Granter
+}
+
+// GetTip returns the fee's tip, if any.
+func (fee StdFee) GetTip() *Tip {
+	return fee.Tip
+}
+
 // Bytes returns the encoded bytes of a StdFee.
 func (fee StdFee) Bytes() []byte {
 	if len(fee.Amount) == 0 {
@@ -144,11 +197,20 @@ var _ sdk.Tx = (*StdTx)(nil)
 
 // StdTx is a standard way to wrap a Msg with Fee and Signatures.
 // NOTE: the first signature is the fee payer (Signatures must not be nil).
+//
+// An Unordered tx is signed without a sequence number and does not increment
+// the signers' sequences. Instead, it is rejected if a tx with the same hash
+// was already included before its TimeoutTimestamp (in unix seconds), after
+// which it can no longer be included.
 type StdTx struct {
-	Msgs       []sdk.Msg      `json:"msg" yaml:"msg"`
-	Fee        StdFee         `json:"fee" yaml:"fee"`
-	Signatures []StdSignature `json:"signatures" yaml:"signatures"`
-	Memo       string         `json:"memo" yaml:"memo"`
+	Msgs             []sdk.Msg      `json:"msg" yaml:"msg"`
+	Fee              StdFee         `json:"fee" yaml:"fee"`
+	Signatures       []StdSignature `json:"signatures" yaml:"signatures"`
+	Memo             string         `json:"memo" yaml:"memo"`
+	Unordered        bool           `json:"unordered,omitempty" yaml:"unordered,omitempty"`
+	TimeoutTimestamp uint64         `json:"timeout_timestamp,omitempty" yaml:"timeout_timestamp,omitempty"`
+
+	ExtensionOptions []ExtensionOption `json:"extension_options,omitempty" yaml:"extension_options,omitempty"`
 }
 
 func NewStdTx(msgs []sdk.Msg, fee StdFee, sigs []StdSignature, memo string) StdTx {
@@ -180,6 +242,20 @@ func (tx StdTx) ValidateBasic() error {
 			"invalid fee provided: %s", tx.Fee.Amount,
 		)
 	}
+	if tx.Fee.Tip != nil {
+		if err := tx.Fee.Tip.Validate(); err != nil {
+			return err
+		}
+	}
+	if tx.Unordered && tx.TimeoutTimestamp == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unordered transaction must have a timeout timestamp")
+	}
+	if !tx.Unordered && tx.TimeoutTimestamp != 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "timeout timestamp is only supported by unordered transactions")
+	}
+	if err := ValidateExtensionOptions(tx.ExtensionOptions); err != nil {
+		return err
+	}
 	if len(stdSigs) == 0 {
 		return sdkerrors.ErrNoSignatures
 	}
@@ -198,6 +274,8 @@ func (tx StdTx) ValidateBasic() error {
 // They are accumulated from the GetSigners method for each Msg
 // in the order they appear in tx.GetMsgs().
 // Duplicate addresses will be omitted.
+// If an explicit fee payer or a tipper is set and is not already a signer, it
+// is appended, in that order, after the message signers.
 func (tx StdTx) GetSigners() []sdk.AccAddress {
 	var signers []sdk.AccAddress
 	seen := map[string]bool{}
@@ -211,6 +289,15 @@ func (tx StdTx) GetSigners() []sdk.AccAddress {
 		}
 	}
 
+	if payer := tx.Fee.Payer; !payer.Empty() && !seen[payer.String()] {
+		signers = append(signers, payer)
+		seen[payer.String()] = true
+	}
+
+	if tip := tx.Fee.Tip; tip != nil && !tip.Tipper.Empty() && !seen[tip.Tipper.String()] {
+		signers = append(signers, tip.Tipper)
+	}
+
 	return signers
 }
 
@@ -253,11 +340,22 @@ func (tx StdTx) GetSignBytes(ctx sdk.Context, acc exported.Account) []byte {
 		accNum = acc.GetAccountNumber()
 	}
 
-	return StdSignBytes(
-		chainID, accNum, acc.GetSequence(), tx.Fee, tx.Msgs, tx.Memo,
-	)
+	return StdSignMsg{
+		ChainID:          chainID,
+		AccountNumber:    accNum,
+		Sequence:         acc.GetSequence(),
+		Fee:              tx.Fee,
+		Msgs:             tx.Msgs,
+		Memo:             tx.Memo,
+		Unordered:        tx.Unordered,
+		TimeoutTimestamp: tx.TimeoutTimestamp,
+		ExtensionOptions: tx.ExtensionOptions,
+	}.Bytes()
 }
 
+// GetExtensionOptions returns the transaction's extension options.
+func (tx StdTx) GetExtensionOptions() []ExtensionOption { return tx.ExtensionOptions }
+
 // GetGas returns the Gas in StdFee
 func (tx StdTx) GetGas() uint64 { return tx.Fee.Gas }
 
@@ -265,45 +363,96 @@ func (tx StdTx) GetGas() uint64 { return tx.Fee.Gas }
 func (tx StdTx) GetFee() sdk.Coins { return tx.Fee.Amount }
 
 // FeePayer returns the address that is responsible for paying fee
-// StdTx returns the first signer as the fee payer
+// StdTx returns the explicit fee payer if set, otherwise the first signer
 // If no signers for tx, return empty address
 func (tx StdTx) FeePayer() sdk.AccAddress {
+	if !tx.Fee.Payer.Empty() {
+		return tx.Fee.Payer
+	}
 	if tx.GetSigners() != nil {
 		return tx.GetSigners()[0]
 	}
 	return sdk.AccAddress{}
 }
 
+// FeeGranter returns the address whose fee allowance pays for the fee, if any.
+func (tx StdTx) FeeGranter() sdk.AccAddress {
+	return tx.Fee.Granter
+}
+
+// GetTip returns the amount tipped to the fee payer, if any.
+func (tx StdTx) GetTip() sdk.Coins {
+	if tx.Fee.Tip == nil {
+		return nil
+	}
+	return tx.Fee.Tip.Amount
+}
+
+// GetTipper returns the address paying the tip, if any.
+func (tx StdTx) GetTipper() sdk.AccAddress {
+	if tx.Fee.Tip == nil {
+		return nil
+	}
+	return tx.Fee.Tip.Tipper
+}
+
+// GetUnordered returns whether the transaction is unordered.
+func (tx StdTx) GetUnordered() bool { return tx.Unordered }
+
+// GetTimeoutTimestamp returns the timeout timestamp, in unix seconds, of an
+// unordered transaction.
+func (tx StdTx) GetTimeoutTimestamp() uint64 { return tx.TimeoutTimestamp }
+
 // StdSignDoc is replay-prevention structure.
 // It includes the result of msg.GetSignBytes(),
 // as well as the ChainID (prevent cross chain replay)
 // and the Sequence numbers for each signature (prevent
 // inchain replay and enforce tx ordering per account).
+// Unordered transactions have no sequence and instead commit to their timeout
+// timestamp.
 type StdSignDoc struct {
-	AccountNumber uint64            `json:"account_number" yaml:"account_number"`
-	ChainID       string            `json:"chain_id" yaml:"chain_id"`
-	Fee           json.RawMessage   `json:"fee" yaml:"fee"`
-	Memo          string            `json:"memo" yaml:"memo"`
-	Msgs          []json.RawMessage `json:"msgs" yaml:"msgs"`
-	Sequence      uint64            `json:"sequence" yaml:"sequence"`
+	AccountNumber    uint64            `json:"account_number" yaml:"account_number"`
+	ChainID          string            `json:"chain_id" yaml:"chain_id"`
+	Fee              json.RawMessage   `json:"fee" yaml:"fee"`
+	Memo             string            `json:"memo" yaml:"memo"`
+	Msgs             []json.RawMessage `json:"msgs" yaml:"msgs"`
+	Sequence         uint64            `json:"sequence" yaml:"sequence"`
+	Unordered        bool              `json:"unordered,omitempty" yaml:"unordered,omitempty"`
+	TimeoutTimestamp uint64            `json:"timeout_timestamp,omitempty" yaml:"timeout_timestamp,omitempty"`
+	ExtensionOptions []ExtensionOption `json:"extension_options,omitempty" yaml:"extension_options,omitempty"`
 }
 
 // StdSignBytes returns the bytes to sign for a transaction.
 func StdSignBytes(chainID string, accnum uint64, sequence uint64, fee StdFee, msgs []sdk.Msg, memo string) []byte {
+	return stdSignBytes(StdSignDoc{
+		AccountNumber: accnum,
+		ChainID:       chainID,
+		Sequence:      sequence,
+	}, fee, msgs, memo)
+}
+
+// StdSignBytesUnordered returns the bytes to sign for an unordered transaction
+// with the given timeout timestamp.
+func StdSignBytesUnordered(chainID string, accnum uint64, timeout uint64, fee StdFee, msgs []sdk.Msg, memo string) []byte {
+	return stdSignBytes(StdSignDoc{
+		AccountNumber:    accnum,
+		ChainID:          chainID,
+		Unordered:        true,
+		TimeoutTimestamp: timeout,
+	}, fee, msgs, memo)
+}
+
+func stdSignBytes(doc StdSignDoc, fee StdFee, msgs []sdk.Msg, memo string) []byte {
 	msgsBytes := make([]json.RawMessage, 0, len(msgs))
 	for _, msg := range msgs {
 		msgsBytes = append(msgsBytes, json.RawMessage(msg.GetSignBytes()))
 	}
 
-	bz, err := codec.Cdc.MarshalJSON(StdSignDoc{
-		AccountNumber: accnum,
-		ChainID:       chainID,
-		Fee:           json.RawMessage(fee.Bytes()),
-		Memo:          memo,
-		Msgs:          msgsBytes,
-		Sequence:      sequence,
-	})
+	doc.Fee = json.RawMessage(fee.Bytes())
+	doc.Memo = memo
+	doc.Msgs = msgsBytes
 
+	bz, err := codec.Cdc.MarshalJSON(doc)
 	if err != nil {
 		panic(err)
 	}
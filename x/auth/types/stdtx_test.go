@@ -34,6 +34,122 @@ func TestStdTx(t *testing.T) {
 	require.Equal(t, addr, feePayer)
 }
 
+func TestStdTxFeePayer(t *testing.T) {
+	payer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	granter := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	msgs := []sdk.Msg{sdk.NewTestMsg(addr)}
+
+	fee := NewTestStdFee()
+	tx := NewStdTx(msgs, fee, nil, "")
+	require.Equal(t, addr, tx.FeePayer())
+	require.True(t, tx.FeeGranter().Empty())
+
+	fee.Payer = payer
+	fee.Granter = granter
+	tx = NewStdTx(msgs, fee, nil, "")
+	require.Equal(t, []sdk.AccAddress{addr, payer}, tx.GetSigners())
+	require.Equal(t, payer, tx.FeePayer())
+	require.Equal(t, granter, tx.FeeGranter())
+
+	// a payer that already signs a msg is not duplicated
+	fee.Payer = addr
+	tx = NewStdTx(msgs, fee, nil, "")
+	require.Equal(t, []sdk.AccAddress{addr}, tx.GetSigners())
+	require.Equal(t, addr, tx.FeePayer())
+}
+
+func TestStdTxTip(t *testing.T) {
+	tipper := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	msgs := []sdk.Msg{sdk.NewTestMsg(addr)}
+	tip := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+
+	fee := NewTestStdFee()
+	tx := NewStdTx(msgs, fee, nil, "")
+	require.Nil(t, tx.GetTip())
+	require.Nil(t, tx.GetTipper())
+
+	fee.Tip = NewTip(tip, tipper)
+	tx = NewStdTx(msgs, fee, nil, "")
+	require.Equal(t, []sdk.AccAddress{addr, tipper}, tx.GetSigners())
+	require.Equal(t, tip, tx.GetTip())
+	require.Equal(t, tipper, tx.GetTipper())
+	require.Equal(t, addr, tx.FeePayer())
+
+	// the tip is part of the sign bytes
+	require.NotEqual(t,
+		StdSignBytes("chain", 0, 0, NewTestStdFee(), msgs, ""),
+		StdSignBytes("chain", 0, 0, fee, msgs, ""),
+	)
+}
+
+func TestStdTxUnordered(t *testing.T) {
+	msgs := []sdk.Msg{sdk.NewTestMsg(addr)}
+	fee := NewTestStdFee()
+
+	// unordered sign bytes commit to the timeout instead of the sequence
+	require.Equal(t, StdSignBytesUnordered("chain", 1, 100, fee, msgs, ""), StdSignBytesUnordered("chain", 1, 100, fee, msgs, ""))
+	require.NotEqual(t, StdSignBytesUnordered("chain", 1, 100, fee, msgs, ""), StdSignBytesUnordered("chain", 1, 200, fee, msgs, ""))
+	require.NotEqual(t, StdSignBytes("chain", 1, 0, fee, msgs, ""), StdSignBytesUnordered("chain", 1, 100, fee, msgs, ""))
+
+	tx := NewStdTx(msgs, fee, []StdSignature{{}}, "")
+	tx.Unordered = true
+	require.Error(t, tx.ValidateBasic())
+
+	tx.TimeoutTimestamp = 100
+	require.NoError(t, tx.ValidateBasic())
+
+	tx.Unordered = false
+	require.Error(t, tx.ValidateBasic())
+}
+
+func TestStdTxExtensionOptions(t *testing.T) {
+	msgs := []sdk.Msg{sdk.NewTestMsg(addr)}
+	fee := NewTestStdFee()
+	opt := NewExtensionOption("/test.Extension", []byte{0x01}, true)
+
+	// extension options are signed over, but leave the sign bytes untouched when empty
+	signMsg := StdSignMsg{ChainID: "chain", AccountNumber: 1, Sequence: 2, Fee: fee, Msgs: msgs}
+	require.Equal(t, StdSignBytes("chain", 1, 2, fee, msgs, ""), signMsg.Bytes())
+
+	signMsg.ExtensionOptions = []ExtensionOption{opt}
+	require.NotEqual(t, StdSignBytes("chain", 1, 2, fee, msgs, ""), signMsg.Bytes())
+	require.Equal(t, []ExtensionOption{opt}, signMsg.StdTx(nil).GetExtensionOptions())
+
+	tx := NewStdTx(msgs, fee, []StdSignature{{}}, "")
+	tx.ExtensionOptions = []ExtensionOption{opt}
+	require.NoError(t, tx.ValidateBasic())
+
+	tx.ExtensionOptions = []ExtensionOption{opt, opt}
+	require.Error(t, tx.ValidateBasic())
+
+	tx.ExtensionOptions = []ExtensionOption{NewExtensionOption("", nil, false)}
+	require.Error(t, tx.ValidateBasic())
+}
+
+func TestExtensionOptionsRegistry(t *testing.T) {
+	registry := NewExtensionOptionsRegistry("/test.Accepted")
+	require.True(t, registry.IsAccepted("/test.Accepted"))
+	require.False(t, registry.IsAccepted("/test.Unknown"))
+
+	require.NoError(t, registry.CheckExtensionOptions(nil))
+	require.NoError(t, registry.CheckExtensionOptions([]ExtensionOption{
+		NewExtensionOption("/test.Accepted", nil, true),
+		NewExtensionOption("/test.Unknown", nil, false),
+	}))
+
+	err := registry.CheckExtensionOptions([]ExtensionOption{NewExtensionOption("/test.Unknown", nil, true)})
+	require.True(t, ErrUnknownExtensionOption.Is(err))
+}
+
+func TestTipValidate(t *testing.T) {
+	tipper := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	require.NoError(t, NewTip(sdk.NewCoins(sdk.NewInt64Coin("atom", 10)), tipper).Validate())
+	require.Error(t, NewTip(sdk.NewCoins(sdk.NewInt64Coin("atom", 10)), nil).Validate())
+	require.Error(t, NewTip(sdk.NewCoins(), tipper).Validate())
+	require.Error(t, NewTip(sdk.Coins{sdk.NewInt64Coin("atom", 0)}, tipper).Validate())
+}
+
 func TestStdSignBytes(t *testing.T) {
 	type args struct {
 		chainID  string
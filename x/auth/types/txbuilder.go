@@ -6,6 +6,7 @@ import (
 	"io"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 
@@ -27,6 +28,12 @@ type TxBuilder struct {
 	memo               string
 	fees               sdk.Coins
 	gasPrices          sdk.DecCoins
+	feePayer           sdk.AccAddress
+	feeGranter         sdk.AccAddress
+	tip                *Tip
+	unordered          bool
+	timeoutTimestamp   uint64
+	extensionOptions   []ExtensionOption
 }
 
 // NewTxBuilder returns a new initialized TxBuilder.
@@ -71,6 +78,43 @@ func NewTxBuilderFromCLI(input io.Reader) TxBuilder {
 	txbldr = txbldr.WithFees(viper.GetString(flags.FlagFees))
 	txbldr = txbldr.WithGasPrices(viper.GetString(flags.FlagGasPrices))
 
+	if payer := viper.GetString(flags.FlagFeePayer); payer != "" {
+		addr, err := sdk.AccAddressFromBech32(payer)
+		if err != nil {
+			panic(err)
+		}
+
+		txbldr = txbldr.WithFeePayer(addr)
+	}
+
+	if granter := viper.GetString(flags.FlagFeeGranter); granter != "" {
+		addr, err := sdk.AccAddressFromBech32(granter)
+		if err != nil {
+			panic(err)
+		}
+
+		txbldr = txbldr.WithFeeGranter(addr)
+	}
+
+	if tip := viper.GetString(flags.FlagTip); tip != "" {
+		amount, err := sdk.ParseCoins(tip)
+		if err != nil {
+			panic(err)
+		}
+
+		tipper, err := sdk.AccAddressFromBech32(viper.GetString(flags.FlagTipper))
+		if err != nil {
+			panic(err)
+		}
+
+		txbldr = txbldr.WithTip(NewTip(amount, tipper))
+	}
+
+	if viper.GetBool(flags.FlagUnordered) {
+		timeout := time.Now().Add(viper.GetDuration(flags.FlagTimeoutDuration))
+		txbldr = txbldr.WithUnordered(true).WithTimeoutTimestamp(uint64(timeout.Unix()))
+	}
+
 	return txbldr
 }
 
@@ -108,6 +152,26 @@ func (bldr TxBuilder) Fees() sdk.Coins { return bldr.fees }
 // GasPrices returns the gas prices set for the transaction, if any.
 func (bldr TxBuilder) GasPrices() sdk.DecCoins { return bldr.gasPrices }
 
+// FeePayer returns the account paying the fees for the transaction, if any.
+func (bldr TxBuilder) FeePayer() sdk.AccAddress { return bldr.feePayer }
+
+// FeeGranter returns the account granting the fee allowance for the
+// transaction, if any.
+func (bldr TxBuilder) FeeGranter() sdk.AccAddress { return bldr.feeGranter }
+
+// Tip returns the tip paid to the fee payer of the transaction, if any.
+func (bldr TxBuilder) Tip() *Tip { return bldr.tip }
+
+// ExtensionOptions returns the extension options of the transaction
+func (bldr TxBuilder) ExtensionOptions() []ExtensionOption { return bldr.extensionOptions }
+
+// Unordered returns whether the transaction is unordered
+func (bldr TxBuilder) Unordered() bool { return bldr.unordered }
+
+// TimeoutTimestamp returns the timeout timestamp, in unix seconds, of an
+// unordered transaction
+func (bldr TxBuilder) TimeoutTimestamp() uint64 { return bldr.timeoutTimestamp }
+
 // WithTxEncoder returns a copy of the context with an updated codec.
 func (bldr TxBuilder) WithTxEncoder(txEncoder sdk.TxEncoder) TxBuilder {
 	bldr.txEncoder = txEncoder
@@ -172,6 +236,44 @@ func (bldr TxBuilder) WithAccountNumber(accnum uint64) TxBuilder {
 	return bldr
 }
 
+// WithFeePayer returns a copy of the context with an updated fee payer.
+func (bldr TxBuilder) WithFeePayer(payer sdk.AccAddress) TxBuilder {
+	bldr.feePayer = payer
+	return bldr
+}
+
+// WithFeeGranter returns a copy of the context with an updated fee granter.
+func (bldr TxBuilder) WithFeeGranter(granter sdk.AccAddress) TxBuilder {
+	bldr.feeGranter = granter
+	return bldr
+}
+
+// WithTip returns a copy of the context with an updated tip.
+func (bldr TxBuilder) WithTip(tip *Tip) TxBuilder {
+	bldr.tip = tip
+	return bldr
+}
+
+// WithUnordered returns a copy of the context with an updated unordered flag.
+func (bldr TxBuilder) WithUnordered(unordered bool) TxBuilder {
+	bldr.unordered = unordered
+	return bldr
+}
+
+// WithExtensionOptions returns a copy of the context with updated extension
+// options.
+func (bldr TxBuilder) WithExtensionOptions(opts ...ExtensionOption) TxBuilder {
+	bldr.extensionOptions = opts
+	return bldr
+}
+
+// WithTimeoutTimestamp returns a copy of the context with an updated timeout
+// timestamp, in unix seconds.
+func (bldr TxBuilder) WithTimeoutTimestamp(timeout uint64) TxBuilder {
+	bldr.timeoutTimestamp = timeout
+	return bldr
+}
+
 // BuildSignMsg builds a single message to be signed from a TxBuilder given a
 // set of messages. It returns an error if a fee is supplied but cannot be
 // parsed.
@@ -197,13 +299,21 @@ func (bldr TxBuilder) BuildSignMsg(msgs []sdk.Msg) (StdSignMsg, error) {
 		}
 	}
 
+	fee := NewStdFee(bldr.gas, fees)
+	fee.Payer = bldr.feePayer
+	fee.Granter = bldr.feeGranter
+	fee.Tip = bldr.tip
+
 	return StdSignMsg{
-		ChainID:       bldr.chainID,
-		AccountNumber: bldr.accountNumber,
-		Sequence:      bldr.sequence,
-		Memo:          bldr.memo,
-		Msgs:          msgs,
-		Fee:           NewStdFee(bldr.gas, fees),
+		ChainID:          bldr.chainID,
+		AccountNumber:    bldr.accountNumber,
+		Sequence:         bldr.sequence,
+		Memo:             bldr.memo,
+		Msgs:             msgs,
+		Fee:              fee,
+		Unordered:        bldr.unordered,
+		TimeoutTimestamp: bldr.timeoutTimestamp,
+		ExtensionOptions: bldr.extensionOptions,
 	}, nil
 }
 
@@ -215,7 +325,7 @@ func (bldr TxBuilder) Sign(name, passphrase string, msg StdSignMsg) ([]byte, err
 		return nil, err
 	}
 
-	return bldr.txEncoder(NewStdTx(msg.Msgs, msg.Fee, []StdSignature{sig}, msg.Memo))
+	return bldr.txEncoder(msg.StdTx([]StdSignature{sig}))
 }
 
 // BuildAndSign builds a single message to be signed, and signs a transaction
@@ -239,7 +349,7 @@ func (bldr TxBuilder) BuildTxForSim(msgs []sdk.Msg) ([]byte, error) {
 
 	// the ante handler will populate with a sentinel pubkey
 	sigs := []StdSignature{{}}
-	return bldr.txEncoder(NewStdTx(signMsg.Msgs, signMsg.Fee, sigs, signMsg.Memo))
+	return bldr.txEncoder(signMsg.StdTx(sigs))
 }
 
 // SignStdTx appends a signature to a StdTx and returns a copy of it. If append
@@ -250,12 +360,15 @@ func (bldr TxBuilder) SignStdTx(name, passphrase string, stdTx StdTx, appendSig
 	}
 
 	stdSignature, err := MakeSignature(bldr.keybase, name, passphrase, StdSignMsg{
-		ChainID:       bldr.chainID,
-		AccountNumber: bldr.accountNumber,
-		Sequence:      bldr.sequence,
-		Fee:           stdTx.Fee,
-		Msgs:          stdTx.GetMsgs(),
-		Memo:          stdTx.GetMemo(),
+		ChainID:          bldr.chainID,
+		AccountNumber:    bldr.accountNumber,
+		Sequence:         bldr.sequence,
+		Fee:              stdTx.Fee,
+		Msgs:             stdTx.GetMsgs(),
+		Memo:             stdTx.GetMemo(),
+		Unordered:        stdTx.Unordered,
+		TimeoutTimestamp: stdTx.TimeoutTimestamp,
+		ExtensionOptions: stdTx.ExtensionOptions,
 	})
 	if err != nil {
 		return
@@ -267,7 +380,8 @@ func (bldr TxBuilder) SignStdTx(name, passphrase string, stdTx StdTx, appendSig
 	} else {
 		sigs = append(sigs, stdSignature)
 	}
-	signedStdTx = NewStdTx(stdTx.GetMsgs(), stdTx.Fee, sigs, stdTx.GetMemo())
+	signedStdTx = stdTx
+	signedStdTx.Signatures = sigs
 	return
 }
 
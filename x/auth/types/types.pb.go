@@ -4,6 +4,7 @@
 package types
 
 import (
+	bytes "bytes"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
@@ -107,11 +108,14 @@ var xxx_messageInfo_ModuleAccount proto.InternalMessageInfo
 
 // Params defines the parameters for the auth module.
 type Params struct {
-	MaxMemoCharacters      uint64 `protobuf:"varint,1,opt,name=max_memo_characters,json=maxMemoCharacters,proto3" json:"max_memo_characters,omitempty" yaml:"max_memo_characters"`
-	TxSigLimit             uint64 `protobuf:"varint,2,opt,name=tx_sig_limit,json=txSigLimit,proto3" json:"tx_sig_limit,omitempty" yaml:"tx_sig_limit"`
-	TxSizeCostPerByte      uint64 `protobuf:"varint,3,opt,name=tx_size_cost_per_byte,json=txSizeCostPerByte,proto3" json:"tx_size_cost_per_byte,omitempty" yaml:"tx_size_cost_per_byte"`
-	SigVerifyCostED25519   uint64 `protobuf:"varint,4,opt,name=sig_verify_cost_ed25519,json=sigVerifyCostEd25519,proto3" json:"sig_verify_cost_ed25519,omitempty" yaml:"sig_verify_cost_ed25519"`
-	SigVerifyCostSecp256k1 uint64 `protobuf:"varint,5,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty" yaml:"sig_verify_cost_secp256k1"`
+	MaxMemoCharacters           uint64 `protobuf:"varint,1,opt,name=max_memo_characters,json=maxMemoCharacters,proto3" json:"max_memo_characters,omitempty" yaml:"max_memo_characters"`
+	TxSigLimit                  uint64 `protobuf:"varint,2,opt,name=tx_sig_limit,json=txSigLimit,proto3" json:"tx_sig_limit,omitempty" yaml:"tx_sig_limit"`
+	TxSizeCostPerByte           uint64 `protobuf:"varint,3,opt,name=tx_size_cost_per_byte,json=txSizeCostPerByte,proto3" json:"tx_size_cost_per_byte,omitempty" yaml:"tx_size_cost_per_byte"`
+	SigVerifyCostED25519        uint64 `protobuf:"varint,4,opt,name=sig_verify_cost_ed25519,json=sigVerifyCostEd25519,proto3" json:"sig_verify_cost_ed25519,omitempty" yaml:"sig_verify_cost_ed25519"`
+	SigVerifyCostSecp256k1      uint64 `protobuf:"varint,5,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty" yaml:"sig_verify_cost_secp256k1"`
+	SigVerifyCostMultisigSubSig uint64 `protobuf:"varint,6,opt,name=sig_verify_cost_multisig_sub_sig,json=sigVerifyCostMultisigSubSig,proto3" json:"sig_verify_cost_multisig_sub_sig,omitempty" yaml:"sig_verify_cost_multisig_sub_sig"`
+	PubKeyChangeCost            uint64 `protobuf:"varint,7,opt,name=pub_key_change_cost,json=pubKeyChangeCost,proto3" json:"pub_key_change_cost,omitempty" yaml:"pub_key_change_cost"`
+	SigVerifyCostSecp256r1      uint64 `protobuf:"varint,8,opt,name=sig_verify_cost_secp256r1,json=sigVerifyCostSecp256r1,proto3" json:"sig_verify_cost_secp256r1,omitempty" yaml:"sig_verify_cost_secp256r1"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -181,56 +185,126 @@ func (m *Params) GetSigVerifyCostSecp256k1() uint64 {
 	return 0
 }
 
+func (m *Params) GetSigVerifyCostMultisigSubSig() uint64 {
+	if m != nil {
+		return m.SigVerifyCostMultisigSubSig
+	}
+	return 0
+}
+
+func (m *Params) GetPubKeyChangeCost() uint64 {
+	if m != nil {
+		return m.PubKeyChangeCost
+	}
+	return 0
+}
+
+func (m *Params) GetSigVerifyCostSecp256r1() uint64 {
+	if m != nil {
+		return m.SigVerifyCostSecp256r1
+	}
+	return 0
+}
+
+// MsgChangePubKey defines a message to rotate the public key of an account,
+// keeping its address, account number, sequence and balances.
+type MsgChangePubKey struct {
+	Address github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty"`
+	PubKey  []byte                                        `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"public_key" yaml:"public_key"`
+}
+
+func (m *MsgChangePubKey) Reset()         { *m = MsgChangePubKey{} }
+func (m *MsgChangePubKey) String() string { return proto.CompactTextString(m) }
+func (*MsgChangePubKey) ProtoMessage()    {}
+func (*MsgChangePubKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d526fa662daab74, []int{3}
+}
+func (m *MsgChangePubKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgChangePubKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgChangePubKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgChangePubKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgChangePubKey.Merge(m, src)
+}
+func (m *MsgChangePubKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgChangePubKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgChangePubKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgChangePubKey proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*BaseAccount)(nil), "cosmos_sdk.x.auth.v1.BaseAccount")
 	proto.RegisterType((*ModuleAccount)(nil), "cosmos_sdk.x.auth.v1.ModuleAccount")
 	proto.RegisterType((*Params)(nil), "cosmos_sdk.x.auth.v1.Params")
+	proto.RegisterType((*MsgChangePubKey)(nil), "cosmos_sdk.x.auth.v1.MsgChangePubKey")
 }
 
 func init() { proto.RegisterFile("x/auth/types/types.proto", fileDescriptor_2d526fa662daab74) }
 
 var fileDescriptor_2d526fa662daab74 = []byte{
-	// 640 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0x8e, 0x9b, 0xd0, 0x1f, 0x97, 0x16, 0xa9, 0x6e, 0xda, 0xba, 0x05, 0xf9, 0x82, 0x07, 0x54,
-	0x24, 0xea, 0x28, 0x45, 0x45, 0x6a, 0x06, 0x44, 0x5d, 0x60, 0x29, 0xad, 0xaa, 0xab, 0xc4, 0xc0,
-	0x62, 0x9d, 0xed, 0x23, 0xb1, 0x92, 0xcb, 0xb9, 0xbe, 0x73, 0x15, 0xf7, 0x2f, 0x40, 0x4c, 0x8c,
-	0x8c, 0xfd, 0x1b, 0xf8, 0x2b, 0x18, 0x3b, 0x32, 0x19, 0x94, 0x2e, 0x88, 0xd1, 0x23, 0x13, 0xb2,
-	0x2f, 0x4d, 0x9d, 0x2a, 0xb0, 0xd8, 0xf7, 0xde, 0xfb, 0xbe, 0xef, 0x3d, 0x7f, 0x4f, 0x67, 0xa0,
-	0x0d, 0x1a, 0x38, 0x12, 0x9d, 0x86, 0x88, 0x03, 0xc2, 0xe5, 0xd3, 0x0c, 0x42, 0x26, 0x98, 0x5a,
-	0x73, 0x19, 0xa7, 0x8c, 0xdb, 0xdc, 0xeb, 0x9a, 0x03, 0x33, 0x03, 0x99, 0xe7, 0xcd, 0xcd, 0xc7,
-	0xa2, 0xe3, 0x87, 0x9e, 0x1d, 0xe0, 0x50, 0xc4, 0x8d, 0x1c, 0xd8, 0x68, 0xb3, 0x36, 0xbb, 0x3d,
-	0x49, 0xb6, 0xf1, 0x69, 0x06, 0x54, 0x2d, 0xcc, 0xc9, 0xbe, 0xeb, 0xb2, 0xa8, 0x2f, 0xd4, 0x43,
-	0x30, 0x87, 0x3d, 0x2f, 0x24, 0x9c, 0x6b, 0x4a, 0x5d, 0xd9, 0x5a, 0xb4, 0x9a, 0x7f, 0x12, 0xb8,
-	0xdd, 0xf6, 0x45, 0x27, 0x72, 0x4c, 0x97, 0xd1, 0x86, 0xec, 0x36, 0x7a, 0x6d, 0x73, 0xaf, 0x3b,
-	0x1a, 0x66, 0xdf, 0x75, 0xf7, 0x25, 0x11, 0xdd, 0x28, 0xa8, 0x6f, 0xc0, 0x5c, 0x10, 0x39, 0x76,
-	0x97, 0xc4, 0xda, 0x4c, 0x2e, 0xb6, 0xfd, 0x3b, 0x81, 0xb5, 0x20, 0x72, 0x7a, 0xbe, 0x9b, 0x65,
-	0x9f, 0x32, 0xea, 0x0b, 0x42, 0x03, 0x11, 0xa7, 0x09, 0x5c, 0x8e, 0x31, 0xed, 0xb5, 0x8c, 0xdb,
-	0xaa, 0x81, 0x66, 0x83, 0xc8, 0x39, 0x24, 0xb1, 0xfa, 0x12, 0xdc, 0xc7, 0x72, 0x3e, 0xbb, 0x1f,
-	0x51, 0x87, 0x84, 0x5a, 0xb9, 0xae, 0x6c, 0x55, 0xac, 0x8d, 0x34, 0x81, 0xab, 0x92, 0x36, 0x59,
-	0x37, 0xd0, 0xd2, 0x28, 0x71, 0x9c, 0xc7, 0xea, 0x26, 0x98, 0xe7, 0xe4, 0x2c, 0x22, 0x7d, 0x97,
-	0x68, 0x95, 0x8c, 0x8b, 0xc6, 0x71, 0x6b, 0xfe, 0xe3, 0x25, 0x2c, 0x7d, 0xb9, 0x84, 0x25, 0xe3,
-	0xab, 0x02, 0x96, 0x8e, 0x98, 0x17, 0xf5, 0xc6, 0x76, 0x60, 0xb0, 0xe8, 0x60, 0x4e, 0xec, 0x91,
-	0x5a, 0xee, 0x49, 0x75, 0xe7, 0x91, 0x39, 0xcd, 0x73, 0xb3, 0xe0, 0xa3, 0xf5, 0xe0, 0x2a, 0x81,
-	0x4a, 0x9a, 0xc0, 0x15, 0x39, 0x5e, 0x51, 0xc4, 0x40, 0x55, 0xa7, 0xe0, 0xb8, 0x0a, 0x2a, 0x7d,
-	0x4c, 0x49, 0xee, 0xd0, 0x02, 0xca, 0xcf, 0x6a, 0x1d, 0x54, 0x03, 0x12, 0x52, 0x9f, 0x73, 0x9f,
-	0xf5, 0xb9, 0x56, 0xae, 0x97, 0xb7, 0x16, 0x50, 0x31, 0x55, 0x18, 0xfa, 0x47, 0x19, 0xcc, 0x9e,
-	0xe0, 0x10, 0x53, 0xae, 0x1e, 0x83, 0x15, 0x8a, 0x07, 0x36, 0x25, 0x94, 0xd9, 0x6e, 0x07, 0x87,
-	0xd8, 0x15, 0x24, 0x94, 0x8b, 0xac, 0x58, 0x7a, 0x9a, 0xc0, 0x4d, 0x39, 0xcd, 0x14, 0x90, 0x81,
-	0x96, 0x29, 0x1e, 0x1c, 0x11, 0xca, 0x0e, 0xc6, 0x39, 0x75, 0x0f, 0x2c, 0x8a, 0x81, 0xcd, 0xfd,
-	0xb6, 0xdd, 0xf3, 0xa9, 0x2f, 0xf2, 0x11, 0x2b, 0xd6, 0xfa, 0xed, 0x67, 0x15, 0xab, 0x06, 0x02,
-	0x62, 0x70, 0xea, 0xb7, 0xdf, 0x66, 0x81, 0x8a, 0xc0, 0x6a, 0x5e, 0xbc, 0x20, 0xb6, 0xcb, 0xb8,
-	0xb0, 0x03, 0x12, 0xda, 0x4e, 0x2c, 0xc8, 0x68, 0x73, 0xf5, 0x34, 0x81, 0x0f, 0x0b, 0x1a, 0x77,
-	0x61, 0x06, 0x5a, 0xce, 0xc4, 0x2e, 0xc8, 0x01, 0xe3, 0xe2, 0x84, 0x84, 0x56, 0x2c, 0x88, 0x7a,
-	0x06, 0xd6, 0xb3, 0x6e, 0xe7, 0x24, 0xf4, 0x3f, 0xc4, 0x12, 0x4f, 0xbc, 0x9d, 0xdd, 0xdd, 0xe6,
-	0x9e, 0xdc, 0xa9, 0xd5, 0x1a, 0x26, 0xb0, 0x76, 0xea, 0xb7, 0xdf, 0xe5, 0x88, 0x8c, 0xfa, 0xfa,
-	0x55, 0x5e, 0x4f, 0x13, 0xa8, 0xcb, 0x6e, 0xff, 0x10, 0x30, 0x50, 0x8d, 0x4f, 0xf0, 0x64, 0x5a,
-	0x8d, 0xc1, 0xc6, 0x5d, 0x06, 0x27, 0x6e, 0xb0, 0xb3, 0xfb, 0xbc, 0xdb, 0xd4, 0xee, 0xe5, 0x4d,
-	0x5f, 0x0c, 0x13, 0xb8, 0x36, 0xd1, 0xf4, 0xf4, 0x06, 0x91, 0x26, 0xb0, 0x3e, 0xbd, 0xed, 0x58,
-	0xc4, 0x40, 0x6b, 0x7c, 0x2a, 0xb7, 0x35, 0x9f, 0x6d, 0xf7, 0xd7, 0x25, 0x54, 0xac, 0x83, 0x6f,
-	0x43, 0x5d, 0xb9, 0x1a, 0xea, 0xca, 0xcf, 0xa1, 0xae, 0x7c, 0xbe, 0xd6, 0x4b, 0x57, 0xd7, 0x7a,
-	0xe9, 0xfb, 0xb5, 0x5e, 0x7a, 0xff, 0xe4, 0xbf, 0x17, 0xb3, 0xf8, 0xcb, 0x70, 0x66, 0xf3, 0xfb,
-	0xfe, 0xec, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc6, 0x00, 0xf0, 0x01, 0x49, 0x04, 0x00, 0x00,
+	// 763 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x41, 0x4f, 0xdb, 0x48,
+	0x14, 0x8e, 0x21, 0x1b, 0xc2, 0x04, 0x76, 0x17, 0x13, 0xc0, 0xc0, 0xca, 0x93, 0xf5, 0x4a, 0xbb,
+	0xac, 0x76, 0x49, 0x14, 0x56, 0xac, 0x44, 0x54, 0x55, 0xc5, 0x69, 0x7b, 0xa1, 0x41, 0xc8, 0x91,
+	0x7a, 0xe8, 0xc5, 0xb2, 0x9d, 0xa9, 0x63, 0x25, 0x13, 0x9b, 0x99, 0x31, 0x8a, 0xf9, 0x05, 0x55,
+	0x4f, 0x3d, 0xf6, 0x52, 0x89, 0x53, 0x7f, 0x40, 0x7f, 0x45, 0x8f, 0x1c, 0x7b, 0xb2, 0xaa, 0x70,
+	0xa9, 0x38, 0xfa, 0xd8, 0x53, 0x65, 0x4f, 0x48, 0x9c, 0x28, 0xa4, 0x97, 0x5e, 0x12, 0xcf, 0x7b,
+	0xef, 0xfb, 0xde, 0x97, 0x6f, 0xfc, 0x5e, 0x80, 0xd4, 0xaf, 0x18, 0x3e, 0x6b, 0x57, 0x58, 0xe0,
+	0x21, 0xca, 0x3f, 0xcb, 0x1e, 0x71, 0x99, 0x2b, 0x16, 0x2d, 0x97, 0x62, 0x97, 0xea, 0xb4, 0xd5,
+	0x29, 0xf7, 0xcb, 0x71, 0x51, 0xf9, 0xa2, 0xba, 0xf3, 0x27, 0x6b, 0x3b, 0xa4, 0xa5, 0x7b, 0x06,
+	0x61, 0x41, 0x25, 0x29, 0xac, 0xd8, 0xae, 0xed, 0x8e, 0x9f, 0x38, 0x5a, 0x79, 0xbd, 0x00, 0x0a,
+	0xaa, 0x41, 0xd1, 0xb1, 0x65, 0xb9, 0x7e, 0x8f, 0x89, 0x27, 0x60, 0xc9, 0x68, 0xb5, 0x08, 0xa2,
+	0x54, 0x12, 0x4a, 0xc2, 0xde, 0x8a, 0x5a, 0xfd, 0x1a, 0xc2, 0x7d, 0xdb, 0x61, 0x6d, 0xdf, 0x2c,
+	0x5b, 0x2e, 0xae, 0xf0, 0x6e, 0xc3, 0xaf, 0x7d, 0xda, 0xea, 0x0c, 0xc5, 0x1c, 0x5b, 0xd6, 0x31,
+	0x07, 0x6a, 0x77, 0x0c, 0xe2, 0x53, 0xb0, 0xe4, 0xf9, 0xa6, 0xde, 0x41, 0x81, 0xb4, 0x90, 0x90,
+	0xed, 0xdf, 0x86, 0xb0, 0xe8, 0xf9, 0x66, 0xd7, 0xb1, 0xe2, 0xe8, 0xbf, 0x2e, 0x76, 0x18, 0xc2,
+	0x1e, 0x0b, 0xa2, 0x10, 0xae, 0x05, 0x06, 0xee, 0xd6, 0x94, 0x71, 0x56, 0xd1, 0x72, 0x9e, 0x6f,
+	0x9e, 0xa0, 0x40, 0x7c, 0x04, 0x7e, 0x36, 0xb8, 0x3e, 0xbd, 0xe7, 0x63, 0x13, 0x11, 0x69, 0xb1,
+	0x24, 0xec, 0x65, 0xd5, 0xed, 0x28, 0x84, 0x1b, 0x1c, 0x36, 0x99, 0x57, 0xb4, 0xd5, 0x61, 0xe0,
+	0x34, 0x39, 0x8b, 0x3b, 0x20, 0x4f, 0xd1, 0xb9, 0x8f, 0x7a, 0x16, 0x92, 0xb2, 0x31, 0x56, 0x1b,
+	0x9d, 0x6b, 0xf9, 0x57, 0x57, 0x30, 0xf3, 0xf6, 0x0a, 0x66, 0x94, 0x0f, 0x02, 0x58, 0x6d, 0xb8,
+	0x2d, 0xbf, 0x3b, 0xb2, 0xc3, 0x00, 0x2b, 0xa6, 0x41, 0x91, 0x3e, 0x64, 0x4b, 0x3c, 0x29, 0x1c,
+	0xfc, 0x5e, 0x9e, 0xe5, 0x79, 0x39, 0xe5, 0xa3, 0xba, 0x7b, 0x1d, 0x42, 0x21, 0x0a, 0xe1, 0x3a,
+	0x97, 0x97, 0x26, 0x51, 0xb4, 0x82, 0x99, 0x72, 0x5c, 0x04, 0xd9, 0x9e, 0x81, 0x51, 0xe2, 0xd0,
+	0xb2, 0x96, 0x3c, 0x8b, 0x25, 0x50, 0xf0, 0x10, 0xc1, 0x0e, 0xa5, 0x8e, 0xdb, 0xa3, 0xd2, 0x62,
+	0x69, 0x71, 0x6f, 0x59, 0x4b, 0x87, 0x52, 0xa2, 0xdf, 0xe5, 0x40, 0xee, 0xcc, 0x20, 0x06, 0xa6,
+	0xe2, 0x29, 0x58, 0xc7, 0x46, 0x5f, 0xc7, 0x08, 0xbb, 0xba, 0xd5, 0x36, 0x88, 0x61, 0x31, 0x44,
+	0xf8, 0x45, 0x66, 0x55, 0x39, 0x0a, 0xe1, 0x0e, 0x57, 0x33, 0xa3, 0x48, 0xd1, 0xd6, 0xb0, 0xd1,
+	0x6f, 0x20, 0xec, 0xd6, 0x47, 0x31, 0xf1, 0x08, 0xac, 0xb0, 0xbe, 0x4e, 0x1d, 0x5b, 0xef, 0x3a,
+	0xd8, 0x61, 0x89, 0xc4, 0xac, 0xba, 0x35, 0xfe, 0x59, 0xe9, 0xac, 0xa2, 0x01, 0xd6, 0x6f, 0x3a,
+	0xf6, 0xb3, 0xf8, 0x20, 0x6a, 0x60, 0x23, 0x49, 0x5e, 0x22, 0xdd, 0x72, 0x29, 0xd3, 0x3d, 0x44,
+	0x74, 0x33, 0x60, 0x68, 0x78, 0x73, 0xa5, 0x28, 0x84, 0xbf, 0xa5, 0x38, 0xa6, 0xcb, 0x14, 0x6d,
+	0x2d, 0x26, 0xbb, 0x44, 0x75, 0x97, 0xb2, 0x33, 0x44, 0xd4, 0x80, 0x21, 0xf1, 0x1c, 0x6c, 0xc5,
+	0xdd, 0x2e, 0x10, 0x71, 0x5e, 0x06, 0xbc, 0x1e, 0xb5, 0x0e, 0x0e, 0x0f, 0xab, 0x47, 0xfc, 0x4e,
+	0xd5, 0xda, 0x20, 0x84, 0xc5, 0xa6, 0x63, 0x3f, 0x4f, 0x2a, 0x62, 0xe8, 0x93, 0xc7, 0x49, 0x3e,
+	0x0a, 0xa1, 0xcc, 0xbb, 0xdd, 0x43, 0xa0, 0x68, 0x45, 0x3a, 0x81, 0xe3, 0x61, 0x31, 0x00, 0xdb,
+	0xd3, 0x08, 0x8a, 0x2c, 0xef, 0xe0, 0xf0, 0xff, 0x4e, 0x55, 0xfa, 0x29, 0x69, 0xfa, 0x70, 0x10,
+	0xc2, 0xcd, 0x89, 0xa6, 0xcd, 0xbb, 0x8a, 0x28, 0x84, 0xa5, 0xd9, 0x6d, 0x47, 0x24, 0x8a, 0xb6,
+	0x49, 0x67, 0x62, 0x45, 0x02, 0x4a, 0xd3, 0x28, 0xec, 0x77, 0x99, 0x13, 0x07, 0xa9, 0x6f, 0xc6,
+	0xc6, 0x4b, 0xb9, 0x44, 0xc1, 0x3f, 0x51, 0x08, 0xff, 0x9a, 0xdd, 0x67, 0x1a, 0xa1, 0x68, 0xbb,
+	0x13, 0xed, 0x1a, 0xc3, 0x7c, 0xd3, 0x37, 0x9b, 0x8e, 0x2d, 0x36, 0xc0, 0xfa, 0x70, 0x60, 0xe3,
+	0x57, 0xa3, 0x67, 0xf3, 0x5b, 0x91, 0x96, 0xa6, 0x5f, 0xa0, 0x19, 0x45, 0x8a, 0xf6, 0x2b, 0x9f,
+	0xd6, 0x7a, 0x12, 0x8b, 0xc9, 0xe7, 0xb8, 0x47, 0xaa, 0x52, 0x7e, 0xbe, 0x7b, 0xe4, 0xfb, 0xee,
+	0x91, 0xfb, 0xdc, 0x23, 0xd5, 0x5a, 0x3e, 0x9e, 0x8d, 0x2f, 0x57, 0x50, 0x50, 0xde, 0x0b, 0xe0,
+	0x97, 0x06, 0xb5, 0xb9, 0xac, 0x33, 0xbe, 0x50, 0x7e, 0xe8, 0x96, 0x7b, 0x30, 0xbd, 0xe5, 0xfe,
+	0xb8, 0x0d, 0x21, 0x18, 0xef, 0xb1, 0xb9, 0xbb, 0x8d, 0x0f, 0x72, 0x2c, 0x54, 0xad, 0x7f, 0x1c,
+	0xc8, 0xc2, 0xf5, 0x40, 0x16, 0x3e, 0x0f, 0x64, 0xe1, 0xcd, 0x8d, 0x9c, 0xb9, 0xbe, 0x91, 0x33,
+	0x9f, 0x6e, 0xe4, 0xcc, 0x8b, 0xbf, 0xe7, 0x2a, 0x4b, 0xff, 0x33, 0x98, 0xb9, 0x64, 0xad, 0xff,
+	0xf7, 0x6d, 0x00, 0xc2, 0x3c, 0x56, 0xd8, 0x30, 0x06, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -267,6 +341,42 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.SigVerifyCostSecp256k1 != that1.SigVerifyCostSecp256k1 {
 		return false
 	}
+	if this.SigVerifyCostMultisigSubSig != that1.SigVerifyCostMultisigSubSig {
+		return false
+	}
+	if this.PubKeyChangeCost != that1.PubKeyChangeCost {
+		return false
+	}
+	if this.SigVerifyCostSecp256r1 != that1.SigVerifyCostSecp256r1 {
+		return false
+	}
+	return true
+}
+func (this *MsgChangePubKey) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgChangePubKey)
+	if !ok {
+		that2, ok := that.(MsgChangePubKey)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Address, that1.Address) {
+		return false
+	}
+	if !bytes.Equal(this.PubKey, that1.PubKey) {
+		return false
+	}
 	return true
 }
 func (m *BaseAccount) Marshal() (dAtA []byte, err error) {
@@ -387,6 +497,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SigVerifyCostSecp256r1 != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SigVerifyCostSecp256r1))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.PubKeyChangeCost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PubKeyChangeCost))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SigVerifyCostMultisigSubSig != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SigVerifyCostMultisigSubSig))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.SigVerifyCostSecp256k1 != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.SigVerifyCostSecp256k1))
 		i--
@@ -415,6 +540,43 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgChangePubKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangePubKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangePubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -493,6 +655,32 @@ func (m *Params) Size() (n int) {
 	if m.SigVerifyCostSecp256k1 != 0 {
 		n += 1 + sovTypes(uint64(m.SigVerifyCostSecp256k1))
 	}
+	if m.SigVerifyCostMultisigSubSig != 0 {
+		n += 1 + sovTypes(uint64(m.SigVerifyCostMultisigSubSig))
+	}
+	if m.PubKeyChangeCost != 0 {
+		n += 1 + sovTypes(uint64(m.PubKeyChangeCost))
+	}
+	if m.SigVerifyCostSecp256r1 != 0 {
+		n += 1 + sovTypes(uint64(m.SigVerifyCostSecp256r1))
+	}
+	return n
+}
+
+func (m *MsgChangePubKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -938,6 +1126,184 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostMultisigSubSig", wireType)
+			}
+			m.SigVerifyCostMultisigSubSig = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigVerifyCostMultisigSubSig |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyChangeCost", wireType)
+			}
+			m.PubKeyChangeCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PubKeyChangeCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostSecp256r1", wireType)
+			}
+			m.SigVerifyCostSecp256r1 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigVerifyCostSecp256r1 |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChangePubKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChangePubKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChangePubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
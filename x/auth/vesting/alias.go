@@ -7,22 +7,43 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 )
 
+const (
+	ModuleName                          = types.ModuleName
+	RouterKey                           = types.RouterKey
+	TypeMsgCreateVestingAccount         = types.TypeMsgCreateVestingAccount
+	TypeMsgCreateClawbackVestingAccount = types.TypeMsgCreateClawbackVestingAccount
+	TypeMsgClawback                     = types.TypeMsgClawback
+)
+
 var (
-	RegisterCodec                  = types.RegisterCodec
-	NewBaseVestingAccount          = types.NewBaseVestingAccount
-	NewContinuousVestingAccountRaw = types.NewContinuousVestingAccountRaw
-	NewContinuousVestingAccount    = types.NewContinuousVestingAccount
-	NewPeriodicVestingAccountRaw   = types.NewPeriodicVestingAccountRaw
-	NewPeriodicVestingAccount      = types.NewPeriodicVestingAccount
-	NewDelayedVestingAccountRaw    = types.NewDelayedVestingAccountRaw
-	NewDelayedVestingAccount       = types.NewDelayedVestingAccount
+	RegisterCodec                      = types.RegisterCodec
+	RegisterMsgCodec                   = types.RegisterMsgCodec
+	NewBaseVestingAccount              = types.NewBaseVestingAccount
+	NewContinuousVestingAccountRaw     = types.NewContinuousVestingAccountRaw
+	NewContinuousVestingAccount        = types.NewContinuousVestingAccount
+	NewPeriodicVestingAccountRaw       = types.NewPeriodicVestingAccountRaw
+	NewPeriodicVestingAccount          = types.NewPeriodicVestingAccount
+	NewDelayedVestingAccountRaw        = types.NewDelayedVestingAccountRaw
+	NewDelayedVestingAccount           = types.NewDelayedVestingAccount
+	NewClawbackVestingAccount          = types.NewClawbackVestingAccount
+	NewPeriod                          = types.NewPeriod
+	NewVestingSchedule                 = types.NewVestingSchedule
+	NewMsgCreateVestingAccount         = types.NewMsgCreateVestingAccount
+	NewMsgCreateClawbackVestingAccount = types.NewMsgCreateClawbackVestingAccount
+	NewMsgClawback                     = types.NewMsgClawback
+	ModuleCdc                          = types.ModuleCdc
 )
 
 type (
-	BaseVestingAccount       = types.BaseVestingAccount
-	ContinuousVestingAccount = types.ContinuousVestingAccount
-	PeriodicVestingAccount   = types.PeriodicVestingAccount
-	DelayedVestingAccount    = types.DelayedVestingAccount
-	Period                   = types.Period
-	Periods                  = types.Periods
+	BaseVestingAccount              = types.BaseVestingAccount
+	ContinuousVestingAccount        = types.ContinuousVestingAccount
+	PeriodicVestingAccount          = types.PeriodicVestingAccount
+	DelayedVestingAccount           = types.DelayedVestingAccount
+	ClawbackVestingAccount          = types.ClawbackVestingAccount
+	Period                          = types.Period
+	Periods                         = types.Periods
+	VestingSchedule                 = types.VestingSchedule
+	MsgCreateVestingAccount         = types.MsgCreateVestingAccount
+	MsgCreateClawbackVestingAccount = types.MsgCreateClawbackVestingAccount
+	MsgClawback                     = types.MsgClawback
 )
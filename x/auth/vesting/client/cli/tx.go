@@ -0,0 +1,217 @@
+package cli
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// Transaction command flags
+const (
+	FlagDelayed = "delayed"
+	FlagDest    = "dest"
+)
+
+// GetTxCmd returns the transaction commands for the vesting module.
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Vesting transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewMsgCreateVestingAccountCmd(cdc),
+		NewMsgCreateClawbackVestingAccountCmd(cdc),
+		NewMsgClawbackCmd(cdc),
+	)
+
+	return txCmd
+}
+
+// NewMsgCreateVestingAccountCmd returns a CLI command handler for creating a
+// MsgCreateVestingAccount transaction.
+func NewMsgCreateVestingAccountCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-vesting-account [to_address] [amount] [end_time]",
+		Short: "Create a new vesting account funded with an allocation of tokens.",
+		Long: `Create a new vesting account funded with an allocation of tokens. The
+account can either be a delayed or continuous vesting account, which is determined
+by the '--delayed' flag. All vesting accounts created will have their start time
+set by the committed block's time. The end_time must be provided as a UNIX epoch
+timestamp.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			toAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			endTime, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			delayed := viper.GetBool(FlagDelayed)
+
+			msg := types.NewMsgCreateVestingAccount(cliCtx.GetFromAddress(), toAddr, amount, endTime, delayed)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Bool(FlagDelayed, false, "Create a delayed vesting account if true")
+
+	return flags.PostCommands(cmd)[0]
+}
+
+// vestingPeriodInput defines a single vesting period as read from a vesting
+// periods file.
+type vestingPeriodInput struct {
+	Length int64  `json:"length"`
+	Amount string `json:"amount"`
+}
+
+// readVestingPeriods reads a JSON list of vesting periods, each having a length
+// in seconds and a comma-separated list of coins, from the given file.
+func readVestingPeriods(path string) (types.Periods, error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var inputs []vestingPeriodInput
+	if err := json.Unmarshal(bz, &inputs); err != nil {
+		return nil, err
+	}
+
+	periods := make(types.Periods, len(inputs))
+	for i, input := range inputs {
+		amount, err := sdk.ParseCoins(input.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse amount of vesting period %d: %w", i, err)
+		}
+
+		periods[i] = types.NewPeriod(input.Length, amount)
+	}
+
+	return periods, nil
+}
+
+// NewMsgCreateClawbackVestingAccountCmd returns a CLI command handler for
+// creating a MsgCreateClawbackVestingAccount transaction.
+func NewMsgCreateClawbackVestingAccountCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-clawback-vesting-account [to_address] [start_time] [periods_file]",
+		Short: "Create a new vesting account whose unvested tokens can be clawed back by the sender.",
+		Long: `Create a new clawback vesting account funded with the sum of all vesting
+period amounts. The start_time must be provided as a UNIX epoch timestamp and the
+periods file must contain a JSON list of vesting periods, e.g.:
+
+[{"length": 2592000, "amount": "1000stake"}, {"length": 2592000, "amount": "1000stake"}]
+
+The sender becomes the funder of the account and may claw back any unvested
+tokens at a later time.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			toAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			startTime, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			periods, err := readVestingPeriods(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateClawbackVestingAccount(cliCtx.GetFromAddress(), toAddr, startTime, periods)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return flags.PostCommands(cmd)[0]
+}
+
+// NewMsgClawbackCmd returns a CLI command handler for creating a MsgClawback
+// transaction.
+func NewMsgClawbackCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback [address]",
+		Short: "Claw back the unvested tokens of a clawback vesting account.",
+		Long: `Claw back all tokens of a clawback vesting account which have not yet
+vested. Only the funder of the account may claw back. Spendable tokens are taken
+first, followed by unbonding and bonded delegations, which are transferred as is.
+The tokens are sent to the funder unless a destination is given via '--dest'.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var dest sdk.AccAddress
+			if destStr := viper.GetString(FlagDest); destStr != "" {
+				dest, err = sdk.AccAddressFromBech32(destStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgClawback(cliCtx.GetFromAddress(), addr, dest)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(FlagDest, "", "Address to send the clawed back tokens to (defaults to the funder)")
+
+	return flags.PostCommands(cmd)[0]
+}
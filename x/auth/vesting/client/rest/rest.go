@@ -0,0 +1,14 @@
+package rest
+
+import (
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+)
+
+// RegisterRoutes registers the vesting module REST routes.
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc("/vesting/accounts/{address}", createVestingAccountHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/vesting/clawback_accounts/{address}", createClawbackVestingAccountHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/vesting/accounts/{address}/clawback", clawbackHandlerFn(cliCtx)).Methods("POST")
+}
@@ -0,0 +1,146 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// CreateVestingAccountReq defines the properties of a create vesting account
+// request's body.
+type CreateVestingAccountReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Amount  sdk.Coins    `json:"amount" yaml:"amount"`
+	EndTime int64        `json:"end_time" yaml:"end_time"`
+	Delayed bool         `json:"delayed" yaml:"delayed"`
+}
+
+// createVestingAccountHandlerFn returns an HTTP REST handler for generating a
+// MsgCreateVestingAccount transaction funding the vesting account at the given
+// address.
+func createVestingAccountHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		bech32Addr := vars["address"]
+
+		toAddr, err := sdk.AccAddressFromBech32(bech32Addr)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		var req CreateVestingAccountReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		msg := types.NewMsgCreateVestingAccount(fromAddr, toAddr, req.Amount, req.EndTime, req.Delayed)
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		authclient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// CreateClawbackVestingAccountReq defines the properties of a create clawback
+// vesting account request's body.
+type CreateClawbackVestingAccountReq struct {
+	BaseReq        rest.BaseReq  `json:"base_req" yaml:"base_req"`
+	StartTime      int64         `json:"start_time" yaml:"start_time"`
+	VestingPeriods types.Periods `json:"vesting_periods" yaml:"vesting_periods"`
+}
+
+// ClawbackReq defines the properties of a clawback request's body.
+type ClawbackReq struct {
+	BaseReq     rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	DestAddress sdk.AccAddress `json:"dest_address" yaml:"dest_address"`
+}
+
+// createClawbackVestingAccountHandlerFn returns an HTTP REST handler for
+// generating a MsgCreateClawbackVestingAccount transaction funding the vesting
+// account at the given address.
+func createClawbackVestingAccountHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		bech32Addr := vars["address"]
+
+		toAddr, err := sdk.AccAddressFromBech32(bech32Addr)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		var req CreateClawbackVestingAccountReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		msg := types.NewMsgCreateClawbackVestingAccount(fromAddr, toAddr, req.StartTime, req.VestingPeriods)
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		authclient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// clawbackHandlerFn returns an HTTP REST handler for generating a MsgClawback
+// transaction against the clawback vesting account at the given address.
+func clawbackHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		bech32Addr := vars["address"]
+
+		addr, err := sdk.AccAddressFromBech32(bech32Addr)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		var req ClawbackReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		funderAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		msg := types.NewMsgClawback(funderAddr, addr, req.DestAddress)
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		authclient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
@@ -0,0 +1,273 @@
+package vesting
+
+import (
+	"fmt"
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// NewHandler returns a handler for x/auth/vesting type messages.
+func NewHandler(ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case types.MsgCreateVestingAccount:
+			return handleMsgCreateVestingAccount(ctx, ak, bk, msg)
+
+		case types.MsgCreateClawbackVestingAccount:
+			return handleMsgCreateClawbackVestingAccount(ctx, ak, bk, msg)
+
+		case types.MsgClawback:
+			return handleMsgClawback(ctx, ak, bk, sk, msg)
+
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
+		}
+	}
+}
+
+func handleMsgCreateVestingAccount(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, msg types.MsgCreateVestingAccount) (*sdk.Result, error) {
+	if err := bk.IsSendEnabledCoins(ctx, msg.Amount...); err != nil {
+		return nil, err
+	}
+
+	if bk.BlockedAddr(msg.ToAddress) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive transactions", msg.ToAddress)
+	}
+
+	if acc := ak.GetAccount(ctx, msg.ToAddress); acc != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
+	}
+
+	baseAccount, ok := ak.NewAccountWithAddress(ctx, msg.ToAddress).(*authtypes.BaseAccount)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid account type; expected: BaseAccount")
+	}
+
+	baseVestingAccount := types.NewBaseVestingAccount(baseAccount, msg.Amount.Sort(), msg.EndTime)
+
+	var acc authexported.Account
+	if msg.Delayed {
+		acc = types.NewDelayedVestingAccountRaw(baseVestingAccount)
+	} else {
+		acc = types.NewContinuousVestingAccountRaw(baseVestingAccount, ctx.BlockTime().Unix())
+	}
+
+	if err := acc.(authexported.GenesisAccount).Validate(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ak.SetAccount(ctx, acc)
+
+	if err := bk.SendCoins(ctx, msg.FromAddress, msg.ToAddress, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateVestingAccount,
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.ToAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyEndTime, fmt.Sprintf("%d", msg.EndTime)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgCreateClawbackVestingAccount(
+	ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, msg types.MsgCreateClawbackVestingAccount,
+) (*sdk.Result, error) {
+	periods := types.Periods(msg.VestingPeriods)
+	amount := periods.TotalAmount()
+
+	if err := bk.IsSendEnabledCoins(ctx, amount...); err != nil {
+		return nil, err
+	}
+
+	if bk.BlockedAddr(msg.ToAddress) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive transactions", msg.ToAddress)
+	}
+
+	if acc := ak.GetAccount(ctx, msg.ToAddress); acc != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
+	}
+
+	baseAccount, ok := ak.NewAccountWithAddress(ctx, msg.ToAddress).(*authtypes.BaseAccount)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid account type; expected: BaseAccount")
+	}
+
+	acc := types.NewClawbackVestingAccount(baseAccount, msg.FromAddress, amount, msg.StartTime, periods)
+
+	if err := acc.Validate(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ak.SetAccount(ctx, acc)
+
+	if err := bk.SendCoins(ctx, msg.FromAddress, msg.ToAddress, amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateClawbackVestingAccount,
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.ToAddress.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyEndTime, fmt.Sprintf("%d", acc.EndTime)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgClawback(
+	ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper, msg types.MsgClawback,
+) (*sdk.Result, error) {
+	acc := ak.GetAccount(ctx, msg.Address)
+	if acc == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", msg.Address)
+	}
+
+	va, ok := acc.(*types.ClawbackVestingAccount)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s is not a clawback vesting account", msg.Address)
+	}
+
+	if !va.FunderAddress.Equals(msg.FunderAddress) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "clawback can only be requested by the funder %s", va.FunderAddress)
+	}
+
+	dest := msg.DestAddress
+	if dest.Empty() {
+		dest = msg.FunderAddress
+	}
+
+	if bk.BlockedAddr(dest) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive transactions", dest)
+	}
+
+	// Truncate the vesting schedule first so that none of the account's coins
+	// are locked while the unvested amount is moved out.
+	unvested := va.Clawback(ctx.BlockTime())
+	ak.SetAccount(ctx, va)
+
+	clawedBack, err := transferUnvested(ctx, ak, bk, sk, msg.Address, dest, unvested)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeClawback,
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.Address.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, dest.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, clawedBack.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FunderAddress.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// transferUnvested moves up to amt coins from the clawed back account to the
+// destination address and returns the amount actually moved. Spendable
+// balances are taken first, followed by unbonding delegations and finally
+// bonded delegations of the bond denomination. Delegations are transferred as
+// is rather than unbonded, so the destination takes over the stake.
+func transferUnvested(
+	ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper,
+	addr, dest sdk.AccAddress, amt sdk.Coins,
+) (sdk.Coins, error) {
+	balance := bk.GetAllBalances(ctx, addr)
+
+	fromBalance := sdk.NewCoins()
+	for _, coin := range amt {
+		if spendable := sdk.MinInt(coin.Amount, balance.AmountOf(coin.Denom)); spendable.IsPositive() {
+			fromBalance = fromBalance.Add(sdk.NewCoin(coin.Denom, spendable))
+		}
+	}
+
+	if !fromBalance.IsZero() {
+		if err := bk.SendCoins(ctx, addr, dest, fromBalance); err != nil {
+			return nil, err
+		}
+	}
+
+	bondDenom := sk.BondDenom(ctx)
+	wantAmt := amt.AmountOf(bondDenom).Sub(fromBalance.AmountOf(bondDenom))
+	stakeTransferred := sdk.ZeroInt()
+
+	for _, ubd := range sk.GetUnbondingDelegations(ctx, addr, math.MaxUint16) {
+		if !wantAmt.IsPositive() {
+			break
+		}
+
+		transferred, err := sk.TransferUnbonding(ctx, addr, dest, ubd.ValidatorAddress, wantAmt)
+		if err != nil {
+			return nil, err
+		}
+
+		wantAmt = wantAmt.Sub(transferred)
+		stakeTransferred = stakeTransferred.Add(transferred)
+	}
+
+	for _, delegation := range sk.GetDelegatorDelegations(ctx, addr, math.MaxUint16) {
+		if !wantAmt.IsPositive() {
+			break
+		}
+
+		validator, found := sk.GetValidator(ctx, delegation.ValidatorAddress)
+		if !found {
+			continue
+		}
+
+		wantShares, err := validator.SharesFromTokens(wantAmt)
+		if err != nil {
+			// the validator has no tokens left to recover
+			continue
+		}
+
+		shares, err := sk.TransferDelegation(ctx, addr, dest, delegation.ValidatorAddress, wantShares)
+		if err != nil {
+			return nil, err
+		}
+
+		transferred := validator.TokensFromShares(shares).TruncateInt()
+		wantAmt = wantAmt.Sub(transferred)
+		stakeTransferred = stakeTransferred.Add(transferred)
+	}
+
+	if !stakeTransferred.IsPositive() {
+		return fromBalance, nil
+	}
+
+	// the transferred stake no longer counts towards the account's delegations
+	stake := sdk.NewCoins(sdk.NewCoin(bondDenom, stakeTransferred))
+	if va, ok := ak.GetAccount(ctx, addr).(*types.ClawbackVestingAccount); ok {
+		va.TrackUndelegation(stake)
+		ak.SetAccount(ctx, va)
+	}
+
+	return fromBalance.Add(stake...), nil
+}
@@ -0,0 +1,182 @@
+package vesting_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+func TestInvalidMsg(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	handler := vesting.NewHandler(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
+
+	res, err := handler(ctx, sdk.NewTestMsg())
+	require.Error(t, err)
+	require.Nil(t, res)
+}
+
+func TestHandleMsgCreateVestingAccount(t *testing.T) {
+	app := simapp.Setup(false)
+	now := time.Now()
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: now})
+	handler := vesting.NewHandler(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
+
+	balances := sdk.NewCoins(sdk.NewInt64Coin("test", 1000))
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(0))
+	addr1 := addrs[0]
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, addr1, balances))
+
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	addr3 := sdk.AccAddress([]byte("addr3_______________"))
+	addr4 := sdk.AccAddress([]byte("addr4_______________"))
+	endTime := now.Add(24 * time.Hour).Unix()
+
+	testCases := []struct {
+		name      string
+		msg       vesting.MsgCreateVestingAccount
+		expectErr bool
+	}{
+		{
+			name:      "create delayed vesting account",
+			msg:       vesting.NewMsgCreateVestingAccount(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("test", 100)), endTime, true),
+			expectErr: false,
+		},
+		{
+			name:      "create continuous vesting account",
+			msg:       vesting.NewMsgCreateVestingAccount(addr1, addr3, sdk.NewCoins(sdk.NewInt64Coin("test", 100)), endTime, false),
+			expectErr: false,
+		},
+		{
+			name:      "continuous vesting account already exists",
+			msg:       vesting.NewMsgCreateVestingAccount(addr1, addr3, sdk.NewCoins(sdk.NewInt64Coin("test", 100)), endTime, false),
+			expectErr: true,
+		},
+		{
+			name:      "continuous vesting account ending in the past",
+			msg:       vesting.NewMsgCreateVestingAccount(addr1, addr4, sdk.NewCoins(sdk.NewInt64Coin("test", 100)), now.Add(-time.Hour).Unix(), false),
+			expectErr: true,
+		},
+		{
+			name:      "insufficient funds",
+			msg:       vesting.NewMsgCreateVestingAccount(addr1, addr4, sdk.NewCoins(sdk.NewInt64Coin("test", 10000)), endTime, false),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			cacheCtx, _ := ctx.CacheContext()
+			if !tc.expectErr {
+				cacheCtx = ctx
+			}
+
+			res, err := handler(cacheCtx, tc.msg)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, res)
+
+			accI := app.AccountKeeper.GetAccount(ctx, tc.msg.ToAddress)
+			require.NotNil(t, accI)
+
+			if tc.msg.Delayed {
+				acc, ok := accI.(*vesting.DelayedVestingAccount)
+				require.True(t, ok)
+				require.Equal(t, tc.msg.Amount, acc.GetVestingCoins(now))
+			} else {
+				acc, ok := accI.(*vesting.ContinuousVestingAccount)
+				require.True(t, ok)
+				require.Equal(t, now.Unix(), acc.GetStartTime())
+				require.Equal(t, tc.msg.Amount, acc.GetVestingCoins(now))
+			}
+
+			require.Equal(t, tc.msg.Amount, app.BankKeeper.GetAllBalances(ctx, tc.msg.ToAddress))
+		})
+	}
+}
+
+func TestHandleMsgClawback(t *testing.T) {
+	app := simapp.Setup(false)
+	now := time.Now()
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: now})
+	handler := vesting.NewHandler(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
+	stakingHandler := staking.NewHandler(app.StakingKeeper)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(10000))
+	funder, other, valAcc := addrs[0], addrs[1], addrs[2]
+	grantee := sdk.AccAddress([]byte("grantee_____________"))
+
+	// create a validator to delegate vesting coins to
+	valAddr := sdk.ValAddress(valAcc)
+	pks := simapp.CreateTestPubKeys(1)
+	commission := staking.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	_, err := stakingHandler(ctx, staking.NewMsgCreateValidator(
+		valAddr, pks[0], sdk.NewInt64Coin(bondDenom, 1000), staking.Description{}, commission, sdk.OneInt(),
+	))
+	require.NoError(t, err)
+
+	// create a clawback vesting account vesting 500 in each of two hours
+	periods := vesting.Periods{
+		vesting.NewPeriod(3600, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 500))),
+		vesting.NewPeriod(3600, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 500))),
+	}
+	_, err = handler(ctx, vesting.NewMsgCreateClawbackVestingAccount(funder, grantee, now.Unix(), periods))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1000)), app.BankKeeper.GetAllBalances(ctx, grantee))
+
+	// the recipient account must not already exist
+	_, err = handler(ctx, vesting.NewMsgCreateClawbackVestingAccount(funder, grantee, now.Unix(), periods))
+	require.Error(t, err)
+
+	// delegate most of the vesting coins
+	_, err = stakingHandler(ctx, staking.NewMsgDelegate(grantee, valAddr, sdk.NewInt64Coin(bondDenom, 800)))
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockTime(now.Add(90 * time.Minute))
+
+	// only the funder may claw back
+	_, err = handler(ctx, vesting.NewMsgClawback(other, grantee, nil))
+	require.Error(t, err)
+
+	// only clawback vesting accounts may be clawed back
+	_, err = handler(ctx, vesting.NewMsgClawback(funder, other, nil))
+	require.Error(t, err)
+
+	_, err = handler(ctx, vesting.NewMsgClawback(funder, grantee, nil))
+	require.NoError(t, err)
+
+	// the unvested 500 come from the spendable balance first, then the delegation
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 9200)), app.BankKeeper.GetAllBalances(ctx, funder))
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, grantee).IsZero())
+
+	funderDel, found := app.StakingKeeper.GetDelegation(ctx, funder, valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(300), funderDel.Shares)
+
+	granteeDel, found := app.StakingKeeper.GetDelegation(ctx, grantee, valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(500), granteeDel.Shares)
+
+	// the account is left fully vested with only the vested coins delegated
+	acc, ok := app.AccountKeeper.GetAccount(ctx, grantee).(*vesting.ClawbackVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 500)), acc.GetOriginalVesting())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 500)), acc.GetDelegatedFree())
+	require.True(t, acc.GetDelegatedVesting().IsZero())
+	require.True(t, acc.GetVestingCoins(ctx.BlockTime()).IsZero())
+	require.Equal(t, now.Add(time.Hour).Unix(), acc.GetEndTime())
+}
@@ -5,12 +5,39 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 )
 
-// RegisterCodec registers the vesting interfaces and concrete types on the
-// provided Amino codec.
+// RegisterCodec registers the vesting account interface and concrete types on
+// the provided Amino codec.
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterInterface((*exported.VestingAccount)(nil), nil)
 	cdc.RegisterConcrete(&BaseVestingAccount{}, "cosmos-sdk/BaseVestingAccount", nil)
 	cdc.RegisterConcrete(&ContinuousVestingAccount{}, "cosmos-sdk/ContinuousVestingAccount", nil)
 	cdc.RegisterConcrete(&DelayedVestingAccount{}, "cosmos-sdk/DelayedVestingAccount", nil)
 	cdc.RegisterConcrete(&PeriodicVestingAccount{}, "cosmos-sdk/PeriodicVestingAccount", nil)
+	cdc.RegisterConcrete(&ClawbackVestingAccount{}, "cosmos-sdk/ClawbackVestingAccount", nil)
+}
+
+// RegisterMsgCodec registers the vesting messages on the provided Amino codec.
+func RegisterMsgCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgCreateVestingAccount{}, "cosmos-sdk/MsgCreateVestingAccount", nil)
+	cdc.RegisterConcrete(MsgCreateClawbackVestingAccount{}, "cosmos-sdk/MsgCreateClawbackVestingAccount", nil)
+	cdc.RegisterConcrete(MsgClawback{}, "cosmos-sdk/MsgClawback", nil)
+}
+
+var (
+	amino = codec.New()
+
+	// ModuleCdc references the global x/auth/vesting module codec. Note, the
+	// codec should ONLY be used in certain instances of tests and for JSON
+	// encoding as Amino is still used for that purpose.
+	//
+	// The actual codec used for serialization should be provided to x/auth/vesting
+	// and defined at the application level.
+	ModuleCdc = codec.NewHybridCodec(amino)
+)
+
+func init() {
+	RegisterCodec(amino)
+	RegisterMsgCodec(amino)
+	codec.RegisterCrypto(amino)
+	amino.Seal()
 }
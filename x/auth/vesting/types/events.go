@@ -0,0 +1,14 @@
+package types
+
+// vesting module event types
+const (
+	EventTypeCreateVestingAccount         = "create_vesting_account"
+	EventTypeCreateClawbackVestingAccount = "create_clawback_vesting_account"
+	EventTypeClawback                     = "clawback"
+
+	AttributeKeyRecipient = "recipient"
+	AttributeKeyEndTime   = "end_time"
+	AttributeKeyAccount   = "account"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,114 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+func TestMsgCreateVestingAccountRoute(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("from"))
+	addr2 := sdk.AccAddress([]byte("to"))
+	coins := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+	msg := types.NewMsgCreateVestingAccount(addr1, addr2, coins, 100000, false)
+
+	require.Equal(t, types.RouterKey, msg.Route())
+	require.Equal(t, types.TypeMsgCreateVestingAccount, msg.Type())
+	require.Equal(t, []sdk.AccAddress{addr1}, msg.GetSigners())
+	require.NotEmpty(t, msg.GetSignBytes())
+}
+
+func TestMsgCreateVestingAccountValidation(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("from"))
+	addr2 := sdk.AccAddress([]byte("to"))
+	atom123 := sdk.NewCoins(sdk.NewInt64Coin("atom", 123))
+	atom0 := sdk.Coins{sdk.NewInt64Coin("atom", 0)}
+
+	var emptyAddr sdk.AccAddress
+
+	cases := []struct {
+		name  string
+		msg   types.MsgCreateVestingAccount
+		valid bool
+	}{
+		{"valid continuous", types.NewMsgCreateVestingAccount(addr1, addr2, atom123, 100000, false), true},
+		{"valid delayed", types.NewMsgCreateVestingAccount(addr1, addr2, atom123, 100000, true), true},
+		{"non positive coins", types.NewMsgCreateVestingAccount(addr1, addr2, atom0, 100000, false), false},
+		{"empty coins", types.NewMsgCreateVestingAccount(addr1, addr2, sdk.Coins{}, 100000, false), false},
+		{"empty from address", types.NewMsgCreateVestingAccount(emptyAddr, addr2, atom123, 100000, false), false},
+		{"empty to address", types.NewMsgCreateVestingAccount(addr1, emptyAddr, atom123, 100000, false), false},
+		{"invalid end time", types.NewMsgCreateVestingAccount(addr1, addr2, atom123, 0, false), false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgCreateClawbackVestingAccountValidation(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("from"))
+	addr2 := sdk.AccAddress([]byte("to"))
+	atom123 := sdk.NewCoins(sdk.NewInt64Coin("atom", 123))
+	periods := types.Periods{types.NewPeriod(3600, atom123), types.NewPeriod(3600, atom123)}
+
+	var emptyAddr sdk.AccAddress
+
+	cases := []struct {
+		name  string
+		msg   types.MsgCreateClawbackVestingAccount
+		valid bool
+	}{
+		{"valid", types.NewMsgCreateClawbackVestingAccount(addr1, addr2, 100000, periods), true},
+		{"empty from address", types.NewMsgCreateClawbackVestingAccount(emptyAddr, addr2, 100000, periods), false},
+		{"empty to address", types.NewMsgCreateClawbackVestingAccount(addr1, emptyAddr, 100000, periods), false},
+		{"invalid start time", types.NewMsgCreateClawbackVestingAccount(addr1, addr2, 0, periods), false},
+		{"no periods", types.NewMsgCreateClawbackVestingAccount(addr1, addr2, 100000, types.Periods{}), false},
+		{"zero length period", types.NewMsgCreateClawbackVestingAccount(addr1, addr2, 100000, types.Periods{types.NewPeriod(0, atom123)}), true},
+		{"negative length period", types.NewMsgCreateClawbackVestingAccount(addr1, addr2, 100000, types.Periods{types.NewPeriod(-1, atom123)}), false},
+		{"invalid period coins", types.NewMsgCreateClawbackVestingAccount(addr1, addr2, 100000, types.Periods{types.NewPeriod(3600, sdk.Coins{sdk.NewInt64Coin("atom", 0)})}), false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgClawback(t *testing.T) {
+	funder := sdk.AccAddress([]byte("funder"))
+	addr := sdk.AccAddress([]byte("addr"))
+	dest := sdk.AccAddress([]byte("dest"))
+
+	msg := types.NewMsgClawback(funder, addr, dest)
+	require.Equal(t, types.RouterKey, msg.Route())
+	require.Equal(t, types.TypeMsgClawback, msg.Type())
+	require.Equal(t, []sdk.AccAddress{funder}, msg.GetSigners())
+	require.NotEmpty(t, msg.GetSignBytes())
+
+	var emptyAddr sdk.AccAddress
+
+	require.NoError(t, msg.ValidateBasic())
+	require.NoError(t, types.NewMsgClawback(funder, addr, emptyAddr).ValidateBasic())
+	require.Error(t, types.NewMsgClawback(emptyAddr, addr, dest).ValidateBasic())
+	require.Error(t, types.NewMsgClawback(funder, emptyAddr, dest).ValidateBasic())
+}
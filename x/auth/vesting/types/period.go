@@ -5,20 +5,60 @@ import (
 	"strings"
 
 	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Periods stores all vesting periods passed as part of a PeriodicVestingAccount
 type Periods []Period
 
+// NewPeriod returns a new Period with the given length (in seconds) and amount.
+func NewPeriod(length int64, amount sdk.Coins) Period {
+	return Period{Length: length, Amount: amount}
+}
+
+// Validate performs basic validation of a vesting period. A period may have a
+// zero length, vesting its amount at the same time as the previous period.
+func (p Period) Validate() error {
+	if p.Length < 0 {
+		return fmt.Errorf("vesting period length cannot be negative: %d", p.Length)
+	}
+	if !p.Amount.IsValid() {
+		return fmt.Errorf("invalid vesting period amount: %s", p.Amount)
+	}
+
+	return nil
+}
+
 // String Period implements stringer interface
 func (p Period) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
 
+// TotalLength returns the summed length of all vesting periods.
+func (vp Periods) TotalLength() int64 {
+	var total int64
+	for _, period := range vp {
+		total += period.Length
+	}
+
+	return total
+}
+
+// TotalAmount returns the sum of coins of all vesting periods.
+func (vp Periods) TotalAmount() sdk.Coins {
+	total := sdk.NewCoins()
+	for _, period := range vp {
+		total = total.Add(period.Amount...)
+	}
+
+	return total
+}
+
 // String Periods implements stringer interface
 func (vp Periods) String() string {
-	periodsListString := make([]string, len(vp))
+	periodsListString := make([]string, 0, len(vp))
 	for _, period := range vp {
 		periodsListString = append(periodsListString, period.String())
 	}
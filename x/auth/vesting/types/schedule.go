@@ -0,0 +1,53 @@
+package types
+
+import (
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+)
+
+// VestingSchedule defines a read-only view of a vesting account's schedule as
+// of a given block time. It is returned by the vesting schedule query.
+type VestingSchedule struct {
+	Address          sdk.AccAddress `json:"address" yaml:"address"`
+	StartTime        int64          `json:"start_time" yaml:"start_time"`
+	EndTime          int64          `json:"end_time" yaml:"end_time"`
+	OriginalVesting  sdk.Coins      `json:"original_vesting" yaml:"original_vesting"`
+	VestedCoins      sdk.Coins      `json:"vested_coins" yaml:"vested_coins"`
+	VestingCoins     sdk.Coins      `json:"vesting_coins" yaml:"vesting_coins"`
+	RemainingPeriods Periods        `json:"remaining_periods" yaml:"remaining_periods"`
+}
+
+// NewVestingSchedule builds a VestingSchedule for the given vesting account at
+// the provided block time. Remaining periods are only populated for periodic
+// and clawback vesting accounts.
+func NewVestingSchedule(acc vestexported.VestingAccount, blockTime time.Time) VestingSchedule {
+	schedule := VestingSchedule{
+		Address:          acc.GetAddress(),
+		StartTime:        acc.GetStartTime(),
+		EndTime:          acc.GetEndTime(),
+		OriginalVesting:  acc.GetOriginalVesting(),
+		VestedCoins:      acc.GetVestedCoins(blockTime),
+		VestingCoins:     acc.GetVestingCoins(blockTime),
+		RemainingPeriods: Periods{},
+	}
+
+	switch va := acc.(type) {
+	case *PeriodicVestingAccount:
+		schedule.RemainingPeriods = va.GetRemainingPeriods(blockTime)
+
+	case *ClawbackVestingAccount:
+		schedule.RemainingPeriods = va.GetRemainingPeriods(blockTime)
+	}
+
+	return schedule
+}
+
+// String implements the Stringer interface.
+func (vs VestingSchedule) String() string {
+	out, _ := yaml.Marshal(vs)
+	return string(out)
+}
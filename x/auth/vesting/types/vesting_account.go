@@ -20,6 +20,7 @@ var (
 	_ vestexported.VestingAccount = (*ContinuousVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*PeriodicVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*DelayedVestingAccount)(nil)
+	_ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
 )
 
 //-----------------------------------------------------------------------------
@@ -175,8 +176,9 @@ type vestingAccountYAML struct {
 	EndTime          int64          `json:"end_time" yaml:"end_time"`
 
 	// custom fields based on concrete vesting type which can be omitted
-	StartTime      int64   `json:"start_time,omitempty" yaml:"start_time,omitempty"`
-	VestingPeriods Periods `json:"vesting_periods,omitempty" yaml:"vesting_periods,omitempty"`
+	StartTime      int64          `json:"start_time,omitempty" yaml:"start_time,omitempty"`
+	VestingPeriods Periods        `json:"vesting_periods,omitempty" yaml:"vesting_periods,omitempty"`
+	FunderAddress  sdk.AccAddress `json:"funder_address,omitempty" yaml:"funder_address,omitempty"`
 }
 
 type vestingAccountJSON struct {
@@ -190,8 +192,9 @@ type vestingAccountJSON struct {
 	EndTime          int64          `json:"end_time" yaml:"end_time"`
 
 	// custom fields based on concrete vesting type which can be omitted
-	StartTime      int64   `json:"start_time,omitempty" yaml:"start_time,omitempty"`
-	VestingPeriods Periods `json:"vesting_periods,omitempty" yaml:"vesting_periods,omitempty"`
+	StartTime      int64          `json:"start_time,omitempty" yaml:"start_time,omitempty"`
+	VestingPeriods Periods        `json:"vesting_periods,omitempty" yaml:"vesting_periods,omitempty"`
+	FunderAddress  sdk.AccAddress `json:"funder_address,omitempty" yaml:"funder_address,omitempty"`
 }
 
 func (bva BaseVestingAccount) String() string {
@@ -438,14 +441,10 @@ func NewPeriodicVestingAccountRaw(bva *BaseVestingAccount, startTime int64, peri
 
 // NewPeriodicVestingAccount returns a new PeriodicVestingAccount
 func NewPeriodicVestingAccount(baseAcc *authtypes.BaseAccount, originalVesting sdk.Coins, startTime int64, periods Periods) *PeriodicVestingAccount {
-	endTime := startTime
-	for _, p := range periods {
-		endTime += p.Length
-	}
 	baseVestingAcc := &BaseVestingAccount{
 		BaseAccount:     baseAcc,
 		OriginalVesting: originalVesting,
-		EndTime:         endTime,
+		EndTime:         startTime + periods.TotalLength(),
 	}
 
 	return &PeriodicVestingAccount{
@@ -517,21 +516,37 @@ func (pva PeriodicVestingAccount) GetVestingPeriods() Periods {
 	return pva.VestingPeriods
 }
 
+// GetRemainingPeriods returns the vesting periods that have not fully elapsed
+// at the given block time. A period that is currently in progress is included
+// in full.
+func (pva PeriodicVestingAccount) GetRemainingPeriods(blockTime time.Time) Periods {
+	remaining := Periods{}
+
+	periodEndTime := pva.StartTime
+	for _, period := range pva.VestingPeriods {
+		periodEndTime += period.Length
+		if blockTime.Unix() < periodEndTime {
+			remaining = append(remaining, period)
+		}
+	}
+
+	return remaining
+}
+
 // Validate checks for errors on the account fields
 func (pva PeriodicVestingAccount) Validate() error {
 	if pva.GetStartTime() >= pva.GetEndTime() {
 		return errors.New("vesting start-time cannot be before end-time")
 	}
-	endTime := pva.StartTime
-	originalVesting := sdk.NewCoins()
 	for _, p := range pva.VestingPeriods {
-		endTime += p.Length
-		originalVesting = originalVesting.Add(p.Amount...)
+		if err := p.Validate(); err != nil {
+			return err
+		}
 	}
-	if endTime != pva.EndTime {
+	if pva.StartTime+Periods(pva.VestingPeriods).TotalLength() != pva.EndTime {
 		return errors.New("vesting end time does not match length of all vesting periods")
 	}
-	if !originalVesting.IsEqual(pva.OriginalVesting) {
+	if !Periods(pva.VestingPeriods).TotalAmount().IsEqual(pva.OriginalVesting) {
 		return errors.New("original vesting coins does not match the sum of all coins in vesting periods")
 	}
 
@@ -613,6 +628,221 @@ func (pva *PeriodicVestingAccount) UnmarshalJSON(bz []byte) error {
 	return nil
 }
 
+//-----------------------------------------------------------------------------
+// Clawback Vesting Account
+
+var _ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
+var _ authexported.GenesisAccount = (*ClawbackVestingAccount)(nil)
+
+// NewClawbackVestingAccount returns a new ClawbackVestingAccount which vests
+// according to the given periods and whose unvested coins may be clawed back
+// by the funder.
+func NewClawbackVestingAccount(
+	baseAcc *authtypes.BaseAccount, funder sdk.AccAddress, originalVesting sdk.Coins, startTime int64, periods Periods,
+) *ClawbackVestingAccount {
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		OriginalVesting: originalVesting,
+		EndTime:         startTime + periods.TotalLength(),
+	}
+
+	return &ClawbackVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+		FunderAddress:      funder,
+		StartTime:          startTime,
+		VestingPeriods:     periods,
+	}
+}
+
+// periodic returns a PeriodicVestingAccount view of the account, which shares
+// the same vesting schedule semantics.
+func (va ClawbackVestingAccount) periodic() PeriodicVestingAccount {
+	return PeriodicVestingAccount{
+		BaseVestingAccount: va.BaseVestingAccount,
+		StartTime:          va.StartTime,
+		VestingPeriods:     va.VestingPeriods,
+	}
+}
+
+// GetVestedCoins returns the total number of vested coins. If no coins are vested,
+// nil is returned.
+func (va ClawbackVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	return va.periodic().GetVestedCoins(blockTime)
+}
+
+// GetVestingCoins returns the total number of vesting coins. If no coins are
+// vesting, nil is returned.
+func (va ClawbackVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return va.OriginalVesting.Sub(va.GetVestedCoins(blockTime))
+}
+
+// LockedCoins returns the set of coins that are not spendable (i.e. locked).
+func (va ClawbackVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return va.BaseVestingAccount.LockedCoinsFromVesting(va.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (va *ClawbackVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) {
+	va.BaseVestingAccount.TrackDelegation(balance, va.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the time when vesting starts for a clawback vesting
+// account.
+func (va ClawbackVestingAccount) GetStartTime() int64 {
+	return va.StartTime
+}
+
+// GetVestingPeriods returns vesting periods associated with clawback vesting account.
+func (va ClawbackVestingAccount) GetVestingPeriods() Periods {
+	return va.VestingPeriods
+}
+
+// GetRemainingPeriods returns the vesting periods that have not fully elapsed
+// at the given block time. A period that is currently in progress is included
+// in full.
+func (va ClawbackVestingAccount) GetRemainingPeriods(blockTime time.Time) Periods {
+	return va.periodic().GetRemainingPeriods(blockTime)
+}
+
+// GetFunderAddress returns the address of the account that funded the vesting
+// account and which may claw back unvested coins.
+func (va ClawbackVestingAccount) GetFunderAddress() sdk.AccAddress {
+	return va.FunderAddress
+}
+
+// Clawback truncates the vesting schedule at the given block time so that only
+// the periods which have already vested remain, making the account fully
+// vested. All delegated coins are considered free afterwards. It returns the
+// coins which were still vesting and should be recovered by the funder.
+//
+// NOTE: Clawback does not move any coins; it is the caller's responsibility
+// to transfer the returned amount out of the account.
+func (va *ClawbackVestingAccount) Clawback(blockTime time.Time) sdk.Coins {
+	unvested := va.GetVestingCoins(blockTime)
+
+	vestedPeriods := Periods{}
+	endTime := va.StartTime
+	for _, period := range va.VestingPeriods {
+		if blockTime.Unix()-endTime < period.Length {
+			break
+		}
+
+		vestedPeriods = append(vestedPeriods, period)
+		endTime += period.Length
+	}
+
+	va.VestingPeriods = vestedPeriods
+	va.OriginalVesting = vestedPeriods.TotalAmount()
+	va.EndTime = endTime
+	va.DelegatedFree = va.DelegatedFree.Add(va.DelegatedVesting...)
+	va.DelegatedVesting = sdk.NewCoins()
+
+	return unvested
+}
+
+// Validate checks for errors on the account fields
+func (va ClawbackVestingAccount) Validate() error {
+	if va.FunderAddress.Empty() {
+		return errors.New("funder address cannot be empty")
+	}
+	if va.GetStartTime() > va.GetEndTime() {
+		return errors.New("vesting start-time cannot be after end-time")
+	}
+	for _, p := range va.VestingPeriods {
+		if err := p.Validate(); err != nil {
+			return err
+		}
+	}
+	if va.StartTime+Periods(va.VestingPeriods).TotalLength() != va.EndTime {
+		return errors.New("vesting end time does not match length of all vesting periods")
+	}
+	if !Periods(va.VestingPeriods).TotalAmount().IsEqual(va.OriginalVesting) {
+		return errors.New("original vesting coins does not match the sum of all coins in vesting periods")
+	}
+
+	return va.BaseVestingAccount.Validate()
+}
+
+func (va ClawbackVestingAccount) String() string {
+	out, _ := va.MarshalYAML()
+	return out.(string)
+}
+
+// MarshalYAML returns the YAML representation of a ClawbackVestingAccount.
+func (va ClawbackVestingAccount) MarshalYAML() (interface{}, error) {
+	alias := vestingAccountYAML{
+		Address:          va.Address,
+		AccountNumber:    va.AccountNumber,
+		Sequence:         va.Sequence,
+		OriginalVesting:  va.OriginalVesting,
+		DelegatedFree:    va.DelegatedFree,
+		DelegatedVesting: va.DelegatedVesting,
+		EndTime:          va.EndTime,
+		StartTime:        va.StartTime,
+		VestingPeriods:   va.VestingPeriods,
+		FunderAddress:    va.FunderAddress,
+	}
+
+	pk := va.GetPubKey()
+	if pk != nil {
+		pks, err := sdk.Bech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, pk)
+		if err != nil {
+			return nil, err
+		}
+
+		alias.PubKey = pks
+	}
+
+	bz, err := yaml.Marshal(alias)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(bz), err
+}
+
+// MarshalJSON returns the JSON representation of a ClawbackVestingAccount.
+func (va ClawbackVestingAccount) MarshalJSON() ([]byte, error) {
+	alias := vestingAccountJSON{
+		Address:          va.Address,
+		PubKey:           va.GetPubKey(),
+		AccountNumber:    va.AccountNumber,
+		Sequence:         va.Sequence,
+		OriginalVesting:  va.OriginalVesting,
+		DelegatedFree:    va.DelegatedFree,
+		DelegatedVesting: va.DelegatedVesting,
+		EndTime:          va.EndTime,
+		StartTime:        va.StartTime,
+		VestingPeriods:   va.VestingPeriods,
+		FunderAddress:    va.FunderAddress,
+	}
+
+	return codec.Cdc.MarshalJSON(alias)
+}
+
+// UnmarshalJSON unmarshals raw JSON bytes into a ClawbackVestingAccount.
+func (va *ClawbackVestingAccount) UnmarshalJSON(bz []byte) error {
+	var alias vestingAccountJSON
+	if err := codec.Cdc.UnmarshalJSON(bz, &alias); err != nil {
+		return err
+	}
+
+	va.BaseVestingAccount = &BaseVestingAccount{
+		BaseAccount:      authtypes.NewBaseAccount(alias.Address, alias.PubKey, alias.AccountNumber, alias.Sequence),
+		OriginalVesting:  alias.OriginalVesting,
+		DelegatedFree:    alias.DelegatedFree,
+		DelegatedVesting: alias.DelegatedVesting,
+		EndTime:          alias.EndTime,
+	}
+	va.StartTime = alias.StartTime
+	va.VestingPeriods = alias.VestingPeriods
+	va.FunderAddress = alias.FunderAddress
+
+	return nil
+}
+
 //-----------------------------------------------------------------------------
 // Delayed Vesting Account
 
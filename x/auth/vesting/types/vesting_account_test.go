@@ -579,6 +579,94 @@ func TestTrackUndelegationPeriodicVestingAcc(t *testing.T) {
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, pva.DelegatedVesting)
 }
 
+func TestGetRemainingPeriodsPeriodicVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	periods := types.Periods{
+		types.NewPeriod(int64(12*60*60), sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}),
+		types.NewPeriod(int64(6*60*60), sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}),
+		types.NewPeriod(int64(6*60*60), sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}),
+	}
+
+	_, _, addr := authtypes.KeyTestPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	pva := types.NewPeriodicVestingAccount(bacc, origCoins, now.Unix(), periods)
+
+	// require all periods remaining at the beginning of the vesting schedule
+	require.Equal(t, periods, pva.GetRemainingPeriods(now))
+
+	// require the in-progress period to still be remaining
+	require.Equal(t, periods, pva.GetRemainingPeriods(now.Add(6*time.Hour)))
+
+	// require the first period to be elapsed once it is over
+	require.Equal(t, periods[1:], pva.GetRemainingPeriods(now.Add(12*time.Hour)))
+	require.Equal(t, periods[2:], pva.GetRemainingPeriods(now.Add(18*time.Hour)))
+
+	// require no periods remaining at the end of the vesting schedule
+	require.Empty(t, pva.GetRemainingPeriods(now.Add(24*time.Hour)))
+
+	schedule := types.NewVestingSchedule(pva, now.Add(12*time.Hour))
+	require.Equal(t, addr, schedule.Address)
+	require.Equal(t, now.Unix(), schedule.StartTime)
+	require.Equal(t, now.Add(24*time.Hour).Unix(), schedule.EndTime)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, schedule.VestedCoins)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, schedule.VestingCoins)
+	require.Equal(t, periods[1:], schedule.RemainingPeriods)
+
+	// require non-periodic vesting accounts to report no remaining periods
+	dva := types.NewDelayedVestingAccount(bacc, origCoins, now.Add(24*time.Hour).Unix())
+	require.Empty(t, types.NewVestingSchedule(dva, now).RemainingPeriods)
+}
+
+func TestClawbackClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	periods := types.Periods{
+		types.NewPeriod(int64(12*60*60), sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}),
+		types.NewPeriod(int64(6*60*60), sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}),
+		types.NewPeriod(int64(6*60*60), sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}),
+	}
+
+	_, _, addr := authtypes.KeyTestPubAddr()
+	_, _, funder := authtypes.KeyTestPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), periods)
+	require.NoError(t, va.Validate())
+	require.Equal(t, now.Add(24*time.Hour).Unix(), va.GetEndTime())
+
+	// delegate 75stake after the first period, covering all 50stake still vesting
+	va.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 75)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, va.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, va.DelegatedFree)
+
+	// require only the unvested coins to be clawed back in the middle of a period
+	unvested := va.Clawback(now.Add(15 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, unvested)
+
+	// require the schedule to be truncated to the elapsed periods
+	require.NoError(t, va.Validate())
+	require.Equal(t, periods[:1], va.GetVestingPeriods())
+	require.Equal(t, now.Add(12*time.Hour).Unix(), va.GetEndTime())
+	require.Equal(t, periods[0].Amount, va.GetOriginalVesting())
+	require.Empty(t, va.GetVestingCoins(now.Add(15*time.Hour)))
+	require.Empty(t, va.LockedCoins(now.Add(15*time.Hour)))
+
+	// require all delegations to be free after a clawback
+	require.Empty(t, va.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 75)}, va.DelegatedFree)
+
+	// require a second clawback to recover nothing
+	require.Empty(t, va.Clawback(now.Add(20*time.Hour)))
+	require.Equal(t, periods[:1], va.GetVestingPeriods())
+
+	// require a clawback before the start time to recover everything
+	va = types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), periods)
+	require.Equal(t, origCoins, va.Clawback(now.Add(-time.Hour)))
+	require.NoError(t, va.Validate())
+	require.Empty(t, va.GetVestingPeriods())
+	require.Equal(t, now.Unix(), va.GetEndTime())
+}
+
 func TestGenesisAccountValidate(t *testing.T) {
 	pubkey := secp256k1.GenPrivKey().PubKey()
 	addr := sdk.AccAddress(pubkey.Address())
@@ -634,6 +722,26 @@ func TestGenesisAccountValidate(t *testing.T) {
 				0, types.Periods{types.Period{Length: int64(100), Amount: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 25)}}}),
 			true,
 		},
+		{
+			"valid zero length vesting period",
+			types.NewPeriodicVestingAccountRaw(
+				baseVestingWithCoins,
+				0, types.Periods{
+					types.Period{Length: int64(100), Amount: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)}},
+					types.Period{Length: int64(0), Amount: sdk.Coins{}},
+				}),
+			false,
+		},
+		{
+			"invalid negative length vesting period",
+			types.NewPeriodicVestingAccountRaw(
+				baseVestingWithCoins,
+				0, types.Periods{
+					types.Period{Length: int64(100), Amount: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)}},
+					types.Period{Length: int64(-1), Amount: sdk.Coins{}},
+				}),
+			true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -789,3 +897,48 @@ func TestDelayedVestingAccountJSON(t *testing.T) {
 	require.NoError(t, json.Unmarshal(bz, &a))
 	require.Equal(t, acc.String(), a.String())
 }
+
+func TestClawbackVestingAccountMarshal(t *testing.T) {
+	pubkey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pubkey.Address())
+	funder := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	coins := sdk.NewCoins(sdk.NewInt64Coin("test", 5))
+	baseAcc := authtypes.NewBaseAccount(addr, pubkey, 10, 50)
+
+	acc := types.NewClawbackVestingAccount(baseAcc, funder, coins, time.Now().Unix(), types.Periods{types.Period{3600, coins}})
+
+	bz, err := appCodec.MarshalAccount(acc)
+	require.Nil(t, err)
+
+	acc2, err := appCodec.UnmarshalAccount(bz)
+	require.Nil(t, err)
+	require.IsType(t, &types.ClawbackVestingAccount{}, acc2)
+	require.Equal(t, acc.String(), acc2.String())
+	require.Equal(t, funder, acc2.(*types.ClawbackVestingAccount).GetFunderAddress())
+
+	// error on bad bytes
+	_, err = appCodec.UnmarshalAccount(bz[:len(bz)/2])
+	require.NotNil(t, err)
+}
+
+func TestClawbackVestingAccountJSON(t *testing.T) {
+	pubkey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pubkey.Address())
+	funder := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	coins := sdk.NewCoins(sdk.NewInt64Coin("test", 5))
+	baseAcc := authtypes.NewBaseAccount(addr, pubkey, 10, 50)
+
+	acc := types.NewClawbackVestingAccount(baseAcc, funder, coins, time.Now().Unix(), types.Periods{types.Period{3600, coins}})
+
+	bz, err := json.Marshal(acc)
+	require.NoError(t, err)
+
+	bz1, err := acc.MarshalJSON()
+	require.NoError(t, err)
+	require.Equal(t, string(bz1), string(bz))
+
+	var a types.ClawbackVestingAccount
+	require.NoError(t, json.Unmarshal(bz, &a))
+	require.Equal(t, acc.String(), a.String())
+	require.Equal(t, funder, a.FunderAddress)
+}
@@ -8,15 +8,25 @@ import (
 )
 
 const (
-	QueryBalance       = types.QueryBalance
-	QueryAllBalances   = types.QueryAllBalances
-	DefaultParamspace  = types.DefaultParamspace
-	DefaultSendEnabled = types.DefaultSendEnabled
+	QueryBalance        = types.QueryBalance
+	QueryAllBalances    = types.QueryAllBalances
+	QueryDenomMetadata  = types.QueryDenomMetadata
+	QueryDenomsMetadata = types.QueryDenomsMetadata
+	QueryDenomOwners    = types.QueryDenomOwners
+	QuerySendEnabled    = types.QuerySendEnabled
+	DefaultParamspace   = types.DefaultParamspace
+	DefaultSendEnabled  = types.DefaultSendEnabled
 
-	EventTypeTransfer      = types.EventTypeTransfer
-	AttributeKeyRecipient  = types.AttributeKeyRecipient
-	AttributeKeySender     = types.AttributeKeySender
-	AttributeValueCategory = types.AttributeValueCategory
+	QuerySpendableBalances = types.QuerySpendableBalances
+
+	EventTypeTransfer          = types.EventTypeTransfer
+	EventTypeSetSendEnabled    = types.EventTypeSetSendEnabled
+	AttributeKeyRecipient      = types.AttributeKeyRecipient
+	AttributeKeySender         = types.AttributeKeySender
+	AttributeKeyDenom          = types.AttributeKeyDenom
+	AttributeKeyEnabled        = types.AttributeKeyEnabled
+	ProposalTypeSetSendEnabled = types.ProposalTypeSetSendEnabled
+	AttributeValueCategory     = types.AttributeValueCategory
 
 	ModuleName   = types.ModuleName
 	StoreKey     = types.StoreKey
@@ -36,6 +46,7 @@ var (
 	ErrNoOutputs                = types.ErrNoOutputs
 	ErrInputOutputMismatch      = types.ErrInputOutputMismatch
 	ErrSendDisabled             = types.ErrSendDisabled
+	ErrHookPanic                = types.ErrHookPanic
 	NewGenesisState             = types.NewGenesisState
 	DefaultGenesisState         = types.DefaultGenesisState
 	SanitizeGenesisBalances     = types.SanitizeGenesisBalances
@@ -48,32 +59,64 @@ var (
 	ParamKeyTable               = types.ParamKeyTable
 	NewQueryBalanceParams       = types.NewQueryBalanceParams
 	NewQueryAllBalancesParams   = types.NewQueryAllBalancesParams
+	NewQueryDenomMetadataParams = types.NewQueryDenomMetadataParams
 	ModuleCdc                   = types.ModuleCdc
-	ParamStoreKeySendEnabled    = types.ParamStoreKeySendEnabled
+	KeySendEnabled              = types.KeySendEnabled
+	KeyDefaultSendEnabled       = types.KeyDefaultSendEnabled
+	NewParams                   = types.NewParams
+	DefaultParams               = types.DefaultParams
+	NewSendEnabled              = types.NewSendEnabled
+	NoOpSendRestrictionFn       = types.NoOpSendRestrictionFn
+	ComposeSendRestrictions     = types.ComposeSendRestrictions
+	NewMultiBankHooks           = types.NewMultiBankHooks
 	BalancesPrefix              = types.BalancesPrefix
+	DenomMetadataPrefix         = types.DenomMetadataPrefix
+	DenomMetadataKey            = types.DenomMetadataKey
+	DenomAddressPrefix          = types.DenomAddressPrefix
+	CreateDenomAddressPrefix    = types.CreateDenomAddressPrefix
+	DenomAddressKey             = types.DenomAddressKey
+	NewQueryDenomOwnersParams   = types.NewQueryDenomOwnersParams
+	NewQuerySendEnabledParams   = types.NewQuerySendEnabledParams
+	NewSetSendEnabledProposal   = types.NewSetSendEnabledProposal
+	NewDenomOwner               = types.NewDenomOwner
 	AddressFromBalancesStore    = types.AddressFromBalancesStore
 	AllInvariants               = keeper.AllInvariants
 	TotalSupply                 = keeper.TotalSupply
 	NewSupply                   = types.NewSupply
 	DefaultSupply               = types.DefaultSupply
+
+	NewQuerySpendableBalancesParams = types.NewQuerySpendableBalancesParams
 )
 
 type (
-	BaseKeeper              = keeper.BaseKeeper
-	SendKeeper              = keeper.SendKeeper
-	BaseSendKeeper          = keeper.BaseSendKeeper
-	ViewKeeper              = keeper.ViewKeeper
-	BaseViewKeeper          = keeper.BaseViewKeeper
-	Balance                 = types.Balance
-	MsgSend                 = types.MsgSend
-	MsgMultiSend            = types.MsgMultiSend
-	Input                   = types.Input
-	Output                  = types.Output
-	QueryBalanceParams      = types.QueryBalanceParams
-	QueryAllBalancesParams  = types.QueryAllBalancesParams
-	GenesisBalancesIterator = types.GenesisBalancesIterator
-	Keeper                  = keeper.Keeper
-	GenesisState            = types.GenesisState
-	Supply                  = types.Supply
-	Codec                   = types.Codec
+	BaseKeeper               = keeper.BaseKeeper
+	SendKeeper               = keeper.SendKeeper
+	BaseSendKeeper           = keeper.BaseSendKeeper
+	ViewKeeper               = keeper.ViewKeeper
+	BaseViewKeeper           = keeper.BaseViewKeeper
+	Balance                  = types.Balance
+	MsgSend                  = types.MsgSend
+	MsgMultiSend             = types.MsgMultiSend
+	Input                    = types.Input
+	Output                   = types.Output
+	QueryBalanceParams       = types.QueryBalanceParams
+	QueryAllBalancesParams   = types.QueryAllBalancesParams
+	QueryDenomMetadataParams = types.QueryDenomMetadataParams
+	GenesisBalancesIterator  = types.GenesisBalancesIterator
+	Keeper                   = keeper.Keeper
+	GenesisState             = types.GenesisState
+	Supply                   = types.Supply
+	Metadata                 = types.Metadata
+	Params                   = types.Params
+	SendEnabled              = types.SendEnabled
+	SendRestrictionFn        = types.SendRestrictionFn
+	MultiBankHooks           = types.MultiBankHooks
+	QueryDenomOwnersParams   = types.QueryDenomOwnersParams
+	QuerySendEnabledParams   = types.QuerySendEnabledParams
+	SetSendEnabledProposal   = types.SetSendEnabledProposal
+	DenomOwner               = types.DenomOwner
+	DenomUnit                = types.DenomUnit
+	Codec                    = types.Codec
+
+	QuerySpendableBalancesParams = types.QuerySpendableBalancesParams
 )
@@ -41,7 +41,11 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 
 	cmd.AddCommand(
 		GetBalancesCmd(cdc),
+		GetCmdSpendableBalances(cdc),
+		GetCmdSendEnabled(cdc),
 		GetCmdQueryTotalSupply(cdc),
+		GetCmdDenomsMetadata(cdc),
+		GetCmdDenomOwners(cdc),
 	)
 
 	return cmd
@@ -115,6 +119,101 @@ func GetBalancesCmd(cdc *codec.Codec) *cobra.Command {
 	return flags.GetCommands(cmd)[0]
 }
 
+// GetCmdSpendableBalances returns a CLI command handler that facilitates
+// querying the balances an account can spend, i.e. excluding its locked coins.
+//
+// TODO: Remove once client-side Protobuf migration has been completed.
+// ref: https://github.com/cosmos/cosmos-sdk/issues/5864
+func GetCmdSpendableBalances(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "spendable-balances [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for the spendable balances of an account by address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for the balances an account can spend, i.e. its balances minus
+the coins locked by vesting.
+
+Example:
+$ %s query %s spendable-balances cosmos1...
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQuerySpendableBalancesParams(addr))
+			if err != nil {
+				return fmt.Errorf("failed to marshal params: %w", err)
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySpendableBalances), bz)
+			if err != nil {
+				return err
+			}
+
+			var balances sdk.Coins
+			if err := cdc.UnmarshalJSON(res, &balances); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(balances)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
+
+// GetCmdSendEnabled returns a CLI command handler that facilitates querying the
+// send enabled entries overriding the default send enabled status.
+//
+// TODO: Remove once client-side Protobuf migration has been completed.
+// ref: https://github.com/cosmos/cosmos-sdk/issues/5864
+func GetCmdSendEnabled(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-enabled [denom1 ...]",
+		Short: "Query for the send enabled entries of coin denominations",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for the send enabled entries, which override the default send enabled
+status of individual coin denominations. If no denomination is given, all the
+entries are returned. The denominations without an entry use the default status.
+
+Example:
+$ %s query %s send-enabled foo bar
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := cdc.MarshalJSON(types.NewQuerySendEnabledParams(args))
+			if err != nil {
+				return fmt.Errorf("failed to marshal params: %w", err)
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySendEnabled), bz)
+			if err != nil {
+				return err
+			}
+
+			var sendEnabled []*types.SendEnabled
+			if err := cdc.UnmarshalJSON(res, &sendEnabled); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(sendEnabled)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
+
 // TODO: Remove once client-side Protobuf migration has been completed.
 // ref: https://github.com/cosmos/cosmos-sdk/issues/5864
 func GetCmdQueryTotalSupply(cdc *codec.Codec) *cobra.Command {
@@ -148,3 +247,92 @@ $ %s query %s total stake
 
 	return flags.GetCommands(cmd)[0]
 }
+
+// GetCmdDenomsMetadata returns the metadata of a single or all the coin
+// denominations that have it set.
+//
+// TODO: Remove once client-side Protobuf migration has been completed.
+// ref: https://github.com/cosmos/cosmos-sdk/issues/5864
+func GetCmdDenomsMetadata(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-metadata",
+		Args:  cobra.NoArgs,
+		Short: "Query the client metadata for coin denominations",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the client metadata for all the registered coin denominations
+
+Example:
+  To query for the client metadata of all coin denominations use:
+  $ %s query %s denom-metadata
+
+To query for the client metadata of a specific coin denomination use:
+  $ %s query %s denom-metadata --denom=[denom]
+`,
+				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			denom := viper.GetString(flagDenom)
+			if denom == "" {
+				return queryDenomsMetadata(cliCtx, cdc)
+			}
+
+			return queryDenomMetadata(cliCtx, cdc, denom)
+		},
+	}
+
+	cmd.Flags().String(flagDenom, "", "The specific denomination to query client metadata for")
+
+	return flags.GetCommands(cmd)[0]
+}
+
+// GetCmdDenomOwners returns a CLI command handler that facilitates querying
+// the accounts holding a given denomination.
+//
+// TODO: Remove once client-side Protobuf migration has been completed.
+// ref: https://github.com/cosmos/cosmos-sdk/issues/5864
+func GetCmdDenomOwners(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-owners [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for all the accounts holding a given coin denomination",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for all the accounts holding a given coin denomination, along with
+their balance of it, ordered by address.
+
+Example:
+$ %s query %s denom-owners stake --page=2 --limit=10
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := types.NewQueryDenomOwnersParams(args[0], viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit))
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return fmt.Errorf("failed to marshal params: %w", err)
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDenomOwners), bz)
+			if err != nil {
+				return err
+			}
+
+			var owners []types.DenomOwner
+			if err := cdc.UnmarshalJSON(res, &owners); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(owners)
+		},
+	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "Query a specific page of paginated results")
+	cmd.Flags().Int(flags.FlagLimit, 100, "Query number of results returned per page")
+
+	return flags.GetCommands(cmd)[0]
+}
@@ -2,6 +2,8 @@ package cli
 
 import (
 	"bufio"
+	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -11,9 +13,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // NewTxCmd returns a root CLI command handler for all x/bank transaction commands.
@@ -123,3 +127,72 @@ func SendTxCmd(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdSubmitSetSendEnabledProposal implements the command to submit a set send
+// enabled proposal.
+//
+// TODO: Remove once client-side Protobuf migration has been completed.
+// ref: https://github.com/cosmos/cosmos-sdk/issues/5864
+func GetCmdSubmitSetSendEnabledProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-send-enabled [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to set the send enabled status of coin denominations",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to set the send enabled status of coin denominations
+along with an initial deposit. The denominations listed in use_default_for have
+their send enabled status reset to the default one. The proposal details must be
+supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal set-send-enabled <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Disable sends of foo",
+  "description": "Disable the transfers of foo until the audit is done",
+  "send_enabled": [
+    {
+      "denom": "foo",
+      "enabled": false
+    }
+  ],
+  "use_default_for": ["bar"],
+  "deposit": "1000stake"
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			proposal, err := ParseSetSendEnabledProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewSetSendEnabledProposal(
+				proposal.Title, proposal.Description, proposal.SendEnabled, proposal.UseDefaultFor,
+			)
+
+			deposit, err := sdk.ParseCoins(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			msg := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"io/ioutil"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -9,6 +10,32 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
+// SetSendEnabledProposalJSON defines a SetSendEnabledProposal with a deposit
+type SetSendEnabledProposalJSON struct {
+	Title         string               `json:"title" yaml:"title"`
+	Description   string               `json:"description" yaml:"description"`
+	SendEnabled   []*types.SendEnabled `json:"send_enabled" yaml:"send_enabled"`
+	UseDefaultFor []string             `json:"use_default_for" yaml:"use_default_for"`
+	Deposit       string               `json:"deposit" yaml:"deposit"`
+}
+
+// ParseSetSendEnabledProposalJSON reads and parses a SetSendEnabledProposalJSON
+// from a file.
+func ParseSetSendEnabledProposalJSON(cdc *codec.Codec, proposalFile string) (SetSendEnabledProposalJSON, error) {
+	proposal := SetSendEnabledProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
 func queryTotalSupply(cliCtx context.CLIContext, cdc *codec.Codec) error {
 	params := types.NewQueryTotalSupplyParams(1, 0) // no pagination
 	bz, err := cdc.MarshalJSON(params)
@@ -50,3 +77,39 @@ func querySupplyOf(cliCtx context.CLIContext, cdc *codec.Codec, denom string) er
 
 	return cliCtx.PrintOutput(supply)
 }
+
+func queryDenomsMetadata(cliCtx context.CLIContext, cdc *codec.Codec) error {
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDenomsMetadata), nil)
+	if err != nil {
+		return err
+	}
+
+	var metadata []types.Metadata
+	err = cdc.UnmarshalJSON(res, &metadata)
+	if err != nil {
+		return err
+	}
+
+	return cliCtx.PrintOutput(metadata)
+}
+
+func queryDenomMetadata(cliCtx context.CLIContext, cdc *codec.Codec, denom string) error {
+	params := types.NewQueryDenomMetadataParams(denom)
+	bz, err := cdc.MarshalJSON(params)
+	if err != nil {
+		return err
+	}
+
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDenomMetadata), bz)
+	if err != nil {
+		return err
+	}
+
+	var metadata types.Metadata
+	err = cdc.UnmarshalJSON(res, &metadata)
+	if err != nil {
+		return err
+	}
+
+	return cliCtx.PrintOutput(metadata)
+}
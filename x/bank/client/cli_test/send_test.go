@@ -26,10 +26,10 @@ func TestCLISend(t *testing.T) {
 	fooAddr := f.KeyAddress(cli.KeyFoo)
 	barAddr := f.KeyAddress(cli.KeyBar)
 
-	startTokens := sdk.TokensFromConsensusPower(50)
+	startTokens := sdk.TokensFromConsensusPower(50, sdk.DefaultPowerReduction)
 	require.Equal(t, startTokens, bankcli.QueryBalances(f, fooAddr).AmountOf(cli.Denom))
 
-	sendTokens := sdk.TokensFromConsensusPower(10)
+	sendTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
 
 	// It does not allow to send in offline mode
 	success, _, stdErr := bankcli.TxSend(f, cli.KeyFoo, barAddr, sdk.NewCoin(cli.Denom, sendTokens), "-y", "--offline")
@@ -183,7 +183,7 @@ func TestCLIFeesDeduction(t *testing.T) {
 	require.Equal(t, fooAmt.Int64(), bankcli.QueryBalances(f, fooAddr).AmountOf(cli.FooDenom).Int64())
 
 	// insufficient funds (coins + fees) tx fails
-	largeCoins := sdk.TokensFromConsensusPower(10000000)
+	largeCoins := sdk.TokensFromConsensusPower(10000000, sdk.DefaultPowerReduction)
 	success, stdOut, _ := bankcli.TxSend(
 		f, cli.KeyFoo, barAddr, sdk.NewCoin(cli.FooDenom, largeCoins),
 		fmt.Sprintf("--fees=%s", sdk.NewInt64Coin(cli.FeeDenom, 2)), "-y")
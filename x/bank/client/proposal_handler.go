@@ -0,0 +1,12 @@
+package client
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/bank/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/bank/client/rest"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+)
+
+// set send enabled proposal handler
+var (
+	ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitSetSendEnabledProposal, rest.ProposalRESTHandler)
+)
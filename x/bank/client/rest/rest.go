@@ -25,6 +25,11 @@ func RegisterHandlers(ctx context.CLIContext, m codec.Marshaler, txg tx.Generato
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/bank/accounts/{address}/transfers", SendRequestHandlerFn(cliCtx)).Methods("POST")
 	r.HandleFunc("/bank/balances/{address}", QueryBalancesRequestHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/bank/spendable_balances/{address}", spendableBalancesHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/bank/send_enabled", sendEnabledHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/bank/total", totalSupplyHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/bank/total/{denom}", supplyOfHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/bank/denoms_metadata", denomsMetadataHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/bank/denoms_metadata/{denom}", denomMetadataHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/bank/denom_owners/{denom}", denomOwnersHandlerFn(cliCtx)).Methods("GET")
 }
@@ -12,6 +12,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // SendReq defines the properties of a send request's body.
@@ -93,3 +95,47 @@ func SendRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		authclient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+// SetSendEnabledProposalReq defines a set send enabled proposal request body.
+type SetSendEnabledProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title         string               `json:"title" yaml:"title"`
+	Description   string               `json:"description" yaml:"description"`
+	SendEnabled   []*types.SendEnabled `json:"send_enabled" yaml:"send_enabled"`
+	UseDefaultFor []string             `json:"use_default_for" yaml:"use_default_for"`
+	Proposer      sdk.AccAddress       `json:"proposer" yaml:"proposer"`
+	Deposit       sdk.Coins            `json:"deposit" yaml:"deposit"`
+}
+
+// ProposalRESTHandler returns a ProposalRESTHandler that exposes the set send
+// enabled REST handler with a given sub-route.
+func ProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "set_send_enabled",
+		Handler:  postSetSendEnabledProposalHandlerFn(cliCtx),
+	}
+}
+
+func postSetSendEnabledProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req SetSendEnabledProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewSetSendEnabledProposal(req.Title, req.Description, req.SendEnabled, req.UseDefaultFor)
+
+		msg := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		authclient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
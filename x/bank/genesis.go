@@ -9,7 +9,7 @@ import (
 
 // InitGenesis initializes the bank module's state from a given genesis state.
 func InitGenesis(ctx sdk.Context, keeper Keeper, genState GenesisState) {
-	keeper.SetSendEnabled(ctx, genState.SendEnabled)
+	keeper.SetParams(ctx, genState.Params)
 
 	var totalSupply sdk.Coins
 
@@ -31,6 +31,10 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, genState GenesisState) {
 	}
 
 	keeper.SetSupply(ctx, NewSupply(genState.Supply))
+
+	for _, meta := range genState.DenomMetadata {
+		keeper.SetDenomMetaData(ctx, meta)
+	}
 }
 
 // ExportGenesis returns the bank module's genesis state.
@@ -56,11 +60,33 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
 		})
 	}
 
-	return NewGenesisState(keeper.GetSendEnabled(ctx), balances, keeper.GetSupply(ctx).GetTotal())
+	// the balances are sorted by address, as the set of balances is a map, for
+	// the exported genesis to be deterministic
+	return NewGenesisState(
+		keeper.GetParams(ctx), SanitizeGenesisBalances(balances), keeper.GetSupply(ctx).GetTotal(),
+		keeper.GetAllDenomMetaData(ctx),
+	)
 }
 
 // ValidateGenesis performs basic validation of supply genesis data returning an
 // error for any failed validation criteria.
 func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	seenMetadata := make(map[string]bool)
+	for _, metadata := range data.DenomMetadata {
+		if seenMetadata[metadata.Base] {
+			return fmt.Errorf("duplicate client metadata for denom %s", metadata.Base)
+		}
+
+		if err := metadata.Validate(); err != nil {
+			return err
+		}
+
+		seenMetadata[metadata.Base] = true
+	}
+
 	return types.NewSupply(data.Supply).ValidateBasic()
 }
@@ -0,0 +1,36 @@
+package bank_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+)
+
+func TestExportGenesis(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	addrs := simapp.AddTestAddrs(app, ctx, 10, sdk.NewInt(1000))
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, addrs[3], sdk.NewCoins(
+		sdk.NewInt64Coin("foocoin", 10), sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000),
+	)))
+
+	// the balances are exported in the order of their addresses
+	genesis := bank.ExportGenesis(ctx, app.BankKeeper)
+	require.Len(t, genesis.Balances, len(addrs))
+	for i := 1; i < len(genesis.Balances); i++ {
+		require.True(t, bytes.Compare(genesis.Balances[i-1].Address, genesis.Balances[i].Address) < 0)
+	}
+
+	for _, balance := range genesis.Balances {
+		require.Equal(t, app.BankKeeper.GetAllBalances(ctx, balance.Address), balance.Coins)
+	}
+
+	require.Equal(t, genesis, bank.ExportGenesis(ctx, app.BankKeeper))
+}
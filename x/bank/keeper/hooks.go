@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// bankHooks holds the hooks of a keeper. It is shared by all the copies of the
+// keeper, so that hooks set after the keeper was passed to other modules apply.
+type bankHooks struct {
+	hooks types.BankHooks
+}
+
+// SetHooks sets the bank hooks. It panics if the hooks were already set, use
+// types.NewMultiBankHooks to register several hooks.
+func (k BaseSendKeeper) SetHooks(bh types.BankHooks) {
+	if k.hooks.hooks != nil {
+		panic("cannot set bank hooks twice")
+	}
+
+	k.hooks.hooks = bh
+}
+
+// beforeSend calls the BeforeSend hook, if any. A panicking hook blocks the
+// transfer as if it returned an error.
+func (k BaseSendKeeper) beforeSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if k.hooks.hooks == nil {
+		return nil
+	}
+
+	return applyHook(ctx, func(ctx sdk.Context) error {
+		return k.hooks.hooks.BeforeSend(ctx, fromAddr, toAddr, amt)
+	})
+}
+
+// afterSend calls the AfterSend hook, if any.
+func (k BaseSendKeeper) afterSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) {
+	if k.hooks.hooks == nil {
+		return
+	}
+
+	k.logHookError(ctx, "AfterSend", applyHook(ctx, func(ctx sdk.Context) error {
+		k.hooks.hooks.AfterSend(ctx, fromAddr, toAddr, amt)
+		return nil
+	}))
+}
+
+// afterMint calls the AfterMint hook, if any.
+func (k BaseSendKeeper) afterMint(ctx sdk.Context, moduleName string, amt sdk.Coins) {
+	if k.hooks.hooks == nil {
+		return
+	}
+
+	k.logHookError(ctx, "AfterMint", applyHook(ctx, func(ctx sdk.Context) error {
+		k.hooks.hooks.AfterMint(ctx, moduleName, amt)
+		return nil
+	}))
+}
+
+// afterBurn calls the AfterBurn hook, if any.
+func (k BaseSendKeeper) afterBurn(ctx sdk.Context, moduleName string, amt sdk.Coins) {
+	if k.hooks.hooks == nil {
+		return
+	}
+
+	k.logHookError(ctx, "AfterBurn", applyHook(ctx, func(ctx sdk.Context) error {
+		k.hooks.hooks.AfterBurn(ctx, moduleName, amt)
+		return nil
+	}))
+}
+
+func (k BaseSendKeeper) logHookError(ctx sdk.Context, hook string, err error) {
+	if err != nil {
+		k.Logger(ctx).Error(
+			fmt.Sprintf("%s hook failed, its state changes were discarded", hook), "err", err,
+		)
+	}
+}
+
+// applyHook runs a hook on a cached context, whose state changes and events are
+// only written if the hook returns without error or panicking. A panic is
+// recovered and returned as an error, so that a faulty hook can't halt the
+// chain, except for out of gas panics which must reach the ante handler.
+func applyHook(ctx sdk.Context, hook func(ctx sdk.Context) error) (err error) {
+	cacheCtx, write := ctx.CacheContext()
+
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); ok {
+				panic(r)
+			}
+
+			err = sdkerrors.Wrapf(types.ErrHookPanic, "%v", r)
+		}
+	}()
+
+	if err := hook(cacheCtx); err != nil {
+		return err
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return nil
+}
@@ -16,6 +16,11 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 // AllInvariants runs all invariants of the X/bank module.
 func AllInvariants(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
+		res, stop := NonnegativeBalanceInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
 		return TotalSupply(k)(ctx)
 	}
 }
@@ -46,7 +51,8 @@ func NonnegativeBalanceInvariant(k ViewKeeper) sdk.Invariant {
 	}
 }
 
-// TotalSupply checks that the total supply reflects all the coins held in accounts
+// TotalSupply checks that the total supply of each denom reflects all the coins
+// of that denom held in accounts
 func TotalSupply(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
 		var expectedTotal sdk.Coins
@@ -57,12 +63,20 @@ func TotalSupply(k Keeper) sdk.Invariant {
 			return false
 		})
 
-		broken := !expectedTotal.IsEqual(supply.GetTotal())
+		var msg string
+		for _, coin := range expectedTotal.Add(supply.GetTotal()...) {
+			balances, total := expectedTotal.AmountOf(coin.Denom), supply.GetTotal().AmountOf(coin.Denom)
+			if !balances.Equal(total) {
+				msg += fmt.Sprintf("\t%s: sum of accounts coins %s, supply %s\n", coin.Denom, balances, total)
+			}
+		}
+
+		broken := msg != ""
 
 		return sdk.FormatInvariant(types.ModuleName, "total supply",
 			fmt.Sprintf(
 				"\tsum of accounts coins: %v\n"+
-					"\tsupply.Total:          %v\n",
-				expectedTotal, supply.GetTotal())), broken
+					"\tsupply.Total:          %v\n%s",
+				expectedTotal, supply.GetTotal(), msg)), broken
 	}
 }
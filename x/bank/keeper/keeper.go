@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth"
@@ -22,6 +23,14 @@ type Keeper interface {
 
 	GetSupply(ctx sdk.Context) exported.SupplyI
 	SetSupply(ctx sdk.Context, supply exported.SupplyI)
+	GetSupplyOf(ctx sdk.Context, denom string) sdk.Int
+	IterateTotalSupply(ctx sdk.Context, cb func(sdk.Coin) (stop bool))
+	GetPaginatedTotalSupply(ctx sdk.Context, page, limit int) sdk.Coins
+
+	GetDenomMetaData(ctx sdk.Context, denom string) (types.Metadata, bool)
+	SetDenomMetaData(ctx sdk.Context, denomMetaData types.Metadata)
+	IterateAllDenomMetaData(ctx sdk.Context, cb func(types.Metadata) (stop bool))
+	GetAllDenomMetaData(ctx sdk.Context) []types.Metadata
 
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
@@ -47,7 +56,7 @@ type BaseKeeper struct {
 
 func NewBaseKeeper(
 	cdc types.Codec, storeKey sdk.StoreKey, ak types.AccountKeeper, paramSpace paramtypes.Subspace,
-	blacklistedAddrs map[string]bool,
+	blockedAddrs map[string]bool,
 ) BaseKeeper {
 
 	// set KeyTable if it has not already been set
@@ -56,7 +65,7 @@ func NewBaseKeeper(
 	}
 
 	return BaseKeeper{
-		BaseSendKeeper: NewBaseSendKeeper(cdc, storeKey, ak, paramSpace, blacklistedAddrs),
+		BaseSendKeeper: NewBaseSendKeeper(cdc, storeKey, ak, paramSpace, blockedAddrs),
 		ak:             ak,
 		cdc:            cdc,
 		storeKey:       storeKey,
@@ -140,31 +149,163 @@ func (k BaseKeeper) UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAdd
 	return nil
 }
 
-// GetSupply retrieves the Supply from store
+// GetSupply retrieves the Supply from store. The total supply is aggregated
+// from the supply of every denom, prefer GetSupplyOf or GetPaginatedTotalSupply
+// when only some denoms are needed.
 func (k BaseKeeper) GetSupply(ctx sdk.Context) exported.SupplyI {
+	total := sdk.NewCoins()
+	k.IterateTotalSupply(ctx, func(coin sdk.Coin) bool {
+		total = append(total, coin)
+		return false
+	})
+
+	return types.NewSupply(total)
+}
+
+// SetSupply sets the Supply to store, replacing the supply of every denom.
+func (k BaseKeeper) SetSupply(ctx sdk.Context, supply exported.SupplyI) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.SupplyPrefix)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	for _, coin := range supply.GetTotal() {
+		k.setSupplyOf(ctx, coin)
+	}
+}
+
+// GetSupplyOf retrieves the total supply of the given denom from store.
+func (k BaseKeeper) GetSupplyOf(ctx sdk.Context, denom string) sdk.Int {
 	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.SupplyKey)
+	bz := store.Get(types.DenomSupplyKey(denom))
 	if bz == nil {
-		panic("stored supply should not have been nil")
+		return sdk.ZeroInt()
 	}
 
-	supply, err := k.cdc.UnmarshalSupply(bz)
-	if err != nil {
+	var amount sdk.Int
+	if err := amount.Unmarshal(bz); err != nil {
 		panic(err)
 	}
 
+	return amount
+}
+
+// IterateTotalSupply iterates over the total supply of every denom, in the
+// lexicographical order of the denoms, and calls the provided callback. If true
+// is returned from the callback, iteration is halted.
+func (k BaseKeeper) IterateTotalSupply(ctx sdk.Context, cb func(sdk.Coin) bool) {
+	store := ctx.KVStore(k.storeKey)
+	supplyStore := prefix.NewStore(store, types.SupplyPrefix)
+
+	iterator := supplyStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdk.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+
+		if cb(sdk.NewCoin(string(iterator.Key()), amount)) {
+			break
+		}
+	}
+}
+
+// GetPaginatedTotalSupply returns a page of the total supply of every denom,
+// sorted by denom.
+func (k BaseKeeper) GetPaginatedTotalSupply(ctx sdk.Context, page, limit int) sdk.Coins {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIteratorPaginated(store, types.SupplyPrefix, uint(page), uint(limit))
+	defer iterator.Close()
+
+	supply := sdk.NewCoins()
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdk.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+
+		supply = append(supply, sdk.NewCoin(string(iterator.Key()[len(types.SupplyPrefix):]), amount))
+	}
+
 	return supply
 }
 
-// SetSupply sets the Supply to store
-func (k BaseKeeper) SetSupply(ctx sdk.Context, supply exported.SupplyI) {
+// setSupplyOf sets the total supply of a denom, deleting it if it is zero.
+func (k BaseKeeper) setSupplyOf(ctx sdk.Context, coin sdk.Coin) {
 	store := ctx.KVStore(k.storeKey)
-	bz, err := k.cdc.MarshalSupply(supply)
+	if coin.IsZero() {
+		store.Delete(types.DenomSupplyKey(coin.Denom))
+		return
+	}
+
+	bz, err := coin.Amount.Marshal()
 	if err != nil {
 		panic(err)
 	}
 
-	store.Set(types.SupplyKey, bz)
+	store.Set(types.DenomSupplyKey(coin.Denom), bz)
+}
+
+// GetDenomMetaData retrieves the denomination metadata of the given denom. It
+// returns false if no metadata is set for the denom.
+func (k BaseKeeper) GetDenomMetaData(ctx sdk.Context, denom string) (types.Metadata, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.DenomMetadataKey(denom))
+	if bz == nil {
+		return types.Metadata{}, false
+	}
+
+	var metadata types.Metadata
+	k.cdc.MustUnmarshalBinaryBare(bz, &metadata)
+
+	return metadata, true
+}
+
+// SetDenomMetaData sets the denomination metadata, keyed by its base denom.
+func (k BaseKeeper) SetDenomMetaData(ctx sdk.Context, denomMetaData types.Metadata) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.DenomMetadataKey(denomMetaData.Base), k.cdc.MustMarshalBinaryBare(&denomMetaData))
+}
+
+// IterateAllDenomMetaData iterates over all the denomination metadata, ordered
+// by base denom, and calls the given callback on each of them. Iteration stops
+// when the callback returns true.
+func (k BaseKeeper) IterateAllDenomMetaData(ctx sdk.Context, cb func(types.Metadata) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DenomMetadataPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var metadata types.Metadata
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &metadata)
+
+		if cb(metadata) {
+			break
+		}
+	}
+}
+
+// GetAllDenomMetaData returns all the denomination metadata, ordered by base
+// denom.
+func (k BaseKeeper) GetAllDenomMetaData(ctx sdk.Context) []types.Metadata {
+	denomMetaData := []types.Metadata{}
+	k.IterateAllDenomMetaData(ctx, func(metadata types.Metadata) bool {
+		denomMetaData = append(denomMetaData, metadata)
+		return false
+	})
+
+	return denomMetaData
 }
 
 // SendCoinsFromModuleToAccount transfers coins from a ModuleAccount to an AccAddress.
@@ -269,15 +410,16 @@ func (k BaseKeeper) MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins)
 		return err
 	}
 
-	// update total supply
-	supply := k.GetSupply(ctx)
-	supply.Inflate(amt)
-
-	k.SetSupply(ctx, supply)
+	// update the total supply of the minted denoms
+	for _, coin := range amt {
+		k.setSupplyOf(ctx, sdk.NewCoin(coin.Denom, k.GetSupplyOf(ctx, coin.Denom).Add(coin.Amount)))
+	}
 
 	logger := k.Logger(ctx)
 	logger.Info(fmt.Sprintf("minted %s from %s module account", amt.String(), moduleName))
 
+	k.afterMint(ctx, moduleName, amt)
+
 	return nil
 }
 
@@ -298,14 +440,21 @@ func (k BaseKeeper) BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins)
 		return err
 	}
 
-	// update total supply
-	supply := k.GetSupply(ctx)
-	supply.Deflate(amt)
-	k.SetSupply(ctx, supply)
+	// update the total supply of the burned denoms
+	for _, coin := range amt {
+		supply := k.GetSupplyOf(ctx, coin.Denom).Sub(coin.Amount)
+		if supply.IsNegative() {
+			panic(fmt.Sprintf("negative %s supply after burning %s", coin.Denom, coin))
+		}
+
+		k.setSupplyOf(ctx, sdk.NewCoin(coin.Denom, supply))
+	}
 
 	logger := k.Logger(ctx)
 	logger.Info(fmt.Sprintf("burned %s from %s module account", amt.String(), moduleName))
 
+	k.afterBurn(ctx, moduleName, amt)
+
 	return nil
 }
 
@@ -319,6 +468,7 @@ func (k BaseKeeper) trackDelegation(ctx sdk.Context, addr sdk.AccAddress, blockT
 	if ok {
 		// TODO: return error on account.TrackDelegation
 		vacc.TrackDelegation(blockTime, balance, amt)
+		k.ak.SetAccount(ctx, acc)
 	}
 
 	return nil
@@ -334,6 +484,7 @@ func (k BaseKeeper) trackUndelegation(ctx sdk.Context, addr sdk.AccAddress, amt
 	if ok {
 		// TODO: return error on account.TrackUndelegation
 		vacc.TrackUndelegation(amt)
+		k.ak.SetAccount(ctx, acc)
 	}
 
 	return nil
@@ -13,10 +13,14 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/mint"
 )
 
 const (
@@ -35,7 +39,7 @@ var (
 	multiPermAcc  = auth.NewEmptyModuleAccount(multiPerm, auth.Burner, auth.Minter, auth.Staking)
 	randomPermAcc = auth.NewEmptyModuleAccount(randomPerm, "random")
 
-	initTokens = sdk.TokensFromConsensusPower(initialPower)
+	initTokens = sdk.TokensFromConsensusPower(initialPower, sdk.DefaultPowerReduction)
 	initCoins  = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initTokens))
 )
 
@@ -70,7 +74,7 @@ func (suite *IntegrationTestSuite) SetupTest() {
 	ctx := app.BaseApp.NewContext(false, abci.Header{})
 
 	app.AccountKeeper.SetParams(ctx, auth.DefaultParams())
-	app.BankKeeper.SetSendEnabled(ctx, true)
+	app.BankKeeper.SetParams(ctx, types.DefaultParams())
 
 	suite.app = app
 	suite.ctx = ctx
@@ -80,7 +84,7 @@ func (suite *IntegrationTestSuite) TestSupply() {
 	app, ctx := suite.app, suite.ctx
 
 	initialPower := int64(100)
-	initTokens := sdk.TokensFromConsensusPower(initialPower)
+	initTokens := sdk.TokensFromConsensusPower(initialPower, sdk.DefaultPowerReduction)
 
 	totalSupply := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initTokens))
 	app.BankKeeper.SetSupply(ctx, types.NewSupply(totalSupply))
@@ -89,6 +93,60 @@ func (suite *IntegrationTestSuite) TestSupply() {
 	suite.Require().Equal(totalSupply, total)
 }
 
+func (suite *IntegrationTestSuite) TestSupplyOf() {
+	app, ctx := suite.app, suite.ctx
+
+	totalSupply := sdk.NewCoins(newBarCoin(20), newFooCoin(10), sdk.NewInt64Coin("zoo", 30))
+	app.BankKeeper.SetSupply(ctx, types.NewSupply(totalSupply))
+
+	suite.Require().Equal(sdk.NewInt(10), app.BankKeeper.GetSupplyOf(ctx, fooDenom))
+	suite.Require().Equal(sdk.NewInt(20), app.BankKeeper.GetSupplyOf(ctx, barDenom))
+	suite.Require().Equal(sdk.ZeroInt(), app.BankKeeper.GetSupplyOf(ctx, "unknown"))
+
+	var iterated sdk.Coins
+	app.BankKeeper.IterateTotalSupply(ctx, func(coin sdk.Coin) bool {
+		iterated = append(iterated, coin)
+		return false
+	})
+	suite.Require().Equal(totalSupply, iterated)
+
+	suite.Require().Equal(sdk.NewCoins(newBarCoin(20), newFooCoin(10)), app.BankKeeper.GetPaginatedTotalSupply(ctx, 1, 2))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("zoo", 30)), app.BankKeeper.GetPaginatedTotalSupply(ctx, 2, 2))
+	suite.Require().Empty(app.BankKeeper.GetPaginatedTotalSupply(ctx, 3, 2))
+
+	// denoms missing from the new supply are removed
+	app.BankKeeper.SetSupply(ctx, types.NewSupply(sdk.NewCoins(newFooCoin(5))))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(5)), app.BankKeeper.GetSupply(ctx).GetTotal())
+	suite.Require().Equal(sdk.ZeroInt(), app.BankKeeper.GetSupplyOf(ctx, barDenom))
+}
+
+func (suite *IntegrationTestSuite) TestTotalSupplyInvariant() {
+	app, ctx := suite.app, suite.ctx
+
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr, balances))
+
+	totalSupply := app.BankKeeper.GetSupply(ctx).GetTotal()
+	app.BankKeeper.SetSupply(ctx, types.NewSupply(totalSupply.Add(balances...)))
+
+	_, broken := keeper.TotalSupply(app.BankKeeper)(ctx)
+	suite.Require().False(broken)
+
+	_, broken = keeper.AllInvariants(app.BankKeeper)(ctx)
+	suite.Require().False(broken)
+
+	// a denom missing from the supply breaks the invariant
+	app.BankKeeper.SetSupply(ctx, types.NewSupply(totalSupply.Add(newFooCoin(100))))
+
+	msg, broken := keeper.TotalSupply(app.BankKeeper)(ctx)
+	suite.Require().True(broken)
+	suite.Require().Contains(msg, "bar: sum of accounts coins 50, supply 0")
+
+	_, broken = keeper.AllInvariants(app.BankKeeper)(ctx)
+	suite.Require().True(broken)
+}
+
 func (suite *IntegrationTestSuite) TestSupply_SendCoins() {
 	app := simapp.Setup(false)
 	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1})
@@ -138,17 +196,17 @@ func (suite *IntegrationTestSuite) TestSupply_SendCoins() {
 	suite.Require().NoError(
 		keeper.SendCoinsFromModuleToModule(ctx, holderAcc.GetName(), auth.Burner, initCoins),
 	)
-	suite.Require().Equal(sdk.Coins(nil), getCoinsByName(ctx, keeper, authKeeper, holderAcc.GetName()))
+	suite.Require().Equal(sdk.NewCoins(), getCoinsByName(ctx, keeper, authKeeper, holderAcc.GetName()))
 	suite.Require().Equal(initCoins, getCoinsByName(ctx, keeper, authKeeper, auth.Burner))
 
 	suite.Require().NoError(
 		keeper.SendCoinsFromModuleToAccount(ctx, auth.Burner, baseAcc.GetAddress(), initCoins),
 	)
-	suite.Require().Equal(sdk.Coins(nil), getCoinsByName(ctx, keeper, authKeeper, auth.Burner))
+	suite.Require().Equal(sdk.NewCoins(), getCoinsByName(ctx, keeper, authKeeper, auth.Burner))
 	suite.Require().Equal(initCoins, keeper.GetAllBalances(ctx, baseAcc.GetAddress()))
 
 	suite.Require().NoError(keeper.SendCoinsFromAccountToModule(ctx, baseAcc.GetAddress(), auth.Burner, initCoins))
-	suite.Require().Equal(sdk.Coins(nil), keeper.GetAllBalances(ctx, baseAcc.GetAddress()))
+	suite.Require().Equal(sdk.NewCoins(), keeper.GetAllBalances(ctx, baseAcc.GetAddress()))
 	suite.Require().Equal(initCoins, getCoinsByName(ctx, keeper, authKeeper, auth.Burner))
 }
 
@@ -244,7 +302,7 @@ func (suite *IntegrationTestSuite) TestSupply_BurnCoins() {
 
 	err = keeper.BurnCoins(ctx, auth.Burner, initCoins)
 	suite.Require().NoError(err)
-	suite.Require().Equal(sdk.Coins(nil), getCoinsByName(ctx, keeper, authKeeper, auth.Burner))
+	suite.Require().Equal(sdk.NewCoins(), getCoinsByName(ctx, keeper, authKeeper, auth.Burner))
 	suite.Require().Equal(initialSupply.GetTotal().Sub(initCoins), keeper.GetSupply(ctx).GetTotal())
 
 	// test same functionality on module account with multiple permissions
@@ -257,7 +315,7 @@ func (suite *IntegrationTestSuite) TestSupply_BurnCoins() {
 
 	err = keeper.BurnCoins(ctx, multiPermAcc.GetName(), initCoins)
 	suite.Require().NoError(err)
-	suite.Require().Equal(sdk.Coins(nil), getCoinsByName(ctx, keeper, authKeeper, multiPermAcc.GetName()))
+	suite.Require().Equal(sdk.NewCoins(), getCoinsByName(ctx, keeper, authKeeper, multiPermAcc.GetName()))
 	suite.Require().Equal(initialSupply.GetTotal().Sub(initCoins), keeper.GetSupply(ctx).GetTotal())
 }
 
@@ -422,9 +480,46 @@ func (suite *IntegrationTestSuite) TestBalance() {
 
 func (suite *IntegrationTestSuite) TestSendEnabled() {
 	app, ctx := suite.app, suite.ctx
-	enabled := false
-	app.BankKeeper.SetSendEnabled(ctx, enabled)
-	suite.Require().Equal(enabled, app.BankKeeper.GetSendEnabled(ctx))
+	enabled := true
+	params := types.DefaultParams()
+	suite.Require().Equal(enabled, params.DefaultSendEnabled)
+
+	app.BankKeeper.SetParams(ctx, params)
+
+	bondCoin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())
+	fooCoin := sdk.NewCoin("foocoin", sdk.OneInt())
+	barCoin := sdk.NewCoin("barcoin", sdk.OneInt())
+
+	// assert with default (all denom) send enabled both Bar and Bond Denom are enabled
+	suite.Require().Equal(enabled, app.BankKeeper.IsSendEnabledCoin(ctx, barCoin))
+	suite.Require().Equal(enabled, app.BankKeeper.IsSendEnabledCoin(ctx, bondCoin))
+
+	// Both coins should be send enabled.
+	err := app.BankKeeper.IsSendEnabledCoins(ctx, fooCoin, bondCoin)
+	suite.Require().NoError(err)
+
+	// Set default send_enabled to !enabled, add a foodenom that overrides default as enabled
+	params.DefaultSendEnabled = !enabled
+	params = params.SetSendEnabledParam(fooCoin.Denom, enabled)
+	app.BankKeeper.SetParams(ctx, params)
+
+	// Expect our specific override to be enabled, others to be !enabled.
+	suite.Require().Equal(enabled, app.BankKeeper.IsSendEnabledCoin(ctx, fooCoin))
+	suite.Require().Equal(!enabled, app.BankKeeper.IsSendEnabledCoin(ctx, barCoin))
+	suite.Require().Equal(!enabled, app.BankKeeper.IsSendEnabledCoin(ctx, bondCoin))
+
+	// Foo coin should be send enabled.
+	err = app.BankKeeper.IsSendEnabledCoins(ctx, fooCoin)
+	suite.Require().NoError(err)
+
+	// Expect an error when one coin is not send enabled.
+	err = app.BankKeeper.IsSendEnabledCoins(ctx, fooCoin, bondCoin)
+	suite.Require().Error(err)
+	suite.Require().True(types.ErrSendDisabled.Is(err))
+
+	// Expect an error when all coins are not send enabled.
+	err = app.BankKeeper.IsSendEnabledCoins(ctx, bondCoin, barCoin)
+	suite.Require().Error(err)
 }
 
 func (suite *IntegrationTestSuite) TestHasBalance() {
@@ -495,7 +590,7 @@ func (suite *IntegrationTestSuite) TestMsgSendEvents() {
 func (suite *IntegrationTestSuite) TestMsgMultiSendEvents() {
 	app, ctx := suite.app, suite.ctx
 
-	app.BankKeeper.SetSendEnabled(ctx, true)
+	app.BankKeeper.SetParams(ctx, types.DefaultParams())
 
 	addr := sdk.AccAddress([]byte("addr1"))
 	addr2 := sdk.AccAddress([]byte("addr2"))
@@ -794,6 +889,43 @@ func (suite *IntegrationTestSuite) TestDelegateCoins() {
 	suite.Require().Equal(delCoins, app.BankKeeper.GetAllBalances(ctx, addr1))
 }
 
+func (suite *IntegrationTestSuite) TestDelegateCoinsTrackVestingAccount() {
+	app, ctx := suite.app, suite.ctx
+	now := tmtime.Now()
+	ctx = ctx.WithBlockHeader(abci.Header{Time: now})
+	endTime := now.Add(24 * time.Hour)
+
+	origCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	delCoins := sdk.NewCoins(sdk.NewInt64Coin("stake", 50))
+
+	addr1 := sdk.AccAddress([]byte("addr1"))
+	addrModule := sdk.AccAddress([]byte("moduleAcc"))
+
+	macc := app.AccountKeeper.NewAccountWithAddress(ctx, addrModule) // we don't need to define an actual module account bc we just need the address for testing
+	bacc := auth.NewBaseAccountWithAddress(addr1)
+	vacc := vesting.NewContinuousVestingAccount(bacc, origCoins, ctx.BlockHeader().Time.Unix(), endTime.Unix())
+
+	app.AccountKeeper.SetAccount(ctx, vacc)
+	app.AccountKeeper.SetAccount(ctx, macc)
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr1, origCoins))
+
+	ctx = ctx.WithBlockTime(now.Add(12 * time.Hour))
+
+	// require the delegation to be tracked by the stored vesting account
+	suite.Require().NoError(app.BankKeeper.DelegateCoins(ctx, addr1, addrModule, delCoins))
+
+	vacc = app.AccountKeeper.GetAccount(ctx, addr1).(*vesting.ContinuousVestingAccount)
+	suite.Require().Equal(delCoins, vacc.DelegatedVesting)
+	suite.Require().True(vacc.DelegatedFree.Empty())
+
+	// require the undelegation to be tracked by the stored vesting account
+	suite.Require().NoError(app.BankKeeper.UndelegateCoins(ctx, addrModule, addr1, delCoins))
+
+	vacc = app.AccountKeeper.GetAccount(ctx, addr1).(*vesting.ContinuousVestingAccount)
+	suite.Require().True(vacc.DelegatedVesting.Empty())
+	suite.Require().True(vacc.DelegatedFree.Empty())
+}
+
 func (suite *IntegrationTestSuite) TestDelegateCoins_Invalid() {
 	app, ctx := suite.app, suite.ctx
 
@@ -893,6 +1025,256 @@ func (suite *IntegrationTestSuite) TestUndelegateCoins_Invalid() {
 	suite.Require().Error(app.BankKeeper.UndelegateCoins(ctx, addrModule, addr1, delCoins))
 }
 
+func (suite *IntegrationTestSuite) TestSendRestriction() {
+	app, ctx := suite.app, suite.ctx
+	defer app.BankKeeper.ClearSendRestriction()
+
+	addr1 := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	addr3 := sdk.AccAddress([]byte("addr3"))
+	sanctioned := sdk.AccAddress([]byte("sanctioned"))
+
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr1, balances))
+
+	// block sends to the sanctioned address and redirect sends to addr2 to addr3
+	app.BankKeeper.AppendSendRestriction(func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		if toAddr.Equals(sanctioned) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is sanctioned", toAddr)
+		}
+
+		return toAddr, nil
+	})
+	app.BankKeeper.AppendSendRestriction(func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		if toAddr.Equals(addr2) {
+			return addr3, nil
+		}
+
+		return toAddr, nil
+	})
+
+	sendAmt := sdk.NewCoins(newFooCoin(10))
+
+	suite.Require().Error(app.BankKeeper.SendCoins(ctx, addr1, sanctioned, sendAmt))
+	suite.Require().Equal(balances, app.BankKeeper.GetAllBalances(ctx, addr1))
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, sanctioned).Empty())
+
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr2, sendAmt))
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, addr2).Empty())
+	suite.Require().Equal(sendAmt, app.BankKeeper.GetAllBalances(ctx, addr3))
+
+	// a blocked output aborts the whole multi-send
+	inputs := []types.Input{{Address: addr1, Coins: sdk.NewCoins(newFooCoin(20))}}
+	outputs := []types.Output{
+		{Address: addr3, Coins: sdk.NewCoins(newFooCoin(10))},
+		{Address: sanctioned, Coins: sdk.NewCoins(newFooCoin(10))},
+	}
+	suite.Require().Error(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	suite.Require().Equal(sendAmt, app.BankKeeper.GetAllBalances(ctx, addr3))
+
+	outputs[1].Address = addr2
+	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, addr2).Empty())
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(30)), app.BankKeeper.GetAllBalances(ctx, addr3))
+
+	// once cleared, sends are no longer restricted
+	app.BankKeeper.ClearSendRestriction()
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, sanctioned, sendAmt))
+	suite.Require().Equal(sendAmt, app.BankKeeper.GetAllBalances(ctx, sanctioned))
+}
+
+func (suite *IntegrationTestSuite) TestDenomOwners() {
+	app, ctx := suite.app, suite.ctx
+
+	addr1 := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	addr3 := sdk.AccAddress([]byte("addr3"))
+
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr1, sdk.NewCoins(newFooCoin(100), newBarCoin(10))))
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr2, sdk.NewCoins(newFooCoin(50))))
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr3, sdk.NewCoins(newBarCoin(20))))
+
+	getOwners := func(denom string) []sdk.AccAddress {
+		owners := []sdk.AccAddress{}
+		app.BankKeeper.IterateDenomOwners(ctx, denom, func(addr sdk.AccAddress) bool {
+			owners = append(owners, addr)
+			return false
+		})
+
+		return owners
+	}
+
+	suite.Require().Equal([]sdk.AccAddress{addr1, addr2}, getOwners(fooDenom))
+	suite.Require().Equal([]sdk.AccAddress{addr1, addr3}, getOwners(barDenom))
+
+	// sending a whole balance away removes the sender from the holders
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr2, addr3, sdk.NewCoins(newFooCoin(50))))
+	suite.Require().Equal([]sdk.AccAddress{addr1, addr3}, getOwners(fooDenom))
+
+	// clearing balances removes the account from the holders of all denoms
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr1, sdk.NewCoins()))
+	suite.Require().Equal([]sdk.AccAddress{addr3}, getOwners(fooDenom))
+	suite.Require().Equal([]sdk.AccAddress{addr3}, getOwners(barDenom))
+
+	owners := app.BankKeeper.GetDenomOwners(ctx, fooDenom, 1, 10)
+	suite.Require().Equal([]types.DenomOwner{types.NewDenomOwner(addr3, newFooCoin(50))}, owners)
+	suite.Require().Empty(app.BankKeeper.GetDenomOwners(ctx, fooDenom, 2, 10))
+}
+
+func (suite *IntegrationTestSuite) TestSetDenomMetaData() {
+	app, ctx := suite.app, suite.ctx
+
+	metadata := suite.getTestMetadata()
+
+	for _, m := range metadata {
+		app.BankKeeper.SetDenomMetaData(ctx, m)
+	}
+
+	actualMetadata, found := app.BankKeeper.GetDenomMetaData(ctx, metadata[1].Base)
+	suite.Require().True(found)
+	suite.Require().Equal(metadata[1], actualMetadata)
+
+	_, found = app.BankKeeper.GetDenomMetaData(ctx, "nonexistent")
+	suite.Require().False(found)
+}
+
+func (suite *IntegrationTestSuite) TestIterateAllDenomMetaData() {
+	app, ctx := suite.app, suite.ctx
+
+	expectedMetadata := suite.getTestMetadata()
+
+	// set metadata
+	for _, m := range expectedMetadata {
+		app.BankKeeper.SetDenomMetaData(ctx, m)
+	}
+
+	// retrieve metadata, which is ordered by base denom
+	actualMetadata := make([]types.Metadata, 0)
+	app.BankKeeper.IterateAllDenomMetaData(ctx, func(metadata types.Metadata) bool {
+		actualMetadata = append(actualMetadata, metadata)
+		return false
+	})
+
+	// execute checks
+	suite.Require().Equal(expectedMetadata, actualMetadata)
+	suite.Require().Equal(expectedMetadata, app.BankKeeper.GetAllDenomMetaData(ctx))
+}
+
+func (suite *IntegrationTestSuite) getTestMetadata() []types.Metadata {
+	return []types.Metadata{
+		{
+			Description: "The native staking token of the Cosmos Hub.",
+			DenomUnits: []*types.DenomUnit{
+				{Denom: "uatom", Exponent: 0, Aliases: []string{"microatom"}},
+				{Denom: "matom", Exponent: 3, Aliases: []string{"milliatom"}},
+				{Denom: "atom", Exponent: 6, Aliases: nil},
+			},
+			Base:    "uatom",
+			Display: "atom",
+			Symbol:  "ATOM",
+		},
+		{
+			Description: "The native staking token of the TOKEN network.",
+			DenomUnits: []*types.DenomUnit{
+				{Denom: "utoken", Exponent: 0, Aliases: []string{"microtoken"}},
+				{Denom: "mtoken", Exponent: 3, Aliases: []string{"millitoken"}},
+				{Denom: "token", Exponent: 6, Aliases: nil},
+			},
+			Base:    "utoken",
+			Display: "token",
+		},
+	}
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
+
+// mockBankHooks records the calls to the bank hooks, and fails the sends to
+// blockedAddr.
+type mockBankHooks struct {
+	blockedAddr sdk.AccAddress
+	panicOnSend bool
+
+	sends  []sdk.Coins
+	mints  []sdk.Coins
+	burns  []sdk.Coins
+	before int
+}
+
+func (h *mockBankHooks) BeforeSend(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) error {
+	h.before++
+	if toAddr.Equals(h.blockedAddr) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is blocked", toAddr)
+	}
+
+	return nil
+}
+
+func (h *mockBankHooks) AfterSend(_ sdk.Context, _, _ sdk.AccAddress, amt sdk.Coins) {
+	if h.panicOnSend {
+		panic("after send")
+	}
+
+	h.sends = append(h.sends, amt)
+}
+
+func (h *mockBankHooks) AfterMint(_ sdk.Context, _ string, amt sdk.Coins) {
+	h.mints = append(h.mints, amt)
+}
+
+func (h *mockBankHooks) AfterBurn(_ sdk.Context, _ string, amt sdk.Coins) {
+	h.burns = append(h.burns, amt)
+}
+
+func (suite *IntegrationTestSuite) TestBankHooks() {
+	app, ctx := suite.app, suite.ctx
+
+	addr1 := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	blocked := sdk.AccAddress([]byte("blocked"))
+
+	hooks := &mockBankHooks{blockedAddr: blocked}
+	app.BankKeeper.SetHooks(hooks)
+	suite.Require().Panics(func() { app.BankKeeper.SetHooks(hooks) })
+
+	balances := sdk.NewCoins(newFooCoin(100))
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr1, balances))
+
+	sendAmt := sdk.NewCoins(newFooCoin(10))
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr2, sendAmt))
+	suite.Require().Equal([]sdk.Coins{sendAmt}, hooks.sends)
+
+	// BeforeSend blocks the transfer before any balance is changed
+	suite.Require().Error(app.BankKeeper.SendCoins(ctx, addr1, blocked, sendAmt))
+	suite.Require().Equal(balances.Sub(sendAmt), app.BankKeeper.GetAllBalances(ctx, addr1))
+
+	inputs := []types.Input{{Address: addr1, Coins: sdk.NewCoins(newFooCoin(20))}}
+	outputs := []types.Output{
+		{Address: addr2, Coins: sendAmt},
+		{Address: blocked, Coins: sendAmt},
+	}
+	suite.Require().Error(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	suite.Require().Equal(sendAmt, app.BankKeeper.GetAllBalances(ctx, addr2))
+
+	outputs[1].Address = addr2
+	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	suite.Require().Equal([]sdk.Coins{sendAmt, sendAmt, sendAmt}, hooks.sends)
+	suite.Require().Equal(6, hooks.before)
+
+	// a panicking AfterSend hook does not abort the transfer
+	hooks.panicOnSend = true
+	suite.Require().NotPanics(func() {
+		suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr2, sendAmt))
+	})
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(40)), app.BankKeeper.GetAllBalances(ctx, addr2))
+	hooks.panicOnSend = false
+
+	mintAmt := sdk.NewCoins(newBarCoin(30))
+	suite.Require().NoError(app.BankKeeper.MintCoins(ctx, mint.ModuleName, mintAmt))
+	suite.Require().Equal([]sdk.Coins{mintAmt}, hooks.mints)
+
+	suite.Require().NoError(app.BankKeeper.SendCoinsFromModuleToModule(ctx, mint.ModuleName, gov.ModuleName, mintAmt))
+	suite.Require().NoError(app.BankKeeper.BurnCoins(ctx, gov.ModuleName, mintAmt))
+	suite.Require().Equal([]sdk.Coins{mintAmt}, hooks.burns)
+}
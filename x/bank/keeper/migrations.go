@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v040 "github.com/cosmos/cosmos-sdk/x/bank/legacy/v0_40"
+)
+
+// Migrator performs the in-place store migrations of the x/bank module.
+type Migrator struct {
+	keeper BaseKeeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper BaseKeeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the x/bank state from the consensus version 1, i.e.
+// v0.39, to the version 2.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return v040.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc, m.keeper.paramSpace)
+}
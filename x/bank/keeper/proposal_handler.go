@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// HandleSetSendEnabledProposal is a handler for executing a passed set send
+// enabled proposal
+func HandleSetSendEnabledProposal(ctx sdk.Context, k Keeper, p *types.SetSendEnabledProposal) error {
+	params := k.GetParams(ctx)
+
+	for _, se := range p.SendEnabled {
+		params = params.SetSendEnabledParam(se.Denom, se.Enabled)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSetSendEnabled,
+				sdk.NewAttribute(types.AttributeKeyDenom, se.Denom),
+				sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(se.Enabled)),
+			),
+		)
+	}
+
+	for _, denom := range p.UseDefaultFor {
+		params = params.DeleteSendEnabledParam(denom)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSetSendEnabled,
+				sdk.NewAttribute(types.AttributeKeyDenom, denom),
+				sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(params.DefaultSendEnabled)),
+			),
+		)
+	}
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	k.SetParams(ctx, params)
+	return nil
+}
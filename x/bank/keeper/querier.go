@@ -3,7 +3,6 @@ package keeper
 import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
-	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -20,12 +19,27 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case types.QueryAllBalances:
 			return queryAllBalance(ctx, req, k)
 
+		case types.QuerySpendableBalances:
+			return querySpendableBalances(ctx, req, k)
+
+		case types.QuerySendEnabled:
+			return querySendEnabled(ctx, req, k)
+
 		case types.QueryTotalSupply:
 			return queryTotalSupply(ctx, req, k)
 
 		case types.QuerySupplyOf:
 			return querySupplyOf(ctx, req, k)
 
+		case types.QueryDenomMetadata:
+			return queryDenomMetadata(ctx, req, k)
+
+		case types.QueryDenomsMetadata:
+			return queryDenomsMetadata(ctx, k)
+
+		case types.QueryDenomOwners:
+			return queryDenomOwners(ctx, req, k)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint: %s", types.ModuleName, path[0])
 		}
@@ -66,6 +80,55 @@ func queryAllBalance(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte,
 	return bz, nil
 }
 
+func querySpendableBalances(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QuerySpendableBalancesParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	balances := k.SpendableCoins(ctx, params.Address)
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, balances)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func querySendEnabled(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QuerySendEnabledParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	sendEnabled := k.GetParams(ctx).SendEnabled
+	if len(params.Denoms) > 0 {
+		denoms := make(map[string]bool, len(params.Denoms))
+		for _, denom := range params.Denoms {
+			denoms[denom] = true
+		}
+
+		filtered := []*types.SendEnabled{}
+		for _, se := range sendEnabled {
+			if denoms[se.Denom] {
+				filtered = append(filtered, se)
+			}
+		}
+
+		sendEnabled = filtered
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, sendEnabled)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
 func queryTotalSupply(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
 	var params types.QueryTotalSupplyParams
 
@@ -74,15 +137,15 @@ func queryTotalSupply(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte,
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	totalSupply := k.GetSupply(ctx).GetTotal()
-
-	start, end := client.Paginate(len(totalSupply), params.Page, params.Limit, 100)
-	if start < 0 || end < 0 {
-		totalSupply = sdk.Coins{}
-	} else {
-		totalSupply = totalSupply[start:end]
+	if params.Page < 1 {
+		params.Page = 1
+	}
+	if params.Limit < 1 {
+		params.Limit = 100
 	}
 
+	totalSupply := k.GetPaginatedTotalSupply(ctx, params.Page, params.Limit)
+
 	res, err := totalSupply.MarshalJSON()
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
@@ -99,7 +162,7 @@ func querySupplyOf(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, er
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	supply := k.GetSupply(ctx).GetTotal().AmountOf(params.Denom)
+	supply := k.GetSupplyOf(ctx, params.Denom)
 
 	res, err := supply.MarshalJSON()
 	if err != nil {
@@ -108,3 +171,64 @@ func querySupplyOf(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, er
 
 	return res, nil
 }
+
+func queryDenomMetadata(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryDenomMetadataParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	metadata, found := k.GetDenomMetaData(ctx, params.Denom)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "no client metadata for denom %s", params.Denom)
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, metadata)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryDenomsMetadata(ctx sdk.Context, k Keeper) ([]byte, error) {
+	metadata := k.GetAllDenomMetaData(ctx)
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, metadata)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryDenomOwners(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryDenomOwnersParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	if err := sdk.ValidateDenom(params.Denom); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if params.Page < 1 {
+		params.Page = 1
+	}
+	if params.Limit < 1 {
+		params.Limit = 100
+	}
+
+	owners := k.GetDenomOwners(ctx, params.Denom, params.Page, params.Limit)
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, owners)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
@@ -2,11 +2,14 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -84,6 +87,80 @@ func (suite *IntegrationTestSuite) TestQuerier_QueryAllBalances() {
 	suite.True(balances.IsEqual(origCoins))
 }
 
+func (suite *IntegrationTestSuite) TestQuerier_QuerySpendableBalances() {
+	app, ctx := suite.app, suite.ctx
+	now := tmtime.Now()
+	ctx = ctx.WithBlockHeader(abci.Header{Time: now})
+	endTime := now.Add(24 * time.Hour)
+
+	_, _, addr := authtypes.KeyTestPubAddr()
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.ModuleName, types.QuerySpendableBalances),
+		Data: []byte{},
+	}
+
+	querier := keeper.NewQuerier(app.BankKeeper)
+
+	res, err := querier(ctx, []string{types.QuerySpendableBalances}, req)
+	suite.Require().NotNil(err)
+	suite.Require().Nil(res)
+
+	// half of the vesting foo coins are locked, the bar coins are not vesting
+	vestingCoins := sdk.NewCoins(newFooCoin(100))
+	origCoins := sdk.NewCoins(newFooCoin(100), newBarCoin(30))
+	vacc := vesting.NewContinuousVestingAccount(
+		authtypes.NewBaseAccountWithAddress(addr), vestingCoins, now.Unix(), endTime.Unix(),
+	)
+
+	app.AccountKeeper.SetAccount(ctx, vacc)
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr, origCoins))
+
+	ctx = ctx.WithBlockTime(now.Add(12 * time.Hour))
+	req.Data = app.Codec().MustMarshalJSON(types.NewQuerySpendableBalancesParams(addr))
+	res, err = querier(ctx, []string{types.QuerySpendableBalances}, req)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+
+	var balances sdk.Coins
+	suite.Require().NoError(app.Codec().UnmarshalJSON(res, &balances))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(50), newBarCoin(30)), balances)
+}
+
+func (suite *IntegrationTestSuite) TestQuerier_QuerySendEnabled() {
+	app, ctx := suite.app, suite.ctx
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.ModuleName, types.QuerySendEnabled),
+		Data: []byte{},
+	}
+
+	querier := keeper.NewQuerier(app.BankKeeper)
+
+	res, err := querier(ctx, []string{types.QuerySendEnabled}, req)
+	suite.Require().NotNil(err)
+	suite.Require().Nil(res)
+
+	fooEnabled := types.NewSendEnabled(fooDenom, true)
+	barDisabled := types.NewSendEnabled(barDenom, false)
+	app.BankKeeper.SetParams(ctx, types.NewParams(true, []*types.SendEnabled{fooEnabled, barDisabled}))
+
+	req.Data = app.Codec().MustMarshalJSON(types.NewQuerySendEnabledParams(nil))
+	res, err = querier(ctx, []string{types.QuerySendEnabled}, req)
+	suite.Require().NoError(err)
+
+	var sendEnabled []*types.SendEnabled
+	suite.Require().NoError(app.Codec().UnmarshalJSON(res, &sendEnabled))
+	suite.Require().Equal([]*types.SendEnabled{fooEnabled, barDisabled}, sendEnabled)
+
+	// denoms without an entry are omitted
+	req.Data = app.Codec().MustMarshalJSON(types.NewQuerySendEnabledParams([]string{barDenom, "unknown"}))
+	res, err = querier(ctx, []string{types.QuerySendEnabled}, req)
+	suite.Require().NoError(err)
+
+	sendEnabled = nil
+	suite.Require().NoError(app.Codec().UnmarshalJSON(res, &sendEnabled))
+	suite.Require().Equal([]*types.SendEnabled{barDisabled}, sendEnabled)
+}
+
 func (suite *IntegrationTestSuite) TestQuerier_QueryTotalSupply() {
 	app, ctx := suite.app, suite.ctx
 	expectedTotalSupply := bank.NewSupply(sdk.NewCoins(sdk.NewInt64Coin("test", 400000000)))
@@ -139,6 +216,98 @@ func (suite *IntegrationTestSuite) TestQuerier_QueryTotalSupplyOf() {
 	suite.Require().Equal(test1Supply.Amount, resp)
 }
 
+func (suite *IntegrationTestSuite) TestQuerier_QueryDenomMetadata() {
+	app, ctx := suite.app, suite.ctx
+
+	expectedMetadata := suite.getTestMetadata()
+	for _, metadata := range expectedMetadata {
+		app.BankKeeper.SetDenomMetaData(ctx, metadata)
+	}
+
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.ModuleName, types.QueryDenomMetadata),
+		Data: []byte{},
+	}
+
+	querier := keeper.NewQuerier(app.BankKeeper)
+
+	res, err := querier(ctx, []string{types.QueryDenomMetadata}, req)
+	suite.Require().NotNil(err)
+	suite.Require().Nil(res)
+
+	req.Data = app.Codec().MustMarshalJSON(types.NewQueryDenomMetadataParams("nonexistent"))
+	res, err = querier(ctx, []string{types.QueryDenomMetadata}, req)
+	suite.Require().Error(err)
+	suite.Require().Nil(res)
+
+	req.Data = app.Codec().MustMarshalJSON(types.NewQueryDenomMetadataParams(expectedMetadata[0].Base))
+	res, err = querier(ctx, []string{types.QueryDenomMetadata}, req)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+
+	var resp types.Metadata
+	suite.Require().NoError(app.Codec().UnmarshalJSON(res, &resp))
+	suite.Require().Equal(expectedMetadata[0], resp)
+}
+
+func (suite *IntegrationTestSuite) TestQuerier_QueryDenomsMetadata() {
+	app, ctx := suite.app, suite.ctx
+
+	expectedMetadata := suite.getTestMetadata()
+	for _, metadata := range expectedMetadata {
+		app.BankKeeper.SetDenomMetaData(ctx, metadata)
+	}
+
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.ModuleName, types.QueryDenomsMetadata),
+		Data: []byte{},
+	}
+
+	querier := keeper.NewQuerier(app.BankKeeper)
+
+	res, err := querier(ctx, []string{types.QueryDenomsMetadata}, req)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+
+	var resp []types.Metadata
+	suite.Require().NoError(app.Codec().UnmarshalJSON(res, &resp))
+	suite.Require().Equal(expectedMetadata, resp)
+}
+
+func (suite *IntegrationTestSuite) TestQuerier_QueryDenomOwners() {
+	app, ctx := suite.app, suite.ctx
+
+	// addresses are chosen in ascending order
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress([]byte("addr1_______________")),
+		sdk.AccAddress([]byte("addr2_______________")),
+		sdk.AccAddress([]byte("addr3_______________")),
+	}
+	for i, addr := range addrs {
+		suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr, sdk.NewCoins(newFooCoin(int64(i+1)))))
+	}
+
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", types.ModuleName, types.QueryDenomOwners),
+		Data: []byte{},
+	}
+
+	querier := keeper.NewQuerier(app.BankKeeper)
+
+	res, err := querier(ctx, []string{types.QueryDenomOwners}, req)
+	suite.Require().NotNil(err)
+	suite.Require().Nil(res)
+
+	req.Data = app.Codec().MustMarshalJSON(types.NewQueryDenomOwnersParams(fooDenom, 2, 2))
+	res, err = querier(ctx, []string{types.QueryDenomOwners}, req)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+
+	var resp []types.DenomOwner
+	suite.Require().NoError(app.Codec().UnmarshalJSON(res, &resp))
+	suite.Require().Equal([]types.DenomOwner{types.NewDenomOwner(addrs[2], newFooCoin(3))}, resp)
+}
+
 func (suite *IntegrationTestSuite) TestQuerierRouteNotFound() {
 	app, ctx := suite.app, suite.ctx
 	req := abci.RequestQuery{
@@ -23,10 +23,19 @@ type SendKeeper interface {
 	SetBalance(ctx sdk.Context, addr sdk.AccAddress, balance sdk.Coin) error
 	SetBalances(ctx sdk.Context, addr sdk.AccAddress, balances sdk.Coins) error
 
-	GetSendEnabled(ctx sdk.Context) bool
-	SetSendEnabled(ctx sdk.Context, enabled bool)
+	GetParams(ctx sdk.Context) types.Params
+	SetParams(ctx sdk.Context, params types.Params)
 
-	BlacklistedAddr(addr sdk.AccAddress) bool
+	IsSendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool
+	IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error
+
+	BlockedAddr(addr sdk.AccAddress) bool
+
+	AppendSendRestriction(restriction types.SendRestrictionFn)
+	PrependSendRestriction(restriction types.SendRestrictionFn)
+	ClearSendRestriction()
+
+	SetHooks(bh types.BankHooks)
 }
 
 var _ SendKeeper = (*BaseSendKeeper)(nil)
@@ -42,26 +51,54 @@ type BaseSendKeeper struct {
 	paramSpace paramtypes.Subspace
 
 	// list of addresses that are restricted from receiving transactions
-	blacklistedAddrs map[string]bool
+	blockedAddrs map[string]bool
+
+	// the send restriction is shared by all the copies of the keeper, so that
+	// restrictions added after the keeper was passed to other modules apply
+	sendRestriction *sendRestriction
+
+	hooks *bankHooks
 }
 
 func NewBaseSendKeeper(
-	cdc codec.Marshaler, storeKey sdk.StoreKey, ak types.AccountKeeper, paramSpace paramtypes.Subspace, blacklistedAddrs map[string]bool,
+	cdc codec.Marshaler, storeKey sdk.StoreKey, ak types.AccountKeeper, paramSpace paramtypes.Subspace, blockedAddrs map[string]bool,
 ) BaseSendKeeper {
 
 	return BaseSendKeeper{
-		BaseViewKeeper:   NewBaseViewKeeper(cdc, storeKey, ak),
-		cdc:              cdc,
-		ak:               ak,
-		storeKey:         storeKey,
-		paramSpace:       paramSpace,
-		blacklistedAddrs: blacklistedAddrs,
+		BaseViewKeeper:  NewBaseViewKeeper(cdc, storeKey, ak),
+		cdc:             cdc,
+		ak:              ak,
+		storeKey:        storeKey,
+		paramSpace:      paramSpace,
+		blockedAddrs:    blockedAddrs,
+		sendRestriction: newSendRestriction(),
+		hooks:           &bankHooks{},
 	}
 }
 
+// AppendSendRestriction adds the provided SendRestrictionFn to run after the
+// previously provided restrictions.
+func (k BaseSendKeeper) AppendSendRestriction(restriction types.SendRestrictionFn) {
+	k.sendRestriction.append(restriction)
+}
+
+// PrependSendRestriction adds the provided SendRestrictionFn to run before the
+// previously provided restrictions.
+func (k BaseSendKeeper) PrependSendRestriction(restriction types.SendRestrictionFn) {
+	k.sendRestriction.prepend(restriction)
+}
+
+// ClearSendRestriction removes the send restriction (if there is one).
+func (k BaseSendKeeper) ClearSendRestriction() {
+	k.sendRestriction.clear()
+}
+
 // InputOutputCoins performs multi-send functionality. It accepts a series of
 // inputs that correspond to a series of outputs. It returns an error if the
 // inputs and outputs don't lineup or if any single transfer of tokens fails.
+// The send restriction and the BeforeSend hook are applied to each output once
+// for each input, in order, before any balance is changed, and the AfterSend
+// hook once the balances are updated.
 func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input, outputs []types.Output) error {
 	// Safety check ensuring that when sending coins the keeper must maintain the
 	// Check supply invariant and validity of Coins.
@@ -69,6 +106,27 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		return err
 	}
 
+	// apply the send restriction to all the outputs first, so that a blocked
+	// output aborts the multi-send before any balance is changed
+	restrictedOutputs := make([]types.Output, len(outputs))
+	for i, out := range outputs {
+		toAddr := out.Address
+		for _, in := range inputs {
+			var err error
+			toAddr, err = k.sendRestriction.apply(ctx, in.Address, toAddr, out.Coins)
+			if err != nil {
+				return err
+			}
+
+			if err := k.beforeSend(ctx, in.Address, toAddr, out.Coins); err != nil {
+				return err
+			}
+		}
+
+		restrictedOutputs[i] = types.NewOutput(toAddr, out.Coins)
+	}
+	outputs = restrictedOutputs
+
 	for _, in := range inputs {
 		_, err := k.SubtractCoins(ctx, in.Address, in.Coins)
 		if err != nil {
@@ -98,12 +156,27 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		)
 	}
 
+	for _, out := range outputs {
+		for _, in := range inputs {
+			k.afterSend(ctx, in.Address, out.Address, out.Coins)
+		}
+	}
+
 	return nil
 }
 
 // SendCoins transfers amt coins from a sending account to a receiving account.
 // An error is returned upon failure.
 func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	toAddr, err := k.sendRestriction.apply(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return err
+	}
+
+	if err := k.beforeSend(ctx, fromAddr, toAddr, amt); err != nil {
+		return err
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeTransfer,
@@ -116,7 +189,7 @@ func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAd
 		),
 	})
 
-	_, err := k.SubtractCoins(ctx, fromAddr, amt)
+	_, err = k.SubtractCoins(ctx, fromAddr, amt)
 	if err != nil {
 		return err
 	}
@@ -135,6 +208,8 @@ func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAd
 		k.ak.SetAccount(ctx, k.ak.NewAccountWithAddress(ctx, toAddr))
 	}
 
+	k.afterSend(ctx, fromAddr, toAddr, amt)
+
 	return nil
 }
 
@@ -208,6 +283,7 @@ func (k BaseSendKeeper) ClearBalances(ctx sdk.Context, addr sdk.AccAddress) {
 
 	for _, key := range keys {
 		accountStore.Delete(key)
+		store.Delete(types.DenomAddressKey(string(key), addr))
 	}
 }
 
@@ -237,26 +313,98 @@ func (k BaseSendKeeper) SetBalance(ctx sdk.Context, addr sdk.AccAddress, balance
 	balancesStore := prefix.NewStore(store, types.BalancesPrefix)
 	accountStore := prefix.NewStore(balancesStore, addr.Bytes())
 
+	// Zero balances are not stored, so that an account's store only holds the
+	// denominations it actually holds. This also keeps the denom to holders
+	// index, which only references non-zero balances, in sync.
+	denomAddrKey := types.DenomAddressKey(balance.Denom, addr)
+	if balance.IsZero() {
+		accountStore.Delete([]byte(balance.Denom))
+		store.Delete(denomAddrKey)
+
+		return nil
+	}
+
 	bz := k.cdc.MustMarshalBinaryBare(&balance)
 	accountStore.Set([]byte(balance.Denom), bz)
+	store.Set(denomAddrKey, []byte{0})
 
 	return nil
 }
 
-// GetSendEnabled returns the current SendEnabled
-func (k BaseSendKeeper) GetSendEnabled(ctx sdk.Context) bool {
-	var enabled bool
-	k.paramSpace.Get(ctx, types.ParamStoreKeySendEnabled, &enabled)
-	return enabled
+// GetParams returns the total set of bank parameters.
+func (k BaseSendKeeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
 }
 
-// SetSendEnabled sets the send enabled
-func (k BaseSendKeeper) SetSendEnabled(ctx sdk.Context, enabled bool) {
-	k.paramSpace.Set(ctx, types.ParamStoreKeySendEnabled, &enabled)
+// SetParams sets the total set of bank parameters.
+func (k BaseSendKeeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
 }
 
-// BlacklistedAddr checks if a given address is blacklisted (i.e restricted from
+// IsSendEnabledCoins checks the coins provided and returns an ErrSendDisabled
+// if any of the coins are not configured for sending.
+func (k BaseSendKeeper) IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error {
+	params := k.GetParams(ctx)
+	for _, coin := range coins {
+		if !params.SendEnabledDenom(coin.Denom) {
+			return sdkerrors.Wrapf(types.ErrSendDisabled, "%s transfers are currently disabled", coin.Denom)
+		}
+	}
+
+	return nil
+}
+
+// IsSendEnabledCoin returns the current SendEnabled status of the provided
+// coin's denom.
+func (k BaseSendKeeper) IsSendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool {
+	return k.GetParams(ctx).SendEnabledDenom(coin.Denom)
+}
+
+// BlockedAddr checks if a given address is blocked (i.e restricted from
 // receiving funds)
-func (k BaseSendKeeper) BlacklistedAddr(addr sdk.AccAddress) bool {
-	return k.blacklistedAddrs[addr.String()]
+func (k BaseSendKeeper) BlockedAddr(addr sdk.AccAddress) bool {
+	return k.blockedAddrs[addr.String()]
+}
+
+// sendRestriction is a struct that houses a SendRestrictionFn. It exists so
+// that the SendRestrictionFn can be updated in the BaseSendKeeper without
+// needing to have a pointer receiver on the keeper methods.
+type sendRestriction struct {
+	fn types.SendRestrictionFn
+}
+
+// newSendRestriction creates a new sendRestriction with nil send restriction.
+func newSendRestriction() *sendRestriction {
+	return &sendRestriction{
+		fn: nil,
+	}
+}
+
+// append adds the provided restriction to this, to be run after the existing
+// function.
+func (r *sendRestriction) append(restriction types.SendRestrictionFn) {
+	r.fn = r.fn.Then(restriction)
+}
+
+// prepend adds the provided restriction to this, to be run before the existing
+// function.
+func (r *sendRestriction) prepend(restriction types.SendRestrictionFn) {
+	r.fn = restriction.Then(r.fn)
+}
+
+// clear removes the send restriction (sets it to nil).
+func (r *sendRestriction) clear() {
+	r.fn = nil
+}
+
+// apply applies the send restriction if there is one, and returns the
+// receiver address the coins must be sent to. If there is no restriction,
+// toAddr is returned unchanged.
+func (r *sendRestriction) apply(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	if r == nil || r.fn == nil {
+		return toAddr, nil
+	}
+
+	return r.fn(ctx, fromAddr, toAddr, amt)
 }
@@ -29,6 +29,9 @@ type ViewKeeper interface {
 
 	IterateAccountBalances(ctx sdk.Context, addr sdk.AccAddress, cb func(coin sdk.Coin) (stop bool))
 	IterateAllBalances(ctx sdk.Context, cb func(address sdk.AccAddress, coin sdk.Coin) (stop bool))
+
+	IterateDenomOwners(ctx sdk.Context, denom string, cb func(address sdk.AccAddress) (stop bool))
+	GetDenomOwners(ctx sdk.Context, denom string, page, limit int) []types.DenomOwner
 }
 
 // BaseViewKeeper implements a read only keeper implementation of ViewKeeper.
@@ -129,6 +132,43 @@ func (k BaseViewKeeper) IterateAllBalances(ctx sdk.Context, cb func(sdk.AccAddre
 	}
 }
 
+// IterateDenomOwners iterates over the addresses of all the accounts holding a
+// non-zero balance of the given denomination, ordered by address, and provides
+// them to a callback. If true is returned from the callback, iteration is
+// halted.
+func (k BaseViewKeeper) IterateDenomOwners(ctx sdk.Context, denom string, cb func(sdk.AccAddress) bool) {
+	store := ctx.KVStore(k.storeKey)
+	denomPrefixStore := prefix.NewStore(store, types.CreateDenomAddressPrefix(denom))
+
+	iterator := denomPrefixStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(sdk.AccAddress(iterator.Key())) {
+			break
+		}
+	}
+}
+
+// GetDenomOwners returns a page of the accounts holding a non-zero balance of
+// the given denomination, along with their balance, ordered by address. The
+// denom to holders index is used, so that the balances of other accounts are
+// not scanned.
+func (k BaseViewKeeper) GetDenomOwners(ctx sdk.Context, denom string, page, limit int) []types.DenomOwner {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIteratorPaginated(store, types.CreateDenomAddressPrefix(denom), uint(page), uint(limit))
+	defer iterator.Close()
+
+	prefixLen := len(types.CreateDenomAddressPrefix(denom))
+	owners := []types.DenomOwner{}
+	for ; iterator.Valid(); iterator.Next() {
+		addr := sdk.AccAddress(iterator.Key()[prefixLen:])
+		owners = append(owners, types.NewDenomOwner(addr, k.GetBalance(ctx, addr, denom)))
+	}
+
+	return owners
+}
+
 // LockedCoins returns all the coins that are not spendable (i.e. locked) for an
 // account by address. For standard accounts, the result will always be no coins.
 // For vesting accounts, LockedCoins is delegated to the concrete vesting account
@@ -146,15 +186,19 @@ func (k BaseViewKeeper) LockedCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Co
 }
 
 // SpendableCoins returns the total balances of spendable coins for an account
-// by address. If the account has no spendable coins, an empty Coins slice is
-// returned.
+// by address, i.e. its balances minus its locked coins. Each denomination is
+// computed separately, so more coins of a denomination being locked than held
+// does not affect the other denominations. If the account has no spendable
+// coins, an empty Coins slice is returned.
 func (k BaseViewKeeper) SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
-	balances := k.GetAllBalances(ctx, addr)
 	locked := k.LockedCoins(ctx, addr)
 
-	spendable, hasNeg := balances.SafeSub(locked)
-	if hasNeg {
-		return sdk.NewCoins()
+	spendable := sdk.NewCoins()
+	for _, balance := range k.GetAllBalances(ctx, addr) {
+		amount := balance.Amount.Sub(locked.AmountOf(balance.Denom))
+		if amount.IsPositive() {
+			spendable = append(spendable, sdk.NewCoin(balance.Denom, amount))
+		}
 	}
 
 	return spendable
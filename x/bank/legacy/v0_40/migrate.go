@@ -0,0 +1,30 @@
+package v040
+
+import (
+	v036supply "github.com/cosmos/cosmos-sdk/x/bank/legacy/v0_36"
+	v039bank "github.com/cosmos/cosmos-sdk/x/bank/legacy/v0_39"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// Migrate accepts exported x/bank and x/supply genesis state from v0.39 and
+// migrates it to v0.40 x/bank genesis state. The migration includes:
+//
+// - Moving the send enabled flag to the DefaultSendEnabled param, no denom
+// overriding it.
+// - Pruning the empty balances, which are no longer stored.
+// - Moving the total supply from the x/supply genesis state.
+func Migrate(bankGenState v039bank.GenesisState, supplyGenState v036supply.GenesisState) types.GenesisState {
+	params := types.DefaultParams()
+	params.DefaultSendEnabled = bankGenState.SendEnabled
+
+	balances := make([]types.Balance, 0, len(bankGenState.Balances))
+	for _, balance := range bankGenState.Balances {
+		if balance.Coins.Empty() {
+			continue
+		}
+
+		balances = append(balances, types.Balance{Address: balance.Address, Coins: balance.Coins})
+	}
+
+	return types.NewGenesisState(params, types.SanitizeGenesisBalances(balances), supplyGenState.Supply, []types.Metadata{})
+}
@@ -0,0 +1,114 @@
+package v040
+
+import (
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// ParamStoreKeySendEnabled is the v0.39 key of the SendEnabled parameter,
+// enabling or disabling the sends of all the denominations.
+var ParamStoreKeySendEnabled = []byte("sendenabled")
+
+// MigrateStore performs an in-place store migration of the x/bank state of a
+// chain upgrading from v0.39. The balances are already stored under a key per
+// (address, denom) pair, so the migration includes:
+//
+// - Pruning the zero balances, which are no longer stored.
+// - Populating the denom to holders index from the non-zero balances.
+// - Splitting the Supply singleton into the total supply of each denom.
+// - Replacing the sendenabled parameter with the DefaultSendEnabled parameter,
+// set to its value, and an empty SendEnabled list.
+//
+// It is meant to be called from an x/upgrade handler. The paramSpace must be
+// the bank module's subspace with its key table set.
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc types.Codec, paramSpace paramtypes.Subspace) error {
+	if err := migrateParams(ctx, paramSpace); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(storeKey)
+
+	if err := migrateSupply(store, cdc); err != nil {
+		return err
+	}
+
+	balancesStore := prefix.NewStore(store, types.BalancesPrefix)
+
+	iterator := balancesStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var zeroBalanceKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		var balance sdk.Coin
+		if err := cdc.UnmarshalBinaryBare(iterator.Value(), &balance); err != nil {
+			return err
+		}
+
+		if balance.IsZero() {
+			zeroBalanceKeys = append(zeroBalanceKeys, iterator.Key())
+			continue
+		}
+
+		addr := types.AddressFromBalancesStore(iterator.Key())
+		store.Set(types.DenomAddressKey(balance.Denom, addr), []byte{0})
+	}
+
+	for _, key := range zeroBalanceKeys {
+		balancesStore.Delete(key)
+	}
+
+	return nil
+}
+
+// migrateParams replaces the v0.39 sendenabled parameter, applying to all the
+// denominations, with the DefaultSendEnabled parameter and an empty list of
+// per-denomination SendEnabled entries.
+func migrateParams(ctx sdk.Context, paramSpace paramtypes.Subspace) error {
+	defaultSendEnabled := types.DefaultSendEnabled
+	if bz := paramSpace.GetRaw(ctx, ParamStoreKeySendEnabled); bz != nil {
+		if err := json.Unmarshal(bz, &defaultSendEnabled); err != nil {
+			return err
+		}
+	}
+
+	paramSpace.Delete(ctx, ParamStoreKeySendEnabled)
+	paramSpace.Set(ctx, types.KeyDefaultSendEnabled, defaultSendEnabled)
+	paramSpace.Set(ctx, types.KeySendEnabled, []*types.SendEnabled{})
+
+	return nil
+}
+
+// migrateSupply replaces the v0.39 Supply singleton, stored under the supply
+// prefix itself, with a supply entry per denom.
+func migrateSupply(store sdk.KVStore, cdc types.Codec) error {
+	bz := store.Get(types.SupplyPrefix)
+	if bz == nil {
+		return nil
+	}
+
+	supply, err := cdc.UnmarshalSupply(bz)
+	if err != nil {
+		return err
+	}
+
+	store.Delete(types.SupplyPrefix)
+
+	for _, coin := range supply.GetTotal() {
+		if coin.IsZero() {
+			continue
+		}
+
+		amountBz, err := coin.Amount.Marshal()
+		if err != nil {
+			return err
+		}
+
+		store.Set(types.DenomSupplyKey(coin.Denom), amountBz)
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package v040_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/std"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v040bank "github.com/cosmos/cosmos-sdk/x/bank/legacy/v0_40"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	storeKey := app.GetKey(types.StoreKey)
+	cdc := std.NewAppCodec(app.Codec())
+
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+	fooCoin := sdk.NewInt64Coin("foo", 10)
+	zeroBarCoin := sdk.NewInt64Coin("bar", 0)
+
+	// write v0.39 balances, including a zero one, without the holders index
+	store := ctx.KVStore(storeKey)
+	accountStore := prefix.NewStore(prefix.NewStore(store, types.BalancesPrefix), addr)
+	accountStore.Set([]byte(fooCoin.Denom), cdc.MustMarshalBinaryBare(&fooCoin))
+	accountStore.Set([]byte(zeroBarCoin.Denom), cdc.MustMarshalBinaryBare(&zeroBarCoin))
+
+	// write the v0.39 supply singleton
+	supplyBz, err := cdc.MarshalSupply(types.NewSupply(sdk.NewCoins(fooCoin)))
+	require.NoError(t, err)
+	store.Set(types.SupplyPrefix, supplyBz)
+
+	// replace the params with the v0.39 sendenabled param, disabling all sends
+	paramSpace := app.GetSubspace(types.ModuleName)
+	paramSpace.Delete(ctx, types.KeySendEnabled)
+	paramSpace.Delete(ctx, types.KeyDefaultSendEnabled)
+	paramStore := prefix.NewStore(ctx.KVStore(app.GetKey(paramtypes.StoreKey)), []byte(types.ModuleName+"/"))
+	paramStore.Set(v040bank.ParamStoreKeySendEnabled, []byte("false"))
+
+	require.NoError(t, v040bank.MigrateStore(ctx, storeKey, cdc, paramSpace))
+
+	require.False(t, paramSpace.Has(ctx, v040bank.ParamStoreKeySendEnabled))
+	params := app.BankKeeper.GetParams(ctx)
+	require.False(t, params.DefaultSendEnabled)
+	require.Empty(t, params.SendEnabled)
+	require.Error(t, app.BankKeeper.IsSendEnabledCoins(ctx, fooCoin))
+
+	require.False(t, store.Has(types.SupplyPrefix))
+	require.Equal(t, fooCoin.Amount, app.BankKeeper.GetSupplyOf(ctx, fooCoin.Denom))
+	require.Equal(t, sdk.NewCoins(fooCoin), app.BankKeeper.GetSupply(ctx).GetTotal())
+
+	require.True(t, accountStore.Has([]byte(fooCoin.Denom)))
+	require.False(t, accountStore.Has([]byte(zeroBarCoin.Denom)))
+	require.True(t, store.Has(types.DenomAddressKey(fooCoin.Denom, addr)))
+	require.False(t, store.Has(types.DenomAddressKey(zeroBarCoin.Denom, addr)))
+
+	require.Equal(t, sdk.NewCoins(fooCoin), app.BankKeeper.GetAllBalances(ctx, addr))
+	require.Equal(t, []types.DenomOwner{types.NewDenomOwner(addr, fooCoin)}, app.BankKeeper.GetDenomOwners(ctx, "foo", 1, 10))
+}
@@ -25,6 +25,7 @@ var (
 	_ module.AppModule           = AppModule{}
 	_ module.AppModuleBasic      = AppModuleBasic{}
 	_ module.AppModuleSimulation = AppModule{}
+	_ module.AppModuleMigrations = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the bank module.
@@ -96,6 +97,21 @@ func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
 	keeper.RegisterInvariants(ir, am.keeper)
 }
 
+// ConsensusVersion returns the consensus version of the bank module.
+func (AppModule) ConsensusVersion() uint64 { return 2 }
+
+// RegisterMigrations registers the bank module in-place store migrations.
+func (am AppModule) RegisterMigrations(configurator module.Configurator) {
+	bk, ok := am.keeper.(keeper.BaseKeeper)
+	if !ok {
+		panic(fmt.Sprintf("the %s migrations require a BaseKeeper, got %T", ModuleName, am.keeper))
+	}
+
+	if err := configurator.RegisterMigration(ModuleName, 1, keeper.NewMigrator(bk).Migrate1to2); err != nil {
+		panic(err)
+	}
+}
+
 // Route returns the message routing key for the bank module.
 func (AppModule) Route() string { return RouterKey }
 
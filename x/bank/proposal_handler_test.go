@@ -0,0 +1,43 @@
+package bank_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestSetSendEnabledProposalHandler(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	params := types.NewParams(true, []*types.SendEnabled{types.NewSendEnabled("bar", false)})
+	app.BankKeeper.SetParams(ctx, params)
+
+	hdlr := bank.NewSetSendEnabledProposalHandler(app.BankKeeper)
+	tp := types.NewSetSendEnabledProposal(
+		"title", "description", []*types.SendEnabled{types.NewSendEnabled("foo", false)}, []string{"bar"},
+	)
+	require.NoError(t, hdlr(ctx, tp))
+
+	params = app.BankKeeper.GetParams(ctx)
+	require.Equal(t, []*types.SendEnabled{types.NewSendEnabled("foo", false)}, params.SendEnabled)
+	require.False(t, params.SendEnabledDenom("foo"))
+	require.True(t, params.SendEnabledDenom("bar"))
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 2)
+	require.Equal(t, types.EventTypeSetSendEnabled, events[0].Type)
+
+	// re-enabling the denom replaces its entry
+	tp = types.NewSetSendEnabledProposal("title", "description", []*types.SendEnabled{types.NewSendEnabled("foo", true)}, nil)
+	require.NoError(t, hdlr(ctx, tp))
+	require.Equal(t, []*types.SendEnabled{types.NewSendEnabled("foo", true)}, app.BankKeeper.GetParams(ctx).SendEnabled)
+
+	require.Error(t, hdlr(ctx, govtypes.NewTextProposal("title", "description")))
+}
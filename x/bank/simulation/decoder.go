@@ -6,6 +6,7 @@ import (
 
 	tmkv "github.com/tendermint/tendermint/libs/kv"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -14,19 +15,37 @@ import (
 func NewDecodeStore(cdc types.Codec) func(kvA, kvB tmkv.Pair) string {
 	return func(kvA, kvB tmkv.Pair) string {
 		switch {
-		case bytes.Equal(kvA.Key[:1], types.SupplyKey):
-			supplyA, err := cdc.UnmarshalSupply(kvA.Value)
-			if err != nil {
+		case bytes.Equal(kvA.Key[:1], types.SupplyPrefix):
+			var supplyA, supplyB sdk.Int
+			if err := supplyA.Unmarshal(kvA.Value); err != nil {
 				panic(err)
 			}
 
-			supplyB, err := cdc.UnmarshalSupply(kvB.Value)
-			if err != nil {
+			if err := supplyB.Unmarshal(kvB.Value); err != nil {
 				panic(err)
 			}
 
 			return fmt.Sprintf("%v\n%v", supplyA, supplyB)
 
+		case bytes.Equal(kvA.Key[:1], types.DenomMetadataPrefix):
+			var metadataA, metadataB types.Metadata
+
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &metadataA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &metadataB)
+
+			return fmt.Sprintf("%v\n%v", metadataA, metadataB)
+
+		case bytes.Equal(kvA.Key[:1], types.DenomAddressPrefix):
+			return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+
+		case bytes.HasPrefix(kvA.Key, types.BalancesPrefix):
+			var balanceA, balanceB sdk.Coin
+
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &balanceA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &balanceB)
+
+			return fmt.Sprintf("%v\n%v", balanceA, balanceB)
+
 		default:
 			panic(fmt.Sprintf("unexpected %s key %X (%s)", types.ModuleName, kvA.Key, kvA.Key))
 		}
@@ -19,13 +19,21 @@ func TestDecodeStore(t *testing.T) {
 	cdc := std.NewAppCodec(std.MakeCodec(simapp.ModuleBasics))
 	dec := simulation.NewDecodeStore(cdc)
 
-	totalSupply := types.NewSupply(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)))
+	totalSupply := sdk.NewInt(1000)
 
-	supplyBz, err := cdc.MarshalSupply(totalSupply)
+	supplyBz, err := totalSupply.Marshal()
 	require.NoError(t, err)
 
+	metadata := types.Metadata{Description: "The native staking token", Base: sdk.DefaultBondDenom, Display: "STAKE"}
+	addr := sdk.AccAddress([]byte("addr________________"))
+	balance := sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)
+	balanceKey := append(append(append([]byte{}, types.BalancesPrefix...), addr...), []byte(sdk.DefaultBondDenom)...)
+
 	kvPairs := tmkv.Pairs{
-		tmkv.Pair{Key: types.SupplyKey, Value: supplyBz},
+		tmkv.Pair{Key: types.DenomSupplyKey(sdk.DefaultBondDenom), Value: supplyBz},
+		tmkv.Pair{Key: types.DenomMetadataKey(sdk.DefaultBondDenom), Value: cdc.MustMarshalBinaryBare(&metadata)},
+		tmkv.Pair{Key: types.DenomAddressKey(sdk.DefaultBondDenom, addr), Value: []byte{0}},
+		tmkv.Pair{Key: balanceKey, Value: cdc.MustMarshalBinaryBare(&balance)},
 		tmkv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
 
@@ -34,6 +42,9 @@ func TestDecodeStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"Supply", fmt.Sprintf("%v\n%v", totalSupply, totalSupply)},
+		{"DenomMetadata", fmt.Sprintf("%v\n%v", metadata, metadata)},
+		{"DenomAddress", "00\n00"},
+		{"Balance", fmt.Sprintf("%v\n%v", balance, balance)},
 		{"other", ""},
 	}
 
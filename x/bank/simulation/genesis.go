@@ -12,14 +12,32 @@ import (
 
 // Simulation parameter constants
 const (
-	SendEnabled = "send_enabled"
+	SendEnabled        = "send_enabled"
+	DefaultSendEnabled = "default_send_enabled"
 )
 
-// GenSendEnabled randomized SendEnabled
-func GenSendEnabled(r *rand.Rand) bool {
+// RandomGenesisDefaultSendParam computes randomized DefaultSendEnabled param
+// for the bank module
+func RandomGenesisDefaultSendParam(r *rand.Rand) bool {
 	return r.Int63n(101) <= 95 // 95% chance of transfers being enabled
 }
 
+// RandomGenesisSendParams randomized per-denomination SendEnabled params for
+// the bank module
+func RandomGenesisSendParams(r *rand.Rand) []*types.SendEnabled {
+	params := types.DefaultParams()
+
+	// 90% chance of transfers being enabled or set to the default state for
+	// the bond denom
+	if r.Int63n(101) <= 10 {
+		// set the bond denom to a random send state, which may or may not
+		// differ from the default one
+		params = params.SetSendEnabledParam(sdk.DefaultBondDenom, r.Int63n(101) <= 50)
+	}
+
+	return params.SendEnabled
+}
+
 // RandomGenesisAccounts returns a slice of account balances. Each account has
 // a balance of simState.InitialStake for sdk.DefaultBondDenom.
 func RandomGenesisBalances(simState *module.SimulationState) []types.Balance {
@@ -37,16 +55,24 @@ func RandomGenesisBalances(simState *module.SimulationState) []types.Balance {
 
 // RandomizedGenState generates a random GenesisState for bank
 func RandomizedGenState(simState *module.SimulationState) {
-	var sendEnabled bool
+	var sendEnabledParams []*types.SendEnabled
 	simState.AppParams.GetOrGenerate(
-		simState.Cdc, SendEnabled, &sendEnabled, simState.Rand,
-		func(r *rand.Rand) { sendEnabled = GenSendEnabled(r) },
+		simState.Cdc, SendEnabled, &sendEnabledParams, simState.Rand,
+		func(r *rand.Rand) { sendEnabledParams = RandomGenesisSendParams(r) },
+	)
+
+	var defaultSendEnabled bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DefaultSendEnabled, &defaultSendEnabled, simState.Rand,
+		func(r *rand.Rand) { defaultSendEnabled = RandomGenesisDefaultSendParam(r) },
 	)
 
 	numAccs := int64(len(simState.Accounts))
 	totalSupply := sdk.NewInt(simState.InitialStake * (numAccs + simState.NumBonded))
 	supply := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, totalSupply))
 
-	bankGenesis := types.NewGenesisState(sendEnabled, RandomGenesisBalances(simState), supply)
+	bankGenesis := types.NewGenesisState(
+		types.NewParams(defaultSendEnabled, sendEnabledParams), RandomGenesisBalances(simState), supply, []types.Metadata{},
+	)
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(bankGenesis)
 }
@@ -60,12 +60,13 @@ func SimulateMsgSend(ak types.AccountKeeper, bk keeper.Keeper) simtypes.Operatio
 		accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
 
-		if !bk.GetSendEnabled(ctx) {
+		simAccount, toSimAcc, coins, skip := randomSendFields(r, ctx, accs, bk, ak)
+
+		// check send_enabled status of each coin denom
+		if err := bk.IsSendEnabledCoins(ctx, coins...); err != nil {
 			return simtypes.NoOpMsg(types.ModuleName), nil, nil
 		}
 
-		simAccount, toSimAcc, coins, skip := randomSendFields(r, ctx, accs, bk, ak)
-
 		if skip {
 			return simtypes.NoOpMsg(types.ModuleName), nil, nil
 		}
@@ -107,7 +108,7 @@ func sendMsgSend(
 	tx := helpers.GenTx(
 		[]sdk.Msg{msg},
 		fees,
-		helpers.DefaultGenTxGas,
+		helpers.SimTxGas,
 		chainID,
 		[]uint64{account.GetAccountNumber()},
 		[]uint64{account.GetSequence()},
@@ -130,10 +131,6 @@ func SimulateMsgMultiSend(ak types.AccountKeeper, bk keeper.Keeper) simtypes.Ope
 		accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
 
-		if !bk.GetSendEnabled(ctx) {
-			return simtypes.NoOpMsg(types.ModuleName), nil, nil
-		}
-
 		// random number of inputs/outputs between [1, 3]
 		inputs := make([]types.Input, r.Intn(3)+1)
 		outputs := make([]types.Output, r.Intn(3)+1)
@@ -154,6 +151,11 @@ func SimulateMsgMultiSend(ak types.AccountKeeper, bk keeper.Keeper) simtypes.Ope
 				simAccount, _, coins, skip = randomSendFields(r, ctx, accs, bk, ak)
 			}
 
+			// check send_enabled status of each coin denom
+			if err := bk.IsSendEnabledCoins(ctx, coins...); err != nil {
+				return simtypes.NoOpMsg(types.ModuleName), nil, nil
+			}
+
 			if skip {
 				return simtypes.NoOpMsg(types.ModuleName), nil, nil
 			}
@@ -249,7 +251,7 @@ func sendMsgMultiSend(
 	tx := helpers.GenTx(
 		[]sdk.Msg{msg},
 		fees,
-		helpers.DefaultGenTxGas,
+		helpers.SimTxGas,
 		chainID,
 		accountNumbers,
 		sequenceNumbers,
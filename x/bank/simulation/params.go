@@ -12,15 +12,18 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
-const keySendEnabled = "sendenabled"
-
 // ParamChanges defines the parameters that can be modified by param change proposals
 // on the simulation
 func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 	return []simtypes.ParamChange{
-		simulation.NewSimParamChange(types.ModuleName, keySendEnabled,
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeySendEnabled),
+			func(r *rand.Rand) string {
+				return string(types.ModuleCdc.MustMarshalJSON(RandomGenesisSendParams(r)))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyDefaultSendEnabled),
 			func(r *rand.Rand) string {
-				return fmt.Sprintf("%v", GenSendEnabled(r))
+				return fmt.Sprintf("%v", RandomGenesisDefaultSendParam(r))
 			},
 		),
 	}
@@ -2,10 +2,13 @@ package types
 
 // bank module event types
 const (
-	EventTypeTransfer = "transfer"
+	EventTypeTransfer       = "transfer"
+	EventTypeSetSendEnabled = "set_send_enabled"
 
 	AttributeKeyRecipient = "recipient"
 	AttributeKeySender    = "sender"
+	AttributeKeyDenom     = "denom"
+	AttributeKeyEnabled   = "enabled"
 
 	AttributeValueCategory = ModuleName
 )
@@ -25,3 +25,16 @@ type AccountKeeper interface {
 	GetModuleAccount(ctx sdk.Context, moduleName string) exported.ModuleAccountI
 	SetModuleAccount(ctx sdk.Context, macc exported.ModuleAccountI)
 }
+
+// BankHooks event hooks for bank transfers, mints and burns (noalias)
+type BankHooks interface {
+	// BeforeSend is called before coins are sent, and blocks the transfer if it
+	// returns an error.
+	BeforeSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+	// AfterSend is called after coins are sent.
+	AfterSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins)
+	// AfterMint is called after coins are minted to a module account.
+	AfterMint(ctx sdk.Context, moduleName string, amt sdk.Coins)
+	// AfterBurn is called after coins are burned from a module account.
+	AfterBurn(ctx sdk.Context, moduleName string, amt sdk.Coins)
+}
@@ -14,9 +14,10 @@ var _ exported.GenesisBalance = (*Balance)(nil)
 
 // GenesisState defines the bank module's genesis state.
 type GenesisState struct {
-	SendEnabled bool      `json:"send_enabled" yaml:"send_enabled"`
-	Balances    []Balance `json:"balances" yaml:"balances"`
-	Supply      sdk.Coins `json:"supply" yaml:"supply"`
+	Params        Params     `json:"params" yaml:"params"`
+	Balances      []Balance  `json:"balances" yaml:"balances"`
+	Supply        sdk.Coins  `json:"supply" yaml:"supply"`
+	DenomMetadata []Metadata `json:"denom_metadata" yaml:"denom_metadata"`
 }
 
 // Balance defines an account address and balance pair used in the bank module's
@@ -50,17 +51,18 @@ func SanitizeGenesisBalances(balances []Balance) []Balance {
 }
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(sendEnabled bool, balances []Balance, supply sdk.Coins) GenesisState {
+func NewGenesisState(params Params, balances []Balance, supply sdk.Coins, denomMetaData []Metadata) GenesisState {
 	return GenesisState{
-		SendEnabled: sendEnabled,
-		Balances:    balances,
-		Supply:      supply,
+		Params:        params,
+		Balances:      balances,
+		Supply:        supply,
+		DenomMetadata: denomMetaData,
 	}
 }
 
 // DefaultGenesisState returns a default bank module genesis state.
 func DefaultGenesisState() GenesisState {
-	return NewGenesisState(true, []Balance{}, DefaultSupply().GetTotal())
+	return NewGenesisState(DefaultParams(), []Balance{}, DefaultSupply().GetTotal(), []Metadata{})
 }
 
 // GetGenesisStateFromAppState returns x/bank GenesisState given raw application
@@ -0,0 +1,44 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ BankHooks = MultiBankHooks{}
+
+// combine multiple bank hooks, all hook functions are run in array sequence
+type MultiBankHooks []BankHooks
+
+func NewMultiBankHooks(hooks ...BankHooks) MultiBankHooks {
+	return hooks
+}
+
+// BeforeSend runs the BeforeSend hooks in sequence, stopping at the first one
+// returning an error.
+func (h MultiBankHooks) BeforeSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	for i := range h {
+		if err := h[i].BeforeSend(ctx, fromAddr, toAddr, amt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h MultiBankHooks) AfterSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) {
+	for i := range h {
+		h[i].AfterSend(ctx, fromAddr, toAddr, amt)
+	}
+}
+
+func (h MultiBankHooks) AfterMint(ctx sdk.Context, moduleName string, amt sdk.Coins) {
+	for i := range h {
+		h[i].AfterMint(ctx, moduleName, amt)
+	}
+}
+
+func (h MultiBankHooks) AfterBurn(ctx sdk.Context, moduleName string, amt sdk.Coins) {
+	for i := range h {
+		h[i].AfterBurn(ctx, moduleName, amt)
+	}
+}
@@ -22,10 +22,40 @@ const (
 
 // KVStore keys
 var (
-	BalancesPrefix = []byte("balances")
-	SupplyKey      = []byte{0x00}
+	BalancesPrefix      = []byte("balances")
+	SupplyPrefix        = []byte{0x00}
+	DenomMetadataPrefix = []byte{0x01}
+	DenomAddressPrefix  = []byte{0x02}
 )
 
+// DenomSupplyKey returns the store key of the total supply of the given denom.
+func DenomSupplyKey(denom string) []byte {
+	return append(SupplyPrefix, []byte(denom)...)
+}
+
+// DenomMetadataKey returns the store key of the metadata of the given denom.
+func DenomMetadataKey(denom string) []byte {
+	return append(DenomMetadataPrefix, []byte(denom)...)
+}
+
+// CreateDenomAddressPrefix returns the prefix of the denom to holders index
+// entries of the given denom.
+//
+// NOTE: denoms can't contain 0x00, so that it can be used as a separator between
+// the denom and the holder address.
+func CreateDenomAddressPrefix(denom string) []byte {
+	key := make([]byte, len(DenomAddressPrefix)+len(denom)+1)
+	copy(key, DenomAddressPrefix)
+	copy(key[len(DenomAddressPrefix):], denom)
+	return key
+}
+
+// DenomAddressKey returns the store key of the denom to holders index entry of
+// the given denom and holder address.
+func DenomAddressKey(denom string, addr sdk.AccAddress) []byte {
+	return append(CreateDenomAddressPrefix(denom), addr.Bytes()...)
+}
+
 // AddressFromBalancesStore returns an account address from a balances prefix
 // store. The key must not contain the perfix BalancesPrefix as the prefix store
 // iterator discards the actual prefix.
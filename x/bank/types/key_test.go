@@ -24,3 +24,17 @@ func TestAddressFromBalancesStore(t *testing.T) {
 	res := types.AddressFromBalancesStore(key)
 	require.Equal(t, res, addr)
 }
+
+func TestDenomAddressKey(t *testing.T) {
+	addr, err := sdk.AccAddressFromBech32("cosmos1n88uc38xhjgxzw9nwre4ep2c8ga4fjxcar6mn7")
+	require.NoError(t, err)
+
+	prefix := types.CreateDenomAddressPrefix("stake")
+	require.Equal(t, cloneAppend(types.DenomAddressPrefix, []byte("stake\x00")), prefix)
+
+	key := types.DenomAddressKey("stake", addr)
+	require.Equal(t, cloneAppend(prefix, addr.Bytes()), key)
+
+	// a denom that is a prefix of another doesn't share its index prefix
+	require.NotEqual(t, prefix, types.CreateDenomAddressPrefix("stake2")[:len(prefix)])
+}
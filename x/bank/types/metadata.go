@@ -0,0 +1,103 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate performs a basic validation of the coin metadata fields. It checks
+// that:
+//
+//   - the base and display denominations are valid coin denominations
+//   - the base and display denominations are present in the denomination units
+//   - the first denomination unit is the base one and has exponent 0
+//   - the denomination units are sorted by exponent in ascending order, without
+//     duplicate exponents or denominations
+func (m Metadata) Validate() error {
+	if err := sdk.ValidateDenom(m.Base); err != nil {
+		return fmt.Errorf("invalid metadata base denom: %w", err)
+	}
+
+	if err := sdk.ValidateDenom(m.Display); err != nil {
+		return fmt.Errorf("invalid metadata display denom: %w", err)
+	}
+
+	if len(m.DenomUnits) == 0 {
+		return errors.New("metadata must have at least one denomination unit")
+	}
+
+	var (
+		hasDisplay     bool
+		currentExp     uint32
+		seenUnits      = make(map[string]bool)
+		firstDenomUnit = m.DenomUnits[0]
+	)
+
+	if firstDenomUnit == nil || firstDenomUnit.Denom != m.Base {
+		return fmt.Errorf("metadata's first denomination unit must be the one with base denom '%s'", m.Base)
+	}
+	if firstDenomUnit.Exponent != 0 {
+		return fmt.Errorf("the exponent for base denomination unit %s must be 0", m.Base)
+	}
+
+	for i, denomUnit := range m.DenomUnits {
+		if denomUnit == nil {
+			return fmt.Errorf("denomination unit %d cannot be nil", i)
+		}
+
+		// The first denomination unit MUST be the base, so the exponent is
+		// only checked against the previous one from the second unit onwards.
+		if i > 0 && denomUnit.Exponent <= currentExp {
+			return fmt.Errorf("the denomination units must be sorted in ascending order of exponent, got %s with exponent %d", denomUnit.Denom, denomUnit.Exponent)
+		}
+		currentExp = denomUnit.Exponent
+
+		if seenUnits[denomUnit.Denom] {
+			return fmt.Errorf("duplicate denomination unit %s", denomUnit.Denom)
+		}
+
+		if denomUnit.Denom == m.Display {
+			hasDisplay = true
+		}
+
+		if err := denomUnit.Validate(); err != nil {
+			return err
+		}
+
+		seenUnits[denomUnit.Denom] = true
+	}
+
+	if !hasDisplay {
+		return fmt.Errorf("metadata must contain a denomination unit with display denom '%s'", m.Display)
+	}
+
+	return nil
+}
+
+// Validate performs a basic validation of the denomination unit fields.
+func (du DenomUnit) Validate() error {
+	if err := sdk.ValidateDenom(du.Denom); err != nil {
+		return fmt.Errorf("invalid denom unit: %w", err)
+	}
+
+	seenAliases := make(map[string]bool)
+	for _, alias := range du.Aliases {
+		if seenAliases[alias] {
+			return fmt.Errorf("duplicate denomination unit alias %s for denomination unit %s", alias, du.Denom)
+		}
+
+		if alias == du.Denom {
+			return fmt.Errorf("alias '%s' must not be the same as the denomination unit", alias)
+		}
+
+		if err := sdk.ValidateDenom(alias); err != nil {
+			return fmt.Errorf("invalid denomination unit alias: %w", err)
+		}
+
+		seenAliases[alias] = true
+	}
+
+	return nil
+}
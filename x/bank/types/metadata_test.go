@@ -0,0 +1,142 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetadataValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		metadata Metadata
+		expErr   bool
+	}{
+		{
+			"valid metadata",
+			Metadata{
+				Description: "The native staking token of the Cosmos Hub.",
+				DenomUnits: []*DenomUnit{
+					{"uatom", uint32(0), []string{"microatom"}},
+					{"matom", uint32(3), []string{"milliatom"}},
+					{"atom", uint32(6), nil},
+				},
+				Base:    "uatom",
+				Display: "atom",
+				Symbol:  "ATOM",
+			},
+			false,
+		},
+		{
+			"invalid base denom",
+			Metadata{Base: ""},
+			true,
+		},
+		{
+			"invalid display denom",
+			Metadata{Base: "uatom", Display: ""},
+			true,
+		},
+		{
+			"no denom units",
+			Metadata{Base: "uatom", Display: "atom"},
+			true,
+		},
+		{
+			"first denom unit is not the base",
+			Metadata{
+				DenomUnits: []*DenomUnit{
+					{"matom", uint32(3), nil},
+					{"uatom", uint32(0), nil},
+				},
+				Base:    "uatom",
+				Display: "uatom",
+			},
+			true,
+		},
+		{
+			"base denom exponent not zero",
+			Metadata{
+				DenomUnits: []*DenomUnit{
+					{"uatom", uint32(1), nil},
+					{"atom", uint32(6), nil},
+				},
+				Base:    "uatom",
+				Display: "atom",
+			},
+			true,
+		},
+		{
+			"denom units not sorted",
+			Metadata{
+				DenomUnits: []*DenomUnit{
+					{"uatom", uint32(0), nil},
+					{"atom", uint32(6), nil},
+					{"matom", uint32(3), nil},
+				},
+				Base:    "uatom",
+				Display: "atom",
+			},
+			true,
+		},
+		{
+			"duplicate denom unit",
+			Metadata{
+				DenomUnits: []*DenomUnit{
+					{"uatom", uint32(0), nil},
+					{"uatom", uint32(1), nil},
+				},
+				Base:    "uatom",
+				Display: "uatom",
+			},
+			true,
+		},
+		{
+			"no display denom unit",
+			Metadata{
+				DenomUnits: []*DenomUnit{
+					{"uatom", uint32(0), nil},
+				},
+				Base:    "uatom",
+				Display: "atom",
+			},
+			true,
+		},
+		{
+			"duplicate alias",
+			Metadata{
+				DenomUnits: []*DenomUnit{
+					{"uatom", uint32(0), []string{"microatom", "microatom"}},
+				},
+				Base:    "uatom",
+				Display: "uatom",
+			},
+			true,
+		},
+		{
+			"alias same as denom unit",
+			Metadata{
+				DenomUnits: []*DenomUnit{
+					{"uatom", uint32(0), []string{"uatom"}},
+				},
+				Base:    "uatom",
+				Display: "uatom",
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.metadata.Validate()
+
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -3,6 +3,9 @@ package types
 import (
 	"fmt"
 
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -13,21 +16,139 @@ const (
 	DefaultSendEnabled = true
 )
 
-// ParamStoreKeySendEnabled is store's key for SendEnabled
-var ParamStoreKeySendEnabled = []byte("sendenabled")
+// Parameter keys
+var (
+	KeySendEnabled        = []byte("SendEnabled")
+	KeyDefaultSendEnabled = []byte("DefaultSendEnabled")
+)
+
+var _ paramtypes.ParamSet = &Params{}
+
+// NewParams creates a new Params object
+func NewParams(defaultSendEnabled bool, sendEnabledParams []*SendEnabled) Params {
+	return Params{
+		SendEnabled:        sendEnabledParams,
+		DefaultSendEnabled: defaultSendEnabled,
+	}
+}
 
-// ParamKeyTable type declaration for parameters
+// ParamKeyTable for bank module.
 func ParamKeyTable() paramtypes.KeyTable {
-	return paramtypes.NewKeyTable(
-		paramtypes.NewParamSetPair(ParamStoreKeySendEnabled, false, validateSendEnabled),
-	)
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value
+// pairs of bank module's parameters.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeySendEnabled, &p.SendEnabled, validateSendEnabledParams),
+		paramtypes.NewParamSetPair(KeyDefaultSendEnabled, &p.DefaultSendEnabled, validateIsBool),
+	}
+}
+
+// DefaultParams returns a default set of parameters, with sends enabled for
+// all denominations.
+func DefaultParams() Params {
+	return Params{
+		SendEnabled:        []*SendEnabled{},
+		DefaultSendEnabled: DefaultSendEnabled,
+	}
+}
+
+// Validate checks that the parameters have valid values.
+func (p Params) Validate() error {
+	return validateSendEnabledParams(p.SendEnabled)
+}
+
+// String implements the stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// SendEnabledDenom returns true if the given denomination is sendable, i.e.
+// if it has an enabled SendEnabled entry or, in the absence of an entry, if
+// sends are enabled by default.
+func (p Params) SendEnabledDenom(denom string) bool {
+	for _, se := range p.SendEnabled {
+		if se.Denom == denom {
+			return se.Enabled
+		}
+	}
+
+	return p.DefaultSendEnabled
+}
+
+// SetSendEnabledParam returns the params with the send enabled status of the
+// given denomination set, replacing its existing entry if any.
+func (p Params) SetSendEnabledParam(denom string, sendEnabled bool) Params {
+	sendParams := []*SendEnabled{}
+	for _, se := range p.SendEnabled {
+		if se.Denom != denom {
+			sendParams = append(sendParams, se)
+		}
+	}
+
+	sendParams = append(sendParams, NewSendEnabled(denom, sendEnabled))
+	return NewParams(p.DefaultSendEnabled, sendParams)
 }
 
-func validateSendEnabled(i interface{}) error {
-	_, ok := i.(bool)
+// DeleteSendEnabledParam returns the params without the send enabled entry of
+// the given denomination, which then uses the default send enabled status.
+func (p Params) DeleteSendEnabledParam(denom string) Params {
+	sendParams := []*SendEnabled{}
+	for _, se := range p.SendEnabled {
+		if se.Denom != denom {
+			sendParams = append(sendParams, se)
+		}
+	}
+
+	return NewParams(p.DefaultSendEnabled, sendParams)
+}
+
+// NewSendEnabled creates a new SendEnabled object.
+func NewSendEnabled(denom string, sendEnabled bool) *SendEnabled {
+	return &SendEnabled{
+		Denom:   denom,
+		Enabled: sendEnabled,
+	}
+}
+
+// String implements the stringer interface.
+func (se SendEnabled) String() string {
+	out, _ := yaml.Marshal(se)
+	return string(out)
+}
+
+func validateSendEnabledParams(i interface{}) error {
+	params, ok := i.([]*SendEnabled)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
+	// ensure each denom is only registered one time.
+	registered := make(map[string]bool)
+	for _, p := range params {
+		if p == nil {
+			return fmt.Errorf("send enabled entry cannot be nil")
+		}
+		if _, exists := registered[p.Denom]; exists {
+			return fmt.Errorf("duplicate send enabled parameter found: '%s'", p.Denom)
+		}
+		if err := sdk.ValidateDenom(p.Denom); err != nil {
+			return err
+		}
+
+		registered[p.Denom] = true
+	}
+
+	return nil
+}
+
+func validateIsBool(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
 	return nil
 }
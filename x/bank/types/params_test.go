@@ -0,0 +1,51 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func Test_validateSendEnabledParams(t *testing.T) {
+	tests := []struct {
+		name    string
+		arg     interface{}
+		wantErr bool
+	}{
+		{"invalid type", sdk.NewCoin("foo", sdk.ZeroInt()), true},
+		{"empty", []*SendEnabled{}, false},
+		{"valid", []*SendEnabled{NewSendEnabled("foo", true), NewSendEnabled("bar", false)}, false},
+		{"nil entry", []*SendEnabled{nil}, true},
+		{"invalid denom", []*SendEnabled{NewSendEnabled("", true)}, true},
+		{"duplicate denom", []*SendEnabled{NewSendEnabled("foo", true), NewSendEnabled("foo", false)}, true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.wantErr, validateSendEnabledParams(tt.arg) != nil)
+		})
+	}
+}
+
+func TestSendEnabledDenom(t *testing.T) {
+	params := DefaultParams()
+	require.True(t, params.SendEnabledDenom("foo"))
+
+	params = params.SetSendEnabledParam("foo", false)
+	require.False(t, params.SendEnabledDenom("foo"))
+	require.True(t, params.SendEnabledDenom("bar"))
+
+	// setting the same denom again replaces its entry
+	params = params.SetSendEnabledParam("foo", true)
+	require.Len(t, params.SendEnabled, 1)
+	require.True(t, params.SendEnabledDenom("foo"))
+
+	params.DefaultSendEnabled = false
+	require.True(t, params.SendEnabledDenom("foo"))
+	require.False(t, params.SendEnabledDenom("bar"))
+	require.NoError(t, params.Validate())
+}
@@ -0,0 +1,98 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeSetSendEnabled defines the type for a SetSendEnabledProposal
+	ProposalTypeSetSendEnabled = "SetSendEnabled"
+)
+
+// Assert SetSendEnabledProposal implements govtypes.Content at compile-time
+var _ govtypes.Content = &SetSendEnabledProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeSetSendEnabled)
+	govtypes.RegisterProposalTypeCodec(&SetSendEnabledProposal{}, "cosmos-sdk/SetSendEnabledProposal")
+}
+
+// NewSetSendEnabledProposal creates a new set send enabled proposal.
+func NewSetSendEnabledProposal(
+	title, description string, sendEnabled []*SendEnabled, useDefaultFor []string,
+) *SetSendEnabledProposal {
+	return &SetSendEnabledProposal{title, description, sendEnabled, useDefaultFor}
+}
+
+// GetTitle returns the title of a set send enabled proposal.
+func (ssp *SetSendEnabledProposal) GetTitle() string { return ssp.Title }
+
+// GetDescription returns the description of a set send enabled proposal.
+func (ssp *SetSendEnabledProposal) GetDescription() string { return ssp.Description }
+
+// ProposalRoute returns the routing key of a set send enabled proposal.
+func (ssp *SetSendEnabledProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a set send enabled proposal.
+func (ssp *SetSendEnabledProposal) ProposalType() string { return ProposalTypeSetSendEnabled }
+
+// ValidateBasic runs basic stateless validity checks
+func (ssp *SetSendEnabledProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(ssp)
+	if err != nil {
+		return err
+	}
+	if len(ssp.SendEnabled) == 0 && len(ssp.UseDefaultFor) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "proposal must set or reset the send enabled status of at least one denom")
+	}
+
+	// ensure each denom is only referenced one time
+	seen := make(map[string]bool)
+	for _, se := range ssp.SendEnabled {
+		if se == nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "send enabled entry cannot be nil")
+		}
+		if err := sdk.ValidateDenom(se.Denom); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		if seen[se.Denom] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate denom %s", se.Denom)
+		}
+
+		seen[se.Denom] = true
+	}
+	for _, denom := range ssp.UseDefaultFor {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		if seen[denom] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate denom %s", denom)
+		}
+
+		seen[denom] = true
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (ssp SetSendEnabledProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Set Send Enabled Proposal:
+  Title:           %s
+  Description:     %s
+  Send Enabled:
+`, ssp.Title, ssp.Description))
+
+	for _, se := range ssp.SendEnabled {
+		b.WriteString(fmt.Sprintf("    %s: %t\n", se.Denom, se.Enabled))
+	}
+
+	b.WriteString(fmt.Sprintf("  Use Default For: %s\n", strings.Join(ssp.UseDefaultFor, ", ")))
+	return b.String()
+}
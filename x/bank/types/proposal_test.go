@@ -0,0 +1,75 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetSendEnabledProposal_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name     string
+		proposal *SetSendEnabledProposal
+		expErr   bool
+	}{
+		{
+			"valid",
+			NewSetSendEnabledProposal("title", "description", []*SendEnabled{NewSendEnabled("foo", false)}, []string{"bar"}),
+			false,
+		},
+		{
+			"only use default for",
+			NewSetSendEnabledProposal("title", "description", nil, []string{"bar"}),
+			false,
+		},
+		{
+			"empty title",
+			NewSetSendEnabledProposal("", "description", []*SendEnabled{NewSendEnabled("foo", false)}, nil),
+			true,
+		},
+		{
+			"no denoms",
+			NewSetSendEnabledProposal("title", "description", nil, nil),
+			true,
+		},
+		{
+			"nil entry",
+			NewSetSendEnabledProposal("title", "description", []*SendEnabled{nil}, nil),
+			true,
+		},
+		{
+			"invalid denom",
+			NewSetSendEnabledProposal("title", "description", []*SendEnabled{NewSendEnabled("F", false)}, nil),
+			true,
+		},
+		{
+			"invalid use default for denom",
+			NewSetSendEnabledProposal("title", "description", nil, []string{"F"}),
+			true,
+		},
+		{
+			"duplicate denom",
+			NewSetSendEnabledProposal(
+				"title", "description", []*SendEnabled{NewSendEnabled("foo", false), NewSendEnabled("foo", true)}, nil,
+			),
+			true,
+		},
+		{
+			"denom both set and reset",
+			NewSetSendEnabledProposal("title", "description", []*SendEnabled{NewSendEnabled("foo", false)}, []string{"foo"}),
+			true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.proposal.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
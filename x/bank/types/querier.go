@@ -10,6 +10,13 @@ const (
 	QueryAllBalances = "all_balances"
 	QueryTotalSupply = "total_supply"
 	QuerySupplyOf    = "supply_of"
+
+	QuerySpendableBalances = "spendable_balances"
+	QuerySendEnabled       = "send_enabled"
+
+	QueryDenomMetadata  = "denom_metadata"
+	QueryDenomsMetadata = "denoms_metadata"
+	QueryDenomOwners    = "denom_owners"
 )
 
 // QueryBalanceParams defines the params for querying an account balance.
@@ -33,6 +40,33 @@ func NewQueryAllBalancesParams(addr sdk.AccAddress) QueryAllBalancesParams {
 	return QueryAllBalancesParams{Address: addr}
 }
 
+// QuerySpendableBalancesParams defines the params for querying the spendable
+// balances of an account.
+type QuerySpendableBalancesParams struct {
+	Address sdk.AccAddress
+}
+
+// NewQuerySpendableBalancesParams creates a new instance of
+// QuerySpendableBalancesParams.
+func NewQuerySpendableBalancesParams(addr sdk.AccAddress) QuerySpendableBalancesParams {
+	return QuerySpendableBalancesParams{Address: addr}
+}
+
+// QuerySendEnabledParams defines the params for the following queries:
+//
+// - 'custom/bank/send_enabled'
+//
+// If no denom is given, all the send enabled entries are returned.
+type QuerySendEnabledParams struct {
+	Denoms []string
+}
+
+// NewQuerySendEnabledParams creates a new instance to query the send enabled
+// entries of the given denominations
+func NewQuerySendEnabledParams(denoms []string) QuerySendEnabledParams {
+	return QuerySendEnabledParams{denoms}
+}
+
 // QueryTotalSupply defines the params for the following queries:
 //
 // - 'custom/bank/totalSupply'
@@ -57,3 +91,42 @@ type QuerySupplyOfParams struct {
 func NewQuerySupplyOfParams(denom string) QuerySupplyOfParams {
 	return QuerySupplyOfParams{denom}
 }
+
+// QueryDenomMetadataParams defines the params for the following queries:
+//
+// - 'custom/bank/denom_metadata'
+type QueryDenomMetadataParams struct {
+	Denom string
+}
+
+// NewQueryDenomMetadataParams creates a new instance to query the metadata of
+// a given denomination
+func NewQueryDenomMetadataParams(denom string) QueryDenomMetadataParams {
+	return QueryDenomMetadataParams{denom}
+}
+
+// QueryDenomOwnersParams defines the params for the following queries:
+//
+// - 'custom/bank/denom_owners'
+type QueryDenomOwnersParams struct {
+	Denom       string
+	Page, Limit int
+}
+
+// NewQueryDenomOwnersParams creates a new instance to query the holders of a
+// given denomination
+func NewQueryDenomOwnersParams(denom string, page, limit int) QueryDenomOwnersParams {
+	return QueryDenomOwnersParams{denom, page, limit}
+}
+
+// DenomOwner defines an account holding a given denomination, along with its
+// balance of it.
+type DenomOwner struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	Balance sdk.Coin       `json:"balance" yaml:"balance"`
+}
+
+// NewDenomOwner creates a new DenomOwner instance.
+func NewDenomOwner(addr sdk.AccAddress, balance sdk.Coin) DenomOwner {
+	return DenomOwner{Address: addr, Balance: balance}
+}
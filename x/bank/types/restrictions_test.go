@@ -0,0 +1,75 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// newRedirectRestriction returns a SendRestrictionFn that records its calls in
+// calls and redirects the coins to the given address.
+func newRedirectRestriction(name string, calls *[]string, newToAddr sdk.AccAddress) types.SendRestrictionFn {
+	return func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		*calls = append(*calls, name)
+		return newToAddr, nil
+	}
+}
+
+func TestComposeSendRestrictions(t *testing.T) {
+	var (
+		ctx      sdk.Context
+		fromAddr = sdk.AccAddress([]byte("from"))
+		toAddr   = sdk.AccAddress([]byte("to"))
+		addr1    = sdk.AccAddress([]byte("addr1"))
+		addr2    = sdk.AccAddress([]byte("addr2"))
+		coins    = sdk.NewCoins(sdk.NewInt64Coin("foo", 10))
+	)
+
+	require.Nil(t, types.ComposeSendRestrictions())
+	require.Nil(t, types.ComposeSendRestrictions(nil, nil))
+
+	// a single restriction is returned as is
+	var calls []string
+	fn := types.ComposeSendRestrictions(nil, newRedirectRestriction("r1", &calls, addr1), nil)
+	newToAddr, err := fn(ctx, fromAddr, toAddr, coins)
+	require.NoError(t, err)
+	require.Equal(t, addr1, newToAddr)
+	require.Equal(t, []string{"r1"}, calls)
+
+	// restrictions run in order, each given the address returned by the previous
+	calls = nil
+	var seenToAddr sdk.AccAddress
+	fn = types.ComposeSendRestrictions(
+		newRedirectRestriction("r1", &calls, addr1),
+		func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+			seenToAddr = toAddr
+			return newRedirectRestriction("r2", &calls, addr2)(ctx, fromAddr, toAddr, coins)
+		},
+	)
+	newToAddr, err = fn(ctx, fromAddr, toAddr, coins)
+	require.NoError(t, err)
+	require.Equal(t, addr1, seenToAddr)
+	require.Equal(t, addr2, newToAddr)
+	require.Equal(t, []string{"r1", "r2"}, calls)
+
+	// an error stops the chain
+	calls = nil
+	blockErr := errors.New("blocked")
+	fn = newRedirectRestriction("r1", &calls, addr1).
+		Then(func(sdk.Context, sdk.AccAddress, sdk.AccAddress, sdk.Coins) (sdk.AccAddress, error) {
+			return nil, blockErr
+		}).
+		Then(newRedirectRestriction("r3", &calls, addr2))
+	_, err = fn(ctx, fromAddr, toAddr, coins)
+	require.Equal(t, blockErr, err)
+	require.Equal(t, []string{"r1"}, calls)
+
+	// the no-op restriction keeps the receiver
+	newToAddr, err = types.NoOpSendRestrictionFn(ctx, fromAddr, toAddr, coins)
+	require.NoError(t, err)
+	require.Equal(t, toAddr, newToAddr)
+}
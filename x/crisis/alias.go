@@ -9,6 +9,8 @@ import (
 
 const (
 	ModuleName           = types.ModuleName
+	QuerierRoute         = types.QuerierRoute
+	QueryInvariants      = types.QueryInvariants
 	DefaultParamspace    = types.DefaultParamspace
 	EventTypeInvariant   = types.EventTypeInvariant
 	AttributeValueCrisis = types.AttributeValueCrisis
@@ -19,12 +21,14 @@ var (
 	RegisterCodec            = types.RegisterCodec
 	ErrNoSender              = types.ErrNoSender
 	ErrUnknownInvariant      = types.ErrUnknownInvariant
+	ErrBrokenInvariant       = types.ErrBrokenInvariant
 	NewGenesisState          = types.NewGenesisState
 	DefaultGenesisState      = types.DefaultGenesisState
 	NewMsgVerifyInvariant    = types.NewMsgVerifyInvariant
 	ParamKeyTable            = types.ParamKeyTable
 	NewInvarRoute            = types.NewInvarRoute
 	NewKeeper                = keeper.NewKeeper
+	NewQuerier               = keeper.NewQuerier
 	ModuleCdc                = types.ModuleCdc
 	ParamStoreKeyConstantFee = types.ParamStoreKeyConstantFee
 )
@@ -0,0 +1,70 @@
+package crisis_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
+)
+
+var (
+	priv1 = secp256k1.GenPrivKey()
+	addr1 = sdk.AccAddress(priv1.PubKey().Address())
+)
+
+func deliverVerifyInvariant(app *simapp.SimApp, seq uint64, breakSupply bool) (sdk.GasInfo, error) {
+	header := abci.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	if breakSupply {
+		ctx := app.BaseApp.NewContext(false, header)
+		app.BankKeeper.SetSupply(ctx, bank.NewSupply(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))))
+	}
+
+	msg := crisis.NewMsgVerifyInvariant(addr1, bank.ModuleName, "total-supply")
+	tx := helpers.GenTx(
+		[]sdk.Msg{msg}, sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)}, helpers.DefaultGenTxGas, "",
+		[]uint64{0}, []uint64{seq}, priv1,
+	)
+	gInfo, _, err := app.Deliver(tx)
+
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+
+	return gInfo, err
+}
+
+func TestVerifyInvariantMsgs(t *testing.T) {
+	genCoin := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000)
+	accs := authexported.GenesisAccounts{&auth.BaseAccount{Address: addr1}}
+	balances := []bank.Balance{{Address: addr1, Coins: sdk.Coins{genCoin}}}
+
+	app := simapp.SetupWithGenesisAccounts(accs, balances...)
+	ctx := app.BaseApp.NewContext(true, abci.Header{})
+	constantFee := app.CrisisKeeper.GetConstantFee(ctx)
+
+	// the sender of a passing invariant is charged the constant fee, and the gas
+	// of the invariant check
+	gInfo, err := deliverVerifyInvariant(app, 0, false)
+	require.NoError(t, err)
+	require.True(t, gInfo.GasUsed > 0)
+	simapp.CheckBalance(t, app, addr1, sdk.Coins{genCoin.Sub(constantFee)})
+
+	// the handler panics on a broken invariant, and its sender keeps the constant
+	// fee
+	_, err = deliverVerifyInvariant(app, 1, true)
+	require.True(t, errors.Is(err, sdkerrors.ErrPanic))
+	require.Contains(t, err.Error(), crisis.ErrBrokenInvariant.Error())
+	simapp.CheckBalance(t, app, addr1, sdk.Coins{genCoin.Sub(constantFee)})
+}
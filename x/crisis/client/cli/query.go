@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+// GetQueryCmd returns the cli query commands for the crisis module.
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	crisisQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the crisis module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	crisisQueryCmd.AddCommand(
+		flags.GetCommands(
+			GetCmdQueryInvariants(cdc),
+		)...,
+	)
+
+	return crisisQueryCmd
+}
+
+// GetCmdQueryInvariants implements a command to return the routes of all the
+// registered invariants, which can be verified with a MsgVerifyInvariant.
+func GetCmdQueryInvariants(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "invariants",
+		Short: "Query the routes of all the registered invariants",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryInvariants)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var routes []string
+			if err := cdc.UnmarshalJSON(res, &routes); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(routes)
+		},
+	}
+}
@@ -1,6 +1,8 @@
 package crisis
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/crisis/keeper"
@@ -31,7 +33,8 @@ func handleMsgVerifyInvariant(ctx sdk.Context, msg types.MsgVerifyInvariant, k k
 		return nil, err
 	}
 
-	// use a cached context to avoid gas costs during invariants
+	// the invariant is checked on a cached context, with the gas meter of the
+	// transaction
 	cacheCtx, _ := ctx.CacheContext()
 
 	found := false
@@ -53,23 +56,16 @@ func handleMsgVerifyInvariant(ctx sdk.Context, msg types.MsgVerifyInvariant, k k
 	}
 
 	if stop {
-		// NOTE currently, because the chain halts here, this transaction will never be included
-		// in the blockchain thus the constant fee will have never been deducted. Thus no
-		// refund is required.
-
-		// TODO uncomment the following code block with implementation of the circuit breaker
-		//// refund constant fee
-		//err := k.distrKeeper.DistributeFromFeePool(ctx, constantFee, msg.Sender)
-		//if err != nil {
-		//// if there are insufficient coins to refund, log the error,
-		//// but still halt the chain.
-		//logger := ctx.Logger().With("module", "x/crisis")
-		//logger.Error(fmt.Sprintf(
-		//"WARNING: insufficient funds to allocate to sender from fee pool, err: %s", err))
-		//}
+		// refund the constant fee, the sender of a broken invariant is not
+		// charged for it
+		if err := k.SendCoinsFromFeeCollectorToAccount(ctx, msg.Sender, constantFee); err != nil {
+			// if there are insufficient coins to refund, log the error, but
+			// still halt the chain
+			k.Logger(ctx).Error(fmt.Sprintf("failed to refund the constant fee of %s: %s", msg.Sender, err))
+		}
 
 		// TODO replace with circuit breaker
-		panic(res)
+		panic(sdkerrors.Wrapf(types.ErrBrokenInvariant, "%s: %s", msgFullRoute, res))
 	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
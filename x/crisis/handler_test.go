@@ -119,3 +119,24 @@ func TestHandleMsgVerifyInvariantWithInvariantBrokenAndNotEnoughPoolCoins(t *tes
 		res, _ = h(ctx, msg)
 	}, fmt.Sprintf("%v", res))
 }
+
+func TestHandleMsgVerifyInvariantRefund(t *testing.T) {
+	app, ctx, addrs := createTestApp()
+	sender := addrs[0]
+	balance := app.BankKeeper.GetAllBalances(ctx, sender)
+	constantFee := app.CrisisKeeper.GetConstantFee(ctx)
+
+	h := crisis.NewHandler(app.CrisisKeeper)
+
+	// a passing invariant consumes the constant fee
+	_, err := h(ctx, crisis.NewMsgVerifyInvariant(sender, testModuleName, dummyRouteWhichPasses.Route))
+	require.NoError(t, err)
+	require.Equal(t, balance.Sub(sdk.NewCoins(constantFee)), app.BankKeeper.GetAllBalances(ctx, sender))
+
+	// the constant fee is refunded for a broken invariant before halting
+	balance = app.BankKeeper.GetAllBalances(ctx, sender)
+	require.Panics(t, func() {
+		h(ctx, crisis.NewMsgVerifyInvariant(sender, testModuleName, dummyRouteWhichFails.Route)) // nolint:errcheck
+	})
+	require.Equal(t, balance, app.BankKeeper.GetAllBalances(ctx, sender))
+}
@@ -17,14 +17,14 @@ type Keeper struct {
 	paramSpace     paramtypes.Subspace
 	invCheckPeriod uint
 
-	supplyKeeper types.SupplyKeeper
+	bankKeeper types.BankKeeper
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
 }
 
 // NewKeeper creates a new Keeper object
 func NewKeeper(
-	paramSpace paramtypes.Subspace, invCheckPeriod uint, supplyKeeper types.SupplyKeeper,
+	paramSpace paramtypes.Subspace, invCheckPeriod uint, bankKeeper types.BankKeeper,
 	feeCollectorName string,
 ) Keeper {
 
@@ -37,7 +37,7 @@ func NewKeeper(
 		routes:           make([]types.InvarRoute, 0),
 		paramSpace:       paramSpace,
 		invCheckPeriod:   invCheckPeriod,
-		supplyKeeper:     supplyKeeper,
+		bankKeeper:       bankKeeper,
 		feeCollectorName: feeCollectorName,
 	}
 }
@@ -58,6 +58,16 @@ func (k Keeper) Routes() []types.InvarRoute {
 	return k.routes
 }
 
+// InvariantRoutes returns the full routes, i.e. "{moduleName}/{route}", of all
+// the registered invariants.
+func (k Keeper) InvariantRoutes() []string {
+	routes := make([]string, len(k.routes))
+	for i, route := range k.routes {
+		routes[i] = route.FullRoute()
+	}
+	return routes
+}
+
 // Invariants returns all the registered Crisis keeper invariants.
 func (k Keeper) Invariants() []sdk.Invariant {
 	invars := make([]sdk.Invariant, len(k.routes))
@@ -96,5 +106,11 @@ func (k Keeper) InvCheckPeriod() uint { return k.invCheckPeriod }
 
 // SendCoinsFromAccountToFeeCollector transfers amt to the fee collector account.
 func (k Keeper) SendCoinsFromAccountToFeeCollector(ctx sdk.Context, senderAddr sdk.AccAddress, amt sdk.Coins) error {
-	return k.supplyKeeper.SendCoinsFromAccountToModule(ctx, senderAddr, k.feeCollectorName, amt)
+	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, senderAddr, k.feeCollectorName, amt)
+}
+
+// SendCoinsFromFeeCollectorToAccount transfers amt from the fee collector
+// account back to the given account.
+func (k Keeper) SendCoinsFromFeeCollectorToAccount(ctx sdk.Context, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, k.feeCollectorName, recipientAddr, amt)
 }
@@ -8,6 +8,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis/keeper"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
 )
 
 func TestLogger(t *testing.T) {
@@ -30,6 +32,28 @@ func TestInvariants(t *testing.T) {
 	require.Equal(t, len(app.CrisisKeeper.Routes()), len(orgInvRoutes)+1)
 }
 
+func TestInvariantRoutes(t *testing.T) {
+	app := simapp.Setup(false)
+	app.CrisisKeeper.RegisterRoute("testModule", "testRoute", func(sdk.Context) (string, bool) { return "", false })
+
+	routes := app.CrisisKeeper.InvariantRoutes()
+	require.Len(t, routes, len(app.CrisisKeeper.Routes()))
+	require.Equal(t, "testModule/testRoute", routes[len(routes)-1])
+
+	ctx := app.NewContext(true, abci.Header{})
+	querier := keeper.NewQuerier(app.CrisisKeeper)
+
+	res, err := querier(ctx, []string{types.QueryInvariants}, abci.RequestQuery{})
+	require.NoError(t, err)
+
+	var queried []string
+	require.NoError(t, types.ModuleCdc.UnmarshalJSON(res, &queried))
+	require.Equal(t, routes, queried)
+
+	_, err = querier(ctx, []string{"other"}, abci.RequestQuery{})
+	require.Error(t, err)
+}
+
 func TestAssertInvariants(t *testing.T) {
 	app := simapp.Setup(false)
 	app.Commit()
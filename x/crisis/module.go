@@ -60,8 +60,10 @@ func (AppModuleBasic) GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	return cli.GetTxCmd(cdc)
 }
 
-// GetQueryCmd returns no root query command for the crisis module.
-func (AppModuleBasic) GetQueryCmd(_ *codec.Codec) *cobra.Command { return nil }
+// GetQueryCmd returns the root query command for the crisis module.
+func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	return cli.GetQueryCmd(cdc)
+}
 
 //____________________________________________________________________________
 
@@ -101,11 +103,13 @@ func (am AppModule) NewHandler() sdk.Handler {
 	return NewHandler(*am.keeper)
 }
 
-// QuerierRoute returns no querier route.
-func (AppModule) QuerierRoute() string { return "" }
+// QuerierRoute returns the crisis module's querier route name.
+func (AppModule) QuerierRoute() string { return QuerierRoute }
 
-// NewQuerierHandler returns no sdk.Querier.
-func (AppModule) NewQuerierHandler() sdk.Querier { return nil }
+// NewQuerierHandler returns the crisis module sdk.Querier.
+func (am AppModule) NewQuerierHandler() sdk.Querier {
+	return keeper.NewQuerier(*am.keeper)
+}
 
 // InitGenesis performs genesis initialization for the crisis module. It returns
 // no validator updates.
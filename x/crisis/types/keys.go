@@ -3,4 +3,10 @@ package types
 const (
 	// module name
 	ModuleName = "crisis"
+
+	// QuerierRoute is the querier route for the crisis module
+	QuerierRoute = ModuleName
+
+	// QueryInvariants is the query endpoint listing the registered invariant routes
+	QueryInvariants = "invariants"
 )
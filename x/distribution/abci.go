@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
 // BeginBlocker sets the proposer for determining distribution during endblock
@@ -30,3 +31,13 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper)
 	consAddr := sdk.ConsAddress(req.Header.ProposerAddress)
 	k.SetPreviousProposerConsAddr(ctx, consAddr)
 }
+
+// EndBlocker compounds the rewards of the delegations with an auto-compounding
+// preference at the end of each staking epoch, so that the restaked tokens are
+// part of the validator set update of the epoch. A compounding which exceeds
+// the gas limit of a block is resumed at the end of the following blocks.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper, sk types.StakingKeeper) {
+	if _, inProgress := k.GetAutoCompoundCursor(ctx); inProgress || sk.IsEpochEnd(ctx) {
+		k.CompoundRewards(ctx)
+	}
+}
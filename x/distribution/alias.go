@@ -23,8 +23,11 @@ const (
 	QueryDelegatorValidators         = types.QueryDelegatorValidators
 	QueryWithdrawAddr                = types.QueryWithdrawAddr
 	QueryCommunityPool               = types.QueryCommunityPool
+	QueryDelegatorAutoCompounds      = types.QueryDelegatorAutoCompounds
 	DefaultParamspace                = types.DefaultParamspace
 	TypeMsgFundCommunityPool         = types.TypeMsgFundCommunityPool
+	TypeMsgWithdrawAllRewards        = types.TypeMsgWithdrawAllRewards
+	TypeMsgSetAutoCompound           = types.TypeMsgSetAutoCompound
 )
 
 var (
@@ -35,9 +38,11 @@ var (
 	CanWithdrawInvariant                       = keeper.CanWithdrawInvariant
 	ReferenceCountInvariant                    = keeper.ReferenceCountInvariant
 	ModuleAccountInvariant                     = keeper.ModuleAccountInvariant
+	OutstandingRewardsInvariant                = keeper.OutstandingRewardsInvariant
 	NewKeeper                                  = keeper.NewKeeper
 	GetValidatorOutstandingRewardsAddress      = types.GetValidatorOutstandingRewardsAddress
 	GetDelegatorWithdrawInfoAddress            = types.GetDelegatorWithdrawInfoAddress
+	GetDelegatorAutoCompoundAddresses          = types.GetDelegatorAutoCompoundAddresses
 	GetDelegatorStartingInfoAddresses          = types.GetDelegatorStartingInfoAddresses
 	GetValidatorHistoricalRewardsAddressPeriod = types.GetValidatorHistoricalRewardsAddressPeriod
 	GetValidatorCurrentRewardsAddress          = types.GetValidatorCurrentRewardsAddress
@@ -79,6 +84,8 @@ var (
 	NewMsgWithdrawDelegatorReward              = types.NewMsgWithdrawDelegatorReward
 	NewMsgWithdrawValidatorCommission          = types.NewMsgWithdrawValidatorCommission
 	MsgFundCommunityPool                       = types.NewMsgFundCommunityPool
+	NewMsgWithdrawAllRewards                   = types.NewMsgWithdrawAllRewards
+	NewMsgSetAutoCompound                      = types.NewMsgSetAutoCompound
 	NewCommunityPoolSpendProposal              = types.NewCommunityPoolSpendProposal
 	NewQueryValidatorOutstandingRewardsParams  = types.NewQueryValidatorOutstandingRewardsParams
 	NewQueryValidatorCommissionParams          = types.NewQueryValidatorCommissionParams
@@ -103,6 +110,8 @@ var (
 	ValidatorCurrentRewardsPrefix        = types.ValidatorCurrentRewardsPrefix
 	ValidatorAccumulatedCommissionPrefix = types.ValidatorAccumulatedCommissionPrefix
 	ValidatorSlashEventPrefix            = types.ValidatorSlashEventPrefix
+	DelegatorAutoCompoundPrefix          = types.DelegatorAutoCompoundPrefix
+	AutoCompoundCursorKey                = types.AutoCompoundCursorKey
 	ParamStoreKeyCommunityTax            = types.ParamStoreKeyCommunityTax
 	ParamStoreKeyBaseProposerReward      = types.ParamStoreKeyBaseProposerReward
 	ParamStoreKeyBonusProposerReward     = types.ParamStoreKeyBonusProposerReward
@@ -113,11 +122,19 @@ var (
 	EventTypeCommission                  = types.EventTypeCommission
 	EventTypeWithdrawRewards             = types.EventTypeWithdrawRewards
 	EventTypeWithdrawCommission          = types.EventTypeWithdrawCommission
+	EventTypeWithdrawAllRewards          = types.EventTypeWithdrawAllRewards
+	EventTypeSetAutoCompound             = types.EventTypeSetAutoCompound
+	EventTypeAutoCompound                = types.EventTypeAutoCompound
 	EventTypeProposerReward              = types.EventTypeProposerReward
 	AttributeKeyWithdrawAddress          = types.AttributeKeyWithdrawAddress
 	AttributeKeyValidator                = types.AttributeKeyValidator
+	AttributeKeyDelegator                = types.AttributeKeyDelegator
+	AttributeKeyCommission               = types.AttributeKeyCommission
+	AttributeKeyEnabled                  = types.AttributeKeyEnabled
 	AttributeValueCategory               = types.AttributeValueCategory
 	ProposalHandler                      = client.ProposalHandler
+
+	ParamStoreKeyAutoCompoundBlockGasLimit = types.ParamStoreKeyAutoCompoundBlockGasLimit
 )
 
 type (
@@ -132,11 +149,14 @@ type (
 	ValidatorCurrentRewardsRecord          = types.ValidatorCurrentRewardsRecord
 	DelegatorStartingInfoRecord            = types.DelegatorStartingInfoRecord
 	ValidatorSlashEventRecord              = types.ValidatorSlashEventRecord
+	DelegatorAutoCompoundRecord            = types.DelegatorAutoCompoundRecord
 	Params                                 = types.Params
 	GenesisState                           = types.GenesisState
 	MsgSetWithdrawAddress                  = types.MsgSetWithdrawAddress
 	MsgWithdrawDelegatorReward             = types.MsgWithdrawDelegatorReward
 	MsgWithdrawValidatorCommission         = types.MsgWithdrawValidatorCommission
+	MsgWithdrawAllRewards                  = types.MsgWithdrawAllRewards
+	MsgSetAutoCompound                     = types.MsgSetAutoCompound
 	CommunityPoolSpendProposal             = types.CommunityPoolSpendProposal
 	QueryValidatorOutstandingRewardsParams = types.QueryValidatorOutstandingRewardsParams
 	QueryValidatorCommissionParams         = types.QueryValidatorCommissionParams
@@ -33,6 +33,7 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryValidatorCommission(queryRoute, cdc),
 		GetCmdQueryValidatorSlashes(queryRoute, cdc),
 		GetCmdQueryDelegatorRewards(queryRoute, cdc),
+		GetCmdQueryDelegatorAutoCompounds(queryRoute, cdc),
 		GetCmdQueryCommunityPool(queryRoute, cdc),
 	)...)
 
@@ -260,6 +261,51 @@ $ %s query distribution rewards cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p co
 	}
 }
 
+// GetCmdQueryDelegatorAutoCompounds implements the query delegator
+// auto-compounding validators command.
+func GetCmdQueryDelegatorAutoCompounds(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "auto-compounds [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the validators a delegator auto-compounds the rewards of",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all validators whose delegation rewards are restaked automatically for a delegator.
+
+Example:
+$ %s query distribution auto-compounds cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			delegatorAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryDelegatorParams(delegatorAddr))
+			if err != nil {
+				return fmt.Errorf("failed to marshal params: %w", err)
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDelegatorAutoCompounds)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var result []sdk.ValAddress
+			if err = cdc.UnmarshalJSON(res, &result); err != nil {
+				return fmt.Errorf("failed to unmarshal response: %w", err)
+			}
+
+			return cliCtx.PrintOutput(result)
+		},
+	}
+}
+
 // GetCmdQueryCommunityPool returns the command for fetching community pool info
 func GetCmdQueryCommunityPool(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -4,6 +4,7 @@ package cli
 import (
 	"bufio"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client/tx"
@@ -29,6 +30,7 @@ var (
 	flagOnlyFromValidator = "only-from-validator"
 	flagIsValidator       = "is-validator"
 	flagCommission        = "commission"
+	flagPerValidator      = "per-validator"
 	flagMaxMessagesPerTx  = "max-msgs"
 )
 
@@ -50,6 +52,7 @@ func NewTxCmd(m codec.Marshaler, txg tx.Generator, ar tx.AccountRetriever) *cobr
 		NewWithdrawRewardsCmd(m, txg, ar),
 		NewWithdrawAllRewardsCmd(m, txg, ar),
 		NewSetWithdrawAddrCmd(m, txg, ar),
+		NewSetAutoCompoundCmd(m, txg, ar),
 		NewFundCommunityPoolCmd(m, txg, ar),
 	)...)
 
@@ -137,14 +140,19 @@ $ %s tx distribution withdraw-rewards cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fx
 func NewWithdrawAllRewardsCmd(m codec.Marshaler, txg tx.Generator, ar tx.AccountRetriever) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "withdraw-all-rewards",
-		Short: "withdraw all delegations rewards for a delegator",
+		Short: "withdraw all delegations rewards for a delegator, and optionally the commission of its validator",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Withdraw all rewards for a single delegator.
+			fmt.Sprintf(`Withdraw all rewards for a single delegator with a single message,
+and optionally withdraw validator commission if the delegator is a validator operator.
+With --per-validator, one message per delegation is sent instead, split into
+transactions of at most --max-msgs messages.
 
 Example:
 $ %s tx distribution withdraw-all-rewards --from mykey
+$ %s tx distribution withdraw-all-rewards --from mykey --commission
+$ %s tx distribution withdraw-all-rewards --from mykey --per-validator
 `,
-				version.ClientName,
+				version.ClientName, version.ClientName, version.ClientName,
 			),
 		),
 		Args: cobra.NoArgs,
@@ -157,6 +165,15 @@ $ %s tx distribution withdraw-all-rewards --from mykey
 
 			delAddr := cliCtx.GetFromAddress()
 
+			if !viper.GetBool(flagPerValidator) {
+				msg := types.NewMsgWithdrawAllRewards(delAddr, viper.GetBool(flagCommission))
+				if err := msg.ValidateBasic(); err != nil {
+					return err
+				}
+
+				return tx.GenerateOrBroadcastTx(cliCtx, txf, msg)
+			}
+
 			// The transaction cannot be generated offline since it requires a query
 			// to get all the validators.
 			if cliCtx.Offline {
@@ -172,6 +189,10 @@ $ %s tx distribution withdraw-all-rewards --from mykey
 			return newSplitAndApply(tx.GenerateOrBroadcastTx, cliCtx, txf, msgs, chunkSize)
 		},
 	}
+
+	cmd.Flags().Bool(flagCommission, false, "also withdraw the commission of the delegator's validator")
+	cmd.Flags().Bool(flagPerValidator, false, "withdraw the rewards of each delegation with a separate message")
+	cmd.Flags().Int(flagMaxMessagesPerTx, MaxMessagesPerTxDefault, "Limit the number of messages per tx with --per-validator (0 for unlimited)")
 	return flags.PostCommands(cmd)[0]
 }
 
@@ -213,6 +234,51 @@ $ %s tx distribution set-withdraw-addr cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75
 	return flags.PostCommands(cmd)[0]
 }
 
+func NewSetAutoCompoundCmd(m codec.Marshaler, txg tx.Generator, ar tx.AccountRetriever) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-auto-compound [validator-addr] [true|false]",
+		Short: "enable or disable the automatic restaking of the rewards of a delegation",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Enable or disable the automatic restaking of the rewards of a delegation.
+The rewards are withdrawn and delegated back to the validator at the end of
+every staking epoch, provided they are withdrawn to the delegator itself.
+
+Example:
+$ %s tx distribution set-auto-compound cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj true --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txf := tx.NewFactoryFromCLI(inBuf).
+				WithTxGenerator(txg).
+				WithAccountRetriever(ar)
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithMarshaler(m)
+
+			delAddr := cliCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			enabled, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAutoCompound(delAddr, valAddr, enabled)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(cliCtx, txf, msg)
+		},
+	}
+	return flags.PostCommands(cmd)[0]
+}
+
 func NewFundCommunityPoolCmd(m codec.Marshaler, txg tx.Generator, ar tx.AccountRetriever) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "community-pool-spend [proposal-file]",
@@ -281,6 +347,7 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 		GetCmdWithdrawRewards(cdc),
 		GetCmdSetWithdrawAddr(cdc),
 		GetCmdWithdrawAllRewards(cdc, storeKey),
+		GetCmdSetAutoCompound(cdc),
 		GetCmdFundCommunityPool(cdc),
 	)...)
 
@@ -363,14 +430,19 @@ $ %s tx distribution withdraw-rewards cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fx
 func GetCmdWithdrawAllRewards(cdc *codec.Codec, queryRoute string) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "withdraw-all-rewards",
-		Short: "withdraw all delegations rewards for a delegator",
+		Short: "withdraw all delegations rewards for a delegator, and optionally the commission of its validator",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Withdraw all rewards for a single delegator.
+			fmt.Sprintf(`Withdraw all rewards for a single delegator with a single message,
+and optionally withdraw validator commission if the delegator is a validator operator.
+With --per-validator, one message per delegation is sent instead, split into
+transactions of at most --max-msgs messages.
 
 Example:
 $ %s tx distribution withdraw-all-rewards --from mykey
+$ %s tx distribution withdraw-all-rewards --from mykey --commission
+$ %s tx distribution withdraw-all-rewards --from mykey --per-validator
 `,
-				version.ClientName,
+				version.ClientName, version.ClientName, version.ClientName,
 			),
 		),
 		Args: cobra.NoArgs,
@@ -381,6 +453,11 @@ $ %s tx distribution withdraw-all-rewards --from mykey
 
 			delAddr := cliCtx.GetFromAddress()
 
+			if !viper.GetBool(flagPerValidator) {
+				msg := types.NewMsgWithdrawAllRewards(delAddr, viper.GetBool(flagCommission))
+				return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+			}
+
 			// The transaction cannot be generated offline since it requires a query
 			// to get all the validators.
 			if cliCtx.Offline {
@@ -397,7 +474,9 @@ $ %s tx distribution withdraw-all-rewards --from mykey
 		},
 	}
 
-	cmd.Flags().Int(flagMaxMessagesPerTx, MaxMessagesPerTxDefault, "Limit the number of messages per tx (0 for unlimited)")
+	cmd.Flags().Bool(flagCommission, false, "also withdraw the commission of the delegator's validator")
+	cmd.Flags().Bool(flagPerValidator, false, "withdraw the rewards of each delegation with a separate message")
+	cmd.Flags().Int(flagMaxMessagesPerTx, MaxMessagesPerTxDefault, "Limit the number of messages per tx with --per-validator (0 for unlimited)")
 	return cmd
 }
 
@@ -433,6 +512,45 @@ $ %s tx distribution set-withdraw-addr cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75
 	}
 }
 
+// command to enable or disable the auto-compounding of a delegation's rewards
+func GetCmdSetAutoCompound(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-auto-compound [validator-addr] [true|false]",
+		Short: "enable or disable the automatic restaking of the rewards of a delegation",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Enable or disable the automatic restaking of the rewards of a delegation.
+The rewards are withdrawn and delegated back to the validator at the end of
+every staking epoch, provided they are withdrawn to the delegator itself.
+
+Example:
+$ %s tx distribution set-auto-compound cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj true --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			delAddr := cliCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			enabled, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAutoCompound(delAddr, valAddr, enabled)
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 // GetCmdSubmitProposal implements the command to submit a community-pool-spend proposal
 func GetCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
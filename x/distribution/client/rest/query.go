@@ -33,6 +33,12 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		delegatorWithdrawalAddrHandlerFn(cliCtx),
 	).Methods("GET")
 
+	// Get the validators the delegator auto-compounds the rewards of
+	r.HandleFunc(
+		"/distribution/delegators/{delegatorAddr}/auto_compound",
+		delegatorAutoCompoundsHandlerFn(cliCtx),
+	).Methods("GET")
+
 	// Validator distribution information
 	r.HandleFunc(
 		"/distribution/validators/{validatorAddr}",
@@ -142,6 +148,31 @@ func delegatorWithdrawalAddrHandlerFn(cliCtx context.CLIContext) http.HandlerFun
 	}
 }
 
+// HTTP request handler to query the auto-compounding validators of a delegator
+func delegatorAutoCompoundsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		delegatorAddr, ok := checkDelegatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		cliCtx, ok = rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		bz := cliCtx.Codec.MustMarshalJSON(types.NewQueryDelegatorParams(delegatorAddr))
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDelegatorAutoCompounds)
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 // ValidatorDistInfo defines the properties of
 // validator distribution information response.
 type ValidatorDistInfo struct {
@@ -25,6 +25,11 @@ type (
 		WithdrawAddress sdk.AccAddress `json:"withdraw_address" yaml:"withdraw_address"`
 	}
 
+	setAutoCompoundReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+		Enabled bool         `json:"enabled" yaml:"enabled"`
+	}
+
 	fundCommunityPoolReq struct {
 		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
 		Amount  sdk.Coins    `json:"amount" yaml:"amount"`
@@ -50,6 +55,12 @@ func registerTxHandlers(cliCtx context.CLIContext, m codec.Marshaler, txg tx.Gen
 		newSetDelegatorWithdrawalAddrHandlerFn(cliCtx, m, txg),
 	).Methods("POST")
 
+	// Enable or disable the auto-compounding of delegation rewards
+	r.HandleFunc(
+		"/distribution/delegators/{delegatorAddr}/auto_compound/{validatorAddr}",
+		newSetAutoCompoundHandlerFn(cliCtx, m, txg),
+	).Methods("POST")
+
 	// Withdraw validator rewards and commission
 	r.HandleFunc(
 		"/distribution/validators/{validatorAddr}/rewards",
@@ -152,6 +163,39 @@ func newSetDelegatorWithdrawalAddrHandlerFn(cliCtx context.CLIContext, m codec.M
 	}
 }
 
+func newSetAutoCompoundHandlerFn(cliCtx context.CLIContext, m codec.Marshaler, txg tx.Generator) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx = cliCtx.WithMarshaler(m)
+		var req setAutoCompoundReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// read and validate URL's variables
+		delAddr, ok := checkDelegatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		valAddr, ok := checkValidatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgSetAutoCompound(delAddr, valAddr, req.Enabled)
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, txg, req.BaseReq, msg)
+	}
+}
+
 func newWithdrawValidatorRewardsHandlerFn(cliCtx context.CLIContext, m codec.Marshaler, txg tx.Generator) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		cliCtx = cliCtx.WithMarshaler(m)
@@ -232,6 +276,12 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router, queryRoute strin
 		setDelegatorWithdrawalAddrHandlerFn(cliCtx),
 	).Methods("POST")
 
+	// Enable or disable the auto-compounding of delegation rewards
+	r.HandleFunc(
+		"/distribution/delegators/{delegatorAddr}/auto_compound/{validatorAddr}",
+		setAutoCompoundHandlerFn(cliCtx),
+	).Methods("POST")
+
 	// Withdraw validator rewards and commission
 	r.HandleFunc(
 		"/distribution/validators/{validatorAddr}/rewards",
@@ -337,6 +387,40 @@ func setDelegatorWithdrawalAddrHandlerFn(cliCtx context.CLIContext) http.Handler
 	}
 }
 
+// Enable or disable the auto-compounding of delegation rewards
+func setAutoCompoundHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req setAutoCompoundReq
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// read and validate URL's variables
+		delAddr, ok := checkDelegatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		valAddr, ok := checkValidatorAddressVar(w, r)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgSetAutoCompound(delAddr, valAddr, req.Enabled)
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		authclient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
 // Withdraw validator rewards and commission
 func withdrawValidatorRewardsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -37,6 +37,9 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 	for _, evt := range data.ValidatorSlashEvents {
 		keeper.SetValidatorSlashEvent(ctx, evt.ValidatorAddress, evt.Height, evt.Period, evt.Event)
 	}
+	for _, ac := range data.DelegatorAutoCompounds {
+		keeper.SetDelegatorAutoCompound(ctx, ac.DelegatorAddress, ac.ValidatorAddress)
+	}
 
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
@@ -140,5 +143,16 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	autoCompounds := make([]types.DelegatorAutoCompoundRecord, 0)
+	keeper.IterateDelegatorAutoCompounds(ctx,
+		func(del sdk.AccAddress, val sdk.ValAddress) (stop bool) {
+			autoCompounds = append(autoCompounds, types.DelegatorAutoCompoundRecord{
+				DelegatorAddress: del,
+				ValidatorAddress: val,
+			})
+			return false
+		},
+	)
+
+	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes, autoCompounds)
 }
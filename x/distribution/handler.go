@@ -25,6 +25,12 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case types.MsgFundCommunityPool:
 			return handleMsgFundCommunityPool(ctx, msg, k)
 
+		case types.MsgWithdrawAllRewards:
+			return handleMsgWithdrawAllRewards(ctx, msg, k)
+
+		case types.MsgSetAutoCompound:
+			return handleMsgSetAutoCompound(ctx, msg, k)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized distribution message type: %T", msg)
 		}
@@ -100,6 +106,40 @@ func handleMsgFundCommunityPool(ctx sdk.Context, msg types.MsgFundCommunityPool,
 	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
 }
 
+func handleMsgWithdrawAllRewards(ctx sdk.Context, msg types.MsgWithdrawAllRewards, k keeper.Keeper) (*sdk.Result, error) {
+	_, _, err := k.WithdrawAllDelegationRewards(ctx, msg.DelegatorAddress, msg.WithdrawCommission)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgSetAutoCompound(ctx sdk.Context, msg types.MsgSetAutoCompound, k keeper.Keeper) (*sdk.Result, error) {
+	err := k.SetAutoCompound(ctx, msg.DelegatorAddress, msg.ValidatorAddress, msg.Enabled)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
 func NewCommunityPoolSpendProposalHandler(k Keeper) govtypes.Handler {
 	return func(ctx sdk.Context, content govtypes.Content) error {
 		switch c := content.(type) {
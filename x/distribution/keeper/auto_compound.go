@@ -0,0 +1,178 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// SetAutoCompound enables or disables the automatic restaking of the rewards of
+// a delegation. It can only be enabled for an existing delegation.
+func (k Keeper) SetAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, enabled bool) error {
+	if enabled {
+		if k.stakingKeeper.Delegation(ctx, delAddr, valAddr) == nil {
+			return sdkerrors.Wrapf(types.ErrNoDelegationExists, "delegator %s to validator %s", delAddr, valAddr)
+		}
+
+		k.SetDelegatorAutoCompound(ctx, delAddr, valAddr)
+	} else {
+		k.DeleteDelegatorAutoCompound(ctx, delAddr, valAddr)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetAutoCompound,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(enabled)),
+		),
+	)
+
+	return nil
+}
+
+// CompoundRewards withdraws the rewards of the delegations with an
+// auto-compounding preference and delegates the withdrawn staking tokens back
+// to the validator. The rewards are only restaked when they are withdrawn to
+// the delegator itself. A delegation whose rewards fail to be compounded is
+// logged and skipped, leaving its state untouched.
+//
+// The compounding is metered with a gas meter limited by the
+// AutoCompoundBlockGasLimit parameter, whose consumed gas is then consumed from
+// the gas meter of the context. Once the limit is reached, the key of
+// the next delegation is stored as a cursor, and the following calls resume
+// from it until every delegation has been compounded. A delegation which alone
+// exceeds the limit is skipped. It returns whether the compounding is done.
+func (k Keeper) CompoundRewards(ctx sdk.Context) (done bool) {
+	gasLimit := k.GetAutoCompoundBlockGasLimit(ctx)
+	if gasLimit == 0 {
+		k.DeleteAutoCompoundCursor(ctx)
+		return true
+	}
+
+	start, found := k.GetAutoCompoundCursor(ctx)
+	if !found {
+		start = types.DelegatorAutoCompoundPrefix
+	}
+
+	gasMeter := sdk.NewGasMeter(gasLimit)
+	defer func() {
+		ctx.GasMeter().ConsumeGas(gasMeter.GasConsumedToLimit(), "compound rewards")
+	}()
+
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	for i := 0; ; i++ {
+		key, found := k.nextDelegatorAutoCompoundKey(ctx, start)
+		if !found {
+			k.DeleteAutoCompoundCursor(ctx)
+			return true
+		}
+
+		if gasMeter.IsOutOfGas() {
+			k.SetAutoCompoundCursor(ctx, key)
+			return false
+		}
+
+		delAddr, valAddr := types.GetDelegatorAutoCompoundAddresses(key)
+		err := k.compoundDelegationRewardsWithGasMeter(ctx, gasMeter, delAddr, valAddr, bondDenom)
+
+		if errors.Is(err, sdkerrors.ErrOutOfGas) && i > 0 {
+			// resume from this delegation with a new gas meter
+			k.SetAutoCompoundCursor(ctx, key)
+			return false
+		}
+
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf(
+				"failed to compound the rewards of delegator %s to validator %s: %s", delAddr, valAddr, err,
+			))
+		}
+
+		start = sdk.InclusiveEndBytes(key)
+	}
+}
+
+// nextDelegatorAutoCompoundKey returns the first auto-compounding preference
+// key which is greater than or equal to start.
+func (k Keeper) nextDelegatorAutoCompoundKey(ctx sdk.Context, start []byte) (key []byte, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.Iterator(start, sdk.PrefixEndBytes(types.DelegatorAutoCompoundPrefix))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return nil, false
+	}
+
+	return iterator.Key(), true
+}
+
+// compoundDelegationRewardsWithGasMeter compounds the rewards of a delegation
+// in a cache context consuming the gas of the given gas meter, and only writes
+// the cache context when it succeeds. It returns an ErrOutOfGas error when the
+// gas meter runs out of gas.
+func (k Keeper) compoundDelegationRewardsWithGasMeter(
+	ctx sdk.Context, gasMeter sdk.GasMeter, delAddr sdk.AccAddress, valAddr sdk.ValAddress, bondDenom string,
+) (err error) {
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager()).WithGasMeter(gasMeter)
+
+	defer func() {
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+
+			err = sdkerrors.Wrap(sdkerrors.ErrOutOfGas, outOfGas.Descriptor)
+		}
+	}()
+
+	if err := k.compoundDelegationRewards(cacheCtx, delAddr, valAddr, bondDenom); err != nil {
+		return err
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return nil
+}
+
+// compoundDelegationRewards withdraws the rewards of a delegation and
+// delegates the withdrawn staking tokens back to the validator.
+func (k Keeper) compoundDelegationRewards(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, bondDenom string,
+) error {
+	rewards, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+	if err != nil {
+		return err
+	}
+
+	amount := rewards.AmountOf(bondDenom)
+	if !amount.IsPositive() || !k.GetDelegatorWithdrawAddr(ctx, delAddr).Equals(delAddr) {
+		return nil
+	}
+
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorExists
+	}
+
+	if _, err := k.stakingKeeper.Delegate(ctx, delAddr, amount, sdk.Unbonded, validator, true); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAutoCompound,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(bondDenom, amount).String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+		),
+	)
+
+	return nil
+}
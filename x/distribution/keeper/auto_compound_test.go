@@ -0,0 +1,220 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+func TestCompoundRewards(t *testing.T) {
+	balancePower := int64(1000)
+	balanceTokens := sdk.TokensFromConsensusPower(balancePower, sdk.DefaultPowerReduction)
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+
+	sh := staking.NewHandler(app.StakingKeeper)
+
+	// set module account coins
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, distrAcc.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// create a validator without commission and delegate to it
+	power := int64(100)
+	valTokens := sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)
+	msg := staking.NewMsgCreateValidator(
+		valAddrs[0], valConsPk1,
+		sdk.NewCoin(sdk.DefaultBondDenom, valTokens),
+		staking.Description{}, staking.NewCommissionRates(sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0)), sdk.OneInt(),
+	)
+	res, err := sh(ctx, msg)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	res, err = sh(ctx, staking.NewMsgDelegate(addr[1], valAddrs[0], sdk.NewCoin(sdk.DefaultBondDenom, valTokens)))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// end block to bond the validator
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// auto-compounding cannot be enabled without a delegation
+	err = app.DistrKeeper.SetAutoCompound(ctx, addr[2], valAddrs[0], true)
+	require.True(t, types.ErrNoDelegationExists.Is(err))
+	require.False(t, app.DistrKeeper.HasDelegatorAutoCompound(ctx, addr[2], valAddrs[0]))
+
+	// enable the auto-compounding of both delegations, the operator's rewards
+	// being withdrawn to another address
+	require.NoError(t, app.DistrKeeper.SetAutoCompound(ctx, addr[0], valAddrs[0], true))
+	require.NoError(t, app.DistrKeeper.SetAutoCompound(ctx, addr[1], valAddrs[0], true))
+	require.NoError(t, app.DistrKeeper.SetWithdrawAddr(ctx, addr[0], addr[2]))
+	require.Equal(t, []sdk.ValAddress{valAddrs[0]}, app.DistrKeeper.GetDelegatorAutoCompounds(ctx, addr[1]))
+
+	// allocate some rewards to the validator
+	initial := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	tokens := sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)}
+	app.DistrKeeper.AllocateTokensToValidator(ctx, app.StakingKeeper.Validator(ctx, valAddrs[0]), tokens)
+
+	delBalance := app.BankKeeper.GetBalance(ctx, addr[1], sdk.DefaultBondDenom)
+	withdrawBalance := app.BankKeeper.GetBalance(ctx, addr[2], sdk.DefaultBondDenom)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.True(t, app.DistrKeeper.CompoundRewards(ctx))
+
+	// the delegator's half of the rewards is restaked
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, addr[1], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, valTokens.Add(initial.QuoRaw(2)).ToDec(), delegation.Shares)
+	require.Equal(t, delBalance, app.BankKeeper.GetBalance(ctx, addr[1], sdk.DefaultBondDenom))
+
+	// the operator's half of the rewards is only withdrawn
+	selfDelegation, found := app.StakingKeeper.GetDelegation(ctx, addr[0], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, valTokens.ToDec(), selfDelegation.Shares)
+	require.Equal(t,
+		withdrawBalance.Add(sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(2))),
+		app.BankKeeper.GetBalance(ctx, addr[2], sdk.DefaultBondDenom),
+	)
+
+	var compounded int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeAutoCompound {
+			compounded++
+		}
+	}
+	require.Equal(t, 1, compounded)
+
+	// the preference is dropped along with the delegation
+	_, err = app.StakingKeeper.Undelegate(ctx, addr[1], valAddrs[0], delegation.Shares)
+	require.NoError(t, err)
+	require.False(t, app.DistrKeeper.HasDelegatorAutoCompound(ctx, addr[1], valAddrs[0]))
+	require.Empty(t, app.DistrKeeper.GetDelegatorAutoCompounds(ctx, addr[1]))
+
+	// disabling the auto-compounding needs no delegation
+	require.NoError(t, app.DistrKeeper.SetAutoCompound(ctx, addr[1], valAddrs[0], false))
+	require.NoError(t, app.DistrKeeper.SetAutoCompound(ctx, addr[0], valAddrs[0], false))
+	require.False(t, app.DistrKeeper.HasDelegatorAutoCompound(ctx, addr[0], valAddrs[0]))
+}
+
+func TestCompoundRewardsGasLimit(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+
+	sh := staking.NewHandler(app.StakingKeeper)
+
+	// create a validator without commission and delegate to it
+	valTokens := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+	msg := staking.NewMsgCreateValidator(
+		valAddrs[0], valConsPk1,
+		sdk.NewCoin(sdk.DefaultBondDenom, valTokens),
+		staking.Description{}, staking.NewCommissionRates(sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0)), sdk.OneInt(),
+	)
+	_, err := sh(ctx, msg)
+	require.NoError(t, err)
+
+	for _, delAddr := range addr[1:] {
+		_, err = sh(ctx, staking.NewMsgDelegate(delAddr, valAddrs[0], sdk.NewCoin(sdk.DefaultBondDenom, valTokens)))
+		require.NoError(t, err)
+	}
+
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(1)
+
+	stakingParams := app.StakingKeeper.GetParams(ctx)
+	stakingParams.EpochLength = 10
+	app.StakingKeeper.SetParams(ctx, stakingParams)
+
+	for _, delAddr := range addr {
+		require.NoError(t, app.DistrKeeper.SetAutoCompound(ctx, delAddr, valAddrs[0], true))
+	}
+
+	// fund the distribution module account and allocate rewards to the validator
+	rewards := sdk.TokensFromConsensusPower(30, sdk.DefaultPowerReduction)
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, distrAcc.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, rewards))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+	app.DistrKeeper.AllocateTokensToValidator(
+		ctx, app.StakingKeeper.Validator(ctx, valAddrs[0]), sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, rewards)},
+	)
+
+	var delAddrs []sdk.AccAddress
+	app.DistrKeeper.IterateDelegatorAutoCompounds(ctx, func(del sdk.AccAddress, _ sdk.ValAddress) (stop bool) {
+		delAddrs = append(delAddrs, del)
+		return false
+	})
+	require.Len(t, delAddrs, 3)
+
+	shares := func(ctx sdk.Context, delAddr sdk.AccAddress) sdk.Dec {
+		delegation, found := app.StakingKeeper.GetDelegation(ctx, delAddr, valAddrs[0])
+		require.True(t, found)
+		return delegation.Shares
+	}
+
+	// a delegation which alone exceeds the gas limit is skipped, and the
+	// compounding is resumed from the next one in the following block
+	params := app.DistrKeeper.GetParams(ctx)
+	params.AutoCompoundBlockGasLimit = 1
+	app.DistrKeeper.SetParams(ctx, params)
+
+	cacheCtx, _ := ctx.CacheContext()
+	for _, height := range []int64{10, 11} {
+		distribution.EndBlocker(cacheCtx.WithBlockHeight(height), app.DistrKeeper, app.StakingKeeper)
+
+		_, inProgress := app.DistrKeeper.GetAutoCompoundCursor(cacheCtx)
+		require.True(t, inProgress)
+	}
+	distribution.EndBlocker(cacheCtx.WithBlockHeight(12), app.DistrKeeper, app.StakingKeeper)
+
+	_, inProgress := app.DistrKeeper.GetAutoCompoundCursor(cacheCtx)
+	require.False(t, inProgress)
+	for _, delAddr := range delAddrs {
+		require.Equal(t, valTokens.ToDec(), shares(cacheCtx, delAddr))
+	}
+
+	// measure the gas of the compounding of the first delegation
+	cacheCtx, _ = ctx.CacheContext()
+	gasMeter := sdk.NewInfiniteGasMeter()
+	params.AutoCompoundBlockGasLimit = types.DefaultAutoCompoundBlockGasLimit
+	app.DistrKeeper.SetParams(cacheCtx, params)
+	app.DistrKeeper.SetAutoCompound(cacheCtx, delAddrs[1], valAddrs[0], false)
+	app.DistrKeeper.SetAutoCompound(cacheCtx, delAddrs[2], valAddrs[0], false)
+	require.True(t, app.DistrKeeper.CompoundRewards(cacheCtx.WithGasMeter(gasMeter)))
+
+	// only the first delegation fits in the gas limit of the first block
+	params.AutoCompoundBlockGasLimit = gasMeter.GasConsumed() * 3 / 2
+	app.DistrKeeper.SetParams(ctx, params)
+
+	ctx = ctx.WithBlockHeight(10)
+	require.False(t, app.DistrKeeper.CompoundRewards(ctx))
+	require.True(t, shares(ctx, delAddrs[0]).GT(valTokens.ToDec()))
+	require.Equal(t, valTokens.ToDec(), shares(ctx, delAddrs[1]))
+
+	cursor, inProgress := app.DistrKeeper.GetAutoCompoundCursor(ctx)
+	require.True(t, inProgress)
+	require.Equal(t, types.GetDelegatorAutoCompoundKey(delAddrs[1], valAddrs[0]), cursor)
+
+	for _, height := range []int64{11, 12} {
+		distribution.EndBlocker(ctx.WithBlockHeight(height), app.DistrKeeper, app.StakingKeeper)
+	}
+	_, inProgress = app.DistrKeeper.GetAutoCompoundCursor(ctx)
+	require.False(t, inProgress)
+	for _, delAddr := range delAddrs {
+		require.True(t, shares(ctx, delAddr).GT(valTokens.ToDec()))
+	}
+}
@@ -4,11 +4,14 @@ import (
 	"testing"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 )
 
@@ -86,7 +89,7 @@ func TestCalculateRewardsAfterSlash(t *testing.T) {
 	// create validator with 50% commission
 	commission := staking.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
 	valPower := int64(100)
-	valTokens := sdk.TokensFromConsensusPower(valPower)
+	valTokens := sdk.TokensFromConsensusPower(valPower, sdk.DefaultPowerReduction)
 	msg := staking.NewMsgCreateValidator(valAddrs[0], valConsPk1,
 		sdk.NewCoin(sdk.DefaultBondDenom, valTokens), staking.Description{}, commission, sdk.OneInt())
 
@@ -126,7 +129,7 @@ func TestCalculateRewardsAfterSlash(t *testing.T) {
 	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 3)
 
 	// allocate some rewards
-	initial := sdk.TokensFromConsensusPower(10)
+	initial := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
 	tokens := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: initial.ToDec()}}
 	app.DistrKeeper.AllocateTokensToValidator(ctx, val, tokens)
 
@@ -154,7 +157,7 @@ func TestCalculateRewardsAfterManySlashes(t *testing.T) {
 
 	// create validator with 50% commission
 	power := int64(100)
-	valTokens := sdk.TokensFromConsensusPower(power)
+	valTokens := sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)
 	commission := staking.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
 	msg := staking.NewMsgCreateValidator(valAddrs[0], valConsPk1,
 		sdk.NewCoin(sdk.DefaultBondDenom, valTokens), staking.Description{}, commission, sdk.OneInt())
@@ -195,7 +198,7 @@ func TestCalculateRewardsAfterManySlashes(t *testing.T) {
 	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 3)
 
 	// allocate some rewards
-	initial := sdk.TokensFromConsensusPower(10)
+	initial := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
 	tokens := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: initial.ToDec()}}
 	app.DistrKeeper.AllocateTokensToValidator(ctx, val, tokens)
 
@@ -300,7 +303,7 @@ func TestCalculateRewardsMultiDelegator(t *testing.T) {
 
 func TestWithdrawDelegationRewardsBasic(t *testing.T) {
 	balancePower := int64(1000)
-	balanceTokens := sdk.TokensFromConsensusPower(balancePower)
+	balanceTokens := sdk.TokensFromConsensusPower(balancePower, sdk.DefaultPowerReduction)
 	app := simapp.Setup(false)
 	ctx := app.BaseApp.NewContext(false, abci.Header{})
 
@@ -316,7 +319,7 @@ func TestWithdrawDelegationRewardsBasic(t *testing.T) {
 
 	// create validator with 50% commission
 	power := int64(100)
-	valTokens := sdk.TokensFromConsensusPower(power)
+	valTokens := sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)
 	commission := staking.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
 	msg := staking.NewMsgCreateValidator(
 		valAddrs[0], valConsPk1,
@@ -345,7 +348,7 @@ func TestWithdrawDelegationRewardsBasic(t *testing.T) {
 	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
 
 	// allocate some rewards
-	initial := sdk.TokensFromConsensusPower(10)
+	initial := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
 	tokens := sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)}
 
 	app.DistrKeeper.AllocateTokensToValidator(ctx, val, tokens)
@@ -379,6 +382,99 @@ func TestWithdrawDelegationRewardsBasic(t *testing.T) {
 	)
 }
 
+func TestWithdrawAllDelegationRewards(t *testing.T) {
+	balancePower := int64(1000)
+	balanceTokens := sdk.TokensFromConsensusPower(balancePower, sdk.DefaultPowerReduction)
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+
+	sh := staking.NewHandler(app.StakingKeeper)
+
+	// set module account coins
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, distrAcc.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// create a validator with 50% commission and one without commission
+	power := int64(100)
+	valTokens := sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)
+	commissions := []staking.CommissionRates{
+		staking.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0)),
+		staking.NewCommissionRates(sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0)),
+	}
+	for i, pk := range []crypto.PubKey{valConsPk1, valConsPk2} {
+		msg := staking.NewMsgCreateValidator(
+			valAddrs[i], pk,
+			sdk.NewCoin(sdk.DefaultBondDenom, valTokens),
+			staking.Description{}, commissions[i], sdk.OneInt(),
+		)
+
+		res, err := sh(ctx, msg)
+		require.NoError(t, err)
+		require.NotNil(t, res)
+	}
+
+	// the first validator operator also delegates to the second validator
+	res, err := sh(ctx, staking.NewMsgDelegate(sdk.AccAddress(valAddrs[0]), valAddrs[1], sdk.NewCoin(sdk.DefaultBondDenom, valTokens)))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// end block to bond validators
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some rewards to both validators
+	initial := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	tokens := sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)}
+
+	for _, valAddr := range valAddrs {
+		app.DistrKeeper.AllocateTokensToValidator(ctx, app.StakingKeeper.Validator(ctx, valAddr), tokens)
+	}
+
+	// a delegator without delegations withdraws nothing
+	rewards, commission, err := app.DistrKeeper.WithdrawAllDelegationRewards(ctx, sdk.AccAddress("nodelegations_______"), true)
+	require.NoError(t, err)
+	require.True(t, rewards.IsZero())
+	require.True(t, commission.IsZero())
+
+	// withdraw all rewards along with the commission
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	rewards, commission, err = app.DistrKeeper.WithdrawAllDelegationRewards(ctx, sdk.AccAddress(valAddrs[0]), true)
+	require.NoError(t, err)
+
+	// half of the rewards of the first validator and half of the rewards of the
+	// second one, as well as the commission of the first validator
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initial)), rewards)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(2))), commission)
+
+	exp := balanceTokens.Sub(valTokens.MulRaw(2)).Add(initial).Add(initial.QuoRaw(2))
+	require.Equal(t,
+		sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, exp)},
+		app.BankKeeper.GetAllBalances(ctx, sdk.AccAddress(valAddrs[0])),
+	)
+	require.True(t, app.DistrKeeper.GetValidatorAccumulatedCommission(ctx, valAddrs[0]).Commission.IsZero())
+
+	// a single aggregated event is emitted instead of one per withdrawal
+	var eventTypes []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != bank.EventTypeTransfer && event.Type != sdk.EventTypeMessage {
+			eventTypes = append(eventTypes, event.Type)
+		}
+	}
+	require.Equal(t, []string{types.EventTypeWithdrawAllRewards}, eventTypes)
+
+	// nothing is left to withdraw
+	rewards, commission, err = app.DistrKeeper.WithdrawAllDelegationRewards(ctx, sdk.AccAddress(valAddrs[0]), true)
+	require.NoError(t, err)
+	require.True(t, rewards.IsZero())
+	require.True(t, commission.IsZero())
+}
+
 func TestCalculateRewardsAfterManySlashesInSameBlock(t *testing.T) {
 	app := simapp.Setup(false)
 	ctx := app.BaseApp.NewContext(false, abci.Header{})
@@ -390,7 +486,7 @@ func TestCalculateRewardsAfterManySlashesInSameBlock(t *testing.T) {
 
 	// create validator with 50% commission
 	power := int64(100)
-	valTokens := sdk.TokensFromConsensusPower(power)
+	valTokens := sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)
 	commission := staking.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
 	msg := staking.NewMsgCreateValidator(valAddrs[0], valConsPk1,
 		sdk.NewCoin(sdk.DefaultBondDenom, valTokens), staking.Description{}, commission, sdk.OneInt())
@@ -422,7 +518,7 @@ func TestCalculateRewardsAfterManySlashesInSameBlock(t *testing.T) {
 	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 3)
 
 	// allocate some rewards
-	initial := sdk.TokensFromConsensusPower(10).ToDec()
+	initial := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction).ToDec()
 	tokens := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: initial}}
 	app.DistrKeeper.AllocateTokensToValidator(ctx, val, tokens)
 
@@ -465,7 +561,7 @@ func TestCalculateRewardsMultiDelegatorMultiSlash(t *testing.T) {
 	// create validator with 50% commission
 	commission := staking.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
 	power := int64(100)
-	valTokens := sdk.TokensFromConsensusPower(power)
+	valTokens := sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)
 	msg := staking.NewMsgCreateValidator(valAddrs[0], valConsPk1,
 		sdk.NewCoin(sdk.DefaultBondDenom, valTokens), staking.Description{}, commission, sdk.OneInt())
 
@@ -484,7 +580,7 @@ func TestCalculateRewardsMultiDelegatorMultiSlash(t *testing.T) {
 	del1 := app.StakingKeeper.Delegation(ctx, sdk.AccAddress(valAddrs[0]), valAddrs[0])
 
 	// allocate some rewards
-	initial := sdk.TokensFromConsensusPower(30).ToDec()
+	initial := sdk.TokensFromConsensusPower(30, sdk.DefaultPowerReduction).ToDec()
 	tokens := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: initial}}
 	app.DistrKeeper.AllocateTokensToValidator(ctx, val, tokens)
 
@@ -494,7 +590,7 @@ func TestCalculateRewardsMultiDelegatorMultiSlash(t *testing.T) {
 	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 3)
 
 	// second delegation
-	delTokens := sdk.TokensFromConsensusPower(100)
+	delTokens := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
 	msg2 := staking.NewMsgDelegate(sdk.AccAddress(valAddrs[1]), valAddrs[0],
 		sdk.NewCoin(sdk.DefaultBondDenom, delTokens))
 
@@ -18,6 +18,8 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 		ReferenceCountInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "module-account",
 		ModuleAccountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "outstanding-rewards",
+		OutstandingRewardsInvariant(k))
 }
 
 // AllInvariants runs all invariants of the distribution module
@@ -35,7 +37,11 @@ func AllInvariants(k Keeper) sdk.Invariant {
 		if stop {
 			return res, stop
 		}
-		return ModuleAccountInvariant(k)(ctx)
+		res, stop = ModuleAccountInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return OutstandingRewardsInvariant(k)(ctx)
 	}
 }
 
@@ -160,3 +166,25 @@ func ModuleAccountInvariant(k Keeper) sdk.Invariant {
 		), broken
 	}
 }
+
+// OutstandingRewardsInvariant checks that the coins held by the distr
+// ModuleAccount cover the sum of validator outstanding rewards, regardless of
+// the community pool
+func OutstandingRewardsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+
+		outstanding := k.GetTotalRewards(ctx)
+
+		macc := k.GetDistributionAccount(ctx)
+		balances := sdk.NewDecCoinsFromCoins(k.bankKeeper.GetAllBalances(ctx, macc.GetAddress())...)
+
+		_, broken := balances.SafeSub(outstanding)
+		return sdk.FormatInvariant(
+			types.ModuleName, "outstanding rewards",
+			fmt.Sprintf("\ttotal outstanding rewards:        %s\n"+
+				"\tdistribution ModuleAccount coins: %s\n",
+				outstanding, balances,
+			),
+		), broken
+	}
+}
@@ -10,6 +10,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/exported"
 )
 
 // Keeper of the distribution store
@@ -21,7 +22,7 @@ type Keeper struct {
 	bankKeeper    types.BankKeeper
 	stakingKeeper types.StakingKeeper
 
-	blacklistedAddrs map[string]bool
+	blockedAddrs map[string]bool
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
 }
@@ -30,7 +31,7 @@ type Keeper struct {
 func NewKeeper(
 	cdc codec.Marshaler, key sdk.StoreKey, paramSpace paramtypes.Subspace,
 	ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper,
-	feeCollectorName string, blacklistedAddrs map[string]bool,
+	feeCollectorName string, blockedAddrs map[string]bool,
 ) Keeper {
 
 	// ensure distribution module account is set
@@ -51,7 +52,7 @@ func NewKeeper(
 		bankKeeper:       bk,
 		stakingKeeper:    sk,
 		feeCollectorName: feeCollectorName,
-		blacklistedAddrs: blacklistedAddrs,
+		blockedAddrs:     blockedAddrs,
 	}
 }
 
@@ -62,8 +63,8 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 
 // SetWithdrawAddr sets a new address that will receive the rewards upon withdrawal
 func (k Keeper) SetWithdrawAddr(ctx sdk.Context, delegatorAddr sdk.AccAddress, withdrawAddr sdk.AccAddress) error {
-	if k.blacklistedAddrs[withdrawAddr.String()] {
-		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is blacklisted from receiving external funds", withdrawAddr)
+	if k.blockedAddrs[withdrawAddr.String()] {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is blocked from receiving external funds", withdrawAddr)
 	}
 
 	if !k.GetWithdrawAddrEnabled(ctx) {
@@ -120,12 +121,94 @@ func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddr
 		return nil, types.ErrNoValidatorCommission
 	}
 
+	commission, err := k.withdrawValidatorCommission(ctx, valAddr, accumCommission)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawCommission,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, commission.String()),
+		),
+	)
+
+	return commission, nil
+}
+
+// WithdrawAllDelegationRewards withdraws the rewards of all the delegations of
+// a delegator and, if withdrawCommission is set and the delegator operates a
+// validator with accumulated commission, the commission of that validator. A
+// single event with the aggregated amounts is emitted.
+func (k Keeper) WithdrawAllDelegationRewards(
+	ctx sdk.Context, delAddr sdk.AccAddress, withdrawCommission bool,
+) (rewards sdk.Coins, commission sdk.Coins, err error) {
+	var delegations []exported.DelegationI
+	k.stakingKeeper.IterateDelegations(ctx, delAddr, func(_ int64, del exported.DelegationI) (stop bool) {
+		delegations = append(delegations, del)
+		return false
+	})
+
+	rewards = sdk.NewCoins()
+	for _, del := range delegations {
+		valAddr := del.GetValidatorAddr()
+
+		val := k.stakingKeeper.Validator(ctx, valAddr)
+		if val == nil {
+			return nil, nil, types.ErrNoValidatorDistInfo
+		}
+
+		delRewards, err := k.withdrawDelegationRewards(ctx, val, del)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// reinitialize the delegation
+		k.initializeDelegation(ctx, valAddr, delAddr)
+		rewards = rewards.Add(delRewards...)
+	}
+
+	commission = sdk.NewCoins()
+	if withdrawCommission {
+		valAddr := sdk.ValAddress(delAddr)
+
+		accumCommission := k.GetValidatorAccumulatedCommission(ctx, valAddr)
+		if !accumCommission.Commission.IsZero() {
+			commission, err = k.withdrawValidatorCommission(ctx, valAddr, accumCommission)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawAllRewards,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, rewards.String()),
+			sdk.NewAttribute(types.AttributeKeyCommission, commission.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+		),
+	)
+
+	return rewards, commission, nil
+}
+
+// withdrawValidatorCommission sends the truncated accumulated commission of a
+// validator to its withdraw address and returns the remainder to the community
+// pool, the same way the remainder of the delegation rewards is.
+func (k Keeper) withdrawValidatorCommission(
+	ctx sdk.Context, valAddr sdk.ValAddress, accumCommission types.ValidatorAccumulatedCommission,
+) (sdk.Coins, error) {
 	commission, remainder := accumCommission.Commission.TruncateDecimal()
-	k.SetValidatorAccumulatedCommission(ctx, valAddr, types.ValidatorAccumulatedCommission{Commission: remainder}) // leave remainder to withdraw later
+	k.SetValidatorAccumulatedCommission(ctx, valAddr, types.ValidatorAccumulatedCommission{})
 
 	// update outstanding
 	outstanding := k.GetValidatorOutstandingRewards(ctx, valAddr).Rewards
-	k.SetValidatorOutstandingRewards(ctx, valAddr, types.ValidatorOutstandingRewards{Rewards: outstanding.Sub(sdk.NewDecCoinsFromCoins(commission...))})
+	k.SetValidatorOutstandingRewards(ctx, valAddr, types.ValidatorOutstandingRewards{Rewards: outstanding.Sub(accumCommission.Commission)})
+
+	feePool := k.GetFeePool(ctx)
+	feePool.CommunityPool = feePool.CommunityPool.Add(remainder...)
+	k.SetFeePool(ctx, feePool)
 
 	if !commission.IsZero() {
 		accAddr := sdk.AccAddress(valAddr)
@@ -136,13 +219,6 @@ func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddr
 		}
 	}
 
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeWithdrawCommission,
-			sdk.NewAttribute(sdk.AttributeKeyAmount, commission.String()),
-		),
-	)
-
 	return commission, nil
 }
 
@@ -158,6 +234,37 @@ func (k Keeper) GetTotalRewards(ctx sdk.Context) (totalRewards sdk.DecCoins) {
 	return totalRewards
 }
 
+// ReconcileOutstandingRewards sets the community pool to the coins of the
+// distribution module account which are not owed as validator outstanding
+// rewards, accounting the rounding dust accrued over time to the community
+// pool. It returns the new community pool, or an error if the module account
+// does not cover the outstanding rewards. It is meant to be called from an
+// upgrade handler.
+func (k Keeper) ReconcileOutstandingRewards(ctx sdk.Context) (sdk.DecCoins, error) {
+	outstanding := k.GetTotalRewards(ctx)
+
+	macc := k.GetDistributionAccount(ctx)
+	balances := sdk.NewDecCoinsFromCoins(k.bankKeeper.GetAllBalances(ctx, macc.GetAddress())...)
+
+	communityPool, hasNeg := balances.SafeSub(outstanding)
+	if hasNeg {
+		return nil, sdkerrors.Wrapf(
+			sdkerrors.ErrInsufficientFunds,
+			"module account coins %s do not cover the outstanding rewards %s", balances, outstanding,
+		)
+	}
+
+	feePool := k.GetFeePool(ctx)
+	k.Logger(ctx).Info(fmt.Sprintf(
+		"reconciled the community pool from %s to %s", feePool.CommunityPool, communityPool,
+	))
+
+	feePool.CommunityPool = communityPool
+	k.SetFeePool(ctx, feePool)
+
+	return communityPool, nil
+}
+
 // FundCommunityPool allows an account to directly fund the community fund pool.
 // The amount is first added to the distribution module account and then directly
 // added to the pool. An error is returned if the amount cannot be sent to the
@@ -10,6 +10,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
@@ -58,7 +59,7 @@ func TestWithdrawValidatorCommission(t *testing.T) {
 
 	// check initial balance
 	balance := app.BankKeeper.GetAllBalances(ctx, sdk.AccAddress(valAddrs[0]))
-	expTokens := sdk.TokensFromConsensusPower(1000)
+	expTokens := sdk.TokensFromConsensusPower(1000, sdk.DefaultPowerReduction)
 	expCoins := sdk.NewCoins(sdk.NewCoin("stake", expTokens))
 	require.Equal(t, expCoins, balance)
 
@@ -79,14 +80,17 @@ func TestWithdrawValidatorCommission(t *testing.T) {
 		sdk.NewCoin("stake", expTokens.AddRaw(1)),
 	), balance)
 
-	// check remainder
-	remainder := app.DistrKeeper.GetValidatorAccumulatedCommission(ctx, valAddrs[0]).Commission
+	// check the remainder is returned to the community pool
+	require.True(t, app.DistrKeeper.GetValidatorAccumulatedCommission(ctx, valAddrs[0]).Commission.IsZero())
+	require.True(t, app.DistrKeeper.GetValidatorOutstandingRewardsCoins(ctx, valAddrs[0]).IsZero())
 	require.Equal(t, sdk.DecCoins{
 		sdk.NewDecCoinFromDec("mytoken", sdk.NewDec(1).Quo(sdk.NewDec(4))),
 		sdk.NewDecCoinFromDec("stake", sdk.NewDec(1).Quo(sdk.NewDec(2))),
-	}, remainder)
+	}, app.DistrKeeper.GetFeePoolCommunityCoins(ctx))
 
-	require.True(t, true)
+	// nothing is left to withdraw
+	_, err = app.DistrKeeper.WithdrawValidatorCommission(ctx, valAddrs[0])
+	require.True(t, types.ErrNoValidatorCommission.Is(err))
 }
 
 func TestGetTotalRewards(t *testing.T) {
@@ -110,6 +114,55 @@ func TestGetTotalRewards(t *testing.T) {
 	require.Equal(t, expectedRewards, totalRewards)
 }
 
+func TestReconcileOutstandingRewards(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+
+	// set module account coins exceeding the outstanding rewards and the
+	// community pool
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, distrAcc.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("stake", 10))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	outstanding := sdk.DecCoins{sdk.NewDecCoinFromDec("stake", sdk.NewDec(7).Quo(sdk.NewDec(2)))}
+	app.DistrKeeper.SetValidatorOutstandingRewards(ctx, valAddrs[0], types.ValidatorOutstandingRewards{Rewards: outstanding})
+
+	feePool := app.DistrKeeper.GetFeePool(ctx)
+	feePool.CommunityPool = sdk.DecCoins{sdk.NewDecCoinFromDec("stake", sdk.NewDec(5).Quo(sdk.NewDec(4)))}
+	app.DistrKeeper.SetFeePool(ctx, feePool)
+
+	_, broken := keeper.ModuleAccountInvariant(app.DistrKeeper)(ctx)
+	require.True(t, broken)
+	_, broken = keeper.OutstandingRewardsInvariant(app.DistrKeeper)(ctx)
+	require.False(t, broken)
+
+	// the community pool is credited with the difference
+	communityPool, err := app.DistrKeeper.ReconcileOutstandingRewards(ctx)
+	require.NoError(t, err)
+
+	expected := sdk.DecCoins{sdk.NewDecCoinFromDec("stake", sdk.NewDec(13).Quo(sdk.NewDec(2)))}
+	require.Equal(t, expected, communityPool)
+	require.Equal(t, expected, app.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+	require.Equal(t, outstanding, app.DistrKeeper.GetValidatorOutstandingRewardsCoins(ctx, valAddrs[0]))
+
+	_, broken = keeper.ModuleAccountInvariant(app.DistrKeeper)(ctx)
+	require.False(t, broken)
+
+	// outstanding rewards which are not covered cannot be reconciled
+	outstanding = sdk.DecCoins{sdk.NewDecCoin("stake", sdk.NewInt(11))}
+	app.DistrKeeper.SetValidatorOutstandingRewards(ctx, valAddrs[0], types.ValidatorOutstandingRewards{Rewards: outstanding})
+
+	_, broken = keeper.OutstandingRewardsInvariant(app.DistrKeeper)(ctx)
+	require.True(t, broken)
+
+	_, err = app.DistrKeeper.ReconcileOutstandingRewards(ctx)
+	require.Error(t, err)
+	require.Equal(t, expected, app.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+}
+
 func TestFundCommunityPool(t *testing.T) {
 	app := simapp.Setup(false)
 	ctx := app.BaseApp.NewContext(false, abci.Header{})
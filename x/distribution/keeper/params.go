@@ -41,3 +41,10 @@ func (k Keeper) GetWithdrawAddrEnabled(ctx sdk.Context) (enabled bool) {
 	k.paramSpace.Get(ctx, types.ParamStoreKeyWithdrawAddrEnabled, &enabled)
 	return enabled
 }
+
+// GetAutoCompoundBlockGasLimit returns the gas which the compounding of the
+// auto-compounding delegations' rewards can consume in each block.
+func (k Keeper) GetAutoCompoundBlockGasLimit(ctx sdk.Context) (gasLimit uint64) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyAutoCompoundBlockGasLimit, &gasLimit)
+	return gasLimit
+}
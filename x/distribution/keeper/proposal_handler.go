@@ -10,8 +10,8 @@ import (
 
 // HandleCommunityPoolSpendProposal is a handler for executing a passed community spend proposal
 func HandleCommunityPoolSpendProposal(ctx sdk.Context, k Keeper, p *types.CommunityPoolSpendProposal) error {
-	if k.blacklistedAddrs[p.Recipient.String()] {
-		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is blacklisted from receiving external funds", p.Recipient)
+	if k.blockedAddrs[p.Recipient.String()] {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is blocked from receiving external funds", p.Recipient)
 	}
 
 	err := k.DistributeFromFeePool(ctx, p.Amount, p.Recipient)
@@ -42,6 +42,9 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case types.QueryCommunityPool:
 			return queryCommunityPool(ctx, path[1:], req, k)
 
+		case types.QueryDelegatorAutoCompounds:
+			return queryDelegatorAutoCompounds(ctx, path[1:], req, k)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query path: %s", path[0])
 		}
@@ -254,3 +257,23 @@ func queryCommunityPool(ctx sdk.Context, _ []string, _ abci.RequestQuery, k Keep
 
 	return bz, nil
 }
+
+func queryDelegatorAutoCompounds(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryDelegatorParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	validators := k.GetDelegatorAutoCompounds(ctx, params.DelegatorAddress)
+	if validators == nil {
+		validators = []sdk.ValAddress{}
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, validators)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
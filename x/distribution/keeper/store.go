@@ -382,3 +382,67 @@ func (k Keeper) DeleteAllValidatorSlashEvents(ctx sdk.Context) {
 		store.Delete(iter.Key())
 	}
 }
+
+// check whether a delegator auto-compounds the rewards of its delegation to a validator
+func (k Keeper) HasDelegatorAutoCompound(ctx sdk.Context, del sdk.AccAddress, val sdk.ValAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetDelegatorAutoCompoundKey(del, val))
+}
+
+// set a delegator auto-compounding preference for a validator
+func (k Keeper) SetDelegatorAutoCompound(ctx sdk.Context, del sdk.AccAddress, val sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetDelegatorAutoCompoundKey(del, val), []byte{})
+}
+
+// delete a delegator auto-compounding preference for a validator
+func (k Keeper) DeleteDelegatorAutoCompound(ctx sdk.Context, del sdk.AccAddress, val sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDelegatorAutoCompoundKey(del, val))
+}
+
+// get the validators of a delegator's auto-compounding preferences
+func (k Keeper) GetDelegatorAutoCompounds(ctx sdk.Context, del sdk.AccAddress) (vals []sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetDelegatorAutoCompoundPrefix(del))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		_, val := types.GetDelegatorAutoCompoundAddresses(iter.Key())
+		vals = append(vals, val)
+	}
+	return vals
+}
+
+// iterate over delegator auto-compounding preferences
+func (k Keeper) IterateDelegatorAutoCompounds(ctx sdk.Context, handler func(del sdk.AccAddress, val sdk.ValAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.DelegatorAutoCompoundPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		del, val := types.GetDelegatorAutoCompoundAddresses(iter.Key())
+		if handler(del, val) {
+			break
+		}
+	}
+}
+
+// get the auto-compounding preference key at which the compounding of rewards
+// resumes, if a compounding is in progress
+func (k Keeper) GetAutoCompoundCursor(ctx sdk.Context) (key []byte, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	key = store.Get(types.AutoCompoundCursorKey)
+	return key, key != nil
+}
+
+// set the auto-compounding preference key at which the compounding of rewards
+// resumes
+func (k Keeper) SetAutoCompoundCursor(ctx sdk.Context, key []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.AutoCompoundCursorKey, key)
+}
+
+// delete the auto-compounding cursor, once the compounding of rewards is done
+func (k Keeper) DeleteAutoCompoundCursor(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.AutoCompoundCursorKey)
+}
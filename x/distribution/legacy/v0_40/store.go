@@ -0,0 +1,20 @@
+package v040
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateStore performs an in-place store migration of the x/distribution state
+// of a chain upgrading from v0.39. The migration includes:
+//
+// - Setting the AutoCompoundBlockGasLimit parameter to its default.
+//
+// It is meant to be called from an x/upgrade handler. The paramSpace must be
+// the distribution module's subspace with its key table set.
+func MigrateStore(ctx sdk.Context, paramSpace paramtypes.Subspace) error {
+	paramSpace.Set(ctx, types.ParamStoreKeyAutoCompoundBlockGasLimit, types.DefaultAutoCompoundBlockGasLimit)
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package v040_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	v040distribution "github.com/cosmos/cosmos-sdk/x/distribution/legacy/v0_40"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	params := app.DistrKeeper.GetParams(ctx)
+	params.AutoCompoundBlockGasLimit = 0
+	app.DistrKeeper.SetParams(ctx, params)
+
+	require.NoError(t, v040distribution.MigrateStore(ctx, app.GetSubspace(types.ModuleName)))
+	require.Equal(t, types.DefaultAutoCompoundBlockGasLimit, app.DistrKeeper.GetAutoCompoundBlockGasLimit(ctx))
+
+	// the other parameters are left untouched
+	params.AutoCompoundBlockGasLimit = types.DefaultAutoCompoundBlockGasLimit
+	require.Equal(t, params, app.DistrKeeper.GetParams(ctx))
+}
@@ -16,6 +16,7 @@ import (
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/distribution/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/distribution/client/rest"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	"github.com/cosmos/cosmos-sdk/x/distribution/simulation"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
@@ -25,6 +26,7 @@ var (
 	_ module.AppModule           = AppModule{}
 	_ module.AppModuleBasic      = AppModuleBasic{}
 	_ module.AppModuleSimulation = AppModule{}
+	_ module.AppModuleMigrations = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the distribution module.
@@ -109,6 +111,16 @@ func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
 	RegisterInvariants(ir, am.keeper)
 }
 
+// ConsensusVersion returns the consensus version of the distribution module.
+func (AppModule) ConsensusVersion() uint64 { return 2 }
+
+// RegisterMigrations registers the distribution module in-place store migrations.
+func (am AppModule) RegisterMigrations(configurator module.Configurator) {
+	if err := configurator.RegisterMigration(ModuleName, 1, keeper.NewMigrator(am.keeper).Migrate1to2); err != nil {
+		panic(err)
+	}
+}
+
 // Route returns the message routing key for the distribution module.
 func (AppModule) Route() string {
 	return RouterKey
@@ -152,7 +164,8 @@ func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 
 // EndBlock returns the end blocker for the distribution module. It returns no validator
 // updates.
-func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	EndBlocker(ctx, am.keeper, am.stakingKeeper)
 	return []abci.ValidatorUpdate{}
 }
 
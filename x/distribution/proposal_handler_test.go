@@ -46,12 +46,21 @@ func TestProposalHandlerPassed(t *testing.T) {
 	feePool.CommunityPool = sdk.NewDecCoinsFromCoins(amount...)
 	app.DistrKeeper.SetFeePool(ctx, feePool)
 
+	// the module account holds the community pool
+	_, broken := distribution.ModuleAccountInvariant(app.DistrKeeper)(ctx)
+	require.False(t, broken)
+
 	tp := testProposal(recipient, amount)
 	hdlr := distribution.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)
 	require.NoError(t, hdlr(ctx, tp))
 
 	balances = app.BankKeeper.GetAllBalances(ctx, recipient)
 	require.Equal(t, balances, amount)
+
+	// the spent amount left both the community pool and the module account
+	require.True(t, app.DistrKeeper.GetFeePoolCommunityCoins(ctx).IsZero())
+	_, broken = distribution.ModuleAccountInvariant(app.DistrKeeper)(ctx)
+	require.False(t, broken)
 }
 
 func TestProposalHandlerFailed(t *testing.T) {
@@ -70,4 +79,8 @@ func TestProposalHandlerFailed(t *testing.T) {
 
 	balances := app.BankKeeper.GetAllBalances(ctx, recipient)
 	require.True(t, balances.IsZero())
+
+	// a failed spend leaves the pool accounting untouched
+	_, broken := distribution.ModuleAccountInvariant(app.DistrKeeper)(ctx)
+	require.False(t, broken)
 }
@@ -64,6 +64,14 @@ func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB tmkv.Pair) string {
 			cdc.MustUnmarshalBinaryBare(kvB.Value, &eventB)
 			return fmt.Sprintf("%v\n%v", eventA, eventB)
 
+		case bytes.Equal(kvA.Key[:1], types.DelegatorAutoCompoundPrefix):
+			return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+
+		case bytes.Equal(kvA.Key[:1], types.AutoCompoundCursorKey):
+			delAddrA, valAddrA := types.GetDelegatorAutoCompoundAddresses(kvA.Value)
+			delAddrB, valAddrB := types.GetDelegatorAutoCompoundAddresses(kvB.Value)
+			return fmt.Sprintf("%v %v\n%v %v", delAddrA, valAddrA, delAddrB, valAddrB)
+
 		default:
 			panic(fmt.Sprintf("invalid distribution key prefix %X", kvA.Key[:1]))
 		}
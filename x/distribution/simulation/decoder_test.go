@@ -48,6 +48,8 @@ func TestDecodeDistributionStore(t *testing.T) {
 		tmkv.Pair{Key: types.GetValidatorCurrentRewardsKey(valAddr1), Value: cdc.MustMarshalBinaryBare(&currentRewards)},
 		tmkv.Pair{Key: types.GetValidatorAccumulatedCommissionKey(valAddr1), Value: cdc.MustMarshalBinaryBare(&commission)},
 		tmkv.Pair{Key: types.GetValidatorSlashEventKeyPrefix(valAddr1, 13), Value: cdc.MustMarshalBinaryBare(&slashEvent)},
+		tmkv.Pair{Key: types.GetDelegatorAutoCompoundKey(delAddr1, valAddr1), Value: []byte{}},
+		tmkv.Pair{Key: types.AutoCompoundCursorKey, Value: types.GetDelegatorAutoCompoundKey(delAddr1, valAddr1)},
 		tmkv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
 
@@ -64,6 +66,8 @@ func TestDecodeDistributionStore(t *testing.T) {
 		{"ValidatorCurrentRewards", fmt.Sprintf("%v\n%v", currentRewards, currentRewards)},
 		{"ValidatorAccumulatedCommission", fmt.Sprintf("%v\n%v", commission, commission)},
 		{"ValidatorSlashEvent", fmt.Sprintf("%v\n%v", slashEvent, slashEvent)},
+		{"DelegatorAutoCompound", "\n"},
+		{"AutoCompoundCursor", fmt.Sprintf("%v %v\n%v %v", delAddr1, valAddr1, delAddr1, valAddr1)},
 		{"other", ""},
 	}
 	for i, tt := range tests {
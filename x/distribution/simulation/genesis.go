@@ -10,6 +10,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
@@ -19,6 +20,8 @@ const (
 	BaseProposerReward  = "base_proposer_reward"
 	BonusProposerReward = "bonus_proposer_reward"
 	WithdrawEnabled     = "withdraw_enabled"
+
+	AutoCompoundBlockGasLimit = "auto_compound_block_gas_limit"
 )
 
 // GenCommunityTax randomized CommunityTax
@@ -41,6 +44,12 @@ func GenWithdrawEnabled(r *rand.Rand) bool {
 	return r.Int63n(101) <= 95 // 95% chance of withdraws being enabled
 }
 
+// GenAutoCompoundBlockGasLimit returns a randomized AutoCompoundBlockGasLimit
+// parameter.
+func GenAutoCompoundBlockGasLimit(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 100000, 20000000))
+}
+
 // RandomizedGenState generates a random GenesisState for distribution
 func RandomizedGenState(simState *module.SimulationState) {
 	var communityTax sdk.Dec
@@ -67,6 +76,12 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { withdrawEnabled = GenWithdrawEnabled(r) },
 	)
 
+	var autoCompoundBlockGasLimit uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, AutoCompoundBlockGasLimit, &autoCompoundBlockGasLimit, simState.Rand,
+		func(r *rand.Rand) { autoCompoundBlockGasLimit = GenAutoCompoundBlockGasLimit(r) },
+	)
+
 	distrGenesis := types.GenesisState{
 		FeePool: types.InitialFeePool(),
 		Params: types.Params{
@@ -74,6 +89,8 @@ func RandomizedGenState(simState *module.SimulationState) {
 			BaseProposerReward:  baseProposerReward,
 			BonusProposerReward: bonusProposerReward,
 			WithdrawAddrEnabled: withdrawEnabled,
+
+			AutoCompoundBlockGasLimit: autoCompoundBlockGasLimit,
 		},
 	}
 
@@ -22,6 +22,8 @@ const (
 	OpWeightMsgWithdrawDelegationReward    = "op_weight_msg_withdraw_delegation_reward"
 	OpWeightMsgWithdrawValidatorCommission = "op_weight_msg_withdraw_validator_commission"
 	OpWeightMsgFundCommunityPool           = "op_weight_msg_fund_community_pool"
+	OpWeightMsgWithdrawAllRewards          = "op_weight_msg_withdraw_all_rewards"
+	OpWeightMsgSetAutoCompound             = "op_weight_msg_set_auto_compound"
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -58,6 +60,20 @@ func WeightedOperations(
 		},
 	)
 
+	var weightMsgWithdrawAllRewards int
+	appParams.GetOrGenerate(cdc, OpWeightMsgWithdrawAllRewards, &weightMsgWithdrawAllRewards, nil,
+		func(_ *rand.Rand) {
+			weightMsgWithdrawAllRewards = simappparams.DefaultWeightMsgWithdrawAllRewards
+		},
+	)
+
+	var weightMsgSetAutoCompound int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSetAutoCompound, &weightMsgSetAutoCompound, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetAutoCompound = simappparams.DefaultWeightMsgSetAutoCompound
+		},
+	)
+
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgSetWithdrawAddress,
@@ -75,6 +91,14 @@ func WeightedOperations(
 			weightMsgFundCommunityPool,
 			SimulateMsgFundCommunityPool(ak, bk, k, sk),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgWithdrawAllRewards,
+			SimulateMsgWithdrawAllRewards(ak, bk, k, sk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSetAutoCompound,
+			SimulateMsgSetAutoCompound(ak, bk, k, sk),
+		),
 	}
 }
 
@@ -103,7 +127,7 @@ func SimulateMsgSetWithdrawAddress(ak types.AccountKeeper, bk types.BankKeeper,
 		tx := helpers.GenTx(
 			[]sdk.Msg{msg},
 			fees,
-			helpers.DefaultGenTxGas,
+			helpers.SimTxGas,
 			chainID,
 			[]uint64{account.GetAccountNumber()},
 			[]uint64{account.GetSequence()},
@@ -150,7 +174,7 @@ func SimulateMsgWithdrawDelegatorReward(ak types.AccountKeeper, bk types.BankKee
 		tx := helpers.GenTx(
 			[]sdk.Msg{msg},
 			fees,
-			helpers.DefaultGenTxGas,
+			helpers.SimTxGas,
 			chainID,
 			[]uint64{account.GetAccountNumber()},
 			[]uint64{account.GetSequence()},
@@ -200,7 +224,7 @@ func SimulateMsgWithdrawValidatorCommission(ak types.AccountKeeper, bk types.Ban
 		tx := helpers.GenTx(
 			[]sdk.Msg{msg},
 			fees,
-			helpers.DefaultGenTxGas,
+			helpers.SimTxGas,
 			chainID,
 			[]uint64{account.GetAccountNumber()},
 			[]uint64{account.GetSequence()},
@@ -250,7 +274,7 @@ func SimulateMsgFundCommunityPool(ak types.AccountKeeper, bk types.BankKeeper, k
 		tx := helpers.GenTx(
 			[]sdk.Msg{msg},
 			fees,
-			helpers.DefaultGenTxGas,
+			helpers.SimTxGas,
 			chainID,
 			[]uint64{account.GetAccountNumber()},
 			[]uint64{account.GetSequence()},
@@ -265,3 +289,88 @@ func SimulateMsgFundCommunityPool(ak types.AccountKeeper, bk types.BankKeeper, k
 		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
 	}
 }
+
+// SimulateMsgWithdrawAllRewards generates a MsgWithdrawAllRewards with random
+// values, withdrawing the commission of the delegator's validator at random.
+func SimulateMsgWithdrawAllRewards(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, sk stakingkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		delegations := sk.GetAllDelegatorDelegations(ctx, simAccount.Address)
+		if len(delegations) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		fees, err := simtypes.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		msg := types.NewMsgWithdrawAllRewards(simAccount.Address, r.Intn(2) == 0)
+
+		// the rewards of every delegation are withdrawn
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			helpers.SimTxGas*uint64(len(delegations)),
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgSetAutoCompound generates a MsgSetAutoCompound with random values,
+// enabling or disabling the auto-compounding of a random delegation.
+func SimulateMsgSetAutoCompound(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, sk stakingkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		delegations := sk.GetAllDelegatorDelegations(ctx, simAccount.Address)
+		if len(delegations) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		delegation := delegations[r.Intn(len(delegations))]
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		fees, err := simtypes.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		msg := types.NewMsgSetAutoCompound(simAccount.Address, delegation.GetValidatorAddr(), r.Intn(2) == 0)
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			helpers.SimTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
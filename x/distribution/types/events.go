@@ -7,10 +7,16 @@ const (
 	EventTypeCommission         = "commission"
 	EventTypeWithdrawRewards    = "withdraw_rewards"
 	EventTypeWithdrawCommission = "withdraw_commission"
+	EventTypeWithdrawAllRewards = "withdraw_all_rewards"
+	EventTypeSetAutoCompound    = "set_auto_compound"
+	EventTypeAutoCompound       = "auto_compound"
 	EventTypeProposerReward     = "proposer_reward"
 
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
+	AttributeKeyDelegator       = "delegator"
+	AttributeKeyCommission      = "commission"
+	AttributeKeyEnabled         = "enabled"
 
 	AttributeValueCategory = ModuleName
 )
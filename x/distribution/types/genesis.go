@@ -51,6 +51,12 @@ type ValidatorSlashEventRecord struct {
 	Event            ValidatorSlashEvent `json:"validator_slash_event" yaml:"validator_slash_event"`
 }
 
+// used for import / export via genesis json
+type DelegatorAutoCompoundRecord struct {
+	DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+}
+
 // GenesisState - all distribution state that must be provided at genesis
 type GenesisState struct {
 	Params                          Params                                 `json:"params" yaml:"params"`
@@ -63,12 +69,14 @@ type GenesisState struct {
 	ValidatorCurrentRewards         []ValidatorCurrentRewardsRecord        `json:"validator_current_rewards" yaml:"validator_current_rewards"`
 	DelegatorStartingInfos          []DelegatorStartingInfoRecord          `json:"delegator_starting_infos" yaml:"delegator_starting_infos"`
 	ValidatorSlashEvents            []ValidatorSlashEventRecord            `json:"validator_slash_events" yaml:"validator_slash_events"`
+	DelegatorAutoCompounds          []DelegatorAutoCompoundRecord          `json:"delegator_auto_compounds" yaml:"delegator_auto_compounds"`
 }
 
 func NewGenesisState(
 	params Params, fp FeePool, dwis []DelegatorWithdrawInfo, pp sdk.ConsAddress, r []ValidatorOutstandingRewardsRecord,
 	acc []ValidatorAccumulatedCommissionRecord, historical []ValidatorHistoricalRewardsRecord,
 	cur []ValidatorCurrentRewardsRecord, dels []DelegatorStartingInfoRecord, slashes []ValidatorSlashEventRecord,
+	autoCompounds []DelegatorAutoCompoundRecord,
 ) GenesisState {
 
 	return GenesisState{
@@ -82,6 +90,7 @@ func NewGenesisState(
 		ValidatorCurrentRewards:         cur,
 		DelegatorStartingInfos:          dels,
 		ValidatorSlashEvents:            slashes,
+		DelegatorAutoCompounds:          autoCompounds,
 	}
 }
 
@@ -98,6 +107,7 @@ func DefaultGenesisState() GenesisState {
 		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
 		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
 		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		DelegatorAutoCompounds:          []DelegatorAutoCompoundRecord{},
 	}
 }
 
@@ -40,6 +40,10 @@ const (
 // - 0x07<valAddr_Bytes>: ValidatorCurrentRewards
 //
 // - 0x08<valAddr_Bytes><height>: ValidatorSlashEvent
+//
+// - 0x09<accAddr_Bytes><valAddr_Bytes>: []byte{}
+//
+// - 0x0A: the 0x09 key at which the compounding of rewards resumes
 var (
 	FeePoolKey                        = []byte{0x00} // key for global distribution state
 	ProposerKey                       = []byte{0x01} // key for the proposer operator address
@@ -51,6 +55,8 @@ var (
 	ValidatorCurrentRewardsPrefix        = []byte{0x06} // key for current validator rewards
 	ValidatorAccumulatedCommissionPrefix = []byte{0x07} // key for accumulated validator commission
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
+	DelegatorAutoCompoundPrefix          = []byte{0x09} // key for delegator auto-compounding preferences
+	AutoCompoundCursorKey                = []byte{0x0A} // key for the auto-compounding preference to resume at
 )
 
 // gets an address from a validator's outstanding rewards key
@@ -132,6 +138,21 @@ func GetValidatorSlashEventAddressHeight(key []byte) (valAddr sdk.ValAddress, he
 	return
 }
 
+// gets the addresses from a delegator auto-compounding preference key
+func GetDelegatorAutoCompoundAddresses(key []byte) (delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	addr := key[1 : 1+sdk.AddrLen]
+	if len(addr) != sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	delAddr = sdk.AccAddress(addr)
+	addr = key[1+sdk.AddrLen:]
+	if len(addr) != sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	valAddr = sdk.ValAddress(addr)
+	return
+}
+
 // gets the outstanding rewards key for a validator
 func GetValidatorOutstandingRewardsKey(valAddr sdk.ValAddress) []byte {
 	return append(ValidatorOutstandingRewardsPrefix, valAddr.Bytes()...)
@@ -191,3 +212,13 @@ func GetValidatorSlashEventKey(v sdk.ValAddress, height, period uint64) []byte {
 	prefix := GetValidatorSlashEventKeyPrefix(v, height)
 	return append(prefix, periodBz...)
 }
+
+// gets the prefix key for a delegator's auto-compounding preferences
+func GetDelegatorAutoCompoundPrefix(d sdk.AccAddress) []byte {
+	return append(DelegatorAutoCompoundPrefix, d.Bytes()...)
+}
+
+// gets the key for a delegator's auto-compounding preference for a validator
+func GetDelegatorAutoCompoundKey(d sdk.AccAddress, v sdk.ValAddress) []byte {
+	return append(GetDelegatorAutoCompoundPrefix(d), v.Bytes()...)
+}
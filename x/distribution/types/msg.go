@@ -7,7 +7,10 @@ import (
 )
 
 // Verify interface at compile time
-var _, _, _ sdk.Msg = &MsgSetWithdrawAddress{}, &MsgWithdrawDelegatorReward{}, &MsgWithdrawValidatorCommission{}
+var (
+	_, _, _ sdk.Msg = &MsgSetWithdrawAddress{}, &MsgWithdrawDelegatorReward{}, &MsgWithdrawValidatorCommission{}
+	_, _    sdk.Msg = &MsgWithdrawAllRewards{}, &MsgSetAutoCompound{}
+)
 
 func NewMsgSetWithdrawAddress(delAddr, withdrawAddr sdk.AccAddress) MsgSetWithdrawAddress {
 	return MsgSetWithdrawAddress{
@@ -143,3 +146,85 @@ func (msg MsgFundCommunityPool) ValidateBasic() error {
 
 	return nil
 }
+
+const TypeMsgWithdrawAllRewards = "withdraw_all_rewards"
+
+// NewMsgWithdrawAllRewards returns a new MsgWithdrawAllRewards with a delegator
+// and whether the commission of its validator is withdrawn as well.
+func NewMsgWithdrawAllRewards(delAddr sdk.AccAddress, withdrawCommission bool) MsgWithdrawAllRewards {
+	return MsgWithdrawAllRewards{
+		DelegatorAddress:   delAddr,
+		WithdrawCommission: withdrawCommission,
+	}
+}
+
+// Route returns the MsgWithdrawAllRewards message route.
+func (msg MsgWithdrawAllRewards) Route() string { return ModuleName }
+
+// Type returns the MsgWithdrawAllRewards message type.
+func (msg MsgWithdrawAllRewards) Type() string { return TypeMsgWithdrawAllRewards }
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgWithdrawAllRewards) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddress}
+}
+
+// GetSignBytes returns the raw bytes for a MsgWithdrawAllRewards message that
+// the expected signer needs to sign.
+func (msg MsgWithdrawAllRewards) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgWithdrawAllRewards message validation.
+func (msg MsgWithdrawAllRewards) ValidateBasic() error {
+	if msg.DelegatorAddress.Empty() {
+		return ErrEmptyDelegatorAddr
+	}
+
+	return nil
+}
+
+const TypeMsgSetAutoCompound = "set_auto_compound"
+
+// NewMsgSetAutoCompound returns a new MsgSetAutoCompound with a delegator, a
+// validator and whether the rewards of the delegation are restaked.
+func NewMsgSetAutoCompound(delAddr sdk.AccAddress, valAddr sdk.ValAddress, enabled bool) MsgSetAutoCompound {
+	return MsgSetAutoCompound{
+		DelegatorAddress: delAddr,
+		ValidatorAddress: valAddr,
+		Enabled:          enabled,
+	}
+}
+
+// Route returns the MsgSetAutoCompound message route.
+func (msg MsgSetAutoCompound) Route() string { return ModuleName }
+
+// Type returns the MsgSetAutoCompound message type.
+func (msg MsgSetAutoCompound) Type() string { return TypeMsgSetAutoCompound }
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgSetAutoCompound) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddress}
+}
+
+// GetSignBytes returns the raw bytes for a MsgSetAutoCompound message that the
+// expected signer needs to sign.
+func (msg MsgSetAutoCompound) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgSetAutoCompound message validation.
+func (msg MsgSetAutoCompound) ValidateBasic() error {
+	if msg.DelegatorAddress.Empty() {
+		return ErrEmptyDelegatorAddr
+	}
+	if msg.ValidatorAddress.Empty() {
+		return ErrEmptyValidatorAddr
+	}
+
+	return nil
+}
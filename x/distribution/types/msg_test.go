@@ -73,6 +73,52 @@ func TestMsgWithdrawValidatorCommission(t *testing.T) {
 	}
 }
 
+// test ValidateBasic for MsgWithdrawAllRewards
+func TestMsgWithdrawAllRewards(t *testing.T) {
+	tests := []struct {
+		delegatorAddr      sdk.AccAddress
+		withdrawCommission bool
+		expectPass         bool
+	}{
+		{delAddr1, false, true},
+		{delAddr1, true, true},
+		{emptyDelAddr, false, false},
+		{emptyDelAddr, true, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgWithdrawAllRewards(tc.delegatorAddr, tc.withdrawCommission)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for MsgSetAutoCompound
+func TestMsgSetAutoCompound(t *testing.T) {
+	tests := []struct {
+		delegatorAddr sdk.AccAddress
+		validatorAddr sdk.ValAddress
+		enabled       bool
+		expectPass    bool
+	}{
+		{delAddr1, valAddr1, true, true},
+		{delAddr1, valAddr1, false, true},
+		{emptyDelAddr, valAddr1, true, false},
+		{delAddr1, emptyValAddr, true, false},
+		{emptyDelAddr, emptyValAddr, false, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgSetAutoCompound(tc.delegatorAddr, tc.validatorAddr, tc.enabled)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
 // test ValidateBasic for MsgDepositIntoCommunityPool
 func TestMsgDepositIntoCommunityPool(t *testing.T) {
 	tests := []struct {
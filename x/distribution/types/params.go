@@ -20,8 +20,14 @@ var (
 	ParamStoreKeyBaseProposerReward  = []byte("baseproposerreward")
 	ParamStoreKeyBonusProposerReward = []byte("bonusproposerreward")
 	ParamStoreKeyWithdrawAddrEnabled = []byte("withdrawaddrenabled")
+
+	ParamStoreKeyAutoCompoundBlockGasLimit = []byte("autocompoundblockgaslimit")
 )
 
+// DefaultAutoCompoundBlockGasLimit is the default gas which the compounding of
+// the auto-compounding delegations' rewards can consume in each block.
+const DefaultAutoCompoundBlockGasLimit uint64 = 10000000
+
 // ParamKeyTable returns the parameter key table.
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -34,6 +40,8 @@ func DefaultParams() Params {
 		BaseProposerReward:  sdk.NewDecWithPrec(1, 2), // 1%
 		BonusProposerReward: sdk.NewDecWithPrec(4, 2), // 4%
 		WithdrawAddrEnabled: true,
+
+		AutoCompoundBlockGasLimit: DefaultAutoCompoundBlockGasLimit,
 	}
 }
 
@@ -49,6 +57,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyBaseProposerReward, &p.BaseProposerReward, validateBaseProposerReward),
 		paramtypes.NewParamSetPair(ParamStoreKeyBonusProposerReward, &p.BonusProposerReward, validateBonusProposerReward),
 		paramtypes.NewParamSetPair(ParamStoreKeyWithdrawAddrEnabled, &p.WithdrawAddrEnabled, validateWithdrawAddrEnabled),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyAutoCompoundBlockGasLimit, &p.AutoCompoundBlockGasLimit, validateAutoCompoundBlockGasLimit,
+		),
 	}
 }
 
@@ -143,3 +154,12 @@ func validateWithdrawAddrEnabled(i interface{}) error {
 
 	return nil
 }
+
+func validateAutoCompoundBlockGasLimit(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
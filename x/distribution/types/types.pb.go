@@ -177,6 +177,124 @@ func (m *MsgWithdrawValidatorCommission) GetValidatorAddress() github_com_cosmos
 	return nil
 }
 
+// MsgWithdrawAllRewards defines a Msg type that allows a delegator to withdraw
+// the rewards of all of its delegations and, optionally, the commission of the
+// validator it operates.
+type MsgWithdrawAllRewards struct {
+	DelegatorAddress   github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	WithdrawCommission bool                                          `protobuf:"varint,2,opt,name=withdraw_commission,json=withdrawCommission,proto3" json:"withdraw_commission,omitempty" yaml:"withdraw_commission"`
+}
+
+func (m *MsgWithdrawAllRewards) Reset()         { *m = MsgWithdrawAllRewards{} }
+func (m *MsgWithdrawAllRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawAllRewards) ProtoMessage()    {}
+func (*MsgWithdrawAllRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9fddf2a8e4a90b09, []int{3}
+}
+func (m *MsgWithdrawAllRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawAllRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawAllRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawAllRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawAllRewards.Merge(m, src)
+}
+func (m *MsgWithdrawAllRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawAllRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawAllRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawAllRewards proto.InternalMessageInfo
+
+func (m *MsgWithdrawAllRewards) GetDelegatorAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return nil
+}
+
+func (m *MsgWithdrawAllRewards) GetWithdrawCommission() bool {
+	if m != nil {
+		return m.WithdrawCommission
+	}
+	return false
+}
+
+// MsgSetAutoCompound defines a Msg type that allows a delegator to enable or
+// disable the automatic restaking of the rewards of its delegation to a
+// validator.
+type MsgSetAutoCompound struct {
+	DelegatorAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	ValidatorAddress github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator_address,omitempty" yaml:"validator_address"`
+	Enabled          bool                                          `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *MsgSetAutoCompound) Reset()         { *m = MsgSetAutoCompound{} }
+func (m *MsgSetAutoCompound) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompound) ProtoMessage()    {}
+func (*MsgSetAutoCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9fddf2a8e4a90b09, []int{4}
+}
+func (m *MsgSetAutoCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompound.Merge(m, src)
+}
+func (m *MsgSetAutoCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoCompound proto.InternalMessageInfo
+
+func (m *MsgSetAutoCompound) GetDelegatorAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return nil
+}
+
+func (m *MsgSetAutoCompound) GetValidatorAddress() github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return nil
+}
+
+func (m *MsgSetAutoCompound) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
 // MsgFundCommunityPool defines a Msg type that allows an account to directly
 // fund the community pool.
 type MsgFundCommunityPool struct {
@@ -188,7 +306,7 @@ func (m *MsgFundCommunityPool) Reset()         { *m = MsgFundCommunityPool{} }
 func (m *MsgFundCommunityPool) String() string { return proto.CompactTextString(m) }
 func (*MsgFundCommunityPool) ProtoMessage()    {}
 func (*MsgFundCommunityPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9fddf2a8e4a90b09, []int{3}
+	return fileDescriptor_9fddf2a8e4a90b09, []int{5}
 }
 func (m *MsgFundCommunityPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -237,12 +355,15 @@ type Params struct {
 	BaseProposerReward  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=base_proposer_reward,json=baseProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_proposer_reward" yaml:"base_proposer_reward"`
 	BonusProposerReward github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bonus_proposer_reward" yaml:"bonus_proposer_reward"`
 	WithdrawAddrEnabled bool                                   `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty" yaml:"withdraw_addr_enabled"`
+	// auto_compound_block_gas_limit is the gas which the compounding of the
+	// auto-compounding delegations' rewards can consume in each block.
+	AutoCompoundBlockGasLimit uint64 `protobuf:"varint,5,opt,name=auto_compound_block_gas_limit,json=autoCompoundBlockGasLimit,proto3" json:"auto_compound_block_gas_limit,omitempty" yaml:"auto_compound_block_gas_limit"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9fddf2a8e4a90b09, []int{4}
+	return fileDescriptor_9fddf2a8e4a90b09, []int{6}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -278,6 +399,13 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetAutoCompoundBlockGasLimit() uint64 {
+	if m != nil {
+		return m.AutoCompoundBlockGasLimit
+	}
+	return 0
+}
+
 // historical rewards for a validator
 // height is implicit within the store key
 // cumulative reward ratio is the sum from the zeroeth period
@@ -286,10 +414,11 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 // which might need to reference this historical entry
 // at any point.
 // ReferenceCount =
-//    number of outstanding delegations which ended the associated period (and might need to read
-//    that record)
-//  + number of slashes which ended the associated period (and might need to read that record)
-//  + one per validator for the zeroeth period, set on initialization
+//
+//	  number of outstanding delegations which ended the associated period (and might need to read
+//	  that record)
+//	+ number of slashes which ended the associated period (and might need to read that record)
+//	+ one per validator for the zeroeth period, set on initialization
 type ValidatorHistoricalRewards struct {
 	CumulativeRewardRatio github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=cumulative_reward_ratio,json=cumulativeRewardRatio,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"cumulative_reward_ratio" yaml:"cumulative_reward_ratio"`
 	ReferenceCount        uint32                                      `protobuf:"varint,2,opt,name=reference_count,json=referenceCount,proto3" json:"reference_count,omitempty" yaml:"reference_count"`
@@ -299,7 +428,7 @@ func (m *ValidatorHistoricalRewards) Reset()         { *m = ValidatorHistoricalR
 func (m *ValidatorHistoricalRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorHistoricalRewards) ProtoMessage()    {}
 func (*ValidatorHistoricalRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9fddf2a8e4a90b09, []int{5}
+	return fileDescriptor_9fddf2a8e4a90b09, []int{7}
 }
 func (m *ValidatorHistoricalRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -354,7 +483,7 @@ func (m *ValidatorCurrentRewards) Reset()         { *m = ValidatorCurrentRewards
 func (m *ValidatorCurrentRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorCurrentRewards) ProtoMessage()    {}
 func (*ValidatorCurrentRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9fddf2a8e4a90b09, []int{6}
+	return fileDescriptor_9fddf2a8e4a90b09, []int{8}
 }
 func (m *ValidatorCurrentRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -407,7 +536,7 @@ func (m *ValidatorAccumulatedCommission) Reset()         { *m = ValidatorAccumul
 func (m *ValidatorAccumulatedCommission) String() string { return proto.CompactTextString(m) }
 func (*ValidatorAccumulatedCommission) ProtoMessage()    {}
 func (*ValidatorAccumulatedCommission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9fddf2a8e4a90b09, []int{7}
+	return fileDescriptor_9fddf2a8e4a90b09, []int{9}
 }
 func (m *ValidatorAccumulatedCommission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -453,7 +582,7 @@ func (m *ValidatorOutstandingRewards) Reset()         { *m = ValidatorOutstandin
 func (m *ValidatorOutstandingRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorOutstandingRewards) ProtoMessage()    {}
 func (*ValidatorOutstandingRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9fddf2a8e4a90b09, []int{8}
+	return fileDescriptor_9fddf2a8e4a90b09, []int{10}
 }
 func (m *ValidatorOutstandingRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -502,7 +631,7 @@ func (m *ValidatorSlashEvent) Reset()         { *m = ValidatorSlashEvent{} }
 func (m *ValidatorSlashEvent) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSlashEvent) ProtoMessage()    {}
 func (*ValidatorSlashEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9fddf2a8e4a90b09, []int{9}
+	return fileDescriptor_9fddf2a8e4a90b09, []int{11}
 }
 func (m *ValidatorSlashEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -546,7 +675,7 @@ type ValidatorSlashEvents struct {
 func (m *ValidatorSlashEvents) Reset()      { *m = ValidatorSlashEvents{} }
 func (*ValidatorSlashEvents) ProtoMessage() {}
 func (*ValidatorSlashEvents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9fddf2a8e4a90b09, []int{10}
+	return fileDescriptor_9fddf2a8e4a90b09, []int{12}
 }
 func (m *ValidatorSlashEvents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -591,7 +720,7 @@ func (m *FeePool) Reset()         { *m = FeePool{} }
 func (m *FeePool) String() string { return proto.CompactTextString(m) }
 func (*FeePool) ProtoMessage()    {}
 func (*FeePool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9fddf2a8e4a90b09, []int{11}
+	return fileDescriptor_9fddf2a8e4a90b09, []int{13}
 }
 func (m *FeePool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -638,7 +767,7 @@ type CommunityPoolSpendProposal struct {
 func (m *CommunityPoolSpendProposal) Reset()      { *m = CommunityPoolSpendProposal{} }
 func (*CommunityPoolSpendProposal) ProtoMessage() {}
 func (*CommunityPoolSpendProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9fddf2a8e4a90b09, []int{12}
+	return fileDescriptor_9fddf2a8e4a90b09, []int{14}
 }
 func (m *CommunityPoolSpendProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -684,7 +813,7 @@ func (m *DelegatorStartingInfo) Reset()         { *m = DelegatorStartingInfo{} }
 func (m *DelegatorStartingInfo) String() string { return proto.CompactTextString(m) }
 func (*DelegatorStartingInfo) ProtoMessage()    {}
 func (*DelegatorStartingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9fddf2a8e4a90b09, []int{13}
+	return fileDescriptor_9fddf2a8e4a90b09, []int{15}
 }
 func (m *DelegatorStartingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -731,6 +860,8 @@ func init() {
 	proto.RegisterType((*MsgSetWithdrawAddress)(nil), "cosmos_sdk.x.distribution.v1.MsgSetWithdrawAddress")
 	proto.RegisterType((*MsgWithdrawDelegatorReward)(nil), "cosmos_sdk.x.distribution.v1.MsgWithdrawDelegatorReward")
 	proto.RegisterType((*MsgWithdrawValidatorCommission)(nil), "cosmos_sdk.x.distribution.v1.MsgWithdrawValidatorCommission")
+	proto.RegisterType((*MsgWithdrawAllRewards)(nil), "cosmos_sdk.x.distribution.v1.MsgWithdrawAllRewards")
+	proto.RegisterType((*MsgSetAutoCompound)(nil), "cosmos_sdk.x.distribution.v1.MsgSetAutoCompound")
 	proto.RegisterType((*MsgFundCommunityPool)(nil), "cosmos_sdk.x.distribution.v1.MsgFundCommunityPool")
 	proto.RegisterType((*Params)(nil), "cosmos_sdk.x.distribution.v1.Params")
 	proto.RegisterType((*ValidatorHistoricalRewards)(nil), "cosmos_sdk.x.distribution.v1.ValidatorHistoricalRewards")
@@ -747,77 +878,85 @@ func init() {
 func init() { proto.RegisterFile("x/distribution/types/types.proto", fileDescriptor_9fddf2a8e4a90b09) }
 
 var fileDescriptor_9fddf2a8e4a90b09 = []byte{
-	// 1116 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x38, 0x6e, 0x9a, 0x4c, 0xd3, 0xa4, 0xd9, 0xd8, 0x49, 0xe4, 0x14, 0xaf, 0x35, 0x12,
-	0x55, 0x24, 0x14, 0x87, 0xd0, 0x5b, 0x0e, 0x48, 0x71, 0x7e, 0x08, 0x50, 0x43, 0xa2, 0x4d, 0x28,
-	0x12, 0x12, 0x5a, 0x8d, 0x77, 0x27, 0xf6, 0x28, 0xeb, 0x9d, 0xd5, 0xcc, 0xd8, 0x4e, 0x7a, 0x41,
-	0xe2, 0x04, 0x02, 0x2a, 0x0e, 0x08, 0x7a, 0xe0, 0xd0, 0x0b, 0x12, 0x54, 0xe2, 0xdf, 0x40, 0x3d,
-	0xf6, 0x06, 0xe2, 0xe0, 0xa2, 0xe4, 0xc6, 0x31, 0x37, 0x38, 0xa1, 0xdd, 0x19, 0xef, 0x6e, 0x1c,
-	0xab, 0x8d, 0x23, 0x95, 0x5e, 0x12, 0xef, 0x9b, 0x99, 0xef, 0xfb, 0xe6, 0x9b, 0x79, 0xef, 0xed,
-	0xc2, 0xf2, 0xd1, 0xb2, 0x4b, 0x85, 0xe4, 0xb4, 0xd6, 0x92, 0x94, 0xf9, 0xcb, 0xf2, 0x38, 0x20,
-	0x42, 0xfd, 0xad, 0x04, 0x9c, 0x49, 0x66, 0xdc, 0x76, 0x98, 0x68, 0x32, 0x61, 0x0b, 0xf7, 0xb0,
-	0x72, 0x54, 0x49, 0x4f, 0xae, 0xb4, 0x57, 0x8a, 0x77, 0x64, 0x83, 0x72, 0xd7, 0x0e, 0x30, 0x97,
-	0xc7, 0xcb, 0xd1, 0x82, 0xe5, 0x3a, 0xab, 0xb3, 0xe4, 0x97, 0x42, 0x29, 0x4e, 0x5f, 0x00, 0x46,
-	0x5f, 0x67, 0x61, 0x61, 0x5b, 0xd4, 0xf7, 0x88, 0xfc, 0x98, 0xca, 0x86, 0xcb, 0x71, 0x67, 0xcd,
-	0x75, 0x39, 0x11, 0xc2, 0x78, 0x00, 0xa7, 0x5d, 0xe2, 0x91, 0x3a, 0x96, 0x8c, 0xdb, 0x58, 0x05,
-	0xe7, 0x41, 0x19, 0x2c, 0x4e, 0x54, 0xb7, 0xcf, 0xba, 0xe6, 0xfc, 0x31, 0x6e, 0x7a, 0xab, 0xe8,
-	0xc2, 0x14, 0xf4, 0x6f, 0xd7, 0x5c, 0xaa, 0x53, 0xd9, 0x68, 0xd5, 0x2a, 0x0e, 0x6b, 0x2e, 0x2b,
-	0xe1, 0xfa, 0xdf, 0x92, 0x70, 0x0f, 0x35, 0xfd, 0x9a, 0xe3, 0x68, 0x26, 0xeb, 0x56, 0x0c, 0xd2,
-	0xe3, 0xee, 0xc0, 0x5b, 0x1d, 0x2d, 0x27, 0xa6, 0xce, 0x46, 0xd4, 0xf7, 0xce, 0xba, 0xe6, 0x9c,
-	0xa2, 0xee, 0x9f, 0x71, 0x05, 0xe6, 0xa9, 0xce, 0xf9, 0x4d, 0xa3, 0xef, 0xb2, 0xb0, 0xb8, 0x2d,
-	0xea, 0x3d, 0x2f, 0x36, 0x7a, 0xc2, 0x2c, 0xd2, 0xc1, 0xdc, 0x7d, 0xad, 0x9e, 0x3c, 0x80, 0xd3,
-	0x6d, 0xec, 0x51, 0xf7, 0x1c, 0x77, 0xb6, 0x9f, 0xfb, 0xc2, 0x94, 0xcb, 0x72, 0xdf, 0xc7, 0x5e,
-	0xcc, 0x1d, 0x83, 0xf4, 0x6c, 0xf9, 0x11, 0xc0, 0x52, 0xca, 0x96, 0xfb, 0xbd, 0xf1, 0x75, 0xd6,
-	0x6c, 0x52, 0x21, 0x28, 0xf3, 0x07, 0xcb, 0x03, 0xff, 0x8f, 0xbc, 0xdf, 0x00, 0xcc, 0x6f, 0x8b,
-	0xfa, 0x56, 0xcb, 0x77, 0x43, 0x45, 0x2d, 0x9f, 0xca, 0xe3, 0x5d, 0xc6, 0x3c, 0xe3, 0x53, 0x38,
-	0x8a, 0x9b, 0xac, 0xe5, 0xcb, 0x79, 0x50, 0x1e, 0x59, 0xbc, 0xf1, 0xce, 0x4c, 0x25, 0x95, 0x47,
-	0xed, 0x95, 0xca, 0x3a, 0xa3, 0x7e, 0xf5, 0xed, 0xa7, 0x5d, 0x33, 0xf3, 0xe4, 0xb9, 0xb9, 0x78,
-	0x09, 0x19, 0xe1, 0x02, 0x61, 0x69, 0x50, 0x63, 0x07, 0x8e, 0xbb, 0x24, 0x60, 0x82, 0x4a, 0xc6,
-	0xf5, 0x51, 0xac, 0x0c, 0x7f, 0xd4, 0x09, 0x06, 0xfa, 0x7d, 0x04, 0x8e, 0xee, 0x62, 0x8e, 0x9b,
-	0xc2, 0x38, 0x84, 0x37, 0x9d, 0xde, 0x5e, 0x6c, 0x89, 0x8f, 0x22, 0x2f, 0xc7, 0xab, 0x5b, 0xa1,
-	0xd8, 0x3f, 0xbb, 0xe6, 0x9d, 0x4b, 0x70, 0x6c, 0x10, 0xe7, 0xac, 0x6b, 0xe6, 0x95, 0xf3, 0xe7,
-	0xc0, 0x90, 0x35, 0x11, 0x3f, 0xef, 0xe3, 0x23, 0xe3, 0x33, 0x98, 0xaf, 0x61, 0x41, 0xec, 0x80,
-	0xb3, 0x80, 0x09, 0xc2, 0x6d, 0x1e, 0xdd, 0xf7, 0x68, 0x4f, 0xe3, 0xd5, 0xed, 0xa1, 0x39, 0x17,
-	0x14, 0xe7, 0x20, 0x4c, 0x64, 0x19, 0x61, 0x78, 0x57, 0x47, 0x75, 0x62, 0x7d, 0x0e, 0x60, 0xa1,
-	0xc6, 0xfc, 0x96, 0xb8, 0x20, 0x61, 0x24, 0x92, 0xf0, 0xe1, 0xd0, 0x12, 0x6e, 0x6b, 0x09, 0x83,
-	0x40, 0x91, 0x35, 0x13, 0xc5, 0xfb, 0x44, 0xec, 0xc3, 0xc2, 0xb9, 0x9a, 0x62, 0x13, 0x1f, 0xd7,
-	0x3c, 0xe2, 0xce, 0xe7, 0xca, 0x60, 0x71, 0xac, 0x5a, 0x4e, 0x50, 0x07, 0x4e, 0x43, 0xd6, 0x4c,
-	0xba, 0x9c, 0x6c, 0xaa, 0xe8, 0x6a, 0xee, 0xd1, 0x63, 0x33, 0x83, 0xbe, 0xcc, 0xc2, 0x62, 0x9c,
-	0x36, 0xef, 0x51, 0x21, 0x19, 0xa7, 0x0e, 0xf6, 0x14, 0xb3, 0x30, 0x7e, 0x02, 0x70, 0xce, 0x69,
-	0x35, 0x5b, 0x1e, 0x96, 0xb4, 0x4d, 0xb4, 0x4c, 0x9b, 0x63, 0x49, 0x99, 0xbe, 0xba, 0xb3, 0x7d,
-	0x57, 0x77, 0x83, 0x38, 0xd1, 0xed, 0xfd, 0x28, 0x74, 0xe6, 0xac, 0x6b, 0x96, 0xf4, 0x31, 0x0f,
-	0x06, 0x41, 0x4f, 0x9e, 0x9b, 0x6f, 0x5d, 0xce, 0x3b, 0x75, 0xc5, 0x0b, 0x09, 0x90, 0xd2, 0x68,
-	0x85, 0x30, 0xc6, 0x3a, 0x9c, 0xe2, 0xe4, 0x80, 0x70, 0xe2, 0x3b, 0xc4, 0x76, 0xa2, 0xcc, 0x0a,
-	0xef, 0xc8, 0xcd, 0x6a, 0xf1, 0xac, 0x6b, 0xce, 0x2a, 0x09, 0x7d, 0x13, 0x90, 0x35, 0x19, 0x47,
-	0xd6, 0xa3, 0xc0, 0x23, 0x00, 0xe7, 0x92, 0x12, 0xd2, 0xe2, 0x9c, 0xf8, 0xb2, 0x67, 0x04, 0x81,
-	0xd7, 0x95, 0x6e, 0xf1, 0x92, 0x7d, 0xdf, 0xd5, 0x59, 0x3b, 0xd4, 0xae, 0x7a, 0xd8, 0xc6, 0x2c,
-	0x1c, 0x0d, 0x08, 0xa7, 0x4c, 0x5d, 0xf1, 0x9c, 0xa5, 0x9f, 0xd0, 0x37, 0x00, 0x96, 0x62, 0x69,
-	0x6b, 0x8e, 0x36, 0x81, 0xb8, 0xa9, 0x42, 0x77, 0x08, 0xa1, 0x13, 0x3f, 0xbd, 0x0a, 0x91, 0x29,
-	0x78, 0xf4, 0x3d, 0x80, 0x0b, 0xb1, 0x9e, 0x9d, 0x96, 0x14, 0x12, 0xfb, 0x2e, 0xf5, 0xeb, 0x3d,
-	0xbb, 0x3a, 0x97, 0xb5, 0x6b, 0x53, 0x5f, 0x93, 0xc9, 0xde, 0x19, 0x45, 0x8b, 0xd0, 0x55, 0x0d,
-	0x44, 0xbf, 0x00, 0x38, 0x13, 0x0b, 0xdb, 0xf3, 0xb0, 0x68, 0x6c, 0xb6, 0x89, 0x2f, 0x8d, 0x2d,
-	0x98, 0x94, 0x67, 0x5b, 0x5b, 0x1c, 0x56, 0xae, 0x5c, 0x75, 0x21, 0xe9, 0xdc, 0xfd, 0x33, 0x90,
-	0x35, 0x15, 0x87, 0x76, 0xa3, 0x88, 0xf1, 0x01, 0x1c, 0x3b, 0xe0, 0xd8, 0x09, 0xdf, 0x70, 0x74,
-	0x15, 0xaa, 0x0c, 0x57, 0x02, 0xac, 0x78, 0x3d, 0xfa, 0x15, 0xc0, 0xfc, 0x00, 0xad, 0xc2, 0x78,
-	0x08, 0xe0, 0x6c, 0xa2, 0x45, 0x84, 0x23, 0x36, 0x89, 0x86, 0xb4, 0x9b, 0x2b, 0x95, 0x17, 0xbd,
-	0x77, 0x55, 0x06, 0x80, 0x56, 0xdf, 0xd4, 0x46, 0xbf, 0xd1, 0xbf, 0xd5, 0x34, 0x3c, 0xb2, 0xf2,
-	0xed, 0x01, 0x82, 0x74, 0xad, 0xf8, 0x01, 0xc0, 0xeb, 0x5b, 0x84, 0x44, 0x1d, 0xec, 0x2b, 0x00,
-	0x27, 0x93, 0xd2, 0x1d, 0x30, 0xe6, 0xbd, 0xe4, 0xa0, 0xef, 0x69, 0xfe, 0x42, 0x7f, 0xd9, 0x0f,
-	0xd7, 0x0e, 0x7d, 0xde, 0x49, 0x0f, 0x0a, 0xd5, 0xa0, 0x87, 0x59, 0x58, 0x3c, 0xd7, 0x61, 0xf7,
-	0x02, 0xe2, 0xbb, 0xaa, 0x8c, 0x62, 0xcf, 0xc8, 0xc3, 0x6b, 0x92, 0x4a, 0x8f, 0xa8, 0x5e, 0x65,
-	0xa9, 0x07, 0xa3, 0x0c, 0x6f, 0xb8, 0x44, 0x38, 0x9c, 0x06, 0xc9, 0x69, 0x5a, 0xe9, 0x50, 0xd8,
-	0x47, 0x39, 0x71, 0x68, 0x40, 0x89, 0x2f, 0xa3, 0x82, 0x7f, 0xb5, 0x3e, 0x1a, 0x63, 0xa4, 0xfa,
-	0x7e, 0xee, 0x15, 0xf4, 0xfd, 0xd5, 0xb1, 0x2f, 0x1e, 0x9b, 0x99, 0xe8, 0xa8, 0xfe, 0x01, 0xb0,
-	0x10, 0xbf, 0x24, 0xee, 0x49, 0xcc, 0x25, 0xf5, 0xeb, 0xef, 0xfb, 0x07, 0x51, 0xa5, 0x0c, 0x38,
-	0x69, 0x53, 0x16, 0xb6, 0x9f, 0x74, 0x1e, 0xa4, 0x2a, 0x65, 0xdf, 0x04, 0x64, 0x4d, 0xf6, 0x22,
-	0x3a, 0x0b, 0xf6, 0xe1, 0x35, 0x21, 0xf1, 0x21, 0xd1, 0x29, 0xf0, 0xee, 0xd0, 0x5d, 0x70, 0x42,
-	0x11, 0x45, 0x20, 0xc8, 0x52, 0x60, 0xc6, 0x26, 0x1c, 0x6d, 0x10, 0x5a, 0x6f, 0x28, 0xaf, 0x73,
-	0xd5, 0xa5, 0xbf, 0xbb, 0xe6, 0x94, 0xc3, 0x49, 0x58, 0xe1, 0x7d, 0x5b, 0x0d, 0x25, 0x22, 0xfb,
-	0x06, 0x90, 0xa5, 0x17, 0x57, 0x77, 0x7e, 0x3e, 0x29, 0x81, 0xa7, 0x27, 0x25, 0xf0, 0xec, 0xa4,
-	0x04, 0xfe, 0x3a, 0x29, 0x81, 0x6f, 0x4f, 0x4b, 0x99, 0x67, 0xa7, 0xa5, 0xcc, 0x1f, 0xa7, 0xa5,
-	0xcc, 0x27, 0x2b, 0x2f, 0xd4, 0x38, 0xe8, 0x83, 0xa7, 0x36, 0x1a, 0x7d, 0x92, 0xdc, 0xfd, 0x2f,
-	0x00, 0x00, 0xff, 0xff, 0x34, 0x5a, 0x18, 0x60, 0x0f, 0x0d, 0x00, 0x00,
+	// 1233 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x38, 0x6e, 0xda, 0x4c, 0xdb, 0xa4, 0xdd, 0xd8, 0xa9, 0x71, 0x5b, 0xaf, 0x35, 0x82,
+	0x2a, 0x12, 0xaa, 0x43, 0xe8, 0xad, 0x07, 0xa4, 0x6c, 0x9a, 0xf0, 0x43, 0x0d, 0x89, 0x36, 0xa5,
+	0x48, 0x48, 0x68, 0x35, 0xde, 0x9d, 0xda, 0x83, 0xd7, 0x3b, 0xab, 0x99, 0x59, 0x3b, 0xe9, 0x05,
+	0x89, 0x13, 0x08, 0xa8, 0x38, 0x20, 0xe8, 0x81, 0x43, 0x2f, 0x20, 0xa8, 0xc4, 0x5f, 0x81, 0x84,
+	0x7a, 0xec, 0x11, 0x71, 0x70, 0x51, 0xca, 0x89, 0xa3, 0x6f, 0x70, 0x42, 0xbb, 0x3b, 0xfb, 0x23,
+	0x8e, 0xd5, 0xc6, 0x95, 0x4a, 0x0f, 0x5c, 0x12, 0xef, 0x9b, 0x37, 0xdf, 0xfb, 0xe6, 0x7b, 0xf3,
+	0xde, 0xdb, 0x85, 0xf5, 0xdd, 0x65, 0x87, 0x0a, 0xc9, 0x69, 0x33, 0x90, 0x94, 0x79, 0xcb, 0x72,
+	0xcf, 0x27, 0x22, 0xfe, 0xdb, 0xf0, 0x39, 0x93, 0x4c, 0xbb, 0x60, 0x33, 0xd1, 0x65, 0xc2, 0x12,
+	0x4e, 0xa7, 0xb1, 0xdb, 0xc8, 0x3b, 0x37, 0x7a, 0x2b, 0xd5, 0x4b, 0xb2, 0x4d, 0xb9, 0x63, 0xf9,
+	0x98, 0xcb, 0xbd, 0xe5, 0x68, 0xc3, 0x72, 0x8b, 0xb5, 0x58, 0xf6, 0x2b, 0x46, 0xa9, 0x9e, 0x3d,
+	0x04, 0x8c, 0xbe, 0x28, 0xc0, 0xf2, 0xa6, 0x68, 0xed, 0x10, 0xf9, 0x3e, 0x95, 0x6d, 0x87, 0xe3,
+	0xfe, 0xaa, 0xe3, 0x70, 0x22, 0x84, 0x76, 0x1b, 0x9e, 0x75, 0x88, 0x4b, 0x5a, 0x58, 0x32, 0x6e,
+	0xe1, 0xd8, 0x58, 0x01, 0x75, 0xb0, 0x74, 0xca, 0xd8, 0x1c, 0x0e, 0xf4, 0xca, 0x1e, 0xee, 0xba,
+	0x57, 0xd1, 0x21, 0x17, 0xf4, 0xcf, 0x40, 0xbf, 0xdc, 0xa2, 0xb2, 0x1d, 0x34, 0x1b, 0x36, 0xeb,
+	0x2e, 0xc7, 0xc4, 0xd5, 0xbf, 0xcb, 0xc2, 0xe9, 0xa8, 0xf0, 0xab, 0xb6, 0xad, 0x22, 0x99, 0x67,
+	0x52, 0x90, 0x24, 0x76, 0x1f, 0x9e, 0xe9, 0x2b, 0x3a, 0x69, 0xe8, 0x42, 0x14, 0xfa, 0xfa, 0x70,
+	0xa0, 0x9f, 0x8b, 0x43, 0x8f, 0x7a, 0x3c, 0x43, 0xe4, 0xf9, 0xfe, 0xc1, 0x43, 0xa3, 0xaf, 0x0b,
+	0xb0, 0xba, 0x29, 0x5a, 0x89, 0x16, 0xd7, 0x12, 0x62, 0x26, 0xe9, 0x63, 0xee, 0xbc, 0x50, 0x4d,
+	0x6e, 0xc3, 0xb3, 0x3d, 0xec, 0x52, 0xe7, 0x40, 0xec, 0xc2, 0x68, 0xec, 0x43, 0x2e, 0x47, 0x8d,
+	0x7d, 0x13, 0xbb, 0x69, 0xec, 0x14, 0x24, 0x91, 0xe5, 0x3b, 0x00, 0x6b, 0x39, 0x59, 0x6e, 0x26,
+	0xeb, 0x6b, 0xac, 0xdb, 0xa5, 0x42, 0x50, 0xe6, 0x8d, 0xa7, 0x07, 0xfe, 0x1b, 0x7a, 0x7f, 0x02,
+	0x58, 0xce, 0xd1, 0x5b, 0x75, 0xdd, 0x38, 0x5f, 0x2f, 0xf6, 0x12, 0x6f, 0xc1, 0x85, 0xf4, 0x8a,
+	0xda, 0xa9, 0x50, 0x51, 0xca, 0x4e, 0x18, 0xb5, 0xe1, 0x40, 0xaf, 0x8e, 0xdc, 0xe3, 0xcc, 0x09,
+	0x99, 0x5a, 0x62, 0xcd, 0x24, 0x46, 0x3f, 0x14, 0xa0, 0x16, 0xd7, 0xea, 0x6a, 0x20, 0xd9, 0x1a,
+	0xeb, 0xfa, 0x2c, 0xf0, 0xfe, 0xb7, 0x97, 0x52, 0xab, 0xc0, 0xe3, 0xc4, 0xc3, 0x4d, 0x97, 0x38,
+	0x95, 0xe9, 0x50, 0x53, 0x33, 0x79, 0x44, 0xbf, 0x02, 0x58, 0xda, 0x14, 0xad, 0x8d, 0xc0, 0x73,
+	0x42, 0xf9, 0x02, 0x8f, 0xca, 0xbd, 0x6d, 0xc6, 0x5c, 0xed, 0x43, 0x38, 0x83, 0xbb, 0x2c, 0xf0,
+	0x64, 0x05, 0xd4, 0xa7, 0x97, 0x4e, 0xbe, 0xbe, 0xd0, 0xc8, 0xf5, 0xd5, 0xde, 0x4a, 0x63, 0x8d,
+	0x51, 0xcf, 0x78, 0xed, 0xc1, 0x40, 0x9f, 0xba, 0xff, 0x48, 0x5f, 0x3a, 0x02, 0xc1, 0x70, 0x83,
+	0x30, 0x15, 0xa8, 0xb6, 0x05, 0x67, 0x1d, 0xe2, 0x33, 0x41, 0x25, 0xe3, 0x4a, 0x85, 0x95, 0xc9,
+	0x55, 0xce, 0x30, 0xd0, 0x2f, 0x45, 0x38, 0xb3, 0x8d, 0x39, 0xee, 0x0a, 0xad, 0x03, 0x4f, 0xdb,
+	0xc9, 0x59, 0x2c, 0x89, 0x77, 0xa3, 0x0c, 0xcf, 0x1a, 0x1b, 0x21, 0xd9, 0xdf, 0x07, 0xfa, 0xa5,
+	0x23, 0xc4, 0xb8, 0x46, 0xec, 0xe1, 0x40, 0x2f, 0xc5, 0x39, 0x39, 0x00, 0x86, 0xcc, 0x53, 0xe9,
+	0xf3, 0x0d, 0xbc, 0xab, 0x7d, 0x0c, 0x4b, 0x4d, 0x2c, 0x88, 0xe5, 0x73, 0xe6, 0x33, 0x41, 0xb8,
+	0xc5, 0xa3, 0x7a, 0x8a, 0xce, 0x34, 0x6b, 0x6c, 0x4e, 0x1c, 0xf3, 0x7c, 0x1c, 0x73, 0x1c, 0x26,
+	0x32, 0xb5, 0xd0, 0xbc, 0xad, 0xac, 0xaa, 0xd1, 0x7e, 0x02, 0x60, 0xb9, 0xc9, 0xbc, 0x40, 0x1c,
+	0xa2, 0x30, 0x1d, 0x51, 0x78, 0x77, 0x62, 0x0a, 0x17, 0x14, 0x85, 0x71, 0xa0, 0xc8, 0x5c, 0x88,
+	0xec, 0x23, 0x24, 0x6e, 0xc0, 0xf2, 0x81, 0x19, 0x63, 0x25, 0xd7, 0xad, 0x18, 0x95, 0x70, 0x3d,
+	0x43, 0x1d, 0xeb, 0x86, 0xcc, 0x85, 0xfc, 0x78, 0x59, 0x8f, 0xad, 0xda, 0x47, 0xf0, 0x22, 0x0e,
+	0x24, 0xb3, 0x6c, 0x55, 0xbf, 0x56, 0xd3, 0x65, 0x76, 0xc7, 0x6a, 0x61, 0x61, 0xb9, 0xb4, 0x4b,
+	0x65, 0xe5, 0x58, 0x1d, 0x2c, 0x15, 0x8d, 0xa5, 0xe1, 0x40, 0x7f, 0x39, 0x46, 0x7f, 0xa2, 0x3b,
+	0x32, 0x5f, 0xc2, 0xb9, 0x6e, 0x60, 0x84, 0xab, 0x6f, 0x62, 0x71, 0x3d, 0x5c, 0xbb, 0x5a, 0xbc,
+	0x7b, 0x4f, 0x9f, 0x42, 0x9f, 0x15, 0x60, 0x35, 0x6d, 0xd9, 0x6f, 0x51, 0x21, 0x19, 0xa7, 0x36,
+	0x4e, 0x7b, 0xe4, 0xf7, 0x00, 0x9e, 0xb3, 0x83, 0x6e, 0xe0, 0x62, 0x49, 0x7b, 0x44, 0x49, 0x62,
+	0x71, 0x2c, 0x29, 0x53, 0x65, 0xb2, 0x38, 0x52, 0x26, 0xd7, 0x88, 0x1d, 0x55, 0xca, 0x7b, 0x61,
+	0x16, 0x86, 0x03, 0xbd, 0xa6, 0xae, 0xd4, 0x78, 0x10, 0x74, 0xff, 0x91, 0xfe, 0xea, 0xd1, 0xf2,
+	0x14, 0x97, 0x53, 0x39, 0x03, 0x8a, 0x39, 0x9a, 0x21, 0x8c, 0xb6, 0x06, 0xe7, 0x39, 0xb9, 0x45,
+	0x38, 0xf1, 0x6c, 0x62, 0xd9, 0x51, 0x15, 0x87, 0xf7, 0xf1, 0xb4, 0x51, 0x1d, 0x0e, 0xf4, 0xc5,
+	0x98, 0xc2, 0x88, 0x03, 0x32, 0xe7, 0x52, 0xcb, 0x5a, 0x64, 0xb8, 0x0b, 0xe0, 0xb9, 0x6c, 0x7c,
+	0x05, 0x9c, 0x13, 0x4f, 0x26, 0x42, 0x10, 0x78, 0x3c, 0xe6, 0x2d, 0x9e, 0x72, 0xee, 0x2b, 0xaa,
+	0x43, 0x4c, 0x74, 0xaa, 0x04, 0x5b, 0x5b, 0x84, 0x33, 0x3e, 0xe1, 0x94, 0xc5, 0xe5, 0x54, 0x34,
+	0xd5, 0x13, 0xfa, 0x12, 0xc0, 0x5a, 0x4a, 0x6d, 0xd5, 0x56, 0x22, 0x10, 0x27, 0x37, 0x64, 0x3b,
+	0x10, 0xe6, 0x26, 0xc9, 0x73, 0x20, 0x99, 0x83, 0x47, 0xdf, 0x00, 0x78, 0x3e, 0xe5, 0xb3, 0x15,
+	0x48, 0x21, 0xb1, 0xe7, 0x50, 0xaf, 0x95, 0xc8, 0xd5, 0x3f, 0xaa, 0x5c, 0xeb, 0xea, 0x9a, 0xcc,
+	0x25, 0x39, 0x8a, 0x36, 0xa1, 0x67, 0x15, 0x10, 0xfd, 0x04, 0xe0, 0x42, 0x4a, 0x6c, 0xc7, 0xc5,
+	0xa2, 0xbd, 0xde, 0x23, 0x9e, 0xd4, 0x36, 0x60, 0x36, 0x24, 0x2c, 0x25, 0x31, 0x88, 0x8a, 0xe9,
+	0x7c, 0xf6, 0xd6, 0x38, 0xea, 0x81, 0xcc, 0xf9, 0xd4, 0xb4, 0x1d, 0x59, 0xb4, 0x77, 0xe0, 0x89,
+	0x5b, 0x1c, 0xdb, 0x32, 0x99, 0xd6, 0xb3, 0x46, 0x63, 0xb2, 0x76, 0x63, 0xa6, 0xfb, 0xd1, 0xcf,
+	0x00, 0x96, 0xc6, 0x70, 0x15, 0xda, 0x1d, 0x00, 0x17, 0x33, 0x2e, 0x22, 0x5c, 0xb1, 0x48, 0xb4,
+	0xa4, 0xd4, 0x5c, 0x69, 0x3c, 0xe9, 0x9d, 0xbf, 0x31, 0x06, 0xd4, 0x78, 0x45, 0x09, 0x7d, 0x71,
+	0xf4, 0xa8, 0x79, 0x78, 0x64, 0x96, 0x7a, 0x63, 0x08, 0xa9, 0x5e, 0xf1, 0x2d, 0x80, 0xc7, 0x37,
+	0x08, 0x89, 0xa6, 0xe5, 0xe7, 0x00, 0xce, 0x65, 0x63, 0xc2, 0x67, 0xcc, 0x7d, 0x4a, 0xa2, 0xaf,
+	0xab, 0xf8, 0xe5, 0xd1, 0x11, 0x13, 0xee, 0x9d, 0x38, 0xdf, 0xd9, 0xbc, 0x0b, 0xd9, 0xa0, 0x3b,
+	0x05, 0x58, 0x3d, 0x30, 0xcd, 0x77, 0x7c, 0xe2, 0x39, 0x71, 0xcb, 0xc6, 0xae, 0x56, 0x82, 0xc7,
+	0x24, 0x95, 0x2e, 0x89, 0xe7, 0xa2, 0x19, 0x3f, 0x68, 0x75, 0x78, 0xd2, 0x21, 0xc2, 0xe6, 0xd4,
+	0xcf, 0xb2, 0x69, 0xe6, 0x4d, 0xe1, 0xcc, 0xe6, 0xc4, 0xa6, 0x3e, 0x25, 0x9e, 0xac, 0x4c, 0x3f,
+	0xf3, 0xcc, 0x4e, 0x31, 0x72, 0xef, 0x18, 0xc5, 0xe7, 0xf0, 0x8e, 0x71, 0xf5, 0xc4, 0xa7, 0xf7,
+	0xf4, 0xa9, 0x28, 0x55, 0x7f, 0x03, 0x58, 0x4e, 0x3f, 0x50, 0x76, 0x24, 0xe6, 0x92, 0x7a, 0xad,
+	0xb7, 0xbd, 0x5b, 0x51, 0xa7, 0xf4, 0x39, 0xe9, 0x51, 0x16, 0x8e, 0xba, 0x7c, 0x1d, 0xe4, 0x3a,
+	0xe5, 0x88, 0x03, 0x32, 0xe7, 0x12, 0x8b, 0xaa, 0x82, 0x1b, 0xf0, 0x98, 0x90, 0xb8, 0x43, 0x54,
+	0x09, 0xbc, 0x31, 0xf1, 0xc4, 0x3d, 0x15, 0x07, 0x8a, 0x40, 0x90, 0x19, 0x83, 0x69, 0xeb, 0x70,
+	0xa6, 0x4d, 0x68, 0xab, 0x1d, 0x6b, 0x5d, 0x34, 0x2e, 0xff, 0x35, 0xd0, 0xe7, 0x6d, 0x4e, 0xc2,
+	0x0e, 0xef, 0x59, 0xf1, 0x52, 0x46, 0x72, 0x64, 0x01, 0x99, 0x6a, 0xb3, 0xb1, 0xf5, 0xe3, 0x7e,
+	0x0d, 0x3c, 0xd8, 0xaf, 0x81, 0x87, 0xfb, 0x35, 0xf0, 0xc7, 0x7e, 0x0d, 0x7c, 0xf5, 0xb8, 0x36,
+	0xf5, 0xf0, 0x71, 0x6d, 0xea, 0xb7, 0xc7, 0xb5, 0xa9, 0x0f, 0x56, 0x9e, 0xc8, 0x71, 0xdc, 0xc7,
+	0x76, 0x73, 0x26, 0xfa, 0x1c, 0xbe, 0xf2, 0xef, 0x00, 0x9c, 0x7a, 0xa9, 0xed, 0x8b, 0x0f, 0x00,
+	0x00,
 }
 
 func (this *MsgSetWithdrawAddress) Equal(that interface{}) bool {
@@ -898,6 +1037,63 @@ func (this *MsgWithdrawValidatorCommission) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgWithdrawAllRewards) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgWithdrawAllRewards)
+	if !ok {
+		that2, ok := that.(MsgWithdrawAllRewards)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.DelegatorAddress, that1.DelegatorAddress) {
+		return false
+	}
+	if this.WithdrawCommission != that1.WithdrawCommission {
+		return false
+	}
+	return true
+}
+func (this *MsgSetAutoCompound) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSetAutoCompound)
+	if !ok {
+		that2, ok := that.(MsgSetAutoCompound)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.DelegatorAddress, that1.DelegatorAddress) {
+		return false
+	}
+	if !bytes.Equal(this.ValidatorAddress, that1.ValidatorAddress) {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	return true
+}
 func (this *MsgFundCommunityPool) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -961,6 +1157,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if this.AutoCompoundBlockGasLimit != that1.AutoCompoundBlockGasLimit {
+		return false
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -1342,6 +1541,93 @@ func (m *MsgWithdrawValidatorCommission) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgWithdrawAllRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawAllRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawAllRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WithdrawCommission {
+		i--
+		if m.WithdrawCommission {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoCompound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoCompound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgFundCommunityPool) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1406,6 +1692,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoCompoundBlockGasLimit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AutoCompoundBlockGasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.WithdrawAddrEnabled {
 		i--
 		if m.WithdrawAddrEnabled {
@@ -1878,44 +2169,83 @@ func (m *MsgWithdrawValidatorCommission) Size() (n int) {
 	return n
 }
 
-func (m *MsgFundCommunityPool) Size() (n int) {
+func (m *MsgWithdrawAllRewards) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
-		}
-	}
-	l = len(m.Depositor)
+	l = len(m.DelegatorAddress)
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.WithdrawCommission {
+		n += 2
+	}
 	return n
 }
 
-func (m *Params) Size() (n int) {
+func (m *MsgSetAutoCompound) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.CommunityTax.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.BaseProposerReward.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.BonusProposerReward.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.WithdrawAddrEnabled {
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Enabled {
 		n += 2
 	}
 	return n
 }
 
-func (m *ValidatorHistoricalRewards) Size() (n int) {
+func (m *MsgFundCommunityPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CommunityTax.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.BaseProposerReward.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.BonusProposerReward.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.WithdrawAddrEnabled {
+		n += 2
+	}
+	if m.AutoCompoundBlockGasLimit != 0 {
+		n += 1 + sovTypes(uint64(m.AutoCompoundBlockGasLimit))
+	}
+	return n
+}
+
+func (m *ValidatorHistoricalRewards) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2404,6 +2734,254 @@ func (m *MsgWithdrawValidatorCommission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgWithdrawAllRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawAllRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawAllRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = append(m.DelegatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.DelegatorAddress == nil {
+				m.DelegatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawCommission", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithdrawCommission = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAutoCompound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAutoCompound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAutoCompound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = append(m.DelegatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.DelegatorAddress == nil {
+				m.DelegatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2676,6 +3254,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundBlockGasLimit", wireType)
+			}
+			m.AutoCompoundBlockGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundBlockGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -11,8 +11,9 @@ import (
 // HandleDoubleSign implements an equivocation evidence handler. Assuming the
 // evidence is valid, the validator committing the misbehavior will be slashed,
 // jailed and tombstoned. Once tombstoned, the validator will not be able to
-// recover. Note, the evidence contains the block time and height at the time of
-// the equivocation.
+// recover. The handled evidence is persisted so that it can be queried. Note,
+// the evidence contains the block time and height at the time of the
+// equivocation.
 //
 // The evidence is considered invalid if:
 // - the evidence is too old
@@ -119,4 +120,5 @@ func (k Keeper) HandleDoubleSign(ctx sdk.Context, evidence types.Equivocation) {
 
 	k.slashingKeeper.JailUntil(ctx, consAddr, types.DoubleSignJailEndTime)
 	k.slashingKeeper.Tombstone(ctx, consAddr)
+	k.SetEvidence(ctx, evidence)
 }
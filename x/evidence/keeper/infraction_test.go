@@ -24,7 +24,7 @@ func (suite *KeeperTestSuite) TestHandleDoubleSign() {
 
 	power := int64(100)
 	stakingParams := suite.app.StakingKeeper.GetParams(ctx)
-	selfDelegation := sdk.TokensFromConsensusPower(power)
+	selfDelegation := sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)
 	operatorAddr, val := valAddresses[0], pubkeys[0]
 
 	// create validator
@@ -61,6 +61,11 @@ func (suite *KeeperTestSuite) TestHandleDoubleSign() {
 	newTokens := suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetTokens()
 	suite.True(newTokens.LT(oldTokens))
 
+	// the evidence should be persisted
+	stored, ok := suite.app.EvidenceKeeper.GetEvidence(ctx, evidence.Hash())
+	suite.True(ok)
+	suite.Equal(evidence.Hash(), stored.Hash())
+
 	// submit duplicate evidence
 	suite.app.EvidenceKeeper.HandleDoubleSign(ctx, evidence)
 
@@ -90,7 +95,7 @@ func (suite *KeeperTestSuite) TestHandleDoubleSign_TooOld() {
 
 	power := int64(100)
 	stakingParams := suite.app.StakingKeeper.GetParams(ctx)
-	amt := sdk.TokensFromConsensusPower(power)
+	amt := sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)
 	operatorAddr, val := valAddresses[0], pubkeys[0]
 
 	// create validator
@@ -122,4 +127,7 @@ func (suite *KeeperTestSuite) TestHandleDoubleSign_TooOld() {
 
 	suite.False(suite.app.StakingKeeper.Validator(ctx, operatorAddr).IsJailed())
 	suite.False(suite.app.SlashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(val.Address())))
+
+	_, ok := suite.app.EvidenceKeeper.GetEvidence(ctx, evidence.Hash())
+	suite.False(ok)
 }
@@ -144,6 +144,21 @@ func (k Keeper) GetAllEvidence(ctx sdk.Context) (evidence []exported.Evidence) {
 	return evidence
 }
 
+// GetPaginatedEvidence returns a page of the stored Evidence objects, ordered
+// by hash.
+func (k Keeper) GetPaginatedEvidence(ctx sdk.Context, page, limit int) []exported.Evidence {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIteratorPaginated(store, types.KeyPrefixEvidence, uint(page), uint(limit))
+	defer iterator.Close()
+
+	evidence := []exported.Evidence{}
+	for ; iterator.Valid(); iterator.Next() {
+		evidence = append(evidence, k.MustUnmarshalEvidence(iterator.Value()))
+	}
+
+	return evidence
+}
+
 // MustUnmarshalEvidence attempts to decode and return an Evidence object from
 // raw encoded bytes. It panics on error.
 func (k Keeper) MustUnmarshalEvidence(bz []byte) exported.Evidence {
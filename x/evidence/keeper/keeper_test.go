@@ -36,7 +36,7 @@ var (
 		sdk.ValAddress(pubkeys[2].Address()),
 	}
 
-	initAmt   = sdk.TokensFromConsensusPower(200)
+	initAmt   = sdk.TokensFromConsensusPower(200, sdk.DefaultPowerReduction)
 	initCoins = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initAmt))
 )
 
@@ -197,6 +197,17 @@ func (suite *KeeperTestSuite) TestIterateEvidence() {
 	suite.Len(evidence, numEvidence)
 }
 
+func (suite *KeeperTestSuite) TestGetPaginatedEvidence() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+	numEvidence := 100
+	suite.populateEvidence(ctx, numEvidence)
+
+	all := suite.app.EvidenceKeeper.GetAllEvidence(ctx)
+	suite.Equal(all[30:60], suite.app.EvidenceKeeper.GetPaginatedEvidence(ctx, 2, 30))
+	suite.Equal(all[90:], suite.app.EvidenceKeeper.GetPaginatedEvidence(ctx, 4, 30))
+	suite.Empty(suite.app.EvidenceKeeper.GetPaginatedEvidence(ctx, 5, 30))
+}
+
 func (suite *KeeperTestSuite) TestGetEvidenceHandler() {
 	handler, err := suite.app.EvidenceKeeper.GetEvidenceHandler(types.Equivocation{}.Route())
 	suite.NoError(err)
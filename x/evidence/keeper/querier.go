@@ -3,7 +3,6 @@ package keeper
 import (
 	"encoding/hex"
 
-	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -13,6 +12,10 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
+// defaultEvidenceLimit is the page size of the AllEvidence query when no limit
+// is given.
+const defaultEvidenceLimit = 100
+
 func NewQuerier(k Keeper) sdk.Querier {
 	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
 		var (
@@ -69,13 +72,15 @@ func queryAllEvidence(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte,
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	evidence := k.GetAllEvidence(ctx)
+	// the evidence is paginated from the store rather than in memory
+	evidence := []exported.Evidence{}
+	if params.Page > 0 && params.Limit >= 0 {
+		limit := params.Limit
+		if limit == 0 {
+			limit = defaultEvidenceLimit
+		}
 
-	start, end := client.Paginate(len(evidence), params.Page, params.Limit, 100)
-	if start < 0 || end < 0 {
-		evidence = []exported.Evidence{}
-	} else {
-		evidence = evidence[start:end]
+		evidence = k.GetPaginatedEvidence(ctx, params.Page, limit)
 	}
 
 	res, err := codec.MarshalJSONIndent(k.cdc, evidence)
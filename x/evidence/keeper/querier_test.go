@@ -71,6 +71,26 @@ func (suite *KeeperTestSuite) TestQueryAllEvidence() {
 	suite.Len(e, numEvidence)
 }
 
+func (suite *KeeperTestSuite) TestQueryAllEvidence_DefaultLimit() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+	cdc := std.NewAppCodec(suite.app.Codec())
+	numEvidence := 150
+
+	suite.populateEvidence(ctx, numEvidence)
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryAllEvidence}, "/"),
+		Data: cdc.MustMarshalJSON(types.NewQueryAllEvidenceParams(2, 0)),
+	}
+
+	bz, err := suite.querier(ctx, []string{types.QueryAllEvidence}, query)
+	suite.Nil(err)
+	suite.NotNil(bz)
+
+	var e []exported.Evidence
+	suite.Nil(cdc.UnmarshalJSON(bz, &e))
+	suite.Len(e, 50)
+}
+
 func (suite *KeeperTestSuite) TestQueryAllEvidence_InvalidPagination() {
 	ctx := suite.ctx.WithIsCheckTx(false)
 	cdc := std.NewAppCodec(suite.app.Codec())
@@ -54,7 +54,13 @@ func GenTxCmd(ctx *server.Context, cdc *codec.Codec, mbm module.BasicManager, sm
 
 		It creates a genesis transaction to create a validator. 
 		The following default parameters are included: 
-		    %s`, defaultsDesc),
+		    %s
+		The commission and the minimum self delegation of the validator are set by the
+		--commission-rate, --commission-max-rate, --commission-max-change-rate and
+		--min-self-delegation flags. The genesis transaction is checked against the
+		genesis file: the key must have a genesis balance covering the staked amount,
+		in the bond denom, and the commission rate cannot be less than the minimum
+		commission rate.`, defaultsDesc),
 
 		RunE: func(cmd *cobra.Command, args []string) error {
 
@@ -136,6 +142,10 @@ func GenTxCmd(ctx *server.Context, cdc *codec.Codec, mbm module.BasicManager, sm
 				return errors.Wrap(err, "failed to build create-validator message")
 			}
 
+			if _, err = genutil.ValidateGenTxMsg(genesisState, msg, cdc); err != nil {
+				return errors.Wrap(err, "failed to validate create-validator message")
+			}
+
 			if key.GetType() == keyring.TypeOffline || key.GetType() == keyring.TypeMulti {
 				cmd.PrintErrln("Offline key passed in. Use `tx sign` command to sign.")
 				return authclient.PrintUnsignedStdTx(txBldr, cliCtx, []sdk.Msg{msg})
@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -17,6 +18,7 @@ import (
 	v036 "github.com/cosmos/cosmos-sdk/x/genutil/legacy/v0_36"
 	v038 "github.com/cosmos/cosmos-sdk/x/genutil/legacy/v0_38"
 	v039 "github.com/cosmos/cosmos-sdk/x/genutil/legacy/v0_39"
+	v040 "github.com/cosmos/cosmos-sdk/x/genutil/legacy/v0_40"
 )
 
 const (
@@ -31,6 +33,7 @@ var migrationMap = extypes.MigrationMap{
 	"v0.36": v036.Migrate,
 	"v0.38": v038.Migrate, // NOTE: v0.37 and v0.38 are genesis compatible
 	"v0.39": v039.Migrate,
+	"v0.40": v040.Migrate,
 }
 
 // GetMigrationCallback returns a MigrationCallback for a given version.
@@ -61,9 +64,13 @@ func MigrateGenesisCmd(_ *server.Context, cdc *codec.Codec) *cobra.Command {
 		Short: "Migrate genesis to a specified target version",
 		Long: fmt.Sprintf(`Migrate the source genesis into the target version and print to STDOUT.
 
+The source genesis must have been exported by the version preceding the target
+version: a genesis is migrated one version at a time. The target versions are:
+%s.
+
 Example:
-$ %s migrate v0.36 /path/to/genesis.json --chain-id=cosmoshub-3 --genesis-time=2019-04-22T17:00:00Z
-`, version.ServerName),
+$ %s migrate v0.40 /path/to/genesis.json --chain-id=cosmoshub-4 --genesis-time=2020-08-01T17:00:00Z
+`, strings.Join(GetMigrationVersions(), ", "), version.ServerName),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			var err error
@@ -1,8 +1,7 @@
 package genutil
 
-// DONTCOVER
-
 import (
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -13,6 +12,7 @@ import (
 	"strings"
 
 	cfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/p2p"
 	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -20,7 +20,6 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankexported "github.com/cosmos/cosmos-sdk/x/bank/exported"
 	"github.com/cosmos/cosmos-sdk/x/genutil/types"
-	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // GenAppStateFromConfig gets the genesis app state from the config
@@ -30,7 +29,7 @@ func GenAppStateFromConfig(cdc *codec.Codec, config *cfg.Config,
 
 	// process genesis transactions, else create default genesis.json
 	appGenTxs, persistentPeers, err := CollectStdTxs(
-		cdc, config.Moniker, initCfg.GenTxsDir, genDoc, genBalIterator,
+		cdc, initCfg.NodeID, initCfg.GenTxsDir, genDoc, genBalIterator,
 	)
 	if err != nil {
 		return appState, err
@@ -68,7 +67,10 @@ func GenAppStateFromConfig(cdc *codec.Codec, config *cfg.Config,
 
 // CollectStdTxs processes and validates application's genesis StdTxs and returns
 // the list of appGenTxs, and persistent peers required to generate genesis.json.
-func CollectStdTxs(cdc *codec.Codec, moniker, genTxsDir string,
+// The persistent peers are the nodes of the genesis StdTxs other than the node
+// of the given node ID. Two genesis StdTxs cannot share a moniker, a node, a
+// validator or a consensus public key.
+func CollectStdTxs(cdc *codec.Codec, nodeID, genTxsDir string,
 	genDoc tmtypes.GenesisDoc, genBalIterator types.GenesisBalancesIterator,
 ) (appGenTxs []authtypes.StdTx, persistentPeers string, err error) {
 
@@ -98,6 +100,13 @@ func CollectStdTxs(cdc *codec.Codec, moniker, genTxsDir string,
 	// addresses and IPs (and port) validator server info
 	var addressesIPs []string
 
+	// the files of the genesis StdTxs by moniker, node ID, validator address and
+	// consensus public key, to reject the duplicates
+	monikers := make(map[string]string)
+	nodeIDs := make(map[string]string)
+	valAddrs := make(map[string]string)
+	valPubKeys := make(map[string]string)
+
 	for _, fo := range fos {
 		filename := filepath.Join(genTxsDir, fo.Name())
 		if !fo.IsDir() && (filepath.Ext(filename) != ".json") {
@@ -126,14 +135,40 @@ func CollectStdTxs(cdc *codec.Codec, moniker, genTxsDir string,
 			return appGenTxs, persistentPeers, fmt.Errorf("failed to find node's address and IP in %s", fo.Name())
 		}
 
+		genTxNodeID, err := parseNodeID(nodeAddrIP)
+		if err != nil {
+			return appGenTxs, persistentPeers, fmt.Errorf("invalid node address %q in %s: %w", nodeAddrIP, fo.Name(), err)
+		}
+
 		// genesis transactions must be single-message
 		msgs := genStdTx.GetMsgs()
 		if len(msgs) != 1 {
-			return appGenTxs, persistentPeers, errors.New("each genesis transaction must provide a single genesis message")
+			return appGenTxs, persistentPeers, fmt.Errorf("genesis transaction %s must provide a single genesis message", fo.Name())
 		}
 
-		// TODO abstract out staking message validation back to staking
-		msg := msgs[0].(stakingtypes.MsgCreateValidator)
+		msg, err := ValidateGenTxMsg(appState, msgs[0], cdc)
+		if err != nil {
+			return appGenTxs, persistentPeers, fmt.Errorf("invalid genesis transaction %s: %w", fo.Name(), err)
+		}
+
+		for _, unique := range []struct {
+			kind  string
+			value string
+			files map[string]string
+		}{
+			{"moniker", msg.Description.Moniker, monikers},
+			{"node ID", genTxNodeID, nodeIDs},
+			{"validator", msg.ValidatorAddress.String(), valAddrs},
+			{"consensus public key", msg.Pubkey, valPubKeys},
+		} {
+			if file, ok := unique.files[unique.value]; ok {
+				return appGenTxs, persistentPeers, fmt.Errorf(
+					"genesis transactions %s and %s have the same %s %s", file, fo.Name(), unique.kind, unique.value,
+				)
+			}
+
+			unique.files[unique.value] = fo.Name()
+		}
 
 		// validate delegator and validator addresses and funds against the accounts in the state
 		delAddr := msg.DelegatorAddress.String()
@@ -157,7 +192,7 @@ func CollectStdTxs(cdc *codec.Codec, moniker, genTxsDir string,
 		}
 
 		// exclude itself from persistent peers
-		if msg.Description.Moniker != moniker {
+		if genTxNodeID != nodeID {
 			addressesIPs = append(addressesIPs, nodeAddrIP)
 		}
 	}
@@ -167,3 +202,19 @@ func CollectStdTxs(cdc *codec.Codec, moniker, genTxsDir string,
 
 	return appGenTxs, persistentPeers, nil
 }
+
+// parseNodeID returns the node ID of a node address of the form
+// <node-id>@<host>:<port>.
+func parseNodeID(nodeAddrIP string) (string, error) {
+	parts := strings.SplitN(nodeAddrIP, "@", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return "", errors.New("expected <node-id>@<host>:<port>")
+	}
+
+	idBz, err := hex.DecodeString(parts[0])
+	if err != nil || len(idBz) != p2p.IDByteLength {
+		return "", fmt.Errorf("node ID %q must be %d hex-encoded bytes", parts[0], p2p.IDByteLength)
+	}
+
+	return parts[0], nil
+}
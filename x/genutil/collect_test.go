@@ -0,0 +1,187 @@
+package genutil_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+const (
+	nodeID1 = "528fd3df22b31f4969b05652bfe8f0fe921321d5"
+	nodeID2 = "0b572fec755dc4a2e3b891d4dae4d1b5ba402446"
+)
+
+type genTxConfig struct {
+	moniker    string
+	nodeID     string
+	addr       sdk.AccAddress
+	pubKey     crypto.PubKey
+	commission sdk.Dec
+}
+
+func newGenTxConfig(moniker, nodeID string, addr sdk.AccAddress) genTxConfig {
+	return genTxConfig{
+		moniker:    moniker,
+		nodeID:     nodeID,
+		addr:       addr,
+		pubKey:     ed25519.GenPrivKey().PubKey(),
+		commission: sdk.NewDecWithPrec(1, 1),
+	}
+}
+
+// genesisDoc returns a genesis doc in which the given addresses have a balance,
+// and whose minimum commission rate is 5%.
+func genesisDoc(t *testing.T, cdc *codec.Codec, addrs ...sdk.AccAddress) tmtypes.GenesisDoc {
+	appState := simapp.NewDefaultGenesisState()
+
+	balances := make([]banktypes.Balance, len(addrs))
+	for i, addr := range addrs {
+		balances[i] = banktypes.Balance{
+			Address: addr,
+			Coins:   sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+		}
+	}
+
+	bankGenState := banktypes.DefaultGenesisState()
+	bankGenState.Balances = balances
+	appState[banktypes.ModuleName] = cdc.MustMarshalJSON(bankGenState)
+
+	stakingGenState := stakingtypes.DefaultGenesisState()
+	stakingGenState.Params.MinCommissionRate = sdk.NewDecWithPrec(5, 2)
+	appState[stakingtypes.ModuleName] = cdc.MustMarshalJSON(stakingGenState)
+
+	appStateBz, err := codec.MarshalJSONIndent(cdc, appState)
+	require.NoError(t, err)
+
+	return tmtypes.GenesisDoc{ChainID: "test-chain", AppState: appStateBz}
+}
+
+// writeGenTxs writes the gentxs of the given configs to a new directory, which
+// it returns.
+func writeGenTxs(t *testing.T, cdc *codec.Codec, configs ...genTxConfig) string {
+	dir, err := ioutil.TempDir("", "gentx")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	for i, config := range configs {
+		msg := stakingtypes.NewMsgCreateValidator(
+			sdk.ValAddress(config.addr), config.pubKey, sdk.NewInt64Coin(sdk.DefaultBondDenom, 10),
+			stakingtypes.NewDescription(config.moniker, "", "", "", ""),
+			stakingtypes.NewCommissionRates(config.commission, sdk.OneDec(), sdk.NewDecWithPrec(1, 2)),
+			sdk.OneInt(),
+		)
+		tx := authtypes.NewStdTx(
+			[]sdk.Msg{msg}, authtypes.NewStdFee(200000, nil), nil, fmt.Sprintf("%s@127.0.0.1:26656", config.nodeID),
+		)
+
+		path := filepath.Join(dir, fmt.Sprintf("gentx-%d.json", i))
+		require.NoError(t, ioutil.WriteFile(path, cdc.MustMarshalJSON(tx), 0600))
+	}
+
+	return dir
+}
+
+func TestCollectStdTxs(t *testing.T) {
+	cdc := std.MakeCodec(simapp.ModuleBasics)
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	genDoc := genesisDoc(t, cdc, addr1, addr2)
+
+	genTxsDir := writeGenTxs(t, cdc, newGenTxConfig("val1", nodeID1, addr1), newGenTxConfig("val2", nodeID2, addr2))
+
+	// the node collecting the gentxs is not one of its persistent peers
+	genTxs, persistentPeers, err := genutil.CollectStdTxs(cdc, nodeID1, genTxsDir, genDoc, banktypes.GenesisBalancesIterator{})
+	require.NoError(t, err)
+	require.Len(t, genTxs, 2)
+	require.Equal(t, nodeID2+"@127.0.0.1:26656", persistentPeers)
+
+	genTxs, persistentPeers, err = genutil.CollectStdTxs(cdc, "", genTxsDir, genDoc, banktypes.GenesisBalancesIterator{})
+	require.NoError(t, err)
+	require.Len(t, genTxs, 2)
+	require.Equal(t, nodeID2+"@127.0.0.1:26656,"+nodeID1+"@127.0.0.1:26656", persistentPeers)
+}
+
+func TestCollectStdTxsInvalid(t *testing.T) {
+	cdc := std.MakeCodec(simapp.ModuleBasics)
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	addr3 := sdk.AccAddress([]byte("addr3_______________"))
+	genDoc := genesisDoc(t, cdc, addr1, addr2)
+
+	samePubKey1 := newGenTxConfig("val1", nodeID1, addr1)
+	samePubKey2 := newGenTxConfig("val2", nodeID2, addr2)
+	samePubKey2.pubKey = samePubKey1.pubKey
+
+	lowCommission := newGenTxConfig("val1", nodeID1, addr1)
+	lowCommission.commission = sdk.NewDecWithPrec(1, 2)
+
+	testCases := []struct {
+		name    string
+		configs []genTxConfig
+		err     string
+	}{
+		{
+			"same moniker",
+			[]genTxConfig{newGenTxConfig("val", nodeID1, addr1), newGenTxConfig("val", nodeID2, addr2)},
+			"have the same moniker val",
+		},
+		{
+			"same node ID",
+			[]genTxConfig{newGenTxConfig("val1", nodeID1, addr1), newGenTxConfig("val2", nodeID1, addr2)},
+			"have the same node ID " + nodeID1,
+		},
+		{
+			"same validator",
+			[]genTxConfig{newGenTxConfig("val1", nodeID1, addr1), newGenTxConfig("val2", nodeID2, addr1)},
+			"have the same validator " + sdk.ValAddress(addr1).String(),
+		},
+		{
+			"same consensus public key",
+			[]genTxConfig{samePubKey1, samePubKey2},
+			"have the same consensus public key " + sdk.MustBech32ifyPubKey(sdk.Bech32PubKeyTypeConsPub, samePubKey1.pubKey),
+		},
+		{
+			"invalid node ID",
+			[]genTxConfig{newGenTxConfig("val1", "node", addr1)},
+			"invalid node address",
+		},
+		{
+			"commission less than the minimum rate",
+			[]genTxConfig{lowCommission},
+			"is less than the minimum rate of 0.050000000000000000",
+		},
+		{
+			"no genesis balance",
+			[]genTxConfig{newGenTxConfig("val3", nodeID1, addr3)},
+			fmt.Sprintf("account %s balance not in genesis state", addr3),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			genTxsDir := writeGenTxs(t, cdc, tc.configs...)
+
+			_, _, err := genutil.CollectStdTxs(cdc, "", genTxsDir, genDoc, banktypes.GenesisBalancesIterator{})
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.err)
+		})
+	}
+}
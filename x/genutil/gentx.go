@@ -48,6 +48,13 @@ func ValidateAccountInGenesis(
 	cdc.MustUnmarshalJSON(appGenesisState[stakingtypes.ModuleName], &stakingData)
 	bondDenom := stakingData.Params.BondDenom
 
+	// ensure that only the bond denom is staked
+	for _, coin := range coins {
+		if coin.Denom != bondDenom {
+			return fmt.Errorf("account %s can only stake %s, not %s", addr, bondDenom, coin.Denom)
+		}
+	}
+
 	var err error
 
 	accountIsInGenesis := false
@@ -88,6 +95,54 @@ func ValidateAccountInGenesis(
 	return nil
 }
 
+// ValidateGenTxMsg checks that the message of a genesis transaction is a
+// MsgCreateValidator which the staking module accepts in the provided genesis
+// state, so that an invalid genesis transaction is reported when it is generated
+// or collected rather than by a panic at InitChain. It returns the message.
+func ValidateGenTxMsg(
+	appGenesisState map[string]json.RawMessage, msg sdk.Msg, cdc *codec.Codec,
+) (stakingtypes.MsgCreateValidator, error) {
+
+	// TODO: abstract back to staking
+	createValMsg, ok := msg.(stakingtypes.MsgCreateValidator)
+	if !ok {
+		return createValMsg, fmt.Errorf("genesis transaction message must be a MsgCreateValidator, not %T", msg)
+	}
+
+	if err := createValMsg.ValidateBasic(); err != nil {
+		return createValMsg, fmt.Errorf("invalid MsgCreateValidator: %w", err)
+	}
+
+	if _, err := createValMsg.Description.EnsureLength(); err != nil {
+		return createValMsg, fmt.Errorf("invalid validator description: %w", err)
+	}
+
+	if _, err := sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, createValMsg.Pubkey); err != nil {
+		return createValMsg, fmt.Errorf("invalid validator consensus public key %s: %w", createValMsg.Pubkey, err)
+	}
+
+	var stakingData stakingtypes.GenesisState
+	if err := cdc.UnmarshalJSON(appGenesisState[stakingtypes.ModuleName], &stakingData); err != nil {
+		return createValMsg, fmt.Errorf("failed to unmarshal %s genesis state: %w", stakingtypes.ModuleName, err)
+	}
+
+	if bondDenom := stakingData.Params.BondDenom; createValMsg.Value.Denom != bondDenom {
+		return createValMsg, fmt.Errorf(
+			"validator %s self-delegates %s, but only %s can be staked",
+			createValMsg.ValidatorAddress, createValMsg.Value.Denom, bondDenom,
+		)
+	}
+
+	if minRate := stakingData.Params.MinCommissionRate; createValMsg.Commission.Rate.LT(minRate) {
+		return createValMsg, fmt.Errorf(
+			"validator %s commission rate %s is less than the minimum rate of %s",
+			createValMsg.ValidatorAddress, createValMsg.Commission.Rate, minRate,
+		)
+	}
+
+	return createValMsg, nil
+}
+
 type deliverTxfn func(abci.RequestDeliverTx) abci.ResponseDeliverTx
 
 // DeliverGenTxs iterates over all genesis txs, decodes each into a StdTx and
@@ -98,7 +153,7 @@ func DeliverGenTxs(
 	stakingKeeper types.StakingKeeper, deliverTx deliverTxfn,
 ) []abci.ValidatorUpdate {
 
-	for _, genTx := range genTxs {
+	for i, genTx := range genTxs {
 		var tx authtypes.StdTx
 		cdc.MustUnmarshalJSON(genTx, &tx)
 
@@ -106,7 +161,7 @@ func DeliverGenTxs(
 
 		res := deliverTx(abci.RequestDeliverTx{Tx: bz})
 		if !res.IsOK() {
-			panic(res.Log)
+			panic(fmt.Sprintf("failed to deliver genesis transaction %d: %s", i, res.Log))
 		}
 	}
 
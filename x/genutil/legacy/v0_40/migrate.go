@@ -0,0 +1,114 @@
+package v040
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	v038auth "github.com/cosmos/cosmos-sdk/x/auth/legacy/v0_38"
+	v040auth "github.com/cosmos/cosmos-sdk/x/auth/legacy/v0_40"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	v036supply "github.com/cosmos/cosmos-sdk/x/bank/legacy/v0_36"
+	v039bank "github.com/cosmos/cosmos-sdk/x/bank/legacy/v0_39"
+	v040bank "github.com/cosmos/cosmos-sdk/x/bank/legacy/v0_40"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	v036gov "github.com/cosmos/cosmos-sdk/x/gov/legacy/v0_36"
+	v040gov "github.com/cosmos/cosmos-sdk/x/gov/legacy/v0_40"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc"
+	transfertypes "github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+	paramsproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	v038staking "github.com/cosmos/cosmos-sdk/x/staking/legacy/v0_38"
+	v040staking "github.com/cosmos/cosmos-sdk/x/staking/legacy/v0_40"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// Migrate migrates exported state from v0.39 to a v0.40 genesis state. The
+// x/auth, x/bank, x/staking and x/gov genesis states are migrated, the x/supply
+// genesis state is merged into the x/bank one, and the genesis states of the
+// modules added in v0.40, i.e. x/capability, x/ibc and the IBC transfer module,
+// are set to their defaults.
+func Migrate(appState genutil.AppMap) genutil.AppMap {
+	v039Codec := codec.New()
+	codec.RegisterCrypto(v039Codec)
+	v038auth.RegisterCodec(v039Codec)
+	v036gov.RegisterCodec(v039Codec)
+	registerProposalContents(v039Codec)
+
+	v040Codec := codec.New()
+	codec.RegisterCrypto(v040Codec)
+	authtypes.RegisterCodec(v040Codec)
+	vestingtypes.RegisterCodec(v040Codec)
+	govtypes.RegisterCodec(v040Codec)
+	registerProposalContents(v040Codec)
+	ibc.AppModuleBasic{}.RegisterCodec(v040Codec)
+
+	// migrate auth state
+	if appState[v038auth.ModuleName] != nil {
+		var authGenState v038auth.GenesisState
+		v039Codec.MustUnmarshalJSON(appState[v038auth.ModuleName], &authGenState)
+
+		delete(appState, v038auth.ModuleName) // delete old key in case the name changed
+		appState[authtypes.ModuleName] = v040Codec.MustMarshalJSON(v040auth.Migrate(authGenState))
+	}
+
+	// migrate bank state, along with the total supply of the deprecated x/supply
+	if appState[v039bank.ModuleName] != nil {
+		var bankGenState v039bank.GenesisState
+		v039Codec.MustUnmarshalJSON(appState[v039bank.ModuleName], &bankGenState)
+
+		supplyGenState := v036supply.EmptyGenesisState()
+		if appState[v036supply.ModuleName] != nil {
+			v039Codec.MustUnmarshalJSON(appState[v036supply.ModuleName], &supplyGenState)
+		}
+
+		// delete deprecated x/supply genesis state
+		delete(appState, v036supply.ModuleName)
+
+		delete(appState, v039bank.ModuleName) // delete old key in case the name changed
+		appState[banktypes.ModuleName] = v040Codec.MustMarshalJSON(v040bank.Migrate(bankGenState, supplyGenState))
+	}
+
+	// migrate staking state
+	if appState[v038staking.ModuleName] != nil {
+		var stakingGenState v038staking.GenesisState
+		v039Codec.MustUnmarshalJSON(appState[v038staking.ModuleName], &stakingGenState)
+
+		delete(appState, v038staking.ModuleName) // delete old key in case the name changed
+		appState[stakingtypes.ModuleName] = v040Codec.MustMarshalJSON(v040staking.Migrate(stakingGenState))
+	}
+
+	// migrate gov state
+	if appState[v036gov.ModuleName] != nil {
+		var govGenState v036gov.GenesisState
+		v039Codec.MustUnmarshalJSON(appState[v036gov.ModuleName], &govGenState)
+
+		delete(appState, v036gov.ModuleName) // delete old key in case the name changed
+		appState[govtypes.ModuleName] = v040Codec.MustMarshalJSON(v040gov.Migrate(govGenState))
+	}
+
+	// set the genesis state of the new IBC modules
+	if appState[capabilitytypes.ModuleName] == nil {
+		appState[capabilitytypes.ModuleName] = v040Codec.MustMarshalJSON(capabilitytypes.DefaultGenesis())
+	}
+
+	if appState[ibc.ModuleName] == nil {
+		appState[ibc.ModuleName] = v040Codec.MustMarshalJSON(ibc.DefaultGenesisState())
+	}
+
+	if appState[transfertypes.ModuleName] == nil {
+		appState[transfertypes.ModuleName] = v040Codec.MustMarshalJSON(transfertypes.DefaultGenesis())
+	}
+
+	return appState
+}
+
+// registerProposalContents registers the proposal contents of the modules
+// other than x/gov, whose JSON encoding is the same in v0.39 and v0.40.
+func registerProposalContents(cdc *codec.Codec) {
+	distrtypes.RegisterCodec(cdc)
+	paramsproposal.RegisterCodec(cdc)
+	upgradetypes.RegisterCodec(cdc)
+}
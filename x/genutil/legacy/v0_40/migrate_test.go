@@ -0,0 +1,265 @@
+package v040_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v034auth "github.com/cosmos/cosmos-sdk/x/auth/legacy/v0_34"
+	v038auth "github.com/cosmos/cosmos-sdk/x/auth/legacy/v0_38"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	v036supply "github.com/cosmos/cosmos-sdk/x/bank/legacy/v0_36"
+	v039bank "github.com/cosmos/cosmos-sdk/x/bank/legacy/v0_39"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/capability"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	v040 "github.com/cosmos/cosmos-sdk/x/genutil/legacy/v0_40"
+	v034gov "github.com/cosmos/cosmos-sdk/x/gov/legacy/v0_34"
+	v036gov "github.com/cosmos/cosmos-sdk/x/gov/legacy/v0_36"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc"
+	transfer "github.com/cosmos/cosmos-sdk/x/ibc/20-transfer"
+	paramsproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	v034staking "github.com/cosmos/cosmos-sdk/x/staking/legacy/v0_34"
+	v036staking "github.com/cosmos/cosmos-sdk/x/staking/legacy/v0_36"
+	v038staking "github.com/cosmos/cosmos-sdk/x/staking/legacy/v0_38"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// v039AppState returns the exported state of a v0.39 chain with a bonded
+// validator, a vesting account and both a text and a parameter change
+// proposal, along with the v0.40 default genesis state of the other modules.
+func v039AppState(pubKey crypto.PubKey, valAddr sdk.ValAddress) genutil.AppMap {
+	addr := sdk.AccAddress(pubKey.Address())
+	cdc := codec.New()
+	codec.RegisterCrypto(cdc)
+	v038auth.RegisterCodec(cdc)
+	v036gov.RegisterCodec(cdc)
+	paramsproposal.RegisterCodec(cdc)
+
+	appState := make(genutil.AppMap)
+	for name, state := range simapp.NewDefaultGenesisState() {
+		appState[name] = state
+	}
+
+	delete(appState, capability.ModuleName)
+	delete(appState, ibc.ModuleName)
+	delete(appState, transfer.ModuleName)
+
+	vestingAddr := sdk.AccAddress([]byte("vesting_____________"))
+	vestingCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500))
+	authGenState := v038auth.NewGenesisState(
+		v034auth.Params{
+			MaxMemoCharacters:      256,
+			TxSigLimit:             7,
+			TxSizeCostPerByte:      10,
+			SigVerifyCostED25519:   590,
+			SigVerifyCostSecp256k1: 1000,
+		},
+		v038auth.GenesisAccounts{
+			v038auth.NewBaseAccount(addr, nil, pubKey, 0, 3),
+			v038auth.NewContinuousVestingAccountRaw(
+				v038auth.NewBaseVestingAccount(
+					v038auth.NewBaseAccount(vestingAddr, nil, nil, 1, 0), vestingCoins, nil, nil, 3160620846,
+				),
+				1580309972,
+			),
+		},
+	)
+	appState[v038auth.ModuleName] = cdc.MustMarshalJSON(authGenState)
+
+	appState[v039bank.ModuleName] = cdc.MustMarshalJSON(v039bank.NewGenesisState(true, []v039bank.Balance{
+		{Address: addr, Coins: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))},
+		{Address: vestingAddr, Coins: vestingCoins},
+		{Address: sdk.AccAddress([]byte("empty_______________")), Coins: sdk.NewCoins()},
+	}))
+	appState[v036supply.ModuleName] = cdc.MustMarshalJSON(v036supply.GenesisState{
+		Supply: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10001530)),
+	})
+
+	tokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	commission := v036staking.Commission{
+		CommissionRates: v036staking.CommissionRates{
+			Rate:          sdk.NewDecWithPrec(1, 1),
+			MaxRate:       sdk.NewDecWithPrec(2, 1),
+			MaxChangeRate: sdk.NewDecWithPrec(1, 2),
+		},
+		UpdateTime: time.Unix(1580000000, 0).UTC(),
+	}
+	appState[v038staking.ModuleName] = cdc.MustMarshalJSON(v038staking.NewGenesisState(
+		v034staking.Params{
+			UnbondingTime: 3 * 24 * time.Hour,
+			MaxValidators: 100,
+			MaxEntries:    5,
+			BondDenom:     sdk.DefaultBondDenom,
+		},
+		sdk.NewInt(10),
+		[]v034staking.LastValidatorPower{{Address: valAddr, Power: 10}},
+		v038staking.Validators{{
+			OperatorAddress:   valAddr,
+			ConsPubKey:        ed25519.GenPrivKey().PubKey(),
+			Status:            sdk.Bonded,
+			Tokens:            tokens,
+			DelegatorShares:   tokens.ToDec(),
+			Description:       v038staking.NewDescription("moniker", "", "", "security@cosmos.network", ""),
+			Commission:        commission,
+			MinSelfDelegation: sdk.OneInt(),
+		}},
+		v034staking.Delegations{{DelegatorAddress: addr, ValidatorAddress: valAddr, Shares: tokens.ToDec()}},
+		[]v034staking.UnbondingDelegation{{
+			DelegatorAddress: addr,
+			ValidatorAddress: valAddr,
+			Entries: []v034staking.UnbondingDelegationEntry{{
+				CreationHeight: 5,
+				CompletionTime: time.Unix(1600000000, 0).UTC(),
+				InitialBalance: sdk.NewInt(10),
+				Balance:        sdk.NewInt(10),
+			}},
+		}},
+		nil,
+		true,
+	))
+
+	// the distribution state of the validator and its delegation, whose encoding
+	// is the same in v0.39 and v0.40
+	distrGenState := distrtypes.DefaultGenesisState()
+	distrGenState.OutstandingRewards = []distrtypes.ValidatorOutstandingRewardsRecord{
+		{ValidatorAddress: valAddr, OutstandingRewards: sdk.DecCoins{}},
+	}
+	distrGenState.ValidatorAccumulatedCommissions = []distrtypes.ValidatorAccumulatedCommissionRecord{
+		{ValidatorAddress: valAddr, Accumulated: distrtypes.InitialValidatorAccumulatedCommission()},
+	}
+	distrGenState.ValidatorHistoricalRewards = []distrtypes.ValidatorHistoricalRewardsRecord{
+		{ValidatorAddress: valAddr, Period: 1, Rewards: distrtypes.NewValidatorHistoricalRewards(sdk.DecCoins{}, 2)},
+	}
+	distrGenState.ValidatorCurrentRewards = []distrtypes.ValidatorCurrentRewardsRecord{
+		{ValidatorAddress: valAddr, Rewards: distrtypes.NewValidatorCurrentRewards(sdk.DecCoins{}, 2)},
+	}
+	distrGenState.DelegatorStartingInfos = []distrtypes.DelegatorStartingInfoRecord{
+		{DelegatorAddress: addr, ValidatorAddress: valAddr, StartingInfo: distrtypes.NewDelegatorStartingInfo(1, tokens.ToDec(), 0)},
+	}
+	appState[distrtypes.ModuleName] = cdc.MustMarshalJSON(distrGenState)
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 20))
+	votingTime := time.Unix(1590000000, 0).UTC()
+	appState[v036gov.ModuleName] = cdc.MustMarshalJSON(v036gov.NewGenesisState(
+		3,
+		v034gov.Deposits{{ProposalID: 1, Depositor: addr, Amount: deposit}},
+		v034gov.Votes{{ProposalID: 1, Voter: addr, Option: v034gov.OptionNoWithVeto}},
+		[]v036gov.Proposal{
+			{
+				Content:          v036gov.NewTextProposal("text", "description"),
+				ProposalID:       1,
+				Status:           v034gov.StatusVotingPeriod,
+				FinalTallyResult: v034gov.TallyResult{Yes: sdk.ZeroInt(), Abstain: sdk.ZeroInt(), No: sdk.ZeroInt(), NoWithVeto: sdk.ZeroInt()},
+				SubmitTime:       votingTime,
+				DepositEndTime:   votingTime,
+				TotalDeposit:     deposit,
+				VotingStartTime:  votingTime,
+				VotingEndTime:    votingTime.Add(48 * time.Hour),
+			},
+			{
+				Content: paramsproposal.NewParameterChangeProposal("params", "description", []paramsproposal.ParamChange{
+					paramsproposal.NewParamChange("staking", "MaxValidators", "105"),
+				}),
+				ProposalID:       2,
+				Status:           v034gov.StatusPassed,
+				FinalTallyResult: v034gov.TallyResult{Yes: tokens, Abstain: sdk.ZeroInt(), No: sdk.ZeroInt(), NoWithVeto: sdk.ZeroInt()},
+				SubmitTime:       votingTime,
+				DepositEndTime:   votingTime,
+				TotalDeposit:     sdk.NewCoins(),
+				VotingStartTime:  votingTime,
+				VotingEndTime:    votingTime,
+			},
+		},
+		v034gov.DepositParams{MinDeposit: deposit, MaxDepositPeriod: 48 * time.Hour},
+		v034gov.VotingParams{VotingPeriod: 48 * time.Hour},
+		v034gov.TallyParams{
+			Quorum:    sdk.NewDecWithPrec(334, 3),
+			Threshold: sdk.NewDecWithPrec(5, 1),
+			Veto:      sdk.NewDecWithPrec(334, 3),
+		},
+	))
+
+	return appState
+}
+
+func TestMigrate(t *testing.T) {
+	pubKey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pubKey.Address())
+	valAddr := sdk.ValAddress(addr)
+	migrated := v040.Migrate(v039AppState(pubKey, valAddr))
+
+	app := simapp.NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, simapp.DefaultNodeHome, 0)
+	cdc := app.Codec()
+
+	// the migrated genesis state is valid, and the chain starts from it
+	require.NoError(t, simapp.ModuleBasics.ValidateGenesis(cdc, migrated))
+	require.NotContains(t, migrated, v036supply.ModuleName)
+
+	stateBytes, err := codec.MarshalJSONIndent(cdc, migrated)
+	require.NoError(t, err)
+
+	res := app.InitChain(abci.RequestInitChain{
+		ConsensusParams: simapp.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	require.Len(t, res.Validators, 1)
+	require.Equal(t, int64(10), res.Validators[0].Power)
+
+	var authGenState authtypes.GenesisState
+	cdc.MustUnmarshalJSON(migrated[authtypes.ModuleName], &authGenState)
+	require.Equal(t, authtypes.DefaultParams().PubKeyChangeCost, authGenState.Params.PubKeyChangeCost)
+	require.Equal(t, uint64(590), authGenState.Params.SigVerifyCostED25519)
+	require.Len(t, authGenState.Accounts, 2)
+	require.Equal(t, addr, authGenState.Accounts[0].GetAddress())
+	require.NotNil(t, authGenState.Accounts[0].GetPubKey())
+	require.Equal(t, uint64(3), authGenState.Accounts[0].GetSequence())
+	require.IsType(t, &vestingtypes.ContinuousVestingAccount{}, authGenState.Accounts[1])
+
+	var bankGenState banktypes.GenesisState
+	cdc.MustUnmarshalJSON(migrated[banktypes.ModuleName], &bankGenState)
+	require.True(t, bankGenState.Params.DefaultSendEnabled)
+	require.Len(t, bankGenState.Balances, 2)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10001530)), bankGenState.Supply)
+
+	var stakingGenState stakingtypes.GenesisState
+	cdc.MustUnmarshalJSON(migrated[stakingtypes.ModuleName], &stakingGenState)
+	require.Equal(t, uint32(5), stakingGenState.Params.MaxEntries)
+	require.Equal(t, uint32(5), stakingGenState.Params.MaxRedelegationEntries)
+	require.Equal(t, stakingtypes.DefaultEpochLength, stakingGenState.Params.EpochLength)
+	require.Equal(t, "security@cosmos.network", stakingGenState.Validators[0].Description.SecurityContact)
+	require.Equal(t, sdk.NewDecWithPrec(1, 1), stakingGenState.Validators[0].Commission.Rate)
+	require.NotEmpty(t, stakingGenState.Validators[0].ConsensusPubkey)
+	require.Len(t, stakingGenState.UnbondingDelegations, 1)
+
+	var govGenState govtypes.GenesisState
+	cdc.MustUnmarshalJSON(migrated[govtypes.ModuleName], &govGenState)
+	require.Equal(t, 24*time.Hour, govGenState.VotingParams.ExpeditedVotingPeriod)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)), govGenState.DepositParams.MinExpeditedDeposit)
+	require.Equal(t, govtypes.NewNonSplitVoteOption(govtypes.OptionNoWithVeto), govGenState.Votes[0].Options)
+	require.Equal(t, govtypes.NewTextProposal("text", "description"), govGenState.Proposals[0].Content)
+	require.Equal(t, govtypes.StatusVotingPeriod, govGenState.Proposals[0].Status)
+	require.IsType(t, &paramsproposal.ParameterChangeProposal{}, govGenState.Proposals[1].Content)
+	require.Equal(t, govtypes.StatusPassed, govGenState.Proposals[1].Status)
+
+	// the genesis state of the IBC modules is kept if already set
+	for _, name := range []string{capability.ModuleName, ibc.ModuleName, transfer.ModuleName} {
+		require.Contains(t, migrated, name)
+	}
+
+	appState := genutil.AppMap{transfer.ModuleName: []byte(`{"port_id":"custom"}`)}
+	require.Equal(t, `{"port_id":"custom"}`, string(v040.Migrate(appState)[transfer.ModuleName]))
+}
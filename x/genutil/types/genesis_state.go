@@ -112,10 +112,15 @@ func ValidateGenesis(genesisState GenesisState) error {
 		}
 
 		// TODO: abstract back to staking
-		if _, ok := msgs[0].(stakingtypes.MsgCreateValidator); !ok {
+		msg, ok := msgs[0].(stakingtypes.MsgCreateValidator)
+		if !ok {
 			return fmt.Errorf(
 				"genesis transaction %v does not contain a MsgCreateValidator", i)
 		}
+
+		if err := msg.ValidateBasic(); err != nil {
+			return fmt.Errorf("genesis transaction %v is invalid: %w", i, err)
+		}
 	}
 	return nil
 }
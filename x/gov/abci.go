@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
@@ -28,7 +29,7 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 			fmt.Sprintf("proposal %d (%s) didn't meet minimum deposit of %s (had only %s); deleted",
 				proposal.ProposalID,
 				proposal.GetTitle(),
-				keeper.GetDepositParams(ctx).MinDeposit,
+				keeper.GetParamsForProposal(ctx, proposal.Content, proposal.Messages).DepositParams.GetMinDeposit(proposal.IsExpedited),
 				proposal.TotalDeposit,
 			),
 		)
@@ -39,7 +40,45 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 	keeper.IterateActiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal Proposal) bool {
 		var tagValue, logMsg string
 
-		passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
+		// The votes of an expedited proposal are tallied in a cached context, as
+		// they are kept if the proposal fails and is converted to a regular one.
+		tallyCtx, writeTally := ctx.CacheContext()
+		passes, burnDeposits, tallyResults := keeper.Tally(tallyCtx, proposal)
+
+		if proposal.IsExpedited && !passes {
+			// The voting period of the proposal is extended to the one of a
+			// regular proposal, its deposits neither being burned nor refunded
+			// until the proposal is tallied again as a regular proposal.
+			keeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalID, proposal.VotingEndTime)
+
+			proposal.IsExpedited = false
+			votingPeriod := keeper.GetParamsForProposal(ctx, proposal.Content, proposal.Messages).VotingParams.VotingPeriod
+			proposal.VotingEndTime = proposal.VotingStartTime.Add(votingPeriod)
+
+			keeper.SetProposal(ctx, proposal)
+			keeper.InsertActiveProposalQueue(ctx, proposal.ProposalID, proposal.VotingEndTime)
+
+			logger.Info(
+				fmt.Sprintf(
+					"expedited proposal %d (%s) tallied; result: rejected, converted to a regular proposal",
+					proposal.ProposalID, proposal.GetTitle(),
+				),
+			)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeActiveProposal,
+					sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalID)),
+					sdk.NewAttribute(types.AttributeKeyProposalResult, types.AttributeValueExpeditedProposalRejected),
+				),
+			)
+			return false
+		}
+
+		// The events of the tally, such as the removal of the tallied votes, are
+		// re-emitted as the cached context is created with a new EventManager.
+		ctx.EventManager().EmitEvents(tallyCtx.EventManager().Events())
+		writeTally()
 
 		if burnDeposits {
 			keeper.DeleteDeposits(ctx, proposal.ProposalID)
@@ -48,13 +87,16 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 		}
 
 		if passes {
-			handler := keeper.Router().GetRoute(proposal.ProposalRoute())
 			cacheCtx, writeCache := ctx.CacheContext()
 
-			// The proposal handler may execute state mutating logic depending
-			// on the proposal content. If the handler fails, no state mutation
-			// is written and the error message is logged.
-			err := handler(cacheCtx, proposal.Content)
+			// The proposal content is executed through its proposal handler,
+			// followed by the proposal messages. If any of them fails, no
+			// state mutation is written and the error message is logged.
+			messages := append(
+				[]sdk.Msg{types.NewMsgExecLegacyContent(proposal.Content, keeper.GetGovernanceAccount(ctx).GetAddress())},
+				proposal.Messages...,
+			)
+			err := executeMessages(cacheCtx, keeper, messages)
 			if err == nil {
 				proposal.Status = StatusPassed
 				tagValue = types.AttributeValueProposalPassed
@@ -101,3 +143,43 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 		return false
 	})
 }
+
+// executeMessages executes the messages of a passed proposal, which are signed
+// by the governance module account, stopping at the first failing message.
+func executeMessages(ctx sdk.Context, keeper Keeper, messages []sdk.Msg) error {
+	for i, msg := range messages {
+		if err := executeMessage(ctx, keeper, msg); err != nil {
+			return sdkerrors.Wrapf(err, "message %d", i)
+		}
+	}
+
+	return nil
+}
+
+// executeMessage executes a message in a cached context, which is only written
+// once the message succeeds. A panicking handler fails the message.
+func executeMessage(ctx sdk.Context, keeper Keeper, msg sdk.Msg) (err error) {
+	handler := keeper.MsgRouter().Route(ctx, msg.Route())
+	if handler == nil {
+		return sdkerrors.Wrapf(types.ErrInvalidProposalMsg, "unrecognized message route %s", msg.Route())
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = sdkerrors.Wrapf(sdkerrors.ErrPanic, "%v", r)
+		}
+	}()
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	res, err := handler(cacheCtx, msg)
+	if err != nil {
+		return err
+	}
+
+	writeCache()
+	for _, event := range res.Events {
+		ctx.EventManager().EmitEvent(sdk.Event(event))
+	}
+
+	return nil
+}
@@ -9,9 +9,12 @@ import (
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 )
 
@@ -216,7 +219,7 @@ func TestTickPassedVotingPeriod(t *testing.T) {
 	require.False(t, activeQueue.Valid())
 	activeQueue.Close()
 
-	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(5))}
+	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(5, sdk.DefaultPowerReduction))}
 	newProposalMsg, err := std.NewMsgSubmitProposal(TestProposal, proposalCoins, addrs[0])
 	require.NoError(t, err)
 
@@ -283,10 +286,10 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	require.NotNil(t, macc)
 	initialModuleAccCoins := app.BankKeeper.GetAllBalances(ctx, macc.GetAddress())
 
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, false, gov.KindStandard, nil)
 	require.NoError(t, err)
 
-	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10))}
+	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction))}
 	newDepositMsg := gov.NewMsgDeposit(addrs[0], proposal.ProposalID, proposalCoins)
 
 	res, err := handler(ctx, newDepositMsg)
@@ -300,18 +303,30 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	deposits := initialModuleAccCoins.Add(proposal.TotalDeposit...).Add(proposalCoins...)
 	require.True(t, moduleAccCoins.IsEqual(deposits))
 
-	err = app.GovKeeper.AddVote(ctx, proposal.ProposalID, addrs[0], gov.OptionYes)
+	err = app.GovKeeper.AddVote(ctx, proposal.ProposalID, addrs[0], gov.NewNonSplitVoteOption(gov.OptionYes))
 	require.NoError(t, err)
 
 	newHeader := ctx.BlockHeader()
 	newHeader.Time = ctx.BlockHeader().Time.Add(app.GovKeeper.GetDepositParams(ctx).MaxDepositPeriod).Add(app.GovKeeper.GetVotingParams(ctx).VotingPeriod)
-	ctx = ctx.WithBlockHeader(newHeader)
+	ctx = ctx.WithBlockHeader(newHeader).WithEventManager(sdk.NewEventManager())
 
 	gov.EndBlocker(ctx, app.GovKeeper)
 
 	macc = app.GovKeeper.GetGovernanceAccount(ctx)
 	require.NotNil(t, macc)
 	require.True(t, app.BankKeeper.GetAllBalances(ctx, macc.GetAddress()).IsEqual(initialModuleAccCoins))
+
+	// the tallied votes are removed from the state with an event
+	require.Empty(t, app.GovKeeper.GetVotes(ctx, proposal.ProposalID))
+
+	var removed bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeRemoveVotes {
+			removed = true
+			require.Equal(t, "1", string(event.Attributes[1].Value))
+		}
+	}
+	require.True(t, removed)
 }
 
 func TestEndBlockerProposalHandlerFailed(t *testing.T) {
@@ -335,17 +350,17 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	// Create a proposal where the handler will pass for the test proposal
 	// because the value of contextKeyBadProposal is true.
 	ctx = ctx.WithValue(contextKeyBadProposal, true)
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, false, gov.KindStandard, nil)
 	require.NoError(t, err)
 
-	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10)))
+	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)))
 	newDepositMsg := gov.NewMsgDeposit(addrs[0], proposal.ProposalID, proposalCoins)
 
 	res, err := handler(ctx, newDepositMsg)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
-	err = app.GovKeeper.AddVote(ctx, proposal.ProposalID, addrs[0], gov.OptionYes)
+	err = app.GovKeeper.AddVote(ctx, proposal.ProposalID, addrs[0], gov.NewNonSplitVoteOption(gov.OptionYes))
 	require.NoError(t, err)
 
 	newHeader := ctx.BlockHeader()
@@ -359,3 +374,298 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	// validate that the proposal fails/has been rejected
 	gov.EndBlocker(ctx, app.GovKeeper)
 }
+
+func TestExpeditedProposalEndBlocker(t *testing.T) {
+	testcases := []struct {
+		name         string
+		voteNo       bool
+		expectPassed bool
+	}{
+		{"expedited proposal passes", false, true},
+		{"expedited proposal converts to a regular proposal", true, false},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			app := simapp.Setup(false)
+			ctx := app.BaseApp.NewContext(false, abci.Header{})
+			addrs := simapp.AddTestAddrs(app, ctx, 2, valTokens)
+
+			SortAddresses(addrs)
+
+			handler := gov.NewHandler(app.GovKeeper)
+			stakingHandler := staking.NewHandler(app.StakingKeeper)
+
+			header := abci.Header{Height: app.LastBlockHeight() + 1}
+			app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+			valAddrs := []sdk.ValAddress{sdk.ValAddress(addrs[0]), sdk.ValAddress(addrs[1])}
+
+			createValidators(t, stakingHandler, ctx, valAddrs, []int64{6, 4})
+			staking.EndBlocker(ctx, app.StakingKeeper)
+
+			macc := app.GovKeeper.GetGovernanceAccount(ctx)
+			require.NotNil(t, macc)
+			initialModuleAccCoins := app.BankKeeper.GetAllBalances(ctx, macc.GetAddress())
+
+			proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, true, gov.KindStandard, nil)
+			require.NoError(t, err)
+			require.True(t, proposal.IsExpedited)
+
+			// the regular minimum deposit does not activate an expedited proposal
+			proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(30, sdk.DefaultPowerReduction)))
+			res, err := handler(ctx, gov.NewMsgDeposit(addrs[0], proposal.ProposalID, proposalCoins))
+			require.NoError(t, err)
+			require.NotNil(t, res)
+
+			proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalID)
+			require.True(t, ok)
+			require.Equal(t, gov.StatusDepositPeriod, proposal.Status)
+
+			proposalCoins = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(20, sdk.DefaultPowerReduction)))
+			res, err = handler(ctx, gov.NewMsgDeposit(addrs[1], proposal.ProposalID, proposalCoins))
+			require.NoError(t, err)
+			require.NotNil(t, res)
+
+			proposal, ok = app.GovKeeper.GetProposal(ctx, proposal.ProposalID)
+			require.True(t, ok)
+			require.Equal(t, gov.StatusVotingPeriod, proposal.Status)
+
+			votingParams := app.GovKeeper.GetVotingParams(ctx)
+			require.Equal(t, proposal.VotingStartTime.Add(votingParams.ExpeditedVotingPeriod), proposal.VotingEndTime)
+
+			err = app.GovKeeper.AddVote(ctx, proposal.ProposalID, addrs[0], gov.NewNonSplitVoteOption(gov.OptionYes))
+			require.NoError(t, err)
+			if tc.voteNo {
+				err = app.GovKeeper.AddVote(ctx, proposal.ProposalID, addrs[1], gov.NewNonSplitVoteOption(gov.OptionNo))
+				require.NoError(t, err)
+			}
+
+			newHeader := ctx.BlockHeader()
+			newHeader.Time = proposal.VotingEndTime
+			ctx = ctx.WithBlockHeader(newHeader)
+
+			gov.EndBlocker(ctx, app.GovKeeper)
+
+			if tc.expectPassed {
+				proposal, ok = app.GovKeeper.GetProposal(ctx, proposal.ProposalID)
+				require.True(t, ok)
+				require.Equal(t, gov.StatusPassed, proposal.Status)
+
+				// the deposits are refunded
+				macc = app.GovKeeper.GetGovernanceAccount(ctx)
+				require.True(t, app.BankKeeper.GetAllBalances(ctx, macc.GetAddress()).IsEqual(initialModuleAccCoins))
+				return
+			}
+
+			// the failed expedited proposal is converted to a regular proposal,
+			// keeping its deposits and votes
+			proposal, ok = app.GovKeeper.GetProposal(ctx, proposal.ProposalID)
+			require.True(t, ok)
+			require.Equal(t, gov.StatusVotingPeriod, proposal.Status)
+			require.False(t, proposal.IsExpedited)
+			require.Equal(t, proposal.VotingStartTime.Add(votingParams.VotingPeriod), proposal.VotingEndTime)
+			require.Len(t, app.GovKeeper.GetVotes(ctx, proposal.ProposalID), 2)
+
+			macc = app.GovKeeper.GetGovernanceAccount(ctx)
+			require.True(t, app.BankKeeper.GetAllBalances(ctx, macc.GetAddress()).IsEqual(initialModuleAccCoins.Add(proposal.TotalDeposit...)))
+
+			// the regular threshold is met at the end of the regular voting period
+			newHeader.Time = proposal.VotingEndTime
+			ctx = ctx.WithBlockHeader(newHeader)
+
+			gov.EndBlocker(ctx, app.GovKeeper)
+
+			proposal, ok = app.GovKeeper.GetProposal(ctx, proposal.ProposalID)
+			require.True(t, ok)
+			require.Equal(t, gov.StatusPassed, proposal.Status)
+		})
+	}
+}
+
+func TestProposalMessagesEndBlocker(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	addrs := simapp.AddTestAddrs(app, ctx, 2, valTokens)
+
+	SortAddresses(addrs)
+
+	handler := gov.NewHandler(app.GovKeeper)
+	stakingHandler := staking.NewHandler(app.StakingKeeper)
+
+	header := abci.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	createValidators(t, stakingHandler, ctx, []sdk.ValAddress{sdk.ValAddress(addrs[0])}, []int64{10})
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// fund the governance module account
+	macc := app.GovKeeper.GetGovernanceAccount(ctx)
+	require.NotNil(t, macc)
+	amount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(5, sdk.DefaultPowerReduction)))
+	_, err := app.BankKeeper.AddCoins(ctx, macc.GetAddress(), amount)
+	require.NoError(t, err)
+
+	// the messages must be signed by the governance module account
+	_, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, []sdk.Msg{bank.NewMsgSend(addrs[0], addrs[1], amount)}, false, gov.KindStandard, nil)
+	require.True(t, gov.ErrInvalidSigner.Is(err))
+
+	msgs := []sdk.Msg{bank.NewMsgSend(macc.GetAddress(), addrs[1], amount)}
+	msg := gov.NewMsgSubmitProposal(TestProposal, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction))), addrs[0])
+	require.NoError(t, msg.SetMessages(msgs))
+	require.NoError(t, msg.ValidateBasic())
+	require.NotPanics(t, func() { msg.GetSignBytes() })
+
+	res, err := handler(ctx, msg)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, 1)
+	require.True(t, ok)
+	require.Equal(t, gov.StatusVotingPeriod, proposal.Status)
+	require.Equal(t, msgs, proposal.Messages)
+
+	err = app.GovKeeper.AddVote(ctx, proposal.ProposalID, addrs[0], gov.NewNonSplitVoteOption(gov.OptionYes))
+	require.NoError(t, err)
+
+	balance := app.BankKeeper.GetAllBalances(ctx, addrs[1])
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = proposal.VotingEndTime
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	// the messages are executed by the governance module account
+	proposal, ok = app.GovKeeper.GetProposal(ctx, proposal.ProposalID)
+	require.True(t, ok)
+	require.Equal(t, gov.StatusPassed, proposal.Status)
+	require.Equal(t, balance.Add(amount...), app.BankKeeper.GetAllBalances(ctx, addrs[1]))
+}
+
+func TestProposalMessagesEndBlockerFailed(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	addrs := simapp.AddTestAddrs(app, ctx, 2, valTokens)
+
+	SortAddresses(addrs)
+
+	stakingHandler := staking.NewHandler(app.StakingKeeper)
+
+	header := abci.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	createValidators(t, stakingHandler, ctx, []sdk.ValAddress{sdk.ValAddress(addrs[0])}, []int64{10})
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// the governance module account cannot pay for the second message
+	macc := app.GovKeeper.GetGovernanceAccount(ctx)
+	amount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(5, sdk.DefaultPowerReduction)))
+	_, err := app.BankKeeper.AddCoins(ctx, macc.GetAddress(), amount)
+	require.NoError(t, err)
+
+	msgs := []sdk.Msg{
+		bank.NewMsgSend(macc.GetAddress(), addrs[1], amount),
+		bank.NewMsgSend(macc.GetAddress(), addrs[1], amount),
+	}
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, msgs, false, gov.KindStandard, nil)
+	require.NoError(t, err)
+
+	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)))
+	_, err = app.GovKeeper.AddDeposit(ctx, proposal.ProposalID, addrs[0], proposalCoins)
+	require.NoError(t, err)
+
+	err = app.GovKeeper.AddVote(ctx, proposal.ProposalID, addrs[0], gov.NewNonSplitVoteOption(gov.OptionYes))
+	require.NoError(t, err)
+
+	balance := app.BankKeeper.GetAllBalances(ctx, addrs[1])
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalID)
+	require.True(t, ok)
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = proposal.VotingEndTime
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	// none of the messages is executed
+	proposal, ok = app.GovKeeper.GetProposal(ctx, proposal.ProposalID)
+	require.True(t, ok)
+	require.Equal(t, gov.StatusFailed, proposal.Status)
+	require.Equal(t, balance, app.BankKeeper.GetAllBalances(ctx, addrs[1]))
+	require.Equal(t, amount, app.BankKeeper.GetAllBalances(ctx, macc.GetAddress()))
+}
+
+func TestProposalContentEndBlockerPanic(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	addrs := simapp.AddTestAddrs(app, ctx, 2, valTokens)
+
+	SortAddresses(addrs)
+
+	stakingHandler := staking.NewHandler(app.StakingKeeper)
+
+	header := abci.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	createValidators(t, stakingHandler, ctx, []sdk.ValAddress{sdk.ValAddress(addrs[0])}, []int64{10})
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	macc := app.GovKeeper.GetGovernanceAccount(ctx)
+	amount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(5, sdk.DefaultPowerReduction)))
+	_, err := app.BankKeeper.AddCoins(ctx, macc.GetAddress(), amount)
+	require.NoError(t, err)
+
+	// the content handler pays from the governance module account, and panics
+	// once the proposal passes
+	passed := false
+	rtr := gov.NewRouter()
+	rtr.AddRoute(gov.RouterKey, func(ctx sdk.Context, content gov.Content) error {
+		if err := app.BankKeeper.SendCoins(ctx, macc.GetAddress(), addrs[1], amount); err != nil {
+			return err
+		}
+		if passed {
+			panic("content handler panic")
+		}
+		return nil
+	})
+
+	msgRouter := baseapp.NewRouter()
+	keeper := gov.NewKeeper(
+		std.NewAppCodec(app.Codec()), app.GetKey(gov.StoreKey), app.GetSubspace(gov.ModuleName),
+		app.AccountKeeper, app.BankKeeper, app.StakingKeeper, rtr, msgRouter,
+	)
+	msgRouter.AddRoute(gov.RouterKey, gov.NewHandler(keeper))
+
+	proposal, err := keeper.SubmitProposal(ctx, TestProposal, nil, false, gov.KindStandard, nil)
+	require.NoError(t, err)
+
+	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)))
+	_, err = keeper.AddDeposit(ctx, proposal.ProposalID, addrs[0], proposalCoins)
+	require.NoError(t, err)
+
+	err = keeper.AddVote(ctx, proposal.ProposalID, addrs[0], gov.NewNonSplitVoteOption(gov.OptionYes))
+	require.NoError(t, err)
+
+	balance := app.BankKeeper.GetAllBalances(ctx, addrs[1])
+
+	proposal, ok := keeper.GetProposal(ctx, proposal.ProposalID)
+	require.True(t, ok)
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = proposal.VotingEndTime
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	passed = true
+	require.NotPanics(t, func() { gov.EndBlocker(ctx, keeper) })
+
+	// the proposal fails without writing the state of its content handler
+	proposal, ok = keeper.GetProposal(ctx, proposal.ProposalID)
+	require.True(t, ok)
+	require.Equal(t, gov.StatusFailed, proposal.Status)
+	require.Equal(t, balance, app.BankKeeper.GetAllBalances(ctx, addrs[1]))
+	require.Equal(t, amount, app.BankKeeper.GetAllBalances(ctx, macc.GetAddress()))
+}
@@ -18,6 +18,7 @@ const (
 	DefaultParamspace     = types.DefaultParamspace
 	TypeMsgDeposit        = types.TypeMsgDeposit
 	TypeMsgVote           = types.TypeMsgVote
+	TypeMsgVoteWeighted   = types.TypeMsgVoteWeighted
 	TypeMsgSubmitProposal = types.TypeMsgSubmitProposal
 	StatusNil             = types.StatusNil
 	StatusDepositPeriod   = types.StatusDepositPeriod
@@ -26,6 +27,9 @@ const (
 	StatusRejected        = types.StatusRejected
 	StatusFailed          = types.StatusFailed
 	ProposalTypeText      = types.ProposalTypeText
+	KindStandard          = types.KindStandard
+	KindMultipleChoice    = types.KindMultipleChoice
+	KindOptimistic        = types.KindOptimistic
 	QueryParams           = types.QueryParams
 	QueryProposals        = types.QueryProposals
 	QueryProposal         = types.QueryProposal
@@ -37,11 +41,14 @@ const (
 	ParamDeposit          = types.ParamDeposit
 	ParamVoting           = types.ParamVoting
 	ParamTallying         = types.ParamTallying
+	ParamProposalType     = types.ParamProposalType
 	OptionEmpty           = types.OptionEmpty
 	OptionYes             = types.OptionYes
 	OptionAbstain         = types.OptionAbstain
 	OptionNo              = types.OptionNo
 	OptionNoWithVeto      = types.OptionNoWithVeto
+
+	TypeMsgExecLegacyContent = types.TypeMsgExecLegacyContent
 )
 
 var (
@@ -49,11 +56,13 @@ var (
 	RegisterInvariants            = keeper.RegisterInvariants
 	AllInvariants                 = keeper.AllInvariants
 	ModuleAccountInvariant        = keeper.ModuleAccountInvariant
+	TallyVotingPowerInvariant     = keeper.TallyVotingPowerInvariant
 	NewKeeper                     = keeper.NewKeeper
 	NewQuerier                    = keeper.NewQuerier
 	RegisterCodec                 = types.RegisterCodec
 	RegisterProposalTypeCodec     = types.RegisterProposalTypeCodec
 	ValidateAbstract              = types.ValidateAbstract
+	ValidateProposalMessages      = types.ValidateProposalMessages
 	NewDeposit                    = types.NewDeposit
 	ErrUnknownProposal            = types.ErrUnknownProposal
 	ErrInactiveProposal           = types.ErrInactiveProposal
@@ -63,6 +72,10 @@ var (
 	ErrInvalidVote                = types.ErrInvalidVote
 	ErrInvalidGenesis             = types.ErrInvalidGenesis
 	ErrNoProposalHandlerExists    = types.ErrNoProposalHandlerExists
+	ErrInvalidProposalMsg         = types.ErrInvalidProposalMsg
+	ErrInvalidSigner              = types.ErrInvalidSigner
+	ErrMinDepositTooSmall         = types.ErrMinDepositTooSmall
+	ErrInvalidProposalKind        = types.ErrInvalidProposalKind
 	NewGenesisState               = types.NewGenesisState
 	DefaultGenesisState           = types.DefaultGenesisState
 	ValidateGenesis               = types.ValidateGenesis
@@ -86,15 +99,21 @@ var (
 	NewMsgSubmitProposalBase      = types.NewMsgSubmitProposalBase
 	NewMsgDeposit                 = types.NewMsgDeposit
 	NewMsgVote                    = types.NewMsgVote
+	NewMsgVoteWeighted            = types.NewMsgVoteWeighted
+	NewMsgExecLegacyContent       = types.NewMsgExecLegacyContent
 	ParamKeyTable                 = types.ParamKeyTable
 	NewDepositParams              = types.NewDepositParams
 	NewTallyParams                = types.NewTallyParams
+	NewProposalTypeParams         = types.NewProposalTypeParams
 	NewVotingParams               = types.NewVotingParams
 	NewParams                     = types.NewParams
 	NewProposal                   = types.NewProposal
 	NewRouter                     = types.NewRouter
 	ProposalStatusFromString      = types.ProposalStatusFromString
 	ValidProposalStatus           = types.ValidProposalStatus
+	ProposalKindFromString        = types.ProposalKindFromString
+	ValidProposalKind             = types.ValidProposalKind
+	ValidateProposalKind          = types.ValidateProposalKind
 	NewTextProposal               = types.NewTextProposal
 	RegisterProposalType          = types.RegisterProposalType
 	ContentFromProposalType       = types.ContentFromProposalType
@@ -111,6 +130,10 @@ var (
 	NewVote                       = types.NewVote
 	VoteOptionFromString          = types.VoteOptionFromString
 	ValidVoteOption               = types.ValidVoteOption
+	NewWeightedVoteOption         = types.NewWeightedVoteOption
+	NewNonSplitVoteOption         = types.NewNonSplitVoteOption
+	WeightedVoteOptionsFromString = types.WeightedVoteOptionsFromString
+	ValidWeightedVoteOption       = types.ValidWeightedVoteOption
 
 	// variable aliases
 	ModuleCdc                   = types.ModuleCdc
@@ -137,14 +160,18 @@ type (
 	MsgSubmitProposalBase = types.MsgSubmitProposalBase
 	MsgDeposit            = types.MsgDeposit
 	MsgVote               = types.MsgVote
+	MsgVoteWeighted       = types.MsgVoteWeighted
+	MsgExecLegacyContent  = types.MsgExecLegacyContent
 	DepositParams         = types.DepositParams
 	TallyParams           = types.TallyParams
+	ProposalTypeParams    = types.ProposalTypeParams
 	VotingParams          = types.VotingParams
 	Params                = types.Params
 	Proposal              = types.Proposal
 	Proposals             = types.Proposals
 	ProposalQueue         = types.ProposalQueue
 	ProposalStatus        = types.ProposalStatus
+	ProposalKind          = types.ProposalKind
 	TextProposal          = types.TextProposal
 	QueryProposalParams   = types.QueryProposalParams
 	QueryDepositParams    = types.QueryDepositParams
@@ -152,8 +179,11 @@ type (
 	QueryProposalsParams  = types.QueryProposalsParams
 	ValidatorGovInfo      = types.ValidatorGovInfo
 	TallyResult           = types.TallyResult
+	OptionLabelTally      = types.OptionLabelTally
 	Vote                  = types.Vote
 	Votes                 = types.Votes
 	VoteOption            = types.VoteOption
+	WeightedVoteOption    = types.WeightedVoteOption
+	WeightedVoteOptions   = types.WeightedVoteOptions
 	Codec                 = types.Codec
 )
@@ -7,6 +7,8 @@ import (
 
 	"github.com/spf13/viper"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	govutils "github.com/cosmos/cosmos-sdk/x/gov/client/utils"
 )
 
@@ -40,3 +42,15 @@ func parseSubmitProposalFlags() (*proposal, error) {
 
 	return proposal, nil
 }
+
+// parseProposalMessages decodes the JSON encoded messages of a proposal file.
+func parseProposalMessages(cdc codec.JSONMarshaler, rawMsgs []json.RawMessage) ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(rawMsgs))
+	for i, rawMsg := range rawMsgs {
+		if err := cdc.UnmarshalJSON(rawMsg, &msgs[i]); err != nil {
+			return nil, fmt.Errorf("failed to decode proposal message %d: %w", i, err)
+		}
+	}
+
+	return msgs, nil
+}
@@ -506,6 +506,10 @@ $ %s query gov params
 			if err != nil {
 				return err
 			}
+			ptp, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/params/proposaltype", queryRoute), nil)
+			if err != nil {
+				return err
+			}
 
 			var tallyParams types.TallyParams
 			cdc.MustUnmarshalJSON(tp, &tallyParams)
@@ -514,7 +518,10 @@ $ %s query gov params
 			var votingParams types.VotingParams
 			cdc.MustUnmarshalJSON(vp, &votingParams)
 
-			return cliCtx.PrintOutput(types.NewParams(votingParams, tallyParams, depositParams))
+			params := types.NewParams(votingParams, tallyParams, depositParams)
+			cdc.MustUnmarshalJSON(ptp, &params.ProposalTypeParams)
+
+			return cliCtx.PrintOutput(params)
 		},
 	}
 }
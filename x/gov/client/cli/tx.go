@@ -2,11 +2,13 @@ package cli
 
 import (
 	"bufio"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -31,6 +33,9 @@ const (
 	flagDepositor    = "depositor"
 	flagStatus       = "status"
 	FlagProposal     = "proposal"
+	FlagIsExpedited  = "expedited"
+	FlagKind         = "kind"
+	FlagOptionLabels = "option-labels"
 )
 
 type proposal struct {
@@ -38,6 +43,7 @@ type proposal struct {
 	Description string
 	Type        string
 	Deposit     string
+	Messages    []json.RawMessage
 }
 
 // ProposalFlags defines the core required fields of a proposal. It is used to
@@ -77,6 +83,7 @@ func NewTxCmd(
 	govTxCmd.AddCommand(flags.PostCommands(
 		NewCmdDeposit(cdc, txg, ar),
 		NewCmdVote(cdc, txg, ar),
+		NewCmdWeightedVote(cdc, txg, ar),
 		cmdSubmitProp,
 	)...)
 
@@ -111,8 +118,16 @@ Where proposal.json contains:
 Which is equivalent to:
 
 $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome proposal" --type="Text" --deposit="10test" --from mykey
+
+The proposal JSON file may also list, under "messages", the JSON encoded messages executed
+with the governance module account as signer once the proposal passes.
+
+A multiple-choice text proposal is voted on the options labeled by --option-labels, the
+labels being given to the Yes, Abstain, No and NoWithVeto vote options in order:
+
+$ %s tx gov submit-proposal --title="Test Poll" --description="My poll" --type="Text" --deposit="10test" --kind="MultipleChoice" --option-labels="red,green,blue" --from mykey
 `,
-				version.ClientName, version.ClientName,
+				version.ClientName, version.ClientName, version.ClientName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -139,6 +154,22 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 			}
 			msg.SetInitialDeposit(amount)
 			msg.SetProposer(cliCtx.FromAddress)
+			msg.SetIsExpedited(viper.GetBool(FlagIsExpedited))
+
+			kind, err := types.ProposalKindFromString(viper.GetString(FlagKind))
+			if err != nil {
+				return err
+			}
+			msg.SetKind(kind)
+			msg.SetOptionLabels(viper.GetStringSlice(FlagOptionLabels))
+
+			messages, err := parseProposalMessages(cdc, proposal.Messages)
+			if err != nil {
+				return err
+			}
+			if err = msg.SetMessages(messages); err != nil {
+				return err
+			}
 
 			if err = msg.ValidateBasic(); err != nil {
 				return err
@@ -153,6 +184,9 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 	cmd.Flags().String(flagProposalType, "", "proposalType of proposal, types: text/parameter_change/software_upgrade")
 	cmd.Flags().String(FlagDeposit, "", "deposit of proposal")
 	cmd.Flags().String(FlagProposal, "", "proposal file path (if this path is given, other proposal flags are ignored)")
+	cmd.Flags().Bool(FlagIsExpedited, false, "submit the proposal as an expedited proposal")
+	cmd.Flags().String(FlagKind, "", "kind of proposal, kinds: Standard/MultipleChoice/Optimistic")
+	cmd.Flags().StringSlice(FlagOptionLabels, nil, "comma separated labels of the vote options of a multiple-choice proposal")
 
 	return cmd
 }
@@ -253,6 +287,56 @@ $ %s tx gov vote 1 yes --from mykey
 	}
 }
 
+// NewCmdWeightedVote implements creating a new weighted vote command.
+func NewCmdWeightedVote(cdc codec.Marshaler, txg tx.Generator, ar tx.AccountRetriever) *cobra.Command {
+	return &cobra.Command{
+		Use:   "weighted-vote [proposal-id] [weighted-options]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Vote for an active proposal, splitting the voting power between the options yes/no/no_with_veto/abstain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a vote for an active proposal, splitting the voting power
+between several options. The weights of the options must sum to 1. You can
+find the proposal-id by running "%s query gov proposals".
+
+
+Example:
+$ %s tx gov weighted-vote 1 yes=0.6,no=0.3,abstain=0.1 --from mykey
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithMarshaler(cdc)
+			txf := tx.NewFactoryFromCLI(inBuf).WithTxGenerator(txg).WithAccountRetriever(ar)
+
+			// Get voting address
+			from := cliCtx.GetFromAddress()
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			// Find out which weighted vote options user chose
+			options, err := types.WeightedVoteOptionsFromString(govutils.NormalizeWeightedVoteOptions(args[1]))
+			if err != nil {
+				return err
+			}
+
+			// Build vote message and run basic validation
+			msg := types.NewMsgVoteWeighted(from, proposalID, options)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(cliCtx, txf, msg)
+		},
+	}
+}
+
 // GetTxCmd returns the transaction commands for this module
 // governance ModuleClient is slightly different from other ModuleClients in that
 // it contains a slice of "proposal" child commands. These commands are respective
@@ -275,6 +359,7 @@ func GetTxCmd(storeKey string, cdc *codec.Codec, pcmds []*cobra.Command) *cobra.
 	govTxCmd.AddCommand(flags.PostCommands(
 		GetCmdDeposit(cdc),
 		GetCmdVote(cdc),
+		GetCmdWeightedVote(cdc),
 		cmdSubmitProp,
 	)...)
 
@@ -305,8 +390,16 @@ Where proposal.json contains:
 Which is equivalent to:
 
 $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome proposal" --type="Text" --deposit="10test" --from mykey
+
+The proposal JSON file may also list, under "messages", the JSON encoded messages executed
+with the governance module account as signer once the proposal passes.
+
+A multiple-choice text proposal is voted on the options labeled by --option-labels, the
+labels being given to the Yes, Abstain, No and NoWithVeto vote options in order:
+
+$ %s tx gov submit-proposal --title="Test Poll" --description="My poll" --type="Text" --deposit="10test" --kind="MultipleChoice" --option-labels="red,green,blue" --from mykey
 `,
-				version.ClientName, version.ClientName,
+				version.ClientName, version.ClientName, version.ClientName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -326,7 +419,21 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 
 			content := types.ContentFromProposalType(proposal.Title, proposal.Description, proposal.Type)
 
+			messages, err := parseProposalMessages(cdc, proposal.Messages)
+			if err != nil {
+				return err
+			}
+
+			kind, err := types.ProposalKindFromString(viper.GetString(FlagKind))
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgSubmitProposal(content, amount, cliCtx.GetFromAddress())
+			msg.SetIsExpedited(viper.GetBool(FlagIsExpedited))
+			msg.SetKind(kind)
+			msg.SetOptionLabels(viper.GetStringSlice(FlagOptionLabels))
+			msg.Messages = messages
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -340,6 +447,9 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 	cmd.Flags().String(flagProposalType, "", "proposalType of proposal, types: text/parameter_change/software_upgrade")
 	cmd.Flags().String(FlagDeposit, "", "deposit of proposal")
 	cmd.Flags().String(FlagProposal, "", "proposal file path (if this path is given, other proposal flags are ignored)")
+	cmd.Flags().Bool(FlagIsExpedited, false, "submit the proposal as an expedited proposal")
+	cmd.Flags().String(FlagKind, "", "kind of proposal, kinds: Standard/MultipleChoice/Optimistic")
+	cmd.Flags().StringSlice(FlagOptionLabels, nil, "comma separated labels of the vote options of a multiple-choice proposal")
 
 	return cmd
 }
@@ -440,4 +550,54 @@ $ %s tx gov vote 1 yes --from mykey
 	}
 }
 
+// GetCmdWeightedVote implements creating a new weighted vote command.
+func GetCmdWeightedVote(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "weighted-vote [proposal-id] [weighted-options]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Vote for an active proposal, splitting the voting power between the options yes/no/no_with_veto/abstain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a vote for an active proposal, splitting the voting power
+between several options. The weights of the options must sum to 1. You can
+find the proposal-id by running "%s query gov proposals".
+
+
+Example:
+$ %s tx gov weighted-vote 1 yes=0.6,no=0.3,abstain=0.1 --from mykey
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			// Get voting address
+			from := cliCtx.GetFromAddress()
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			// Find out which weighted vote options user chose
+			options, err := types.WeightedVoteOptionsFromString(govutils.NormalizeWeightedVoteOptions(args[1]))
+			if err != nil {
+				return err
+			}
+
+			// Build vote message and run basic validation
+			msg := types.NewMsgVoteWeighted(from, proposalID, options)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 // DONTCOVER
@@ -49,12 +49,16 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, phs []ProposalREST
 
 // PostProposalReq defines the properties of a proposal request's body.
 type PostProposalReq struct {
-	BaseReq        rest.BaseReq   `json:"base_req" yaml:"base_req"`
-	Title          string         `json:"title" yaml:"title"`                     // Title of the proposal
-	Description    string         `json:"description" yaml:"description"`         // Description of the proposal
-	ProposalType   string         `json:"proposal_type" yaml:"proposal_type"`     // Type of proposal. Initial set {PlainTextProposal }
-	Proposer       sdk.AccAddress `json:"proposer" yaml:"proposer"`               // Address of the proposer
-	InitialDeposit sdk.Coins      `json:"initial_deposit" yaml:"initial_deposit"` // Coins to add to the proposal's deposit
+	BaseReq        rest.BaseReq       `json:"base_req" yaml:"base_req"`
+	Title          string             `json:"title" yaml:"title"`                     // Title of the proposal
+	Description    string             `json:"description" yaml:"description"`         // Description of the proposal
+	ProposalType   string             `json:"proposal_type" yaml:"proposal_type"`     // Type of proposal. Initial set {PlainTextProposal }
+	Proposer       sdk.AccAddress     `json:"proposer" yaml:"proposer"`               // Address of the proposer
+	InitialDeposit sdk.Coins          `json:"initial_deposit" yaml:"initial_deposit"` // Coins to add to the proposal's deposit
+	IsExpedited    bool               `json:"is_expedited" yaml:"is_expedited"`       // Whether the proposal is expedited
+	Messages       []sdk.Msg          `json:"messages" yaml:"messages"`               // Messages executed by the governance module account once the proposal passes
+	Kind           types.ProposalKind `json:"kind" yaml:"kind"`                       // Kind of the proposal, deciding how its votes are tallied
+	OptionLabels   []string           `json:"option_labels" yaml:"option_labels"`     // Labels of the vote options of a multiple-choice proposal
 }
 
 // DepositReq defines the properties of a deposit request's body.
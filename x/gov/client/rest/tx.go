@@ -48,6 +48,12 @@ func newPostProposalHandlerFn(cliCtx context.CLIContext, txg tx.Generator, newMs
 		}
 		msg.SetInitialDeposit(req.InitialDeposit)
 		msg.SetProposer(req.Proposer)
+		msg.SetIsExpedited(req.IsExpedited)
+		msg.SetKind(req.Kind)
+		msg.SetOptionLabels(req.OptionLabels)
+		if rest.CheckBadRequestError(w, msg.SetMessages(req.Messages)) {
+			return
+		}
 		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
 			return
 		}
@@ -163,6 +169,10 @@ func postProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		content := types.ContentFromProposalType(req.Title, req.Description, proposalType)
 
 		msg := types.NewMsgSubmitProposal(content, req.InitialDeposit, req.Proposer)
+		msg.SetIsExpedited(req.IsExpedited)
+		msg.SetKind(req.Kind)
+		msg.SetOptionLabels(req.OptionLabels)
+		msg.Messages = req.Messages
 		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
 			return
 		}
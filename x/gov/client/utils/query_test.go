@@ -77,8 +77,8 @@ func TestGetPaginatedVotes(t *testing.T) {
 				{Msgs: acc2Msgs[:1]},
 			},
 			votes: []types.Vote{
-				types.NewVote(0, acc1, types.OptionYes),
-				types.NewVote(0, acc2, types.OptionYes)},
+				types.NewVote(0, acc1, types.NewNonSplitVoteOption(types.OptionYes)),
+				types.NewVote(0, acc2, types.NewNonSplitVoteOption(types.OptionYes))},
 		},
 
 		{
@@ -90,8 +90,8 @@ func TestGetPaginatedVotes(t *testing.T) {
 				{Msgs: acc2Msgs},
 			},
 			votes: []types.Vote{
-				types.NewVote(0, acc1, types.OptionYes),
-				types.NewVote(0, acc1, types.OptionYes)},
+				types.NewVote(0, acc1, types.NewNonSplitVoteOption(types.OptionYes)),
+				types.NewVote(0, acc1, types.NewNonSplitVoteOption(types.OptionYes))},
 		},
 		{
 			description: "2MsgPerTx2Chunk",
@@ -102,8 +102,8 @@ func TestGetPaginatedVotes(t *testing.T) {
 				{Msgs: acc2Msgs},
 			},
 			votes: []types.Vote{
-				types.NewVote(0, acc2, types.OptionYes),
-				types.NewVote(0, acc2, types.OptionYes)},
+				types.NewVote(0, acc2, types.NewNonSplitVoteOption(types.OptionYes)),
+				types.NewVote(0, acc2, types.NewNonSplitVoteOption(types.OptionYes))},
 		},
 		{
 			description: "IncompleteSearchTx",
@@ -112,7 +112,7 @@ func TestGetPaginatedVotes(t *testing.T) {
 			txs: []authtypes.StdTx{
 				{Msgs: acc1Msgs[:1]},
 			},
-			votes: []types.Vote{types.NewVote(0, acc1, types.OptionYes)},
+			votes: []types.Vote{types.NewVote(0, acc1, types.NewNonSplitVoteOption(types.OptionYes))},
 		},
 		{
 			description: "InvalidPage",
@@ -13,6 +13,7 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 	k.SetDepositParams(ctx, data.DepositParams)
 	k.SetVotingParams(ctx, data.VotingParams)
 	k.SetTallyParams(ctx, data.TallyParams)
+	k.SetProposalTypeParams(ctx, data.ProposalTypeParams)
 
 	// check if the deposits pool account exists
 	moduleAcc := k.GetGovernanceAccount(ctx)
@@ -56,6 +57,7 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 	depositParams := k.GetDepositParams(ctx)
 	votingParams := k.GetVotingParams(ctx)
 	tallyParams := k.GetTallyParams(ctx)
+	proposalTypeParams := k.GetProposalTypeParams(ctx)
 	proposals := k.GetProposals(ctx)
 
 	var proposalsDeposits Deposits
@@ -76,5 +78,6 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 		DepositParams:      depositParams,
 		VotingParams:       votingParams,
 		TallyParams:        tallyParams,
+		ProposalTypeParams: proposalTypeParams,
 	}
 }
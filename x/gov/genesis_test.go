@@ -29,11 +29,11 @@ func TestImportExportQueues(t *testing.T) {
 
 	// Create two proposals, put the second into the voting period
 	proposal := TestProposal
-	proposal1, err := app.GovKeeper.SubmitProposal(ctx, proposal)
+	proposal1, err := app.GovKeeper.SubmitProposal(ctx, proposal, nil, false, gov.KindStandard, nil)
 	require.NoError(t, err)
 	proposalID1 := proposal1.ProposalID
 
-	proposal2, err := app.GovKeeper.SubmitProposal(ctx, proposal)
+	proposal2, err := app.GovKeeper.SubmitProposal(ctx, proposal, nil, false, gov.KindStandard, nil)
 	require.NoError(t, err)
 	proposalID2 := proposal2.ProposalID
 
@@ -120,10 +120,10 @@ func TestEqualProposals(t *testing.T) {
 
 	// Submit two proposals
 	proposal := TestProposal
-	proposal1, err := app.GovKeeper.SubmitProposal(ctx, proposal)
+	proposal1, err := app.GovKeeper.SubmitProposal(ctx, proposal, nil, false, gov.KindStandard, nil)
 	require.NoError(t, err)
 
-	proposal2, err := app.GovKeeper.SubmitProposal(ctx, proposal)
+	proposal2, err := app.GovKeeper.SubmitProposal(ctx, proposal, nil, false, gov.KindStandard, nil)
 	require.NoError(t, err)
 
 	// They are similar but their IDs should be different
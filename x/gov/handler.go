@@ -23,6 +23,12 @@ func NewHandler(keeper Keeper) sdk.Handler {
 		case MsgVote:
 			return handleMsgVote(ctx, keeper, msg)
 
+		case MsgVoteWeighted:
+			return handleMsgVoteWeighted(ctx, keeper, msg)
+
+		case MsgExecLegacyContent:
+			return handleMsgExecLegacyContent(ctx, keeper, msg)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
 		}
@@ -30,7 +36,15 @@ func NewHandler(keeper Keeper) sdk.Handler {
 }
 
 func handleMsgSubmitProposal(ctx sdk.Context, keeper Keeper, msg MsgSubmitProposalI) (*sdk.Result, error) {
-	proposal, err := keeper.SubmitProposal(ctx, msg.GetContent())
+	if err := keeper.ValidateInitialDeposit(
+		ctx, msg.GetInitialDeposit(), msg.GetContent(), msg.GetMessages(), msg.GetIsExpedited(),
+	); err != nil {
+		return nil, err
+	}
+
+	proposal, err := keeper.SubmitProposal(
+		ctx, msg.GetContent(), msg.GetMessages(), msg.GetIsExpedited(), msg.GetKind(), msg.GetOptionLabels(),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -90,7 +104,7 @@ func handleMsgDeposit(ctx sdk.Context, keeper Keeper, msg MsgDeposit) (*sdk.Resu
 }
 
 func handleMsgVote(ctx sdk.Context, keeper Keeper, msg MsgVote) (*sdk.Result, error) {
-	err := keeper.AddVote(ctx, msg.ProposalID, msg.Voter, msg.Option)
+	err := keeper.AddVote(ctx, msg.ProposalID, msg.Voter, types.NewNonSplitVoteOption(msg.Option))
 	if err != nil {
 		return nil, err
 	}
@@ -105,3 +119,28 @@ func handleMsgVote(ctx sdk.Context, keeper Keeper, msg MsgVote) (*sdk.Result, er
 
 	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
 }
+
+func handleMsgVoteWeighted(ctx sdk.Context, keeper Keeper, msg MsgVoteWeighted) (*sdk.Result, error) {
+	err := keeper.AddVote(ctx, msg.ProposalID, msg.Voter, msg.Options)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Voter.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgExecLegacyContent(ctx sdk.Context, keeper Keeper, msg MsgExecLegacyContent) (*sdk.Result, error) {
+	if err := keeper.ExecLegacyContent(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
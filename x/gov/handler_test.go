@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 )
@@ -20,3 +21,30 @@ func TestInvalidMsg(t *testing.T) {
 	require.Nil(t, res)
 	require.True(t, strings.Contains(err.Error(), "unrecognized gov message type"))
 }
+
+func TestSubmitProposalMinInitialDeposit(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	addrs := simapp.AddTestAddrs(app, ctx, 1, valTokens)
+	h := gov.NewHandler(app.GovKeeper)
+
+	depositParams := app.GovKeeper.GetDepositParams(ctx)
+	depositParams.MinInitialDepositRatio = sdk.NewDecWithPrec(25, 2)
+	app.GovKeeper.SetDepositParams(ctx, depositParams)
+
+	minInitialDeposit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, depositParams.MinDeposit.AmountOf(sdk.DefaultBondDenom).QuoRaw(4)))
+	lowDeposit := minInitialDeposit.Sub(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+
+	_, err := h(ctx, gov.NewMsgSubmitProposal(TestProposal, lowDeposit, addrs[0]))
+	require.True(t, gov.ErrMinDepositTooSmall.Is(err))
+
+	res, err := h(ctx, gov.NewMsgSubmitProposal(TestProposal, minInitialDeposit, addrs[0]))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// the ratio applies to the minimum expedited deposit of expedited proposals
+	msg := gov.NewMsgSubmitProposal(TestProposal, minInitialDeposit, addrs[0])
+	msg.SetIsExpedited(true)
+	_, err = h(ctx, msg)
+	require.True(t, gov.ErrMinDepositTooSmall.Is(err))
+}
@@ -125,8 +125,9 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 
 	// Check if deposit has provided sufficient total funds to transition the proposal into the voting period
 	activatedVotingPeriod := false
+	minDeposit := keeper.GetParamsForProposal(ctx, proposal.Content, proposal.Messages).DepositParams.GetMinDeposit(proposal.IsExpedited)
 
-	if proposal.Status == types.StatusDepositPeriod && proposal.TotalDeposit.IsAllGTE(keeper.GetDepositParams(ctx).MinDeposit) {
+	if proposal.Status == types.StatusDepositPeriod && proposal.TotalDeposit.IsAllGTE(minDeposit) {
 		keeper.ActivateVotingPeriod(ctx, proposal)
 
 		activatedVotingPeriod = true
@@ -168,3 +169,17 @@ func (keeper Keeper) RefundDeposits(ctx sdk.Context, proposalID uint64) {
 		return false
 	})
 }
+
+// ValidateInitialDeposit validates that the initial deposit of a regular or an
+// expedited proposal with the given content and messages is at least the
+// MinInitialDepositRatio of its minimum deposit.
+func (keeper Keeper) ValidateInitialDeposit(
+	ctx sdk.Context, initialDeposit sdk.Coins, content types.Content, messages []sdk.Msg, isExpedited bool,
+) error {
+	minInitialDeposit := keeper.GetParamsForProposal(ctx, content, messages).DepositParams.GetMinInitialDeposit(isExpedited)
+	if !initialDeposit.IsAllGTE(minInitialDeposit) {
+		return sdkerrors.Wrapf(types.ErrMinDepositTooSmall, "got %s, expected at least %s", initialDeposit, minInitialDeposit)
+	}
+
+	return nil
+}
@@ -9,6 +9,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func TestDeposits(t *testing.T) {
@@ -18,12 +20,12 @@ func TestDeposits(t *testing.T) {
 	TestAddrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000000))
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil, false, types.KindStandard, nil)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalID
 
-	fourStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(4)))
-	fiveStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(5)))
+	fourStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(4, sdk.DefaultPowerReduction)))
+	fiveStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(5, sdk.DefaultPowerReduction)))
 
 	addr0Initial := app.BankKeeper.GetAllBalances(ctx, TestAddrs[0])
 	addr1Initial := app.BankKeeper.GetAllBalances(ctx, TestAddrs[1])
@@ -101,3 +103,71 @@ func TestDeposits(t *testing.T) {
 	require.Equal(t, addr0Initial, app.BankKeeper.GetAllBalances(ctx, TestAddrs[0]))
 	require.Equal(t, addr1Initial, app.BankKeeper.GetAllBalances(ctx, TestAddrs[1]))
 }
+
+func TestDepositsProposalTypeParams(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	TestAddrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(100000000))
+
+	// text proposals need twice the global minimum deposit and vote for an hour
+	minDeposit := app.GovKeeper.GetDepositParams(ctx).MinDeposit
+	app.GovKeeper.SetProposalTypeParams(ctx, []types.ProposalTypeParams{
+		types.NewProposalTypeParams(types.ProposalTypeText, minDeposit.Add(minDeposit...), time.Hour, sdk.ZeroDec(), sdk.ZeroDec()),
+	})
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, false, types.KindStandard, nil)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+
+	votingStarted, err := app.GovKeeper.AddDeposit(ctx, proposalID, TestAddrs[0], minDeposit)
+	require.NoError(t, err)
+	require.False(t, votingStarted)
+
+	votingStarted, err = app.GovKeeper.AddDeposit(ctx, proposalID, TestAddrs[0], minDeposit)
+	require.NoError(t, err)
+	require.True(t, votingStarted)
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, proposal.VotingStartTime.Add(time.Hour), proposal.VotingEndTime)
+}
+
+func TestDepositsProposalMessageTypeParams(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	TestAddrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(100000000))
+
+	// the sends of the community funds need twice the global minimum deposit
+	// and a higher threshold
+	minDeposit := app.GovKeeper.GetDepositParams(ctx).MinDeposit
+	app.GovKeeper.SetProposalTypeParams(ctx, []types.ProposalTypeParams{
+		types.NewProposalTypeParams("bank/send", minDeposit.Add(minDeposit...), 0, sdk.ZeroDec(), sdk.NewDecWithPrec(75, 2)),
+	})
+
+	// a text proposal sending coins uses the params of the send
+	govAddr := app.GovKeeper.GetGovernanceAccount(ctx).GetAddress()
+	messages := []sdk.Msg{bank.NewMsgSend(govAddr, TestAddrs[0], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))}
+
+	params := app.GovKeeper.GetParamsForProposal(ctx, TestProposal, messages)
+	require.Equal(t, sdk.NewDecWithPrec(75, 2), params.TallyParams.Threshold)
+
+	depositParams := app.GovKeeper.GetDepositParams(ctx)
+	depositParams.MinInitialDepositRatio = sdk.OneDec()
+	app.GovKeeper.SetDepositParams(ctx, depositParams)
+	require.NoError(t, app.GovKeeper.ValidateInitialDeposit(ctx, minDeposit, TestProposal, nil, false))
+	require.Error(t, app.GovKeeper.ValidateInitialDeposit(ctx, minDeposit, TestProposal, messages, false))
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, messages, false, types.KindStandard, nil)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+
+	votingStarted, err := app.GovKeeper.AddDeposit(ctx, proposalID, TestAddrs[0], minDeposit)
+	require.NoError(t, err)
+	require.False(t, votingStarted)
+
+	votingStarted, err = app.GovKeeper.AddDeposit(ctx, proposalID, TestAddrs[0], minDeposit)
+	require.NoError(t, err)
+	require.True(t, votingStarted)
+}
@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// defaultQueryLimit is the number of results of a page whose limit is not set.
+const defaultQueryLimit = 100
+
+// Vote returns the vote of a voter on a proposal.
+func (keeper Keeper) Vote(c context.Context, req *types.QueryVoteRequest) (*types.QueryVoteResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+	if req.Voter.Empty() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "empty voter address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	vote, found := keeper.GetVote(ctx, req.ProposalID, req.Voter)
+	if !found {
+		return nil, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "voter %s has not voted on proposal %d", req.Voter, req.ProposalID,
+		)
+	}
+
+	return &types.QueryVoteResponse{Vote: vote}, nil
+}
+
+// Votes returns the paginated votes on a proposal, along with the total number of
+// votes on it.
+func (keeper Keeper) Votes(c context.Context, req *types.QueryVotesRequest) (*types.QueryVotesResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	iterator := keeper.pageIterator(ctx, types.VotesKey(req.ProposalID), req.Page, req.Limit)
+	defer iterator.Close()
+
+	var votes types.Votes
+	for ; iterator.Valid(); iterator.Next() {
+		var vote types.Vote
+		if err := keeper.cdc.UnmarshalBinaryBare(iterator.Value(), &vote); err != nil {
+			return nil, err
+		}
+
+		votes = append(votes, vote)
+	}
+
+	total := countKeys(ctx.KVStore(keeper.storeKey), types.VotesKey(req.ProposalID))
+
+	return &types.QueryVotesResponse{Votes: votes, Total: total}, nil
+}
+
+// Deposits returns the paginated deposits on a proposal, along with the total
+// number of deposits on it.
+func (keeper Keeper) Deposits(c context.Context, req *types.QueryDepositsRequest) (*types.QueryDepositsResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	iterator := keeper.pageIterator(ctx, types.DepositsKey(req.ProposalID), req.Page, req.Limit)
+	defer iterator.Close()
+
+	var deposits types.Deposits
+	for ; iterator.Valid(); iterator.Next() {
+		var deposit types.Deposit
+		if err := keeper.cdc.UnmarshalBinaryBare(iterator.Value(), &deposit); err != nil {
+			return nil, err
+		}
+
+		deposits = append(deposits, deposit)
+	}
+
+	total := countKeys(ctx.KVStore(keeper.storeKey), types.DepositsKey(req.ProposalID))
+
+	return &types.QueryDepositsResponse{Deposits: deposits, Total: total}, nil
+}
+
+// pageIterator returns an iterator over a page of the results stored under the
+// prefix, the first page being iterated if page is not set and the default
+// limit being used if limit is not set.
+func (keeper Keeper) pageIterator(ctx sdk.Context, prefix []byte, page, limit uint64) sdk.Iterator {
+	if page == 0 {
+		page = 1
+	}
+	if limit == 0 {
+		limit = defaultQueryLimit
+	}
+
+	return sdk.KVStorePrefixIteratorPaginated(ctx.KVStore(keeper.storeKey), prefix, uint(page), uint(limit))
+}
+
+// countKeys returns the number of keys of the store with the given prefix, i.e.
+// the total number of results of a paginated query over that prefix.
+func countKeys(store sdk.KVStore, prefix []byte) uint64 {
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	var count uint64
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+	}
+
+	return count
+}
+
+// TallyResult returns the final tally of an ended proposal or the interim tally
+// of a proposal in its voting period.
+func (keeper Keeper) TallyResult(c context.Context, req *types.QueryTallyResultRequest) (*types.QueryTallyResultResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	proposal, found := keeper.GetProposal(ctx, req.ProposalID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", req.ProposalID)
+	}
+
+	return &types.QueryTallyResultResponse{Tally: keeper.GetTallyResult(ctx, proposal)}, nil
+}
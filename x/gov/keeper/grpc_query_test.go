@@ -0,0 +1,178 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestGRPCQueryVotes(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	c := sdk.WrapSDKContext(ctx)
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(30000000))
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, false, types.KindStandard, nil)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+
+	proposal.Status = types.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], types.NewNonSplitVoteOption(types.OptionNo)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[2], types.NewNonSplitVoteOption(types.OptionAbstain)))
+
+	// the vote of a single voter
+	vote, err := app.GovKeeper.Vote(c, &types.QueryVoteRequest{ProposalID: proposalID, Voter: addrs[1]})
+	require.NoError(t, err)
+	require.Equal(t, types.OptionNo, vote.Vote.Option)
+
+	_, err = app.GovKeeper.Vote(c, &types.QueryVoteRequest{ProposalID: proposalID + 1, Voter: addrs[1]})
+	require.Error(t, err)
+	_, err = app.GovKeeper.Vote(c, &types.QueryVoteRequest{ProposalID: proposalID})
+	require.Error(t, err)
+	_, err = app.GovKeeper.Vote(c, nil)
+	require.Error(t, err)
+
+	// all the votes are returned on the first page of the default limit
+	votes, err := app.GovKeeper.Votes(c, &types.QueryVotesRequest{ProposalID: proposalID})
+	require.NoError(t, err)
+	require.Equal(t, app.GovKeeper.GetVotes(ctx, proposalID), votes.Votes)
+	require.Equal(t, uint64(3), votes.Total)
+
+	// the votes are paginated by page and limit, along with their total number
+	votes, err = app.GovKeeper.Votes(c, &types.QueryVotesRequest{ProposalID: proposalID, Page: 1, Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, votes.Votes, 2)
+	require.Equal(t, uint64(3), votes.Total)
+
+	nextVotes, err := app.GovKeeper.Votes(c, &types.QueryVotesRequest{ProposalID: proposalID, Page: 2, Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, nextVotes.Votes, 1)
+	require.Equal(t, app.GovKeeper.GetVotes(ctx, proposalID), append(votes.Votes, nextVotes.Votes...))
+
+	nextVotes, err = app.GovKeeper.Votes(c, &types.QueryVotesRequest{ProposalID: proposalID, Page: 3, Limit: 2})
+	require.NoError(t, err)
+	require.Empty(t, nextVotes.Votes)
+	require.Equal(t, uint64(3), nextVotes.Total)
+
+	// the votes are removed once tallied
+	_, _, _ = app.GovKeeper.Tally(ctx, proposal)
+	votes, err = app.GovKeeper.Votes(c, &types.QueryVotesRequest{ProposalID: proposalID})
+	require.NoError(t, err)
+	require.Empty(t, votes.Votes)
+	require.Zero(t, votes.Total)
+}
+
+func TestGRPCQueryDeposits(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	c := sdk.WrapSDKContext(ctx)
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000000))
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, false, types.KindStandard, nil)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+
+	oneStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(1, sdk.DefaultPowerReduction)))
+	_, err = app.GovKeeper.AddDeposit(ctx, proposalID, addrs[0], oneStake)
+	require.NoError(t, err)
+	_, err = app.GovKeeper.AddDeposit(ctx, proposalID, addrs[1], oneStake)
+	require.NoError(t, err)
+
+	deposits, err := app.GovKeeper.Deposits(c, &types.QueryDepositsRequest{ProposalID: proposalID})
+	require.NoError(t, err)
+	require.Equal(t, app.GovKeeper.GetDeposits(ctx, proposalID), deposits.Deposits)
+	require.Equal(t, uint64(2), deposits.Total)
+
+	deposits, err = app.GovKeeper.Deposits(c, &types.QueryDepositsRequest{ProposalID: proposalID, Page: 2, Limit: 1})
+	require.NoError(t, err)
+	require.Equal(t, app.GovKeeper.GetDeposits(ctx, proposalID)[1:], deposits.Deposits)
+	require.Equal(t, uint64(2), deposits.Total)
+
+	// the total is encoded along with the page
+	bz, err := deposits.Marshal()
+	require.NoError(t, err)
+	var decoded types.QueryDepositsResponse
+	require.NoError(t, decoded.Unmarshal(bz))
+	require.Equal(t, *deposits, decoded)
+
+	_, err = app.GovKeeper.Deposits(c, nil)
+	require.Error(t, err)
+}
+
+func TestGRPCQueryTallyResult(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	c := sdk.WrapSDKContext(ctx)
+
+	valAccAddrs, _ := createValidators(ctx, app, []int64{5, 5, 5})
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, false, types.KindStandard, nil)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+
+	// the tally of a proposal in its deposit period is empty
+	tally, err := app.GovKeeper.TallyResult(c, &types.QueryTallyResultRequest{ProposalID: proposalID})
+	require.NoError(t, err)
+	require.True(t, tally.Tally.Equals(types.EmptyTallyResult()))
+
+	proposal.Status = types.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[1], types.NewNonSplitVoteOption(types.OptionNo)))
+
+	// the interim tally is computed without removing the votes
+	tally, err = app.GovKeeper.TallyResult(c, &types.QueryTallyResultRequest{ProposalID: proposalID})
+	require.NoError(t, err)
+	require.Equal(t, sdk.TokensFromConsensusPower(5, sdk.DefaultPowerReduction), tally.Tally.Yes)
+	require.Equal(t, sdk.TokensFromConsensusPower(5, sdk.DefaultPowerReduction), tally.Tally.No)
+	require.Len(t, app.GovKeeper.GetVotes(ctx, proposalID), 2)
+
+	// the final tally is stored with an ended proposal, its votes being removed
+	_, _, proposal.FinalTallyResult = app.GovKeeper.Tally(ctx, proposal)
+	proposal.Status = types.StatusRejected
+	app.GovKeeper.SetProposal(ctx, proposal)
+	require.Empty(t, app.GovKeeper.GetVotes(ctx, proposalID))
+
+	finalTally, err := app.GovKeeper.TallyResult(c, &types.QueryTallyResultRequest{ProposalID: proposalID})
+	require.NoError(t, err)
+	require.Equal(t, tally.Tally, finalTally.Tally)
+
+	_, err = app.GovKeeper.TallyResult(c, &types.QueryTallyResultRequest{ProposalID: proposalID + 1})
+	require.Error(t, err)
+	_, err = app.GovKeeper.TallyResult(c, nil)
+	require.Error(t, err)
+}
+
+func TestGRPCQueryRoutes(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	// the service is registered with the app's router under the method names
+	require.NotNil(t, app.GRPCQueryRouter().Route(types.QueryVoteMethod))
+	require.NotNil(t, app.GRPCQueryRouter().Route(types.QueryVotesMethod))
+	require.NotNil(t, app.GRPCQueryRouter().Route(types.QueryTallyResultMethod))
+
+	handler := app.GRPCQueryRouter().Route(types.QueryDepositsMethod)
+	require.NotNil(t, handler)
+
+	reqBz, err := (&types.QueryDepositsRequest{ProposalID: 1}).Marshal()
+	require.NoError(t, err)
+
+	res, err := handler(ctx, abci.RequestQuery{Path: types.QueryDepositsMethod, Data: reqBz})
+	require.NoError(t, err)
+
+	var deposits types.QueryDepositsResponse
+	require.NoError(t, deposits.Unmarshal(res.Value))
+	require.Empty(t, deposits.Deposits)
+}
@@ -12,12 +12,18 @@ import (
 // RegisterInvariants registers all governance invariants
 func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper, bk types.BankKeeper) {
 	ir.RegisterRoute(types.ModuleName, "module-account", ModuleAccountInvariant(keeper, bk))
+	ir.RegisterRoute(types.ModuleName, "tally-voting-power", TallyVotingPowerInvariant(keeper))
 }
 
 // AllInvariants runs all invariants of the governance module
 func AllInvariants(keeper Keeper, bk types.BankKeeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		return ModuleAccountInvariant(keeper, bk)(ctx)
+		res, stop := ModuleAccountInvariant(keeper, bk)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return TallyVotingPowerInvariant(keeper)(ctx)
 	}
 }
 
@@ -41,3 +47,33 @@ func ModuleAccountInvariant(keeper Keeper, bk types.BankKeeper) sdk.Invariant {
 				balances, expectedDeposits)), broken
 	}
 }
+
+// TallyVotingPowerInvariant checks that the voting power tallied on each
+// proposal in its voting period does not exceed the total bonded tokens
+func TallyVotingPowerInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		totalBondedTokens := keeper.sk.TotalBondedTokens(ctx)
+		keeper.IterateProposals(ctx, func(proposal types.Proposal) bool {
+			if proposal.Status != types.StatusVotingPeriod {
+				return false
+			}
+
+			tally := keeper.GetTallyResult(ctx, proposal)
+			totalVotingPower := tally.Yes.Add(tally.Abstain).Add(tally.No).Add(tally.NoWithVeto)
+			if totalVotingPower.GT(totalBondedTokens) {
+				broken = true
+				msg += fmt.Sprintf("\tproposal %d tallied voting power: %s\n", proposal.ProposalID, totalVotingPower)
+			}
+
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "tally voting power",
+			fmt.Sprintf("\ttotal bonded tokens: %s\n%s", totalBondedTokens, msg)), broken
+	}
+}
@@ -28,8 +28,11 @@ type Keeper struct {
 	// The codec codec for binary encoding/decoding.
 	cdc types.Codec
 
-	// Proposal router
-	router types.Router
+	// Proposal content router, executing the MsgExecLegacyContent messages
+	legacyRouter types.Router
+
+	// Msg router executing the messages of the passed proposals
+	msgRouter sdk.Router
 }
 
 // NewKeeper returns a governance keeper. It handles:
@@ -42,6 +45,7 @@ type Keeper struct {
 func NewKeeper(
 	cdc types.Codec, key sdk.StoreKey, paramSpace types.ParamSubspace,
 	authKeeper types.AccountKeeper, bankKeeper types.BankKeeper, sk types.StakingKeeper, rtr types.Router,
+	msgRouter sdk.Router,
 ) Keeper {
 
 	// ensure governance module account is set
@@ -55,13 +59,14 @@ func NewKeeper(
 	rtr.Seal()
 
 	return Keeper{
-		storeKey:   key,
-		paramSpace: paramSpace,
-		authKeeper: authKeeper,
-		bankKeeper: bankKeeper,
-		sk:         sk,
-		cdc:        cdc,
-		router:     rtr,
+		storeKey:     key,
+		paramSpace:   paramSpace,
+		authKeeper:   authKeeper,
+		bankKeeper:   bankKeeper,
+		sk:           sk,
+		cdc:          cdc,
+		legacyRouter: rtr,
+		msgRouter:    msgRouter,
 	}
 }
 
@@ -70,9 +75,14 @@ func (keeper Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
-// Router returns the gov Keeper's Router
-func (keeper Keeper) Router() types.Router {
-	return keeper.router
+// LegacyRouter returns the gov Keeper's proposal content Router
+func (keeper Keeper) LegacyRouter() types.Router {
+	return keeper.legacyRouter
+}
+
+// MsgRouter returns the gov Keeper's Msg router
+func (keeper Keeper) MsgRouter() sdk.Router {
+	return keeper.msgRouter
 }
 
 // GetGovernanceAccount returns the governance ModuleAccount
@@ -26,6 +26,29 @@ func (keeper Keeper) GetTallyParams(ctx sdk.Context) types.TallyParams {
 	return tallyParams
 }
 
+// GetProposalTypeParams returns the current ProposalTypeParams from the global param store
+func (keeper Keeper) GetProposalTypeParams(ctx sdk.Context) []types.ProposalTypeParams {
+	var proposalTypeParams []types.ProposalTypeParams
+	keeper.paramSpace.Get(ctx, types.ParamStoreKeyProposalTypeParams, &proposalTypeParams)
+	return proposalTypeParams
+}
+
+// GetParamsForProposalType returns the params of the proposals of a proposal
+// type, the global params overridden by the ProposalTypeParams of the type.
+func (keeper Keeper) GetParamsForProposalType(ctx sdk.Context, proposalType string) types.Params {
+	params := types.NewParams(keeper.GetVotingParams(ctx), keeper.GetTallyParams(ctx), keeper.GetDepositParams(ctx))
+	params.ProposalTypeParams = keeper.GetProposalTypeParams(ctx)
+	return params.ForProposalType(proposalType)
+}
+
+// GetParamsForProposal returns the params of a proposal with the given content
+// and messages, the strictest of the params of their types.
+func (keeper Keeper) GetParamsForProposal(ctx sdk.Context, content types.Content, messages []sdk.Msg) types.Params {
+	params := types.NewParams(keeper.GetVotingParams(ctx), keeper.GetTallyParams(ctx), keeper.GetDepositParams(ctx))
+	params.ProposalTypeParams = keeper.GetProposalTypeParams(ctx)
+	return params.ForProposalTypes(types.ProposalParamsTypes(content, messages))
+}
+
 // SetDepositParams sets DepositParams to the global param store
 func (keeper Keeper) SetDepositParams(ctx sdk.Context, depositParams types.DepositParams) {
 	keeper.paramSpace.Set(ctx, types.ParamStoreKeyDepositParams, &depositParams)
@@ -40,3 +63,8 @@ func (keeper Keeper) SetVotingParams(ctx sdk.Context, votingParams types.VotingP
 func (keeper Keeper) SetTallyParams(ctx sdk.Context, tallyParams types.TallyParams) {
 	keeper.paramSpace.Set(ctx, types.ParamStoreKeyTallyParams, &tallyParams)
 }
+
+// SetProposalTypeParams sets ProposalTypeParams to the global param store
+func (keeper Keeper) SetProposalTypeParams(ctx sdk.Context, proposalTypeParams []types.ProposalTypeParams) {
+	keeper.paramSpace.Set(ctx, types.ParamStoreKeyProposalTypeParams, &proposalTypeParams)
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -9,18 +10,57 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
-// SubmitProposal create new proposal given a content
-func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (types.Proposal, error) {
-	if !keeper.router.HasRoute(content.ProposalRoute()) {
-		return types.Proposal{}, sdkerrors.Wrap(types.ErrNoProposalHandlerExists, content.ProposalRoute())
+// SubmitProposal create new proposal of the given kind given a content and the
+// messages executed once it passes, as an expedited proposal if isExpedited is
+// set. The option labels label the vote options of a multiple-choice proposal.
+func (keeper Keeper) SubmitProposal(
+	ctx sdk.Context, content types.Content, messages []sdk.Msg, isExpedited bool,
+	kind types.ProposalKind, optionLabels []string,
+) (types.Proposal, error) {
+	if err := types.ValidateProposalKind(kind, optionLabels, isExpedited); err != nil {
+		return types.Proposal{}, err
+	}
+
+	// A multiple-choice proposal only signals the chosen option and an
+	// optimistic proposal passes without any vote, so neither can have
+	// messages nor a content changing the state.
+	if kind == types.KindMultipleChoice || kind == types.KindOptimistic {
+		if len(messages) != 0 {
+			return types.Proposal{}, sdkerrors.Wrapf(types.ErrInvalidProposalKind, "%s proposal cannot have messages", kind)
+		}
+		if content.ProposalType() != types.ProposalTypeText {
+			return types.Proposal{}, sdkerrors.Wrapf(
+				types.ErrInvalidProposalKind, "%s proposal cannot have a %s content", kind, content.ProposalType(),
+			)
+		}
+	}
+
+	// The messages are executed with the governance module account as their
+	// only signer, so that any message gated by this account can be governed.
+	if err := types.ValidateProposalMessages(messages); err != nil {
+		return types.Proposal{}, err
+	}
+
+	govAddr := keeper.authKeeper.GetModuleAddress(types.ModuleName)
+	for i, msg := range messages {
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(govAddr) {
+			return types.Proposal{}, sdkerrors.Wrapf(types.ErrInvalidSigner, "message %d", i)
+		}
+
+		if keeper.msgRouter.Route(ctx, msg.Route()) == nil {
+			return types.Proposal{}, sdkerrors.Wrapf(types.ErrInvalidProposalMsg, "unrecognized message route %s", msg.Route())
+		}
 	}
 
 	// Execute the proposal content in a cache-wrapped context to validate the
 	// actual parameter changes before the proposal proceeds through the
 	// governance process. State is not persisted.
 	cacheCtx, _ := ctx.CacheContext()
-	handler := keeper.router.GetRoute(content.ProposalRoute())
-	if err := handler(cacheCtx, content); err != nil {
+	if err := keeper.ExecLegacyContent(cacheCtx, types.NewMsgExecLegacyContent(content, govAddr)); err != nil {
+		if errors.Is(err, types.ErrNoProposalHandlerExists) {
+			return types.Proposal{}, err
+		}
 		return types.Proposal{}, sdkerrors.Wrap(types.ErrInvalidProposalContent, err.Error())
 	}
 
@@ -33,6 +73,10 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (typ
 	depositPeriod := keeper.GetDepositParams(ctx).MaxDepositPeriod
 
 	proposal := types.NewProposal(content, proposalID, submitTime, submitTime.Add(depositPeriod))
+	proposal.IsExpedited = isExpedited
+	proposal.Kind = kind
+	proposal.OptionLabels = optionLabels
+	proposal.Messages = messages
 
 	keeper.SetProposal(ctx, proposal)
 	keeper.InsertInactiveProposalQueue(ctx, proposalID, proposal.DepositEndTime)
@@ -48,6 +92,23 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (typ
 	return proposal, nil
 }
 
+// ExecLegacyContent executes the content of a MsgExecLegacyContent through the
+// proposal handler of its route. The message must be signed by the governance
+// module account.
+func (keeper Keeper) ExecLegacyContent(ctx sdk.Context, msg types.MsgExecLegacyContent) error {
+	if govAddr := keeper.authKeeper.GetModuleAddress(types.ModuleName); !msg.Authority.Equals(govAddr) {
+		return sdkerrors.Wrapf(types.ErrInvalidSigner, "expected %s, got %s", govAddr, msg.Authority)
+	}
+
+	route := msg.Content.ProposalRoute()
+	if !keeper.legacyRouter.HasRoute(route) {
+		return sdkerrors.Wrap(types.ErrNoProposalHandlerExists, route)
+	}
+
+	handler := keeper.legacyRouter.GetRoute(route)
+	return handler(ctx, msg.Content)
+}
+
 // GetProposal get proposal from store by ProposalID
 func (keeper Keeper) GetProposal(ctx sdk.Context, proposalID uint64) (types.Proposal, bool) {
 	store := ctx.KVStore(keeper.storeKey)
@@ -183,7 +244,7 @@ func (keeper Keeper) SetProposalID(ctx sdk.Context, proposalID uint64) {
 
 func (keeper Keeper) ActivateVotingPeriod(ctx sdk.Context, proposal types.Proposal) {
 	proposal.VotingStartTime = ctx.BlockHeader().Time
-	votingPeriod := keeper.GetVotingParams(ctx).VotingPeriod
+	votingPeriod := keeper.GetParamsForProposal(ctx, proposal.Content, proposal.Messages).VotingParams.GetVotingPeriod(proposal.IsExpedited)
 	proposal.VotingEndTime = proposal.VotingStartTime.Add(votingPeriod)
 	proposal.Status = types.StatusVotingPeriod
 	keeper.SetProposal(ctx, proposal)
@@ -11,7 +11,10 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
 )
 
 func TestGetSetProposal(t *testing.T) {
@@ -19,7 +22,7 @@ func TestGetSetProposal(t *testing.T) {
 	ctx := app.BaseApp.NewContext(false, abci.Header{})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil, false, types.KindStandard, nil)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalID
 	app.GovKeeper.SetProposal(ctx, proposal)
@@ -34,7 +37,7 @@ func TestActivateVotingPeriod(t *testing.T) {
 	ctx := app.BaseApp.NewContext(false, abci.Header{})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil, false, types.KindStandard, nil)
 	require.NoError(t, err)
 
 	require.True(t, proposal.VotingStartTime.Equal(time.Time{}))
@@ -77,7 +80,7 @@ func TestSubmitProposal(t *testing.T) {
 	}
 
 	for i, tc := range testCases {
-		_, err := app.GovKeeper.SubmitProposal(ctx, tc.content)
+		_, err := app.GovKeeper.SubmitProposal(ctx, tc.content, nil, false, types.KindStandard, nil)
 		require.True(t, errors.Is(tc.expectedErr, err), "tc #%d; got: %v, expected: %v", i, err, tc.expectedErr)
 	}
 }
@@ -98,7 +101,7 @@ func TestGetProposalsFiltered(t *testing.T) {
 
 			if i%2 == 0 {
 				d := types.NewDeposit(proposalID, addr1, nil)
-				v := types.NewVote(proposalID, addr1, types.OptionYes)
+				v := types.NewVote(proposalID, addr1, types.NewNonSplitVoteOption(types.OptionYes))
 				app.GovKeeper.SetDeposit(ctx, d)
 				app.GovKeeper.SetVote(ctx, v)
 			}
@@ -137,3 +140,66 @@ func TestGetProposalsFiltered(t *testing.T) {
 		}
 	}
 }
+
+func TestSubmitProposalKinds(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	govAddr := app.GovKeeper.GetGovernanceAccount(ctx).GetAddress()
+	addrs := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(10000000))
+	msgs := []sdk.Msg{bank.NewMsgSend(govAddr, addrs[0], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))}
+
+	testCases := []struct {
+		name         string
+		messages     []sdk.Msg
+		isExpedited  bool
+		kind         types.ProposalKind
+		optionLabels []string
+		expectPass   bool
+	}{
+		{"standard", msgs, false, types.KindStandard, nil, true},
+		{"standard with option labels", nil, false, types.KindStandard, []string{"a", "b"}, false},
+		{"optimistic", nil, false, types.KindOptimistic, nil, true},
+		{"optimistic with messages", msgs, false, types.KindOptimistic, nil, false},
+		{"expedited optimistic", nil, true, types.KindOptimistic, nil, false},
+		{"multiple-choice", nil, false, types.KindMultipleChoice, []string{"a", "b", "c", "d"}, true},
+		{"multiple-choice with messages", msgs, false, types.KindMultipleChoice, []string{"a", "b"}, false},
+		{"multiple-choice with a single option", nil, false, types.KindMultipleChoice, []string{"a"}, false},
+		{"multiple-choice with too many options", nil, false, types.KindMultipleChoice, []string{"a", "b", "c", "d", "e"}, false},
+		{"multiple-choice with duplicated options", nil, false, types.KindMultipleChoice, []string{"a", "a"}, false},
+		{"multiple-choice with a blank option", nil, false, types.KindMultipleChoice, []string{"a", " "}, false},
+		{"invalid kind", nil, false, types.ProposalKind(10), nil, false},
+	}
+
+	for _, tc := range testCases {
+		proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, tc.messages, tc.isExpedited, tc.kind, tc.optionLabels)
+		if tc.expectPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.kind, proposal.Kind, tc.name)
+			require.Equal(t, tc.optionLabels, proposal.OptionLabels, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestSubmitSignalingProposalContent(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	addrs := simapp.AddTestAddrs(app, ctx, 1, sdk.NewInt(10000000))
+	contents := []types.Content{
+		upgrade.NewSoftwareUpgradeProposal("title", "description", upgrade.Plan{Name: "plan", Height: 100}),
+		distribution.NewCommunityPoolSpendProposal(
+			"title", "description", addrs[0], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)),
+		),
+	}
+
+	for _, content := range contents {
+		_, err := app.GovKeeper.SubmitProposal(ctx, content, nil, false, types.KindOptimistic, nil)
+		require.True(t, errors.Is(err, types.ErrInvalidProposalKind), content.ProposalType())
+
+		_, err = app.GovKeeper.SubmitProposal(ctx, content, nil, false, types.KindMultipleChoice, []string{"a", "b"})
+		require.True(t, errors.Is(err, types.ErrInvalidProposalKind), content.ProposalType())
+	}
+}
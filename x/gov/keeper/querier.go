@@ -67,6 +67,13 @@ func queryParams(ctx sdk.Context, path []string, req abci.RequestQuery, keeper K
 		}
 		return bz, nil
 
+	case types.ParamProposalType:
+		bz, err := codec.MarshalJSONIndent(keeper.cdc, keeper.GetProposalTypeParams(ctx))
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+		}
+		return bz, nil
+
 	default:
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "%s is not a valid query request path", req.Path)
 	}
@@ -163,19 +170,7 @@ func queryTally(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Ke
 		return nil, sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
 	}
 
-	var tallyResult types.TallyResult
-
-	switch {
-	case proposal.Status == types.StatusDepositPeriod:
-		tallyResult = types.EmptyTallyResult()
-
-	case proposal.Status == types.StatusPassed || proposal.Status == types.StatusRejected:
-		tallyResult = proposal.FinalTallyResult
-
-	default:
-		// proposal is in voting period
-		_, _, tallyResult = keeper.Tally(ctx, proposal)
-	}
+	tallyResult := keeper.GetTallyResult(ctx, proposal)
 
 	bz, err := codec.MarshalJSONIndent(keeper.cdc, tallyResult)
 	if err != nil {
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/exported"
@@ -18,34 +20,52 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 	results[types.OptionNoWithVeto] = sdk.ZeroDec()
 
 	totalVotingPower := sdk.ZeroDec()
+
+	// the bonded validators are fetched into currValidators when first met
+	// as a voter or as the validator of a voter's delegation, instead of all
+	// of them, the ones which voted being recorded in votingValidators
 	currValidators := make(map[string]types.ValidatorGovInfo)
+	var votingValidators []string
+	getValidator := func(valAddr sdk.ValAddress) (types.ValidatorGovInfo, bool) {
+		valAddrStr := valAddr.String()
+		if val, ok := currValidators[valAddrStr]; ok {
+			return val, true
+		}
 
-	// fetch all the bonded validators, insert them into currValidators
-	keeper.sk.IterateBondedValidatorsByPower(ctx, func(index int64, validator exported.ValidatorI) (stop bool) {
-		currValidators[validator.GetOperator().String()] = types.NewValidatorGovInfo(
+		validator := keeper.sk.Validator(ctx, valAddr)
+		if validator == nil || !validator.IsBonded() || validator.IsJailed() {
+			return types.ValidatorGovInfo{}, false
+		}
+
+		val := types.NewValidatorGovInfo(
 			validator.GetOperator(),
 			validator.GetBondedTokens(),
 			validator.GetDelegatorShares(),
 			sdk.ZeroDec(),
-			types.OptionEmpty,
+			types.WeightedVoteOptions{},
 		)
+		currValidators[valAddrStr] = val
+		return val, true
+	}
 
-		return false
-	})
-
+	var removedVotes int
 	keeper.IterateVotes(ctx, proposal.ProposalID, func(vote types.Vote) bool {
 		// if validator, just record it in the map
-		valAddrStr := sdk.ValAddress(vote.Voter).String()
-		if val, ok := currValidators[valAddrStr]; ok {
-			val.Vote = vote.Option
-			currValidators[valAddrStr] = val
+		valAddr := sdk.ValAddress(vote.Voter)
+		voteOptions := vote.WeightedOptions()
+		if val, ok := getValidator(valAddr); ok {
+			val.Vote = voteOptions
+			currValidators[valAddr.String()] = val
+			votingValidators = append(votingValidators, valAddr.String())
 		}
 
 		// iterate over all delegations from voter, deduct from any delegated-to validators
 		keeper.sk.IterateDelegations(ctx, vote.Voter, func(index int64, delegation exported.DelegationI) (stop bool) {
 			valAddrStr := delegation.GetValidatorAddr().String()
 
-			if val, ok := currValidators[valAddrStr]; ok {
+			// the explicit vote of the delegator overrides the vote of the
+			// validator for the delegated shares, deducted from the validator's
+			if val, ok := getValidator(delegation.GetValidatorAddr()); ok {
 				// There is no need to handle the special case that validator address equal to voter address.
 				// Because voter's voting power will tally again even if there will deduct voter's voting power from validator.
 				val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
@@ -54,7 +74,10 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 				delegatorShare := delegation.GetShares().Quo(val.DelegatorShares)
 				votingPower := delegatorShare.MulInt(val.BondedTokens)
 
-				results[vote.Option] = results[vote.Option].Add(votingPower)
+				// split the voting power between the weighted options
+				for _, option := range voteOptions {
+					results[option.Option] = results[option.Option].Add(votingPower.Mul(option.Weight))
+				}
 				totalVotingPower = totalVotingPower.Add(votingPower)
 			}
 
@@ -62,36 +85,69 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 		})
 
 		keeper.deleteVote(ctx, vote.ProposalID, vote.Voter)
+		removedVotes++
 		return false
 	})
 
-	// iterate over the validators again to tally their voting power
-	for _, val := range currValidators {
-		if val.Vote == types.OptionEmpty {
-			continue
-		}
-
+	// the votes are removed from the state once tallied
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveVotes,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalID)),
+			sdk.NewAttribute(types.AttributeKeyVotes, fmt.Sprintf("%d", removedVotes)),
+		),
+	)
+
+	// iterate over the validators which voted to tally their remaining voting
+	// power
+	for _, valAddrStr := range votingValidators {
+		val := currValidators[valAddrStr]
 		sharesAfterDeductions := val.DelegatorShares.Sub(val.DelegatorDeductions)
 		fractionAfterDeductions := sharesAfterDeductions.Quo(val.DelegatorShares)
 		votingPower := fractionAfterDeductions.MulInt(val.BondedTokens)
 
-		results[val.Vote] = results[val.Vote].Add(votingPower)
+		for _, option := range val.Vote {
+			results[option.Option] = results[option.Option].Add(votingPower.Mul(option.Weight))
+		}
 		totalVotingPower = totalVotingPower.Add(votingPower)
 	}
 
-	tallyParams := keeper.GetTallyParams(ctx)
+	params := keeper.GetParamsForProposal(ctx, proposal.Content, proposal.Messages)
+	tallyParams, depositParams := params.TallyParams, params.DepositParams
 	tallyResults = types.NewTallyResultFromMap(results)
 
 	// TODO: Upgrade the spec to cover all of these cases & remove pseudocode.
 	// If there is no staked coins, the proposal fails
-	if keeper.sk.TotalBondedTokens(ctx).IsZero() {
+	totalBondedTokens := keeper.sk.TotalBondedTokens(ctx).ToDec()
+	if totalBondedTokens.IsZero() {
 		return false, false, tallyResults
 	}
 
+	// An optimistic proposal passes unless the voting power vetoing it reaches
+	// the optimistic veto threshold of the total stake
+	if proposal.Kind == types.KindOptimistic {
+		if results[types.OptionNoWithVeto].Quo(totalBondedTokens).GTE(tallyParams.OptimisticVetoThreshold) {
+			return false, depositParams.BurnVoteVeto, tallyResults
+		}
+
+		return true, false, tallyResults
+	}
+
+	percentVoting := totalVotingPower.Quo(totalBondedTokens)
+
+	// A multiple-choice proposal passes once the quorum is reached, its tally
+	// result holding the voting power of each labeled option
+	if proposal.Kind == types.KindMultipleChoice {
+		if percentVoting.LT(tallyParams.MultipleChoiceQuorum) {
+			return false, depositParams.BurnVoteQuorum, tallyResults
+		}
+
+		return true, false, tallyResults
+	}
+
 	// If there is not enough quorum of votes, the proposal fails
-	percentVoting := totalVotingPower.Quo(keeper.sk.TotalBondedTokens(ctx).ToDec())
-	if percentVoting.LT(tallyParams.Quorum) {
-		return false, true, tallyResults
+	if percentVoting.LT(tallyParams.GetQuorum(proposal.IsExpedited)) {
+		return false, depositParams.BurnVoteQuorum, tallyResults
 	}
 
 	// If no one votes (everyone abstains), proposal fails
@@ -101,14 +157,35 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 
 	// If more than 1/3 of voters veto, proposal fails
 	if results[types.OptionNoWithVeto].Quo(totalVotingPower).GT(tallyParams.Veto) {
-		return false, true, tallyResults
+		return false, depositParams.BurnVoteVeto, tallyResults
 	}
 
-	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
-	if results[types.OptionYes].Quo(totalVotingPower.Sub(results[types.OptionAbstain])).GT(tallyParams.Threshold) {
+	// If more than 1/2 (2/3 for an expedited proposal) of non-abstaining voters
+	// vote Yes, proposal passes
+	if results[types.OptionYes].Quo(totalVotingPower.Sub(results[types.OptionAbstain])).GT(tallyParams.GetThreshold(proposal.IsExpedited)) {
 		return true, false, tallyResults
 	}
 
 	// If more than 1/2 of non-abstaining voters vote No, proposal fails
 	return false, false, tallyResults
 }
+
+// GetTallyResult returns the final tally of a proposal once its voting period
+// has ended and its interim tally, computed on demand without removing the
+// votes, while it is in its voting period.
+func (keeper Keeper) GetTallyResult(ctx sdk.Context, proposal types.Proposal) types.TallyResult {
+	switch proposal.Status {
+	case types.StatusDepositPeriod:
+		return types.EmptyTallyResult()
+
+	case types.StatusPassed, types.StatusRejected, types.StatusFailed:
+		return proposal.FinalTallyResult
+
+	default:
+		// proposal is in voting period, the votes being tallied in a discarded
+		// cache context
+		cacheCtx, _ := ctx.CacheContext()
+		_, _, tallyResult := keeper.Tally(cacheCtx, proposal)
+		return tallyResult
+	}
+}
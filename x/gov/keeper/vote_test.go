@@ -18,22 +18,22 @@ func TestVotes(t *testing.T) {
 	addrs := simapp.AddTestAddrsIncremental(app, ctx, 5, sdk.NewInt(30000000))
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil, false, types.KindStandard, nil)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalID
 
 	var invalidOption types.VoteOption = 0x10
 
-	require.Error(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.OptionYes), "proposal not on voting period")
-	require.Error(t, app.GovKeeper.AddVote(ctx, 10, addrs[0], types.OptionYes), "invalid proposal ID")
+	require.Error(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)), "proposal not on voting period")
+	require.Error(t, app.GovKeeper.AddVote(ctx, 10, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)), "invalid proposal ID")
 
 	proposal.Status = types.StatusVotingPeriod
 	app.GovKeeper.SetProposal(ctx, proposal)
 
-	require.Error(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], invalidOption), "invalid option")
+	require.Error(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(invalidOption)), "invalid option")
 
 	// Test first vote
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.OptionAbstain))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionAbstain)))
 	vote, found := app.GovKeeper.GetVote(ctx, proposalID, addrs[0])
 	require.True(t, found)
 	require.Equal(t, addrs[0], vote.Voter)
@@ -41,7 +41,7 @@ func TestVotes(t *testing.T) {
 	require.Equal(t, types.OptionAbstain, vote.Option)
 
 	// Test change of vote
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.OptionYes))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
 	vote, found = app.GovKeeper.GetVote(ctx, proposalID, addrs[0])
 	require.True(t, found)
 	require.Equal(t, addrs[0], vote.Voter)
@@ -49,17 +49,33 @@ func TestVotes(t *testing.T) {
 	require.Equal(t, types.OptionYes, vote.Option)
 
 	// Test second vote
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], types.OptionNoWithVeto))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], types.NewNonSplitVoteOption(types.OptionNoWithVeto)))
 	vote, found = app.GovKeeper.GetVote(ctx, proposalID, addrs[1])
 	require.True(t, found)
 	require.Equal(t, addrs[1], vote.Voter)
 	require.Equal(t, proposalID, vote.ProposalID)
 	require.Equal(t, types.OptionNoWithVeto, vote.Option)
 
+	// Test weighted vote
+	options := types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(60, 2)),
+		types.NewWeightedVoteOption(types.OptionNo, sdk.NewDecWithPrec(30, 2)),
+		types.NewWeightedVoteOption(types.OptionAbstain, sdk.NewDecWithPrec(5, 2)),
+		types.NewWeightedVoteOption(types.OptionNoWithVeto, sdk.NewDecWithPrec(5, 2)),
+	}
+	require.Error(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[2], options[:2]), "invalid total weight")
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[2], options))
+	vote, found = app.GovKeeper.GetVote(ctx, proposalID, addrs[2])
+	require.True(t, found)
+	require.Equal(t, addrs[2], vote.Voter)
+	require.Equal(t, proposalID, vote.ProposalID)
+	require.Equal(t, types.OptionEmpty, vote.Option)
+	require.Equal(t, options, vote.WeightedOptions())
+
 	// Test vote iterator
 	// NOTE order of deposits is determined by the addresses
 	votes := app.GovKeeper.GetAllVotes(ctx)
-	require.Len(t, votes, 2)
+	require.Len(t, votes, 3)
 	require.Equal(t, votes, app.GovKeeper.GetVotes(ctx, proposalID))
 	require.Equal(t, addrs[0], votes[0].Voter)
 	require.Equal(t, proposalID, votes[0].ProposalID)
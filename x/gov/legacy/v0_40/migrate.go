@@ -0,0 +1,92 @@
+package v040
+
+import (
+	"fmt"
+
+	v034gov "github.com/cosmos/cosmos-sdk/x/gov/legacy/v0_34"
+	v036gov "github.com/cosmos/cosmos-sdk/x/gov/legacy/v0_36"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// Migrate accepts exported x/gov genesis state from v0.36 to v0.39 and
+// migrates it to v0.40 x/gov genesis state. The migration includes:
+//
+// - Setting the params which did not exist before to the values set by
+// MigrateStore, with no proposal type params.
+// - Giving the full weight to the option of the votes.
+// - Migrating the text proposals to the v0.40 text proposal. The content of the
+// other proposals must already be decoded into their v0.40 types, which have
+// the same JSON encoding as before.
+func Migrate(oldGovState v036gov.GenesisState) types.GenesisState {
+	depositParams := migrateDepositParams(types.DepositParams{
+		MinDeposit:       oldGovState.DepositParams.MinDeposit,
+		MaxDepositPeriod: oldGovState.DepositParams.MaxDepositPeriod,
+	})
+	votingParams := migrateVotingParams(types.VotingParams{
+		VotingPeriod: oldGovState.VotingParams.VotingPeriod,
+	})
+	tallyParams := migrateTallyParams(types.TallyParams{
+		Quorum:    oldGovState.TallyParams.Quorum,
+		Threshold: oldGovState.TallyParams.Threshold,
+		Veto:      oldGovState.TallyParams.Veto,
+	})
+
+	deposits := make(types.Deposits, len(oldGovState.Deposits))
+	for i, deposit := range oldGovState.Deposits {
+		deposits[i] = types.NewDeposit(deposit.ProposalID, deposit.Depositor, deposit.Amount)
+	}
+
+	votes := make(types.Votes, len(oldGovState.Votes))
+	for i, vote := range oldGovState.Votes {
+		votes[i] = types.NewVote(
+			vote.ProposalID, vote.Voter, types.NewNonSplitVoteOption(types.VoteOption(vote.Option)),
+		)
+	}
+
+	proposals := make(types.Proposals, len(oldGovState.Proposals))
+	for i, proposal := range oldGovState.Proposals {
+		proposals[i] = types.Proposal{
+			Content: migrateContent(proposal.Content),
+			ProposalBase: types.ProposalBase{
+				ProposalID:       proposal.ProposalID,
+				Status:           types.ProposalStatus(proposal.Status),
+				FinalTallyResult: migrateTallyResult(proposal.FinalTallyResult),
+				SubmitTime:       proposal.SubmitTime,
+				DepositEndTime:   proposal.DepositEndTime,
+				TotalDeposit:     proposal.TotalDeposit,
+				VotingStartTime:  proposal.VotingStartTime,
+				VotingEndTime:    proposal.VotingEndTime,
+			},
+		}
+	}
+
+	return types.GenesisState{
+		StartingProposalID: oldGovState.StartingProposalID,
+		Deposits:           deposits,
+		Votes:              votes,
+		Proposals:          proposals,
+		DepositParams:      *depositParams,
+		VotingParams:       *votingParams,
+		TallyParams:        *tallyParams,
+		ProposalTypeParams: []types.ProposalTypeParams{},
+	}
+}
+
+func migrateContent(oldContent v036gov.Content) types.Content {
+	switch content := oldContent.(type) {
+	case v036gov.TextProposal:
+		return types.NewTextProposal(content.Title, content.Description)
+
+	case types.Content:
+		return content
+
+	default:
+		panic(fmt.Sprintf("failed to migrate proposal content of type %T", oldContent))
+	}
+}
+
+func migrateTallyResult(oldTallyResult v034gov.TallyResult) types.TallyResult {
+	return types.NewTallyResult(
+		oldTallyResult.Yes, oldTallyResult.Abstain, oldTallyResult.No, oldTallyResult.NoWithVeto,
+	)
+}
@@ -0,0 +1,85 @@
+package v040
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// MinExpeditedDepositMultiplier is the multiple of the minimum deposit the
+// minimum expedited deposit is migrated to.
+const MinExpeditedDepositMultiplier = 5
+
+// MigrateStore performs an in-place store migration of the x/gov state of a
+// chain upgrading from v0.39. The migration includes:
+//
+// - Setting the MinExpeditedDeposit param to MinExpeditedDepositMultiplier
+// times the MinDeposit.
+// - Setting the MinInitialDepositRatio param to zero and the BurnVoteQuorum and
+// BurnVoteVeto params to true, keeping the v0.39 deposit handling.
+// - Setting the ExpeditedVotingPeriod param to half the VotingPeriod.
+// - Setting the ExpeditedQuorum param to its default, or to the Quorum if
+// greater.
+// - Setting the ExpeditedThreshold param to its default, or halfway between the
+// Threshold and one if the Threshold isn't lower than the default.
+// - Setting the MultipleChoiceQuorum param to the Quorum and the
+// OptimisticVetoThreshold param to its default.
+// - Setting the ProposalTypeParams param to an empty list, all the proposal
+// types using the global params.
+//
+// It is meant to be called from an x/upgrade handler. The paramSpace must be
+// the gov module's subspace with its key table set.
+func MigrateStore(ctx sdk.Context, paramSpace types.ParamSubspace) error {
+	var depositParams types.DepositParams
+	paramSpace.Get(ctx, types.ParamStoreKeyDepositParams, &depositParams)
+	paramSpace.Set(ctx, types.ParamStoreKeyDepositParams, migrateDepositParams(depositParams))
+
+	var votingParams types.VotingParams
+	paramSpace.Get(ctx, types.ParamStoreKeyVotingParams, &votingParams)
+	paramSpace.Set(ctx, types.ParamStoreKeyVotingParams, migrateVotingParams(votingParams))
+
+	var tallyParams types.TallyParams
+	paramSpace.Get(ctx, types.ParamStoreKeyTallyParams, &tallyParams)
+	paramSpace.Set(ctx, types.ParamStoreKeyTallyParams, migrateTallyParams(tallyParams))
+
+	proposalTypeParams := []types.ProposalTypeParams{}
+	paramSpace.Set(ctx, types.ParamStoreKeyProposalTypeParams, &proposalTypeParams)
+
+	return nil
+}
+
+// migrateDepositParams sets the deposit params which did not exist in v0.39.
+func migrateDepositParams(depositParams types.DepositParams) *types.DepositParams {
+	depositParams.MinExpeditedDeposit = sdk.NewCoins()
+	for _, coin := range depositParams.MinDeposit {
+		depositParams.MinExpeditedDeposit = depositParams.MinExpeditedDeposit.Add(
+			sdk.NewCoin(coin.Denom, coin.Amount.MulRaw(MinExpeditedDepositMultiplier)),
+		)
+	}
+
+	depositParams.MinInitialDepositRatio = sdk.ZeroDec()
+	depositParams.BurnVoteQuorum = true
+	depositParams.BurnVoteVeto = true
+
+	return &depositParams
+}
+
+// migrateVotingParams sets the voting params which did not exist in v0.39.
+func migrateVotingParams(votingParams types.VotingParams) *types.VotingParams {
+	votingParams.ExpeditedVotingPeriod = votingParams.VotingPeriod / 2
+	return &votingParams
+}
+
+// migrateTallyParams sets the tally params which did not exist in v0.39.
+func migrateTallyParams(tallyParams types.TallyParams) *types.TallyParams {
+	tallyParams.ExpeditedQuorum = sdk.MaxDec(types.DefaultExpeditedQuorum, tallyParams.Quorum)
+
+	tallyParams.ExpeditedThreshold = types.DefaultExpeditedThreshold
+	if tallyParams.Threshold.GTE(types.DefaultExpeditedThreshold) {
+		tallyParams.ExpeditedThreshold = tallyParams.Threshold.Add(sdk.OneDec()).QuoInt64(2)
+	}
+
+	tallyParams.MultipleChoiceQuorum = tallyParams.Quorum
+	tallyParams.OptimisticVetoThreshold = types.DefaultOptimisticVetoThreshold
+
+	return &tallyParams
+}
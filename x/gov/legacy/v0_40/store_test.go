@@ -0,0 +1,54 @@
+package v040_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v040gov "github.com/cosmos/cosmos-sdk/x/gov/legacy/v0_40"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	paramSpace := app.GetSubspace(types.ModuleName)
+
+	// write v0.39 params, without the expedited proposal params
+	minDeposit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	paramSpace.Set(ctx, types.ParamStoreKeyDepositParams, &types.DepositParams{
+		MinDeposit: minDeposit, MaxDepositPeriod: types.DefaultPeriod,
+	})
+	paramSpace.Set(ctx, types.ParamStoreKeyVotingParams, &types.VotingParams{VotingPeriod: 10 * time.Hour})
+	paramSpace.Set(ctx, types.ParamStoreKeyTallyParams, &types.TallyParams{
+		Quorum: sdk.NewDecWithPrec(6, 1), Threshold: sdk.NewDecWithPrec(8, 1), Veto: types.DefaultVeto,
+	})
+
+	require.NoError(t, v040gov.MigrateStore(ctx, paramSpace))
+
+	depositParams := app.GovKeeper.GetDepositParams(ctx)
+	require.Equal(t, minDeposit, depositParams.MinDeposit)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500)), depositParams.MinExpeditedDeposit)
+	require.True(t, depositParams.MinInitialDepositRatio.IsZero())
+	require.True(t, depositParams.BurnVoteQuorum)
+	require.True(t, depositParams.BurnVoteVeto)
+
+	votingParams := app.GovKeeper.GetVotingParams(ctx)
+	require.Equal(t, 10*time.Hour, votingParams.VotingPeriod)
+	require.Equal(t, 5*time.Hour, votingParams.ExpeditedVotingPeriod)
+
+	// the expedited quorum and threshold are raised above the regular ones
+	tallyParams := app.GovKeeper.GetTallyParams(ctx)
+	require.Equal(t, sdk.NewDecWithPrec(6, 1), tallyParams.ExpeditedQuorum)
+	require.Equal(t, sdk.NewDecWithPrec(9, 1), tallyParams.ExpeditedThreshold)
+	require.Equal(t, sdk.NewDecWithPrec(6, 1), tallyParams.MultipleChoiceQuorum)
+	require.Equal(t, types.DefaultOptimisticVetoThreshold, tallyParams.OptimisticVetoThreshold)
+
+	require.Empty(t, app.GovKeeper.GetProposalTypeParams(ctx))
+
+	require.NoError(t, types.ValidateGenesis(types.NewGenesisState(1, depositParams, votingParams, tallyParams)))
+}
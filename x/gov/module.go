@@ -11,6 +11,7 @@ import (
 	"github.com/spf13/cobra"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -20,14 +21,18 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/gov/client"
 	"github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	"github.com/cosmos/cosmos-sdk/x/gov/simulation"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 var (
-	_ module.AppModule           = AppModule{}
-	_ module.AppModuleBasic      = AppModuleBasic{}
-	_ module.AppModuleSimulation = AppModule{}
+	_ module.AppModule                 = AppModule{}
+	_ module.AppModuleBasic            = AppModuleBasic{}
+	_ module.AppModuleSimulation       = AppModule{}
+	_ module.AppModuleMigrations       = AppModule{}
+	_ module.AppModuleQueryService     = AppModule{}
+	_ module.AppModuleBasicGRPCGateway = AppModuleBasic{}
 )
 
 // AppModuleBasic defines the basic application module used by the gov module.
@@ -79,6 +84,12 @@ func (a AppModuleBasic) RegisterRESTRoutes(ctx context.CLIContext, rtr *mux.Rout
 	rest.RegisterRoutes(ctx, rtr, proposalRESTHandlers)
 }
 
+// QueryServiceDesc returns the description of the gRPC query service of the
+// gov module, served over REST by the gRPC gateway.
+func (AppModuleBasic) QueryServiceDesc() *grpc.ServiceDesc {
+	return types.QueryServiceDesc()
+}
+
 // GetTxCmd returns the root tx command for the gov module.
 func (a AppModuleBasic) GetTxCmd(cdc *codec.Codec) *cobra.Command {
 
@@ -126,6 +137,16 @@ func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
 	RegisterInvariants(ir, am.keeper, am.bankKeeper)
 }
 
+// ConsensusVersion returns the consensus version of the gov module.
+func (AppModule) ConsensusVersion() uint64 { return 2 }
+
+// RegisterMigrations registers the gov module in-place store migrations.
+func (am AppModule) RegisterMigrations(configurator module.Configurator) {
+	if err := configurator.RegisterMigration(ModuleName, 1, keeper.NewMigrator(am.keeper).Migrate1to2); err != nil {
+		panic(err)
+	}
+}
+
 // Route returns the message routing key for the gov module.
 func (AppModule) Route() string {
 	return RouterKey
@@ -146,6 +167,11 @@ func (am AppModule) NewQuerierHandler() sdk.Querier {
 	return NewQuerier(am.keeper)
 }
 
+// RegisterQueryService registers the gRPC query service of the gov module.
+func (am AppModule) RegisterQueryService(server module.GRPCServer) {
+	types.RegisterQueryService(server, am.keeper)
+}
+
 // InitGenesis performs genesis initialization for the gov module. It returns
 // no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONMarshaler, data json.RawMessage) []abci.ValidatorUpdate {
@@ -17,12 +17,21 @@ import (
 
 // Simulation parameter constants
 const (
-	DepositParamsMinDeposit    = "deposit_params_min_deposit"
-	DepositParamsDepositPeriod = "deposit_params_deposit_period"
-	VotingParamsVotingPeriod   = "voting_params_voting_period"
-	TallyParamsQuorum          = "tally_params_quorum"
-	TallyParamsThreshold       = "tally_params_threshold"
-	TallyParamsVeto            = "tally_params_veto"
+	DepositParamsMinDeposit           = "deposit_params_min_deposit"
+	DepositParamsDepositPeriod        = "deposit_params_deposit_period"
+	DepositParamsMinExpeditedDeposit  = "deposit_params_min_expedited_deposit"
+	DepositParamsMinInitialRatio      = "deposit_params_min_initial_deposit_ratio"
+	DepositParamsBurnVoteQuorum       = "deposit_params_burn_vote_quorum"
+	DepositParamsBurnVoteVeto         = "deposit_params_burn_vote_veto"
+	VotingParamsVotingPeriod          = "voting_params_voting_period"
+	VotingParamsExpeditedVotingPeriod = "voting_params_expedited_voting_period"
+	TallyParamsQuorum                 = "tally_params_quorum"
+	TallyParamsThreshold              = "tally_params_threshold"
+	TallyParamsVeto                   = "tally_params_veto"
+	TallyParamsExpeditedQuorum        = "tally_params_expedited_quorum"
+	TallyParamsExpeditedThreshold     = "tally_params_expedited_threshold"
+	TallyParamsMultipleChoiceQuorum   = "tally_params_multiple_choice_quorum"
+	TallyParamsOptimisticVeto         = "tally_params_optimistic_veto_threshold"
 )
 
 // GenDepositParamsDepositPeriod randomized DepositParamsDepositPeriod
@@ -35,11 +44,45 @@ func GenDepositParamsMinDeposit(r *rand.Rand) sdk.Coins {
 	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(simulation.RandIntBetween(r, 1, 1e3))))
 }
 
+// GenDepositParamsMinExpeditedDeposit randomized DepositParamsMinExpeditedDeposit,
+// greater than the minimum deposit
+func GenDepositParamsMinExpeditedDeposit(r *rand.Rand, minDeposit sdk.Coins) sdk.Coins {
+	minExpeditedDeposit := sdk.NewCoins()
+	for _, coin := range minDeposit {
+		minExpeditedDeposit = minExpeditedDeposit.Add(
+			sdk.NewCoin(coin.Denom, coin.Amount.MulRaw(int64(simulation.RandIntBetween(r, 2, 6)))),
+		)
+	}
+
+	return minExpeditedDeposit
+}
+
+// GenDepositParamsMinInitialRatio randomized DepositParamsMinInitialRatio
+func GenDepositParamsMinInitialRatio(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 0, 50)), 2)
+}
+
+// GenDepositParamsBurnVoteQuorum randomized DepositParamsBurnVoteQuorum
+func GenDepositParamsBurnVoteQuorum(r *rand.Rand) bool {
+	return r.Int63n(101) <= 50 // 50% chance of the deposits being burned
+}
+
+// GenDepositParamsBurnVoteVeto randomized DepositParamsBurnVoteVeto
+func GenDepositParamsBurnVoteVeto(r *rand.Rand) bool {
+	return r.Int63n(101) <= 50 // 50% chance of the deposits being burned
+}
+
 // GenVotingParamsVotingPeriod randomized VotingParamsVotingPeriod
 func GenVotingParamsVotingPeriod(r *rand.Rand) time.Duration {
 	return time.Duration(simulation.RandIntBetween(r, 1, 2*60*60*24*2)) * time.Second
 }
 
+// GenVotingParamsExpeditedVotingPeriod randomized VotingParamsExpeditedVotingPeriod,
+// shorter than the voting period
+func GenVotingParamsExpeditedVotingPeriod(r *rand.Rand, votingPeriod time.Duration) time.Duration {
+	return votingPeriod * time.Duration(simulation.RandIntBetween(r, 10, 100)) / 100
+}
+
 // GenTallyParamsQuorum randomized TallyParamsQuorum
 func GenTallyParamsQuorum(r *rand.Rand) sdk.Dec {
 	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 334, 500)), 3)
@@ -55,6 +98,28 @@ func GenTallyParamsVeto(r *rand.Rand) sdk.Dec {
 	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 250, 334)), 3)
 }
 
+// GenTallyParamsExpeditedQuorum randomized TallyParamsExpeditedQuorum, not
+// lower than any randomized quorum
+func GenTallyParamsExpeditedQuorum(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 500, 700)), 3)
+}
+
+// GenTallyParamsExpeditedThreshold randomized TallyParamsExpeditedThreshold,
+// greater than any randomized threshold
+func GenTallyParamsExpeditedThreshold(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 550, 700)), 3)
+}
+
+// GenTallyParamsMultipleChoiceQuorum randomized TallyParamsMultipleChoiceQuorum
+func GenTallyParamsMultipleChoiceQuorum(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 200, 500)), 3)
+}
+
+// GenTallyParamsOptimisticVeto randomized TallyParamsOptimisticVeto
+func GenTallyParamsOptimisticVeto(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 50, 334)), 3)
+}
+
 // RandomizedGenState generates a random GenesisState for gov
 func RandomizedGenState(simState *module.SimulationState) {
 	startingProposalID := uint64(simState.Rand.Intn(100))
@@ -71,12 +136,42 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { depositPeriod = GenDepositParamsDepositPeriod(r) },
 	)
 
+	var minExpeditedDeposit sdk.Coins
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DepositParamsMinExpeditedDeposit, &minExpeditedDeposit, simState.Rand,
+		func(r *rand.Rand) { minExpeditedDeposit = GenDepositParamsMinExpeditedDeposit(r, minDeposit) },
+	)
+
+	var minInitialDepositRatio sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DepositParamsMinInitialRatio, &minInitialDepositRatio, simState.Rand,
+		func(r *rand.Rand) { minInitialDepositRatio = GenDepositParamsMinInitialRatio(r) },
+	)
+
+	var burnVoteQuorum bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DepositParamsBurnVoteQuorum, &burnVoteQuorum, simState.Rand,
+		func(r *rand.Rand) { burnVoteQuorum = GenDepositParamsBurnVoteQuorum(r) },
+	)
+
+	var burnVoteVeto bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DepositParamsBurnVoteVeto, &burnVoteVeto, simState.Rand,
+		func(r *rand.Rand) { burnVoteVeto = GenDepositParamsBurnVoteVeto(r) },
+	)
+
 	var votingPeriod time.Duration
 	simState.AppParams.GetOrGenerate(
 		simState.Cdc, VotingParamsVotingPeriod, &votingPeriod, simState.Rand,
 		func(r *rand.Rand) { votingPeriod = GenVotingParamsVotingPeriod(r) },
 	)
 
+	var expeditedVotingPeriod time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, VotingParamsExpeditedVotingPeriod, &expeditedVotingPeriod, simState.Rand,
+		func(r *rand.Rand) { expeditedVotingPeriod = GenVotingParamsExpeditedVotingPeriod(r, votingPeriod) },
+	)
+
 	var quorum sdk.Dec
 	simState.AppParams.GetOrGenerate(
 		simState.Cdc, TallyParamsQuorum, &quorum, simState.Rand,
@@ -95,11 +190,39 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { veto = GenTallyParamsVeto(r) },
 	)
 
+	var expeditedQuorum sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, TallyParamsExpeditedQuorum, &expeditedQuorum, simState.Rand,
+		func(r *rand.Rand) { expeditedQuorum = GenTallyParamsExpeditedQuorum(r) },
+	)
+
+	var expeditedThreshold sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, TallyParamsExpeditedThreshold, &expeditedThreshold, simState.Rand,
+		func(r *rand.Rand) { expeditedThreshold = GenTallyParamsExpeditedThreshold(r) },
+	)
+
+	var multipleChoiceQuorum sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, TallyParamsMultipleChoiceQuorum, &multipleChoiceQuorum, simState.Rand,
+		func(r *rand.Rand) { multipleChoiceQuorum = GenTallyParamsMultipleChoiceQuorum(r) },
+	)
+
+	var optimisticVetoThreshold sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, TallyParamsOptimisticVeto, &optimisticVetoThreshold, simState.Rand,
+		func(r *rand.Rand) { optimisticVetoThreshold = GenTallyParamsOptimisticVeto(r) },
+	)
+
 	govGenesis := types.NewGenesisState(
 		startingProposalID,
-		types.NewDepositParams(minDeposit, depositPeriod),
-		types.NewVotingParams(votingPeriod),
-		types.NewTallyParams(quorum, threshold, veto),
+		types.NewDepositParams(
+			minDeposit, depositPeriod, minExpeditedDeposit, minInitialDepositRatio, burnVoteQuorum, burnVoteVeto,
+		),
+		types.NewVotingParams(votingPeriod, expeditedVotingPeriod),
+		types.NewTallyParams(
+			quorum, threshold, veto, expeditedQuorum, expeditedThreshold, multipleChoiceQuorum, optimisticVetoThreshold,
+		),
 	)
 
 	fmt.Printf("Selected randomly generated governance parameters:\n%s\n", codec.MustMarshalJSONIndent(simState.Cdc, govGenesis))
@@ -14,12 +14,16 @@ import (
 )
 
 const (
-	keyVotingParams  = "votingparams"
-	keyDepositParams = "depositparams"
-	keyTallyParams   = "tallyparams"
-	subkeyQuorum     = "quorum"
-	subkeyThreshold  = "threshold"
-	subkeyVeto       = "veto"
+	keyVotingParams          = "votingparams"
+	keyDepositParams         = "depositparams"
+	keyTallyParams           = "tallyparams"
+	subkeyQuorum             = "quorum"
+	subkeyThreshold          = "threshold"
+	subkeyVeto               = "veto"
+	subkeyExpeditedQuorum    = "expedited_quorum"
+	subkeyExpeditedThreshold = "expedited_threshold"
+	subkeyMultipleChoice     = "multiple_choice_quorum"
+	subkeyOptimisticVeto     = "optimistic_veto_threshold"
 )
 
 // ParamChanges defines the parameters that can be modified by param change proposals
@@ -28,7 +32,13 @@ func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 	return []simtypes.ParamChange{
 		simulation.NewSimParamChange(types.ModuleName, keyVotingParams,
 			func(r *rand.Rand) string {
-				return fmt.Sprintf(`{"voting_period": "%d"}`, GenVotingParamsVotingPeriod(r))
+				// the expedited voting period is changed along, as it must stay
+				// shorter than the voting period
+				votingPeriod := GenVotingParamsVotingPeriod(r)
+				return fmt.Sprintf(
+					`{"voting_period": "%d", "expedited_voting_period": "%d"}`,
+					votingPeriod, GenVotingParamsExpeditedVotingPeriod(r, votingPeriod),
+				)
 			},
 		),
 		simulation.NewSimParamChange(types.ModuleName, keyDepositParams,
@@ -45,6 +55,10 @@ func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 					{subkeyQuorum, GenTallyParamsQuorum(r)},
 					{subkeyThreshold, GenTallyParamsThreshold(r)},
 					{subkeyVeto, GenTallyParamsVeto(r)},
+					{subkeyExpeditedQuorum, GenTallyParamsExpeditedQuorum(r)},
+					{subkeyExpeditedThreshold, GenTallyParamsExpeditedThreshold(r)},
+					{subkeyMultipleChoice, GenTallyParamsMultipleChoiceQuorum(r)},
+					{subkeyOptimisticVeto, GenTallyParamsOptimisticVeto(r)},
 				}
 
 				pc := make(map[string]string)
@@ -14,10 +14,8 @@ type ParamSubspace interface {
 
 // StakingKeeper expected staking keeper (Validator and Delegator sets) (noalias)
 type StakingKeeper interface {
-	// iterate through bonded validators by operator address, execute func for each validator
-	IterateBondedValidatorsByPower(
-		sdk.Context, func(index int64, validator stakingexported.ValidatorI) (stop bool),
-	)
+	// get a particular validator by operator address
+	Validator(sdk.Context, sdk.ValAddress) stakingexported.ValidatorI
 
 	TotalBondedTokens(sdk.Context) sdk.Int // total bonded tokens within the validator set
 	IterateDelegations(
@@ -15,6 +15,8 @@ type GenesisState struct {
 	DepositParams      DepositParams `json:"deposit_params" yaml:"deposit_params"`
 	VotingParams       VotingParams  `json:"voting_params" yaml:"voting_params"`
 	TallyParams        TallyParams   `json:"tally_params" yaml:"tally_params"`
+
+	ProposalTypeParams []ProposalTypeParams `json:"proposal_type_params,omitempty" yaml:"proposal_type_params,omitempty"`
 }
 
 // NewGenesisState creates a new genesis state for the governance module
@@ -44,7 +46,22 @@ func (data GenesisState) Equal(other GenesisState) bool {
 		data.Proposals.Equal(other.Proposals) &&
 		data.DepositParams.Equal(other.DepositParams) &&
 		data.TallyParams.Equal(other.TallyParams) &&
-		data.VotingParams.Equal(other.VotingParams)
+		data.VotingParams.Equal(other.VotingParams) &&
+		proposalTypeParamsEqual(data.ProposalTypeParams, other.ProposalTypeParams)
+}
+
+func proposalTypeParamsEqual(ptps, others []ProposalTypeParams) bool {
+	if len(ptps) != len(others) {
+		return false
+	}
+
+	for i, ptp := range ptps {
+		if !ptp.Equal(others[i]) {
+			return false
+		}
+	}
+
+	return true
 }
 
 // IsEmpty returns true if a GenesisState is empty
@@ -71,5 +88,17 @@ func ValidateGenesis(data GenesisState) error {
 			data.DepositParams.MinDeposit.String())
 	}
 
-	return nil
+	if err := validateDepositParams(data.DepositParams); err != nil {
+		return err
+	}
+
+	if err := validateVotingParams(data.VotingParams); err != nil {
+		return err
+	}
+
+	if err := validateTallyParams(data.TallyParams); err != nil {
+		return err
+	}
+
+	return validateProposalTypeParams(data.ProposalTypeParams)
 }
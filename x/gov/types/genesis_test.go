@@ -2,8 +2,11 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestEqualProposalID(t *testing.T) {
@@ -20,3 +23,101 @@ func TestEqualProposalID(t *testing.T) {
 	require.Equal(t, state1, state2)
 	require.True(t, state1.Equal(state2))
 }
+
+func TestValidateGenesisExpeditedParams(t *testing.T) {
+	require.NoError(t, ValidateGenesis(DefaultGenesisState()))
+
+	testCases := []struct {
+		name     string
+		malleate func(*GenesisState)
+	}{
+		{"min expedited deposit not above min deposit", func(gs *GenesisState) {
+			gs.DepositParams.MinExpeditedDeposit = gs.DepositParams.MinDeposit
+		}},
+		{"expedited voting period not shorter than voting period", func(gs *GenesisState) {
+			gs.VotingParams.ExpeditedVotingPeriod = gs.VotingParams.VotingPeriod
+		}},
+		{"non-positive expedited voting period", func(gs *GenesisState) {
+			gs.VotingParams.ExpeditedVotingPeriod = 0
+		}},
+		{"expedited quorum below quorum", func(gs *GenesisState) {
+			gs.TallyParams.ExpeditedQuorum = gs.TallyParams.Quorum.Sub(sdk.NewDecWithPrec(1, 2))
+		}},
+		{"expedited threshold not above threshold", func(gs *GenesisState) {
+			gs.TallyParams.ExpeditedThreshold = gs.TallyParams.Threshold
+		}},
+		{"expedited threshold above one", func(gs *GenesisState) {
+			gs.TallyParams.ExpeditedThreshold = sdk.NewDecWithPrec(11, 1)
+		}},
+		{"multiple-choice quorum above one", func(gs *GenesisState) {
+			gs.TallyParams.MultipleChoiceQuorum = sdk.NewDecWithPrec(11, 1)
+		}},
+		{"non-positive optimistic veto threshold", func(gs *GenesisState) {
+			gs.TallyParams.OptimisticVetoThreshold = sdk.ZeroDec()
+		}},
+		{"blank proposal type", func(gs *GenesisState) {
+			gs.ProposalTypeParams = []ProposalTypeParams{{ProposalType: " "}}
+		}},
+		{"duplicate proposal type", func(gs *GenesisState) {
+			gs.ProposalTypeParams = []ProposalTypeParams{{ProposalType: ProposalTypeText}, {ProposalType: ProposalTypeText}}
+		}},
+		{"proposal type threshold above one", func(gs *GenesisState) {
+			gs.ProposalTypeParams = []ProposalTypeParams{{ProposalType: ProposalTypeText, Threshold: sdk.NewDecWithPrec(11, 1)}}
+		}},
+	}
+
+	for _, tc := range testCases {
+		gs := DefaultGenesisState()
+		tc.malleate(&gs)
+		require.Error(t, ValidateGenesis(gs), tc.name)
+	}
+}
+
+func TestParamsForProposalType(t *testing.T) {
+	params := DefaultParams()
+	params.ProposalTypeParams = []ProposalTypeParams{
+		NewProposalTypeParams("SoftwareUpgrade", nil, 0, sdk.NewDecWithPrec(6, 1), sdk.NewDecWithPrec(75, 2)),
+	}
+	require.NoError(t, validateProposalTypeParams(params.ProposalTypeParams))
+
+	// the proposal types without params use the global ones
+	require.Equal(t, params, params.ForProposalType(ProposalTypeText))
+
+	// the non-zero params of the proposal type override the global ones, the
+	// expedited quorum and threshold being raised to them
+	upgradeParams := params.ForProposalType("SoftwareUpgrade")
+	require.Equal(t, params.DepositParams, upgradeParams.DepositParams)
+	require.Equal(t, params.VotingParams, upgradeParams.VotingParams)
+	require.Equal(t, sdk.NewDecWithPrec(6, 1), upgradeParams.TallyParams.Quorum)
+	require.Equal(t, sdk.NewDecWithPrec(6, 1), upgradeParams.TallyParams.ExpeditedQuorum)
+	require.Equal(t, sdk.NewDecWithPrec(75, 2), upgradeParams.TallyParams.Threshold)
+	require.Equal(t, sdk.NewDecWithPrec(75, 2), upgradeParams.TallyParams.ExpeditedThreshold)
+	require.Equal(t, params.TallyParams.Veto, upgradeParams.TallyParams.Veto)
+}
+
+func TestParamsForProposalTypes(t *testing.T) {
+	params := DefaultParams()
+	minDeposit := params.DepositParams.MinDeposit
+	params.ProposalTypeParams = []ProposalTypeParams{
+		NewProposalTypeParams(ProposalTypeText, nil, time.Hour, sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(1, 1)),
+		NewProposalTypeParams("SoftwareUpgrade", minDeposit.Add(minDeposit...), 0, sdk.NewDecWithPrec(6, 1), sdk.NewDecWithPrec(75, 2)),
+	}
+	require.NoError(t, validateProposalTypeParams(params.ProposalTypeParams))
+
+	// a single proposal type uses its own params
+	require.Equal(t, params.ForProposalType(ProposalTypeText), params.ForProposalTypes([]string{ProposalTypeText}))
+
+	// the strictest params of the proposal types are used, the types without
+	// params using the global ones
+	textAndUpgradeParams := params.ForProposalTypes([]string{ProposalTypeText, "SoftwareUpgrade"})
+	require.Equal(t, minDeposit.Add(minDeposit...), textAndUpgradeParams.DepositParams.MinDeposit)
+	require.Equal(t, params.VotingParams.VotingPeriod, textAndUpgradeParams.VotingParams.VotingPeriod)
+	require.Equal(t, sdk.NewDecWithPrec(6, 1), textAndUpgradeParams.TallyParams.Quorum)
+	require.Equal(t, sdk.NewDecWithPrec(75, 2), textAndUpgradeParams.TallyParams.Threshold)
+	require.Equal(t, sdk.NewDecWithPrec(75, 2), textAndUpgradeParams.TallyParams.ExpeditedThreshold)
+
+	textAndSendParams := params.ForProposalTypes([]string{ProposalTypeText, "bank/send"})
+	require.Equal(t, params.DepositParams, textAndSendParams.DepositParams)
+	require.Equal(t, params.VotingParams, textAndSendParams.VotingParams)
+	require.Equal(t, params.TallyParams, textAndSendParams.TallyParams)
+}
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"encoding/json"
+	"fmt"
+
 	"gopkg.in/yaml.v2"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,10 +14,13 @@ import (
 const (
 	TypeMsgDeposit        = "deposit"
 	TypeMsgVote           = "vote"
+	TypeMsgVoteWeighted   = "weighted_vote"
 	TypeMsgSubmitProposal = "submit_proposal"
+
+	TypeMsgExecLegacyContent = "exec_legacy_content"
 )
 
-var _, _, _ sdk.Msg = MsgSubmitProposalBase{}, MsgDeposit{}, MsgVote{}
+var _, _, _, _, _ sdk.Msg = MsgSubmitProposalBase{}, MsgDeposit{}, MsgVote{}, MsgVoteWeighted{}, MsgExecLegacyContent{}
 
 // MsgSubmitProposalI defines the specific interface a concrete message must
 // implement in order to process governance proposals. The concrete MsgSubmitProposal
@@ -30,6 +36,18 @@ type MsgSubmitProposalI interface {
 
 	GetProposer() sdk.AccAddress
 	SetProposer(sdk.AccAddress)
+
+	GetIsExpedited() bool
+	SetIsExpedited(bool)
+
+	GetKind() ProposalKind
+	SetKind(ProposalKind)
+
+	GetOptionLabels() []string
+	SetOptionLabels([]string)
+
+	GetMessages() []sdk.Msg
+	SetMessages([]sdk.Msg) error
 }
 
 // NewMsgSubmitProposalBase creates a new MsgSubmitProposalBase.
@@ -52,6 +70,24 @@ func (msg *MsgSubmitProposalBase) SetProposer(address sdk.AccAddress) {
 	msg.Proposer = address
 }
 
+func (msg *MsgSubmitProposalBase) GetIsExpedited() bool { return msg.IsExpedited }
+
+func (msg *MsgSubmitProposalBase) SetIsExpedited(isExpedited bool) {
+	msg.IsExpedited = isExpedited
+}
+
+func (msg *MsgSubmitProposalBase) GetKind() ProposalKind { return msg.Kind }
+
+func (msg *MsgSubmitProposalBase) SetKind(kind ProposalKind) {
+	msg.Kind = kind
+}
+
+func (msg *MsgSubmitProposalBase) GetOptionLabels() []string { return msg.OptionLabels }
+
+func (msg *MsgSubmitProposalBase) SetOptionLabels(optionLabels []string) {
+	msg.OptionLabels = optionLabels
+}
+
 // Route implements Msg
 func (msg MsgSubmitProposalBase) Route() string { return RouterKey }
 
@@ -70,7 +106,7 @@ func (msg MsgSubmitProposalBase) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.InitialDeposit.String())
 	}
 
-	return nil
+	return ValidateProposalKind(msg.Kind, msg.OptionLabels, msg.IsExpedited)
 }
 
 // GetSignBytes implements Msg
@@ -173,6 +209,108 @@ func (msg MsgVote) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Voter}
 }
 
+// NewMsgVoteWeighted creates a message to cast a vote split between several
+// options on an active proposal
+func NewMsgVoteWeighted(voter sdk.AccAddress, proposalID uint64, options WeightedVoteOptions) MsgVoteWeighted {
+	return MsgVoteWeighted{proposalID, voter, options}
+}
+
+// Route implements Msg
+func (msg MsgVoteWeighted) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgVoteWeighted) Type() string { return TypeMsgVoteWeighted }
+
+// ValidateBasic implements Msg
+func (msg MsgVoteWeighted) ValidateBasic() error {
+	if msg.Voter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Voter.String())
+	}
+
+	return msg.Options.Validate()
+}
+
+// String implements the Stringer interface
+func (msg MsgVoteWeighted) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgVoteWeighted) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgVoteWeighted) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Voter}
+}
+
+// MsgExecLegacyContent defines a message executing the content of a passed
+// proposal through its proposal handler. It is only executed by the governance
+// module, on behalf of its module account.
+type MsgExecLegacyContent struct {
+	Content   Content        `json:"content" yaml:"content"`
+	Authority sdk.AccAddress `json:"authority" yaml:"authority"`
+}
+
+// NewMsgExecLegacyContent creates a message to execute the content of a passed
+// proposal with the given authority
+func NewMsgExecLegacyContent(content Content, authority sdk.AccAddress) MsgExecLegacyContent {
+	return MsgExecLegacyContent{content, authority}
+}
+
+// Route implements Msg
+func (msg MsgExecLegacyContent) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgExecLegacyContent) Type() string { return TypeMsgExecLegacyContent }
+
+// ValidateBasic implements Msg
+func (msg MsgExecLegacyContent) ValidateBasic() error {
+	if msg.Content == nil {
+		return sdkerrors.Wrap(ErrInvalidProposalContent, "missing content")
+	}
+	if msg.Authority.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Authority.String())
+	}
+
+	return msg.Content.ValidateBasic()
+}
+
+// String implements the Stringer interface
+func (msg MsgExecLegacyContent) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgExecLegacyContent) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgExecLegacyContent) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Authority}
+}
+
+// ValidateProposalMessages performs a basic validation of the messages of a
+// proposal.
+func ValidateProposalMessages(messages []sdk.Msg) error {
+	for i, msg := range messages {
+		if msg == nil {
+			return sdkerrors.Wrapf(ErrInvalidProposalMsg, "message %d is empty", i)
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(ErrInvalidProposalMsg, fmt.Sprintf("message %d: %s", i, err))
+		}
+	}
+
+	return nil
+}
+
 // ---------------------------------------------------------------------------
 // Deprecated
 //
@@ -185,8 +323,12 @@ func (msg MsgVote) GetSigners() []sdk.AccAddress {
 // TODO: Remove once client-side Protobuf migration has been completed.
 type MsgSubmitProposal struct {
 	Content        Content        `json:"content" yaml:"content"`
-	InitialDeposit sdk.Coins      `json:"initial_deposit" yaml:"initial_deposit"` //  Initial deposit paid by sender. Must be strictly positive
-	Proposer       sdk.AccAddress `json:"proposer" yaml:"proposer"`               //  Address of the proposer
+	InitialDeposit sdk.Coins      `json:"initial_deposit" yaml:"initial_deposit"`       //  Initial deposit paid by sender. Must be strictly positive
+	Proposer       sdk.AccAddress `json:"proposer" yaml:"proposer"`                     //  Address of the proposer
+	IsExpedited    bool           `json:"is_expedited,omitempty" yaml:"is_expedited"`   //  Whether the proposal is expedited
+	Messages       []sdk.Msg      `json:"messages,omitempty" yaml:"messages"`           //  Messages executed by the governance module account once the proposal passes
+	Kind           ProposalKind   `json:"kind,omitempty" yaml:"kind"`                   //  Kind of the proposal, deciding how its votes are tallied
+	OptionLabels   []string       `json:"option_labels,omitempty" yaml:"option_labels"` //  Labels of the vote options of a multiple-choice proposal
 }
 
 var _ MsgSubmitProposalI = &MsgSubmitProposal{}
@@ -195,7 +337,7 @@ var _ MsgSubmitProposalI = &MsgSubmitProposal{}
 //
 // TODO: Remove once client-side Protobuf migration has been completed.
 func NewMsgSubmitProposal(content Content, initialDeposit sdk.Coins, proposer sdk.AccAddress) *MsgSubmitProposal {
-	return &MsgSubmitProposal{content, initialDeposit, proposer}
+	return &MsgSubmitProposal{Content: content, InitialDeposit: initialDeposit, Proposer: proposer}
 }
 
 // ValidateBasic implements Msg
@@ -215,13 +357,38 @@ func (msg MsgSubmitProposal) ValidateBasic() error {
 	if !IsValidProposalType(msg.Content.ProposalType()) {
 		return sdkerrors.Wrap(ErrInvalidProposalType, msg.Content.ProposalType())
 	}
+	if err := ValidateProposalMessages(msg.Messages); err != nil {
+		return err
+	}
+	if err := ValidateProposalKind(msg.Kind, msg.OptionLabels, msg.IsExpedited); err != nil {
+		return err
+	}
 
 	return msg.Content.ValidateBasic()
 }
 
-// GetSignBytes implements Msg
+// GetSignBytes implements Msg. The messages of the proposal, whose concrete types
+// are not registered on the module codec, are signed with their own sign bytes.
 func (msg MsgSubmitProposal) GetSignBytes() []byte {
-	bz := ModuleCdc.MustMarshalJSON(msg)
+	if len(msg.Messages) == 0 {
+		bz := ModuleCdc.MustMarshalJSON(msg)
+		return sdk.MustSortJSON(bz)
+	}
+
+	messages := make([]json.RawMessage, len(msg.Messages))
+	for i, m := range msg.Messages {
+		messages[i] = m.GetSignBytes()
+	}
+
+	msg.Messages = nil
+	bz, err := json.Marshal(struct {
+		Msg      json.RawMessage   `json:"msg"`
+		Messages []json.RawMessage `json:"messages"`
+	}{ModuleCdc.MustMarshalJSON(msg), messages})
+	if err != nil {
+		panic(err)
+	}
+
 	return sdk.MustSortJSON(bz)
 }
 
@@ -232,6 +399,10 @@ func (msg MsgSubmitProposal) Type() string                 { return TypeMsgSubmi
 func (msg MsgSubmitProposal) GetContent() Content          { return msg.Content }
 func (msg MsgSubmitProposal) GetInitialDeposit() sdk.Coins { return msg.InitialDeposit }
 func (msg MsgSubmitProposal) GetProposer() sdk.AccAddress  { return msg.Proposer }
+func (msg MsgSubmitProposal) GetIsExpedited() bool         { return msg.IsExpedited }
+func (msg MsgSubmitProposal) GetMessages() []sdk.Msg       { return msg.Messages }
+func (msg MsgSubmitProposal) GetKind() ProposalKind        { return msg.Kind }
+func (msg MsgSubmitProposal) GetOptionLabels() []string    { return msg.OptionLabels }
 
 func (msg *MsgSubmitProposal) SetContent(content Content) error {
 	msg.Content = content
@@ -245,3 +416,20 @@ func (msg *MsgSubmitProposal) SetInitialDeposit(deposit sdk.Coins) {
 func (msg *MsgSubmitProposal) SetProposer(proposer sdk.AccAddress) {
 	msg.Proposer = proposer
 }
+
+func (msg *MsgSubmitProposal) SetIsExpedited(isExpedited bool) {
+	msg.IsExpedited = isExpedited
+}
+
+func (msg *MsgSubmitProposal) SetMessages(messages []sdk.Msg) error {
+	msg.Messages = messages
+	return nil
+}
+
+func (msg *MsgSubmitProposal) SetKind(kind ProposalKind) {
+	msg.Kind = kind
+}
+
+func (msg *MsgSubmitProposal) SetOptionLabels(optionLabels []string) {
+	msg.OptionLabels = optionLabels
+}
@@ -115,3 +115,66 @@ func TestMsgVote(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgVoteWeighted(t *testing.T) {
+	tests := []struct {
+		proposalID uint64
+		voterAddr  sdk.AccAddress
+		options    WeightedVoteOptions
+		expectPass bool
+	}{
+		{0, addrs[0], NewNonSplitVoteOption(OptionYes), true},
+		{0, sdk.AccAddress{}, NewNonSplitVoteOption(OptionYes), false},
+		{0, addrs[0], NewNonSplitVoteOption(OptionNoWithVeto), true},
+		{0, addrs[0], NewNonSplitVoteOption(VoteOption(0x13)), false},
+		{0, addrs[0], WeightedVoteOptions{}, false},
+		{0, addrs[0], WeightedVoteOptions{
+			NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(7, 1)),
+			NewWeightedVoteOption(OptionAbstain, sdk.NewDecWithPrec(3, 1)),
+		}, true},
+		{0, addrs[0], WeightedVoteOptions{
+			NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(7, 1)),
+			NewWeightedVoteOption(OptionAbstain, sdk.NewDecWithPrec(2, 1)),
+		}, false},
+		{0, addrs[0], WeightedVoteOptions{
+			NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(5, 1)),
+			NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(5, 1)),
+		}, false},
+		{0, addrs[0], WeightedVoteOptions{
+			NewWeightedVoteOption(OptionYes, sdk.ZeroDec()),
+			NewWeightedVoteOption(OptionNo, sdk.OneDec()),
+		}, false},
+		{0, addrs[0], WeightedVoteOptions{
+			NewWeightedVoteOption(OptionYes, sdk.NewDec(2)),
+			NewWeightedVoteOption(OptionNo, sdk.NewDec(-1)),
+		}, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgVoteWeighted(tc.voterAddr, tc.proposalID, tc.options)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestWeightedVoteOptionsFromString(t *testing.T) {
+	options, err := WeightedVoteOptionsFromString("Yes=0.6,NoWithVeto=0.4")
+	require.NoError(t, err)
+	require.Equal(t, WeightedVoteOptions{
+		NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(6, 1)),
+		NewWeightedVoteOption(OptionNoWithVeto, sdk.NewDecWithPrec(4, 1)),
+	}.String(), options.String())
+
+	options, err = WeightedVoteOptionsFromString("Abstain")
+	require.NoError(t, err)
+	require.Equal(t, NewNonSplitVoteOption(OptionAbstain).String(), options.String())
+
+	_, err = WeightedVoteOptionsFromString("Yes=0.6,Maybe=0.4")
+	require.Error(t, err)
+
+	_, err = WeightedVoteOptionsFromString("Yes=half")
+	require.Error(t, err)
+}
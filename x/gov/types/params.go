@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"gopkg.in/yaml.v2"
@@ -12,15 +13,28 @@ import (
 
 // Default period for deposits & voting
 const (
-	DefaultPeriod time.Duration = time.Hour * 24 * 2 // 2 days
+	DefaultPeriod          time.Duration = time.Hour * 24 * 2 // 2 days
+	DefaultExpeditedPeriod time.Duration = time.Hour * 24     // 1 day
 )
 
 // Default governance params
 var (
-	DefaultMinDepositTokens = sdk.TokensFromConsensusPower(10)
-	DefaultQuorum           = sdk.NewDecWithPrec(334, 3)
-	DefaultThreshold        = sdk.NewDecWithPrec(5, 1)
-	DefaultVeto             = sdk.NewDecWithPrec(334, 3)
+	DefaultMinDepositTokens          = sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	DefaultMinExpeditedDepositTokens = sdk.TokensFromConsensusPower(50, sdk.DefaultPowerReduction)
+	DefaultQuorum                    = sdk.NewDecWithPrec(334, 3)
+	DefaultThreshold                 = sdk.NewDecWithPrec(5, 1)
+	DefaultVeto                      = sdk.NewDecWithPrec(334, 3)
+	DefaultExpeditedQuorum           = sdk.NewDecWithPrec(5, 1)
+	DefaultExpeditedThreshold        = sdk.NewDecWithPrec(667, 3)
+	DefaultMinInitialDepositRatio    = sdk.ZeroDec()
+	DefaultMultipleChoiceQuorum      = sdk.NewDecWithPrec(334, 3)
+	DefaultOptimisticVetoThreshold   = sdk.NewDecWithPrec(1, 1)
+)
+
+// Default deposit burn policy
+const (
+	DefaultBurnVoteQuorum = true
+	DefaultBurnVoteVeto   = true
 )
 
 // Parameter store key
@@ -28,6 +42,8 @@ var (
 	ParamStoreKeyDepositParams = []byte("depositparams")
 	ParamStoreKeyVotingParams  = []byte("votingparams")
 	ParamStoreKeyTallyParams   = []byte("tallyparams")
+
+	ParamStoreKeyProposalTypeParams = []byte("proposaltypeparams")
 )
 
 // ParamKeyTable - Key declaration for parameters
@@ -36,20 +52,33 @@ func ParamKeyTable() paramtypes.KeyTable {
 		paramtypes.NewParamSetPair(ParamStoreKeyDepositParams, DepositParams{}, validateDepositParams),
 		paramtypes.NewParamSetPair(ParamStoreKeyVotingParams, VotingParams{}, validateVotingParams),
 		paramtypes.NewParamSetPair(ParamStoreKeyTallyParams, TallyParams{}, validateTallyParams),
+		paramtypes.NewParamSetPair(ParamStoreKeyProposalTypeParams, []ProposalTypeParams{}, validateProposalTypeParams),
 	)
 }
 
 // DepositParams defines the params around deposits for governance
 type DepositParams struct {
-	MinDeposit       sdk.Coins     `json:"min_deposit,omitempty" yaml:"min_deposit,omitempty"`               //  Minimum deposit for a proposal to enter voting period.
-	MaxDepositPeriod time.Duration `json:"max_deposit_period,omitempty" yaml:"max_deposit_period,omitempty"` //  Maximum period for Atom holders to deposit on a proposal. Initial value: 2 months
+	MinDeposit          sdk.Coins     `json:"min_deposit,omitempty" yaml:"min_deposit,omitempty"`                     //  Minimum deposit for a proposal to enter voting period.
+	MaxDepositPeriod    time.Duration `json:"max_deposit_period,omitempty" yaml:"max_deposit_period,omitempty"`       //  Maximum period for Atom holders to deposit on a proposal. Initial value: 2 months
+	MinExpeditedDeposit sdk.Coins     `json:"min_expedited_deposit,omitempty" yaml:"min_expedited_deposit,omitempty"` //  Minimum deposit for an expedited proposal to enter voting period.
+
+	MinInitialDepositRatio sdk.Dec `json:"min_initial_deposit_ratio,omitempty" yaml:"min_initial_deposit_ratio,omitempty"` //  Minimum ratio of the minimum deposit to deposit on submission. Initial value: 0
+	BurnVoteQuorum         bool    `json:"burn_vote_quorum,omitempty" yaml:"burn_vote_quorum,omitempty"`                   //  Whether the deposits of a proposal failing to meet the quorum are burned. Initial value: true
+	BurnVoteVeto           bool    `json:"burn_vote_veto,omitempty" yaml:"burn_vote_veto,omitempty"`                       //  Whether the deposits of a vetoed proposal are burned. Initial value: true
 }
 
 // NewDepositParams creates a new DepositParams object
-func NewDepositParams(minDeposit sdk.Coins, maxDepositPeriod time.Duration) DepositParams {
+func NewDepositParams(
+	minDeposit sdk.Coins, maxDepositPeriod time.Duration, minExpeditedDeposit sdk.Coins,
+	minInitialDepositRatio sdk.Dec, burnVoteQuorum, burnVoteVeto bool,
+) DepositParams {
 	return DepositParams{
-		MinDeposit:       minDeposit,
-		MaxDepositPeriod: maxDepositPeriod,
+		MinDeposit:             minDeposit,
+		MaxDepositPeriod:       maxDepositPeriod,
+		MinExpeditedDeposit:    minExpeditedDeposit,
+		MinInitialDepositRatio: minInitialDepositRatio,
+		BurnVoteQuorum:         burnVoteQuorum,
+		BurnVoteVeto:           burnVoteVeto,
 	}
 }
 
@@ -58,9 +87,37 @@ func DefaultDepositParams() DepositParams {
 	return NewDepositParams(
 		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinDepositTokens)),
 		DefaultPeriod,
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinExpeditedDepositTokens)),
+		DefaultMinInitialDepositRatio,
+		DefaultBurnVoteQuorum,
+		DefaultBurnVoteVeto,
 	)
 }
 
+// GetMinDeposit returns the minimum deposit of a regular or an expedited
+// proposal.
+func (dp DepositParams) GetMinDeposit(isExpedited bool) sdk.Coins {
+	if isExpedited {
+		return dp.MinExpeditedDeposit
+	}
+
+	return dp.MinDeposit
+}
+
+// GetMinInitialDeposit returns the minimum deposit to make on the submission of
+// a regular or an expedited proposal, the MinInitialDepositRatio of its minimum
+// deposit.
+func (dp DepositParams) GetMinInitialDeposit(isExpedited bool) sdk.Coins {
+	minInitialDeposit := sdk.NewCoins()
+	for _, coin := range dp.GetMinDeposit(isExpedited) {
+		minInitialDeposit = minInitialDeposit.Add(
+			sdk.NewCoin(coin.Denom, coin.Amount.ToDec().Mul(dp.MinInitialDepositRatio).Ceil().TruncateInt()),
+		)
+	}
+
+	return minInitialDeposit
+}
+
 // String implements stringer insterface
 func (dp DepositParams) String() string {
 	out, _ := yaml.Marshal(dp)
@@ -69,7 +126,10 @@ func (dp DepositParams) String() string {
 
 // Equal checks equality of DepositParams
 func (dp DepositParams) Equal(dp2 DepositParams) bool {
-	return dp.MinDeposit.IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod
+	return dp.MinDeposit.IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod &&
+		dp.MinExpeditedDeposit.IsEqual(dp2.MinExpeditedDeposit) &&
+		dp.MinInitialDepositRatio.Equal(dp2.MinInitialDepositRatio) &&
+		dp.BurnVoteQuorum == dp2.BurnVoteQuorum && dp.BurnVoteVeto == dp2.BurnVoteVeto
 }
 
 func validateDepositParams(i interface{}) error {
@@ -84,34 +144,80 @@ func validateDepositParams(i interface{}) error {
 	if v.MaxDepositPeriod <= 0 {
 		return fmt.Errorf("maximum deposit period must be positive: %d", v.MaxDepositPeriod)
 	}
+	if !v.MinExpeditedDeposit.IsValid() {
+		return fmt.Errorf("invalid minimum expedited deposit: %s", v.MinExpeditedDeposit)
+	}
+	if v.MinExpeditedDeposit.IsAllLTE(v.MinDeposit) {
+		return fmt.Errorf("minimum expedited deposit %s must be greater than the minimum deposit %s", v.MinExpeditedDeposit, v.MinDeposit)
+	}
+	if v.MinInitialDepositRatio.IsNil() {
+		return fmt.Errorf("minimum initial deposit ratio cannot be nil")
+	}
+	if v.MinInitialDepositRatio.IsNegative() || v.MinInitialDepositRatio.GT(sdk.OneDec()) {
+		return fmt.Errorf("minimum initial deposit ratio must be between 0 and 1: %s", v.MinInitialDepositRatio)
+	}
 
 	return nil
 }
 
 // TallyParams defines the params around Tallying votes in governance
 type TallyParams struct {
-	Quorum    sdk.Dec `json:"quorum,omitempty" yaml:"quorum,omitempty"`       //  Minimum percentage of total stake needed to vote for a result to be considered valid
-	Threshold sdk.Dec `json:"threshold,omitempty" yaml:"threshold,omitempty"` //  Minimum proportion of Yes votes for proposal to pass. Initial value: 0.5
-	Veto      sdk.Dec `json:"veto,omitempty" yaml:"veto,omitempty"`           //  Minimum value of Veto votes to Total votes ratio for proposal to be vetoed. Initial value: 1/3
+	Quorum             sdk.Dec `json:"quorum,omitempty" yaml:"quorum,omitempty"`                           //  Minimum percentage of total stake needed to vote for a result to be considered valid
+	Threshold          sdk.Dec `json:"threshold,omitempty" yaml:"threshold,omitempty"`                     //  Minimum proportion of Yes votes for proposal to pass. Initial value: 0.5
+	Veto               sdk.Dec `json:"veto,omitempty" yaml:"veto,omitempty"`                               //  Minimum value of Veto votes to Total votes ratio for proposal to be vetoed. Initial value: 1/3
+	ExpeditedQuorum    sdk.Dec `json:"expedited_quorum,omitempty" yaml:"expedited_quorum,omitempty"`       //  Minimum percentage of total stake needed to vote for the result of an expedited proposal to be considered valid
+	ExpeditedThreshold sdk.Dec `json:"expedited_threshold,omitempty" yaml:"expedited_threshold,omitempty"` //  Minimum proportion of Yes votes for an expedited proposal to pass. Initial value: 0.667
+
+	MultipleChoiceQuorum    sdk.Dec `json:"multiple_choice_quorum,omitempty" yaml:"multiple_choice_quorum,omitempty"`       //  Minimum percentage of total stake needed to vote for the result of a multiple-choice proposal to be considered valid. Initial value: 0.334
+	OptimisticVetoThreshold sdk.Dec `json:"optimistic_veto_threshold,omitempty" yaml:"optimistic_veto_threshold,omitempty"` //  Minimum percentage of total stake voting NoWithVeto for an optimistic proposal to be rejected. Initial value: 0.1
 }
 
 // NewTallyParams creates a new TallyParams object
-func NewTallyParams(quorum, threshold, veto sdk.Dec) TallyParams {
+func NewTallyParams(
+	quorum, threshold, veto, expeditedQuorum, expeditedThreshold, multipleChoiceQuorum, optimisticVetoThreshold sdk.Dec,
+) TallyParams {
 	return TallyParams{
-		Quorum:    quorum,
-		Threshold: threshold,
-		Veto:      veto,
+		Quorum:                  quorum,
+		Threshold:               threshold,
+		Veto:                    veto,
+		ExpeditedQuorum:         expeditedQuorum,
+		ExpeditedThreshold:      expeditedThreshold,
+		MultipleChoiceQuorum:    multipleChoiceQuorum,
+		OptimisticVetoThreshold: optimisticVetoThreshold,
 	}
 }
 
 // DefaultTallyParams default parameters for tallying
 func DefaultTallyParams() TallyParams {
-	return NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultVeto)
+	return NewTallyParams(
+		DefaultQuorum, DefaultThreshold, DefaultVeto, DefaultExpeditedQuorum, DefaultExpeditedThreshold,
+		DefaultMultipleChoiceQuorum, DefaultOptimisticVetoThreshold,
+	)
+}
+
+// GetQuorum returns the quorum of a regular or an expedited proposal.
+func (tp TallyParams) GetQuorum(isExpedited bool) sdk.Dec {
+	if isExpedited {
+		return tp.ExpeditedQuorum
+	}
+
+	return tp.Quorum
+}
+
+// GetThreshold returns the threshold of a regular or an expedited proposal.
+func (tp TallyParams) GetThreshold(isExpedited bool) sdk.Dec {
+	if isExpedited {
+		return tp.ExpeditedThreshold
+	}
+
+	return tp.Threshold
 }
 
 // Equal checks equality of TallyParams
 func (tp TallyParams) Equal(other TallyParams) bool {
-	return tp.Quorum.Equal(other.Quorum) && tp.Threshold.Equal(other.Threshold) && tp.Veto.Equal(other.Veto)
+	return tp.Quorum.Equal(other.Quorum) && tp.Threshold.Equal(other.Threshold) && tp.Veto.Equal(other.Veto) &&
+		tp.ExpeditedQuorum.Equal(other.ExpeditedQuorum) && tp.ExpeditedThreshold.Equal(other.ExpeditedThreshold) &&
+		tp.MultipleChoiceQuorum.Equal(other.MultipleChoiceQuorum) && tp.OptimisticVetoThreshold.Equal(other.OptimisticVetoThreshold)
 }
 
 // String implements stringer insterface
@@ -144,30 +250,66 @@ func validateTallyParams(i interface{}) error {
 	if v.Veto.GT(sdk.OneDec()) {
 		return fmt.Errorf("veto threshold too large: %s", v)
 	}
+	if v.ExpeditedQuorum.IsNil() || v.ExpeditedQuorum.LT(v.Quorum) {
+		return fmt.Errorf("expedited quorom must not be lower than the quorum: %s", v)
+	}
+	if v.ExpeditedQuorum.GT(sdk.OneDec()) {
+		return fmt.Errorf("expedited quorom too large: %s", v)
+	}
+	if v.ExpeditedThreshold.IsNil() || v.ExpeditedThreshold.LTE(v.Threshold) {
+		return fmt.Errorf("expedited vote threshold must be greater than the vote threshold: %s", v)
+	}
+	if v.ExpeditedThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("expedited vote threshold too large: %s", v)
+	}
+	if v.MultipleChoiceQuorum.IsNil() || v.MultipleChoiceQuorum.IsNegative() {
+		return fmt.Errorf("multiple-choice quorum cannot be negative: %s", v)
+	}
+	if v.MultipleChoiceQuorum.GT(sdk.OneDec()) {
+		return fmt.Errorf("multiple-choice quorum too large: %s", v)
+	}
+	if v.OptimisticVetoThreshold.IsNil() || !v.OptimisticVetoThreshold.IsPositive() {
+		return fmt.Errorf("optimistic veto threshold must be positive: %s", v)
+	}
+	if v.OptimisticVetoThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("optimistic veto threshold too large: %s", v)
+	}
 
 	return nil
 }
 
 // VotingParams defines the params around Voting in governance
 type VotingParams struct {
-	VotingPeriod time.Duration `json:"voting_period,omitempty" yaml:"voting_period,omitempty"` //  Length of the voting period.
+	VotingPeriod          time.Duration `json:"voting_period,omitempty" yaml:"voting_period,omitempty"`                     //  Length of the voting period.
+	ExpeditedVotingPeriod time.Duration `json:"expedited_voting_period,omitempty" yaml:"expedited_voting_period,omitempty"` //  Length of the voting period of an expedited proposal.
 }
 
 // NewVotingParams creates a new VotingParams object
-func NewVotingParams(votingPeriod time.Duration) VotingParams {
+func NewVotingParams(votingPeriod, expeditedVotingPeriod time.Duration) VotingParams {
 	return VotingParams{
-		VotingPeriod: votingPeriod,
+		VotingPeriod:          votingPeriod,
+		ExpeditedVotingPeriod: expeditedVotingPeriod,
 	}
 }
 
 // DefaultVotingParams default parameters for voting
 func DefaultVotingParams() VotingParams {
-	return NewVotingParams(DefaultPeriod)
+	return NewVotingParams(DefaultPeriod, DefaultExpeditedPeriod)
+}
+
+// GetVotingPeriod returns the voting period of a regular or an expedited
+// proposal.
+func (vp VotingParams) GetVotingPeriod(isExpedited bool) time.Duration {
+	if isExpedited {
+		return vp.ExpeditedVotingPeriod
+	}
+
+	return vp.VotingPeriod
 }
 
 // Equal checks equality of TallyParams
 func (vp VotingParams) Equal(other VotingParams) bool {
-	return vp.VotingPeriod == other.VotingPeriod
+	return vp.VotingPeriod == other.VotingPeriod && vp.ExpeditedVotingPeriod == other.ExpeditedVotingPeriod
 }
 
 // String implements stringer interface
@@ -185,6 +327,92 @@ func validateVotingParams(i interface{}) error {
 	if v.VotingPeriod <= 0 {
 		return fmt.Errorf("voting period must be positive: %s", v.VotingPeriod)
 	}
+	if v.ExpeditedVotingPeriod <= 0 {
+		return fmt.Errorf("expedited voting period must be positive: %s", v.ExpeditedVotingPeriod)
+	}
+	if v.ExpeditedVotingPeriod >= v.VotingPeriod {
+		return fmt.Errorf("expedited voting period %s must be shorter than the voting period %s", v.ExpeditedVotingPeriod, v.VotingPeriod)
+	}
+
+	return nil
+}
+
+// ProposalTypeParams defines the params overriding the global deposit, voting
+// and tally params for the proposals of a proposal type. A zero field falls
+// back to the global param.
+type ProposalTypeParams struct {
+	ProposalType string        `json:"proposal_type" yaml:"proposal_type"`                     //  Type of the proposal content or message the params apply to, e.g. SoftwareUpgrade or bank/send.
+	MinDeposit   sdk.Coins     `json:"min_deposit,omitempty" yaml:"min_deposit,omitempty"`     //  Minimum deposit for a proposal of the type to enter voting period.
+	VotingPeriod time.Duration `json:"voting_period,omitempty" yaml:"voting_period,omitempty"` //  Length of the voting period of a proposal of the type.
+	Quorum       sdk.Dec       `json:"quorum,omitempty" yaml:"quorum,omitempty"`               //  Minimum percentage of total stake needed to vote for the result of a proposal of the type to be considered valid.
+	Threshold    sdk.Dec       `json:"threshold,omitempty" yaml:"threshold,omitempty"`         //  Minimum proportion of Yes votes for a proposal of the type to pass.
+}
+
+// NewProposalTypeParams creates a new ProposalTypeParams object
+func NewProposalTypeParams(
+	proposalType string, minDeposit sdk.Coins, votingPeriod time.Duration, quorum, threshold sdk.Dec,
+) ProposalTypeParams {
+	return ProposalTypeParams{
+		ProposalType: proposalType,
+		MinDeposit:   minDeposit,
+		VotingPeriod: votingPeriod,
+		Quorum:       quorum,
+		Threshold:    threshold,
+	}
+}
+
+// Equal checks equality of ProposalTypeParams
+func (ptp ProposalTypeParams) Equal(other ProposalTypeParams) bool {
+	return ptp.ProposalType == other.ProposalType && ptp.MinDeposit.IsEqual(other.MinDeposit) &&
+		ptp.VotingPeriod == other.VotingPeriod && isZeroOrEqual(ptp.Quorum, other.Quorum) &&
+		isZeroOrEqual(ptp.Threshold, other.Threshold)
+}
+
+// String implements stringer interface
+func (ptp ProposalTypeParams) String() string {
+	out, _ := yaml.Marshal(ptp)
+	return string(out)
+}
+
+// isZeroOrEqual returns true if both decimals are equal or unset, a nil
+// decimal being encoded as zero.
+func isZeroOrEqual(d1, d2 sdk.Dec) bool {
+	if d1.IsNil() || d2.IsNil() {
+		return (d1.IsNil() || d1.IsZero()) && (d2.IsNil() || d2.IsZero())
+	}
+
+	return d1.Equal(d2)
+}
+
+func validateProposalTypeParams(i interface{}) error {
+	v, ok := i.([]ProposalTypeParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	proposalTypes := make(map[string]bool, len(v))
+	for _, ptp := range v {
+		if strings.TrimSpace(ptp.ProposalType) == "" {
+			return fmt.Errorf("proposal type cannot be blank: %s", ptp)
+		}
+		if proposalTypes[ptp.ProposalType] {
+			return fmt.Errorf("duplicate params for proposal type %s", ptp.ProposalType)
+		}
+		proposalTypes[ptp.ProposalType] = true
+
+		if !ptp.MinDeposit.IsValid() {
+			return fmt.Errorf("invalid minimum deposit of proposal type %s: %s", ptp.ProposalType, ptp.MinDeposit)
+		}
+		if ptp.VotingPeriod < 0 {
+			return fmt.Errorf("voting period of proposal type %s cannot be negative: %s", ptp.ProposalType, ptp.VotingPeriod)
+		}
+		if !ptp.Quorum.IsNil() && (ptp.Quorum.IsNegative() || ptp.Quorum.GT(sdk.OneDec())) {
+			return fmt.Errorf("quorum of proposal type %s must be between 0 and 1: %s", ptp.ProposalType, ptp.Quorum)
+		}
+		if !ptp.Threshold.IsNil() && (ptp.Threshold.IsNegative() || ptp.Threshold.GT(sdk.OneDec())) {
+			return fmt.Errorf("vote threshold of proposal type %s must be between 0 and 1: %s", ptp.ProposalType, ptp.Threshold)
+		}
+	}
 
 	return nil
 }
@@ -194,11 +422,88 @@ type Params struct {
 	VotingParams  VotingParams  `json:"voting_params" yaml:"voting_params"`
 	TallyParams   TallyParams   `json:"tally_params" yaml:"tally_params"`
 	DepositParams DepositParams `json:"deposit_params" yaml:"deposit_parmas"`
+
+	ProposalTypeParams []ProposalTypeParams `json:"proposal_type_params,omitempty" yaml:"proposal_type_params,omitempty"`
 }
 
 func (gp Params) String() string {
-	return gp.VotingParams.String() + "\n" +
+	out := gp.VotingParams.String() + "\n" +
 		gp.TallyParams.String() + "\n" + gp.DepositParams.String()
+	for _, ptp := range gp.ProposalTypeParams {
+		out += "\n" + ptp.String()
+	}
+
+	return out
+}
+
+// ForProposalType returns the params of the proposals of a proposal type, the
+// global params overridden by the non-zero ProposalTypeParams of the type. The
+// expedited quorum and threshold are raised to the overridden ones if lower, so
+// that expediting a proposal never lowers the votes it needs to pass.
+func (gp Params) ForProposalType(proposalType string) Params {
+	for _, ptp := range gp.ProposalTypeParams {
+		if ptp.ProposalType != proposalType {
+			continue
+		}
+
+		if !ptp.MinDeposit.Empty() {
+			gp.DepositParams.MinDeposit = ptp.MinDeposit
+		}
+		if ptp.VotingPeriod > 0 {
+			gp.VotingParams.VotingPeriod = ptp.VotingPeriod
+		}
+		if !ptp.Quorum.IsNil() && !ptp.Quorum.IsZero() {
+			gp.TallyParams.Quorum = ptp.Quorum
+			gp.TallyParams.ExpeditedQuorum = sdk.MaxDec(gp.TallyParams.ExpeditedQuorum, ptp.Quorum)
+		}
+		if !ptp.Threshold.IsNil() && !ptp.Threshold.IsZero() {
+			gp.TallyParams.Threshold = ptp.Threshold
+			gp.TallyParams.ExpeditedThreshold = sdk.MaxDec(gp.TallyParams.ExpeditedThreshold, ptp.Threshold)
+		}
+
+		break
+	}
+
+	return gp
+}
+
+// ForProposalTypes returns the params of the proposals spanning several
+// proposal types, i.e. a content and messages of different types. They are the
+// strictest of the params of each type: the largest minimum deposit of each
+// denom, the longest voting period and the highest quorums and thresholds.
+func (gp Params) ForProposalTypes(proposalTypes []string) Params {
+	params := gp
+	for i, proposalType := range proposalTypes {
+		typeParams := gp.ForProposalType(proposalType)
+		if i == 0 {
+			params = typeParams
+			continue
+		}
+
+		params.DepositParams.MinDeposit = maxCoins(params.DepositParams.MinDeposit, typeParams.DepositParams.MinDeposit)
+		if typeParams.VotingParams.VotingPeriod > params.VotingParams.VotingPeriod {
+			params.VotingParams.VotingPeriod = typeParams.VotingParams.VotingPeriod
+		}
+
+		params.TallyParams.Quorum = sdk.MaxDec(params.TallyParams.Quorum, typeParams.TallyParams.Quorum)
+		params.TallyParams.Threshold = sdk.MaxDec(params.TallyParams.Threshold, typeParams.TallyParams.Threshold)
+		params.TallyParams.ExpeditedQuorum = sdk.MaxDec(params.TallyParams.ExpeditedQuorum, typeParams.TallyParams.ExpeditedQuorum)
+		params.TallyParams.ExpeditedThreshold = sdk.MaxDec(
+			params.TallyParams.ExpeditedThreshold, typeParams.TallyParams.ExpeditedThreshold,
+		)
+	}
+
+	return params
+}
+
+// maxCoins returns the largest amount of each denom of two sets of coins.
+func maxCoins(coinsA, coinsB sdk.Coins) sdk.Coins {
+	max := sdk.NewCoins()
+	for _, coin := range coinsA.Add(coinsB...) {
+		max = max.Add(sdk.NewCoin(coin.Denom, sdk.MaxInt(coinsA.AmountOf(coin.Denom), coinsB.AmountOf(coin.Denom))))
+	}
+
+	return max
 }
 
 // NewParams creates a new gov Params instance
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -20,6 +21,9 @@ const DefaultStartingProposalID uint64 = 1
 type Proposal struct {
 	Content `json:"content" yaml:"content"` // Proposal content interface
 	ProposalBase
+
+	// Messages executed by the governance module account once the proposal passes
+	Messages []sdk.Msg `json:"messages,omitempty" yaml:"messages,omitempty"`
 }
 
 // NewProposal creates a new Proposal instance
@@ -37,9 +41,42 @@ func NewProposal(content Content, id uint64, submitTime, depositEndTime time.Tim
 	}
 }
 
+// ProposalParamsTypes returns the types the params of a proposal with the
+// given content and messages are resolved from: the type of its content and
+// the type of each of its messages. The type of a MsgExecLegacyContent is the
+// type of its content and the type of any other message is its route and type,
+// e.g. bank/send.
+func ProposalParamsTypes(content Content, messages []sdk.Msg) []string {
+	proposalTypes := []string{content.ProposalType()}
+	for _, msg := range messages {
+		if execMsg, ok := msg.(MsgExecLegacyContent); ok {
+			proposalTypes = append(proposalTypes, execMsg.Content.ProposalType())
+			continue
+		}
+
+		proposalTypes = append(proposalTypes, fmt.Sprintf("%s/%s", msg.Route(), msg.Type()))
+	}
+
+	return proposalTypes
+}
+
 // Equal returns true if two Proposal types are equal.
 func (p Proposal) Equal(other Proposal) bool {
-	return p.ProposalBase.Equal(other.ProposalBase) && p.Content.String() == other.Content.String()
+	if !p.ProposalBase.Equal(other.ProposalBase) || p.Content.String() != other.Content.String() {
+		return false
+	}
+
+	if len(p.Messages) != len(other.Messages) {
+		return false
+	}
+
+	for i, msg := range p.Messages {
+		if !bytes.Equal(msg.GetSignBytes(), other.Messages[i].GetSignBytes()) {
+			return false
+		}
+	}
+
+	return true
 }
 
 // String implements stringer interface
@@ -189,6 +226,139 @@ func (status ProposalStatus) Format(s fmt.State, verb rune) {
 	}
 }
 
+// Bounds of the labeled vote options of a multiple-choice proposal
+const (
+	MinOptionLabels      = 2
+	MaxOptionLabels      = 4
+	MaxOptionLabelLength = 140
+)
+
+// MultipleChoiceVoteOptions are the vote options of a multiple-choice proposal,
+// in the order of their labels.
+var MultipleChoiceVoteOptions = []VoteOption{OptionYes, OptionAbstain, OptionNo, OptionNoWithVeto}
+
+// ProposalKindFromString turns a string into a ProposalKind
+func ProposalKindFromString(str string) (ProposalKind, error) {
+	switch str {
+	case "Standard", "":
+		return KindStandard, nil
+
+	case "MultipleChoice":
+		return KindMultipleChoice, nil
+
+	case "Optimistic":
+		return KindOptimistic, nil
+
+	default:
+		return ProposalKind(0xff), fmt.Errorf("'%s' is not a valid proposal kind", str)
+	}
+}
+
+// ValidProposalKind returns true if the proposal kind is valid and false
+// otherwise.
+func ValidProposalKind(kind ProposalKind) bool {
+	return kind == KindStandard || kind == KindMultipleChoice || kind == KindOptimistic
+}
+
+// MarshalJSON Marshals to JSON using string representation of the kind
+func (kind ProposalKind) MarshalJSON() ([]byte, error) {
+	return json.Marshal(kind.String())
+}
+
+// UnmarshalJSON Unmarshals from JSON using string representation of the kind
+func (kind *ProposalKind) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	k, err := ProposalKindFromString(s)
+	if err != nil {
+		return err
+	}
+
+	*kind = k
+	return nil
+}
+
+// String implements the Stringer interface.
+func (kind ProposalKind) String() string {
+	switch kind {
+	case KindStandard:
+		return "Standard"
+
+	case KindMultipleChoice:
+		return "MultipleChoice"
+
+	case KindOptimistic:
+		return "Optimistic"
+
+	default:
+		return ""
+	}
+}
+
+// ValidateProposalKind returns an error if the proposal kind is invalid, if the
+// option labels of a multiple-choice proposal are invalid or are set for
+// another kind of proposal, or if a proposal other than a standard one is
+// expedited.
+func ValidateProposalKind(kind ProposalKind, optionLabels []string, isExpedited bool) error {
+	if !ValidProposalKind(kind) {
+		return sdkerrors.Wrapf(ErrInvalidProposalKind, "%d", kind)
+	}
+	if isExpedited && kind != KindStandard {
+		return sdkerrors.Wrapf(ErrInvalidProposalKind, "%s proposal cannot be expedited", kind)
+	}
+
+	if kind != KindMultipleChoice {
+		if len(optionLabels) != 0 {
+			return sdkerrors.Wrapf(ErrInvalidProposalKind, "%s proposal cannot have option labels", kind)
+		}
+
+		return nil
+	}
+
+	if len(optionLabels) < MinOptionLabels || len(optionLabels) > MaxOptionLabels {
+		return sdkerrors.Wrapf(
+			ErrInvalidProposalKind, "multiple-choice proposal must have between %d and %d option labels, got %d",
+			MinOptionLabels, MaxOptionLabels, len(optionLabels),
+		)
+	}
+
+	usedLabels := make(map[string]bool)
+	for _, label := range optionLabels {
+		if len(strings.TrimSpace(label)) == 0 {
+			return sdkerrors.Wrap(ErrInvalidProposalKind, "option label cannot be blank")
+		}
+		if len(label) > MaxOptionLabelLength {
+			return sdkerrors.Wrapf(ErrInvalidProposalKind, "option label is longer than %d", MaxOptionLabelLength)
+		}
+		if usedLabels[label] {
+			return sdkerrors.Wrapf(ErrInvalidProposalKind, "duplicated option label %s", label)
+		}
+
+		usedLabels[label] = true
+	}
+
+	return nil
+}
+
+// IsValidVoteOption returns true if the vote option can be voted on the
+// proposal, the options of a multiple-choice proposal being the labeled ones.
+func (p Proposal) IsValidVoteOption(option VoteOption) bool {
+	if p.Kind != KindMultipleChoice {
+		return ValidVoteOption(option)
+	}
+
+	for i := 0; i < len(p.OptionLabels) && i < len(MultipleChoiceVoteOptions); i++ {
+		if option == MultipleChoiceVoteOptions[i] {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Proposal types
 const (
 	ProposalTypeText string = "Text"
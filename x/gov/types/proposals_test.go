@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestProposalStatus_Format(t *testing.T) {
@@ -22,3 +24,42 @@ func TestProposalStatus_Format(t *testing.T) {
 		require.Equal(t, tt.expectedStringOutput, got)
 	}
 }
+
+func TestProposalKindFromString(t *testing.T) {
+	for _, kind := range []ProposalKind{KindStandard, KindMultipleChoice, KindOptimistic} {
+		parsed, err := ProposalKindFromString(kind.String())
+		require.NoError(t, err)
+		require.Equal(t, kind, parsed)
+	}
+
+	kind, err := ProposalKindFromString("")
+	require.NoError(t, err)
+	require.Equal(t, KindStandard, kind)
+
+	_, err = ProposalKindFromString("Unknown")
+	require.Error(t, err)
+}
+
+func TestProposalIsValidVoteOption(t *testing.T) {
+	proposal := Proposal{ProposalBase: ProposalBase{Kind: KindOptimistic}}
+	require.True(t, proposal.IsValidVoteOption(OptionNoWithVeto))
+	require.False(t, proposal.IsValidVoteOption(OptionEmpty))
+
+	proposal = Proposal{ProposalBase: ProposalBase{Kind: KindMultipleChoice, OptionLabels: []string{"a", "b"}}}
+	require.True(t, proposal.IsValidVoteOption(OptionYes))
+	require.True(t, proposal.IsValidVoteOption(OptionAbstain))
+	require.False(t, proposal.IsValidVoteOption(OptionNo))
+	require.False(t, proposal.IsValidVoteOption(OptionNoWithVeto))
+}
+
+func TestProposalParamsTypes(t *testing.T) {
+	content := NewTextProposal("title", "description")
+	require.Equal(t, []string{ProposalTypeText}, ProposalParamsTypes(content, nil))
+
+	// the content of a MsgExecLegacyContent is unwrapped
+	messages := []sdk.Msg{
+		NewMsgExecLegacyContent(NewTextProposal("title", "description"), sdk.AccAddress("gov")),
+		sdk.NewTestMsg(),
+	}
+	require.Equal(t, []string{ProposalTypeText, ProposalTypeText, "TestMsg/Test message"}, ProposalParamsTypes(content, messages))
+}
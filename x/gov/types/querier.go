@@ -17,9 +17,10 @@ const (
 	QueryVote      = "vote"
 	QueryTally     = "tally"
 
-	ParamDeposit  = "deposit"
-	ParamVoting   = "voting"
-	ParamTallying = "tallying"
+	ParamDeposit      = "deposit"
+	ParamVoting       = "voting"
+	ParamTallying     = "tallying"
+	ParamProposalType = "proposaltype"
 )
 
 // QueryProposalParams Params for queries:
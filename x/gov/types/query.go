@@ -0,0 +1,29 @@
+package types
+
+import (
+	"google.golang.org/grpc"
+)
+
+// RegisterQueryService registers the QueryServer with the provided server,
+// which unlike for RegisterQueryServer does not have to be a *grpc.Server, e.g.
+// the baseapp GRPCQueryRouter serving the queries over ABCI.
+func RegisterQueryService(server interface {
+	RegisterService(sd *grpc.ServiceDesc, ss interface{})
+}, srv QueryServer) {
+	server.RegisterService(&_Query_serviceDesc, srv)
+}
+
+// QueryServiceDesc returns the description of the Query service, whose methods
+// the gRPC gateway serves over REST.
+func QueryServiceDesc() *grpc.ServiceDesc {
+	return &_Query_serviceDesc
+}
+
+// Full names of the Query service methods, which are the paths of their ABCI
+// queries.
+const (
+	QueryVoteMethod        = "/cosmos_sdk.x.gov.v1.Query/Vote"
+	QueryVotesMethod       = "/cosmos_sdk.x.gov.v1.Query/Votes"
+	QueryDepositsMethod    = "/cosmos_sdk.x.gov.v1.Query/Deposits"
+	QueryTallyResultMethod = "/cosmos_sdk.x.gov.v1.Query/TallyResult"
+)
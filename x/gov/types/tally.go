@@ -8,16 +8,16 @@ import (
 
 // ValidatorGovInfo used for tallying
 type ValidatorGovInfo struct {
-	Address             sdk.ValAddress // address of the validator operator
-	BondedTokens        sdk.Int        // Power of a Validator
-	DelegatorShares     sdk.Dec        // Total outstanding delegator shares
-	DelegatorDeductions sdk.Dec        // Delegator deductions from validator's delegators voting independently
-	Vote                VoteOption     // Vote of the validator
+	Address             sdk.ValAddress      // address of the validator operator
+	BondedTokens        sdk.Int             // Power of a Validator
+	DelegatorShares     sdk.Dec             // Total outstanding delegator shares
+	DelegatorDeductions sdk.Dec             // Delegator deductions from validator's delegators voting independently
+	Vote                WeightedVoteOptions // Vote of the validator
 }
 
 // NewValidatorGovInfo creates a ValidatorGovInfo instance
 func NewValidatorGovInfo(address sdk.ValAddress, bondedTokens sdk.Int, delegatorShares,
-	delegatorDeductions sdk.Dec, vote VoteOption) ValidatorGovInfo {
+	delegatorDeductions sdk.Dec, vote WeightedVoteOptions) ValidatorGovInfo {
 
 	return ValidatorGovInfo{
 		Address:             address,
@@ -66,3 +66,32 @@ func (tr TallyResult) String() string {
 	out, _ := yaml.Marshal(tr)
 	return string(out)
 }
+
+// OptionLabelTally defines the tally of a labeled vote option of a
+// multiple-choice proposal
+type OptionLabelTally struct {
+	Label string  `json:"label" yaml:"label"`
+	Count sdk.Int `json:"count" yaml:"count"`
+}
+
+// OptionLabelTallies returns the tally of each labeled vote option of a
+// multiple-choice proposal, in the order of the labels.
+func (tr TallyResult) OptionLabelTallies(optionLabels []string) []OptionLabelTally {
+	counts := map[VoteOption]sdk.Int{
+		OptionYes:        tr.Yes,
+		OptionAbstain:    tr.Abstain,
+		OptionNo:         tr.No,
+		OptionNoWithVeto: tr.NoWithVeto,
+	}
+
+	tallies := make([]OptionLabelTally, 0, len(optionLabels))
+	for i, label := range optionLabels {
+		if i == len(MultipleChoiceVoteOptions) {
+			break
+		}
+
+		tallies = append(tallies, OptionLabelTally{Label: label, Count: counts[MultipleChoiceVoteOptions[i]]})
+	}
+
+	return tallies
+}
@@ -67,6 +67,38 @@ func (VoteOption) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_a5ae5e91b5b3fb03, []int{0}
 }
 
+// ProposalKind defines the kind of a proposal, deciding how its votes are
+// tallied.
+type ProposalKind int32
+
+const (
+	// PROPOSAL_KIND_STANDARD defines a standard proposal, passing if the quorum
+	// and the threshold of Yes votes are reached and the veto threshold isn't.
+	KindStandard ProposalKind = 0
+	// PROPOSAL_KIND_MULTIPLE_CHOICE defines a proposal voted on labeled options,
+	// tallying the votes of each option once the quorum is reached.
+	KindMultipleChoice ProposalKind = 1
+	// PROPOSAL_KIND_OPTIMISTIC defines a proposal passing unless the optimistic
+	// veto threshold is reached.
+	KindOptimistic ProposalKind = 2
+)
+
+var ProposalKind_name = map[int32]string{
+	0: "PROPOSAL_KIND_STANDARD",
+	1: "PROPOSAL_KIND_MULTIPLE_CHOICE",
+	2: "PROPOSAL_KIND_OPTIMISTIC",
+}
+
+var ProposalKind_value = map[string]int32{
+	"PROPOSAL_KIND_STANDARD":        0,
+	"PROPOSAL_KIND_MULTIPLE_CHOICE": 1,
+	"PROPOSAL_KIND_OPTIMISTIC":      2,
+}
+
+func (ProposalKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a5ae5e91b5b3fb03, []int{1}
+}
+
 // ProposalStatus is a type alias that represents a proposal status as a byte
 type ProposalStatus int32
 
@@ -104,7 +136,7 @@ var ProposalStatus_value = map[string]int32{
 }
 
 func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_a5ae5e91b5b3fb03, []int{1}
+	return fileDescriptor_a5ae5e91b5b3fb03, []int{2}
 }
 
 // MsgSubmitProposalBase defines an sdk.Msg type that supports submitting arbitrary
@@ -117,6 +149,14 @@ func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
 type MsgSubmitProposalBase struct {
 	InitialDeposit github_com_cosmos_cosmos_sdk_types.Coins      `protobuf:"bytes,1,rep,name=initial_deposit,json=initialDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"initial_deposit" yaml:"initial_deposit"`
 	Proposer       github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=proposer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"proposer,omitempty"`
+	// is_expedited submits the proposal as an expedited proposal, with a shorter
+	// voting period and a higher minimum deposit, quorum and threshold.
+	IsExpedited bool `protobuf:"varint,3,opt,name=is_expedited,json=isExpedited,proto3" json:"is_expedited,omitempty" yaml:"is_expedited"`
+	// kind defines the kind of the proposal, deciding how its votes are tallied.
+	Kind ProposalKind `protobuf:"varint,4,opt,name=kind,proto3,enum=cosmos_sdk.x.gov.v1.ProposalKind" json:"kind,omitempty"`
+	// option_labels labels the vote options of a multiple-choice proposal, in the
+	// order of the Yes, Abstain, No and NoWithVeto vote options.
+	OptionLabels []string `protobuf:"bytes,5,rep,name=option_labels,json=optionLabels,proto3" json:"option_labels,omitempty" yaml:"option_labels"`
 }
 
 func (m *MsgSubmitProposalBase) Reset()      { *m = MsgSubmitProposalBase{} }
@@ -190,6 +230,46 @@ func (m *MsgVote) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgVote proto.InternalMessageInfo
 
+// MsgVoteWeighted defines a message to cast a vote split between several
+// options, whose weights sum to one
+type MsgVoteWeighted struct {
+	ProposalID uint64                                        `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id" yaml:"proposal_id"`
+	Voter      github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=voter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"voter,omitempty"`
+	Options    WeightedVoteOptions                           `protobuf:"bytes,3,rep,name=options,proto3,castrepeated=WeightedVoteOptions" json:"options"`
+}
+
+func (m *MsgVoteWeighted) Reset()      { *m = MsgVoteWeighted{} }
+func (*MsgVoteWeighted) ProtoMessage() {}
+func (*MsgVoteWeighted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5ae5e91b5b3fb03, []int{2}
+}
+func (m *MsgVoteWeighted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteWeighted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteWeighted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVoteWeighted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteWeighted.Merge(m, src)
+}
+func (m *MsgVoteWeighted) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteWeighted) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteWeighted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoteWeighted proto.InternalMessageInfo
+
 // MsgDeposit defines a message to submit a deposit to an existing proposal
 type MsgDeposit struct {
 	ProposalID uint64                                        `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id" yaml:"proposal_id"`
@@ -200,7 +280,7 @@ type MsgDeposit struct {
 func (m *MsgDeposit) Reset()      { *m = MsgDeposit{} }
 func (*MsgDeposit) ProtoMessage() {}
 func (*MsgDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5ae5e91b5b3fb03, []int{2}
+	return fileDescriptor_a5ae5e91b5b3fb03, []int{3}
 }
 func (m *MsgDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -239,7 +319,7 @@ type TextProposal struct {
 func (m *TextProposal) Reset()      { *m = TextProposal{} }
 func (*TextProposal) ProtoMessage() {}
 func (*TextProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5ae5e91b5b3fb03, []int{3}
+	return fileDescriptor_a5ae5e91b5b3fb03, []int{4}
 }
 func (m *TextProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -278,7 +358,7 @@ type Deposit struct {
 func (m *Deposit) Reset()      { *m = Deposit{} }
 func (*Deposit) ProtoMessage() {}
 func (*Deposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5ae5e91b5b3fb03, []int{4}
+	return fileDescriptor_a5ae5e91b5b3fb03, []int{5}
 }
 func (m *Deposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -319,13 +399,16 @@ type ProposalBase struct {
 	TotalDeposit     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=total_deposit,json=totalDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_deposit" yaml:"total_deposit"`
 	VotingStartTime  time.Time                                `protobuf:"bytes,7,opt,name=voting_start_time,json=votingStartTime,proto3,stdtime" json:"voting_start_time" yaml:"voting_start_time"`
 	VotingEndTime    time.Time                                `protobuf:"bytes,8,opt,name=voting_end_time,json=votingEndTime,proto3,stdtime" json:"voting_end_time" yaml:"voting_end_time"`
+	IsExpedited      bool                                     `protobuf:"varint,9,opt,name=is_expedited,json=isExpedited,proto3" json:"is_expedited,omitempty" yaml:"is_expedited"`
+	Kind             ProposalKind                             `protobuf:"varint,10,opt,name=kind,proto3,enum=cosmos_sdk.x.gov.v1.ProposalKind" json:"kind,omitempty"`
+	OptionLabels     []string                                 `protobuf:"bytes,11,rep,name=option_labels,json=optionLabels,proto3" json:"option_labels,omitempty" yaml:"option_labels"`
 }
 
 func (m *ProposalBase) Reset()         { *m = ProposalBase{} }
 func (m *ProposalBase) String() string { return proto.CompactTextString(m) }
 func (*ProposalBase) ProtoMessage()    {}
 func (*ProposalBase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5ae5e91b5b3fb03, []int{5}
+	return fileDescriptor_a5ae5e91b5b3fb03, []int{6}
 }
 func (m *ProposalBase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -365,7 +448,7 @@ type TallyResult struct {
 func (m *TallyResult) Reset()      { *m = TallyResult{} }
 func (*TallyResult) ProtoMessage() {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5ae5e91b5b3fb03, []int{6}
+	return fileDescriptor_a5ae5e91b5b3fb03, []int{7}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -394,18 +477,59 @@ func (m *TallyResult) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TallyResult proto.InternalMessageInfo
 
+// WeightedVoteOption defines a vote option along with the weight of the voting
+// power cast for it
+type WeightedVoteOption struct {
+	Option VoteOption                             `protobuf:"varint,1,opt,name=option,proto3,enum=cosmos_sdk.x.gov.v1.VoteOption" json:"option,omitempty"`
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+}
+
+func (m *WeightedVoteOption) Reset()      { *m = WeightedVoteOption{} }
+func (*WeightedVoteOption) ProtoMessage() {}
+func (*WeightedVoteOption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5ae5e91b5b3fb03, []int{8}
+}
+func (m *WeightedVoteOption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedVoteOption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedVoteOption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightedVoteOption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedVoteOption.Merge(m, src)
+}
+func (m *WeightedVoteOption) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedVoteOption) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedVoteOption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedVoteOption proto.InternalMessageInfo
+
 // Vote defines a vote on a governance proposal. A vote corresponds to a proposal
-// ID, the voter, and the vote option.
+// ID, the voter, and the weighted vote options. The option is only set for the
+// votes cast for a single option.
 type Vote struct {
 	ProposalID uint64                                        `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" yaml:"proposal_id"`
 	Voter      github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=voter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"voter,omitempty"`
 	Option     VoteOption                                    `protobuf:"varint,3,opt,name=option,proto3,enum=cosmos_sdk.x.gov.v1.VoteOption" json:"option,omitempty"`
+	Options    WeightedVoteOptions                           `protobuf:"bytes,4,rep,name=options,proto3,castrepeated=WeightedVoteOptions" json:"options"`
 }
 
 func (m *Vote) Reset()      { *m = Vote{} }
 func (*Vote) ProtoMessage() {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5ae5e91b5b3fb03, []int{7}
+	return fileDescriptor_a5ae5e91b5b3fb03, []int{9}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -436,98 +560,119 @@ var xxx_messageInfo_Vote proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterEnum("cosmos_sdk.x.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
+	proto.RegisterEnum("cosmos_sdk.x.gov.v1.ProposalKind", ProposalKind_name, ProposalKind_value)
 	proto.RegisterEnum("cosmos_sdk.x.gov.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
 	proto.RegisterType((*MsgSubmitProposalBase)(nil), "cosmos_sdk.x.gov.v1.MsgSubmitProposalBase")
 	proto.RegisterType((*MsgVote)(nil), "cosmos_sdk.x.gov.v1.MsgVote")
+	proto.RegisterType((*MsgVoteWeighted)(nil), "cosmos_sdk.x.gov.v1.MsgVoteWeighted")
 	proto.RegisterType((*MsgDeposit)(nil), "cosmos_sdk.x.gov.v1.MsgDeposit")
 	proto.RegisterType((*TextProposal)(nil), "cosmos_sdk.x.gov.v1.TextProposal")
 	proto.RegisterType((*Deposit)(nil), "cosmos_sdk.x.gov.v1.Deposit")
 	proto.RegisterType((*ProposalBase)(nil), "cosmos_sdk.x.gov.v1.ProposalBase")
 	proto.RegisterType((*TallyResult)(nil), "cosmos_sdk.x.gov.v1.TallyResult")
+	proto.RegisterType((*WeightedVoteOption)(nil), "cosmos_sdk.x.gov.v1.WeightedVoteOption")
 	proto.RegisterType((*Vote)(nil), "cosmos_sdk.x.gov.v1.Vote")
 }
 
 func init() { proto.RegisterFile("x/gov/types/types.proto", fileDescriptor_a5ae5e91b5b3fb03) }
 
 var fileDescriptor_a5ae5e91b5b3fb03 = []byte{
-	// 1230 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0xcf, 0x8f, 0xd3, 0x46,
-	0x14, 0x8e, 0xb3, 0xbf, 0xd8, 0x49, 0x36, 0x6b, 0x66, 0x29, 0x9b, 0xba, 0xaa, 0x6d, 0x02, 0x42,
-	0x2b, 0x04, 0x5e, 0x58, 0x0e, 0x55, 0xa9, 0x54, 0x35, 0x26, 0x06, 0x8c, 0xd8, 0x38, 0xb2, 0xcd,
-	0x22, 0x5a, 0x55, 0x96, 0x77, 0x6d, 0xbc, 0x2e, 0x8e, 0x27, 0xcd, 0xcc, 0xa6, 0xec, 0xad, 0xea,
-	0xa1, 0x42, 0x39, 0x71, 0xe4, 0x12, 0x09, 0xa9, 0x1c, 0x50, 0x4f, 0xfd, 0x33, 0xf6, 0x56, 0x0e,
-	0xad, 0x84, 0x7a, 0x08, 0x65, 0x39, 0xb4, 0xea, 0xa1, 0x07, 0x2e, 0x95, 0x7a, 0xaa, 0xe2, 0x19,
-	0xb3, 0x4e, 0x76, 0xe9, 0xb2, 0xa2, 0x95, 0xaa, 0x5e, 0x92, 0xf8, 0xf9, 0xfb, 0xbe, 0x37, 0xef,
-	0xcd, 0x9b, 0x6f, 0x14, 0x30, 0x7f, 0x67, 0x31, 0x40, 0x9d, 0x45, 0xb2, 0xd9, 0xf2, 0x31, 0xfd,
-	0x54, 0x5a, 0x6d, 0x44, 0x10, 0x9c, 0x5b, 0x43, 0xb8, 0x89, 0xb0, 0x83, 0xbd, 0xdb, 0xca, 0x1d,
-	0x25, 0x40, 0x1d, 0xa5, 0x73, 0x4e, 0x38, 0xbc, 0x0b, 0x27, 0x9c, 0x24, 0xeb, 0x61, 0xdb, 0x73,
-	0x5a, 0x6e, 0x9b, 0x6c, 0x2e, 0x26, 0xa1, 0xc5, 0x00, 0x05, 0x68, 0xe7, 0x17, 0xc3, 0x49, 0x01,
-	0x42, 0x41, 0xe4, 0x53, 0xc8, 0xea, 0xc6, 0xad, 0x45, 0x12, 0x36, 0x7d, 0x4c, 0xdc, 0x66, 0x8b,
-	0x02, 0x2a, 0x7f, 0x70, 0xe0, 0xad, 0x65, 0x1c, 0x58, 0x1b, 0xab, 0xcd, 0x90, 0x34, 0xda, 0xa8,
-	0x85, 0xb0, 0x1b, 0xa9, 0x2e, 0xf6, 0xe1, 0x5d, 0x0e, 0xcc, 0x86, 0x71, 0x48, 0x42, 0x37, 0x72,
-	0x3c, 0xbf, 0x85, 0x70, 0x48, 0xca, 0x9c, 0x3c, 0xb6, 0x50, 0x58, 0x9a, 0x53, 0x32, 0xab, 0xec,
-	0x9c, 0x53, 0x2e, 0xa2, 0x30, 0x56, 0xaf, 0x6e, 0xf5, 0xa5, 0xdc, 0x8b, 0xbe, 0x74, 0x74, 0xd3,
-	0x6d, 0x46, 0x17, 0x2a, 0x23, 0xcc, 0xca, 0xb7, 0x4f, 0xa5, 0x85, 0x20, 0x24, 0xeb, 0x1b, 0xab,
-	0xca, 0x1a, 0x6a, 0x2e, 0x52, 0x01, 0xf6, 0x75, 0x06, 0x7b, 0xb7, 0x59, 0x75, 0x03, 0x29, 0x6c,
-	0x96, 0x18, 0xbb, 0x46, 0xc9, 0x70, 0x19, 0x1c, 0x6a, 0x25, 0x4b, 0xf3, 0xdb, 0xe5, 0xbc, 0xcc,
-	0x2d, 0x14, 0xd5, 0x73, 0x7f, 0xf6, 0xa5, 0x33, 0xaf, 0xa1, 0x57, 0x5d, 0x5b, 0xab, 0x7a, 0x5e,
-	0xdb, 0xc7, 0xd8, 0x7c, 0x29, 0x71, 0x61, 0xfc, 0xd7, 0x07, 0x12, 0x57, 0xf9, 0x85, 0x03, 0x53,
-	0xcb, 0x38, 0x58, 0x41, 0xc4, 0x87, 0x36, 0x28, 0xb4, 0x58, 0xed, 0x4e, 0xe8, 0x95, 0x39, 0x99,
-	0x5b, 0x18, 0x57, 0xcf, 0x6f, 0xf7, 0x25, 0x90, 0xb6, 0x44, 0xaf, 0xfd, 0xd6, 0x97, 0xb2, 0xa0,
-	0x17, 0x7d, 0x09, 0xd2, 0x52, 0x33, 0xc1, 0x8a, 0x09, 0xd2, 0x27, 0xdd, 0x83, 0x97, 0xc1, 0x44,
-	0x07, 0x91, 0x37, 0x59, 0x33, 0xe5, 0xc3, 0xf7, 0xc0, 0x24, 0x6a, 0x91, 0x10, 0xc5, 0xe5, 0x31,
-	0x99, 0x5b, 0x28, 0x2d, 0x49, 0xca, 0x1e, 0x63, 0xa2, 0x0c, 0x2a, 0x31, 0x12, 0x98, 0xc9, 0xe0,
-	0xac, 0xd2, 0xfb, 0x79, 0x00, 0x96, 0x71, 0x90, 0x76, 0xf3, 0xdf, 0x29, 0xd6, 0x00, 0xd3, 0x6c,
-	0xaf, 0xd1, 0x1b, 0x14, 0xbc, 0xa3, 0x01, 0x3f, 0x05, 0x93, 0x6e, 0x13, 0x6d, 0xc4, 0xa4, 0x3c,
-	0xf6, 0xea, 0xa9, 0x3b, 0x3b, 0x98, 0xba, 0x03, 0xcd, 0x16, 0x13, 0x65, 0xad, 0xb9, 0x06, 0x8a,
-	0xb6, 0x7f, 0xe7, 0xe5, 0xe0, 0xc3, 0x23, 0x60, 0x82, 0x84, 0x24, 0xf2, 0x93, 0xae, 0x4c, 0x9b,
-	0xf4, 0x01, 0xca, 0xa0, 0xe0, 0xf9, 0x78, 0xad, 0x1d, 0xd2, 0x4d, 0xc8, 0x27, 0xef, 0xb2, 0x21,
-	0xa6, 0xf6, 0x75, 0x1e, 0x4c, 0xa5, 0x5d, 0xd6, 0xf6, 0xea, 0xf2, 0x89, 0xe1, 0x2e, 0xff, 0x6f,
-	0xdb, 0xfa, 0xfd, 0x24, 0x28, 0x0e, 0x99, 0x89, 0xba, 0x57, 0x37, 0x8e, 0xed, 0x9a, 0xb9, 0x7c,
-	0x32, 0x6a, 0xd3, 0xcc, 0x42, 0x46, 0x5a, 0x71, 0x03, 0x4c, 0x62, 0xe2, 0x92, 0x0d, 0x9c, 0xf4,
-	0xa1, 0xb4, 0x74, 0x7c, 0xcf, 0x53, 0x90, 0xea, 0x59, 0x09, 0x54, 0x15, 0x76, 0x2c, 0xe9, 0xe5,
-	0x02, 0xa8, 0x4a, 0xc5, 0x64, 0x72, 0xf0, 0x73, 0x00, 0x6f, 0x85, 0xb1, 0x1b, 0x39, 0xc4, 0x8d,
-	0xa2, 0x4d, 0xa7, 0xed, 0xe3, 0x8d, 0x88, 0x24, 0x47, 0xad, 0xb0, 0x24, 0xef, 0x99, 0xc4, 0x1e,
-	0x00, 0xcd, 0x04, 0xa7, 0x1e, 0x63, 0xc6, 0xf7, 0x36, 0xcd, 0xb2, 0x5b, 0xa9, 0x62, 0xf2, 0x49,
-	0x30, 0x43, 0x82, 0x9f, 0x80, 0x02, 0x4e, 0x2c, 0xd7, 0x19, 0x18, 0x72, 0x79, 0x3c, 0xc9, 0x25,
-	0x28, 0xd4, 0xad, 0x95, 0xd4, 0xad, 0x15, 0x3b, 0x75, 0x6b, 0x55, 0x64, 0x59, 0xd8, 0xbc, 0x64,
-	0xc8, 0x95, 0x7b, 0x4f, 0x25, 0xce, 0x04, 0x34, 0x32, 0x20, 0xc0, 0x10, 0xf0, 0x6c, 0xbf, 0x1d,
-	0x3f, 0xf6, 0x68, 0x86, 0x89, 0x7d, 0x33, 0x1c, 0x67, 0x19, 0xe6, 0x69, 0x86, 0x51, 0x05, 0x9a,
-	0xa6, 0xc4, 0xc2, 0x5a, 0xec, 0x25, 0xa9, 0xbe, 0xe2, 0xc0, 0x0c, 0x41, 0x24, 0x73, 0x45, 0x4c,
-	0xbe, 0x7a, 0xaa, 0xae, 0xb0, 0x0c, 0x47, 0x68, 0x86, 0x21, 0xde, 0xc1, 0x2e, 0x88, 0x62, 0xc2,
-	0x4d, 0x8f, 0x5a, 0x04, 0x0e, 0x77, 0x10, 0x09, 0xe3, 0x60, 0xb0, 0xb3, 0x6d, 0xd6, 0xd2, 0xa9,
-	0x7d, 0x0b, 0x3e, 0xc1, 0x96, 0x53, 0xa6, 0xcb, 0xd9, 0x25, 0x41, 0x2b, 0x9e, 0xa5, 0x71, 0x6b,
-	0x10, 0x4e, 0x4a, 0xbe, 0x05, 0x58, 0x68, 0xa7, 0xb9, 0x87, 0xf6, 0xcd, 0x55, 0x19, 0xbe, 0x1d,
-	0x47, 0x04, 0x68, 0xa6, 0x19, 0x1a, 0x65, 0xad, 0xbd, 0x50, 0xbc, 0xff, 0x40, 0xe2, 0x1e, 0x3d,
-	0x90, 0xb8, 0xe4, 0x44, 0x6d, 0xe5, 0x41, 0x21, 0x3b, 0x40, 0x1f, 0x81, 0xb1, 0x4d, 0x1f, 0x53,
-	0x9b, 0x52, 0x95, 0x81, 0xfa, 0x4f, 0x7d, 0xe9, 0xe4, 0x6b, 0x34, 0x50, 0x8f, 0x89, 0x39, 0xa0,
-	0xc2, 0x2b, 0x60, 0xca, 0x5d, 0xc5, 0xc4, 0x0d, 0x99, 0xa1, 0x1d, 0x58, 0x25, 0xa5, 0xc3, 0x0f,
-	0x41, 0x3e, 0x46, 0xc9, 0x79, 0x39, 0xb8, 0x48, 0x3e, 0x46, 0x30, 0x00, 0xc5, 0x18, 0x39, 0x5f,
-	0x84, 0x64, 0xdd, 0xe9, 0xf8, 0x04, 0x25, 0xa7, 0x61, 0x5a, 0xd5, 0x0e, 0xa6, 0xf4, 0xa2, 0x2f,
-	0xcd, 0xd1, 0xe6, 0x66, 0xb5, 0x2a, 0x26, 0x88, 0xd1, 0x8d, 0x90, 0xac, 0xaf, 0xf8, 0x04, 0x31,
-	0x73, 0xfa, 0x91, 0x03, 0xe3, 0xc9, 0xad, 0xff, 0x0f, 0x59, 0xf4, 0x7f, 0xe4, 0x9a, 0x3f, 0xf5,
-	0x3b, 0x07, 0xc0, 0xce, 0x4b, 0x78, 0x1a, 0xcc, 0xaf, 0x18, 0xb6, 0xe6, 0x18, 0x0d, 0x5b, 0x37,
-	0xea, 0xce, 0xf5, 0xba, 0xd5, 0xd0, 0x2e, 0xea, 0x97, 0x74, 0xad, 0xc6, 0xe7, 0x84, 0xd9, 0x6e,
-	0x4f, 0x2e, 0x50, 0xa0, 0xd6, 0x6c, 0x91, 0x4d, 0x58, 0x01, 0xb3, 0x59, 0xf4, 0x4d, 0xcd, 0xe2,
-	0x39, 0x61, 0xa6, 0xdb, 0x93, 0xa7, 0x29, 0xea, 0xa6, 0x8f, 0xe1, 0x29, 0x30, 0x97, 0xc5, 0x54,
-	0x55, 0xcb, 0xae, 0xea, 0x75, 0x3e, 0x2f, 0x1c, 0xee, 0xf6, 0xe4, 0x19, 0x8a, 0xab, 0xb2, 0x99,
-	0x90, 0x41, 0x29, 0x8b, 0xad, 0x1b, 0xfc, 0x98, 0x50, 0xec, 0xf6, 0xe4, 0x43, 0x14, 0x56, 0x47,
-	0x70, 0x09, 0x94, 0x87, 0x11, 0xce, 0x0d, 0xdd, 0xbe, 0xe2, 0xac, 0x68, 0xb6, 0xc1, 0x8f, 0x0b,
-	0x47, 0xba, 0x3d, 0x99, 0x4f, 0xb1, 0xe9, 0x06, 0x0a, 0xc5, 0xbb, 0xdf, 0x88, 0xb9, 0x47, 0x0f,
-	0xc5, 0xdc, 0x77, 0x0f, 0xc5, 0xdc, 0xa9, 0x1f, 0xf2, 0xa0, 0x34, 0x6c, 0xf7, 0x50, 0x01, 0xef,
-	0x34, 0x4c, 0xa3, 0x61, 0x58, 0xd5, 0x6b, 0x8e, 0x65, 0x57, 0xed, 0xeb, 0xd6, 0x48, 0xe1, 0x49,
-	0x49, 0x14, 0x5c, 0x0f, 0x23, 0xf8, 0x01, 0x10, 0x47, 0xf1, 0x35, 0xad, 0x61, 0x58, 0xba, 0xed,
-	0x34, 0x34, 0x53, 0x37, 0x6a, 0x3c, 0x27, 0xcc, 0x77, 0x7b, 0xf2, 0x1c, 0xa5, 0x30, 0xc7, 0x69,
-	0xf8, 0xed, 0x10, 0x79, 0xf0, 0x7d, 0xf0, 0xee, 0x28, 0x79, 0xc5, 0xb0, 0xf5, 0xfa, 0xe5, 0x94,
-	0x9b, 0x17, 0x8e, 0x76, 0x7b, 0x32, 0xa4, 0xdc, 0x95, 0xe4, 0x74, 0x33, 0xea, 0x69, 0x70, 0x74,
-	0x94, 0xda, 0xa8, 0x5a, 0x96, 0x56, 0xe3, 0xc7, 0x04, 0xbe, 0xdb, 0x93, 0x8b, 0x94, 0xd3, 0x70,
-	0x31, 0xf6, 0x3d, 0x78, 0x16, 0x94, 0x47, 0xd1, 0xa6, 0x76, 0x55, 0xbb, 0x68, 0x6b, 0x35, 0x7e,
-	0x5c, 0x80, 0xdd, 0x9e, 0x5c, 0xa2, 0x78, 0xd3, 0xff, 0xcc, 0x5f, 0x23, 0xfe, 0x9e, 0xfa, 0x97,
-	0xaa, 0xfa, 0x35, 0xad, 0xc6, 0x4f, 0x64, 0xf5, 0x2f, 0xb9, 0x61, 0xe4, 0x7b, 0xc3, 0x6d, 0x55,
-	0xeb, 0x5b, 0xcf, 0xc4, 0xdc, 0x93, 0x67, 0x62, 0xee, 0xcb, 0x6d, 0x31, 0xb7, 0xb5, 0x2d, 0x72,
-	0x8f, 0xb7, 0x45, 0xee, 0xe7, 0x6d, 0x91, 0xbb, 0xf7, 0x5c, 0xcc, 0x3d, 0x7e, 0x2e, 0xe6, 0x9e,
-	0x3c, 0x17, 0x73, 0x1f, 0xff, 0xbd, 0x59, 0x67, 0xfe, 0xdf, 0xac, 0x4e, 0x26, 0x7e, 0x78, 0xfe,
-	0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc7, 0x53, 0x05, 0xd1, 0xf5, 0x0c, 0x00, 0x00,
+	// 1507 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xcd, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xf6, 0xda, 0xce, 0xd7, 0xd8, 0x71, 0xdc, 0x49, 0x7f, 0x8d, 0x7f, 0xae, 0xf0, 0x6e, 0xdd,
+	0xaa, 0x44, 0x55, 0xeb, 0xb4, 0xa9, 0x10, 0xa2, 0x08, 0x84, 0x1d, 0x6f, 0x9a, 0x6d, 0x13, 0xdb,
+	0x5a, 0x6f, 0x13, 0x15, 0x04, 0xab, 0x8d, 0x77, 0xeb, 0x0c, 0x5d, 0xef, 0x18, 0xcf, 0x24, 0x4d,
+	0x6e, 0xc0, 0x01, 0x55, 0x3e, 0xf5, 0x84, 0x2a, 0x21, 0x4b, 0x95, 0xe8, 0xa1, 0xf4, 0xc4, 0x05,
+	0xf1, 0x2f, 0xe4, 0xd8, 0x03, 0x87, 0x8a, 0x83, 0x4b, 0xd3, 0x03, 0x88, 0x03, 0x87, 0x1c, 0x39,
+	0xa1, 0xdd, 0x99, 0x4d, 0xd6, 0x4e, 0xfa, 0x91, 0x96, 0x0a, 0xc4, 0x25, 0xc9, 0xcc, 0x3e, 0xcf,
+	0xf3, 0xce, 0xfb, 0xcc, 0xc7, 0xfb, 0x2a, 0x60, 0x62, 0x7d, 0xaa, 0x8e, 0xd7, 0xa6, 0xe8, 0x46,
+	0xd3, 0x22, 0xec, 0x67, 0xae, 0xd9, 0xc2, 0x14, 0xc3, 0xf1, 0x1a, 0x26, 0x0d, 0x4c, 0x74, 0x62,
+	0x5e, 0xcf, 0xad, 0xe7, 0xea, 0x78, 0x2d, 0xb7, 0x76, 0x2e, 0x7d, 0x68, 0x0f, 0x2e, 0x7d, 0x92,
+	0xae, 0xa0, 0x96, 0xa9, 0x37, 0x8d, 0x16, 0xdd, 0x98, 0xf2, 0xa6, 0xa6, 0xea, 0xb8, 0x8e, 0x77,
+	0xff, 0xe2, 0x38, 0xb1, 0x8e, 0x71, 0xdd, 0xb6, 0x18, 0x64, 0x79, 0xf5, 0xda, 0x14, 0x45, 0x0d,
+	0x8b, 0x50, 0xa3, 0xd1, 0x64, 0x80, 0xec, 0x77, 0x11, 0xf0, 0xbf, 0x05, 0x52, 0xaf, 0xae, 0x2e,
+	0x37, 0x10, 0xad, 0xb4, 0x70, 0x13, 0x13, 0xc3, 0x2e, 0x18, 0xc4, 0x82, 0x37, 0x05, 0x30, 0x86,
+	0x1c, 0x44, 0x91, 0x61, 0xeb, 0xa6, 0xd5, 0xc4, 0x04, 0xd1, 0x94, 0x20, 0x45, 0x26, 0x63, 0xd3,
+	0xe3, 0xb9, 0xc0, 0x2a, 0xd7, 0xce, 0xe5, 0x66, 0x30, 0x72, 0x0a, 0x97, 0x36, 0xbb, 0x62, 0x68,
+	0xbb, 0x2b, 0x1e, 0xd9, 0x30, 0x1a, 0xf6, 0x85, 0x6c, 0x1f, 0x33, 0x7b, 0xff, 0x91, 0x38, 0x59,
+	0x47, 0x74, 0x65, 0x75, 0x39, 0x57, 0xc3, 0x8d, 0x29, 0x26, 0xc0, 0x7f, 0x9d, 0x21, 0xe6, 0x75,
+	0x9e, 0x9d, 0x2b, 0x45, 0xd4, 0x04, 0x67, 0x17, 0x19, 0x19, 0x2e, 0x80, 0xe1, 0xa6, 0xb7, 0x34,
+	0xab, 0x95, 0x0a, 0x4b, 0xc2, 0x64, 0xbc, 0x70, 0xee, 0xcf, 0xae, 0x78, 0xe6, 0x05, 0xf4, 0xf2,
+	0xb5, 0x5a, 0xde, 0x34, 0x5b, 0x16, 0x21, 0xea, 0x8e, 0x04, 0xbc, 0x00, 0xe2, 0x88, 0xe8, 0xd6,
+	0x7a, 0xd3, 0x32, 0x11, 0xb5, 0xcc, 0x54, 0x44, 0x12, 0x26, 0x87, 0x0b, 0x13, 0xdb, 0x5d, 0x71,
+	0x9c, 0x2f, 0x3e, 0xf0, 0x35, 0xab, 0xc6, 0x10, 0x91, 0xfd, 0x11, 0x7c, 0x0b, 0x44, 0xaf, 0x23,
+	0xc7, 0x4c, 0x45, 0x25, 0x61, 0x32, 0x31, 0x7d, 0x2c, 0xb7, 0xcf, 0x7e, 0xe5, 0x7c, 0x1b, 0x2f,
+	0x23, 0xc7, 0x54, 0x3d, 0x38, 0x7c, 0x0f, 0x8c, 0xe2, 0x26, 0x45, 0xd8, 0xd1, 0x6d, 0x63, 0xd9,
+	0xb2, 0x49, 0x6a, 0x40, 0x8a, 0x4c, 0x8e, 0x14, 0x52, 0xdb, 0x5d, 0xf1, 0x30, 0x8b, 0xd9, 0xf3,
+	0x39, 0xab, 0xc6, 0xd9, 0x78, 0xde, 0x1b, 0x5e, 0x88, 0xfe, 0x76, 0x47, 0x14, 0xb2, 0xbf, 0x0a,
+	0x60, 0x68, 0x81, 0xd4, 0x17, 0x31, 0xb5, 0xa0, 0x06, 0x62, 0x4d, 0x1e, 0x46, 0x47, 0x66, 0x4a,
+	0x90, 0x84, 0xc9, 0x68, 0xe1, 0xfc, 0x56, 0x57, 0x04, 0x7e, 0x74, 0xa5, 0xf8, 0x7b, 0x57, 0x0c,
+	0x82, 0xb6, 0xbb, 0x22, 0x64, 0xb1, 0x02, 0x93, 0x59, 0x15, 0xf8, 0x23, 0xc5, 0x84, 0x17, 0xc1,
+	0xc0, 0x1a, 0xa6, 0xaf, 0xe2, 0x32, 0xe3, 0xc3, 0xb7, 0xc1, 0x20, 0x4b, 0xc0, 0x33, 0x37, 0x31,
+	0x2d, 0xee, 0x6b, 0x94, 0x9b, 0x49, 0xd9, 0x83, 0xa9, 0x1c, 0xce, 0x33, 0xfd, 0x3a, 0x0c, 0xc6,
+	0x78, 0xa6, 0x4b, 0x16, 0xaa, 0xaf, 0xb8, 0xce, 0xff, 0xcb, 0x33, 0xfe, 0x04, 0x0c, 0xb1, 0x14,
+	0x48, 0x2a, 0xe2, 0xdd, 0x92, 0x37, 0xf7, 0x4d, 0xd9, 0x4f, 0x67, 0x37, 0xf5, 0xc2, 0x51, 0xf7,
+	0xe6, 0xdc, 0x7f, 0x24, 0x8e, 0xef, 0xfd, 0x46, 0x54, 0x5f, 0x94, 0x1b, 0x73, 0x3b, 0x0c, 0xc0,
+	0x02, 0xa9, 0xfb, 0x17, 0xe3, 0xf5, 0x78, 0x52, 0x06, 0x23, 0xfc, 0xda, 0xe2, 0x57, 0xf0, 0x65,
+	0x57, 0x03, 0x7e, 0x0c, 0x06, 0x8d, 0x06, 0x5e, 0x75, 0x68, 0x2a, 0xf2, 0xf4, 0x07, 0xe4, 0x2c,
+	0xb7, 0xe1, 0xc5, 0x9f, 0x09, 0x2e, 0xca, 0xad, 0x99, 0x07, 0x71, 0xcd, 0x5a, 0xdf, 0x79, 0xc3,
+	0xe0, 0x61, 0x30, 0x40, 0x11, 0xb5, 0x2d, 0xcf, 0x95, 0x11, 0x95, 0x0d, 0xa0, 0x04, 0x62, 0xa6,
+	0x45, 0x6a, 0x2d, 0xc4, 0x4e, 0x67, 0xd8, 0xfb, 0x16, 0x9c, 0xe2, 0x6a, 0x5f, 0x85, 0xc1, 0x90,
+	0xef, 0xb2, 0xbc, 0x9f, 0xcb, 0x27, 0x7a, 0x5d, 0xfe, 0xcf, 0xda, 0xfa, 0xc5, 0x30, 0x88, 0xf7,
+	0xd4, 0x85, 0xc2, 0x7e, 0x6e, 0x1c, 0xdb, 0x73, 0xe6, 0xc2, 0xde, 0x51, 0x1b, 0xe1, 0x0f, 0x6a,
+	0x9f, 0x15, 0x4b, 0x60, 0x90, 0x50, 0x83, 0xae, 0x12, 0xcf, 0x87, 0xc4, 0xf4, 0xf1, 0x67, 0xbe,
+	0xa3, 0x55, 0x0f, 0x5a, 0x48, 0xef, 0x56, 0x97, 0x9d, 0x05, 0x30, 0x95, 0xac, 0xca, 0xe5, 0xe0,
+	0x67, 0x00, 0x5e, 0x43, 0x8e, 0x61, 0xeb, 0xd4, 0xb0, 0xed, 0x0d, 0xbd, 0x65, 0x91, 0x55, 0x9b,
+	0x7a, 0x6f, 0x50, 0x6c, 0x5a, 0xda, 0x37, 0x88, 0xe6, 0x02, 0x55, 0x0f, 0x57, 0x38, 0xc6, 0x6b,
+	0xd8, 0xff, 0x59, 0x94, 0xbd, 0x4a, 0x59, 0x35, 0xe9, 0x4d, 0x06, 0x48, 0xf0, 0x23, 0x10, 0x23,
+	0x5e, 0xf5, 0xd4, 0xdd, 0xda, 0xea, 0x15, 0x86, 0xd8, 0x74, 0x3a, 0xc7, 0x0a, 0x6f, 0xce, 0x2f,
+	0xbc, 0x39, 0xcd, 0x2f, 0xbc, 0x85, 0x0c, 0x8f, 0xc2, 0xcf, 0x4b, 0x80, 0x9c, 0xbd, 0xf5, 0x48,
+	0x14, 0x54, 0xc0, 0x66, 0x5c, 0x02, 0x44, 0x20, 0xc9, 0xf7, 0x5b, 0xb7, 0x1c, 0x93, 0x45, 0x18,
+	0x78, 0x6e, 0x84, 0xe3, 0x3c, 0xc2, 0x04, 0x8b, 0xd0, 0xaf, 0xc0, 0xc2, 0x24, 0xf8, 0xb4, 0xec,
+	0x98, 0x5e, 0xa8, 0x2f, 0x05, 0x30, 0x4a, 0x31, 0x0d, 0x54, 0xfb, 0xc1, 0xa7, 0x9f, 0xaa, 0x39,
+	0x1e, 0x81, 0x17, 0xaf, 0x1e, 0xde, 0xc1, 0x6a, 0x7d, 0xdc, 0xe3, 0xfa, 0x57, 0xcd, 0x06, 0x87,
+	0xd6, 0x30, 0x45, 0x4e, 0xdd, 0xdd, 0xd9, 0x16, 0xb7, 0x74, 0xe8, 0xb9, 0x09, 0x9f, 0xe0, 0xcb,
+	0x49, 0xb1, 0xe5, 0xec, 0x91, 0x60, 0x19, 0x8f, 0xb1, 0xf9, 0xaa, 0x3b, 0xed, 0xa5, 0x7c, 0x0d,
+	0xf0, 0xa9, 0x5d, 0x73, 0x87, 0x9f, 0x1b, 0x2b, 0xdb, 0xdb, 0xe8, 0xf4, 0x09, 0xb0, 0x48, 0xa3,
+	0x6c, 0xd6, 0xb7, 0xb6, 0xbf, 0xe1, 0x18, 0x79, 0x89, 0x86, 0x03, 0xbc, 0x62, 0xc3, 0x11, 0x3b,
+	0x50, 0xc3, 0x11, 0xbf, 0x7d, 0x47, 0x14, 0xee, 0xdd, 0x11, 0x05, 0xef, 0x0d, 0xd8, 0x0c, 0x83,
+	0x58, 0xf0, 0xc8, 0x7f, 0x00, 0x22, 0x1b, 0x16, 0x61, 0x0f, 0x6b, 0x21, 0xe7, 0xfa, 0xf1, 0x73,
+	0x57, 0x3c, 0xf9, 0x02, 0x5b, 0xae, 0x38, 0x54, 0x75, 0xa9, 0x70, 0x0e, 0x0c, 0x19, 0xcb, 0x84,
+	0x1a, 0x88, 0x3f, 0xc1, 0x07, 0x56, 0xf1, 0xe9, 0xf0, 0x7d, 0x10, 0x76, 0x70, 0x2a, 0xf2, 0x52,
+	0x22, 0x61, 0x07, 0xc3, 0x3a, 0x88, 0x3b, 0x58, 0xbf, 0x81, 0xe8, 0x8a, 0xbe, 0x66, 0x51, 0xec,
+	0xdd, 0xdf, 0x91, 0x82, 0x7c, 0x30, 0xa5, 0xdd, 0x9d, 0x0c, 0x6a, 0x65, 0x55, 0xe0, 0xe0, 0x25,
+	0x44, 0x57, 0x16, 0x2d, 0x8a, 0xf9, 0x73, 0xfa, 0x8d, 0x00, 0xe0, 0xde, 0x3a, 0x1f, 0xe8, 0x97,
+	0x84, 0x03, 0xf5, 0x4b, 0x70, 0x16, 0x0c, 0xde, 0xf0, 0xe4, 0x5e, 0xc2, 0xc7, 0xa2, 0x55, 0x53,
+	0x39, 0x9b, 0xaf, 0xee, 0x87, 0x30, 0x88, 0x7a, 0xed, 0xe5, 0xdf, 0x54, 0xf2, 0xfe, 0xf1, 0x7e,
+	0x32, 0xd8, 0x96, 0x45, 0x5f, 0x5b, 0x5b, 0x76, 0xea, 0x0f, 0x01, 0x80, 0xc0, 0x6e, 0x9e, 0x06,
+	0x13, 0x8b, 0x65, 0x4d, 0xd6, 0xcb, 0x15, 0x4d, 0x29, 0x97, 0xf4, 0x2b, 0xa5, 0x6a, 0x45, 0x9e,
+	0x51, 0x66, 0x15, 0xb9, 0x98, 0x0c, 0xa5, 0xc7, 0xda, 0x1d, 0x29, 0xc6, 0x80, 0x72, 0xa3, 0x49,
+	0x37, 0x60, 0x16, 0x8c, 0x05, 0xd1, 0x57, 0xe5, 0x6a, 0x52, 0x48, 0x8f, 0xb6, 0x3b, 0xd2, 0x08,
+	0x43, 0x5d, 0xb5, 0x08, 0x3c, 0x05, 0xc6, 0x83, 0x98, 0x7c, 0xa1, 0xaa, 0xe5, 0x95, 0x52, 0x32,
+	0x9c, 0x3e, 0xd4, 0xee, 0x48, 0xa3, 0x0c, 0x97, 0xe7, 0x37, 0x42, 0x02, 0x89, 0x20, 0xb6, 0x54,
+	0x4e, 0x46, 0xd2, 0xf1, 0x76, 0x47, 0x1a, 0x66, 0xb0, 0x12, 0x86, 0xd3, 0x20, 0xd5, 0x8b, 0xd0,
+	0x97, 0x14, 0x6d, 0x4e, 0x5f, 0x94, 0xb5, 0x72, 0x32, 0x9a, 0x3e, 0xdc, 0xee, 0x48, 0x49, 0x1f,
+	0xeb, 0x1f, 0xdf, 0x74, 0xfc, 0xe6, 0xb7, 0x99, 0xd0, 0xbd, 0xbb, 0x99, 0xd0, 0xf7, 0x77, 0x33,
+	0xa1, 0x53, 0x3f, 0x0a, 0x20, 0x1e, 0x7c, 0x75, 0xe0, 0x69, 0x70, 0xa4, 0xa2, 0x96, 0x2b, 0xe5,
+	0x6a, 0x7e, 0x5e, 0xbf, 0xac, 0x94, 0x8a, 0x7a, 0x55, 0xcb, 0x97, 0x8a, 0x79, 0xd5, 0xcd, 0x38,
+	0xd9, 0xee, 0x48, 0x71, 0x17, 0x55, 0xa5, 0x86, 0x63, 0x1a, 0x2d, 0x13, 0xbe, 0x03, 0xde, 0xe8,
+	0x45, 0x2f, 0x5c, 0x99, 0xd7, 0x94, 0xca, 0xbc, 0xac, 0xcf, 0xcc, 0x95, 0x95, 0x19, 0x39, 0x29,
+	0xa4, 0x8f, 0xb4, 0x3b, 0x12, 0x74, 0x49, 0x0b, 0xab, 0x36, 0x45, 0x4d, 0xdb, 0x9a, 0x59, 0xc1,
+	0xa8, 0x66, 0xc1, 0xb3, 0x20, 0xd5, 0x4b, 0x75, 0x93, 0x58, 0x50, 0xaa, 0x9a, 0x32, 0x93, 0x0c,
+	0xa7, 0x61, 0xbb, 0x23, 0x25, 0x5c, 0x96, 0xbb, 0xfe, 0x06, 0x22, 0x14, 0xd5, 0xfa, 0x56, 0xfe,
+	0x53, 0x18, 0x24, 0x7a, 0x1b, 0x0b, 0x98, 0x03, 0x47, 0x77, 0x24, 0xab, 0x5a, 0x5e, 0xbb, 0x52,
+	0xed, 0xdb, 0x32, 0x6f, 0x33, 0x18, 0xb8, 0x84, 0x6c, 0xf8, 0x2e, 0xc8, 0xf4, 0xe3, 0x8b, 0x72,
+	0xa5, 0x5c, 0x55, 0x34, 0xbd, 0x22, 0xab, 0x4a, 0xb9, 0x98, 0x14, 0xd2, 0x13, 0xed, 0x8e, 0x34,
+	0xce, 0x28, 0xbc, 0xb6, 0x55, 0xac, 0x16, 0xc2, 0xbd, 0xa9, 0x73, 0xf2, 0x62, 0x59, 0x53, 0x4a,
+	0x17, 0x7d, 0x6e, 0x98, 0xa5, 0xce, 0xb8, 0x8b, 0x5e, 0x1d, 0xe1, 0xd4, 0xa0, 0xc7, 0x9c, 0x5a,
+	0xc9, 0x57, 0xab, 0x72, 0x31, 0x19, 0x61, 0x1e, 0x33, 0x4e, 0xc5, 0x20, 0xc4, 0x32, 0x7b, 0x8c,
+	0xe2, 0x68, 0x55, 0xbe, 0x24, 0xcf, 0x68, 0x72, 0x31, 0x19, 0x65, 0x46, 0x31, 0xbc, 0x6a, 0x7d,
+	0x6a, 0xd5, 0xa8, 0xb5, 0xaf, 0xfe, 0x6c, 0x5e, 0x99, 0x97, 0x8b, 0xc9, 0x81, 0xa0, 0xfe, 0xac,
+	0x81, 0x6c, 0xcb, 0xec, 0xb5, 0xb5, 0x50, 0xda, 0x7c, 0x9c, 0x09, 0x3d, 0x7c, 0x9c, 0x09, 0x7d,
+	0xbe, 0x95, 0x09, 0x6d, 0x6e, 0x65, 0x84, 0x07, 0x5b, 0x19, 0xe1, 0x97, 0xad, 0x8c, 0x70, 0xeb,
+	0x49, 0x26, 0xf4, 0xe0, 0x49, 0x26, 0xf4, 0xf0, 0x49, 0x26, 0xf4, 0xe1, 0xb3, 0xdb, 0x82, 0xc0,
+	0x3f, 0x45, 0x96, 0x07, 0xbd, 0xca, 0x7b, 0xfe, 0xaf, 0x01, 0x00, 0x50, 0xa0, 0xe3, 0x60, 0x2a,
+	0x11, 0x00, 0x00,
 }
 
 func (this *MsgSubmitProposalBase) Equal(that interface{}) bool {
@@ -560,6 +705,20 @@ func (this *MsgSubmitProposalBase) Equal(that interface{}) bool {
 	if !bytes.Equal(this.Proposer, that1.Proposer) {
 		return false
 	}
+	if this.IsExpedited != that1.IsExpedited {
+		return false
+	}
+	if this.Kind != that1.Kind {
+		return false
+	}
+	if len(this.OptionLabels) != len(that1.OptionLabels) {
+		return false
+	}
+	for i := range this.OptionLabels {
+		if this.OptionLabels[i] != that1.OptionLabels[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *MsgVote) Equal(that interface{}) bool {
@@ -592,6 +751,41 @@ func (this *MsgVote) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgVoteWeighted) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgVoteWeighted)
+	if !ok {
+		that2, ok := that.(MsgVoteWeighted)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ProposalID != that1.ProposalID {
+		return false
+	}
+	if !bytes.Equal(this.Voter, that1.Voter) {
+		return false
+	}
+	if len(this.Options) != len(that1.Options) {
+		return false
+	}
+	for i := range this.Options {
+		if !this.Options[i].Equal(&that1.Options[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *MsgDeposit) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -737,6 +931,20 @@ func (this *ProposalBase) Equal(that interface{}) bool {
 	if !this.VotingEndTime.Equal(that1.VotingEndTime) {
 		return false
 	}
+	if this.IsExpedited != that1.IsExpedited {
+		return false
+	}
+	if this.Kind != that1.Kind {
+		return false
+	}
+	if len(this.OptionLabels) != len(that1.OptionLabels) {
+		return false
+	}
+	for i := range this.OptionLabels {
+		if this.OptionLabels[i] != that1.OptionLabels[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *TallyResult) Equal(that interface{}) bool {
@@ -772,6 +980,33 @@ func (this *TallyResult) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *WeightedVoteOption) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WeightedVoteOption)
+	if !ok {
+		that2, ok := that.(WeightedVoteOption)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Option != that1.Option {
+		return false
+	}
+	if !this.Weight.Equal(that1.Weight) {
+		return false
+	}
+	return true
+}
 func (this *Vote) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -800,6 +1035,14 @@ func (this *Vote) Equal(that interface{}) bool {
 	if this.Option != that1.Option {
 		return false
 	}
+	if len(this.Options) != len(that1.Options) {
+		return false
+	}
+	for i := range this.Options {
+		if !this.Options[i].Equal(&that1.Options[i]) {
+			return false
+		}
+	}
 	return true
 }
 
@@ -813,6 +1056,9 @@ type ProposalBaseFace interface {
 	GetTotalDeposit() github_com_cosmos_cosmos_sdk_types.Coins
 	GetVotingStartTime() time.Time
 	GetVotingEndTime() time.Time
+	GetIsExpedited() bool
+	GetKind() ProposalKind
+	GetOptionLabels() []string
 }
 
 func (this *ProposalBase) Proto() github_com_gogo_protobuf_proto.Message {
@@ -855,6 +1101,18 @@ func (this *ProposalBase) GetVotingEndTime() time.Time {
 	return this.VotingEndTime
 }
 
+func (this *ProposalBase) GetIsExpedited() bool {
+	return this.IsExpedited
+}
+
+func (this *ProposalBase) GetKind() ProposalKind {
+	return this.Kind
+}
+
+func (this *ProposalBase) GetOptionLabels() []string {
+	return this.OptionLabels
+}
+
 func NewProposalBaseFromFace(that ProposalBaseFace) *ProposalBase {
 	this := &ProposalBase{}
 	this.ProposalID = that.GetProposalID()
@@ -865,6 +1123,9 @@ func NewProposalBaseFromFace(that ProposalBaseFace) *ProposalBase {
 	this.TotalDeposit = that.GetTotalDeposit()
 	this.VotingStartTime = that.GetVotingStartTime()
 	this.VotingEndTime = that.GetVotingEndTime()
+	this.IsExpedited = that.GetIsExpedited()
+	this.Kind = that.GetKind()
+	this.OptionLabels = that.GetOptionLabels()
 	return this
 }
 
@@ -888,6 +1149,30 @@ func (m *MsgSubmitProposalBase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OptionLabels) > 0 {
+		for iNdEx := len(m.OptionLabels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OptionLabels[iNdEx])
+			copy(dAtA[i:], m.OptionLabels[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.OptionLabels[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Kind != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IsExpedited {
+		i--
+		if m.IsExpedited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Proposer) > 0 {
 		i -= len(m.Proposer)
 		copy(dAtA[i:], m.Proposer)
@@ -952,6 +1237,55 @@ func (m *MsgVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgVoteWeighted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteWeighted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteWeighted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ProposalID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgDeposit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1107,6 +1441,30 @@ func (m *ProposalBase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OptionLabels) > 0 {
+		for iNdEx := len(m.OptionLabels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OptionLabels[iNdEx])
+			copy(dAtA[i:], m.OptionLabels[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.OptionLabels[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.Kind != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.IsExpedited {
+		i--
+		if m.IsExpedited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
 	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime):])
 	if err1 != nil {
 		return 0, err1
@@ -1239,6 +1597,44 @@ func (m *TallyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedVoteOption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedVoteOption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Option != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Option))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Vote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1259,6 +1655,20 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.Option != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.Option))
 		i--
@@ -1306,6 +1716,18 @@ func (m *MsgSubmitProposalBase) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.IsExpedited {
+		n += 2
+	}
+	if m.Kind != 0 {
+		n += 1 + sovTypes(uint64(m.Kind))
+	}
+	if len(m.OptionLabels) > 0 {
+		for _, s := range m.OptionLabels {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1328,6 +1750,28 @@ func (m *MsgVote) Size() (n int) {
 	return n
 }
 
+func (m *MsgVoteWeighted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalID != 0 {
+		n += 1 + sovTypes(uint64(m.ProposalID))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *MsgDeposit) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1417,6 +1861,18 @@ func (m *ProposalBase) Size() (n int) {
 	n += 1 + l + sovTypes(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime)
 	n += 1 + l + sovTypes(uint64(l))
+	if m.IsExpedited {
+		n += 2
+	}
+	if m.Kind != 0 {
+		n += 1 + sovTypes(uint64(m.Kind))
+	}
+	if len(m.OptionLabels) > 0 {
+		for _, s := range m.OptionLabels {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1437,6 +1893,20 @@ func (m *TallyResult) Size() (n int) {
 	return n
 }
 
+func (m *WeightedVoteOption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Option != 0 {
+		n += 1 + sovTypes(uint64(m.Option))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
 func (m *Vote) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1453,6 +1923,12 @@ func (m *Vote) Size() (n int) {
 	if m.Option != 0 {
 		n += 1 + sovTypes(uint64(m.Option))
 	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1493,9 +1969,220 @@ func (m *MsgSubmitProposalBase) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitialDeposit = append(m.InitialDeposit, types.Coin{})
+			if err := m.InitialDeposit[len(m.InitialDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = append(m.Proposer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proposer == nil {
+				m.Proposer = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsExpedited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsExpedited = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= ProposalKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionLabels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionLabels = append(m.OptionLabels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+			}
+			m.ProposalID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1505,31 +2192,31 @@ func (m *MsgSubmitProposalBase) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InitialDeposit = append(m.InitialDeposit, types.Coin{})
-			if err := m.InitialDeposit[len(m.InitialDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Voter = append(m.Voter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Voter == nil {
+				m.Voter = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
 			}
-			var byteLen int
+			m.Option = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1539,26 +2226,11 @@ func (m *MsgSubmitProposalBase) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Option |= VoteOption(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Proposer = append(m.Proposer[:0], dAtA[iNdEx:postIndex]...)
-			if m.Proposer == nil {
-				m.Proposer = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1583,7 +2255,7 @@ func (m *MsgSubmitProposalBase) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVote) Unmarshal(dAtA []byte) error {
+func (m *MsgVoteWeighted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1606,10 +2278,10 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVote: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVoteWeighted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVoteWeighted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1666,10 +2338,10 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
-			m.Option = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1679,11 +2351,26 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Option |= VoteOption(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2371,6 +3058,77 @@ func (m *ProposalBase) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsExpedited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsExpedited = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= ProposalKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionLabels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionLabels = append(m.OptionLabels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2584,6 +3342,112 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedVoteOption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedVoteOption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Vote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2685,6 +3549,40 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3,15 +3,34 @@ package types
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"gopkg.in/yaml.v2"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-// NewVote creates a new Vote instance
-func NewVote(proposalID uint64, voter sdk.AccAddress, option VoteOption) Vote {
-	return Vote{proposalID, voter, option}
+// NewVote creates a new Vote instance. The option of the vote is only set for
+// a vote cast for a single option.
+func NewVote(proposalID uint64, voter sdk.AccAddress, options WeightedVoteOptions) Vote {
+	option := OptionEmpty
+	if len(options) == 1 && options[0].Weight.Equal(sdk.OneDec()) {
+		option = options[0].Option
+	}
+
+	return Vote{proposalID, voter, option, options}
+}
+
+// WeightedOptions returns the weighted options of the vote. The votes stored
+// before the weighted votes only have an option, which is given the full
+// weight.
+func (v Vote) WeightedOptions() WeightedVoteOptions {
+	if len(v.Options) == 0 && v.Option != OptionEmpty {
+		return NewNonSplitVoteOption(v.Option)
+	}
+
+	return v.Options
 }
 
 func (v Vote) String() string {
@@ -43,7 +62,7 @@ func (v Votes) String() string {
 	}
 	out := fmt.Sprintf("Votes for Proposal %d:", v[0].ProposalID)
 	for _, vot := range v {
-		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.Option)
+		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.WeightedOptions())
 	}
 	return out
 }
@@ -53,6 +72,91 @@ func (v Vote) Empty() bool {
 	return v.Equal(Vote{})
 }
 
+// NewWeightedVoteOption creates a new WeightedVoteOption instance
+func NewWeightedVoteOption(option VoteOption, weight sdk.Dec) WeightedVoteOption {
+	return WeightedVoteOption{option, weight}
+}
+
+// NewNonSplitVoteOption returns the weighted vote options of a vote cast for a
+// single option.
+func NewNonSplitVoteOption(option VoteOption) WeightedVoteOptions {
+	return WeightedVoteOptions{NewWeightedVoteOption(option, sdk.OneDec())}
+}
+
+func (w WeightedVoteOption) String() string {
+	return fmt.Sprintf("%s=%s", w.Option, w.Weight)
+}
+
+// WeightedVoteOptions is a collection of WeightedVoteOption objects
+type WeightedVoteOptions []WeightedVoteOption
+
+func (v WeightedVoteOptions) String() string {
+	options := make([]string, len(v))
+	for i, option := range v {
+		options[i] = option.String()
+	}
+
+	return strings.Join(options, ",")
+}
+
+// Validate returns an error if any option is invalid, is repeated or doesn't
+// have a weight in (0, 1], or if the weights don't sum to one.
+func (v WeightedVoteOptions) Validate() error {
+	if len(v) == 0 {
+		return sdkerrors.Wrap(ErrInvalidVote, "no vote option")
+	}
+
+	usedOptions := make(map[VoteOption]bool)
+	totalWeight := sdk.ZeroDec()
+	for _, option := range v {
+		if !ValidWeightedVoteOption(option) {
+			return sdkerrors.Wrap(ErrInvalidVote, option.String())
+		}
+
+		if usedOptions[option.Option] {
+			return sdkerrors.Wrapf(ErrInvalidVote, "duplicated vote option %s", option.Option)
+		}
+
+		usedOptions[option.Option] = true
+		totalWeight = totalWeight.Add(option.Weight)
+	}
+
+	if !totalWeight.Equal(sdk.OneDec()) {
+		return sdkerrors.Wrapf(ErrInvalidVote, "total weight of the vote options is not one: %s", totalWeight)
+	}
+
+	return nil
+}
+
+// WeightedVoteOptionsFromString returns the WeightedVoteOptions from a string
+// of comma separated {option}={weight} values, e.g. "Yes=0.6,No=0.4". A single
+// option without a weight is given the full weight. It returns an error if the
+// string is invalid.
+func WeightedVoteOptionsFromString(str string) (WeightedVoteOptions, error) {
+	var options WeightedVoteOptions
+
+	for _, optionStr := range strings.Split(str, ",") {
+		fields := strings.Split(optionStr, "=")
+
+		option, err := VoteOptionFromString(fields[0])
+		if err != nil {
+			return nil, err
+		}
+
+		weight := sdk.OneDec()
+		if len(fields) > 1 {
+			weight, err = sdk.NewDecFromStr(fields[1])
+			if err != nil {
+				return nil, fmt.Errorf("'%s' is not a valid vote option weight: %w", fields[1], err)
+			}
+		}
+
+		options = append(options, NewWeightedVoteOption(option, weight))
+	}
+
+	return options, nil
+}
+
 // VoteOptionFromString returns a VoteOption from a string. It returns an error
 // if the string is invalid.
 func VoteOptionFromString(str string) (VoteOption, error) {
@@ -85,6 +189,16 @@ func ValidVoteOption(option VoteOption) bool {
 	return false
 }
 
+// ValidWeightedVoteOption returns true if the vote option is valid and its
+// weight is in (0, 1], and false otherwise.
+func ValidWeightedVoteOption(option WeightedVoteOption) bool {
+	if option.Weight.IsNil() || !option.Weight.IsPositive() || option.Weight.GT(sdk.OneDec()) {
+		return false
+	}
+
+	return ValidVoteOption(option.Option)
+}
+
 // Marshal needed for protobuf compatibility.
 func (vo VoteOption) Marshal() ([]byte, error) {
 	return []byte{byte(vo)}, nil
@@ -1,13 +1,27 @@
 package client
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
 	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/keeper"
 )
 
-// BeginBlocker updates an existing localhost client with the latest block height.
+// BeginBlocker stores the upgraded consensus state at the last block before a
+// scheduled IBC chain upgrade and updates an existing localhost client with
+// the latest block height.
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	plan, found := k.GetUpgradePlan(ctx)
+	if found && len(plan.UpgradedClientState) > 0 && plan.Height-1 == ctx.BlockHeight() {
+		// the validator set of the last block committed before the upgrade is
+		// the trusted validator set of the upgraded chain, which the
+		// counterparty chains cannot upgrade their clients without
+		if err := k.SetUpgradedConsensusState(ctx, plan.Height); err != nil {
+			panic(fmt.Errorf("failed to set the upgraded consensus state: %w", err))
+		}
+	}
+
 	localhostClient, found := k.GetClientState(ctx, exported.ClientTypeLocalHost)
 	if !found {
 		return
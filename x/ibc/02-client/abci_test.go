@@ -5,13 +5,17 @@ import (
 
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	client "github.com/cosmos/cosmos-sdk/x/ibc/02-client"
 	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
 	localhosttypes "github.com/cosmos/cosmos-sdk/x/ibc/09-localhost/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
 type ClientTestSuite struct {
@@ -58,3 +62,47 @@ func (suite *ClientTestSuite) TestBeginBlocker() {
 		suite.Require().Equal(prevHeight+1, localHostClient.GetLatestHeight())
 	}
 }
+
+func (suite *ClientTestSuite) TestBeginBlockerUpgradedConsensusState() {
+	pk, err := tmtypes.NewMockPV().GetPubKey()
+	suite.Require().NoError(err)
+	val := staking.NewValidator(sdk.ValAddress(pk.Address()), pk, staking.Description{})
+	val.Status = sdk.Bonded
+	val.Tokens = sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	suite.app.StakingKeeper.SetHistoricalInfo(
+		suite.ctx, suite.ctx.BlockHeight(), staking.NewHistoricalInfo(suite.ctx.BlockHeader(), staking.Validators{val}),
+	)
+
+	planHeight := suite.ctx.BlockHeight() + 1
+
+	// a plan without an upgraded client state doesn't store a consensus state
+	plan := upgradetypes.Plan{Name: "test", Height: planHeight}
+	suite.Require().NoError(suite.app.UpgradeKeeper.ScheduleUpgrade(suite.ctx, plan))
+
+	client.BeginBlocker(suite.ctx, suite.app.IBCKeeper.ClientKeeper)
+	_, found := suite.app.UpgradeKeeper.GetUpgradedConsensusState(suite.ctx, planHeight)
+	suite.Require().False(found)
+
+	// the consensus state is stored at the last block before the upgrade
+	plan.UpgradedClientState = []byte("upgraded client state")
+	suite.Require().NoError(suite.app.UpgradeKeeper.ScheduleUpgrade(suite.ctx, plan))
+
+	client.BeginBlocker(suite.ctx, suite.app.IBCKeeper.ClientKeeper)
+	bz, found := suite.app.UpgradeKeeper.GetUpgradedConsensusState(suite.ctx, planHeight)
+	suite.Require().True(found)
+
+	var consensusState exported.ConsensusState
+	suite.cdc.MustUnmarshalBinaryBare(bz, &consensusState)
+	suite.Require().Equal(uint64(planHeight), consensusState.GetHeight())
+	suite.Require().Equal([]byte(ibctmtypes.SentinelRoot), consensusState.GetRoot().GetHash())
+
+	tmConsensusState, ok := consensusState.(ibctmtypes.ConsensusState)
+	suite.Require().True(ok)
+	suite.Require().Equal(1, tmConsensusState.ValidatorSet.Size())
+
+	// the chain halts when the consensus state can't be stored
+	suite.app.StakingKeeper.DeleteHistoricalInfo(suite.ctx, suite.ctx.BlockHeight())
+	suite.Require().Panics(func() {
+		client.BeginBlocker(suite.ctx, suite.app.IBCKeeper.ClientKeeper)
+	})
+}
@@ -52,6 +52,7 @@ var (
 type (
 	Keeper                = keeper.Keeper
 	StakingKeeper         = types.StakingKeeper
+	UpgradeKeeper         = types.UpgradeKeeper
 	GenesisState          = types.GenesisState
 	ClientConsensusStates = types.ClientConsensusStates
 )
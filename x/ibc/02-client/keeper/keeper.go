@@ -10,12 +10,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
 	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
 	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
 	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
 	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
 // Keeper represents a type that grants read and write permissions to any client
@@ -24,14 +26,16 @@ type Keeper struct {
 	storeKey      sdk.StoreKey
 	cdc           *codec.Codec
 	stakingKeeper types.StakingKeeper
+	upgradeKeeper types.UpgradeKeeper
 }
 
 // NewKeeper creates a new NewKeeper instance
-func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, sk types.StakingKeeper) Keeper {
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, sk types.StakingKeeper, uk types.UpgradeKeeper) Keeper {
 	return Keeper{
 		storeKey:      key,
 		cdc:           cdc,
 		stakingKeeper: sk,
+		upgradeKeeper: uk,
 	}
 }
 
@@ -201,11 +205,41 @@ func (k Keeper) GetSelfConsensusState(ctx sdk.Context, height uint64) (exported.
 		Height:       height,
 		Timestamp:    histInfo.Header.Time,
 		Root:         commitmenttypes.NewMerkleRoot(histInfo.Header.AppHash),
-		ValidatorSet: tmtypes.NewValidatorSet(valSet.ToTmValidators()),
+		ValidatorSet: tmtypes.NewValidatorSet(valSet.ToTmValidators(k.stakingKeeper.PowerReduction(ctx))),
 	}
 	return consensusState, true
 }
 
+// GetUpgradePlan returns the currently scheduled upgrade plan, if any.
+func (k Keeper) GetUpgradePlan(ctx sdk.Context) (upgradetypes.Plan, bool) {
+	return k.upgradeKeeper.GetUpgradePlan(ctx)
+}
+
+// SetUpgradedConsensusState stores the consensus state the clients of this
+// chain can be upgraded with once the chain halts at the given plan height. It
+// commits to the current validator set, which is the last trusted validator
+// set of the chain before the upgrade, and to a sentinel commitment root since
+// the root of the upgraded chain is not known in advance.
+func (k Keeper) SetUpgradedConsensusState(ctx sdk.Context, planHeight int64) error {
+	histInfo, found := k.stakingKeeper.GetHistoricalInfo(ctx, ctx.BlockHeight())
+	if !found {
+		return sdkerrors.Wrapf(types.ErrSelfConsensusStateNotFound, "height %d", ctx.BlockHeight())
+	}
+
+	valSet := stakingtypes.Validators(histInfo.Valset)
+
+	var consensusState exported.ConsensusState = ibctmtypes.ConsensusState{
+		Height:       uint64(planHeight),
+		Timestamp:    ctx.BlockTime(),
+		Root:         commitmenttypes.NewMerkleRoot([]byte(ibctmtypes.SentinelRoot)),
+		ValidatorSet: tmtypes.NewValidatorSet(valSet.ToTmValidators(k.stakingKeeper.PowerReduction(ctx))),
+	}
+
+	bz := k.cdc.MustMarshalBinaryBare(consensusState)
+	k.upgradeKeeper.SetUpgradedConsensusState(ctx, planHeight, bz)
+	return nil
+}
+
 // IterateClients provides an iterator over all stored light client State
 // objects. For each State object, cb will be called. If the cb returns true,
 // the iterator will close and stop.
@@ -11,6 +11,10 @@ import (
 	commitmentexported "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/exported"
 )
 
+// SentinelRoot is the commitment root of the consensus states stored for the
+// clients of an upgraded chain, whose root is not known before the upgrade.
+const SentinelRoot = "sentinel_root"
+
 // ConsensusState defines a Tendermint consensus state
 type ConsensusState struct {
 	Timestamp    time.Time               `json:"timestamp" yaml:"timestamp"`
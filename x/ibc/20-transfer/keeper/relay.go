@@ -29,6 +29,10 @@ func (k Keeper) SendTransfer(
 	sender sdk.AccAddress,
 	receiver string,
 ) error {
+	if err := k.bankKeeper.IsSendEnabledCoins(ctx, amount...); err != nil {
+		return err
+	}
+
 	sourceChannelEnd, found := k.channelKeeper.GetChannel(ctx, sourcePort, sourceChannel)
 	if !found {
 		return sdkerrors.Wrap(channel.ErrChannelNotFound, sourceChannel)
@@ -157,6 +161,10 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channel.Packet, data types.
 		return err
 	}
 
+	if k.bankKeeper.BlockedAddr(receiver) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", receiver)
+	}
+
 	if source {
 
 		// mint new tokens if the source of the transfer is the same chain
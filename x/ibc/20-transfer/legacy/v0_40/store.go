@@ -0,0 +1,46 @@
+package v040
+
+import (
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+)
+
+// GetEscrowAddress returns the escrow address of the specified channel as it
+// was derived in v0.39, i.e. before the ADR 028 module address derivation.
+func GetEscrowAddress(portID, channelID string) sdk.AccAddress {
+	return sdk.AccAddress(crypto.AddressHash([]byte(portID + channelID)))
+}
+
+// MigrateStore performs an in-place store migration of the ICS20 transfer
+// state of a chain upgrading from v0.39. The migration includes:
+//
+// - Moving the escrowed balance of each channel of the transfer port from its
+// v0.39 escrow address to its ADR 028 escrow address.
+//
+// It is meant to be called from an x/upgrade handler. The portID must be the
+// port the transfer module is bound to.
+func MigrateStore(
+	ctx sdk.Context, portID string, channelKeeper types.ChannelKeeper, bankKeeper types.BankKeeper,
+) error {
+	for _, channel := range channelKeeper.GetAllChannels(ctx) {
+		if channel.PortID != portID {
+			continue
+		}
+
+		legacyEscrow := GetEscrowAddress(channel.PortID, channel.ID)
+
+		balances := bankKeeper.GetAllBalances(ctx, legacyEscrow)
+		if balances.IsZero() {
+			continue
+		}
+
+		escrow := types.GetEscrowAddress(channel.PortID, channel.ID)
+		if err := bankKeeper.SendCoins(ctx, legacyEscrow, escrow, balances); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package v040_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channelexported "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/exported"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	v040transfer "github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/legacy/v0_40"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	// a channel of the transfer port and a channel of another port, each with
+	// an escrowed balance at its v0.39 escrow address
+	channel := channeltypes.NewChannel(
+		channelexported.OPEN, channelexported.UNORDERED,
+		channeltypes.NewCounterparty("counterpartyport", "counterpartychannel"), []string{"connection"}, "ics20-1",
+	)
+	app.IBCKeeper.ChannelKeeper.SetChannel(ctx, types.PortID, "channel", channel)
+	app.IBCKeeper.ChannelKeeper.SetChannel(ctx, "otherport", "channel", channel)
+
+	escrowed := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+	legacyEscrow := v040transfer.GetEscrowAddress(types.PortID, "channel")
+	otherEscrow := v040transfer.GetEscrowAddress("otherport", "channel")
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, legacyEscrow, escrowed))
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, otherEscrow, escrowed))
+
+	require.NoError(t, v040transfer.MigrateStore(ctx, types.PortID, app.IBCKeeper.ChannelKeeper, app.BankKeeper))
+
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, legacyEscrow).IsZero())
+	require.Equal(t, escrowed, app.BankKeeper.GetAllBalances(ctx, types.GetEscrowAddress(types.PortID, "channel")))
+
+	// the escrowed balances of the channels of other ports are left untouched
+	require.Equal(t, escrowed, app.BankKeeper.GetAllBalances(ctx, otherEscrow))
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, types.GetEscrowAddress("otherport", "channel")).IsZero())
+}
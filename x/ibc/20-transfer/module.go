@@ -22,14 +22,16 @@ import (
 	porttypes "github.com/cosmos/cosmos-sdk/x/ibc/05-port/types"
 	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/client/rest"
+	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/keeper"
 	"github.com/cosmos/cosmos-sdk/x/ibc/20-transfer/types"
 	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
 )
 
 var (
-	_ module.AppModule      = AppModule{}
-	_ port.IBCModule        = AppModule{}
-	_ module.AppModuleBasic = AppModuleBasic{}
+	_ module.AppModule           = AppModule{}
+	_ port.IBCModule             = AppModule{}
+	_ module.AppModuleBasic      = AppModuleBasic{}
+	_ module.AppModuleMigrations = AppModule{}
 )
 
 // AppModuleBasic is the 20-transfer appmodulebasic
@@ -89,6 +91,16 @@ func (AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
 	// TODO
 }
 
+// ConsensusVersion implements the AppModuleMigrations interface
+func (AppModule) ConsensusVersion() uint64 { return 2 }
+
+// RegisterMigrations implements the AppModuleMigrations interface
+func (am AppModule) RegisterMigrations(configurator module.Configurator) {
+	if err := configurator.RegisterMigration(ModuleName, 1, keeper.NewMigrator(am.keeper).Migrate1to2); err != nil {
+		panic(err)
+	}
+}
+
 // Route implements the AppModule interface
 func (AppModule) Route() string {
 	return RouterKey
@@ -3,9 +3,8 @@ package types
 import (
 	"fmt"
 
-	"github.com/tendermint/tendermint/crypto"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	ibctypes "github.com/cosmos/cosmos-sdk/x/ibc/types"
 )
 
@@ -33,13 +32,12 @@ const (
 	QuerierRoute = ModuleName
 )
 
-// GetEscrowAddress returns the escrow address for the specified channel
-//
-// CONTRACT: this assumes that there's only one bank bridge module that owns the
-// port associated with the channel ID so that the address created is actually
-// unique.
+// GetEscrowAddress returns the escrow address for the specified channel. It is
+// derived as a sub-account of the transfer module account from the port and
+// channel IDs, so that it cannot collide with the escrow address of any other
+// channel or with any other account.
 func GetEscrowAddress(portID, channelID string) sdk.AccAddress {
-	return sdk.AccAddress(crypto.AddressHash([]byte(portID + channelID)))
+	return sdk.AccAddress(address.Module(ModuleName, []byte(portID), []byte(channelID)))
 }
 
 // GetDenomPrefix returns the receiving denomination prefix
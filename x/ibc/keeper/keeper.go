@@ -21,9 +21,10 @@ type Keeper struct {
 
 // NewKeeper creates a new ibc Keeper
 func NewKeeper(
-	cdc *codec.Codec, key sdk.StoreKey, stakingKeeper client.StakingKeeper, scopedKeeper capability.ScopedKeeper,
+	cdc *codec.Codec, key sdk.StoreKey, stakingKeeper client.StakingKeeper, upgradeKeeper client.UpgradeKeeper,
+	scopedKeeper capability.ScopedKeeper,
 ) *Keeper {
-	clientKeeper := client.NewKeeper(cdc, key, stakingKeeper)
+	clientKeeper := client.NewKeeper(cdc, key, stakingKeeper, upgradeKeeper)
 	connectionKeeper := connection.NewKeeper(cdc, key, clientKeeper)
 	portKeeper := port.NewKeeper(scopedKeeper)
 	channelKeeper := channel.NewKeeper(cdc, key, clientKeeper, connectionKeeper, portKeeper, scopedKeeper)
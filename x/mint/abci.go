@@ -5,8 +5,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
-// BeginBlocker mints new tokens for the previous block.
-func BeginBlocker(ctx sdk.Context, k Keeper) {
+// BeginBlocker mints new tokens for the previous block, with the inflation rate
+// calculated by the provided InflationCalculationFn.
+func BeginBlocker(ctx sdk.Context, k Keeper, ic types.InflationCalculationFn) {
 	// fetch stored minter & params
 	minter := k.GetMinter(ctx)
 	params := k.GetParams(ctx)
@@ -14,7 +15,7 @@ func BeginBlocker(ctx sdk.Context, k Keeper) {
 	// recalculate inflation rate
 	totalStakingSupply := k.StakingTokenSupply(ctx)
 	bondedRatio := k.BondedRatio(ctx)
-	minter.Inflation = minter.NextInflationRate(params, bondedRatio)
+	minter.Inflation = ic(ctx, minter, params, bondedRatio)
 	minter.AnnualProvisions = minter.NextAnnualProvisions(params, totalStakingSupply)
 	k.SetMinter(ctx, minter)
 
@@ -32,6 +32,8 @@ var (
 	NewParams            = types.NewParams
 	DefaultParams        = types.DefaultParams
 
+	DefaultInflationCalculationFn = types.DefaultInflationCalculationFn
+
 	// variable aliases
 	ModuleCdc              = types.ModuleCdc
 	MinterKey              = types.MinterKey
@@ -48,4 +50,6 @@ type (
 	GenesisState = types.GenesisState
 	Minter       = types.Minter
 	Params       = types.Params
+
+	InflationCalculationFn = types.InflationCalculationFn
 )
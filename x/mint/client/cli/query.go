@@ -1,15 +1,12 @@
 package cli
 
 import (
-	"fmt"
-
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
-	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
@@ -44,18 +41,12 @@ func GetCmdQueryParams(cdc *codec.Codec) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParameters)
-			res, _, err := cliCtx.QueryWithData(route, nil)
-			if err != nil {
+			var res types.QueryParamsResponse
+			if _, err := cliCtx.QueryGRPC(types.QueryParamsMethod, &types.QueryParamsRequest{}, &res); err != nil {
 				return err
 			}
 
-			var params types.Params
-			if err := cdc.UnmarshalJSON(res, &params); err != nil {
-				return err
-			}
-
-			return cliCtx.PrintOutput(params)
+			return cliCtx.PrintOutput(res.Params)
 		},
 	}
 }
@@ -70,18 +61,12 @@ func GetCmdQueryInflation(cdc *codec.Codec) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryInflation)
-			res, _, err := cliCtx.QueryWithData(route, nil)
-			if err != nil {
-				return err
-			}
-
-			var inflation sdk.Dec
-			if err := cdc.UnmarshalJSON(res, &inflation); err != nil {
+			var res types.QueryInflationResponse
+			if _, err := cliCtx.QueryGRPC(types.QueryInflationMethod, &types.QueryInflationRequest{}, &res); err != nil {
 				return err
 			}
 
-			return cliCtx.PrintOutput(inflation)
+			return cliCtx.PrintOutput(res.Inflation)
 		},
 	}
 }
@@ -96,18 +81,12 @@ func GetCmdQueryAnnualProvisions(cdc *codec.Codec) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAnnualProvisions)
-			res, _, err := cliCtx.QueryWithData(route, nil)
-			if err != nil {
-				return err
-			}
-
-			var inflation sdk.Dec
-			if err := cdc.UnmarshalJSON(res, &inflation); err != nil {
+			var res types.QueryAnnualProvisionsResponse
+			if _, err := cliCtx.QueryGRPC(types.QueryAnnualProvisionsMethod, &types.QueryAnnualProvisionsRequest{}, &res); err != nil {
 				return err
 			}
 
-			return cliCtx.PrintOutput(inflation)
+			return cliCtx.PrintOutput(res.AnnualProvisions)
 		},
 	}
 }
@@ -0,0 +1,49 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+func TestGRPCQueries(t *testing.T) {
+	app, ctx := createTestApp(true)
+	c := sdk.WrapSDKContext(ctx)
+
+	params, err := app.MintKeeper.Params(c, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, app.MintKeeper.GetParams(ctx), params.Params)
+
+	inflation, err := app.MintKeeper.Inflation(c, &types.QueryInflationRequest{})
+	require.NoError(t, err)
+	require.Equal(t, app.MintKeeper.GetMinter(ctx).Inflation, inflation.Inflation)
+
+	annualProvisions, err := app.MintKeeper.AnnualProvisions(c, &types.QueryAnnualProvisionsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, app.MintKeeper.GetMinter(ctx).AnnualProvisions, annualProvisions.AnnualProvisions)
+}
+
+func TestGRPCQueryRoutes(t *testing.T) {
+	app, ctx := createTestApp(true)
+
+	// the service is registered with the app's router under the method names
+	handler := app.GRPCQueryRouter().Route(types.QueryInflationMethod)
+	require.NotNil(t, handler)
+
+	reqBz, err := (&types.QueryInflationRequest{}).Marshal()
+	require.NoError(t, err)
+
+	res, err := handler(ctx, abci.RequestQuery{Path: types.QueryInflationMethod, Data: reqBz})
+	require.NoError(t, err)
+
+	var inflation types.QueryInflationResponse
+	require.NoError(t, inflation.Unmarshal(res.Value))
+	require.Equal(t, app.MintKeeper.GetMinter(ctx).Inflation, inflation.Inflation)
+
+	require.NotNil(t, app.GRPCQueryRouter().Route(types.QueryParamsMethod))
+	require.NotNil(t, app.GRPCQueryRouter().Route(types.QueryAnnualProvisionsMethod))
+}
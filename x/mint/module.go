@@ -8,6 +8,7 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/spf13/cobra"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -21,9 +22,11 @@ import (
 )
 
 var (
-	_ module.AppModule           = AppModule{}
-	_ module.AppModuleBasic      = AppModuleBasic{}
-	_ module.AppModuleSimulation = AppModule{}
+	_ module.AppModule                 = AppModule{}
+	_ module.AppModuleBasic            = AppModuleBasic{}
+	_ module.AppModuleSimulation       = AppModule{}
+	_ module.AppModuleQueryService     = AppModule{}
+	_ module.AppModuleBasicGRPCGateway = AppModuleBasic{}
 )
 
 // AppModuleBasic defines the basic application module used by the mint module.
@@ -62,6 +65,12 @@ func (AppModuleBasic) RegisterRESTRoutes(ctx context.CLIContext, rtr *mux.Router
 	rest.RegisterRoutes(ctx, rtr)
 }
 
+// QueryServiceDesc returns the description of the gRPC query service of the
+// mint module, served over REST by the gRPC gateway.
+func (AppModuleBasic) QueryServiceDesc() *grpc.ServiceDesc {
+	return types.QueryServiceDesc()
+}
+
 // GetTxCmd returns no root tx command for the mint module.
 func (AppModuleBasic) GetTxCmd(_ *codec.Codec) *cobra.Command { return nil }
 
@@ -78,14 +87,25 @@ type AppModule struct {
 
 	keeper     Keeper
 	authKeeper types.AccountKeeper
+
+	// inflationCalculator is used to calculate the inflation rate in BeginBlock
+	inflationCalculator types.InflationCalculationFn
 }
 
-// NewAppModule creates a new AppModule object
-func NewAppModule(cdc codec.Marshaler, keeper Keeper, ak types.AccountKeeper) AppModule {
+// NewAppModule creates a new AppModule object. If the InflationCalculationFn
+// is nil, the DefaultInflationCalculationFn is used.
+func NewAppModule(
+	cdc codec.Marshaler, keeper Keeper, ak types.AccountKeeper, ic types.InflationCalculationFn,
+) AppModule {
+	if ic == nil {
+		ic = types.DefaultInflationCalculationFn
+	}
+
 	return AppModule{
-		AppModuleBasic: AppModuleBasic{cdc: cdc},
-		keeper:         keeper,
-		authKeeper:     ak,
+		AppModuleBasic:      AppModuleBasic{cdc: cdc},
+		keeper:              keeper,
+		authKeeper:          ak,
+		inflationCalculator: ic,
 	}
 }
 
@@ -113,6 +133,11 @@ func (am AppModule) NewQuerierHandler() sdk.Querier {
 	return NewQuerier(am.keeper)
 }
 
+// RegisterQueryService registers the gRPC query service of the mint module.
+func (am AppModule) RegisterQueryService(server module.GRPCServer) {
+	types.RegisterQueryService(server, am.keeper)
+}
+
 // InitGenesis performs genesis initialization for the mint module. It returns
 // no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONMarshaler, data json.RawMessage) []abci.ValidatorUpdate {
@@ -132,7 +157,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONMarshaler) json
 
 // BeginBlock returns the begin blocker for the mint module.
 func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
-	BeginBlocker(ctx, am.keeper)
+	BeginBlocker(ctx, am.keeper, am.inflationCalculator)
 }
 
 // EndBlock returns the end blocker for the mint module. It returns no validator
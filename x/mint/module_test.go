@@ -7,6 +7,7 @@ import (
 	"github.com/tendermint/tendermint/abci/types"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/mint"
 )
@@ -25,3 +26,24 @@ func TestItCreatesModuleAccountOnInitBlock(t *testing.T) {
 	acc := app.AccountKeeper.GetAccount(ctx, auth.NewModuleAddress(mint.ModuleName))
 	require.NotNil(t, acc)
 }
+
+func TestBeginBlockerInflationCalculationFn(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, types.Header{})
+
+	fixedInflation := sdk.NewDecWithPrec(5, 2)
+	mint.BeginBlocker(ctx, app.MintKeeper, func(_ sdk.Context, _ mint.Minter, _ mint.Params, _ sdk.Dec) sdk.Dec {
+		return fixedInflation
+	})
+
+	minter := app.MintKeeper.GetMinter(ctx)
+	require.Equal(t, fixedInflation, minter.Inflation)
+	require.Equal(t, fixedInflation.MulInt(app.StakingKeeper.StakingTokenSupply(ctx)), minter.AnnualProvisions)
+
+	// the default function applies the bonded ratio based rate change
+	params := app.MintKeeper.GetParams(ctx)
+	expected := minter.NextInflationRate(params, app.StakingKeeper.BondedRatio(ctx))
+
+	mint.BeginBlocker(ctx, app.MintKeeper, mint.DefaultInflationCalculationFn)
+	require.Equal(t, expected, app.MintKeeper.GetMinter(ctx).Inflation)
+}
@@ -0,0 +1,18 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InflationCalculationFn defines the function used by the BeginBlocker to
+// calculate the inflation rate of each block. It receives the stored minter
+// and params along with the current bonded ratio and returns the new inflation
+// rate. It lets an application replace the default bonded ratio based formula
+// with its own issuance schedule, e.g. a fixed or decaying one.
+type InflationCalculationFn func(ctx sdk.Context, minter Minter, params Params, bondedRatio sdk.Dec) sdk.Dec
+
+// DefaultInflationCalculationFn is the default InflationCalculationFn, which
+// targets the GoalBonded ratio with a rate of change bounded by the params.
+func DefaultInflationCalculationFn(_ sdk.Context, minter Minter, params Params, bondedRatio sdk.Dec) sdk.Dec {
+	return minter.NextInflationRate(params, bondedRatio)
+}
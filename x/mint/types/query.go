@@ -0,0 +1,28 @@
+package types
+
+import (
+	"google.golang.org/grpc"
+)
+
+// RegisterQueryService registers the QueryServer with the provided server,
+// which unlike for RegisterQueryServer does not have to be a *grpc.Server, e.g.
+// the baseapp GRPCQueryRouter serving the queries over ABCI.
+func RegisterQueryService(server interface {
+	RegisterService(sd *grpc.ServiceDesc, ss interface{})
+}, srv QueryServer) {
+	server.RegisterService(&_Query_serviceDesc, srv)
+}
+
+// QueryServiceDesc returns the description of the Query service, whose methods
+// the gRPC gateway serves over REST.
+func QueryServiceDesc() *grpc.ServiceDesc {
+	return &_Query_serviceDesc
+}
+
+// Full names of the Query service methods, which are the paths of their ABCI
+// queries.
+const (
+	QueryParamsMethod           = "/cosmos_sdk.x.mint.v1.Query/Params"
+	QueryInflationMethod        = "/cosmos_sdk.x.mint.v1.Query/Inflation"
+	QueryAnnualProvisionsMethod = "/cosmos_sdk.x.mint.v1.Query/AnnualProvisions"
+)
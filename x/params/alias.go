@@ -22,6 +22,10 @@ var (
 	NewQuerySubspaceParams    = types.NewQuerySubspaceParams
 	NewQuerier                = keeper.NewQuerier
 	NewSubspaceParamsResponse = types.NewSubspaceParamsResponse
+	NewQueryAllSubspaceParams = types.NewQueryAllSubspaceParams
+	NewParamResponse          = types.NewParamResponse
+
+	NewAllSubspaceParamsResponse = types.NewAllSubspaceParamsResponse
 )
 
 type (
@@ -34,4 +38,8 @@ type (
 	KeyTable               = types.KeyTable
 	QuerySubspaceParams    = types.QuerySubspaceParams
 	SubspaceParamsResponse = types.SubspaceParamsResponse
+	QueryAllSubspaceParams = types.QueryAllSubspaceParams
+	ParamResponse          = types.ParamResponse
+
+	AllSubspaceParamsResponse = types.AllSubspaceParamsResponse
 )
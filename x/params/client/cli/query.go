@@ -22,7 +22,11 @@ func NewQueryCmd(m codec.Marshaler) *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmd.AddCommand(NewQuerySubspaceParamsCmd(m))
+	cmd.AddCommand(
+		NewQuerySubspaceParamsCmd(m),
+		NewQuerySubspacesCmd(m),
+		NewQueryAllSubspaceParamsCmd(m),
+	)
 
 	return cmd
 }
@@ -61,3 +65,67 @@ func NewQuerySubspaceParamsCmd(m codec.Marshaler) *cobra.Command {
 
 	return flags.GetCommands(cmd)[0]
 }
+
+// NewQuerySubspacesCmd returns a CLI command handler for querying the names of
+// all the subspaces registered with the x/params module.
+func NewQuerySubspacesCmd(m codec.Marshaler) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "subspaces",
+		Short: "Query for the names of all the registered subspaces",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithMarshaler(m)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySubspaces)
+
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var resp []string
+			if err := m.UnmarshalJSON(bz, &resp); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(resp)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
+
+// NewQueryAllSubspaceParamsCmd returns a CLI command handler for querying all
+// the raw parameters of a subspace along with their registered types.
+func NewQueryAllSubspaceParamsCmd(m codec.Marshaler) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "subspace-params [subspace]",
+		Short: "Query for all the raw parameters of a subspace with their types",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithMarshaler(m)
+
+			params := types.NewQueryAllSubspaceParams(args[0])
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAllParams)
+
+			bz, err := m.MarshalJSON(params)
+			if err != nil {
+				return fmt.Errorf("failed to marshal params: %w", err)
+			}
+
+			bz, _, err = cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var resp types.AllSubspaceParamsResponse
+			if err := m.UnmarshalJSON(bz, &resp); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(resp)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
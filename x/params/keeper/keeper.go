@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -59,3 +60,14 @@ func (k Keeper) GetSubspace(s string) (types.Subspace, bool) {
 	}
 	return *space, ok
 }
+
+// GetSubspaceNames returns the sorted names of all the allocated subspaces.
+func (k Keeper) GetSubspaceNames() []string {
+	names := make([]string, 0, len(k.spaces))
+	for name := range k.spaces {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
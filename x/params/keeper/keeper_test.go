@@ -5,9 +5,12 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	"github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -198,6 +201,42 @@ type paramJSON struct {
 	Param2 string `json:"param2,omitempty" yaml:"param2,omitempty"`
 }
 
+func TestQuerier(t *testing.T) {
+	_, ctx, _, _, keeper := testComponents()
+
+	table := types.NewKeyTable(
+		types.NewParamSetPair([]byte("key1"), int64(0), validateNoOp),
+		types.NewParamSetPair([]byte("key2"), string(""), validateNoOp),
+	)
+	space := keeper.Subspace("test").WithKeyTable(table)
+	keeper.Subspace("other")
+	space.Set(ctx, []byte("key1"), int64(10))
+
+	querier := paramskeeper.NewQuerier(keeper)
+
+	bz, err := querier(ctx, []string{types.QuerySubspaces}, abci.RequestQuery{})
+	require.NoError(t, err)
+
+	var names []string
+	require.NoError(t, codec.Cdc.UnmarshalJSON(bz, &names))
+	require.Equal(t, []string{"other", "test"}, names)
+
+	req := abci.RequestQuery{Data: codec.Cdc.MustMarshalJSON(types.NewQueryAllSubspaceParams("test"))}
+	bz, err = querier(ctx, []string{types.QueryAllParams}, req)
+	require.NoError(t, err)
+
+	var resp types.AllSubspaceParamsResponse
+	require.NoError(t, codec.Cdc.UnmarshalJSON(bz, &resp))
+	require.Equal(t, types.NewAllSubspaceParamsResponse("test", []types.ParamResponse{
+		types.NewParamResponse("key1", "int64", `"10"`),
+		types.NewParamResponse("key2", "string", ""),
+	}), resp)
+
+	req = abci.RequestQuery{Data: codec.Cdc.MustMarshalJSON(types.NewQueryAllSubspaceParams("unknown"))}
+	_, err = querier(ctx, []string{types.QueryAllParams}, req)
+	require.Error(t, err)
+}
+
 func TestJSONUpdate(t *testing.T) {
 	_, ctx, _, _, keeper := testComponents()
 
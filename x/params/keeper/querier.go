@@ -17,6 +17,12 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case types.QueryParams:
 			return queryParams(ctx, req, k)
 
+		case types.QuerySubspaces:
+			return querySubspaces(k)
+
+		case types.QueryAllParams:
+			return queryAllParams(ctx, req, k)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query path: %s", path[0])
 		}
@@ -45,3 +51,42 @@ func queryParams(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, erro
 
 	return bz, nil
 }
+
+func querySubspaces(k Keeper) ([]byte, error) {
+	bz, err := codec.MarshalJSONIndent(codec.Cdc, k.GetSubspaceNames())
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryAllParams(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryAllSubspaceParams
+
+	if err := codec.Cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	ss, ok := k.GetSubspace(params.Subspace)
+	if !ok {
+		return nil, sdkerrors.Wrap(proposal.ErrUnknownSubspace, params.Subspace)
+	}
+
+	keys := ss.Keys()
+	paramsResp := make([]types.ParamResponse, len(keys))
+	for i, key := range keys {
+		ty, _ := ss.KeyType([]byte(key))
+		rawValue := ss.GetRaw(ctx, []byte(key))
+		paramsResp[i] = types.NewParamResponse(key, ty.String(), string(rawValue))
+	}
+
+	resp := types.NewAllSubspaceParamsResponse(params.Subspace, paramsResp)
+
+	bz, err := codec.MarshalJSONIndent(codec.Cdc, resp)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
@@ -30,6 +30,11 @@ func handleParameterChangeProposal(ctx sdk.Context, k keeper.Keeper, p *proposal
 			return sdkerrors.Wrap(proposal.ErrUnknownSubspace, c.Subspace)
 		}
 
+		// Update panics on an unregistered key
+		if _, ok := ss.KeyType([]byte(c.Key)); !ok {
+			return sdkerrors.Wrapf(proposal.ErrSettingParameter, "key: %s, value: %s, err: parameter not registered", c.Key, c.Value)
+		}
+
 		k.Logger(ctx).Info(
 			fmt.Sprintf("attempt to set new parameter value; key: %s, value: %s", c.Key, c.Value),
 		)
@@ -9,7 +9,9 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 	dbm "github.com/tendermint/tm-db"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/std"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
@@ -109,6 +111,45 @@ func TestProposalHandlerFailed(t *testing.T) {
 	require.False(t, ss.Has(input.ctx, []byte(keyMaxValidators)))
 }
 
+func TestProposalHandlerInvalidChange(t *testing.T) {
+	input := newTestInput(t)
+	ss := input.keeper.Subspace(testSubspace).WithKeyTable(
+		types.NewKeyTable().RegisterParamSet(&testParams{}),
+	)
+	hdlr := params.NewParamChangeProposalHandler(input.keeper)
+
+	// an unregistered key is rejected instead of panicking
+	tp := testProposal(proposal.NewParamChange(testSubspace, "UnknownKey", "1"))
+	require.NotPanics(t, func() { require.Error(t, hdlr(input.ctx, tp)) })
+
+	// the invalid change of a proposal fails its whole execution, whose state
+	// changes are not written by the governance module
+	cacheCtx, _ := input.ctx.CacheContext()
+	tp = testProposal(
+		proposal.NewParamChange(testSubspace, keyMaxValidators, "1"),
+		proposal.NewParamChange(testSubspace, keySlashingRate, `"invalidType"`),
+	)
+	require.Error(t, hdlr(cacheCtx, tp))
+	require.False(t, ss.Has(input.ctx, []byte(keyMaxValidators)))
+}
+
+func TestProposalHandlerConsensusParams(t *testing.T) {
+	input := newTestInput(t)
+	ss := input.keeper.Subspace(baseapp.Paramspace).WithKeyTable(std.ConsensusParamsKeyTable())
+	ss.Set(input.ctx, baseapp.ParamStoreKeyBlockParams, abci.BlockParams{MaxBytes: 200000, MaxGas: -1})
+	hdlr := params.NewParamChangeProposalHandler(input.keeper)
+
+	tp := testProposal(proposal.NewParamChange(baseapp.Paramspace, string(baseapp.ParamStoreKeyBlockParams), `{"max_bytes": "0"}`))
+	require.Error(t, hdlr(input.ctx, tp))
+
+	tp = testProposal(proposal.NewParamChange(baseapp.Paramspace, string(baseapp.ParamStoreKeyBlockParams), `{"max_gas": "5000000"}`))
+	require.NoError(t, hdlr(input.ctx, tp))
+
+	var bp abci.BlockParams
+	ss.Get(input.ctx, baseapp.ParamStoreKeyBlockParams, &bp)
+	require.Equal(t, abci.BlockParams{MaxBytes: 200000, MaxGas: 5000000}, bp)
+}
+
 func TestProposalHandlerUpdateOmitempty(t *testing.T) {
 	input := newTestInput(t)
 	ss := input.keeper.Subspace(testSubspace).WithKeyTable(
@@ -0,0 +1,21 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	tmkv "github.com/tendermint/tendermint/libs/kv"
+)
+
+// NewDecodeStore returns a decoder function closure that prints the KVPair's
+// Value, the JSON of a parameter stored under its subspace name and key.
+func NewDecodeStore() func(kvA, kvB tmkv.Pair) string {
+	return func(kvA, kvB tmkv.Pair) string {
+		switch {
+		case bytes.IndexByte(kvA.Key, '/') > 0:
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+		default:
+			panic(fmt.Sprintf("invalid params key %X", kvA.Key))
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package simulation_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmkv "github.com/tendermint/tendermint/libs/kv"
+
+	"github.com/cosmos/cosmos-sdk/x/params/simulation"
+)
+
+func TestDecodeStore(t *testing.T) {
+	dec := simulation.NewDecodeStore()
+
+	kvPairs := tmkv.Pairs{
+		tmkv.Pair{Key: []byte("staking/MaxValidators"), Value: []byte(`100`)},
+		tmkv.Pair{Key: []byte("bank/sendenabled"), Value: []byte(`true`)},
+		tmkv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"MaxValidators", "100\n100"},
+		{"SendEnabled", "true\ntrue"},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs[i], kvPairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs[i], kvPairs[i]), tt.name)
+			}
+		})
+	}
+}
@@ -2,7 +2,9 @@ package types
 
 // Querier path constants
 const (
-	QueryParams = "params"
+	QueryParams    = "params"
+	QuerySubspaces = "subspaces"
+	QueryAllParams = "all_params"
 )
 
 // QuerySubspaceParams defines the params for querying module params by a given
@@ -33,3 +35,45 @@ func NewSubspaceParamsResponse(ss, key, value string) SubspaceParamsResponse {
 		Value:    value,
 	}
 }
+
+// QueryAllSubspaceParams defines the params for querying all the module params
+// of a given subspace.
+type QueryAllSubspaceParams struct {
+	Subspace string
+}
+
+// ParamResponse defines a parameter of a subspace along with its registered
+// type and raw value.
+type ParamResponse struct {
+	Key   string
+	Type  string
+	Value string
+}
+
+// AllSubspaceParamsResponse defines the response for querying all the
+// parameters of a subspace.
+type AllSubspaceParamsResponse struct {
+	Subspace string
+	Params   []ParamResponse
+}
+
+func NewQueryAllSubspaceParams(ss string) QueryAllSubspaceParams {
+	return QueryAllSubspaceParams{
+		Subspace: ss,
+	}
+}
+
+func NewParamResponse(key, ty, value string) ParamResponse {
+	return ParamResponse{
+		Key:   key,
+		Type:  ty,
+		Value: value,
+	}
+}
+
+func NewAllSubspaceParamsResponse(ss string, params []ParamResponse) AllSubspaceParamsResponse {
+	return AllSubspaceParamsResponse{
+		Subspace: ss,
+		Params:   params,
+	}
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"reflect"
+	"sort"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
@@ -137,6 +138,14 @@ func (s Subspace) Has(ctx sdk.Context, key []byte) bool {
 	return store.Has(key)
 }
 
+// Delete removes a parameter by key from the Subspace's KVStore, regardless of
+// whether the key is registered, e.g. to drop a parameter replaced by a store
+// migration.
+func (s Subspace) Delete(ctx sdk.Context, key []byte) {
+	store := s.kvStore(ctx)
+	store.Delete(key)
+}
+
 // Modified returns true if the parameter key is set in the Subspace's transient
 // KVStore.
 func (s Subspace) Modified(ctx sdk.Context, key []byte) bool {
@@ -244,6 +253,27 @@ func (s Subspace) Name() string {
 	return string(s.name)
 }
 
+// Keys returns the sorted parameter keys registered in the Subspace's KeyTable.
+func (s Subspace) Keys() []string {
+	keys := make([]string, 0, len(s.table.m))
+	for k := range s.table.m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+	return keys
+}
+
+// KeyType returns the registered type of a parameter key, if it is registered.
+func (s Subspace) KeyType(key []byte) (reflect.Type, bool) {
+	attr, ok := s.table.m[string(key)]
+	if !ok {
+		return nil, false
+	}
+
+	return attr.ty, true
+}
+
 // Wrapper of Subspace, provides immutable functions only
 type ReadOnlySubspace struct {
 	s Subspace
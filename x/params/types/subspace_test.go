@@ -139,6 +139,19 @@ func (suite *SubspaceTestSuite) TestUpdate() {
 	suite.Require().Equal(good, v)
 }
 
+func (suite *SubspaceTestSuite) TestKeys() {
+	suite.Require().Equal(
+		[]string{string(keyBondDenom), string(keyMaxValidators), string(keyUnbondingTime)}, suite.ss.Keys(),
+	)
+
+	ty, ok := suite.ss.KeyType(keyUnbondingTime)
+	suite.Require().True(ok)
+	suite.Require().Equal("time.Duration", ty.String())
+
+	_, ok = suite.ss.KeyType([]byte("invalid_key"))
+	suite.Require().False(ok)
+}
+
 func (suite *SubspaceTestSuite) TestGetParamSet() {
 	a := params{
 		UnbondingTime: time.Hour * 48,
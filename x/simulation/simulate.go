@@ -45,7 +45,7 @@ func initChain(
 func SimulateFromSeed(
 	tb testing.TB, w io.Writer, app *baseapp.BaseApp,
 	appStateFn simulation.AppStateFn, ops WeightedOperations,
-	blackListedAccs map[string]bool, config simulation.Config,
+	blockedAccs map[string]bool, config simulation.Config,
 ) (stopEarly bool, exportedParams Params, err error) {
 	// in case we have to end early, don't os.Exit so that we can run cleanup code.
 	testingMode, _, b := getTestingMode(tb)
@@ -77,7 +77,7 @@ func SimulateFromSeed(
 	var tmpAccs []simulation.Account
 
 	for _, acc := range accs {
-		if !blackListedAccs[acc.Address.String()] {
+		if !blockedAccs[acc.Address.String()] {
 			tmpAccs = append(tmpAccs, acc)
 		}
 	}
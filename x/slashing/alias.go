@@ -18,62 +18,72 @@ const (
 	QueryParameters             = types.QueryParameters
 	QuerySigningInfo            = types.QuerySigningInfo
 	QuerySigningInfos           = types.QuerySigningInfos
+	QueryUnjailStatus           = types.QueryUnjailStatus
+	MissedBlockBitmapChunkSize  = types.MissedBlockBitmapChunkSize
 
 	EventTypeSlash                 = types.EventTypeSlash
 	EventTypeLiveness              = types.EventTypeLiveness
 	AttributeKeyAddress            = types.AttributeKeyAddress
 	AttributeKeyHeight             = types.AttributeKeyHeight
+	AttributeKeyInfractionHeight   = types.AttributeKeyInfractionHeight
 	AttributeKeyPower              = types.AttributeKeyPower
 	AttributeKeyReason             = types.AttributeKeyReason
 	AttributeKeyJailed             = types.AttributeKeyJailed
 	AttributeKeyMissedBlocks       = types.AttributeKeyMissedBlocks
+	AttributeKeyBurnedCoins        = types.AttributeKeyBurnedCoins
 	AttributeValueDoubleSign       = types.AttributeValueDoubleSign
 	AttributeValueMissingSignature = types.AttributeValueMissingSignature
 	AttributeValueCategory         = types.AttributeValueCategory
+
+	DefaultMinSelfDelegationJailDuration = types.DefaultMinSelfDelegationJailDuration
 )
 
 var (
 	// functions aliases
-	NewKeeper                                = keeper.NewKeeper
-	NewQuerier                               = keeper.NewQuerier
-	RegisterCodec                            = types.RegisterCodec
-	ErrNoValidatorForAddress                 = types.ErrNoValidatorForAddress
-	ErrBadValidatorAddr                      = types.ErrBadValidatorAddr
-	ErrValidatorJailed                       = types.ErrValidatorJailed
-	ErrValidatorNotJailed                    = types.ErrValidatorNotJailed
-	ErrMissingSelfDelegation                 = types.ErrMissingSelfDelegation
-	ErrSelfDelegationTooLowToUnjail          = types.ErrSelfDelegationTooLowToUnjail
-	ErrNoSigningInfoFound                    = types.ErrNoSigningInfoFound
-	NewGenesisState                          = types.NewGenesisState
-	NewMissedBlock                           = types.NewMissedBlock
-	DefaultGenesisState                      = types.DefaultGenesisState
-	ValidateGenesis                          = types.ValidateGenesis
-	GetValidatorSigningInfoKey               = types.GetValidatorSigningInfoKey
-	GetValidatorSigningInfoAddress           = types.GetValidatorSigningInfoAddress
-	GetValidatorMissedBlockBitArrayPrefixKey = types.GetValidatorMissedBlockBitArrayPrefixKey
-	GetValidatorMissedBlockBitArrayKey       = types.GetValidatorMissedBlockBitArrayKey
-	GetAddrPubkeyRelationKey                 = types.GetAddrPubkeyRelationKey
-	NewMsgUnjail                             = types.NewMsgUnjail
-	ParamKeyTable                            = types.ParamKeyTable
-	NewParams                                = types.NewParams
-	DefaultParams                            = types.DefaultParams
-	NewQuerySigningInfoParams                = types.NewQuerySigningInfoParams
-	NewQuerySigningInfosParams               = types.NewQuerySigningInfosParams
-	NewValidatorSigningInfo                  = types.NewValidatorSigningInfo
+	NewKeeper                               = keeper.NewKeeper
+	NewQuerier                              = keeper.NewQuerier
+	RegisterCodec                           = types.RegisterCodec
+	ErrNoValidatorForAddress                = types.ErrNoValidatorForAddress
+	ErrBadValidatorAddr                     = types.ErrBadValidatorAddr
+	ErrValidatorJailed                      = types.ErrValidatorJailed
+	ErrValidatorNotJailed                   = types.ErrValidatorNotJailed
+	ErrMissingSelfDelegation                = types.ErrMissingSelfDelegation
+	ErrSelfDelegationTooLowToUnjail         = types.ErrSelfDelegationTooLowToUnjail
+	ErrNoSigningInfoFound                   = types.ErrNoSigningInfoFound
+	NewGenesisState                         = types.NewGenesisState
+	NewMissedBlock                          = types.NewMissedBlock
+	DefaultGenesisState                     = types.DefaultGenesisState
+	ValidateGenesis                         = types.ValidateGenesis
+	GetValidatorSigningInfoKey              = types.GetValidatorSigningInfoKey
+	GetValidatorSigningInfoAddress          = types.GetValidatorSigningInfoAddress
+	GetValidatorMissedBlockBitmapPrefixKey  = types.GetValidatorMissedBlockBitmapPrefixKey
+	GetValidatorMissedBlockBitmapChunkKey   = types.GetValidatorMissedBlockBitmapChunkKey
+	GetValidatorMissedBlockBitmapChunkIndex = types.GetValidatorMissedBlockBitmapChunkIndex
+	GetAddrPubkeyRelationKey                = types.GetAddrPubkeyRelationKey
+	NewMsgUnjail                            = types.NewMsgUnjail
+	ParamKeyTable                           = types.ParamKeyTable
+	NewParams                               = types.NewParams
+	DefaultParams                           = types.DefaultParams
+	NewQuerySigningInfoParams               = types.NewQuerySigningInfoParams
+	NewQuerySigningInfosParams              = types.NewQuerySigningInfosParams
+	NewQueryUnjailStatusParams              = types.NewQueryUnjailStatusParams
+	NewValidatorSigningInfo                 = types.NewValidatorSigningInfo
 
 	// variable aliases
-	ModuleCdc                       = types.ModuleCdc
-	ValidatorSigningInfoKey         = types.ValidatorSigningInfoKey
-	ValidatorMissedBlockBitArrayKey = types.ValidatorMissedBlockBitArrayKey
-	AddrPubkeyRelationKey           = types.AddrPubkeyRelationKey
-	DefaultMinSignedPerWindow       = types.DefaultMinSignedPerWindow
-	DefaultSlashFractionDoubleSign  = types.DefaultSlashFractionDoubleSign
-	DefaultSlashFractionDowntime    = types.DefaultSlashFractionDowntime
-	KeySignedBlocksWindow           = types.KeySignedBlocksWindow
-	KeyMinSignedPerWindow           = types.KeyMinSignedPerWindow
-	KeyDowntimeJailDuration         = types.KeyDowntimeJailDuration
-	KeySlashFractionDoubleSign      = types.KeySlashFractionDoubleSign
-	KeySlashFractionDowntime        = types.KeySlashFractionDowntime
+	ModuleCdc                      = types.ModuleCdc
+	ValidatorSigningInfoKey        = types.ValidatorSigningInfoKey
+	AddrPubkeyRelationKey          = types.AddrPubkeyRelationKey
+	ValidatorMissedBlockBitmapKey  = types.ValidatorMissedBlockBitmapKey
+	DefaultMinSignedPerWindow      = types.DefaultMinSignedPerWindow
+	DefaultSlashFractionDoubleSign = types.DefaultSlashFractionDoubleSign
+	DefaultSlashFractionDowntime   = types.DefaultSlashFractionDowntime
+	KeySignedBlocksWindow          = types.KeySignedBlocksWindow
+	KeyMinSignedPerWindow          = types.KeyMinSignedPerWindow
+	KeyDowntimeJailDuration        = types.KeyDowntimeJailDuration
+	KeySlashFractionDoubleSign     = types.KeySlashFractionDoubleSign
+	KeySlashFractionDowntime       = types.KeySlashFractionDowntime
+
+	KeyMinSelfDelegationJailDuration = types.KeyMinSelfDelegationJailDuration
 )
 
 type (
@@ -85,5 +95,7 @@ type (
 	Params                  = types.Params
 	QuerySigningInfoParams  = types.QuerySigningInfoParams
 	QuerySigningInfosParams = types.QuerySigningInfosParams
+	QueryUnjailStatusParams = types.QueryUnjailStatusParams
+	UnjailStatus            = types.UnjailStatus
 	ValidatorSigningInfo    = types.ValidatorSigningInfo
 )
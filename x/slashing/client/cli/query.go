@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -29,6 +30,8 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	slashingQueryCmd.AddCommand(
 		flags.GetCommands(
 			GetCmdQuerySigningInfo(queryRoute, cdc),
+			GetCmdQuerySigningInfos(cdc),
+			GetCmdQueryUnjailStatus(cdc),
 			GetCmdQueryParams(cdc),
 		)...,
 	)
@@ -78,6 +81,88 @@ $ <appcli> query slashing signing-info cosmosvalconspub1zcjduepqfhvwcmt7p06fvdge
 	}
 }
 
+// GetCmdQuerySigningInfos implements the command to query the signing infos of
+// all the validators, paginated.
+func GetCmdQuerySigningInfos(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signing-infos",
+		Short: "Query the signing information of all the validators",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(`Query the signing information of all the validators, paginated:
+
+$ <appcli> query slashing signing-infos --page=2 --limit=50
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := types.NewQuerySigningInfosParams(viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit))
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySigningInfos)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var signingInfos []types.ValidatorSigningInfo
+			if err := cdc.UnmarshalJSON(res, &signingInfos); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(signingInfos)
+		},
+	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of signing infos to query for")
+	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of signing infos to query for")
+
+	return cmd
+}
+
+// GetCmdQueryUnjailStatus implements the command to query whether a validator
+// can be unjailed.
+func GetCmdQueryUnjailStatus(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "unjail-status [validator-addr]",
+		Short: "Query whether a validator can be unjailed and why not",
+		Long: strings.TrimSpace(`Query whether a validator can be unjailed at the current block and, if it cannot,
+the reason why, e.g. tombstoned, jail time remaining or self-delegation below minimum:
+
+$ <appcli> query slashing unjail-status cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryUnjailStatusParams(valAddr))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryUnjailStatus)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var status types.UnjailStatus
+			if err := cdc.UnmarshalJSON(res, &status); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(status)
+		},
+	}
+}
+
 // GetCmdQueryParams implements a command to fetch slashing parameters.
 func GetCmdQueryParams(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
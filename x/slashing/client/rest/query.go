@@ -23,6 +23,11 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		signingInfoHandlerListFn(cliCtx),
 	).Methods("GET")
 
+	r.HandleFunc(
+		"/slashing/validators/{validatorAddr}/unjail_status",
+		unjailStatusHandlerFn(cliCtx),
+	).Methods("GET")
+
 	r.HandleFunc(
 		"/slashing/parameters",
 		queryParamsHandlerFn(cliCtx),
@@ -91,6 +96,35 @@ func signingInfoHandlerListFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+// http request handler to query whether a validator can be unjailed
+func unjailStatusHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		valAddr, err := sdk.ValAddressFromBech32(mux.Vars(r)["validatorAddr"])
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		bz, err := cliCtx.Codec.MarshalJSON(types.NewQueryUnjailStatusParams(valAddr))
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryUnjailStatus)
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 func queryParamsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
@@ -0,0 +1,39 @@
+package slashing_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+)
+
+func TestExportGenesis(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	for i, pk := range simapp.CreateTestPubKeys(10) {
+		consAddr := sdk.ConsAddress(pk.Address())
+		info := slashing.NewValidatorSigningInfo(consAddr, int64(i), 1, time.Unix(0, 0).UTC(), false, 1)
+		app.SlashingKeeper.SetValidatorSigningInfo(ctx, consAddr, info)
+		app.SlashingKeeper.SetValidatorMissedBlockBitArray(ctx, consAddr, 0, true)
+	}
+
+	// the exported genesis is deterministic, although its signing infos and
+	// missed blocks are maps
+	module := slashing.NewAppModule(std.NewAppCodec(app.Codec()), app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
+	genesis := module.ExportGenesis(ctx, app.Codec())
+	for i := 0; i < 5; i++ {
+		require.Equal(t, string(genesis), string(module.ExportGenesis(ctx, app.Codec())))
+	}
+
+	var genesisState slashing.GenesisState
+	app.Codec().MustUnmarshalJSON(genesis, &genesisState)
+	require.Len(t, genesisState.SigningInfos, 10)
+	require.Len(t, genesisState.MissedBlocks, 10)
+}
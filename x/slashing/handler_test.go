@@ -23,10 +23,10 @@ func TestCannotUnjailUnlessJailed(t *testing.T) {
 	ctx := app.BaseApp.NewContext(false, abci.Header{})
 
 	pks := simapp.CreateTestPubKeys(1)
-	simapp.AddTestAddrsFromPubKeys(app, ctx, pks, sdk.TokensFromConsensusPower(200))
+	simapp.AddTestAddrsFromPubKeys(app, ctx, pks, sdk.TokensFromConsensusPower(200, sdk.DefaultPowerReduction))
 
 	slh := slashing.NewHandler(app.SlashingKeeper)
-	amt := sdk.TokensFromConsensusPower(100)
+	amt := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
 	addr, val := sdk.ValAddress(pks[0].Address()), pks[0]
 
 	msg := slashingkeeper.NewTestMsgCreateValidator(addr, val, amt)
@@ -54,11 +54,11 @@ func TestCannotUnjailUnlessMeetMinSelfDelegation(t *testing.T) {
 	app := simapp.Setup(false)
 	ctx := app.BaseApp.NewContext(false, abci.Header{})
 	pks := simapp.CreateTestPubKeys(1)
-	simapp.AddTestAddrsFromPubKeys(app, ctx, pks, sdk.TokensFromConsensusPower(200))
+	simapp.AddTestAddrsFromPubKeys(app, ctx, pks, sdk.TokensFromConsensusPower(200, sdk.DefaultPowerReduction))
 
 	slh := slashing.NewHandler(app.SlashingKeeper)
 	amtInt := int64(100)
-	addr, val, amt := sdk.ValAddress(pks[0].Address()), pks[0], sdk.TokensFromConsensusPower(amtInt)
+	addr, val, amt := sdk.ValAddress(pks[0].Address()), pks[0], sdk.TokensFromConsensusPower(amtInt, sdk.DefaultPowerReduction)
 	msg := slashingkeeper.NewTestMsgCreateValidator(addr, val, amt)
 	msg.MinSelfDelegation = amt
 
@@ -94,14 +94,14 @@ func TestJailedValidatorDelegations(t *testing.T) {
 	ctx := app.BaseApp.NewContext(false, abci.Header{Time: time.Unix(0, 0)})
 
 	pks := simapp.CreateTestPubKeys(3)
-	simapp.AddTestAddrsFromPubKeys(app, ctx, pks, sdk.TokensFromConsensusPower(20))
+	simapp.AddTestAddrsFromPubKeys(app, ctx, pks, sdk.TokensFromConsensusPower(20, sdk.DefaultPowerReduction))
 	app.SlashingKeeper.SetParams(ctx, slashingkeeper.TestParams())
 
 	stakingParams := app.StakingKeeper.GetParams(ctx)
 	app.StakingKeeper.SetParams(ctx, stakingParams)
 
 	// create a validator
-	bondAmount := sdk.TokensFromConsensusPower(10)
+	bondAmount := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
 	valPubKey := pks[1]
 	valAddr, consAddr := sdk.ValAddress(pks[1].Address()), sdk.ConsAddress(pks[0].Address())
 
@@ -175,11 +175,11 @@ func TestHandleAbsentValidator(t *testing.T) {
 	ctx := app.BaseApp.NewContext(false, abci.Header{Time: time.Unix(0, 0)})
 
 	pks := simapp.CreateTestPubKeys(1)
-	simapp.AddTestAddrsFromPubKeys(app, ctx, pks, sdk.TokensFromConsensusPower(200))
+	simapp.AddTestAddrsFromPubKeys(app, ctx, pks, sdk.TokensFromConsensusPower(200, sdk.DefaultPowerReduction))
 	app.SlashingKeeper.SetParams(ctx, slashingkeeper.TestParams())
 
 	power := int64(100)
-	amt := sdk.TokensFromConsensusPower(power)
+	amt := sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)
 	addr, val := sdk.ValAddress(pks[0].Address()), pks[0]
 	sh := staking.NewHandler(app.StakingKeeper)
 	slh := slashing.NewHandler(app.SlashingKeeper)
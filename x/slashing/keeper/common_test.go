@@ -3,5 +3,5 @@ package keeper_test
 import sdk "github.com/cosmos/cosmos-sdk/types"
 
 var (
-	InitTokens = sdk.TokensFromConsensusPower(200)
+	InitTokens = sdk.TokensFromConsensusPower(200, sdk.DefaultPowerReduction)
 )
@@ -32,6 +32,29 @@ func (k Keeper) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) {
 	k.AddPubkey(ctx, validator.GetConsPubKey())
 }
 
+// When a validator is jailed, keep it in jail for at least the minimum self
+// delegation jail duration. The jailings for downtime and double signing
+// override the jail time afterwards with their own.
+func (k Keeper) AfterValidatorJailed(ctx sdk.Context, address sdk.ConsAddress) {
+	duration := k.MinSelfDelegationJailDuration(ctx)
+	if duration == 0 {
+		return
+	}
+
+	// a validator without signing info was never bonded, and can unjail as
+	// soon as it bonds enough
+	signInfo, found := k.GetValidatorSigningInfo(ctx, address)
+	if !found {
+		return
+	}
+
+	jailedUntil := ctx.BlockHeader().Time.Add(duration)
+	if jailedUntil.After(signInfo.JailedUntil) {
+		signInfo.JailedUntil = jailedUntil
+		k.SetValidatorSigningInfo(ctx, address, signInfo)
+	}
+}
+
 // When a validator is removed, delete the address-pubkey relation.
 func (k Keeper) AfterValidatorRemoved(ctx sdk.Context, address sdk.ConsAddress) {
 	k.deleteAddrPubkeyRelation(ctx, crypto.Address(address))
@@ -52,24 +75,57 @@ func (k Keeper) Hooks() Hooks {
 }
 
 // Implements sdk.ValidatorHooks
-func (h Hooks) AfterValidatorBonded(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
+func (h Hooks) AfterValidatorBonded(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
 	h.k.AfterValidatorBonded(ctx, consAddr, valAddr)
+	return nil
 }
 
 // Implements sdk.ValidatorHooks
-func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, consAddr sdk.ConsAddress, _ sdk.ValAddress) {
+func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, consAddr sdk.ConsAddress, _ sdk.ValAddress) error {
 	h.k.AfterValidatorRemoved(ctx, consAddr)
+	return nil
 }
 
 // Implements sdk.ValidatorHooks
-func (h Hooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) {
+func (h Hooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) error {
 	h.k.AfterValidatorCreated(ctx, valAddr)
+	return nil
+}
+
+func (h Hooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+// Implements sdk.ValidatorHooks
+func (h Hooks) AfterValidatorJailed(ctx sdk.Context, consAddr sdk.ConsAddress, _ sdk.ValAddress) error {
+	h.k.AfterValidatorJailed(ctx, consAddr)
+	return nil
 }
 
-func (h Hooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress)  {}
-func (h Hooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress)                          {}
-func (h Hooks) BeforeDelegationCreated(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress)        {}
-func (h Hooks) BeforeDelegationSharesModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) {}
-func (h Hooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress)        {}
-func (h Hooks) AfterDelegationModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress)        {}
-func (h Hooks) BeforeValidatorSlashed(_ sdk.Context, _ sdk.ValAddress, _ sdk.Dec)                {}
+func (h Hooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) BeforeDelegationCreated(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) BeforeDelegationSharesModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterDelegationModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) BeforeValidatorSlashed(_ sdk.Context, _ sdk.ValAddress, _ sdk.Dec) error {
+	return nil
+}
+
+func (h Hooks) AfterUnbondingInitiated(_ sdk.Context, _ uint64) error {
+	return nil
+}
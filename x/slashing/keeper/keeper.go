@@ -71,19 +71,26 @@ func (k Keeper) GetPubkey(ctx sdk.Context, address crypto.Address) (crypto.PubKe
 	return pkStr, nil
 }
 
-// Slash attempts to slash a validator. The slash is delegated to the staking
-// module to make the necessary validator changes.
+// Slash attempts to slash a validator for double signing. The slash is
+// delegated to the staking module to make the necessary validator changes.
 func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, fraction sdk.Dec, power, distributionHeight int64) {
+	burned := k.sk.Slash(ctx, consAddr, distributionHeight, power, fraction)
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeSlash,
 			sdk.NewAttribute(types.AttributeKeyAddress, consAddr.String()),
 			sdk.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", power)),
 			sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueDoubleSign),
+			sdk.NewAttribute(types.AttributeKeyInfractionHeight, fmt.Sprintf("%d", distributionHeight)),
+			sdk.NewAttribute(types.AttributeKeyBurnedCoins, k.burnedCoins(ctx, burned).String()),
 		),
 	)
+}
 
-	k.sk.Slash(ctx, consAddr, distributionHeight, power, fraction)
+// burnedCoins returns the coins of the staking tokens burned by a slash.
+func (k Keeper) burnedCoins(ctx sdk.Context, burned sdk.Int) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(k.sk.BondDenom(ctx), burned))
 }
 
 // Jail attempts to jail a validator. The slash is delegated to the staking module
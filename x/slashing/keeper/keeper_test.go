@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 )
 
@@ -21,10 +22,10 @@ func TestUnJailNotBonded(t *testing.T) {
 	p.MaxValidators = 5
 	app.StakingKeeper.SetParams(ctx, p)
 
-	amt := sdk.TokensFromConsensusPower(100)
+	amt := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
 	sh := staking.NewHandler(app.StakingKeeper)
 
-	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 6, sdk.TokensFromConsensusPower(200))
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 6, sdk.TokensFromConsensusPower(200, sdk.DefaultPowerReduction))
 	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
 	pks := simapp.CreateTestPubKeys(6)
 
@@ -41,8 +42,8 @@ func TestUnJailNotBonded(t *testing.T) {
 
 	// create a 6th validator with less power than the cliff validator (won't be bonded)
 	addr, val := valAddrs[5], pks[5]
-	createValMsg := keeper.NewTestMsgCreateValidator(addr, val, sdk.TokensFromConsensusPower(50))
-	createValMsg.MinSelfDelegation = sdk.TokensFromConsensusPower(50)
+	createValMsg := keeper.NewTestMsgCreateValidator(addr, val, sdk.TokensFromConsensusPower(50, sdk.DefaultPowerReduction))
+	createValMsg.MinSelfDelegation = sdk.TokensFromConsensusPower(50, sdk.DefaultPowerReduction)
 	res, err := sh(ctx, createValMsg)
 	require.NoError(t, err)
 	require.NotNil(t, res)
@@ -56,7 +57,7 @@ func TestUnJailNotBonded(t *testing.T) {
 	require.Equal(t, sdk.BondStatusUnbonded, validator.GetStatus().String())
 
 	// unbond below minimum self-delegation
-	msgUnbond := staking.NewMsgUndelegate(sdk.AccAddress(addr), addr, sdk.NewCoin(p.BondDenom, sdk.TokensFromConsensusPower(1)))
+	msgUnbond := staking.NewMsgUndelegate(sdk.AccAddress(addr), addr, sdk.NewCoin(p.BondDenom, sdk.TokensFromConsensusPower(1, sdk.DefaultPowerReduction)))
 	res, err = sh(ctx, msgUnbond)
 	require.NoError(t, err)
 	require.NotNil(t, res)
@@ -76,7 +77,7 @@ func TestUnJailNotBonded(t *testing.T) {
 	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
 
 	// bond to meet minimum self-delegation
-	msgBond := staking.NewMsgDelegate(sdk.AccAddress(addr), addr, sdk.NewCoin(p.BondDenom, sdk.TokensFromConsensusPower(1)))
+	msgBond := staking.NewMsgDelegate(sdk.AccAddress(addr), addr, sdk.NewCoin(p.BondDenom, sdk.TokensFromConsensusPower(1, sdk.DefaultPowerReduction)))
 	res, err = sh(ctx, msgBond)
 	require.NoError(t, err)
 	require.NotNil(t, res)
@@ -92,6 +93,53 @@ func TestUnJailNotBonded(t *testing.T) {
 	require.False(t, validator.Jailed)
 }
 
+// Test that a slash emits an event with the infraction and the burned tokens
+func TestSlashEvent(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	power := int64(100)
+	amt := sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, amt)
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+	pks := simapp.CreateTestPubKeys(1)
+
+	sh := staking.NewHandler(app.StakingKeeper)
+	res, err := sh(ctx, keeper.NewTestMsgCreateValidator(valAddrs[0], pks[0], amt))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	consAddr := sdk.ConsAddress(pks[0].Address())
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	app.SlashingKeeper.Slash(ctx, consAddr, sdk.NewDecWithPrec(5, 2), power, ctx.BlockHeight())
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeSlash, events[0].Type)
+
+	attributes := make(map[string]string)
+	for _, attribute := range events[0].Attributes {
+		attributes[string(attribute.Key)] = string(attribute.Value)
+	}
+
+	require.Equal(t, map[string]string{
+		types.AttributeKeyAddress:          consAddr.String(),
+		types.AttributeKeyPower:            "100",
+		types.AttributeKeyReason:           types.AttributeValueDoubleSign,
+		types.AttributeKeyInfractionHeight: "1",
+		types.AttributeKeyBurnedCoins: sdk.NewCoins(
+			sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(5, sdk.DefaultPowerReduction)),
+		).String(),
+	}, attributes)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, sdk.TokensFromConsensusPower(95, sdk.DefaultPowerReduction), validator.GetTokens())
+}
+
 // Test a new validator entering the validator set
 // Ensure that SigningInfo.StartHeight is set correctly
 // and that they are not immediately jailed
@@ -99,12 +147,12 @@ func TestHandleNewValidator(t *testing.T) {
 	app := simapp.Setup(false)
 	ctx := app.BaseApp.NewContext(false, abci.Header{})
 
-	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(200))
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(200, sdk.DefaultPowerReduction))
 	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
 	pks := simapp.CreateTestPubKeys(1)
 
 	addr, val := valAddrs[0], pks[0]
-	amt := sdk.TokensFromConsensusPower(100)
+	amt := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
 	sh := staking.NewHandler(app.StakingKeeper)
 
 	ctx = ctx.WithBlockHeight(app.SlashingKeeper.SignedBlocksWindow(ctx) + 1)
@@ -138,7 +186,7 @@ func TestHandleNewValidator(t *testing.T) {
 	validator, _ := app.StakingKeeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(val))
 	require.Equal(t, sdk.Bonded, validator.GetStatus())
 	bondPool := app.StakingKeeper.GetBondedPool(ctx)
-	expTokens := sdk.TokensFromConsensusPower(100)
+	expTokens := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
 	require.Equal(t, expTokens.Int64(), app.BankKeeper.GetBalance(ctx, bondPool.GetAddress(), app.StakingKeeper.BondDenom(ctx)).Amount.Int64())
 }
 
@@ -150,8 +198,8 @@ func TestHandleAlreadyJailed(t *testing.T) {
 	ctx := app.BaseApp.NewContext(false, abci.Header{})
 	power := int64(100)
 
-	amt := sdk.TokensFromConsensusPower(power)
-	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(200))
+	amt := sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(200, sdk.DefaultPowerReduction))
 	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
 	pks := simapp.CreateTestPubKeys(1)
 
@@ -184,7 +232,7 @@ func TestHandleAlreadyJailed(t *testing.T) {
 	require.Equal(t, sdk.Unbonding, validator.GetStatus())
 
 	// validator should have been slashed
-	resultingTokens := amt.Sub(sdk.TokensFromConsensusPower(1))
+	resultingTokens := amt.Sub(sdk.TokensFromConsensusPower(1, sdk.DefaultPowerReduction))
 	require.Equal(t, resultingTokens, validator.GetTokens())
 
 	// another block missed
@@ -213,9 +261,9 @@ func TestValidatorDippingInAndOut(t *testing.T) {
 	power := int64(100)
 
 	pks := simapp.CreateTestPubKeys(3)
-	simapp.AddTestAddrsFromPubKeys(app, ctx, pks, sdk.TokensFromConsensusPower(200))
+	simapp.AddTestAddrsFromPubKeys(app, ctx, pks, sdk.TokensFromConsensusPower(200, sdk.DefaultPowerReduction))
 
-	amt := sdk.TokensFromConsensusPower(power)
+	amt := sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)
 	addr, val := pks[0].Address(), pks[0]
 	consAddr := sdk.ConsAddress(addr)
 	sh := staking.NewHandler(app.StakingKeeper)
@@ -233,7 +281,7 @@ func TestValidatorDippingInAndOut(t *testing.T) {
 	}
 
 	// kick first validator out of validator set
-	newAmt := sdk.TokensFromConsensusPower(101)
+	newAmt := sdk.TokensFromConsensusPower(101, sdk.DefaultPowerReduction)
 	res, err = sh(ctx, keeper.NewTestMsgCreateValidator(sdk.ValAddress(pks[1].Address()), pks[1], newAmt))
 	require.NoError(t, err)
 	require.NotNil(t, res)
@@ -248,7 +296,7 @@ func TestValidatorDippingInAndOut(t *testing.T) {
 	ctx = ctx.WithBlockHeight(height)
 
 	// validator added back in
-	delTokens := sdk.TokensFromConsensusPower(50)
+	delTokens := sdk.TokensFromConsensusPower(50, sdk.DefaultPowerReduction)
 	res, err = sh(ctx, keeper.NewTestMsgDelegate(sdk.AccAddress(pks[2].Address()), sdk.ValAddress(pks[0].Address()), delTokens))
 	require.NoError(t, err)
 	require.NotNil(t, res)
@@ -317,3 +365,115 @@ func TestValidatorDippingInAndOut(t *testing.T) {
 	require.Equal(t, sdk.Unbonding, validator.Status)
 
 }
+
+// Test that the missed blocks left outside of a shortened signed blocks window
+// are pruned once the missed block bit array wraps around
+func TestPruneMissedBlocksOutsideWindow(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: time.Unix(0, 0)})
+	app.SlashingKeeper.SetParams(ctx, keeper.TestParams())
+
+	pks := simapp.CreateTestPubKeys(1)
+	consAddr := sdk.ConsAddress(pks[0].Address())
+	app.SlashingKeeper.AddPubkey(ctx, pks[0])
+
+	// miss blocks at the start and the end of the window
+	info := types.NewValidatorSigningInfo(consAddr, 0, 0, time.Unix(0, 0), false, 0)
+	app.SlashingKeeper.SetValidatorSigningInfo(ctx, consAddr, info)
+	for height := int64(0); height < app.SlashingKeeper.SignedBlocksWindow(ctx); height++ {
+		ctx = ctx.WithBlockHeight(height)
+		app.SlashingKeeper.HandleValidatorSignature(ctx, pks[0].Address(), 100, height != 1 && height != 900)
+	}
+
+	info, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, int64(2), info.MissedBlocksCounter)
+
+	// shorten the window, the block missed at index 900 is now outside of it
+	params := app.SlashingKeeper.GetParams(ctx)
+	params.SignedBlocksWindow = 100
+	app.SlashingKeeper.SetParams(ctx, params)
+
+	// the array wraps around and prunes the block missed outside of the window
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	app.SlashingKeeper.HandleValidatorSignature(ctx, pks[0].Address(), 100, true)
+
+	info, found = app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, int64(1), info.MissedBlocksCounter)
+	require.False(t, app.SlashingKeeper.GetValidatorMissedBlockBitArray(ctx, consAddr, 900))
+	require.True(t, app.SlashingKeeper.GetValidatorMissedBlockBitArray(ctx, consAddr, 1))
+}
+
+// Test that the unjail status tells why a validator cannot be unjailed, and
+// that a validator jailed for falling below its minimum self delegation stays
+// jailed for the minimum self delegation jail duration
+func TestUnjailStatus(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: time.Unix(0, 0)})
+
+	params := keeper.TestParams()
+	params.MinSelfDelegationJailDuration = time.Hour
+	app.SlashingKeeper.SetParams(ctx, params)
+
+	amt := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+	unit := sdk.TokensFromConsensusPower(1, sdk.DefaultPowerReduction)
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(200, sdk.DefaultPowerReduction))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+	pks := simapp.CreateTestPubKeys(1)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	sh := staking.NewHandler(app.StakingKeeper)
+	createValMsg := keeper.NewTestMsgCreateValidator(valAddrs[0], pks[0], amt)
+	createValMsg.MinSelfDelegation = amt
+	res, err := sh(ctx, createValMsg)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	_, err = app.SlashingKeeper.UnjailStatus(ctx, sdk.ValAddress("unknown_____________"))
+	require.True(t, types.ErrNoValidatorForAddress.Is(err))
+
+	status, err := app.SlashingKeeper.UnjailStatus(ctx, valAddrs[0])
+	require.NoError(t, err)
+	require.False(t, status.CanUnjail)
+	require.False(t, status.Jailed)
+	require.Equal(t, types.ErrValidatorNotJailed.Error(), status.Reason)
+
+	// unbond below the minimum self delegation, jailing the validator
+	res, err = sh(ctx, staking.NewMsgUndelegate(addrDels[0], valAddrs[0], sdk.NewCoin(bondDenom, unit)))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	status, err = app.SlashingKeeper.UnjailStatus(ctx, valAddrs[0])
+	require.NoError(t, err)
+	require.False(t, status.CanUnjail)
+	require.True(t, status.Jailed)
+	require.Equal(t, amt.Sub(unit), status.SelfDelegation)
+	require.Equal(t, amt, status.MinSelfDelegation)
+	require.Contains(t, status.Reason, types.ErrSelfDelegationTooLowToUnjail.Error())
+
+	// bond back above the minimum, the validator still has to wait
+	res, err = sh(ctx, staking.NewMsgDelegate(addrDels[0], valAddrs[0], sdk.NewCoin(bondDenom, unit)))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(time.Minute))
+	status, err = app.SlashingKeeper.UnjailStatus(ctx, valAddrs[0])
+	require.NoError(t, err)
+	require.False(t, status.CanUnjail)
+	require.Equal(t, time.Unix(0, 0).Add(time.Hour).UTC(), status.JailedUntil)
+	require.Equal(t, 59*time.Minute, status.JailTimeRemaining)
+	require.Contains(t, status.Reason, types.ErrValidatorJailed.Error())
+	require.True(t, types.ErrValidatorJailed.Is(app.SlashingKeeper.Unjail(ctx, valAddrs[0])))
+
+	// the validator can unjail once the minimum jail duration has passed
+	ctx = ctx.WithBlockTime(time.Unix(0, 0).Add(time.Hour))
+	status, err = app.SlashingKeeper.UnjailStatus(ctx, valAddrs[0])
+	require.NoError(t, err)
+	require.True(t, status.CanUnjail)
+	require.Empty(t, status.Reason)
+	require.Zero(t, status.JailTimeRemaining)
+	require.NoError(t, app.SlashingKeeper.Unjail(ctx, valAddrs[0]))
+}
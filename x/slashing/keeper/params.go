@@ -42,6 +42,13 @@ func (k Keeper) SlashFractionDowntime(ctx sdk.Context) (res sdk.Dec) {
 	return
 }
 
+// MinSelfDelegationJailDuration - minimum jail duration for falling below the
+// minimum self delegation
+func (k Keeper) MinSelfDelegationJailDuration(ctx sdk.Context) (res time.Duration) {
+	k.paramspace.Get(ctx, types.KeyMinSelfDelegationJailDuration, &res)
+	return
+}
+
 // GetParams returns the total set of slashing parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramspace.GetParamSet(ctx, &params)
@@ -3,7 +3,6 @@ package keeper
 import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
-	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -23,6 +22,9 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case types.QuerySigningInfos:
 			return querySigningInfos(ctx, req, k)
 
+		case types.QueryUnjailStatus:
+			return queryUnjailStatus(ctx, req, k)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint: %s", types.ModuleName, path[0])
 		}
@@ -69,18 +71,19 @@ func querySigningInfos(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	var signingInfos []types.ValidatorSigningInfo
+	if params.Limit < 0 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid limit %d", params.Limit)
+	}
 
-	k.IterateValidatorSigningInfos(ctx, func(consAddr sdk.ConsAddress, info types.ValidatorSigningInfo) (stop bool) {
-		signingInfos = append(signingInfos, info)
-		return false
-	})
+	// the signing infos are paginated from the store rather than in memory
+	signingInfos := []types.ValidatorSigningInfo{}
+	if params.Page > 0 {
+		limit := params.Limit
+		if limit == 0 {
+			limit = int(k.sk.MaxValidators(ctx))
+		}
 
-	start, end := client.Paginate(len(signingInfos), params.Page, params.Limit, int(k.sk.MaxValidators(ctx)))
-	if start < 0 || end < 0 {
-		signingInfos = []types.ValidatorSigningInfo{}
-	} else {
-		signingInfos = signingInfos[start:end]
+		signingInfos = k.GetPaginatedValidatorSigningInfos(ctx, params.Page, limit)
 	}
 
 	res, err := codec.MarshalJSONIndent(types.ModuleCdc, signingInfos)
@@ -90,3 +93,24 @@ func querySigningInfos(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte
 
 	return res, nil
 }
+
+func queryUnjailStatus(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryUnjailStatusParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	status, err := k.UnjailStatus(ctx, params.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, params.ValidatorAddress.String())
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, status)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
@@ -3,8 +3,6 @@ package keeper
 import (
 	"time"
 
-	gogotypes "github.com/gogo/protobuf/types"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
@@ -54,38 +52,56 @@ func (k Keeper) IterateValidatorSigningInfos(ctx sdk.Context,
 	}
 }
 
+// GetPaginatedValidatorSigningInfos returns a page of the stored
+// ValidatorSigningInfos, ordered by consensus address
+func (k Keeper) GetPaginatedValidatorSigningInfos(ctx sdk.Context, page, limit int) []types.ValidatorSigningInfo {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIteratorPaginated(store, types.ValidatorSigningInfoKey, uint(page), uint(limit))
+	defer iter.Close()
+
+	signingInfos := []types.ValidatorSigningInfo{}
+	for ; iter.Valid(); iter.Next() {
+		var info types.ValidatorSigningInfo
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &info)
+		signingInfos = append(signingInfos, info)
+	}
+
+	return signingInfos
+}
+
 // GetValidatorMissedBlockBitArray gets the bit for the missed blocks array
 func (k Keeper) GetValidatorMissedBlockBitArray(ctx sdk.Context, address sdk.ConsAddress, index int64) bool {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.GetValidatorMissedBlockBitArrayKey(address, index))
-	var missed gogotypes.BoolValue
-	if bz == nil {
-		// lazy: treat empty key as not missed
+	chunk := k.getValidatorMissedBlockBitmapChunk(ctx, address, index/types.MissedBlockBitmapChunkSize)
+	if chunk == nil {
+		// lazy: treat empty chunk as not missed
 		return false
 	}
-	k.cdc.MustUnmarshalBinaryBare(bz, &missed)
 
-	return missed.Value
+	bit := index % types.MissedBlockBitmapChunkSize
+	return chunk[bit/8]&(1<<uint(bit%8)) != 0
 }
 
-// IterateValidatorMissedBlockBitArray iterates over the signed blocks window
-// and performs a callback function
+// IterateValidatorMissedBlockBitArray iterates over the missed blocks of the
+// bitmap in order and performs a callback function
 func (k Keeper) IterateValidatorMissedBlockBitArray(ctx sdk.Context,
 	address sdk.ConsAddress, handler func(index int64, missed bool) (stop bool)) {
 
 	store := ctx.KVStore(k.storeKey)
-	index := int64(0)
-	// Array may be sparse
-	for ; index < k.SignedBlocksWindow(ctx); index++ {
-		var missed gogotypes.BoolValue
-		bz := store.Get(types.GetValidatorMissedBlockBitArrayKey(address, index))
-		if bz == nil {
-			continue
-		}
-
-		k.cdc.MustUnmarshalBinaryBare(bz, &missed)
-		if handler(index, missed.Value) {
-			break
+	iter := sdk.KVStorePrefixIterator(store, types.GetValidatorMissedBlockBitmapPrefixKey(address))
+	defer iter.Close()
+	// Bitmap may be sparse, only the chunks with missed blocks are stored
+	for ; iter.Valid(); iter.Next() {
+		offset := types.GetValidatorMissedBlockBitmapChunkIndex(iter.Key()) * types.MissedBlockBitmapChunkSize
+		for bit, b := range iter.Value() {
+			for i := 0; i < 8; i++ {
+				if b&(1<<uint(i)) == 0 {
+					continue
+				}
+
+				if handler(offset+int64(bit*8+i), true) {
+					return
+				}
+			}
 		}
 	}
 }
@@ -131,17 +147,82 @@ func (k Keeper) IsTombstoned(ctx sdk.Context, consAddr sdk.ConsAddress) bool {
 // SetValidatorMissedBlockBitArray sets the bit that checks if the validator has
 // missed a block in the current window
 func (k Keeper) SetValidatorMissedBlockBitArray(ctx sdk.Context, address sdk.ConsAddress, index int64, missed bool) {
-	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshalBinaryBare(&gogotypes.BoolValue{Value: missed})
-	store.Set(types.GetValidatorMissedBlockBitArrayKey(address, index), bz)
+	chunkIndex := index / types.MissedBlockBitmapChunkSize
+	chunk := k.getValidatorMissedBlockBitmapChunk(ctx, address, chunkIndex)
+	if chunk == nil {
+		if !missed {
+			return
+		}
+
+		chunk = make([]byte, types.MissedBlockBitmapChunkSize/8)
+	}
+
+	bit := index % types.MissedBlockBitmapChunkSize
+	if missed {
+		chunk[bit/8] |= 1 << uint(bit%8)
+	} else {
+		chunk[bit/8] &^= 1 << uint(bit%8)
+	}
+
+	k.setValidatorMissedBlockBitmapChunk(ctx, address, chunkIndex, chunk)
+}
+
+// pruneValidatorMissedBlockBitArray clears the bits of the missed blocks array
+// outside of the given signed blocks window, which are left behind when the
+// window is shortened, and returns the number of missed blocks cleared.
+func (k Keeper) pruneValidatorMissedBlockBitArray(ctx sdk.Context, address sdk.ConsAddress, window int64) (pruned int64) {
+	var indexes []int64
+	k.IterateValidatorMissedBlockBitArray(ctx, address, func(index int64, _ bool) (stop bool) {
+		if index >= window {
+			indexes = append(indexes, index)
+		}
+		return false
+	})
+
+	for _, index := range indexes {
+		k.SetValidatorMissedBlockBitArray(ctx, address, index, false)
+	}
+
+	return int64(len(indexes))
 }
 
-// clearValidatorMissedBlockBitArray deletes every instance of ValidatorMissedBlockBitArray in the store
+// clearValidatorMissedBlockBitArray deletes every chunk of the missed block bitmap in the store
 func (k Keeper) clearValidatorMissedBlockBitArray(ctx sdk.Context, address sdk.ConsAddress) {
 	store := ctx.KVStore(k.storeKey)
-	iter := sdk.KVStorePrefixIterator(store, types.GetValidatorMissedBlockBitArrayPrefixKey(address))
+	iter := sdk.KVStorePrefixIterator(store, types.GetValidatorMissedBlockBitmapPrefixKey(address))
 	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
 		store.Delete(iter.Key())
 	}
 }
+
+// getValidatorMissedBlockBitmapChunk returns a copy of a chunk of the missed
+// block bitmap, safe to modify, or nil if none of its blocks were missed
+func (k Keeper) getValidatorMissedBlockBitmapChunk(ctx sdk.Context, address sdk.ConsAddress, chunkIndex int64) []byte {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorMissedBlockBitmapChunkKey(address, chunkIndex))
+	if bz == nil {
+		return nil
+	}
+
+	chunk := make([]byte, len(bz))
+	copy(chunk, bz)
+
+	return chunk
+}
+
+// setValidatorMissedBlockBitmapChunk stores a chunk of the missed block bitmap,
+// deleting it when none of its blocks were missed
+func (k Keeper) setValidatorMissedBlockBitmapChunk(ctx sdk.Context, address sdk.ConsAddress, chunkIndex int64, chunk []byte) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetValidatorMissedBlockBitmapChunkKey(address, chunkIndex)
+
+	for _, b := range chunk {
+		if b != 0 {
+			store.Set(key, chunk)
+			return
+		}
+	}
+
+	store.Delete(key)
+}
@@ -16,7 +16,7 @@ import (
 func TestGetSetValidatorSigningInfo(t *testing.T) {
 	app := simapp.Setup(false)
 	ctx := app.BaseApp.NewContext(false, abci.Header{})
-	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(200))
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(200, sdk.DefaultPowerReduction))
 
 	info, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, sdk.ConsAddress(addrDels[0]))
 	require.False(t, found)
@@ -40,19 +40,59 @@ func TestGetSetValidatorSigningInfo(t *testing.T) {
 func TestGetSetValidatorMissedBlockBitArray(t *testing.T) {
 	app := simapp.Setup(false)
 	ctx := app.BaseApp.NewContext(false, abci.Header{})
-	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(200))
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(200, sdk.DefaultPowerReduction))
 
 	missed := app.SlashingKeeper.GetValidatorMissedBlockBitArray(ctx, sdk.ConsAddress(addrDels[0]), 0)
 	require.False(t, missed) // treat empty key as not missed
 	app.SlashingKeeper.SetValidatorMissedBlockBitArray(ctx, sdk.ConsAddress(addrDels[0]), 0, true)
 	missed = app.SlashingKeeper.GetValidatorMissedBlockBitArray(ctx, sdk.ConsAddress(addrDels[0]), 0)
 	require.True(t, missed) // now should be missed
+
+	// set a block in another chunk of the bitmap
+	index := int64(types.MissedBlockBitmapChunkSize + 1)
+	app.SlashingKeeper.SetValidatorMissedBlockBitArray(ctx, sdk.ConsAddress(addrDels[0]), index, true)
+	require.True(t, app.SlashingKeeper.GetValidatorMissedBlockBitArray(ctx, sdk.ConsAddress(addrDels[0]), index))
+	require.False(t, app.SlashingKeeper.GetValidatorMissedBlockBitArray(ctx, sdk.ConsAddress(addrDels[0]), index-1))
+
+	var indexes []int64
+	app.SlashingKeeper.IterateValidatorMissedBlockBitArray(ctx, sdk.ConsAddress(addrDels[0]), func(index int64, missed bool) (stop bool) {
+		require.True(t, missed)
+		indexes = append(indexes, index)
+		return false
+	})
+	require.Equal(t, []int64{0, index}, indexes)
+
+	// the chunks are deleted along with their last missed block
+	app.SlashingKeeper.SetValidatorMissedBlockBitArray(ctx, sdk.ConsAddress(addrDels[0]), 0, false)
+	app.SlashingKeeper.SetValidatorMissedBlockBitArray(ctx, sdk.ConsAddress(addrDels[0]), index, false)
+	iterator := sdk.KVStorePrefixIterator(
+		ctx.KVStore(app.GetKey(types.StoreKey)), types.GetValidatorMissedBlockBitmapPrefixKey(sdk.ConsAddress(addrDels[0])),
+	)
+	defer iterator.Close()
+	require.False(t, iterator.Valid())
+}
+
+func TestGetPaginatedValidatorSigningInfos(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.TokensFromConsensusPower(200, sdk.DefaultPowerReduction))
+
+	var infos []types.ValidatorSigningInfo
+	for i, addr := range addrDels {
+		info := types.NewValidatorSigningInfo(sdk.ConsAddress(addr), int64(i), 0, time.Unix(0, 0).UTC(), false, 0)
+		app.SlashingKeeper.SetValidatorSigningInfo(ctx, sdk.ConsAddress(addr), info)
+		infos = append(infos, info)
+	}
+
+	require.Equal(t, infos[:2], app.SlashingKeeper.GetPaginatedValidatorSigningInfos(ctx, 1, 2))
+	require.Equal(t, infos[2:], app.SlashingKeeper.GetPaginatedValidatorSigningInfos(ctx, 2, 2))
+	require.Empty(t, app.SlashingKeeper.GetPaginatedValidatorSigningInfos(ctx, 3, 2))
 }
 
 func TestTombstoned(t *testing.T) {
 	app := simapp.Setup(false)
 	ctx := app.BaseApp.NewContext(false, abci.Header{})
-	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(200))
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(200, sdk.DefaultPowerReduction))
 
 	require.Panics(t, func() { app.SlashingKeeper.Tombstone(ctx, sdk.ConsAddress(addrDels[0])) })
 	require.False(t, app.SlashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(addrDels[0])))
@@ -76,7 +116,7 @@ func TestTombstoned(t *testing.T) {
 func TestJailUntil(t *testing.T) {
 	app := simapp.Setup(false)
 	ctx := app.BaseApp.NewContext(false, abci.Header{})
-	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(200))
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(200, sdk.DefaultPowerReduction))
 
 	require.Panics(t, func() { app.SlashingKeeper.JailUntil(ctx, sdk.ConsAddress(addrDels[0]), time.Now()) })
 
@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingexported "github.com/cosmos/cosmos-sdk/x/staking/exported"
 )
 
 // Unjail calls the staking Unjail function to unjail a validator if the
@@ -14,6 +15,57 @@ func (k Keeper) Unjail(ctx sdk.Context, validatorAddr sdk.ValAddress) error {
 		return types.ErrNoValidatorForAddress
 	}
 
+	if err := k.canUnjail(ctx, validator); err != nil {
+		return err
+	}
+
+	k.sk.Unjail(ctx, sdk.ConsAddress(validator.GetConsPubKey().Address()))
+	return nil
+}
+
+// UnjailStatus returns whether a validator can be unjailed at the current
+// block and, if it cannot, the reason why along with the state it depends on.
+func (k Keeper) UnjailStatus(ctx sdk.Context, validatorAddr sdk.ValAddress) (types.UnjailStatus, error) {
+	validator := k.sk.Validator(ctx, validatorAddr)
+	if validator == nil {
+		return types.UnjailStatus{}, types.ErrNoValidatorForAddress
+	}
+
+	status := types.UnjailStatus{
+		ValidatorAddress:  validatorAddr,
+		Jailed:            validator.IsJailed(),
+		SelfDelegation:    sdk.ZeroInt(),
+		MinSelfDelegation: validator.GetMinSelfDelegation(),
+	}
+
+	if selfDel := k.sk.Delegation(ctx, sdk.AccAddress(validatorAddr), validatorAddr); selfDel != nil {
+		status.SelfDelegation = validator.TokensFromShares(selfDel.GetShares()).TruncateInt()
+	}
+
+	consAddr := sdk.ConsAddress(validator.GetConsPubKey().Address())
+	if info, found := k.GetValidatorSigningInfo(ctx, consAddr); found {
+		status.Tombstoned = info.Tombstoned
+		status.JailedUntil = info.JailedUntil
+
+		if remaining := info.JailedUntil.Sub(ctx.BlockHeader().Time); remaining > 0 {
+			status.JailTimeRemaining = remaining
+		}
+	}
+
+	if err := k.canUnjail(ctx, validator); err != nil {
+		status.Reason = err.Error()
+	} else {
+		status.CanUnjail = true
+	}
+
+	return status, nil
+}
+
+// canUnjail returns the reason why a validator cannot be unjailed at the
+// current block, if any.
+func (k Keeper) canUnjail(ctx sdk.Context, validator stakingexported.ValidatorI) error {
+	validatorAddr := validator.GetOperator()
+
 	// cannot be unjailed if no self-delegation exists
 	selfDel := k.sk.Delegation(ctx, sdk.AccAddress(validatorAddr), validatorAddr)
 	if selfDel == nil {
@@ -47,15 +99,16 @@ func (k Keeper) Unjail(ctx sdk.Context, validatorAddr sdk.ValAddress) error {
 	if found {
 		// cannot be unjailed if tombstoned
 		if info.Tombstoned {
-			return types.ErrValidatorJailed
+			return sdkerrors.Wrap(types.ErrValidatorJailed, "validator is tombstoned")
 		}
 
 		// cannot be unjailed until out of jail
-		if ctx.BlockHeader().Time.Before(info.JailedUntil) {
-			return types.ErrValidatorJailed
+		if now := ctx.BlockHeader().Time; now.Before(info.JailedUntil) {
+			return sdkerrors.Wrapf(
+				types.ErrValidatorJailed, "jailed until %s, %s remaining", info.JailedUntil, info.JailedUntil.Sub(now),
+			)
 		}
 	}
 
-	k.sk.Unjail(ctx, consAddr)
 	return nil
 }
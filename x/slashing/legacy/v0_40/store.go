@@ -0,0 +1,85 @@
+package v040
+
+import (
+	"encoding/binary"
+
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// ValidatorMissedBlockBitArrayKey is the v0.39 prefix of the missed block bit
+// arrays, stored with one bool per block.
+var ValidatorMissedBlockBitArrayKey = []byte{0x02}
+
+// GetValidatorMissedBlockBitArrayKey returns the v0.39 key of a block of the
+// missed block bit array of a validator.
+func GetValidatorMissedBlockBitArrayKey(v sdk.ConsAddress, i int64) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, uint64(i))
+	return append(append(ValidatorMissedBlockBitArrayKey, v.Bytes()...), b...)
+}
+
+// MigrateStore performs an in-place store migration of the x/slashing state of
+// a chain upgrading from v0.39. The migration includes:
+//
+// - Replacing the missed block bit arrays with the chunked missed block bitmaps.
+// - Dropping the blocks which were not missed, which are no longer stored.
+// - Setting the MinSelfDelegationJailDuration parameter to its default.
+//
+// It is meant to be called from an x/upgrade handler. The paramSpace must be
+// the slashing module's subspace with its key table set.
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.Marshaler, paramSpace types.ParamSubspace) error {
+	paramSpace.Set(ctx, types.KeyMinSelfDelegationJailDuration, types.DefaultMinSelfDelegationJailDuration)
+
+	store := ctx.KVStore(storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, ValidatorMissedBlockBitArrayKey)
+	defer iterator.Close()
+
+	var (
+		keys      [][]byte
+		chunkKeys []string
+	)
+
+	chunks := make(map[string][]byte)
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		keys = append(keys, key)
+
+		var missed gogotypes.BoolValue
+		if err := cdc.UnmarshalBinaryBare(iterator.Value(), &missed); err != nil {
+			return err
+		}
+
+		if !missed.Value {
+			continue
+		}
+
+		address := sdk.ConsAddress(key[1 : 1+sdk.AddrLen])
+		index := int64(binary.LittleEndian.Uint64(key[1+sdk.AddrLen:]))
+
+		chunkKey := string(types.GetValidatorMissedBlockBitmapChunkKey(address, index/types.MissedBlockBitmapChunkSize))
+		chunk, ok := chunks[chunkKey]
+		if !ok {
+			chunk = make([]byte, types.MissedBlockBitmapChunkSize/8)
+			chunks[chunkKey] = chunk
+			chunkKeys = append(chunkKeys, chunkKey)
+		}
+
+		bit := index % types.MissedBlockBitmapChunkSize
+		chunk[bit/8] |= 1 << uint(bit%8)
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	for _, chunkKey := range chunkKeys {
+		store.Set([]byte(chunkKey), chunks[chunkKey])
+	}
+
+	return nil
+}
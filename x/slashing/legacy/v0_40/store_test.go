@@ -0,0 +1,61 @@
+package v040_test
+
+import (
+	"testing"
+
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v040slashing "github.com/cosmos/cosmos-sdk/x/slashing/legacy/v0_40"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	storeKey := app.GetKey(types.StoreKey)
+	cdc := std.NewAppCodec(app.Codec())
+
+	consAddr1 := sdk.ConsAddress([]byte("cons1_______________"))
+	consAddr2 := sdk.ConsAddress([]byte("cons2_______________"))
+
+	// write v0.39 missed block bit arrays, with missed and signed blocks
+	store := ctx.KVStore(storeKey)
+	setMissed := func(addr sdk.ConsAddress, index int64, missed bool) {
+		store.Set(
+			v040slashing.GetValidatorMissedBlockBitArrayKey(addr, index),
+			cdc.MustMarshalBinaryBare(&gogotypes.BoolValue{Value: missed}),
+		)
+	}
+
+	setMissed(consAddr1, 0, true)
+	setMissed(consAddr1, 1, false)
+	setMissed(consAddr1, 1500, true)
+	setMissed(consAddr2, 3, false)
+
+	require.NoError(t, v040slashing.MigrateStore(ctx, storeKey, cdc, app.GetSubspace(types.ModuleName)))
+	require.Equal(t, types.DefaultMinSelfDelegationJailDuration, app.SlashingKeeper.MinSelfDelegationJailDuration(ctx))
+
+	iterator := sdk.KVStorePrefixIterator(store, v040slashing.ValidatorMissedBlockBitArrayKey)
+	require.False(t, iterator.Valid())
+	iterator.Close()
+
+	var indexes []int64
+	app.SlashingKeeper.IterateValidatorMissedBlockBitArray(ctx, consAddr1, func(index int64, missed bool) (stop bool) {
+		require.True(t, missed)
+		indexes = append(indexes, index)
+		return false
+	})
+	require.Equal(t, []int64{0, 1500}, indexes)
+	require.True(t, app.SlashingKeeper.GetValidatorMissedBlockBitArray(ctx, consAddr1, 1500))
+	require.False(t, app.SlashingKeeper.GetValidatorMissedBlockBitArray(ctx, consAddr1, 1))
+
+	// only the chunks with missed blocks are stored
+	iterator = sdk.KVStorePrefixIterator(store, types.GetValidatorMissedBlockBitmapPrefixKey(consAddr2))
+	require.False(t, iterator.Valid())
+	iterator.Close()
+}
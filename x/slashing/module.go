@@ -17,6 +17,7 @@ import (
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/slashing/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/slashing/client/rest"
+	"github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	"github.com/cosmos/cosmos-sdk/x/slashing/simulation"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
@@ -26,6 +27,7 @@ var (
 	_ module.AppModule           = AppModule{}
 	_ module.AppModuleBasic      = AppModuleBasic{}
 	_ module.AppModuleSimulation = AppModule{}
+	_ module.AppModuleMigrations = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the slashing module.
@@ -107,6 +109,16 @@ func (AppModule) Name() string {
 // RegisterInvariants registers the slashing module invariants.
 func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
 
+// ConsensusVersion returns the consensus version of the slashing module.
+func (AppModule) ConsensusVersion() uint64 { return 2 }
+
+// RegisterMigrations registers the slashing module in-place store migrations.
+func (am AppModule) RegisterMigrations(configurator module.Configurator) {
+	if err := configurator.RegisterMigration(ModuleName, 1, keeper.NewMigrator(am.keeper).Migrate1to2); err != nil {
+		panic(err)
+	}
+}
+
 // Route returns the message routing key for the slashing module.
 func (AppModule) Route() string {
 	return RouterKey
@@ -140,7 +152,10 @@ func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONMarshaler, data j
 // module.
 func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONMarshaler) json.RawMessage {
 	gs := ExportGenesis(ctx, am.keeper)
-	return cdc.MustMarshalJSON(gs)
+
+	// the keys of the JSON objects are sorted, as the signing infos and missed
+	// blocks are maps, encoded by amino in their random iteration order
+	return sdk.MustSortJSON(cdc.MustMarshalJSON(gs))
 }
 
 // BeginBlock returns the begin blocker for the slashing module.
@@ -22,11 +22,8 @@ func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB tmkv.Pair) string {
 			cdc.MustUnmarshalBinaryBare(kvB.Value, &infoB)
 			return fmt.Sprintf("%v\n%v", infoA, infoB)
 
-		case bytes.Equal(kvA.Key[:1], types.ValidatorMissedBlockBitArrayKey):
-			var missedA, missedB gogotypes.BoolValue
-			cdc.MustUnmarshalBinaryBare(kvA.Value, &missedA)
-			cdc.MustUnmarshalBinaryBare(kvB.Value, &missedB)
-			return fmt.Sprintf("missedA: %v\nmissedB: %v", missedA.Value, missedB.Value)
+		case bytes.Equal(kvA.Key[:1], types.ValidatorMissedBlockBitmapKey):
+			return fmt.Sprintf("missedA: %X\nmissedB: %X", kvA.Value, kvB.Value)
 
 		case bytes.Equal(kvA.Key[:1], types.AddrPubkeyRelationKey):
 			var pubKeyA, pubKeyB gogotypes.StringValue
@@ -34,11 +34,12 @@ func TestDecodeStore(t *testing.T) {
 
 	info := types.NewValidatorSigningInfo(consAddr1, 0, 1, time.Now().UTC(), false, 0)
 	bechPK := sdk.MustBech32ifyPubKey(sdk.Bech32PubKeyTypeConsPub, delPk1)
-	missed := gogotypes.BoolValue{Value: true}
+	missed := make([]byte, types.MissedBlockBitmapChunkSize/8)
+	missed[0] = 1 << 6
 
 	kvPairs := tmkv.Pairs{
 		tmkv.Pair{Key: types.GetValidatorSigningInfoKey(consAddr1), Value: cdc.MustMarshalBinaryBare(&info)},
-		tmkv.Pair{Key: types.GetValidatorMissedBlockBitArrayKey(consAddr1, 6), Value: cdc.MustMarshalBinaryBare(&missed)},
+		tmkv.Pair{Key: types.GetValidatorMissedBlockBitmapChunkKey(consAddr1, 0), Value: missed},
 		tmkv.Pair{Key: types.GetAddrPubkeyRelationKey(delAddr1), Value: cdc.MustMarshalBinaryBare(&gogotypes.StringValue{Value: bechPK})},
 		tmkv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
@@ -48,7 +49,7 @@ func TestDecodeStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"ValidatorSigningInfo", fmt.Sprintf("%v\n%v", info, info)},
-		{"ValidatorMissedBlockBitArray", fmt.Sprintf("missedA: %v\nmissedB: %v", missed.Value, missed.Value)},
+		{"ValidatorMissedBlockBitmap", fmt.Sprintf("missedA: %X\nmissedB: %X", missed, missed)},
 		{"AddrPubkeyRelation", fmt.Sprintf("PubKeyA: %s\nPubKeyB: %s", bechPK, bechPK)},
 		{"other", ""},
 	}
@@ -22,6 +22,8 @@ const (
 	DowntimeJailDuration    = "downtime_jail_duration"
 	SlashFractionDoubleSign = "slash_fraction_double_sign"
 	SlashFractionDowntime   = "slash_fraction_downtime"
+
+	MinSelfDelegationJailDuration = "min_self_delegation_jail_duration"
 )
 
 // GenSignedBlocksWindow randomized SignedBlocksWindow
@@ -49,6 +51,11 @@ func GenSlashFractionDowntime(r *rand.Rand) sdk.Dec {
 	return sdk.NewDec(1).Quo(sdk.NewDec(int64(r.Intn(200) + 1)))
 }
 
+// GenMinSelfDelegationJailDuration randomized MinSelfDelegationJailDuration
+func GenMinSelfDelegationJailDuration(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 0, 60*60)) * time.Second
+}
+
 // RandomizedGenState generates a random GenesisState for slashing
 func RandomizedGenState(simState *module.SimulationState) {
 	var signedBlocksWindow int64
@@ -81,9 +88,15 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { slashFractionDowntime = GenSlashFractionDowntime(r) },
 	)
 
+	var minSelfDelegationJailDuration time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MinSelfDelegationJailDuration, &minSelfDelegationJailDuration, simState.Rand,
+		func(r *rand.Rand) { minSelfDelegationJailDuration = GenMinSelfDelegationJailDuration(r) },
+	)
+
 	params := types.NewParams(
 		signedBlocksWindow, minSignedPerWindow, downtimeJailDuration,
-		slashFractionDoubleSign, slashFractionDowntime,
+		slashFractionDoubleSign, slashFractionDowntime, minSelfDelegationJailDuration,
 	)
 
 	slashingGenesis := types.NewGenesisState(params, nil, nil)
@@ -6,12 +6,14 @@ const (
 	EventTypeSlash    = "slash"
 	EventTypeLiveness = "liveness"
 
-	AttributeKeyAddress      = "address"
-	AttributeKeyHeight       = "height"
-	AttributeKeyPower        = "power"
-	AttributeKeyReason       = "reason"
-	AttributeKeyJailed       = "jailed"
-	AttributeKeyMissedBlocks = "missed_blocks"
+	AttributeKeyAddress          = "address"
+	AttributeKeyHeight           = "height"
+	AttributeKeyInfractionHeight = "infraction_height"
+	AttributeKeyPower            = "power"
+	AttributeKeyReason           = "reason"
+	AttributeKeyJailed           = "jailed"
+	AttributeKeyMissedBlocks     = "missed_blocks"
+	AttributeKeyBurnedCoins      = "burned_coins"
 
 	AttributeValueDoubleSign       = "double_sign"
 	AttributeValueMissingSignature = "missing_signature"
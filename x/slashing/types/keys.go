@@ -18,6 +18,10 @@ const (
 
 	// QuerierRoute is the querier route for slashing
 	QuerierRoute = ModuleName
+
+	// MissedBlockBitmapChunkSize is the number of blocks, i.e. of bits, stored
+	// in each chunk of the missed block bitmap of a validator
+	MissedBlockBitmapChunkSize = 1024
 )
 
 // Keys for slashing store
@@ -25,13 +29,16 @@ const (
 //
 // - 0x01<consAddress_Bytes>: ValidatorSigningInfo
 //
-// - 0x02<consAddress_Bytes><period_Bytes>: bool
-//
 // - 0x03<accAddr_Bytes>: crypto.PubKey
+//
+// - 0x04<consAddress_Bytes><chunkIndex_Bytes>: []byte
+//
+// The missed block bit arrays used to be stored with one bool per block under
+// the 0x02 prefix.
 var (
-	ValidatorSigningInfoKey         = []byte{0x01} // Prefix for signing info
-	ValidatorMissedBlockBitArrayKey = []byte{0x02} // Prefix for missed block bit array
-	AddrPubkeyRelationKey           = []byte{0x03} // Prefix for address-pubkey relation
+	ValidatorSigningInfoKey       = []byte{0x01} // Prefix for signing info
+	AddrPubkeyRelationKey         = []byte{0x03} // Prefix for address-pubkey relation
+	ValidatorMissedBlockBitmapKey = []byte{0x04} // Prefix for missed block bitmap chunks
 )
 
 // GetValidatorSigningInfoKey - stored by *Consensus* address (not operator address)
@@ -48,16 +55,25 @@ func GetValidatorSigningInfoAddress(key []byte) (v sdk.ConsAddress) {
 	return sdk.ConsAddress(addr)
 }
 
-// GetValidatorMissedBlockBitArrayPrefixKey - stored by *Consensus* address (not operator address)
-func GetValidatorMissedBlockBitArrayPrefixKey(v sdk.ConsAddress) []byte {
-	return append(ValidatorMissedBlockBitArrayKey, v.Bytes()...)
+// GetValidatorMissedBlockBitmapPrefixKey - stored by *Consensus* address (not operator address)
+func GetValidatorMissedBlockBitmapPrefixKey(v sdk.ConsAddress) []byte {
+	return append(ValidatorMissedBlockBitmapKey, v.Bytes()...)
 }
 
-// GetValidatorMissedBlockBitArrayKey - stored by *Consensus* address (not operator address)
-func GetValidatorMissedBlockBitArrayKey(v sdk.ConsAddress, i int64) []byte {
+// GetValidatorMissedBlockBitmapChunkKey - stored by *Consensus* address (not operator address)
+// and by chunk index, in big endian so that the chunks are iterated in order
+func GetValidatorMissedBlockBitmapChunkKey(v sdk.ConsAddress, chunkIndex int64) []byte {
 	b := make([]byte, 8)
-	binary.LittleEndian.PutUint64(b, uint64(i))
-	return append(GetValidatorMissedBlockBitArrayPrefixKey(v), b...)
+	binary.BigEndian.PutUint64(b, uint64(chunkIndex))
+	return append(GetValidatorMissedBlockBitmapPrefixKey(v), b...)
+}
+
+// GetValidatorMissedBlockBitmapChunkIndex - extract the chunk index from a missed block bitmap chunk key
+func GetValidatorMissedBlockBitmapChunkIndex(key []byte) int64 {
+	if len(key) != 1+sdk.AddrLen+8 {
+		panic("unexpected key length")
+	}
+	return int64(binary.BigEndian.Uint64(key[1+sdk.AddrLen:]))
 }
 
 // GetAddrPubkeyRelationKey gets pubkey relation key used to get the pubkey from the address
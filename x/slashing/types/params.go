@@ -13,6 +13,10 @@ const (
 	DefaultParamspace           = ModuleName
 	DefaultSignedBlocksWindow   = int64(100)
 	DefaultDowntimeJailDuration = 60 * 10 * time.Second
+
+	// DefaultMinSelfDelegationJailDuration lets the validators jailed for falling
+	// below their minimum self delegation unjail as soon as they bond enough
+	DefaultMinSelfDelegationJailDuration = time.Duration(0)
 )
 
 var (
@@ -28,6 +32,8 @@ var (
 	KeyDowntimeJailDuration    = []byte("DowntimeJailDuration")
 	KeySlashFractionDoubleSign = []byte("SlashFractionDoubleSign")
 	KeySlashFractionDowntime   = []byte("SlashFractionDowntime")
+
+	KeyMinSelfDelegationJailDuration = []byte("MinSelfDelegationJailDuration")
 )
 
 // ParamKeyTable for slashing module
@@ -42,34 +48,40 @@ type Params struct {
 	DowntimeJailDuration    time.Duration `json:"downtime_jail_duration" yaml:"downtime_jail_duration"`
 	SlashFractionDoubleSign sdk.Dec       `json:"slash_fraction_double_sign" yaml:"slash_fraction_double_sign"`
 	SlashFractionDowntime   sdk.Dec       `json:"slash_fraction_downtime" yaml:"slash_fraction_downtime"`
+
+	// MinSelfDelegationJailDuration is the minimum duration of the jailing of a
+	// validator for falling below its minimum self delegation
+	MinSelfDelegationJailDuration time.Duration `json:"min_self_delegation_jail_duration" yaml:"min_self_delegation_jail_duration"`
 }
 
 // NewParams creates a new Params object
 func NewParams(
 	signedBlocksWindow int64, minSignedPerWindow sdk.Dec, downtimeJailDuration time.Duration,
-	slashFractionDoubleSign, slashFractionDowntime sdk.Dec,
+	slashFractionDoubleSign, slashFractionDowntime sdk.Dec, minSelfDelegationJailDuration time.Duration,
 ) Params {
 
 	return Params{
-		SignedBlocksWindow:      signedBlocksWindow,
-		MinSignedPerWindow:      minSignedPerWindow,
-		DowntimeJailDuration:    downtimeJailDuration,
-		SlashFractionDoubleSign: slashFractionDoubleSign,
-		SlashFractionDowntime:   slashFractionDowntime,
+		SignedBlocksWindow:            signedBlocksWindow,
+		MinSignedPerWindow:            minSignedPerWindow,
+		DowntimeJailDuration:          downtimeJailDuration,
+		SlashFractionDoubleSign:       slashFractionDoubleSign,
+		SlashFractionDowntime:         slashFractionDowntime,
+		MinSelfDelegationJailDuration: minSelfDelegationJailDuration,
 	}
 }
 
 // String implements the stringer interface for Params
 func (p Params) String() string {
 	return fmt.Sprintf(`Slashing Params:
-  SignedBlocksWindow:      %d
-  MinSignedPerWindow:      %s
-  DowntimeJailDuration:    %s
-  SlashFractionDoubleSign: %s
-  SlashFractionDowntime:   %s`,
+  SignedBlocksWindow:            %d
+  MinSignedPerWindow:            %s
+  DowntimeJailDuration:          %s
+  SlashFractionDoubleSign:       %s
+  SlashFractionDowntime:         %s
+  MinSelfDelegationJailDuration: %s`,
 		p.SignedBlocksWindow, p.MinSignedPerWindow,
 		p.DowntimeJailDuration, p.SlashFractionDoubleSign,
-		p.SlashFractionDowntime)
+		p.SlashFractionDowntime, p.MinSelfDelegationJailDuration)
 }
 
 // ParamSetPairs - Implements params.ParamSet
@@ -80,6 +92,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyDowntimeJailDuration, &p.DowntimeJailDuration, validateDowntimeJailDuration),
 		paramtypes.NewParamSetPair(KeySlashFractionDoubleSign, &p.SlashFractionDoubleSign, validateSlashFractionDoubleSign),
 		paramtypes.NewParamSetPair(KeySlashFractionDowntime, &p.SlashFractionDowntime, validateSlashFractionDowntime),
+		paramtypes.NewParamSetPair(KeyMinSelfDelegationJailDuration, &p.MinSelfDelegationJailDuration, validateMinSelfDelegationJailDuration),
 	}
 }
 
@@ -87,7 +100,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 func DefaultParams() Params {
 	return NewParams(
 		DefaultSignedBlocksWindow, DefaultMinSignedPerWindow, DefaultDowntimeJailDuration,
-		DefaultSlashFractionDoubleSign, DefaultSlashFractionDowntime,
+		DefaultSlashFractionDoubleSign, DefaultSlashFractionDowntime, DefaultMinSelfDelegationJailDuration,
 	)
 }
 
@@ -164,3 +177,16 @@ func validateSlashFractionDowntime(i interface{}) error {
 
 	return nil
 }
+
+func validateMinSelfDelegationJailDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("min self delegation jail duration cannot be negative: %s", v)
+	}
+
+	return nil
+}
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"fmt"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -11,6 +14,7 @@ const (
 	QueryParameters   = "parameters"
 	QuerySigningInfo  = "signingInfo"
 	QuerySigningInfos = "signingInfos"
+	QueryUnjailStatus = "unjailStatus"
 )
 
 // QuerySigningInfoParams defines the params for the following queries:
@@ -34,3 +38,44 @@ type QuerySigningInfosParams struct {
 func NewQuerySigningInfosParams(page, limit int) QuerySigningInfosParams {
 	return QuerySigningInfosParams{page, limit}
 }
+
+// QueryUnjailStatusParams defines the params for the following queries:
+// - 'custom/slashing/unjailStatus'
+type QueryUnjailStatusParams struct {
+	ValidatorAddress sdk.ValAddress
+}
+
+// NewQueryUnjailStatusParams creates a new QueryUnjailStatusParams instance
+func NewQueryUnjailStatusParams(valAddr sdk.ValAddress) QueryUnjailStatusParams {
+	return QueryUnjailStatusParams{valAddr}
+}
+
+// UnjailStatus defines whether a validator can be unjailed at the current
+// block and, if it cannot, the reason why along with the state it depends on.
+type UnjailStatus struct {
+	ValidatorAddress  sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+	CanUnjail         bool           `json:"can_unjail" yaml:"can_unjail"`
+	Reason            string         `json:"reason,omitempty" yaml:"reason,omitempty"`
+	Jailed            bool           `json:"jailed" yaml:"jailed"`
+	Tombstoned        bool           `json:"tombstoned" yaml:"tombstoned"`
+	JailedUntil       time.Time      `json:"jailed_until" yaml:"jailed_until"`
+	JailTimeRemaining time.Duration  `json:"jail_time_remaining" yaml:"jail_time_remaining"`
+	SelfDelegation    sdk.Int        `json:"self_delegation" yaml:"self_delegation"`
+	MinSelfDelegation sdk.Int        `json:"min_self_delegation" yaml:"min_self_delegation"`
+}
+
+// String implements the stringer interface for UnjailStatus
+func (s UnjailStatus) String() string {
+	return fmt.Sprintf(`Unjail Status:
+  Validator Address:   %s
+  Can Unjail:          %t
+  Reason:              %s
+  Jailed:              %t
+  Tombstoned:          %t
+  Jailed Until:        %s
+  Jail Time Remaining: %s
+  Self Delegation:     %s
+  Min Self Delegation: %s`,
+		s.ValidatorAddress, s.CanUnjail, s.Reason, s.Jailed, s.Tombstoned,
+		s.JailedUntil, s.JailTimeRemaining, s.SelfDelegation, s.MinSelfDelegation)
+}
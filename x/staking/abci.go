@@ -13,7 +13,12 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	k.TrackHistoricalInfo(ctx)
 }
 
-// Called every block, update validator set
+// Called every block, update validator set. At the end of an epoch, the queued
+// delegation messages are handled before the validator set is updated.
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
+	if k.IsEpochEnd(ctx) {
+		executeEpochMsgs(ctx, k)
+	}
+
 	return k.BlockValidatorUpdates(ctx)
 }
@@ -15,6 +15,10 @@ const (
 	DefaultUnbondingTime               = types.DefaultUnbondingTime
 	DefaultMaxValidators               = types.DefaultMaxValidators
 	DefaultMaxEntries                  = types.DefaultMaxEntries
+	DefaultMaxRedelegationEntries      = types.DefaultMaxRedelegationEntries
+	DefaultEpochLength                 = types.DefaultEpochLength
+	HookErrorModePropagate             = types.HookErrorModePropagate
+	HookErrorModeLog                   = types.HookErrorModeLog
 	NotBondedPoolName                  = types.NotBondedPoolName
 	BondedPoolName                     = types.BondedPoolName
 	QueryValidators                    = types.QueryValidators
@@ -27,11 +31,16 @@ const (
 	QueryValidatorUnbondingDelegations = types.QueryValidatorUnbondingDelegations
 	QueryDelegation                    = types.QueryDelegation
 	QueryUnbondingDelegation           = types.QueryUnbondingDelegation
+	QueryUnbondingDelegationEntryLimit = types.QueryUnbondingDelegationEntryLimit
+	QueryRedelegationEntryLimit        = types.QueryRedelegationEntryLimit
 	QueryDelegatorValidators           = types.QueryDelegatorValidators
 	QueryDelegatorValidator            = types.QueryDelegatorValidator
 	QueryPool                          = types.QueryPool
 	QueryParameters                    = types.QueryParameters
 	QueryHistoricalInfo                = types.QueryHistoricalInfo
+	QueryTokenizeShareRecordByDenom    = types.QueryTokenizeShareRecordByDenom
+	QueryTokenizeShareRecordsOwned     = types.QueryTokenizeShareRecordsOwned
+	QueryTotalLiquidStaked             = types.QueryTotalLiquidStaked
 	MaxMonikerLength                   = types.MaxMonikerLength
 	MaxIdentityLength                  = types.MaxIdentityLength
 	MaxWebsiteLength                   = types.MaxWebsiteLength
@@ -121,6 +130,13 @@ var (
 	ErrInvalidHistoricalInfo           = types.ErrInvalidHistoricalInfo
 	ErrNoHistoricalInfo                = types.ErrNoHistoricalInfo
 	ErrEmptyValidatorPubKey            = types.ErrEmptyValidatorPubKey
+	ErrCommissionLTMinRate             = types.ErrCommissionLTMinRate
+	ErrNoUnbondingDelegationEntry      = types.ErrNoUnbondingDelegationEntry
+	ErrUnbondingNotFound               = types.ErrUnbondingNotFound
+	ErrUnbondingOnHoldRefCountNegative = types.ErrUnbondingOnHoldRefCountNegative
+	ErrTokenizeShareRecordNotExists    = types.ErrTokenizeShareRecordNotExists
+	ErrRedelegationInProgress          = types.ErrRedelegationInProgress
+	ErrExceedingFreeVestingDelegations = types.ErrExceedingFreeVestingDelegations
 	NewGenesisState                    = types.NewGenesisState
 	DefaultGenesisState                = types.DefaultGenesisState
 	NewMultiStakingHooks               = types.NewMultiStakingHooks
@@ -150,11 +166,15 @@ var (
 	GetREDsToValDstIndexKey            = types.GetREDsToValDstIndexKey
 	GetREDsByDelToValDstIndexKey       = types.GetREDsByDelToValDstIndexKey
 	GetHistoricalInfoKey               = types.GetHistoricalInfoKey
+	GetValidatorLiquidSharesKey        = types.GetValidatorLiquidSharesKey
 	NewMsgCreateValidator              = types.NewMsgCreateValidator
 	NewMsgEditValidator                = types.NewMsgEditValidator
 	NewMsgDelegate                     = types.NewMsgDelegate
 	NewMsgBeginRedelegate              = types.NewMsgBeginRedelegate
 	NewMsgUndelegate                   = types.NewMsgUndelegate
+	NewMsgCancelUnbondingDelegation    = types.NewMsgCancelUnbondingDelegation
+	NewMsgTokenizeShares               = types.NewMsgTokenizeShares
+	NewMsgRedeemTokensForShares        = types.NewMsgRedeemTokensForShares
 	NewParams                          = types.NewParams
 	DefaultParams                      = types.DefaultParams
 	MustUnmarshalParams                = types.MustUnmarshalParams
@@ -171,6 +191,10 @@ var (
 	MustUnmarshalValidator             = types.MustUnmarshalValidator
 	UnmarshalValidator                 = types.UnmarshalValidator
 	NewDescription                     = types.NewDescription
+	NewTokenizeShareRecord             = types.NewTokenizeShareRecord
+	MustMarshalTokenizeShareRecord     = types.MustMarshalTokenizeShareRecord
+	MustUnmarshalTokenizeShareRecord   = types.MustUnmarshalTokenizeShareRecord
+	UnmarshalTokenizeShareRecord       = types.UnmarshalTokenizeShareRecord
 
 	// variable aliases
 	ModuleCdc                        = types.ModuleCdc
@@ -189,51 +213,68 @@ var (
 	RedelegationQueueKey             = types.RedelegationQueueKey
 	ValidatorQueueKey                = types.ValidatorQueueKey
 	HistoricalInfoKey                = types.HistoricalInfoKey
+	LastTokenizeShareRecordIDKey     = types.LastTokenizeShareRecordIDKey
+	TokenizeShareRecordPrefix        = types.TokenizeShareRecordPrefix
+	TotalLiquidStakedTokensKey       = types.TotalLiquidStakedTokensKey
+	ValidatorLiquidSharesPrefix      = types.ValidatorLiquidSharesPrefix
 	KeyUnbondingTime                 = types.KeyUnbondingTime
 	KeyMaxValidators                 = types.KeyMaxValidators
 	KeyMaxEntries                    = types.KeyMaxEntries
+	KeyMaxRedelegationEntries        = types.KeyMaxRedelegationEntries
+	KeyEpochLength                   = types.KeyEpochLength
 	KeyBondDenom                     = types.KeyBondDenom
+	KeyMinCommissionRate             = types.KeyMinCommissionRate
+	DefaultMinCommissionRate         = types.DefaultMinCommissionRate
+	KeyGlobalLiquidStakingCap        = types.KeyGlobalLiquidStakingCap
+	KeyValidatorLiquidStakingCap     = types.KeyValidatorLiquidStakingCap
+	DefaultGlobalLiquidStakingCap    = types.DefaultGlobalLiquidStakingCap
+	DefaultValidatorLiquidStakingCap = types.DefaultValidatorLiquidStakingCap
 )
 
 type (
-	Keeper                    = keeper.Keeper
-	Commission                = types.Commission
-	CommissionRates           = types.CommissionRates
-	DVPair                    = types.DVPair
-	DVVTriplet                = types.DVVTriplet
-	Delegation                = types.Delegation
-	Delegations               = types.Delegations
-	UnbondingDelegation       = types.UnbondingDelegation
-	UnbondingDelegationEntry  = types.UnbondingDelegationEntry
-	UnbondingDelegations      = types.UnbondingDelegations
-	Redelegation              = types.Redelegation
-	RedelegationEntry         = types.RedelegationEntry
-	Redelegations             = types.Redelegations
-	HistoricalInfo            = types.HistoricalInfo
-	DelegationResponse        = types.DelegationResponse
-	DelegationResponses       = types.DelegationResponses
-	RedelegationResponse      = types.RedelegationResponse
-	RedelegationEntryResponse = types.RedelegationEntryResponse
-	RedelegationResponses     = types.RedelegationResponses
-	GenesisState              = types.GenesisState
-	LastValidatorPower        = types.LastValidatorPower
-	MultiStakingHooks         = types.MultiStakingHooks
-	MsgCreateValidator        = types.MsgCreateValidator
-	MsgEditValidator          = types.MsgEditValidator
-	MsgDelegate               = types.MsgDelegate
-	MsgBeginRedelegate        = types.MsgBeginRedelegate
-	MsgUndelegate             = types.MsgUndelegate
-	Params                    = types.Params
-	Pool                      = types.Pool
-	QueryDelegatorParams      = types.QueryDelegatorParams
-	QueryValidatorParams      = types.QueryValidatorParams
-	QueryBondsParams          = types.QueryBondsParams
-	QueryRedelegationParams   = types.QueryRedelegationParams
-	QueryValidatorsParams     = types.QueryValidatorsParams
-	QueryHistoricalInfoParams = types.QueryHistoricalInfoParams
-	Validator                 = types.Validator
-	Validators                = types.Validators
-	Description               = types.Description
-	DelegationI               = exported.DelegationI
-	ValidatorI                = exported.ValidatorI
+	Keeper                       = keeper.Keeper
+	Commission                   = types.Commission
+	CommissionRates              = types.CommissionRates
+	DVPair                       = types.DVPair
+	DVVTriplet                   = types.DVVTriplet
+	Delegation                   = types.Delegation
+	Delegations                  = types.Delegations
+	UnbondingDelegation          = types.UnbondingDelegation
+	UnbondingDelegationEntry     = types.UnbondingDelegationEntry
+	UnbondingDelegations         = types.UnbondingDelegations
+	Redelegation                 = types.Redelegation
+	RedelegationEntry            = types.RedelegationEntry
+	Redelegations                = types.Redelegations
+	HistoricalInfo               = types.HistoricalInfo
+	DelegationResponse           = types.DelegationResponse
+	DelegationResponses          = types.DelegationResponses
+	RedelegationResponse         = types.RedelegationResponse
+	RedelegationEntryResponse    = types.RedelegationEntryResponse
+	RedelegationResponses        = types.RedelegationResponses
+	GenesisState                 = types.GenesisState
+	LastValidatorPower           = types.LastValidatorPower
+	MultiStakingHooks            = types.MultiStakingHooks
+	HookErrorMode                = types.HookErrorMode
+	MsgCreateValidator           = types.MsgCreateValidator
+	MsgEditValidator             = types.MsgEditValidator
+	MsgDelegate                  = types.MsgDelegate
+	MsgBeginRedelegate           = types.MsgBeginRedelegate
+	MsgUndelegate                = types.MsgUndelegate
+	MsgCancelUnbondingDelegation = types.MsgCancelUnbondingDelegation
+	MsgTokenizeShares            = types.MsgTokenizeShares
+	MsgRedeemTokensForShares     = types.MsgRedeemTokensForShares
+	TokenizeShareRecord          = types.TokenizeShareRecord
+	Params                       = types.Params
+	Pool                         = types.Pool
+	QueryDelegatorParams         = types.QueryDelegatorParams
+	QueryValidatorParams         = types.QueryValidatorParams
+	QueryBondsParams             = types.QueryBondsParams
+	QueryRedelegationParams      = types.QueryRedelegationParams
+	QueryValidatorsParams        = types.QueryValidatorsParams
+	QueryHistoricalInfoParams    = types.QueryHistoricalInfoParams
+	Validator                    = types.Validator
+	Validators                   = types.Validators
+	Description                  = types.Description
+	DelegationI                  = exported.DelegationI
+	ValidatorI                   = exported.ValidatorI
 )
@@ -27,6 +27,8 @@ const (
 
 	FlagMinSelfDelegation = "min-self-delegation"
 
+	FlagStatus = "status"
+
 	FlagGenesisFormat = "genesis-format"
 	FlagNodeID        = "node-id"
 	FlagIP            = "ip"
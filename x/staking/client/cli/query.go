@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -30,16 +31,22 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryDelegations(queryRoute, cdc),
 		GetCmdQueryUnbondingDelegation(queryRoute, cdc),
 		GetCmdQueryUnbondingDelegations(queryRoute, cdc),
+		GetCmdQueryUnbondingDelegationEntryLimit(queryRoute, cdc),
 		GetCmdQueryRedelegation(queryRoute, cdc),
 		GetCmdQueryRedelegations(queryRoute, cdc),
+		GetCmdQueryRedelegationEntryLimit(queryRoute, cdc),
 		GetCmdQueryValidator(queryRoute, cdc),
 		GetCmdQueryValidators(queryRoute, cdc),
+		GetCmdQueryValidatorSelfBond(queryRoute, cdc),
 		GetCmdQueryValidatorDelegations(queryRoute, cdc),
 		GetCmdQueryValidatorUnbondingDelegations(queryRoute, cdc),
 		GetCmdQueryValidatorRedelegations(queryRoute, cdc),
 		GetCmdQueryHistoricalInfo(queryRoute, cdc),
 		GetCmdQueryParams(queryRoute, cdc),
-		GetCmdQueryPool(queryRoute, cdc))...)
+		GetCmdQueryPool(queryRoute, cdc),
+		GetCmdQueryTokenizeShareRecordByDenom(queryRoute, cdc),
+		GetCmdQueryTokenizeShareRecordsOwned(queryRoute, cdc),
+		GetCmdQueryTotalLiquidStaked(queryRoute, cdc))...)
 
 	return stakingQueryCmd
 }
@@ -88,22 +95,45 @@ $ %s query staking validator cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhff
 
 // GetCmdQueryValidators implements the query all validators command.
 func GetCmdQueryValidators(storeName string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "validators",
 		Short: "Query for all validators",
 		Args:  cobra.NoArgs,
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Query details about all validators on a network.
+			fmt.Sprintf(`Query details about all validators on a network, or about a page of the
+validators with the given bond status, i.e. bonded, unbonding or unbonded.
 
 Example:
 $ %s query staking validators
+$ %s query staking validators --status=bonded --page=2 --limit=10
 `,
-				version.ClientName,
+				version.ClientName, version.ClientName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
+			if status := viper.GetString(FlagStatus); status != "" {
+				params := types.NewQueryValidatorsParams(viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit), status)
+				bz, err := cdc.MarshalJSON(params)
+				if err != nil {
+					return err
+				}
+
+				route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryValidators)
+				res, _, err := cliCtx.QueryWithData(route, bz)
+				if err != nil {
+					return err
+				}
+
+				var validators types.Validators
+				if err := cdc.UnmarshalJSON(res, &validators); err != nil {
+					return err
+				}
+
+				return cliCtx.PrintOutput(validators)
+			}
+
 			resKVs, _, err := cliCtx.QuerySubspace(types.ValidatorsKey, storeName)
 			if err != nil {
 				return err
@@ -122,6 +152,58 @@ $ %s query staking validators
 			return cliCtx.PrintOutput(validators)
 		},
 	}
+
+	cmd.Flags().String(FlagStatus, "", "Query the validators with a bond status (bonded|unbonding|unbonded)")
+	cmd.Flags().Int(flags.FlagPage, 1, "Query a specific page of the validators with the bond status")
+	cmd.Flags().Int(flags.FlagLimit, 0, "Query number of validators returned per page, defaulting to the max validators")
+
+	return cmd
+}
+
+// GetCmdQueryValidatorSelfBond implements the command to query the
+// self-delegation of a validator's operator.
+func GetCmdQueryValidatorSelfBond(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "self-bond [validator-addr]",
+		Short: "Query the self-bond of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the tokens worth of the self-delegation of a validator's operator along
+with the validator's minimum self delegation, below which the validator is jailed.
+
+Example:
+$ %s query staking self-bond cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryValidatorParams(valAddr, 0, 0))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryValidatorSelfBond)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var selfBond types.ValidatorSelfBond
+			if err := cdc.UnmarshalJSON(res, &selfBond); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(selfBond)
+		},
+	}
 }
 
 // GetCmdQueryValidatorUnbondingDelegations implements the query all unbonding delegatations from a validator command.
@@ -147,7 +229,7 @@ $ %s query staking unbonding-delegations-from cosmosvaloper1gghjut3ccd8ay0zduzj6
 				return err
 			}
 
-			bz, err := cdc.MarshalJSON(types.NewQueryValidatorParams(valAddr))
+			bz, err := cdc.MarshalJSON(types.NewQueryValidatorParams(valAddr, 0, 0))
 			if err != nil {
 				return err
 			}
@@ -200,7 +282,7 @@ $ %s query staking redelegations-from cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fx
 				return err
 			}
 
-			var resp types.RedelegationResponses
+			var resp types.QueryRedelegationsResult
 			if err := cdc.UnmarshalJSON(res, &resp); err != nil {
 				return err
 			}
@@ -262,14 +344,14 @@ $ %s query staking delegation cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p cosm
 // GetCmdQueryDelegations implements the command to query all the delegations
 // made from one delegator.
 func GetCmdQueryDelegations(queryRoute string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "delegations [delegator-addr]",
 		Short: "Query all delegations made by one delegator",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Query delegations for an individual delegator on all validators.
 
 Example:
-$ %s query staking delegations cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+$ %s query staking delegations cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --page=2 --limit=10
 `,
 				version.ClientName,
 			),
@@ -283,7 +365,7 @@ $ %s query staking delegations cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
 				return err
 			}
 
-			bz, err := cdc.MarshalJSON(types.NewQueryDelegatorParams(delAddr))
+			bz, err := cdc.MarshalJSON(types.NewQueryDelegatorParams(delAddr, viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit)))
 			if err != nil {
 				return err
 			}
@@ -294,7 +376,7 @@ $ %s query staking delegations cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
 				return err
 			}
 
-			var resp types.DelegationResponses
+			var resp types.QueryDelegationsResult
 			if err := cdc.UnmarshalJSON(res, &resp); err != nil {
 				return err
 			}
@@ -302,19 +384,24 @@ $ %s query staking delegations cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
 			return cliCtx.PrintOutput(resp)
 		},
 	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "Query a specific page of paginated results")
+	cmd.Flags().Int(flags.FlagLimit, 100, "Query number of results returned per page")
+
+	return cmd
 }
 
 // GetCmdQueryValidatorDelegations implements the command to query all the
 // delegations to a specific validator.
 func GetCmdQueryValidatorDelegations(queryRoute string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "delegations-to [validator-addr]",
 		Short: "Query all delegations made to one validator",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Query delegations on an individual validator.
 
 Example:
-$ %s query staking delegations-to cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+$ %s query staking delegations-to cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --page=2 --limit=10
 `,
 				version.ClientName,
 			),
@@ -328,7 +415,9 @@ $ %s query staking delegations-to cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ld
 				return err
 			}
 
-			bz, err := cdc.MarshalJSON(types.NewQueryValidatorParams(valAddr))
+			bz, err := cdc.MarshalJSON(types.NewQueryValidatorParams(
+				valAddr, viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit),
+			))
 			if err != nil {
 				return err
 			}
@@ -339,7 +428,7 @@ $ %s query staking delegations-to cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ld
 				return err
 			}
 
-			var resp types.DelegationResponses
+			var resp types.QueryDelegationsResult
 			if err := cdc.UnmarshalJSON(res, &resp); err != nil {
 				return err
 			}
@@ -347,6 +436,11 @@ $ %s query staking delegations-to cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ld
 			return cliCtx.PrintOutput(resp)
 		},
 	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "Query a specific page of paginated results")
+	cmd.Flags().Int(flags.FlagLimit, 100, "Query number of results returned per page")
+
+	return cmd
 }
 
 // GetCmdQueryUnbondingDelegation implements the command to query a single
@@ -402,14 +496,14 @@ $ %s query staking unbonding-delegation cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld7
 // GetCmdQueryUnbondingDelegations implements the command to query all the
 // unbonding-delegation records for a delegator.
 func GetCmdQueryUnbondingDelegations(queryRoute string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "unbonding-delegations [delegator-addr]",
 		Short: "Query all unbonding-delegations records for one delegator",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Query unbonding delegations for an individual delegator.
 
 Example:
-$ %s query staking unbonding-delegation cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+$ %s query staking unbonding-delegations cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --page=2 --limit=10
 `,
 				version.ClientName,
 			),
@@ -423,7 +517,9 @@ $ %s query staking unbonding-delegation cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld7
 				return err
 			}
 
-			bz, err := cdc.MarshalJSON(types.NewQueryDelegatorParams(delegatorAddr))
+			bz, err := cdc.MarshalJSON(types.NewQueryDelegatorParams(
+				delegatorAddr, viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit),
+			))
 			if err != nil {
 				return err
 			}
@@ -434,12 +530,68 @@ $ %s query staking unbonding-delegation cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld7
 				return err
 			}
 
-			var ubds types.UnbondingDelegations
-			if err = cdc.UnmarshalJSON(res, &ubds); err != nil {
+			var resp types.QueryUnbondingDelegationsResult
+			if err = cdc.UnmarshalJSON(res, &resp); err != nil {
 				return err
 			}
 
-			return cliCtx.PrintOutput(ubds)
+			return cliCtx.PrintOutput(resp)
+		},
+	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "Query a specific page of paginated results")
+	cmd.Flags().Int(flags.FlagLimit, 100, "Query number of results returned per page")
+
+	return cmd
+}
+
+// GetCmdQueryUnbondingDelegationEntryLimit implements the command to query the
+// number of entries of an unbonding-delegation record and its maximum.
+func GetCmdQueryUnbondingDelegationEntryLimit(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "unbonding-entry-limit [delegator-addr] [validator-addr]",
+		Short: "Query the number of unbonding-delegation entries between a delegator and a validator and its maximum",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the number of unbonding delegation entries of an individual delegator on an
+individual validator, along with the maximum number of entries, above which undelegations are rejected.
+
+Example:
+$ %s query staking unbonding-entry-limit cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryBondsParams(delAddr, valAddr))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryUnbondingDelegationEntryLimit)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var limit types.EntryLimit
+			if err := cdc.UnmarshalJSON(res, &limit); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(limit)
 		},
 	}
 }
@@ -489,7 +641,7 @@ $ %s query staking redelegation cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p co
 				return err
 			}
 
-			var resp types.RedelegationResponses
+			var resp types.QueryRedelegationsResult
 			if err := cdc.UnmarshalJSON(res, &resp); err != nil {
 				return err
 			}
@@ -499,10 +651,66 @@ $ %s query staking redelegation cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p co
 	}
 }
 
+// GetCmdQueryRedelegationEntryLimit implements the command to query the
+// number of entries of a redelegation record and its maximum.
+func GetCmdQueryRedelegationEntryLimit(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "redelegation-entry-limit [delegator-addr] [src-validator-addr] [dst-validator-addr]",
+		Short: "Query the number of redelegation entries between a delegator and a source and destination validator and its maximum",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the number of redelegation entries of an individual delegator between a source and
+destination validator, along with the maximum number of entries, above which redelegations are rejected.
+
+Example:
+$ %s query staking redelegation-entry-limit cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p cosmosvaloper1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			valSrcAddr, err := sdk.ValAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			valDstAddr, err := sdk.ValAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryRedelegationParams(delAddr, valSrcAddr, valDstAddr))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryRedelegationEntryLimit)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var limit types.EntryLimit
+			if err := cdc.UnmarshalJSON(res, &limit); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(limit)
+		},
+	}
+}
+
 // GetCmdQueryRedelegations implements the command to query all the
 // redelegation records for a delegator.
 func GetCmdQueryRedelegations(queryRoute string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "redelegations [delegator-addr]",
 		Args:  cobra.ExactArgs(1),
 		Short: "Query all redelegations records for one delegator",
@@ -510,7 +718,7 @@ func GetCmdQueryRedelegations(queryRoute string, cdc *codec.Codec) *cobra.Comman
 			fmt.Sprintf(`Query all redelegation records for an individual delegator.
 
 Example:
-$ %s query staking redelegation cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+$ %s query staking redelegations cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --page=2 --limit=10
 `,
 				version.ClientName,
 			),
@@ -523,7 +731,11 @@ $ %s query staking redelegation cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
 				return err
 			}
 
-			bz, err := cdc.MarshalJSON(types.QueryRedelegationParams{DelegatorAddr: delAddr})
+			bz, err := cdc.MarshalJSON(types.QueryRedelegationParams{
+				DelegatorAddr: delAddr,
+				Page:          viper.GetInt(flags.FlagPage),
+				Limit:         viper.GetInt(flags.FlagLimit),
+			})
 			if err != nil {
 				return err
 			}
@@ -534,7 +746,7 @@ $ %s query staking redelegation cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
 				return err
 			}
 
-			var resp types.RedelegationResponses
+			var resp types.QueryRedelegationsResult
 			if err := cdc.UnmarshalJSON(res, &resp); err != nil {
 				return err
 			}
@@ -542,6 +754,11 @@ $ %s query staking redelegation cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
 			return cliCtx.PrintOutput(resp)
 		},
 	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "Query a specific page of paginated results")
+	cmd.Flags().Int(flags.FlagLimit, 100, "Query number of results returned per page")
+
+	return cmd
 }
 
 // GetCmdQueryHistoricalInfo implements the historical info query command
@@ -651,3 +868,120 @@ $ %s query staking params
 		},
 	}
 }
+
+// GetCmdQueryTokenizeShareRecordByDenom implements the query tokenize share record command.
+func GetCmdQueryTokenizeShareRecordByDenom(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "tokenize-share-record [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the tokenize share record backing a share denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the tokenize share record backing a share denom.
+
+Example:
+$ %s query staking tokenize-share-record cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj/1
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := cdc.MarshalJSON(types.NewQueryTokenizeShareRecordByDenomParams(args[0]))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryTokenizeShareRecordByDenom)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var record types.TokenizeShareRecord
+			if err := cdc.UnmarshalJSON(res, &record); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(record)
+		},
+	}
+}
+
+// GetCmdQueryTokenizeShareRecordsOwned implements the query tokenize share records owned command.
+func GetCmdQueryTokenizeShareRecordsOwned(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "tokenize-share-records-owned [owner-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query all tokenize share records owned by an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all tokenize share records owned by an account.
+
+Example:
+$ %s query staking tokenize-share-records-owned cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryTokenizeShareRecordsOwnedParams(owner))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryTokenizeShareRecordsOwned)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var records []types.TokenizeShareRecord
+			if err := cdc.UnmarshalJSON(res, &records); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(records)
+		},
+	}
+}
+
+// GetCmdQueryTotalLiquidStaked implements the query total liquid staked command.
+func GetCmdQueryTotalLiquidStaked(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "total-liquid-staked",
+		Args:  cobra.NoArgs,
+		Short: "Query the total amount of liquid staked tokens",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the total amount of tokens backing share tokens.
+
+Example:
+$ %s query staking total-liquid-staked
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryTotalLiquidStaked)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var tokens sdk.Int
+			if err := cdc.UnmarshalJSON(res, &tokens); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(tokens)
+		},
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/tests/cli"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // TxStakingCreateValidator is simcli tx staking create-validator
@@ -57,16 +58,16 @@ func QueryStakingUnbondingDelegationsFrom(f *cli.Fixtures, valAddr sdk.ValAddres
 }
 
 // QueryStakingDelegationsTo is simcli query staking delegations-to
-func QueryStakingDelegationsTo(f *cli.Fixtures, valAddr sdk.ValAddress, flags ...string) []staking.Delegation {
+func QueryStakingDelegationsTo(f *cli.Fixtures, valAddr sdk.ValAddress, flags ...string) staking.DelegationResponses {
 	cmd := fmt.Sprintf("%s query staking delegations-to %s %v", f.SimcliBinary, valAddr, f.Flags())
 	out, _ := tests.ExecuteT(f.T, cli.AddFlags(cmd, flags), "")
 
-	var delegations []staking.Delegation
+	var resp stakingtypes.QueryDelegationsResult
 
-	err := f.Cdc.UnmarshalJSON([]byte(out), &delegations)
+	err := f.Cdc.UnmarshalJSON([]byte(out), &resp)
 	require.NoError(f.T, err, "out %v\n, err %v", out, err)
 
-	return delegations
+	return resp.Delegations
 }
 
 // QueryStakingPool is simcli query staking pool
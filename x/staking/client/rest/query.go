@@ -57,12 +57,24 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		unbondingDelegationHandlerFn(cliCtx),
 	).Methods("GET")
 
+	// Query the number of entries of an unbonding delegation between a delegator and a validator
+	r.HandleFunc(
+		"/staking/delegators/{delegatorAddr}/unbonding_delegations/{validatorAddr}/entry_limit",
+		unbondingDelegationEntryLimitHandlerFn(cliCtx),
+	).Methods("GET")
+
 	// Query redelegations (filters in query params)
 	r.HandleFunc(
 		"/staking/redelegations",
 		redelegationsHandlerFn(cliCtx),
 	).Methods("GET")
 
+	// Query the number of entries of a redelegation (addresses in query params)
+	r.HandleFunc(
+		"/staking/redelegations/entry_limit",
+		redelegationEntryLimitHandlerFn(cliCtx),
+	).Methods("GET")
+
 	// Get all validators
 	r.HandleFunc(
 		"/staking/validators",
@@ -75,6 +87,12 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		validatorHandlerFn(cliCtx),
 	).Methods("GET")
 
+	// Get the self-bond of a validator
+	r.HandleFunc(
+		"/staking/validators/{validatorAddr}/self_bond",
+		validatorSelfBondHandlerFn(cliCtx),
+	).Methods("GET")
+
 	// Get all delegations to a validator
 	r.HandleFunc(
 		"/staking/validators/{validatorAddr}/delegations",
@@ -104,6 +122,18 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		"/staking/parameters",
 		paramsHandlerFn(cliCtx),
 	).Methods("GET")
+
+	// Get all tokenize share records owned by an account
+	r.HandleFunc(
+		"/staking/owners/{ownerAddr}/tokenize_share_records",
+		tokenizeShareRecordsOwnedHandlerFn(cliCtx),
+	).Methods("GET")
+
+	// Get the total amount of liquid staked tokens
+	r.HandleFunc(
+		"/staking/total_liquid_staked",
+		totalLiquidStakedHandlerFn(cliCtx),
+	).Methods("GET")
 }
 
 // HTTP request handler to query a delegator delegations
@@ -193,60 +223,19 @@ func unbondingDelegationHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return queryBonds(cliCtx, "custom/staking/unbondingDelegation")
 }
 
+// HTTP request handler to query the number of entries of an unbonding delegation and its maximum
+func unbondingDelegationEntryLimitHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return queryBonds(cliCtx, fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryUnbondingDelegationEntryLimit))
+}
+
 // HTTP request handler to query redelegations
 func redelegationsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		var params types.QueryRedelegationParams
-
-		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
-		if !ok {
-			return
-		}
-
-		bechDelegatorAddr := r.URL.Query().Get("delegator")
-		bechSrcValidatorAddr := r.URL.Query().Get("validator_from")
-		bechDstValidatorAddr := r.URL.Query().Get("validator_to")
-
-		if len(bechDelegatorAddr) != 0 {
-			delegatorAddr, err := sdk.AccAddressFromBech32(bechDelegatorAddr)
-			if rest.CheckBadRequestError(w, err) {
-				return
-			}
-
-			params.DelegatorAddr = delegatorAddr
-		}
-
-		if len(bechSrcValidatorAddr) != 0 {
-			srcValidatorAddr, err := sdk.ValAddressFromBech32(bechSrcValidatorAddr)
-			if rest.CheckBadRequestError(w, err) {
-				return
-			}
-
-			params.SrcValidatorAddr = srcValidatorAddr
-		}
-
-		if len(bechDstValidatorAddr) != 0 {
-			dstValidatorAddr, err := sdk.ValAddressFromBech32(bechDstValidatorAddr)
-			if rest.CheckBadRequestError(w, err) {
-				return
-			}
-
-			params.DstValidatorAddr = dstValidatorAddr
-		}
-
-		bz, err := cliCtx.Codec.MarshalJSON(params)
-		if rest.CheckBadRequestError(w, err) {
-			return
-		}
-
-		res, height, err := cliCtx.QueryWithData("custom/staking/redelegations", bz)
-		if rest.CheckInternalServerError(w, err) {
-			return
-		}
+	return queryRedelegations(cliCtx, "custom/staking/redelegations")
+}
 
-		cliCtx = cliCtx.WithHeight(height)
-		rest.PostProcessResponse(w, cliCtx, res)
-	}
+// HTTP request handler to query the number of entries of a redelegation and its maximum
+func redelegationEntryLimitHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return queryRedelegations(cliCtx, fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryRedelegationEntryLimit))
 }
 
 // HTTP request handler to query a delegation
@@ -306,6 +295,11 @@ func validatorHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return queryValidator(cliCtx, "custom/staking/validator")
 }
 
+// HTTP request handler to query the self-bond of a validator
+func validatorSelfBondHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return queryValidator(cliCtx, fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorSelfBond))
+}
+
 // HTTP request handler to query all unbonding delegations from a validator
 func validatorDelegationsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return queryValidator(cliCtx, fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorDelegations))
@@ -380,3 +374,49 @@ func paramsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
+
+// HTTP request handler to query the tokenize share records owned by an account
+func tokenizeShareRecordsOwnedHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		owner, err := sdk.AccAddressFromBech32(mux.Vars(r)["ownerAddr"])
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		bz, err := cliCtx.Codec.MarshalJSON(types.NewQueryTokenizeShareRecordsOwnedParams(owner))
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryTokenizeShareRecordsOwned), bz)
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// HTTP request handler to query the total amount of liquid staked tokens
+func totalLiquidStakedHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryTotalLiquidStaked), nil)
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
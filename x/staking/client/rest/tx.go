@@ -28,6 +28,18 @@ func registerTxHandlers(cliCtx context.CLIContext, m codec.Marshaler, txg tx.Gen
 		"/staking/delegators/{delegatorAddr}/redelegations",
 		newPostRedelegationsHandlerFn(cliCtx, m, txg),
 	).Methods("POST")
+	r.HandleFunc(
+		"/staking/delegators/{delegatorAddr}/unbonding_delegations/cancel",
+		newPostCancelUnbondingDelegationHandlerFn(cliCtx, m, txg),
+	).Methods("POST")
+	r.HandleFunc(
+		"/staking/delegators/{delegatorAddr}/tokenize_shares",
+		newPostTokenizeSharesHandlerFn(cliCtx, m, txg),
+	).Methods("POST")
+	r.HandleFunc(
+		"/staking/delegators/{delegatorAddr}/redeem_tokens",
+		newPostRedeemTokensForSharesHandlerFn(cliCtx, m, txg),
+	).Methods("POST")
 }
 
 type (
@@ -55,6 +67,31 @@ type (
 		ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"` // in bech32
 		Amount           sdk.Coin       `json:"amount" yaml:"amount"`
 	}
+
+	// CancelUnbondingDelegationRequest defines the properties of a cancel unbonding delegation request's body.
+	CancelUnbondingDelegationRequest struct {
+		BaseReq          rest.BaseReq   `json:"base_req" yaml:"base_req"`
+		DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"` // in bech32
+		ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"` // in bech32
+		Amount           sdk.Coin       `json:"amount" yaml:"amount"`
+		CreationHeight   int64          `json:"creation_height" yaml:"creation_height"`
+	}
+
+	// TokenizeSharesRequest defines the properties of a tokenize shares request's body.
+	TokenizeSharesRequest struct {
+		BaseReq             rest.BaseReq   `json:"base_req" yaml:"base_req"`
+		DelegatorAddress    sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"` // in bech32
+		ValidatorAddress    sdk.ValAddress `json:"validator_address" yaml:"validator_address"` // in bech32
+		Amount              sdk.Coin       `json:"amount" yaml:"amount"`
+		TokenizedShareOwner sdk.AccAddress `json:"tokenized_share_owner" yaml:"tokenized_share_owner"` // in bech32
+	}
+
+	// RedeemTokensForSharesRequest defines the properties of a redeem tokens for shares request's body.
+	RedeemTokensForSharesRequest struct {
+		BaseReq          rest.BaseReq   `json:"base_req" yaml:"base_req"`
+		DelegatorAddress sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"` // in bech32
+		Amount           sdk.Coin       `json:"amount" yaml:"amount"`
+	}
 )
 
 func newPostDelegationsHandlerFn(cliCtx context.CLIContext, m codec.Marshaler, txg tx.Generator) http.HandlerFunc {
@@ -156,6 +193,107 @@ func newPostUnbondingDelegationsHandlerFn(cliCtx context.CLIContext, m codec.Mar
 	}
 }
 
+func newPostCancelUnbondingDelegationHandlerFn(cliCtx context.CLIContext, m codec.Marshaler, txg tx.Generator) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx = cliCtx.WithMarshaler(m)
+
+		var req CancelUnbondingDelegationRequest
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		msg := types.NewMsgCancelUnbondingDelegation(
+			req.DelegatorAddress, req.ValidatorAddress, req.CreationHeight, req.Amount,
+		)
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		if !bytes.Equal(fromAddr, req.DelegatorAddress) {
+			rest.WriteErrorResponse(w, http.StatusUnauthorized, "must use own delegator address")
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, txg, req.BaseReq, msg)
+	}
+}
+
+func newPostTokenizeSharesHandlerFn(cliCtx context.CLIContext, m codec.Marshaler, txg tx.Generator) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx = cliCtx.WithMarshaler(m)
+
+		var req TokenizeSharesRequest
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		msg := types.NewMsgTokenizeShares(req.DelegatorAddress, req.ValidatorAddress, req.Amount, req.TokenizedShareOwner)
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		if !bytes.Equal(fromAddr, req.DelegatorAddress) {
+			rest.WriteErrorResponse(w, http.StatusUnauthorized, "must use own delegator address")
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, txg, req.BaseReq, msg)
+	}
+}
+
+func newPostRedeemTokensForSharesHandlerFn(cliCtx context.CLIContext, m codec.Marshaler, txg tx.Generator) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx = cliCtx.WithMarshaler(m)
+
+		var req RedeemTokensForSharesRequest
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		msg := types.NewMsgRedeemTokensForShares(req.DelegatorAddress, req.Amount)
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		if !bytes.Equal(fromAddr, req.DelegatorAddress) {
+			rest.WriteErrorResponse(w, http.StatusUnauthorized, "must use own delegator address")
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(cliCtx, w, txg, req.BaseReq, msg)
+	}
+}
+
 // ---------------------------------------------------------------------------
 // Deprecated
 //
@@ -174,6 +312,18 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		"/staking/delegators/{delegatorAddr}/redelegations",
 		postRedelegationsHandlerFn(cliCtx),
 	).Methods("POST")
+	r.HandleFunc(
+		"/staking/delegators/{delegatorAddr}/unbonding_delegations/cancel",
+		postCancelUnbondingDelegationHandlerFn(cliCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		"/staking/delegators/{delegatorAddr}/tokenize_shares",
+		postTokenizeSharesHandlerFn(cliCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		"/staking/delegators/{delegatorAddr}/redeem_tokens",
+		postRedeemTokensForSharesHandlerFn(cliCtx),
+	).Methods("POST")
 }
 
 func postDelegationsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
@@ -271,3 +421,101 @@ func postUnbondingDelegationsHandlerFn(cliCtx context.CLIContext) http.HandlerFu
 		authclient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+func postCancelUnbondingDelegationHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CancelUnbondingDelegationRequest
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		msg := types.NewMsgCancelUnbondingDelegation(
+			req.DelegatorAddress, req.ValidatorAddress, req.CreationHeight, req.Amount,
+		)
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		if !bytes.Equal(fromAddr, req.DelegatorAddress) {
+			rest.WriteErrorResponse(w, http.StatusUnauthorized, "must use own delegator address")
+			return
+		}
+
+		authclient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func postTokenizeSharesHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req TokenizeSharesRequest
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		msg := types.NewMsgTokenizeShares(req.DelegatorAddress, req.ValidatorAddress, req.Amount, req.TokenizedShareOwner)
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		if !bytes.Equal(fromAddr, req.DelegatorAddress) {
+			rest.WriteErrorResponse(w, http.StatusUnauthorized, "must use own delegator address")
+			return
+		}
+
+		authclient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func postRedeemTokensForSharesHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req RedeemTokensForSharesRequest
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		msg := types.NewMsgRedeemTokensForShares(req.DelegatorAddress, req.Amount)
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		if !bytes.Equal(fromAddr, req.DelegatorAddress) {
+			rest.WriteErrorResponse(w, http.StatusUnauthorized, "must use own delegator address")
+			return
+		}
+
+		authclient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
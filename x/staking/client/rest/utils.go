@@ -74,6 +74,68 @@ func queryBonds(cliCtx context.CLIContext, endpoint string) http.HandlerFunc {
 	}
 }
 
+func queryRedelegations(cliCtx context.CLIContext, endpoint string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var params types.QueryRedelegationParams
+
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, 0)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		params.Page, params.Limit = page, limit
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		bechDelegatorAddr := r.URL.Query().Get("delegator")
+		bechSrcValidatorAddr := r.URL.Query().Get("validator_from")
+		bechDstValidatorAddr := r.URL.Query().Get("validator_to")
+
+		if len(bechDelegatorAddr) != 0 {
+			delegatorAddr, err := sdk.AccAddressFromBech32(bechDelegatorAddr)
+			if rest.CheckBadRequestError(w, err) {
+				return
+			}
+
+			params.DelegatorAddr = delegatorAddr
+		}
+
+		if len(bechSrcValidatorAddr) != 0 {
+			srcValidatorAddr, err := sdk.ValAddressFromBech32(bechSrcValidatorAddr)
+			if rest.CheckBadRequestError(w, err) {
+				return
+			}
+
+			params.SrcValidatorAddr = srcValidatorAddr
+		}
+
+		if len(bechDstValidatorAddr) != 0 {
+			dstValidatorAddr, err := sdk.ValAddressFromBech32(bechDstValidatorAddr)
+			if rest.CheckBadRequestError(w, err) {
+				return
+			}
+
+			params.DstValidatorAddr = dstValidatorAddr
+		}
+
+		bz, err := cliCtx.Codec.MarshalJSON(params)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(endpoint, bz)
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 func queryDelegator(cliCtx context.CLIContext, endpoint string) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
@@ -84,12 +146,17 @@ func queryDelegator(cliCtx context.CLIContext, endpoint string) http.HandlerFunc
 			return
 		}
 
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, 0)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
 		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
 		if !ok {
 			return
 		}
 
-		params := types.NewQueryDelegatorParams(delegatorAddr)
+		params := types.NewQueryDelegatorParams(delegatorAddr, page, limit)
 
 		bz, err := cliCtx.Codec.MarshalJSON(params)
 		if rest.CheckBadRequestError(w, err) {
@@ -116,12 +183,17 @@ func queryValidator(cliCtx context.CLIContext, endpoint string) http.HandlerFunc
 			return
 		}
 
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, 0)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
 		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
 		if !ok {
 			return
 		}
 
-		params := types.NewQueryValidatorParams(validatorAddr)
+		params := types.NewQueryValidatorParams(validatorAddr, page, limit)
 
 		bz, err := cliCtx.Codec.MarshalJSON(params)
 		if rest.CheckBadRequestError(w, err) {
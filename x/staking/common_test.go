@@ -52,6 +52,7 @@ func getBaseSimappWithCustomKeeper() (*codec.Codec, *simapp.SimApp, sdk.Context)
 		app.AccountKeeper,
 		app.BankKeeper,
 		app.GetSubspace(staking.ModuleName),
+		sdk.DefaultPowerReduction,
 	)
 	app.StakingKeeper.SetParams(ctx, types.DefaultParams())
 
@@ -42,7 +42,9 @@ func InitGenesis(
 
 		// Call the creation hook if not exported
 		if !data.Exported {
-			keeper.AfterValidatorCreated(ctx, validator.OperatorAddress)
+			if err := keeper.AfterValidatorCreated(ctx, validator.OperatorAddress); err != nil {
+				panic(err)
+			}
 		}
 
 		// update timeslice if necessary
@@ -63,22 +65,40 @@ func InitGenesis(
 	for _, delegation := range data.Delegations {
 		// Call the before-creation hook if not exported
 		if !data.Exported {
-			keeper.BeforeDelegationCreated(ctx, delegation.DelegatorAddress, delegation.ValidatorAddress)
+			if err := keeper.BeforeDelegationCreated(ctx, delegation.DelegatorAddress, delegation.ValidatorAddress); err != nil {
+				panic(err)
+			}
 		}
 
 		keeper.SetDelegation(ctx, delegation)
 		// Call the after-modification hook if not exported
 		if !data.Exported {
-			keeper.AfterDelegationModified(ctx, delegation.DelegatorAddress, delegation.ValidatorAddress)
+			if err := keeper.AfterDelegationModified(ctx, delegation.DelegatorAddress, delegation.ValidatorAddress); err != nil {
+				panic(err)
+			}
 		}
 	}
 
+	if data.LastUnbondingID != 0 {
+		keeper.SetUnbondingID(ctx, data.LastUnbondingID)
+	}
+
 	for _, ubd := range data.UnbondingDelegations {
 		keeper.SetUnbondingDelegation(ctx, ubd)
 
 		for _, entry := range ubd.Entries {
 			keeper.InsertUBDQueue(ctx, ubd, entry.CompletionTime)
 			notBondedTokens = notBondedTokens.Add(entry.Balance)
+
+			// index the entries so that their holds can still be released, and
+			// make sure new entries do not reuse their IDs
+			if entry.UnbondingId != 0 {
+				keeper.SetUnbondingDelegationByUnbondingID(ctx, ubd, entry.UnbondingId)
+
+				if entry.UnbondingId > keeper.GetUnbondingID(ctx) {
+					keeper.SetUnbondingID(ctx, entry.UnbondingId)
+				}
+			}
 		}
 	}
 
@@ -90,6 +110,45 @@ func InitGenesis(
 		}
 	}
 
+	// the liquid staking totals are rebuilt from the delegations of the records
+	totalLiquidStaked := sdk.ZeroInt()
+
+	for _, record := range data.TokenizeShareRecords {
+		keeper.SetTokenizeShareRecord(ctx, record)
+
+		delegation, found := keeper.GetDelegation(ctx, record.GetModuleAddress(), record.Validator)
+		if !found {
+			continue
+		}
+
+		validator, found := keeper.GetValidator(ctx, record.Validator)
+		if !found {
+			panic(fmt.Sprintf("validator %s not found", record.Validator))
+		}
+
+		totalLiquidStaked = totalLiquidStaked.Add(validator.TokensFromShares(delegation.Shares).TruncateInt())
+		keeper.SetValidatorLiquidShares(
+			ctx, record.Validator, keeper.GetValidatorLiquidShares(ctx, record.Validator).Add(delegation.Shares),
+		)
+	}
+
+	// the exported total tokens are kept as, unlike the tokens of the
+	// delegations, they are not reduced by slashes
+	if data.Exported {
+		totalLiquidStaked = data.TotalLiquidStakedTokens
+	}
+
+	keeper.SetTotalLiquidStakedTokens(ctx, totalLiquidStaked)
+	keeper.SetLastTokenizeShareRecordID(ctx, data.LastTokenizeShareRecordID)
+
+	// the delegation messages queued until the end of the epoch are queued again
+	// in the same order
+	for _, msg := range data.EpochMsgs {
+		if err := keeper.QueueEpochMsg(ctx, msg); err != nil {
+			panic(err)
+		}
+	}
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
@@ -132,7 +191,7 @@ func InitGenesis(
 				panic(fmt.Sprintf("validator %s not found", lv.Address))
 			}
 
-			update := validator.ABCIValidatorUpdate()
+			update := validator.ABCIValidatorUpdate(keeper.PowerReduction(ctx))
 			update.Power = lv.Power // keep the next-val-set offset, use the last power for the first block
 			res = append(res, update)
 		}
@@ -177,6 +236,12 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 		UnbondingDelegations: unbondingDelegations,
 		Redelegations:        redelegations,
 		Exported:             true,
+
+		TokenizeShareRecords:      keeper.GetAllTokenizeShareRecords(ctx),
+		LastTokenizeShareRecordID: keeper.GetLastTokenizeShareRecordID(ctx),
+		TotalLiquidStakedTokens:   keeper.GetTotalLiquidStakedTokens(ctx),
+		LastUnbondingID:           keeper.GetUnbondingID(ctx),
+		EpochMsgs:                 keeper.GetAllEpochMsgs(ctx),
 	}
 }
 
@@ -185,7 +250,7 @@ func WriteValidators(ctx sdk.Context, keeper Keeper) (vals []tmtypes.GenesisVali
 	keeper.IterateLastValidators(ctx, func(_ int64, validator exported.ValidatorI) (stop bool) {
 		vals = append(vals, tmtypes.GenesisValidator{
 			PubKey: validator.GetConsPubKey(),
-			Power:  validator.GetConsensusPower(),
+			Power:  validator.GetConsensusPower(keeper.PowerReduction(ctx)),
 			Name:   validator.GetMoniker(),
 		})
 
@@ -202,9 +267,50 @@ func ValidateGenesis(data types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisStateTokenizeShareRecords(data.TokenizeShareRecords, data.LastTokenizeShareRecordID); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStateEpochMsgs(data.EpochMsgs); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
+func validateGenesisStateTokenizeShareRecords(records []types.TokenizeShareRecord, lastID uint64) error {
+	ids := make(map[uint64]bool, len(records))
+
+	for _, record := range records {
+		if ids[record.Id] {
+			return fmt.Errorf("duplicate tokenize share record in genesis state: id %d", record.Id)
+		}
+
+		if record.Id == 0 || record.Id > lastID {
+			return fmt.Errorf("invalid tokenize share record id %d, last id %d", record.Id, lastID)
+		}
+
+		ids[record.Id] = true
+	}
+
+	return nil
+}
+
+func validateGenesisStateEpochMsgs(msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		var epochMsg types.EpochMsg
+		if err := epochMsg.SetMsg(msg); err != nil {
+			return fmt.Errorf("invalid epoch message in genesis state: %w", err)
+		}
+
+		if err := msg.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid epoch message in genesis state: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func validateGenesisStateValidators(validators []types.Validator) (err error) {
 	addrMap := make(map[string]bool, len(validators))
 
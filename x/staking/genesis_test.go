@@ -21,7 +21,7 @@ func bootstrapGenesisTest(t *testing.T, power int64, numAddrs int) (*simapp.SimA
 
 	addrDels, _ := generateAddresses(app, ctx, numAddrs, 10000)
 
-	amt := sdk.TokensFromConsensusPower(power)
+	amt := sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)
 	totalSupply := sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), amt.MulRaw(int64(len(addrDels)))))
 
 	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
@@ -37,7 +37,7 @@ func bootstrapGenesisTest(t *testing.T, power int64, numAddrs int) (*simapp.SimA
 func TestInitGenesis(t *testing.T) {
 	app, ctx, addrs := bootstrapGenesisTest(t, 1000, 10)
 
-	valTokens := sdk.TokensFromConsensusPower(1)
+	valTokens := sdk.TokensFromConsensusPower(1, sdk.DefaultPowerReduction)
 
 	params := app.StakingKeeper.GetParams(ctx)
 	validators := make([]types.Validator, 2)
@@ -64,11 +64,16 @@ func TestInitGenesis(t *testing.T) {
 	validators[1].DelegatorShares = valTokens.ToDec()
 
 	genesisState := types.NewGenesisState(params, validators, delegations)
+	genesisState.LastUnbondingID = 5
+	msgDelegate := types.NewMsgDelegate(addrs[2], sdk.ValAddress(addrs[0]), sdk.NewCoin(params.BondDenom, valTokens))
+	genesisState.EpochMsgs = []sdk.Msg{&msgDelegate}
 	vals := staking.InitGenesis(ctx, app.StakingKeeper, app.AccountKeeper, app.BankKeeper, genesisState)
 
 	actualGenesis := staking.ExportGenesis(ctx, app.StakingKeeper)
 	require.Equal(t, genesisState.Params, actualGenesis.Params)
+	require.Equal(t, genesisState.LastUnbondingID, actualGenesis.LastUnbondingID)
 	require.Equal(t, genesisState.Delegations, actualGenesis.Delegations)
+	require.Equal(t, genesisState.EpochMsgs, actualGenesis.EpochMsgs)
 	require.EqualValues(t, app.StakingKeeper.GetAllValidators(ctx), actualGenesis.Validators)
 
 	// now make sure the validators are bonded and intra-tx counters are correct
@@ -82,7 +87,7 @@ func TestInitGenesis(t *testing.T) {
 
 	abcivals := make([]abci.ValidatorUpdate, len(vals))
 	for i, val := range validators {
-		abcivals[i] = val.ABCIValidatorUpdate()
+		abcivals[i] = val.ABCIValidatorUpdate(sdk.DefaultPowerReduction)
 	}
 
 	require.Equal(t, abcivals, vals)
@@ -104,9 +109,9 @@ func TestInitGenesisLargeValidatorSet(t *testing.T) {
 
 		validators[i].Status = sdk.Bonded
 
-		tokens := sdk.TokensFromConsensusPower(1)
+		tokens := sdk.TokensFromConsensusPower(1, sdk.DefaultPowerReduction)
 		if i < 100 {
-			tokens = sdk.TokensFromConsensusPower(2)
+			tokens = sdk.TokensFromConsensusPower(2, sdk.DefaultPowerReduction)
 		}
 		validators[i].Tokens = tokens
 		validators[i].DelegatorShares = tokens.ToDec()
@@ -117,7 +122,7 @@ func TestInitGenesisLargeValidatorSet(t *testing.T) {
 
 	abcivals := make([]abci.ValidatorUpdate, 100)
 	for i, val := range validators[:100] {
-		abcivals[i] = val.ABCIValidatorUpdate()
+		abcivals[i] = val.ABCIValidatorUpdate(sdk.DefaultPowerReduction)
 	}
 
 	require.Equal(t, abcivals, vals)
@@ -150,6 +155,36 @@ func TestValidateGenesis(t *testing.T) {
 			data.Validators[0].Jailed = true
 			data.Validators[0].Status = sdk.Bonded
 		}, true},
+		// validate genesis tokenize share records
+		{"tokenize share records", func(data *types.GenesisState) {
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{
+				types.NewTokenizeShareRecord(1, sdk.AccAddress(pk.Address()), sdk.ValAddress(pk.Address())),
+				types.NewTokenizeShareRecord(2, sdk.AccAddress(pk.Address()), sdk.ValAddress(pk.Address())),
+			}
+			data.LastTokenizeShareRecordID = 2
+		}, false},
+		{"duplicate tokenize share record", func(data *types.GenesisState) {
+			record := types.NewTokenizeShareRecord(1, sdk.AccAddress(pk.Address()), sdk.ValAddress(pk.Address()))
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{record, record}
+			data.LastTokenizeShareRecordID = 1
+		}, true},
+		{"tokenize share record above last id", func(data *types.GenesisState) {
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{
+				types.NewTokenizeShareRecord(2, sdk.AccAddress(pk.Address()), sdk.ValAddress(pk.Address())),
+			}
+			data.LastTokenizeShareRecordID = 1
+		}, true},
+		// validate genesis epoch messages
+		{"epoch messages", func(data *types.GenesisState) {
+			data.EpochMsgs = []sdk.Msg{types.NewMsgDelegate(
+				sdk.AccAddress(pk.Address()), sdk.ValAddress(pk.Address()), sdk.NewInt64Coin(sdk.DefaultBondDenom, 1),
+			)}
+		}, false},
+		{"invalid epoch message", func(data *types.GenesisState) {
+			data.EpochMsgs = []sdk.Msg{types.NewMsgDelegate(
+				sdk.AccAddress(pk.Address()), sdk.ValAddress(pk.Address()), sdk.NewInt64Coin(sdk.DefaultBondDenom, 0),
+			)}
+		}, true},
 	}
 
 	for _, tt := range tests {
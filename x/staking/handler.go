@@ -1,6 +1,7 @@
 package staking
 
 import (
+	"strconv"
 	"time"
 
 	gogotypes "github.com/gogo/protobuf/types"
@@ -17,6 +18,13 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
 		ctx = ctx.WithEventManager(sdk.NewEventManager())
 
+		switch msg.(type) {
+		case types.MsgDelegate, types.MsgBeginRedelegate, types.MsgUndelegate:
+			if k.IsEpochMode(ctx) {
+				return queueEpochMsg(ctx, msg, k)
+			}
+		}
+
 		switch msg := msg.(type) {
 		case types.MsgCreateValidator:
 			return handleMsgCreateValidator(ctx, msg, k)
@@ -33,6 +41,15 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case types.MsgUndelegate:
 			return handleMsgUndelegate(ctx, msg, k)
 
+		case types.MsgCancelUnbondingDelegation:
+			return handleMsgCancelUnbondingDelegation(ctx, msg, k)
+
+		case types.MsgTokenizeShares:
+			return handleMsgTokenizeShares(ctx, msg, k)
+
+		case types.MsgRedeemTokensForShares:
+			return handleMsgRedeemTokensForShares(ctx, msg, k)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
 		}
@@ -42,6 +59,78 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 // These functions assume everything has been authenticated,
 // now we just perform action and save
 
+// queueEpochMsg queues a delegation message until the end of the epoch, once it
+// checked that the message would currently be handled successfully.
+func queueEpochMsg(ctx sdk.Context, msg sdk.Msg, k keeper.Keeper) (*sdk.Result, error) {
+	cacheCtx, _ := ctx.CacheContext()
+	if _, err := handleEpochMsg(cacheCtx, msg, k); err != nil {
+		return nil, err
+	}
+
+	if err := k.QueueEpochMsg(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeQueueEpochMsg,
+			sdk.NewAttribute(types.AttributeKeyMsgType, msg.Type()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.GetSigners()[0].String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// handleEpochMsg handles a delegation message which may be queued until the end
+// of the epoch.
+func handleEpochMsg(ctx sdk.Context, msg sdk.Msg, k keeper.Keeper) (*sdk.Result, error) {
+	switch msg := msg.(type) {
+	case types.MsgDelegate:
+		return handleMsgDelegate(ctx, msg, k)
+	case *types.MsgDelegate:
+		return handleMsgDelegate(ctx, *msg, k)
+	case types.MsgBeginRedelegate:
+		return handleMsgBeginRedelegate(ctx, msg, k)
+	case *types.MsgBeginRedelegate:
+		return handleMsgBeginRedelegate(ctx, *msg, k)
+	case types.MsgUndelegate:
+		return handleMsgUndelegate(ctx, msg, k)
+	case *types.MsgUndelegate:
+		return handleMsgUndelegate(ctx, *msg, k)
+	default:
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s epoch message type: %T", ModuleName, msg)
+	}
+}
+
+// executeEpochMsgs handles the delegation messages queued until the end of the
+// epoch, in the order in which they were queued. The state changes of a failing
+// message are discarded and its error is reported in an event.
+func executeEpochMsgs(ctx sdk.Context, k keeper.Keeper) {
+	for _, msg := range k.DequeueAllEpochMsgs(ctx) {
+		cacheCtx, write := ctx.CacheContext()
+		if _, err := handleEpochMsg(cacheCtx, msg, k); err != nil {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeEpochMsgFailed,
+					sdk.NewAttribute(types.AttributeKeyMsgType, msg.Type()),
+					sdk.NewAttribute(sdk.AttributeKeySender, msg.GetSigners()[0].String()),
+					sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+				),
+			)
+
+			continue
+		}
+
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+}
+
 func handleMsgCreateValidator(ctx sdk.Context, msg types.MsgCreateValidator, k keeper.Keeper) (*sdk.Result, error) {
 	// check to see if the pubkey or sender has been registered before
 	if _, found := k.GetValidator(ctx, msg.ValidatorAddress); found {
@@ -65,6 +154,10 @@ func handleMsgCreateValidator(ctx sdk.Context, msg types.MsgCreateValidator, k k
 		return nil, err
 	}
 
+	if minRate := k.MinCommissionRate(ctx); msg.Commission.Rate.LT(minRate) {
+		return nil, sdkerrors.Wrapf(ErrCommissionLTMinRate, "cannot set validator commission to less than minimum rate of %s", minRate)
+	}
+
 	cp := ctx.ConsensusParams()
 	if cp != nil && cp.Validator != nil {
 		tmPubKey := tmtypes.TM2PB.PubKey(pk)
@@ -95,7 +188,9 @@ func handleMsgCreateValidator(ctx sdk.Context, msg types.MsgCreateValidator, k k
 	k.SetNewValidatorByPowerIndex(ctx, validator)
 
 	// call the after-creation hook
-	k.AfterValidatorCreated(ctx, validator.OperatorAddress)
+	if err := k.AfterValidatorCreated(ctx, validator.OperatorAddress); err != nil {
+		return nil, err
+	}
 
 	// move coins from the msg.Address account to a (self-delegation) delegator account
 	// the validator account and global shares are updated within here
@@ -137,13 +232,19 @@ func handleMsgEditValidator(ctx sdk.Context, msg types.MsgEditValidator, k keepe
 	validator.Description = description
 
 	if msg.CommissionRate != nil {
+		if minRate := k.MinCommissionRate(ctx); msg.CommissionRate.LT(minRate) {
+			return nil, sdkerrors.Wrapf(ErrCommissionLTMinRate, "cannot set validator commission to less than minimum rate of %s", minRate)
+		}
+
 		commission, err := k.UpdateValidatorCommission(ctx, validator, *msg.CommissionRate)
 		if err != nil {
 			return nil, err
 		}
 
 		// call the before-modification hook since we're about to update the commission
-		k.BeforeValidatorModified(ctx, msg.ValidatorAddress)
+		if err := k.BeforeValidatorModified(ctx, msg.ValidatorAddress); err != nil {
+			return nil, err
+		}
 
 		validator.Commission = commission
 	}
@@ -153,7 +254,7 @@ func handleMsgEditValidator(ctx sdk.Context, msg types.MsgEditValidator, k keepe
 			return nil, ErrMinSelfDelegationDecreased
 		}
 
-		if msg.MinSelfDelegation.GT(validator.Tokens) {
+		if msg.MinSelfDelegation.GT(k.GetValidatorSelfBond(ctx, validator)) {
 			return nil, ErrSelfDelegationBelowMinimum
 		}
 
@@ -250,6 +351,102 @@ func handleMsgUndelegate(ctx sdk.Context, msg types.MsgUndelegate, k keeper.Keep
 	return &sdk.Result{Data: completionTimeBz, Events: ctx.EventManager().ABCIEvents()}, nil
 }
 
+func handleMsgCancelUnbondingDelegation(
+	ctx sdk.Context, msg types.MsgCancelUnbondingDelegation, k keeper.Keeper,
+) (*sdk.Result, error) {
+	if msg.Amount.Denom != k.BondDenom(ctx) {
+		return nil, ErrBadDenom
+	}
+
+	_, err := k.CancelUnbondingDelegation(
+		ctx, msg.DelegatorAddress, msg.ValidatorAddress, msg.CreationHeight, msg.Amount.Amount,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelUnbondingDelegation,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCreationHeight, strconv.FormatInt(msg.CreationHeight, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgTokenizeShares(ctx sdk.Context, msg types.MsgTokenizeShares, k keeper.Keeper) (*sdk.Result, error) {
+	if msg.Amount.Denom != k.BondDenom(ctx) {
+		return nil, ErrBadDenom
+	}
+
+	shareToken, err := k.TokenizeShares(
+		ctx, msg.DelegatorAddress, msg.ValidatorAddress, msg.Amount, msg.TokenizedShareOwner,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	record, _ := k.GetTokenizeShareRecordByDenom(ctx, shareToken.Denom)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTokenizeShares,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyShareOwner, msg.TokenizedShareOwner.String()),
+			sdk.NewAttribute(types.AttributeKeyShareRecordID, strconv.FormatUint(record.Id, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, shareToken.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+func handleMsgRedeemTokensForShares(
+	ctx sdk.Context, msg types.MsgRedeemTokensForShares, k keeper.Keeper,
+) (*sdk.Result, error) {
+	record, found := k.GetTokenizeShareRecordByDenom(ctx, msg.Amount.Denom)
+	if !found {
+		return nil, types.ErrTokenizeShareRecordNotExists
+	}
+
+	returnAmount, err := k.RedeemTokensForShares(ctx, msg.DelegatorAddress, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRedeemShares,
+			sdk.NewAttribute(types.AttributeKeyValidator, record.Validator.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyShareRecordID, strconv.FormatUint(record.Id, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, returnAmount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
 func handleMsgBeginRedelegate(ctx sdk.Context, msg types.MsgBeginRedelegate, k keeper.Keeper) (*sdk.Result, error) {
 	shares, err := k.ValidateUnbondAmount(
 		ctx, msg.DelegatorAddress, msg.ValidatorSrcAddress, msg.Amount.Amount,
@@ -26,7 +26,7 @@ func bootstrapHandlerGenesisTest(t *testing.T, power int64, numAddrs int, accAmo
 
 	addrDels, addrVals := generateAddresses(app, ctx, numAddrs, accAmount)
 
-	amt := sdk.TokensFromConsensusPower(power)
+	amt := sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)
 	totalSupply := sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), amt.MulRaw(int64(len(addrDels)))))
 
 	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
@@ -41,7 +41,7 @@ func bootstrapHandlerGenesisTest(t *testing.T, power int64, numAddrs int, accAmo
 
 func TestValidatorByPowerIndex(t *testing.T) {
 	initPower := int64(1000000)
-	initBond := sdk.TokensFromConsensusPower(initPower)
+	initBond := sdk.TokensFromConsensusPower(initPower, sdk.DefaultPowerReduction)
 
 	app, ctx, _, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 10, 10000000000000)
 
@@ -68,7 +68,7 @@ func TestValidatorByPowerIndex(t *testing.T) {
 	// verify that the by power index exists
 	validator, found := app.StakingKeeper.GetValidator(ctx, validatorAddr)
 	require.True(t, found)
-	power := staking.GetValidatorsByPowerIndexKey(validator)
+	power := staking.GetValidatorsByPowerIndexKey(validator, sdk.DefaultPowerReduction)
 	require.True(t, keeper.ValidatorByPowerIndexExists(ctx, app.StakingKeeper, power))
 
 	// create a second validator keep it bonded
@@ -99,11 +99,11 @@ func TestValidatorByPowerIndex(t *testing.T) {
 	// but the new power record should have been created
 	validator, found = app.StakingKeeper.GetValidator(ctx, validatorAddr)
 	require.True(t, found)
-	power2 := staking.GetValidatorsByPowerIndexKey(validator)
+	power2 := staking.GetValidatorsByPowerIndexKey(validator, sdk.DefaultPowerReduction)
 	require.True(t, keeper.ValidatorByPowerIndexExists(ctx, app.StakingKeeper, power2))
 
 	// now the new record power index should be the same as the original record
-	power3 := staking.GetValidatorsByPowerIndexKey(validator)
+	power3 := staking.GetValidatorsByPowerIndexKey(validator, sdk.DefaultPowerReduction)
 	require.Equal(t, power2, power3)
 
 	// unbond self-delegation
@@ -140,7 +140,7 @@ func TestDuplicatesMsgCreateValidator(t *testing.T) {
 	addr1, addr2 := valAddrs[0], valAddrs[1]
 	pk1, pk2 := PKs[0], PKs[1]
 
-	valTokens := sdk.TokensFromConsensusPower(10)
+	valTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
 	msgCreateValidator1 := NewTestMsgCreateValidator(addr1, pk1, valTokens)
 	res, err := handler(ctx, msgCreateValidator1)
 	require.NoError(t, err)
@@ -219,7 +219,7 @@ func TestLegacyValidatorDelegations(t *testing.T) {
 	app, ctx, delAddrs, valAddrs := bootstrapHandlerGenesisTest(t, 1000, 2, 100000000)
 	handler := staking.NewHandler(app.StakingKeeper)
 
-	bondAmount := sdk.TokensFromConsensusPower(10)
+	bondAmount := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
 	valAddr := valAddrs[0]
 	valConsPubKey, valConsAddr := PKs[0], sdk.ConsAddress(PKs[0].Address())
 	delAddr := delAddrs[1]
@@ -318,13 +318,13 @@ func TestLegacyValidatorDelegations(t *testing.T) {
 
 func TestIncrementsMsgDelegate(t *testing.T) {
 	initPower := int64(1000)
-	initBond := sdk.TokensFromConsensusPower(initPower)
+	initBond := sdk.TokensFromConsensusPower(initPower, sdk.DefaultPowerReduction)
 	app, ctx, delAddrs, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 2, 1000000000)
 	handler := staking.NewHandler(app.StakingKeeper)
 
 	params := app.StakingKeeper.GetParams(ctx)
 
-	bondAmount := sdk.TokensFromConsensusPower(10)
+	bondAmount := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
 	validatorAddr, delegatorAddr := valAddrs[0], delAddrs[1]
 
 	// first create validator
@@ -390,7 +390,7 @@ func TestIncrementsMsgDelegate(t *testing.T) {
 
 func TestEditValidatorDecreaseMinSelfDelegation(t *testing.T) {
 	initPower := int64(100)
-	initBond := sdk.TokensFromConsensusPower(100)
+	initBond := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
 	app, ctx, _, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 1, 1000000000)
 
 	validatorAddr := valAddrs[0]
@@ -424,7 +424,7 @@ func TestEditValidatorDecreaseMinSelfDelegation(t *testing.T) {
 
 func TestEditValidatorIncreaseMinSelfDelegationBeyondCurrentBond(t *testing.T) {
 	initPower := int64(100)
-	initBond := sdk.TokensFromConsensusPower(100)
+	initBond := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
 
 	app, ctx, _, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 2, 1000000000)
 	validatorAddr := valAddrs[0]
@@ -457,9 +457,53 @@ func TestEditValidatorIncreaseMinSelfDelegationBeyondCurrentBond(t *testing.T) {
 	require.Nil(t, res)
 }
 
+func TestMinCommissionRate(t *testing.T) {
+	initPower := int64(100)
+	initBond := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+
+	app, ctx, _, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 1, 1000000000)
+	validatorAddr := valAddrs[0]
+
+	minRate := sdk.NewDecWithPrec(5, 2)
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MinCommissionRate = minRate
+	app.StakingKeeper.SetParams(ctx, params)
+
+	handler := staking.NewHandler(app.StakingKeeper)
+
+	// the default commission rate is below the minimum
+	msgCreateValidator := NewTestMsgCreateValidator(validatorAddr, PKs[0], initBond)
+	res, err := handler(ctx, msgCreateValidator)
+	require.True(t, types.ErrCommissionLTMinRate.Is(err), err)
+	require.Nil(t, res)
+
+	msgCreateValidator.Commission = types.NewCommissionRates(minRate, sdk.OneDec(), sdk.OneDec())
+	res, err = handler(ctx, msgCreateValidator)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(24 * time.Hour))
+
+	newRate := sdk.NewDecWithPrec(1, 2)
+	msgEditValidator := types.NewMsgEditValidator(validatorAddr, types.Description{}, &newRate, nil)
+	res, err = handler(ctx, msgEditValidator)
+	require.True(t, types.ErrCommissionLTMinRate.Is(err), err)
+	require.Nil(t, res)
+
+	newRate = sdk.NewDecWithPrec(10, 2)
+	msgEditValidator = types.NewMsgEditValidator(validatorAddr, types.Description{}, &newRate, nil)
+	res, err = handler(ctx, msgEditValidator)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, newRate, validator.Commission.Rate)
+}
+
 func TestIncrementsMsgUnbond(t *testing.T) {
 	initPower := int64(1000)
-	initBond := sdk.TokensFromConsensusPower(initPower)
+	initBond := sdk.TokensFromConsensusPower(initPower, sdk.DefaultPowerReduction)
 
 	app, ctx, delAddrs, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 2, 1000000000)
 	handler := staking.NewHandler(app.StakingKeeper)
@@ -544,8 +588,8 @@ func TestIncrementsMsgUnbond(t *testing.T) {
 	errorCases := []sdk.Int{
 		//1<<64 - 1, // more than int64 power
 		//1<<63 + 1, // more than int64 power
-		sdk.TokensFromConsensusPower(1<<63 - 1),
-		sdk.TokensFromConsensusPower(1 << 31),
+		sdk.TokensFromConsensusPower(1<<63-1, sdk.DefaultPowerReduction),
+		sdk.TokensFromConsensusPower(1<<31, sdk.DefaultPowerReduction),
 		initBond,
 	}
 
@@ -567,9 +611,112 @@ func TestIncrementsMsgUnbond(t *testing.T) {
 	require.NotNil(t, res, "msgUnbond: %v\nshares: %s\nleftBonded: %s\n", msgUndelegate, unbondAmt, leftBonded)
 }
 
+func TestCancelUnbondingDelegation(t *testing.T) {
+	initPower := int64(1000)
+	initBond := sdk.TokensFromConsensusPower(initPower, sdk.DefaultPowerReduction)
+
+	app, ctx, delAddrs, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 2, 1000000000)
+	handler := staking.NewHandler(app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(10)
+
+	validatorAddr, delegatorAddr := valAddrs[0], delAddrs[1]
+
+	msgCreateValidator := NewTestMsgCreateValidator(validatorAddr, PKs[0], initBond)
+	res, err := handler(ctx, msgCreateValidator)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	msgDelegate := NewTestMsgDelegate(delegatorAddr, validatorAddr, initBond)
+	res, err = handler(ctx, msgDelegate)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	bondedPoolBalance := func() sdk.Int {
+		return app.BankKeeper.GetBalance(ctx, app.StakingKeeper.GetBondedPool(ctx).GetAddress(), sdk.DefaultBondDenom).Amount
+	}
+	initBondedPool := bondedPoolBalance()
+
+	unbondAmt := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+	res, err = handler(ctx, types.NewMsgUndelegate(delegatorAddr, validatorAddr, unbondAmt))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	require.Equal(t, initBondedPool.Sub(unbondAmt.Amount), bondedPoolBalance())
+
+	cancelAmt := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(40))
+
+	// no entry at that height
+	res, err = handler(ctx, types.NewMsgCancelUnbondingDelegation(delegatorAddr, validatorAddr, 9, cancelAmt))
+	require.True(t, types.ErrNoUnbondingDelegationEntry.Is(err), err)
+	require.Nil(t, res)
+
+	// more than the entry balance
+	tooMuch := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(101))
+	res, err = handler(ctx, types.NewMsgCancelUnbondingDelegation(delegatorAddr, validatorAddr, 10, tooMuch))
+	require.Error(t, err)
+	require.Nil(t, res)
+
+	// wrong denom
+	badDenom := sdk.NewCoin("foo", sdk.NewInt(40))
+	res, err = handler(ctx, types.NewMsgCancelUnbondingDelegation(delegatorAddr, validatorAddr, 10, badDenom))
+	require.Error(t, err)
+	require.Nil(t, res)
+
+	// cancel part of the entry
+	res, err = handler(ctx, types.NewMsgCancelUnbondingDelegation(delegatorAddr, validatorAddr, 10, cancelAmt))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	ubd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, delegatorAddr, validatorAddr)
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 1)
+	require.Equal(t, sdk.NewInt(60), ubd.Entries[0].Balance)
+	require.Equal(t, sdk.NewInt(60), ubd.Entries[0].InitialBalance)
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, delegatorAddr, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, initBond.Sub(sdk.NewInt(60)), delegation.Shares.RoundInt())
+	require.Equal(t, initBondedPool.Sub(sdk.NewInt(60)), bondedPoolBalance())
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, initBond.MulRaw(2).Sub(sdk.NewInt(60)), validator.BondedTokens())
+
+	// cancel the rest of the entry
+	res, err = handler(ctx, types.NewMsgCancelUnbondingDelegation(
+		delegatorAddr, validatorAddr, 10, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(60)),
+	))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delegatorAddr, validatorAddr)
+	require.False(t, found)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, delegatorAddr, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, initBond, delegation.Shares.RoundInt())
+	require.Equal(t, initBondedPool, bondedPoolBalance())
+
+	// nothing left to cancel
+	res, err = handler(ctx, types.NewMsgCancelUnbondingDelegation(delegatorAddr, validatorAddr, 10, cancelAmt))
+	require.True(t, types.ErrNoUnbondingDelegation.Is(err), err)
+	require.Nil(t, res)
+
+	// mature entries cannot be cancelled
+	res, err = handler(ctx, types.NewMsgUndelegate(delegatorAddr, validatorAddr, unbondAmt))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(app.StakingKeeper.UnbondingTime(ctx)))
+	res, err = handler(ctx, types.NewMsgCancelUnbondingDelegation(delegatorAddr, validatorAddr, 10, cancelAmt))
+	require.True(t, types.ErrNoUnbondingDelegationEntry.Is(err), err)
+	require.Nil(t, res)
+}
+
 func TestMultipleMsgCreateValidator(t *testing.T) {
 	initPower := int64(1000)
-	initTokens := sdk.TokensFromConsensusPower(initPower)
+	initTokens := sdk.TokensFromConsensusPower(initPower, sdk.DefaultPowerReduction)
 	app, ctx, delAddrs, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 3, 1000000000)
 
 	handler := staking.NewHandler(app.StakingKeeper)
@@ -591,7 +738,7 @@ func TestMultipleMsgCreateValidator(t *testing.T) {
 
 	// bond them all
 	for i, validatorAddr := range validatorAddrs {
-		valTokens := sdk.TokensFromConsensusPower(10)
+		valTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
 		msgCreateValidatorOnBehalfOf := NewTestMsgCreateValidator(validatorAddr, PKs[i], valTokens)
 
 		res, err := handler(ctx, msgCreateValidatorOnBehalfOf)
@@ -618,7 +765,7 @@ func TestMultipleMsgCreateValidator(t *testing.T) {
 		_, found := app.StakingKeeper.GetValidator(ctx, validatorAddr)
 		require.True(t, found)
 
-		unbondAmt := sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10))
+		unbondAmt := sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction))
 		msgUndelegate := types.NewMsgUndelegate(delegatorAddrs[i], validatorAddr, unbondAmt) // remove delegation
 		res, err := handler(ctx, msgUndelegate)
 		require.NoError(t, err)
@@ -767,14 +914,14 @@ func TestValidatorQueue(t *testing.T) {
 	app.StakingKeeper.SetParams(ctx, params)
 
 	// create the validator
-	valTokens := sdk.TokensFromConsensusPower(10)
+	valTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
 	msgCreateValidator := NewTestMsgCreateValidator(validatorAddr, PKs[0], valTokens)
 	res, err := handler(ctx, msgCreateValidator)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
 	// bond a delegator
-	delTokens := sdk.TokensFromConsensusPower(10)
+	delTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
 	msgDelegate := NewTestMsgDelegate(delegatorAddr, validatorAddr, delTokens)
 	res, err = handler(ctx, msgDelegate)
 	require.NoError(t, err)
@@ -832,7 +979,7 @@ func TestUnbondingPeriod(t *testing.T) {
 	app.StakingKeeper.SetParams(ctx, params)
 
 	// create the validator
-	valTokens := sdk.TokensFromConsensusPower(10)
+	valTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
 	msgCreateValidator := NewTestMsgCreateValidator(validatorAddr, PKs[0], valTokens)
 	res, err := handler(ctx, msgCreateValidator)
 	require.NoError(t, err)
@@ -841,7 +988,7 @@ func TestUnbondingPeriod(t *testing.T) {
 	staking.EndBlocker(ctx, app.StakingKeeper)
 
 	// begin unbonding
-	unbondAmt := sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10))
+	unbondAmt := sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction))
 	msgUndelegate := types.NewMsgUndelegate(sdk.AccAddress(validatorAddr), validatorAddr, unbondAmt)
 	res, err = handler(ctx, msgUndelegate)
 	require.NoError(t, err)
@@ -1048,7 +1195,7 @@ func TestMultipleRedelegationAtSameTime(t *testing.T) {
 	app.StakingKeeper.SetParams(ctx, params)
 
 	// create the validators
-	valTokens := sdk.TokensFromConsensusPower(10)
+	valTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
 	msgCreateValidator := NewTestMsgCreateValidator(valAddr, PKs[0], valTokens)
 	res, err := handler(ctx, msgCreateValidator)
 	require.NoError(t, err)
@@ -1080,10 +1227,12 @@ func TestMultipleRedelegationAtSameTime(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
-	// now there should be two entries
+	// the second entry is merged into the first, created at the same height
 	rd, found = app.StakingKeeper.GetRedelegation(ctx, selfDelAddr, valAddr, valAddr2)
 	require.True(t, found)
-	require.Len(t, rd.Entries, 2)
+	require.Len(t, rd.Entries, 1)
+	require.Equal(t, valTokens, rd.Entries[0].InitialBalance)
+	require.Equal(t, valTokens.ToDec(), rd.Entries[0].SharesDst)
 
 	// move forward in time, should complete both redelegations
 	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(1 * time.Second))
@@ -1106,7 +1255,7 @@ func TestMultipleRedelegationAtUniqueTimes(t *testing.T) {
 	app.StakingKeeper.SetParams(ctx, params)
 
 	// create the validators
-	valTokens := sdk.TokensFromConsensusPower(10)
+	valTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
 	msgCreateValidator := NewTestMsgCreateValidator(valAddr, PKs[0], valTokens)
 	res, err := handler(ctx, msgCreateValidator)
 	require.NoError(t, err)
@@ -1165,7 +1314,7 @@ func TestMultipleUnbondingDelegationAtSameTime(t *testing.T) {
 	app.StakingKeeper.SetParams(ctx, params)
 
 	// create the validator
-	valTokens := sdk.TokensFromConsensusPower(10)
+	valTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
 	msgCreateValidator := NewTestMsgCreateValidator(valAddr, PKs[0], valTokens)
 	res, err := handler(ctx, msgCreateValidator)
 	require.NoError(t, err)
@@ -1192,10 +1341,12 @@ func TestMultipleUnbondingDelegationAtSameTime(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
-	// now there should be two entries
+	// the second entry is merged into the first, created at the same height
 	ubd, found = app.StakingKeeper.GetUnbondingDelegation(ctx, selfDelAddr, valAddr)
 	require.True(t, found)
-	require.Len(t, ubd.Entries, 2)
+	require.Len(t, ubd.Entries, 1)
+	require.Equal(t, valTokens, ubd.Entries[0].Balance)
+	require.Equal(t, valTokens, ubd.Entries[0].InitialBalance)
 
 	// move forwaubd in time, should complete both ubds
 	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(1 * time.Second))
@@ -1216,7 +1367,7 @@ func TestMultipleUnbondingDelegationAtUniqueTimes(t *testing.T) {
 	app.StakingKeeper.SetParams(ctx, params)
 
 	// create the validator
-	valTokens := sdk.TokensFromConsensusPower(10)
+	valTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
 	msgCreateValidator := NewTestMsgCreateValidator(valAddr, PKs[0], valTokens)
 	res, err := handler(ctx, msgCreateValidator)
 	require.NoError(t, err)
@@ -1277,7 +1428,7 @@ func TestUnbondingWhenExcessValidators(t *testing.T) {
 	app.StakingKeeper.SetParams(ctx, params)
 
 	// add three validators
-	valTokens1 := sdk.TokensFromConsensusPower(50)
+	valTokens1 := sdk.TokensFromConsensusPower(50, sdk.DefaultPowerReduction)
 	msgCreateValidator := NewTestMsgCreateValidator(validatorAddr1, PKs[0], valTokens1)
 	res, err := handler(ctx, msgCreateValidator)
 	require.NoError(t, err)
@@ -1287,7 +1438,7 @@ func TestUnbondingWhenExcessValidators(t *testing.T) {
 	app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
 	require.Equal(t, 1, len(app.StakingKeeper.GetLastValidators(ctx)))
 
-	valTokens2 := sdk.TokensFromConsensusPower(30)
+	valTokens2 := sdk.TokensFromConsensusPower(30, sdk.DefaultPowerReduction)
 	msgCreateValidator = NewTestMsgCreateValidator(validatorAddr2, PKs[1], valTokens2)
 	res, err = handler(ctx, msgCreateValidator)
 	require.NoError(t, err)
@@ -1297,7 +1448,7 @@ func TestUnbondingWhenExcessValidators(t *testing.T) {
 	app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
 	require.Equal(t, 2, len(app.StakingKeeper.GetLastValidators(ctx)))
 
-	valTokens3 := sdk.TokensFromConsensusPower(10)
+	valTokens3 := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
 	msgCreateValidator = NewTestMsgCreateValidator(validatorAddr3, PKs[2], valTokens3)
 	res, err = handler(ctx, msgCreateValidator)
 	require.NoError(t, err)
@@ -1335,7 +1486,7 @@ func TestBondUnbondRedelegateSlashTwice(t *testing.T) {
 	valA, valB, del := valAddrs[0], valAddrs[1], delAddrs[2]
 	consAddr0 := sdk.ConsAddress(PKs[0].Address())
 
-	valTokens := sdk.TokensFromConsensusPower(10)
+	valTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
 	msgCreateValidator := NewTestMsgCreateValidator(valA, PKs[0], valTokens)
 	res, err := handler(ctx, msgCreateValidator)
 	require.NoError(t, err)
@@ -1360,14 +1511,14 @@ func TestBondUnbondRedelegateSlashTwice(t *testing.T) {
 	ctx = ctx.WithBlockHeight(1)
 
 	// begin unbonding 4 stake
-	unbondAmt := sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(4))
+	unbondAmt := sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(4, sdk.DefaultPowerReduction))
 	msgUndelegate := types.NewMsgUndelegate(del, valA, unbondAmt)
 	res, err = handler(ctx, msgUndelegate)
 	require.NoError(t, err)
 	require.NotNil(t, res)
 
 	// begin redelegate 6 stake
-	redAmt := sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(6))
+	redAmt := sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(6, sdk.DefaultPowerReduction))
 	msgBeginRedelegate := types.NewMsgBeginRedelegate(del, valA, valB, redAmt)
 	res, err = handler(ctx, msgBeginRedelegate)
 	require.NoError(t, err)
@@ -1451,7 +1602,7 @@ func TestInvalidCoinDenom(t *testing.T) {
 
 	valA, valB, delAddr := valAddrs[0], valAddrs[1], delAddrs[2]
 
-	valTokens := sdk.TokensFromConsensusPower(100)
+	valTokens := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
 	invalidCoin := sdk.NewCoin("churros", valTokens)
 	validCoin := sdk.NewCoin(sdk.DefaultBondDenom, valTokens)
 	oneCoin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())
@@ -1503,3 +1654,284 @@ func TestInvalidCoinDenom(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 }
+
+func TestTokenizeSharesAndRedeemTokens(t *testing.T) {
+	initPower := int64(1000)
+	initBond := sdk.TokensFromConsensusPower(initPower, sdk.DefaultPowerReduction)
+
+	app, ctx, delAddrs, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 3, 1000000000)
+	handler := staking.NewHandler(app.StakingKeeper)
+
+	validatorAddr, delegatorAddr, holderAddr := valAddrs[0], delAddrs[1], delAddrs[2]
+
+	msgCreateValidator := NewTestMsgCreateValidator(validatorAddr, PKs[0], initBond)
+	res, err := handler(ctx, msgCreateValidator)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	msgDelegate := NewTestMsgDelegate(delegatorAddr, validatorAddr, initBond)
+	res, err = handler(ctx, msgDelegate)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	tokenizeAmt := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000))
+
+	// wrong denom
+	res, err = handler(ctx, types.NewMsgTokenizeShares(delegatorAddr, validatorAddr, sdk.NewInt64Coin("foo", 1000), delegatorAddr))
+	require.Error(t, err)
+	require.Nil(t, res)
+
+	// more than delegated
+	tooMuch := sdk.NewCoin(sdk.DefaultBondDenom, initBond.AddRaw(1))
+	res, err = handler(ctx, types.NewMsgTokenizeShares(delegatorAddr, validatorAddr, tooMuch, delegatorAddr))
+	require.Error(t, err)
+	require.Nil(t, res)
+
+	res, err = handler(ctx, types.NewMsgTokenizeShares(delegatorAddr, validatorAddr, tokenizeAmt, delegatorAddr))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	record, found := app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, delegatorAddr, record.Owner)
+	require.Equal(t, validatorAddr, record.Validator)
+	require.Equal(t, []types.TokenizeShareRecord{record}, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, delegatorAddr))
+
+	shareDenom := record.GetShareTokenDenom()
+	require.Equal(t, validatorAddr.String()+"/1", shareDenom)
+	require.Equal(t, tokenizeAmt.Amount, app.BankKeeper.GetBalance(ctx, delegatorAddr, shareDenom).Amount)
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, delegatorAddr, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, initBond.Sub(tokenizeAmt.Amount), delegation.Shares.RoundInt())
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), validatorAddr)
+	require.True(t, found)
+	require.Equal(t, tokenizeAmt.Amount, delegation.Shares.RoundInt())
+
+	require.Equal(t, tokenizeAmt.Amount, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+	require.Equal(t, tokenizeAmt.Amount.ToDec(), app.StakingKeeper.GetValidatorLiquidShares(ctx, validatorAddr))
+
+	// the share tokens are transferable and can be redeemed by their holder
+	transferAmt := sdk.NewCoin(shareDenom, sdk.NewInt(400))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, delegatorAddr, holderAddr, sdk.NewCoins(transferAmt)))
+
+	res, err = handler(ctx, types.NewMsgRedeemTokensForShares(holderAddr, transferAmt))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, holderAddr, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, transferAmt.Amount, delegation.Shares.RoundInt())
+	require.True(t, app.BankKeeper.GetBalance(ctx, holderAddr, shareDenom).IsZero())
+
+	_, found = app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt(600), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	// more than held
+	res, err = handler(ctx, types.NewMsgRedeemTokensForShares(holderAddr, transferAmt))
+	require.Error(t, err)
+	require.Nil(t, res)
+
+	// unknown share denom
+	res, err = handler(ctx, types.NewMsgRedeemTokensForShares(delegatorAddr, sdk.NewInt64Coin(validatorAddr.String()+"/2", 1)))
+	require.True(t, types.ErrTokenizeShareRecordNotExists.Is(err), err)
+	require.Nil(t, res)
+
+	// redeeming the last share tokens removes the record
+	res, err = handler(ctx, types.NewMsgRedeemTokensForShares(delegatorAddr, sdk.NewCoin(shareDenom, sdk.NewInt(600))))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	_, found = app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.False(t, found)
+	require.Empty(t, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, delegatorAddr))
+
+	_, found = app.StakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), validatorAddr)
+	require.False(t, found)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, delegatorAddr, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, initBond.Sub(transferAmt.Amount), delegation.Shares.RoundInt())
+
+	require.True(t, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx).IsZero())
+	require.True(t, app.StakingKeeper.GetValidatorLiquidShares(ctx, validatorAddr).IsZero())
+}
+
+func TestTokenizeSharesSlash(t *testing.T) {
+	initPower := int64(1000)
+	initBond := sdk.TokensFromConsensusPower(initPower, sdk.DefaultPowerReduction)
+
+	app, ctx, delAddrs, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 2, 1000000000)
+	handler := staking.NewHandler(app.StakingKeeper)
+
+	validatorAddr, delegatorAddr := valAddrs[0], delAddrs[1]
+
+	res, err := handler(ctx, NewTestMsgCreateValidator(validatorAddr, PKs[0], initBond))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	res, err = handler(ctx, NewTestMsgDelegate(delegatorAddr, validatorAddr, initBond))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	res, err = handler(ctx, types.NewMsgTokenizeShares(delegatorAddr, validatorAddr, sdk.NewCoin(sdk.DefaultBondDenom, initBond), delegatorAddr))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	require.Equal(t, initBond, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	// slashing the validator by half halves the tokens backing the share tokens
+	validator, found := app.StakingKeeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	app.StakingKeeper.Slash(ctx, sdk.ConsAddress(PKs[0].Address()), ctx.BlockHeight(), validator.GetConsensusPower(sdk.DefaultPowerReduction), sdk.NewDecWithPrec(5, 1))
+	require.Equal(t, initBond.QuoRaw(2), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+	require.Equal(t, initBond.ToDec(), app.StakingKeeper.GetValidatorLiquidShares(ctx, validatorAddr))
+
+	// redeeming all the share tokens leaves no liquid staked tokens
+	res, err = handler(ctx, types.NewMsgRedeemTokensForShares(delegatorAddr, sdk.NewCoin(validatorAddr.String()+"/1", initBond)))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	require.True(t, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx).IsZero())
+}
+
+func TestTokenizeSharesLiquidStakingCaps(t *testing.T) {
+	initPower := int64(1000)
+	initBond := sdk.TokensFromConsensusPower(initPower, sdk.DefaultPowerReduction)
+
+	app, ctx, delAddrs, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 2, 1000000000)
+	handler := staking.NewHandler(app.StakingKeeper)
+
+	validatorAddr, delegatorAddr := valAddrs[0], delAddrs[1]
+
+	msgCreateValidator := NewTestMsgCreateValidator(validatorAddr, PKs[0], initBond)
+	res, err := handler(ctx, msgCreateValidator)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	msgDelegate := NewTestMsgDelegate(delegatorAddr, validatorAddr, initBond)
+	res, err = handler(ctx, msgDelegate)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	// a tenth of the validator's shares, which hold all the bonded tokens
+	tenth := sdk.NewCoin(sdk.DefaultBondDenom, initBond.QuoRaw(5))
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.ValidatorLiquidStakingCap = sdk.MustNewDecFromStr("0.05")
+	app.StakingKeeper.SetParams(ctx, params)
+
+	res, err = handler(ctx, types.NewMsgTokenizeShares(delegatorAddr, validatorAddr, tenth, delegatorAddr))
+	require.True(t, types.ErrValidatorLiquidStakingCapExceeded.Is(err), err)
+	require.Nil(t, res)
+
+	params.ValidatorLiquidStakingCap = sdk.OneDec()
+	params.GlobalLiquidStakingCap = sdk.MustNewDecFromStr("0.05")
+	app.StakingKeeper.SetParams(ctx, params)
+
+	res, err = handler(ctx, types.NewMsgTokenizeShares(delegatorAddr, validatorAddr, tenth, delegatorAddr))
+	require.True(t, types.ErrGlobalLiquidStakingCapExceeded.Is(err), err)
+	require.Nil(t, res)
+
+	params.GlobalLiquidStakingCap = sdk.MustNewDecFromStr("0.1")
+	params.ValidatorLiquidStakingCap = sdk.MustNewDecFromStr("0.1")
+	app.StakingKeeper.SetParams(ctx, params)
+
+	res, err = handler(ctx, types.NewMsgTokenizeShares(delegatorAddr, validatorAddr, tenth, delegatorAddr))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	// the caps account for the shares tokenized before
+	oneCoin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())
+	res, err = handler(ctx, types.NewMsgTokenizeShares(delegatorAddr, validatorAddr, oneCoin, delegatorAddr))
+	require.True(t, types.ErrGlobalLiquidStakingCapExceeded.Is(err), err)
+	require.Nil(t, res)
+
+	params.GlobalLiquidStakingCap = sdk.OneDec()
+	app.StakingKeeper.SetParams(ctx, params)
+
+	res, err = handler(ctx, types.NewMsgTokenizeShares(delegatorAddr, validatorAddr, oneCoin, delegatorAddr))
+	require.True(t, types.ErrValidatorLiquidStakingCapExceeded.Is(err), err)
+	require.Nil(t, res)
+}
+
+func TestEpochDelegationMsgs(t *testing.T) {
+	initPower := int64(1000)
+	app, ctx, delAddrs, valAddrs := bootstrapHandlerGenesisTest(t, initPower, 2, 1000000000)
+	handler := staking.NewHandler(app.StakingKeeper)
+
+	bondAmount := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	validatorAddr, delegatorAddr := valAddrs[0], delAddrs[1]
+
+	// validators are still created right away
+	res, err := handler(ctx, NewTestMsgCreateValidator(validatorAddr, PKs[0], bondAmount))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.EpochLength = 3
+	app.StakingKeeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(1)
+
+	// a delegation is queued until the end of the epoch
+	res, err = handler(ctx, NewTestMsgDelegate(delegatorAddr, validatorAddr, bondAmount))
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	require.Equal(t, types.EventTypeQueueEpochMsg, res.Events[0].Type)
+
+	_, found := app.StakingKeeper.GetDelegation(ctx, delegatorAddr, validatorAddr)
+	require.False(t, found)
+	require.Len(t, app.StakingKeeper.GetAllEpochMsgs(ctx), 1)
+
+	// a message that cannot be handled is rejected when it is delivered
+	_, err = handler(ctx, NewTestMsgDelegate(delegatorAddr, sdk.ValAddress(delegatorAddr), bondAmount))
+	require.Error(t, err)
+
+	// both undelegations are valid when they are delivered, but together they
+	// exceed the self delegation
+	msgUndelegate := types.NewMsgUndelegate(
+		sdk.AccAddress(validatorAddr), validatorAddr, sdk.NewCoin(params.BondDenom, bondAmount),
+	)
+	_, err = handler(ctx, msgUndelegate)
+	require.NoError(t, err)
+	msgUndelegate = types.NewMsgUndelegate(
+		sdk.AccAddress(validatorAddr), validatorAddr, sdk.NewCoin(params.BondDenom, sdk.OneInt()),
+	)
+	_, err = handler(ctx, msgUndelegate)
+	require.NoError(t, err)
+	require.Len(t, app.StakingKeeper.GetAllEpochMsgs(ctx), 3)
+
+	// nothing is handled before the end of the epoch
+	ctx = ctx.WithBlockHeight(2)
+	require.Empty(t, staking.EndBlocker(ctx, app.StakingKeeper))
+	require.Len(t, app.StakingKeeper.GetAllEpochMsgs(ctx), 3)
+
+	ctx = ctx.WithBlockHeight(3).WithEventManager(sdk.NewEventManager())
+	updates := staking.EndBlocker(ctx, app.StakingKeeper)
+	require.Len(t, updates, 1)
+	require.Empty(t, app.StakingKeeper.GetAllEpochMsgs(ctx))
+
+	bond, found := app.StakingKeeper.GetDelegation(ctx, delegatorAddr, validatorAddr)
+	require.True(t, found)
+	require.Equal(t, bondAmount, bond.Shares.RoundInt())
+
+	// the self delegation was fully undelegated by the first undelegation, so
+	// the second one failed
+	_, found = app.StakingKeeper.GetDelegation(ctx, sdk.AccAddress(validatorAddr), validatorAddr)
+	require.False(t, found)
+
+	var failed []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeEpochMsgFailed {
+			failed = append(failed, event)
+		}
+	}
+	require.Len(t, failed, 1)
+}
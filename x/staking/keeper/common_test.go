@@ -33,6 +33,7 @@ func createTestInput() (*codec.Codec, *simapp.SimApp, sdk.Context) {
 		app.AccountKeeper,
 		app.BankKeeper,
 		app.GetSubspace(staking.ModuleName),
+		sdk.DefaultPowerReduction,
 	)
 
 	return codec.New(), app, ctx
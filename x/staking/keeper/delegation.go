@@ -55,14 +55,32 @@ func (k Keeper) GetAllDelegations(ctx sdk.Context) (delegations []types.Delegati
 func (k Keeper) GetValidatorDelegations(ctx sdk.Context, valAddr sdk.ValAddress) (delegations []types.Delegation) { //nolint:interfacer
 	store := ctx.KVStore(k.storeKey)
 
-	iterator := sdk.KVStorePrefixIterator(store, types.DelegationKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetDelegationsByValIndexKey(valAddr))
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
-		delegation := types.MustUnmarshalDelegation(k.cdc, iterator.Value())
-		if delegation.GetValidatorAddr().Equals(valAddr) {
-			delegations = append(delegations, delegation)
-		}
+		key := types.GetDelegationKeyFromValIndexKey(iterator.Key())
+		delegation := types.MustUnmarshalDelegation(k.cdc, store.Get(key))
+		delegations = append(delegations, delegation)
+	}
+
+	return delegations
+}
+
+// GetPaginatedValidatorDelegations returns the delegations to a validator in
+// the selected page, ordered by delegator address.
+func (k Keeper) GetPaginatedValidatorDelegations(
+	ctx sdk.Context, valAddr sdk.ValAddress, page, limit int,
+) []types.Delegation {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIteratorPaginated(store, types.GetDelegationsByValIndexKey(valAddr), uint(page), uint(limit))
+	defer iterator.Close()
+
+	delegations := []types.Delegation{}
+	for ; iterator.Valid(); iterator.Next() {
+		key := types.GetDelegationKeyFromValIndexKey(iterator.Key())
+		delegations = append(delegations, types.MustUnmarshalDelegation(k.cdc, store.Get(key)))
 	}
 
 	return delegations
@@ -93,14 +111,21 @@ func (k Keeper) SetDelegation(ctx sdk.Context, delegation types.Delegation) {
 	store := ctx.KVStore(k.storeKey)
 	b := types.MustMarshalDelegation(k.cdc, delegation)
 	store.Set(types.GetDelegationKey(delegation.DelegatorAddress, delegation.ValidatorAddress), b)
+	store.Set(types.GetDelegationByValIndexKey(delegation.DelegatorAddress, delegation.ValidatorAddress), []byte{}) // index, store empty bytes
 }
 
-// remove a delegation
-func (k Keeper) RemoveDelegation(ctx sdk.Context, delegation types.Delegation) {
+// remove a delegation, unless the BeforeDelegationRemoved hook returns an error
+func (k Keeper) RemoveDelegation(ctx sdk.Context, delegation types.Delegation) error {
 	// TODO: Consider calling hooks outside of the store wrapper functions, it's unobvious.
-	k.BeforeDelegationRemoved(ctx, delegation.DelegatorAddress, delegation.ValidatorAddress)
+	if err := k.BeforeDelegationRemoved(ctx, delegation.DelegatorAddress, delegation.ValidatorAddress); err != nil {
+		return err
+	}
+
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetDelegationKey(delegation.DelegatorAddress, delegation.ValidatorAddress))
+	store.Delete(types.GetDelegationByValIndexKey(delegation.DelegatorAddress, delegation.ValidatorAddress))
+
+	return nil
 }
 
 // return a given amount of all the delegator unbonding-delegations
@@ -203,21 +228,33 @@ func (k Keeper) RemoveUnbondingDelegation(ctx sdk.Context, ubd types.UnbondingDe
 }
 
 // SetUnbondingDelegationEntry adds an entry to the unbonding delegation at
-// the given addresses. It creates the unbonding delegation if it does not exist
+// the given addresses. It creates the unbonding delegation if it does not exist.
+// A new entry is given a unique ID, passed to the AfterUnbondingInitiated hook,
+// while an entry merged into an existing one keeps the existing entry's ID.
+// An error returned by the hook is returned to the caller.
 func (k Keeper) SetUnbondingDelegationEntry(
 	ctx sdk.Context, delegatorAddr sdk.AccAddress, validatorAddr sdk.ValAddress,
 	creationHeight int64, minTime time.Time, balance sdk.Int,
-) types.UnbondingDelegation {
+) (types.UnbondingDelegation, error) {
 	ubd, found := k.GetUnbondingDelegation(ctx, delegatorAddr, validatorAddr)
-	if found {
-		ubd.AddEntry(creationHeight, minTime, balance)
-	} else {
+	if !found {
 		ubd = types.NewUnbondingDelegation(delegatorAddr, validatorAddr, creationHeight, minTime, balance)
+	} else if !ubd.AddEntry(creationHeight, minTime, balance) {
+		k.SetUnbondingDelegation(ctx, ubd)
+		return ubd, nil
 	}
 
+	id := k.IncrementUnbondingID(ctx)
+	ubd.Entries[len(ubd.Entries)-1].UnbondingId = id
+
 	k.SetUnbondingDelegation(ctx, ubd)
+	k.SetUnbondingDelegationByUnbondingID(ctx, ubd, id)
+
+	if err := k.AfterUnbondingInitiated(ctx, id); err != nil {
+		return ubd, err
+	}
 
-	return ubd
+	return ubd, nil
 }
 
 // unbonding delegation queue timeslice operations
@@ -251,6 +288,12 @@ func (k Keeper) InsertUBDQueue(ctx sdk.Context, ubd types.UnbondingDelegation,
 	dvPair := types.DVPair{DelegatorAddress: ubd.DelegatorAddress, ValidatorAddress: ubd.ValidatorAddress}
 
 	timeSlice := k.GetUBDQueueTimeSlice(ctx, completionTime)
+	for _, pair := range timeSlice {
+		if pair.Equal(dvPair) {
+			return // already queued, e.g. for a merged entry
+		}
+	}
+
 	if len(timeSlice) == 0 {
 		k.SetUBDQueueTimeSlice(ctx, completionTime, []types.DVPair{dvPair})
 	} else {
@@ -361,7 +404,7 @@ func (k Keeper) HasMaxRedelegationEntries(ctx sdk.Context,
 		return false
 	}
 
-	return len(red.Entries) >= int(k.MaxEntries(ctx))
+	return len(red.Entries) >= int(k.MaxRedelegationEntries(ctx))
 }
 
 // set a redelegation and associated index
@@ -453,6 +496,12 @@ func (k Keeper) InsertRedelegationQueue(ctx sdk.Context, red types.Redelegation,
 		ValidatorSrcAddress: red.ValidatorSrcAddress,
 		ValidatorDstAddress: red.ValidatorDstAddress}
 
+	for _, triplet := range timeSlice {
+		if triplet.Equal(dvvTriplet) {
+			return // already queued, e.g. for a merged entry
+		}
+	}
+
 	if len(timeSlice) == 0 {
 		k.SetRedelegationQueueTimeSlice(ctx, completionTime, []types.DVVTriplet{dvvTriplet})
 	} else {
@@ -508,9 +557,13 @@ func (k Keeper) Delegate(
 
 	// call the appropriate hook if present
 	if found {
-		k.BeforeDelegationSharesModified(ctx, delAddr, validator.OperatorAddress)
+		err = k.BeforeDelegationSharesModified(ctx, delAddr, validator.OperatorAddress)
 	} else {
-		k.BeforeDelegationCreated(ctx, delAddr, validator.OperatorAddress)
+		err = k.BeforeDelegationCreated(ctx, delAddr, validator.OperatorAddress)
+	}
+
+	if err != nil {
+		return sdk.ZeroDec(), err
 	}
 
 	// if subtractAccount is true then we are
@@ -561,7 +614,9 @@ func (k Keeper) Delegate(
 	k.SetDelegation(ctx, delegation)
 
 	// Call the after-modification hook
-	k.AfterDelegationModified(ctx, delegation.DelegatorAddress, delegation.ValidatorAddress)
+	if err := k.AfterDelegationModified(ctx, delegation.DelegatorAddress, delegation.ValidatorAddress); err != nil {
+		return sdk.ZeroDec(), err
+	}
 
 	return newShares, nil
 }
@@ -577,7 +632,9 @@ func (k Keeper) Unbond(
 	}
 
 	// call the before-delegation-modified hook
-	k.BeforeDelegationSharesModified(ctx, delAddr, valAddr)
+	if err := k.BeforeDelegationSharesModified(ctx, delAddr, valAddr); err != nil {
+		return amount, err
+	}
 
 	// ensure that we have enough shares to remove
 	if delegation.Shares.LT(shares) {
@@ -605,11 +662,15 @@ func (k Keeper) Unbond(
 
 	// remove the delegation
 	if delegation.Shares.IsZero() {
-		k.RemoveDelegation(ctx, delegation)
+		err = k.RemoveDelegation(ctx, delegation)
 	} else {
 		k.SetDelegation(ctx, delegation)
 		// call the after delegation modification hook
-		k.AfterDelegationModified(ctx, delegation.DelegatorAddress, delegation.ValidatorAddress)
+		err = k.AfterDelegationModified(ctx, delegation.DelegatorAddress, delegation.ValidatorAddress)
+	}
+
+	if err != nil {
+		return amount, err
 	}
 
 	// remove the shares and coins from the validator
@@ -666,7 +727,7 @@ func (k Keeper) Undelegate(
 	}
 
 	if k.HasMaxUnbondingDelegationEntries(ctx, delAddr, valAddr) {
-		return time.Time{}, types.ErrMaxUnbondingDelegationEntries
+		return time.Time{}, sdkerrors.Wrapf(types.ErrMaxUnbondingDelegationEntries, "max entries: %d", k.MaxEntries(ctx))
 	}
 
 	returnAmount, err := k.Unbond(ctx, delAddr, valAddr, sharesAmount)
@@ -680,15 +741,19 @@ func (k Keeper) Undelegate(
 	}
 
 	completionTime := ctx.BlockHeader().Time.Add(k.UnbondingTime(ctx))
-	ubd := k.SetUnbondingDelegationEntry(ctx, delAddr, valAddr, ctx.BlockHeight(), completionTime, returnAmount)
+	ubd, err := k.SetUnbondingDelegationEntry(ctx, delAddr, valAddr, ctx.BlockHeight(), completionTime, returnAmount)
+	if err != nil {
+		return time.Time{}, err
+	}
+
 	k.InsertUBDQueue(ctx, ubd, completionTime)
 
 	return completionTime, nil
 }
 
 // CompleteUnbonding completes the unbonding of all mature entries in the
-// retrieved unbonding delegation object and returns the total unbonding balance
-// or an error upon failure.
+// retrieved unbonding delegation object, except for the ones on hold, and
+// returns the total unbonding balance or an error upon failure.
 func (k Keeper) CompleteUnbonding(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coins, error) {
 	ubd, found := k.GetUnbondingDelegation(ctx, delAddr, valAddr)
 	if !found {
@@ -702,8 +767,9 @@ func (k Keeper) CompleteUnbonding(ctx sdk.Context, delAddr sdk.AccAddress, valAd
 	// loop through all the entries and complete unbonding mature entries
 	for i := 0; i < len(ubd.Entries); i++ {
 		entry := ubd.Entries[i]
-		if entry.IsMature(ctxTime) {
+		if entry.IsMature(ctxTime) && !entry.OnHold() {
 			ubd.RemoveEntry(int64(i))
+			k.DeleteUnbondingIndex(ctx, entry.UnbondingId)
 			i--
 
 			// track undelegation only when remaining or truncated shares are non-zero
@@ -730,6 +796,67 @@ func (k Keeper) CompleteUnbonding(ctx sdk.Context, delAddr sdk.AccAddress, valAd
 	return balances, nil
 }
 
+// CancelUnbondingDelegation cancels amt tokens of the unbonding delegation
+// entry created at the given height, which must not have matured yet, and
+// delegates them back to the validator. It returns the shares delegated.
+func (k Keeper) CancelUnbondingDelegation(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, creationHeight int64, amt sdk.Int,
+) (sdk.Dec, error) {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.ZeroDec(), types.ErrNoValidatorFound
+	}
+
+	if validator.IsJailed() {
+		return sdk.ZeroDec(), types.ErrValidatorJailed
+	}
+
+	ubd, found := k.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return sdk.ZeroDec(), types.ErrNoUnbondingDelegation
+	}
+
+	ctxTime := ctx.BlockHeader().Time
+	entryIndex := -1
+
+	for i, entry := range ubd.Entries {
+		if entry.CreationHeight == creationHeight && !entry.IsMature(ctxTime) {
+			entryIndex = i
+			break
+		}
+	}
+
+	if entryIndex == -1 {
+		return sdk.ZeroDec(), sdkerrors.Wrapf(types.ErrNoUnbondingDelegationEntry, "creation height: %d", creationHeight)
+	}
+
+	entry := ubd.Entries[entryIndex]
+	if amt.GT(entry.Balance) {
+		return sdk.ZeroDec(), sdkerrors.Wrapf(
+			types.ErrBadSharesAmount, "amount %s exceeds the unbonding delegation entry balance %s", amt, entry.Balance,
+		)
+	}
+
+	if amt.Equal(entry.Balance) {
+		ubd.RemoveEntry(int64(entryIndex))
+		k.DeleteUnbondingIndex(ctx, entry.UnbondingId)
+	} else {
+		entry.Balance = entry.Balance.Sub(amt)
+		entry.InitialBalance = entry.InitialBalance.Sub(amt)
+		ubd.Entries[entryIndex] = entry
+	}
+
+	// set the unbonding delegation or remove it if there are no more entries
+	if len(ubd.Entries) == 0 {
+		k.RemoveUnbondingDelegation(ctx, ubd)
+	} else {
+		k.SetUnbondingDelegation(ctx, ubd)
+	}
+
+	// the unbonding tokens are held by the not bonded pool
+	return k.Delegate(ctx, delAddr, amt, sdk.Unbonding, validator, false)
+}
+
 // begin unbonding / redelegation; create a redelegation record
 func (k Keeper) BeginRedelegation(
 	ctx sdk.Context, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress, sharesAmount sdk.Dec,
@@ -754,7 +881,7 @@ func (k Keeper) BeginRedelegation(
 	}
 
 	if k.HasMaxRedelegationEntries(ctx, delAddr, valSrcAddr, valDstAddr) {
-		return time.Time{}, types.ErrMaxRedelegationEntries
+		return time.Time{}, sdkerrors.Wrapf(types.ErrMaxRedelegationEntries, "max entries: %d", k.MaxRedelegationEntries(ctx))
 	}
 
 	returnAmount, err := k.Unbond(ctx, delAddr, valSrcAddr, sharesAmount)
@@ -866,3 +993,146 @@ func (k Keeper) ValidateUnbondAmount(
 
 	return shares, nil
 }
+
+// TransferDelegation moves up to wantShares delegator shares of the given
+// validator from one delegator to another without unbonding the underlying
+// tokens. It returns the amount of shares actually transferred, which is
+// capped at the shares held by the source delegation, or the error returned by
+// a staking hook. The source delegator's unbonding delegations and redelegations
+// are left untouched.
+func (k Keeper) TransferDelegation(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, wantShares sdk.Dec,
+) (sdk.Dec, error) {
+	transferred := sdk.ZeroDec()
+
+	if fromAddr.Equals(toAddr) || !wantShares.IsPositive() {
+		return transferred, nil
+	}
+
+	delFrom, found := k.GetDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		return transferred, nil
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return transferred, nil
+	}
+
+	transferred = sdk.MinDec(wantShares, delFrom.Shares)
+
+	// subtract the shares from the source delegation
+	if err := k.BeforeDelegationSharesModified(ctx, fromAddr, valAddr); err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	delFrom.Shares = delFrom.Shares.Sub(transferred)
+
+	// If the source is the operator of the validator and the transfer decreases
+	// the validator's self-delegation below their minimum, we jail the validator.
+	isValidatorOperator := fromAddr.Equals(validator.OperatorAddress)
+	if isValidatorOperator && !validator.Jailed &&
+		validator.TokensFromShares(delFrom.Shares).TruncateInt().LT(validator.MinSelfDelegation) {
+		k.jailValidator(ctx, validator)
+	}
+
+	var err error
+	if delFrom.Shares.IsZero() {
+		err = k.RemoveDelegation(ctx, delFrom)
+	} else {
+		k.SetDelegation(ctx, delFrom)
+		err = k.AfterDelegationModified(ctx, fromAddr, valAddr)
+	}
+
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	// add the shares to the destination delegation, creating it if needed
+	delTo, found := k.GetDelegation(ctx, toAddr, valAddr)
+	if found {
+		err = k.BeforeDelegationSharesModified(ctx, toAddr, valAddr)
+	} else {
+		err = k.BeforeDelegationCreated(ctx, toAddr, valAddr)
+		delTo = types.NewDelegation(toAddr, valAddr, sdk.ZeroDec())
+	}
+
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	delTo.Shares = delTo.Shares.Add(transferred)
+	k.SetDelegation(ctx, delTo)
+
+	if err := k.AfterDelegationModified(ctx, toAddr, valAddr); err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	return transferred, nil
+}
+
+// TransferUnbonding moves up to wantAmt tokens of the unbonding delegation
+// entries from one delegator to another for the given validator. Entries keep
+// their creation height and completion time, so the tokens are released to the
+// destination delegator at the same time they would have been released to the
+// source. Entries on hold are not transferred. It returns the amount of tokens
+// actually transferred, or the error returned by a staking hook.
+func (k Keeper) TransferUnbonding(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, wantAmt sdk.Int,
+) (sdk.Int, error) {
+	transferred := sdk.ZeroInt()
+
+	if fromAddr.Equals(toAddr) || !wantAmt.IsPositive() {
+		return transferred, nil
+	}
+
+	ubdFrom, found := k.GetUnbondingDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		return transferred, nil
+	}
+
+	modified := false
+	for i := 0; i < len(ubdFrom.Entries) && wantAmt.IsPositive(); i++ {
+		if k.HasMaxUnbondingDelegationEntries(ctx, toAddr, valAddr) {
+			break
+		}
+
+		entry := ubdFrom.Entries[i]
+		amt := sdk.MinInt(entry.Balance, wantAmt)
+		if !amt.IsPositive() || entry.OnHold() {
+			continue
+		}
+
+		ubdTo, err := k.SetUnbondingDelegationEntry(ctx, toAddr, valAddr, entry.CreationHeight, entry.CompletionTime, amt)
+		if err != nil {
+			return sdk.ZeroInt(), err
+		}
+
+		k.InsertUBDQueue(ctx, ubdTo, entry.CompletionTime)
+
+		if amt.Equal(entry.Balance) {
+			ubdFrom.RemoveEntry(int64(i))
+			k.DeleteUnbondingIndex(ctx, entry.UnbondingId)
+			i--
+		} else {
+			entry.Balance = entry.Balance.Sub(amt)
+			entry.InitialBalance = entry.InitialBalance.Sub(amt)
+			ubdFrom.Entries[i] = entry
+		}
+
+		transferred = transferred.Add(amt)
+		wantAmt = wantAmt.Sub(amt)
+		modified = true
+	}
+
+	if modified {
+		// set the unbonding delegation or remove it if there are no more entries
+		if len(ubdFrom.Entries) == 0 {
+			k.RemoveUnbondingDelegation(ctx, ubdFrom)
+		} else {
+			k.SetUnbondingDelegation(ctx, ubdFrom)
+		}
+	}
+
+	return transferred, nil
+}
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -107,7 +108,7 @@ func TestDelegation(t *testing.T) {
 	}
 
 	// delete a record
-	app.StakingKeeper.RemoveDelegation(ctx, bond2to3)
+	require.NoError(t, app.StakingKeeper.RemoveDelegation(ctx, bond2to3))
 	_, found = app.StakingKeeper.GetDelegation(ctx, addrDels[1], valAddrs[2])
 	require.False(t, found)
 	resBonds = app.StakingKeeper.GetDelegatorDelegations(ctx, addrDels[1], 5)
@@ -119,8 +120,8 @@ func TestDelegation(t *testing.T) {
 	require.Equal(t, 2, len(resBonds))
 
 	// delete all the records from delegator 2
-	app.StakingKeeper.RemoveDelegation(ctx, bond2to1)
-	app.StakingKeeper.RemoveDelegation(ctx, bond2to2)
+	require.NoError(t, app.StakingKeeper.RemoveDelegation(ctx, bond2to1))
+	require.NoError(t, app.StakingKeeper.RemoveDelegation(ctx, bond2to2))
 	_, found = app.StakingKeeper.GetDelegation(ctx, addrDels[1], valAddrs[0])
 	require.False(t, found)
 	_, found = app.StakingKeeper.GetDelegation(ctx, addrDels[1], valAddrs[1])
@@ -182,7 +183,7 @@ func TestUnbondDelegation(t *testing.T) {
 	delAddrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(10000))
 	valAddrs := simapp.ConvertAddrsToValAddrs(delAddrs)
 
-	startTokens := sdk.TokensFromConsensusPower(10)
+	startTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
 	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
 
 	require.NoError(t,
@@ -206,7 +207,7 @@ func TestUnbondDelegation(t *testing.T) {
 	delegation := types.NewDelegation(delAddrs[0], valAddrs[0], issuedShares)
 	app.StakingKeeper.SetDelegation(ctx, delegation)
 
-	bondTokens := sdk.TokensFromConsensusPower(6)
+	bondTokens := sdk.TokensFromConsensusPower(6, sdk.DefaultPowerReduction)
 	amount, err := app.StakingKeeper.Unbond(ctx, delAddrs[0], valAddrs[0], bondTokens.ToDec())
 	require.NoError(t, err)
 	require.Equal(t, bondTokens, amount) // shares to be added to an unbonding delegation
@@ -227,7 +228,7 @@ func TestUnbondingDelegationsMaxEntries(t *testing.T) {
 	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(10000))
 	addrVals := simapp.ConvertAddrsToValAddrs(addrDels)
 
-	startTokens := sdk.TokensFromConsensusPower(10)
+	startTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
 
 	bondDenom := app.StakingKeeper.BondDenom(ctx)
 	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
@@ -254,10 +255,11 @@ func TestUnbondingDelegationsMaxEntries(t *testing.T) {
 	oldBonded := app.BankKeeper.GetBalance(ctx, app.StakingKeeper.GetBondedPool(ctx).GetAddress(), bondDenom).Amount
 	oldNotBonded := app.BankKeeper.GetBalance(ctx, app.StakingKeeper.GetNotBondedPool(ctx).GetAddress(), bondDenom).Amount
 
-	// should all pass
+	// should all pass, at different heights so that the entries are not merged
 	var completionTime time.Time
 	for i := uint32(0); i < maxEntries; i++ {
 		var err error
+		ctx = ctx.WithBlockHeight(int64(i))
 		completionTime, err = app.StakingKeeper.Undelegate(ctx, addrDels[0], addrVals[0], sdk.NewDec(1))
 		require.NoError(t, err)
 	}
@@ -309,7 +311,7 @@ func TestUndelegateSelfDelegationBelowMinSelfDelegation(t *testing.T) {
 
 	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(10000))
 	addrVals := simapp.ConvertAddrsToValAddrs(addrDels)
-	delTokens := sdk.TokensFromConsensusPower(10)
+	delTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
 	delCoins := sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), delTokens))
 
 	//create a validator with a self-delegation
@@ -357,7 +359,7 @@ func TestUndelegateSelfDelegationBelowMinSelfDelegation(t *testing.T) {
 	app.StakingKeeper.SetDelegation(ctx, delegation)
 
 	val0AccAddr := sdk.AccAddress(addrVals[0].Bytes())
-	_, err = app.StakingKeeper.Undelegate(ctx, val0AccAddr, addrVals[0], sdk.TokensFromConsensusPower(6).ToDec())
+	_, err = app.StakingKeeper.Undelegate(ctx, val0AccAddr, addrVals[0], sdk.TokensFromConsensusPower(6, sdk.DefaultPowerReduction).ToDec())
 	require.NoError(t, err)
 
 	// end block
@@ -366,14 +368,14 @@ func TestUndelegateSelfDelegationBelowMinSelfDelegation(t *testing.T) {
 
 	validator, found := app.StakingKeeper.GetValidator(ctx, addrVals[0])
 	require.True(t, found)
-	require.Equal(t, sdk.TokensFromConsensusPower(14), validator.Tokens)
+	require.Equal(t, sdk.TokensFromConsensusPower(14, sdk.DefaultPowerReduction), validator.Tokens)
 	require.Equal(t, sdk.Unbonding, validator.Status)
 	require.True(t, validator.Jailed)
 }
 
 func TestUndelegateFromUnbondingValidator(t *testing.T) {
 	_, app, ctx := createTestInput()
-	delTokens := sdk.TokensFromConsensusPower(10)
+	delTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
 	delCoins := sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), delTokens))
 
 	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(0))
@@ -467,7 +469,7 @@ func TestUndelegateFromUnbondingValidator(t *testing.T) {
 
 func TestUndelegateFromUnbondedValidator(t *testing.T) {
 	_, app, ctx := createTestInput()
-	delTokens := sdk.TokensFromConsensusPower(10)
+	delTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
 	delCoins := sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), delTokens))
 
 	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(0))
@@ -484,7 +486,7 @@ func TestUndelegateFromUnbondedValidator(t *testing.T) {
 	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
 	app.StakingKeeper.SetValidatorByConsAddr(ctx, validator)
 
-	valTokens := sdk.TokensFromConsensusPower(10)
+	valTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
 	validator, issuedShares := validator.AddTokensFromDel(valTokens)
 	require.Equal(t, valTokens, issuedShares.RoundInt())
 	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
@@ -536,7 +538,7 @@ func TestUndelegateFromUnbondedValidator(t *testing.T) {
 	require.Equal(t, validator.Status, sdk.Unbonded)
 
 	// unbond some of the other delegation's shares
-	unbondTokens := sdk.TokensFromConsensusPower(6)
+	unbondTokens := sdk.TokensFromConsensusPower(6, sdk.DefaultPowerReduction)
 	_, err = app.StakingKeeper.Undelegate(ctx, addrDels[1], addrVals[0], unbondTokens.ToDec())
 	require.NoError(t, err)
 
@@ -552,7 +554,7 @@ func TestUndelegateFromUnbondedValidator(t *testing.T) {
 
 func TestUnbondingAllDelegationFromValidator(t *testing.T) {
 	_, app, ctx := createTestInput()
-	delTokens := sdk.TokensFromConsensusPower(10)
+	delTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
 	delCoins := sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), delTokens))
 
 	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(0))
@@ -569,7 +571,7 @@ func TestUnbondingAllDelegationFromValidator(t *testing.T) {
 	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
 	app.StakingKeeper.SetValidatorByConsAddr(ctx, validator)
 
-	valTokens := sdk.TokensFromConsensusPower(10)
+	valTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
 	validator, issuedShares := validator.AddTokensFromDel(valTokens)
 	require.Equal(t, valTokens, issuedShares.RoundInt())
 
@@ -723,7 +725,7 @@ func TestRedelegateToSameValidator(t *testing.T) {
 	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(0))
 	addrVals := simapp.ConvertAddrsToValAddrs(addrDels)
 
-	valTokens := sdk.TokensFromConsensusPower(10)
+	valTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
 	startCoins := sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), valTokens))
 
 	// add bonded tokens to pool for delegations
@@ -754,7 +756,7 @@ func TestRedelegationMaxEntries(t *testing.T) {
 	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(0))
 	addrVals := simapp.ConvertAddrsToValAddrs(addrDels)
 
-	startTokens := sdk.TokensFromConsensusPower(20)
+	startTokens := sdk.TokensFromConsensusPower(20, sdk.DefaultPowerReduction)
 	startCoins := sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), startTokens))
 
 	// add bonded tokens to pool for delegations
@@ -766,7 +768,7 @@ func TestRedelegationMaxEntries(t *testing.T) {
 
 	// create a validator with a self-delegation
 	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
-	valTokens := sdk.TokensFromConsensusPower(10)
+	valTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
 	validator, issuedShares := validator.AddTokensFromDel(valTokens)
 	require.Equal(t, valTokens, issuedShares.RoundInt())
 	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
@@ -782,12 +784,13 @@ func TestRedelegationMaxEntries(t *testing.T) {
 	validator2 = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator2, true)
 	require.Equal(t, sdk.Bonded, validator2.Status)
 
-	maxEntries := app.StakingKeeper.MaxEntries(ctx)
+	maxEntries := app.StakingKeeper.MaxRedelegationEntries(ctx)
 
-	// redelegations should pass
+	// redelegations should pass, at different heights so that the entries are not merged
 	var completionTime time.Time
 	for i := uint32(0); i < maxEntries; i++ {
 		var err error
+		ctx = ctx.WithBlockHeight(int64(i))
 		completionTime, err = app.StakingKeeper.BeginRedelegation(ctx, val0AccAddr, addrVals[0], addrVals[1], sdk.NewDec(1))
 		require.NoError(t, err)
 	}
@@ -812,7 +815,7 @@ func TestRedelegateSelfDelegation(t *testing.T) {
 	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(0))
 	addrVals := simapp.ConvertAddrsToValAddrs(addrDels)
 
-	startTokens := sdk.TokensFromConsensusPower(30)
+	startTokens := sdk.TokensFromConsensusPower(30, sdk.DefaultPowerReduction)
 	startCoins := sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), startTokens))
 
 	// add bonded tokens to pool for delegations
@@ -826,7 +829,7 @@ func TestRedelegateSelfDelegation(t *testing.T) {
 	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
 	app.StakingKeeper.SetValidatorByConsAddr(ctx, validator)
 
-	valTokens := sdk.TokensFromConsensusPower(10)
+	valTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
 	validator, issuedShares := validator.AddTokensFromDel(valTokens)
 	require.Equal(t, valTokens, issuedShares.RoundInt())
 
@@ -844,7 +847,7 @@ func TestRedelegateSelfDelegation(t *testing.T) {
 	require.Equal(t, sdk.Bonded, validator2.Status)
 
 	// create a second delegation to validator 1
-	delTokens := sdk.TokensFromConsensusPower(10)
+	delTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
 	validator, issuedShares = validator.AddTokensFromDel(delTokens)
 	require.Equal(t, delTokens, issuedShares.RoundInt())
 	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
@@ -871,7 +874,7 @@ func TestRedelegateFromUnbondingValidator(t *testing.T) {
 	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(0))
 	addrVals := simapp.ConvertAddrsToValAddrs(addrDels)
 
-	startTokens := sdk.TokensFromConsensusPower(30)
+	startTokens := sdk.TokensFromConsensusPower(30, sdk.DefaultPowerReduction)
 	startCoins := sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), startTokens))
 
 	// add bonded tokens to pool for delegations
@@ -885,7 +888,7 @@ func TestRedelegateFromUnbondingValidator(t *testing.T) {
 	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
 	app.StakingKeeper.SetValidatorByConsAddr(ctx, validator)
 
-	valTokens := sdk.TokensFromConsensusPower(10)
+	valTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
 	validator, issuedShares := validator.AddTokensFromDel(valTokens)
 	require.Equal(t, valTokens, issuedShares.RoundInt())
 	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
@@ -895,7 +898,7 @@ func TestRedelegateFromUnbondingValidator(t *testing.T) {
 
 	// create a second delegation to this validator
 	app.StakingKeeper.DeleteValidatorByPowerIndex(ctx, validator)
-	delTokens := sdk.TokensFromConsensusPower(10)
+	delTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
 	validator, issuedShares = validator.AddTokensFromDel(delTokens)
 	require.Equal(t, delTokens, issuedShares.RoundInt())
 	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
@@ -938,7 +941,7 @@ func TestRedelegateFromUnbondingValidator(t *testing.T) {
 	ctx = ctx.WithBlockHeader(header)
 
 	// unbond some of the other delegation's shares
-	redelegateTokens := sdk.TokensFromConsensusPower(6)
+	redelegateTokens := sdk.TokensFromConsensusPower(6, sdk.DefaultPowerReduction)
 	_, err = app.StakingKeeper.BeginRedelegation(ctx, addrDels[1], addrVals[0], addrVals[1], redelegateTokens.ToDec())
 	require.NoError(t, err)
 
@@ -956,7 +959,7 @@ func TestRedelegateFromUnbondedValidator(t *testing.T) {
 	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(0))
 	addrVals := simapp.ConvertAddrsToValAddrs(addrDels)
 
-	startTokens := sdk.TokensFromConsensusPower(30)
+	startTokens := sdk.TokensFromConsensusPower(30, sdk.DefaultPowerReduction)
 	startCoins := sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), startTokens))
 
 	// add bonded tokens to pool for delegations
@@ -970,7 +973,7 @@ func TestRedelegateFromUnbondedValidator(t *testing.T) {
 	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
 	app.StakingKeeper.SetValidatorByConsAddr(ctx, validator)
 
-	valTokens := sdk.TokensFromConsensusPower(10)
+	valTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
 	validator, issuedShares := validator.AddTokensFromDel(valTokens)
 	require.Equal(t, valTokens, issuedShares.RoundInt())
 	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
@@ -980,7 +983,7 @@ func TestRedelegateFromUnbondedValidator(t *testing.T) {
 
 	// create a second delegation to this validator
 	app.StakingKeeper.DeleteValidatorByPowerIndex(ctx, validator)
-	delTokens := sdk.TokensFromConsensusPower(10)
+	delTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
 	validator, issuedShares = validator.AddTokensFromDel(delTokens)
 	require.Equal(t, delTokens, issuedShares.RoundInt())
 	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
@@ -1015,7 +1018,7 @@ func TestRedelegateFromUnbondedValidator(t *testing.T) {
 	app.StakingKeeper.UnbondingToUnbonded(ctx, validator)
 
 	// redelegate some of the delegation's shares
-	redelegationTokens := sdk.TokensFromConsensusPower(6)
+	redelegationTokens := sdk.TokensFromConsensusPower(6, sdk.DefaultPowerReduction)
 	_, err = app.StakingKeeper.BeginRedelegation(ctx, addrDels[1], addrVals[0], addrVals[1], redelegationTokens.ToDec())
 	require.NoError(t, err)
 
@@ -1023,3 +1026,237 @@ func TestRedelegateFromUnbondedValidator(t *testing.T) {
 	red, found := app.StakingKeeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
 	require.False(t, found, "%v", red)
 }
+
+func TestTransferDelegation(t *testing.T) {
+	_, app, ctx := createTestInput()
+
+	delAddrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(delAddrs)
+
+	startTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
+
+	require.NoError(t,
+		app.BankKeeper.SetBalances(
+			ctx,
+			notBondedPool.GetAddress(),
+			sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), startTokens)),
+		),
+	)
+	app.AccountKeeper.SetModuleAccount(ctx, notBondedPool)
+
+	validator := types.NewValidator(valAddrs[0], PKs[0], types.Description{})
+	validator, issuedShares := validator.AddTokensFromDel(startTokens)
+	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
+
+	delegation := types.NewDelegation(delAddrs[0], valAddrs[0], issuedShares)
+	app.StakingKeeper.SetDelegation(ctx, delegation)
+
+	// transfer part of the delegation to a new delegator
+	wantShares := sdk.TokensFromConsensusPower(4, sdk.DefaultPowerReduction).ToDec()
+	transferred, err := app.StakingKeeper.TransferDelegation(ctx, delAddrs[0], delAddrs[1], valAddrs[0], wantShares)
+	require.NoError(t, err)
+	require.Equal(t, wantShares, transferred)
+
+	delFrom, found := app.StakingKeeper.GetDelegation(ctx, delAddrs[0], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, issuedShares.Sub(wantShares), delFrom.Shares)
+
+	delTo, found := app.StakingKeeper.GetDelegation(ctx, delAddrs[1], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, wantShares, delTo.Shares)
+
+	// the validator's tokens and shares are unchanged
+	resValidator, found := app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, validator.Tokens, resValidator.Tokens)
+	require.Equal(t, validator.DelegatorShares, resValidator.DelegatorShares)
+
+	// transferring more than the delegation holds is capped and removes it
+	transferred, err = app.StakingKeeper.TransferDelegation(ctx, delAddrs[0], delAddrs[1], valAddrs[0], issuedShares)
+	require.NoError(t, err)
+	require.Equal(t, issuedShares.Sub(wantShares), transferred)
+
+	_, found = app.StakingKeeper.GetDelegation(ctx, delAddrs[0], valAddrs[0])
+	require.False(t, found)
+
+	delTo, found = app.StakingKeeper.GetDelegation(ctx, delAddrs[1], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, issuedShares, delTo.Shares)
+
+	// nothing is transferred from a missing delegation
+	transferred, err = app.StakingKeeper.TransferDelegation(ctx, delAddrs[0], delAddrs[1], valAddrs[0], issuedShares)
+	require.NoError(t, err)
+	require.True(t, transferred.IsZero())
+}
+
+func TestTransferUnbonding(t *testing.T) {
+	_, app, ctx := createTestInput()
+
+	delAddrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(delAddrs)
+
+	completionTime := ctx.BlockHeader().Time.Add(time.Hour)
+	_, err := app.StakingKeeper.SetUnbondingDelegationEntry(ctx, delAddrs[0], valAddrs[0], 1, completionTime, sdk.NewInt(5))
+	require.NoError(t, err)
+	_, err = app.StakingKeeper.SetUnbondingDelegationEntry(ctx, delAddrs[0], valAddrs[0], 2, completionTime.Add(time.Hour), sdk.NewInt(10))
+	require.NoError(t, err)
+
+	// transfer the first entry and part of the second one
+	transferred, err := app.StakingKeeper.TransferUnbonding(ctx, delAddrs[0], delAddrs[1], valAddrs[0], sdk.NewInt(8))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(8), transferred)
+
+	ubdFrom, found := app.StakingKeeper.GetUnbondingDelegation(ctx, delAddrs[0], valAddrs[0])
+	require.True(t, found)
+	require.Len(t, ubdFrom.Entries, 1)
+	require.Equal(t, sdk.NewInt(7), ubdFrom.Entries[0].Balance)
+
+	ubdTo, found := app.StakingKeeper.GetUnbondingDelegation(ctx, delAddrs[1], valAddrs[0])
+	require.True(t, found)
+	require.Len(t, ubdTo.Entries, 2)
+	require.Equal(t, sdk.NewInt(5), ubdTo.Entries[0].Balance)
+	require.Equal(t, completionTime, ubdTo.Entries[0].CompletionTime)
+	require.Equal(t, sdk.NewInt(3), ubdTo.Entries[1].Balance)
+	require.Equal(t, int64(2), ubdTo.Entries[1].CreationHeight)
+
+	// the destination entries are queued for completion
+	require.Contains(t, app.StakingKeeper.GetUBDQueueTimeSlice(ctx, completionTime),
+		types.DVPair{DelegatorAddress: delAddrs[1], ValidatorAddress: valAddrs[0]})
+
+	// transferring more than remains is capped and removes the source
+	transferred, err = app.StakingKeeper.TransferUnbonding(ctx, delAddrs[0], delAddrs[1], valAddrs[0], sdk.NewInt(100))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(7), transferred)
+
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delAddrs[0], valAddrs[0])
+	require.False(t, found)
+}
+
+// vetoHooks rejects every new delegation and delegation removal.
+type vetoHooks struct {
+	types.MultiStakingHooks
+}
+
+var errVetoed = errors.New("vetoed")
+
+func (vetoHooks) BeforeDelegationCreated(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return errVetoed
+}
+
+func (vetoHooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return errVetoed
+}
+
+func TestHookErrorAbortsDelegation(t *testing.T) {
+	_, app, ctx := createTestInput()
+	app.StakingKeeper = *app.StakingKeeper.SetHooks(vetoHooks{})
+
+	delAddrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(delAddrs)
+
+	validator := types.NewValidator(valAddrs[0], PKs[0], types.Description{})
+	validator, issuedShares := validator.AddTokensFromDel(sdk.NewInt(100))
+	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
+
+	delegation := types.NewDelegation(delAddrs[0], valAddrs[0], issuedShares)
+	app.StakingKeeper.SetDelegation(ctx, delegation)
+
+	// a new delegation is rejected before any tokens are moved
+	initBalance := app.BankKeeper.GetAllBalances(ctx, delAddrs[1])
+	_, err := app.StakingKeeper.Delegate(ctx, delAddrs[1], sdk.NewInt(10), sdk.Unbonded, validator, true)
+	require.Equal(t, errVetoed, err)
+	require.Equal(t, initBalance, app.BankKeeper.GetAllBalances(ctx, delAddrs[1]))
+
+	_, found := app.StakingKeeper.GetDelegation(ctx, delAddrs[1], valAddrs[0])
+	require.False(t, found)
+
+	// the removal of a delegation is rejected
+	require.Equal(t, errVetoed, app.StakingKeeper.RemoveDelegation(ctx, delegation))
+
+	_, found = app.StakingKeeper.GetDelegation(ctx, delAddrs[0], valAddrs[0])
+	require.True(t, found)
+
+	_, err = app.StakingKeeper.Unbond(ctx, delAddrs[0], valAddrs[0], issuedShares)
+	require.Equal(t, errVetoed, err)
+}
+
+func TestHookErrorModeLog(t *testing.T) {
+	_, app, ctx := createTestInput()
+	app.StakingKeeper = *app.StakingKeeper.SetHooks(vetoHooks{}).SetHookErrorMode(types.HookErrorModeLog)
+
+	delAddrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(10000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(delAddrs)
+
+	validator := types.NewValidator(valAddrs[0], PKs[0], types.Description{})
+	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
+
+	// the errors of the hooks are logged and don't abort the delegation
+	_, err := app.StakingKeeper.Delegate(ctx, delAddrs[0], sdk.NewInt(10), sdk.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	_, found := app.StakingKeeper.GetDelegation(ctx, delAddrs[0], valAddrs[0])
+	require.True(t, found)
+}
+
+// storeHooks sets its key in a store before every new delegation, and then
+// returns its error, if any.
+type storeHooks struct {
+	types.MultiStakingHooks
+
+	storeKey sdk.StoreKey
+	key      []byte
+	err      error
+}
+
+func (h storeHooks) BeforeDelegationCreated(ctx sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	ctx.KVStore(h.storeKey).Set(h.key, []byte{1})
+	return h.err
+}
+
+func TestMultiStakingHooksDiscardFailingHooks(t *testing.T) {
+	_, app, ctx := createTestInput()
+	storeKey := app.GetKey(types.StoreKey)
+
+	failingKey, okKey := []byte("failing"), []byte("ok")
+	hooks := types.NewMultiStakingHooks(
+		storeHooks{storeKey: storeKey, key: failingKey, err: errVetoed},
+		storeHooks{storeKey: storeKey, key: okKey},
+	)
+
+	// the following hooks still run, and only the state changes of the hooks that
+	// succeeded are written
+	err := hooks.BeforeDelegationCreated(ctx, sdk.AccAddress{}, sdk.ValAddress{})
+	require.Equal(t, errVetoed, err)
+
+	store := ctx.KVStore(storeKey)
+	require.False(t, store.Has(failingKey))
+	require.True(t, store.Has(okKey))
+}
+
+// jailVetoHooks fails on every validator jailing.
+type jailVetoHooks struct {
+	types.MultiStakingHooks
+}
+
+func (jailVetoHooks) AfterValidatorJailed(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return errVetoed
+}
+
+func TestHookErrorLoggedOnJail(t *testing.T) {
+	_, app, ctx := createTestInput()
+	app.StakingKeeper = *app.StakingKeeper.SetHooks(jailVetoHooks{})
+
+	valAddrs := simapp.ConvertAddrsToValAddrs(simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(10000)))
+	validator := types.NewValidator(valAddrs[0], PKs[0], types.Description{})
+	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
+	app.StakingKeeper.SetValidatorByConsAddr(ctx, validator)
+
+	// jailing is driven by the block and can't be aborted, so the hook error is
+	// logged even when the errors of the hooks are propagated
+	require.NotPanics(t, func() { app.StakingKeeper.Jail(ctx, validator.GetConsAddr()) })
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	require.True(t, found)
+	require.True(t, validator.Jailed)
+}
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// IsEpochMode returns whether the validator set is updated less often than at
+// the end of every block, in which case the delegation messages are queued until
+// the end of the epoch.
+func (k Keeper) IsEpochMode(ctx sdk.Context) bool {
+	return k.EpochLength(ctx) > 1
+}
+
+// GetLastEpochMsgID returns the ID of the last queued epoch message.
+func (k Keeper) GetLastEpochMsgID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.LastEpochMsgIDKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastEpochMsgID sets the ID of the last queued epoch message.
+func (k Keeper) SetLastEpochMsgID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastEpochMsgIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// QueueEpochMsg queues a delegation message until the end of the epoch, after
+// the messages already queued.
+func (k Keeper) QueueEpochMsg(ctx sdk.Context, msg sdk.Msg) error {
+	var epochMsg types.EpochMsg
+	if err := epochMsg.SetMsg(msg); err != nil {
+		return err
+	}
+
+	id := k.GetLastEpochMsgID(ctx) + 1
+	k.SetLastEpochMsgID(ctx, id)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetEpochMsgKey(id), k.cdc.MustMarshalBinaryBare(&epochMsg))
+
+	return nil
+}
+
+// GetAllEpochMsgs returns the queued epoch messages, in the order in which they
+// were queued.
+func (k Keeper) GetAllEpochMsgs(ctx sdk.Context) (msgs []sdk.Msg) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.EpochMsgQueueKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var epochMsg types.EpochMsg
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &epochMsg)
+
+		msgs = append(msgs, epochMsg.GetMsg())
+	}
+
+	return msgs
+}
+
+// DequeueAllEpochMsgs returns the queued epoch messages, in the order in which
+// they were queued, and removes them from the queue.
+func (k Keeper) DequeueAllEpochMsgs(ctx sdk.Context) (msgs []sdk.Msg) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.EpochMsgQueueKey)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		var epochMsg types.EpochMsg
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &epochMsg)
+
+		msgs = append(msgs, epochMsg.GetMsg())
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	return msgs
+}
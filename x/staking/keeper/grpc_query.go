@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// DelegatorDelegations returns the paginated delegations of a delegator, along
+// with the total number of delegations of the delegator.
+func (k Keeper) DelegatorDelegations(
+	c context.Context, req *types.QueryDelegatorDelegationsRequest,
+) (*types.QueryDelegatorDelegationsResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+	if req.DelegatorAddress.Empty() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "empty delegator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	page, limit := pageAndLimit(int(req.Page), int(req.Limit))
+
+	delegations := k.GetPaginatedDelegatorDelegations(ctx, req.DelegatorAddress, page, limit)
+	total := countKeys(ctx.KVStore(k.storeKey), types.GetDelegationsKey(req.DelegatorAddress))
+
+	return &types.QueryDelegatorDelegationsResponse{Delegations: delegations, Total: uint64(total)}, nil
+}
+
+// DelegatorUnbondingDelegations returns the paginated unbonding delegations of a
+// delegator, along with the total number of unbonding delegations of the
+// delegator.
+func (k Keeper) DelegatorUnbondingDelegations(
+	c context.Context, req *types.QueryDelegatorUnbondingDelegationsRequest,
+) (*types.QueryDelegatorUnbondingDelegationsResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+	if req.DelegatorAddress.Empty() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "empty delegator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	page, limit := pageAndLimit(int(req.Page), int(req.Limit))
+
+	ubds := k.GetPaginatedDelegatorUnbondingDelegations(ctx, req.DelegatorAddress, page, limit)
+	total := countKeys(ctx.KVStore(k.storeKey), types.GetUBDsKey(req.DelegatorAddress))
+
+	return &types.QueryDelegatorUnbondingDelegationsResponse{UnbondingDelegations: ubds, Total: uint64(total)}, nil
+}
+
+// Redelegations returns the paginated redelegations of a delegator, or of all the
+// delegators if it is not set, along with their total number.
+func (k Keeper) Redelegations(
+	c context.Context, req *types.QueryRedelegationsRequest,
+) (*types.QueryRedelegationsResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	page, limit := pageAndLimit(int(req.Page), int(req.Limit))
+
+	redels := k.GetPaginatedRedelegations(ctx, req.DelegatorAddress, page, limit)
+	total := countKeys(ctx.KVStore(k.storeKey), types.GetREDsKey(req.DelegatorAddress))
+
+	return &types.QueryRedelegationsResponse{Redelegations: redels, Total: uint64(total)}, nil
+}
+
+// Validators returns the paginated validators with a bond status, along with the
+// total number of validators with that status.
+func (k Keeper) Validators(
+	c context.Context, req *types.QueryValidatorsRequest,
+) (*types.QueryValidatorsResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	switch req.Status {
+	case sdk.Unbonded, sdk.Unbonding, sdk.Bonded:
+	default:
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid validator status %d", req.Status)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	page, limit := int(req.Page), int(req.Limit)
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = int(k.MaxValidators(ctx))
+	}
+
+	validators := k.GetPaginatedValidatorsByStatus(ctx, req.Status, page, limit)
+	total := countKeys(ctx.KVStore(k.storeKey), types.GetValidatorsByStatusKey(req.Status))
+
+	return &types.QueryValidatorsResponse{Validators: validators, Total: uint64(total)}, nil
+}
+
+// ValidatorDelegations returns the paginated delegations to a validator, along
+// with the total number of delegations to the validator.
+func (k Keeper) ValidatorDelegations(
+	c context.Context, req *types.QueryValidatorDelegationsRequest,
+) (*types.QueryValidatorDelegationsResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+	if req.ValidatorAddress.Empty() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "empty validator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	page, limit := pageAndLimit(int(req.Page), int(req.Limit))
+
+	delegations := k.GetPaginatedValidatorDelegations(ctx, req.ValidatorAddress, page, limit)
+	total := countKeys(ctx.KVStore(k.storeKey), types.GetDelegationsByValIndexKey(req.ValidatorAddress))
+
+	return &types.QueryValidatorDelegationsResponse{Delegations: delegations, Total: uint64(total)}, nil
+}
@@ -0,0 +1,203 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestGRPCDelegatorQueries(t *testing.T) {
+	_, app, ctx := createTestInput()
+	c := sdk.WrapSDKContext(ctx)
+
+	addrs := simapp.AddTestAddrs(app, ctx, 3, sdk.TokensFromConsensusPower(10000, sdk.DefaultPowerReduction))
+	delAddr := addrs[0]
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs[1:])
+
+	var vals []types.Validator
+	for i, valAddr := range valAddrs {
+		val := types.NewValidator(valAddr, PKs[i], types.Description{})
+		app.StakingKeeper.SetValidator(ctx, val)
+		app.StakingKeeper.SetValidatorByPowerIndex(ctx, val)
+		vals = append(vals, val)
+	}
+
+	delAmount := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+	for _, val := range vals {
+		_, err := app.StakingKeeper.Delegate(ctx, delAddr, delAmount, sdk.Unbonded, val, true)
+		require.NoError(t, err)
+	}
+	app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	amount := sdk.TokensFromConsensusPower(20, sdk.DefaultPowerReduction)
+	_, err := app.StakingKeeper.Undelegate(ctx, delAddr, valAddrs[0], amount.ToDec())
+	require.NoError(t, err)
+	_, err = app.StakingKeeper.BeginRedelegation(ctx, delAddr, valAddrs[0], valAddrs[1], amount.ToDec())
+	require.NoError(t, err)
+
+	_, err = app.StakingKeeper.DelegatorDelegations(c, nil)
+	require.Error(t, err)
+	_, err = app.StakingKeeper.DelegatorDelegations(c, &types.QueryDelegatorDelegationsRequest{})
+	require.Error(t, err)
+
+	delegations, err := app.StakingKeeper.DelegatorDelegations(
+		c, &types.QueryDelegatorDelegationsRequest{DelegatorAddress: delAddr},
+	)
+	require.NoError(t, err)
+	require.Equal(t, app.StakingKeeper.GetAllDelegatorDelegations(ctx, delAddr), delegations.Delegations)
+	require.Equal(t, uint64(2), delegations.Total)
+
+	delegations, err = app.StakingKeeper.DelegatorDelegations(
+		c, &types.QueryDelegatorDelegationsRequest{DelegatorAddress: delAddr, Page: 2, Limit: 1},
+	)
+	require.NoError(t, err)
+	require.Len(t, delegations.Delegations, 1)
+	require.Equal(t, app.StakingKeeper.GetAllDelegatorDelegations(ctx, delAddr)[1], delegations.Delegations[0])
+	require.Equal(t, uint64(2), delegations.Total)
+
+	_, err = app.StakingKeeper.DelegatorUnbondingDelegations(c, &types.QueryDelegatorUnbondingDelegationsRequest{})
+	require.Error(t, err)
+
+	ubds, err := app.StakingKeeper.DelegatorUnbondingDelegations(
+		c, &types.QueryDelegatorUnbondingDelegationsRequest{DelegatorAddress: delAddr},
+	)
+	require.NoError(t, err)
+	require.Equal(t, app.StakingKeeper.GetAllUnbondingDelegations(ctx, delAddr), ubds.UnbondingDelegations)
+	require.Equal(t, uint64(1), ubds.Total)
+
+	ubds, err = app.StakingKeeper.DelegatorUnbondingDelegations(
+		c, &types.QueryDelegatorUnbondingDelegationsRequest{DelegatorAddress: delAddr, Page: 2, Limit: 1},
+	)
+	require.NoError(t, err)
+	require.Empty(t, ubds.UnbondingDelegations)
+	require.Equal(t, uint64(1), ubds.Total)
+
+	redel, found := app.StakingKeeper.GetRedelegation(ctx, delAddr, valAddrs[0], valAddrs[1])
+	require.True(t, found)
+
+	redels, err := app.StakingKeeper.Redelegations(c, &types.QueryRedelegationsRequest{DelegatorAddress: delAddr})
+	require.NoError(t, err)
+	require.Equal(t, []types.Redelegation{redel}, redels.Redelegations)
+	require.Equal(t, uint64(1), redels.Total)
+
+	// the redelegations of all the delegators are returned without a delegator
+	redels, err = app.StakingKeeper.Redelegations(c, &types.QueryRedelegationsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.Redelegation{redel}, redels.Redelegations)
+	require.Equal(t, uint64(1), redels.Total)
+
+	redels, err = app.StakingKeeper.Redelegations(c, &types.QueryRedelegationsRequest{DelegatorAddress: addrs[1]})
+	require.NoError(t, err)
+	require.Empty(t, redels.Redelegations)
+	require.Zero(t, redels.Total)
+}
+
+func TestGRPCValidatorQueries(t *testing.T) {
+	_, app, ctx := createTestInput()
+	c := sdk.WrapSDKContext(ctx)
+
+	addrs := simapp.AddTestAddrs(app, ctx, 3, sdk.TokensFromConsensusPower(10000, sdk.DefaultPowerReduction))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+
+	for i, valAddr := range valAddrs {
+		val := types.NewValidator(valAddr, PKs[i], types.Description{})
+		app.StakingKeeper.SetValidator(ctx, val)
+		app.StakingKeeper.SetValidatorByPowerIndex(ctx, val)
+	}
+
+	// the first two validators are bonded and delegated to by all the accounts
+	delAmount := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+	for _, valAddr := range valAddrs[:2] {
+		for _, delAddr := range addrs {
+			val, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+			require.True(t, found)
+			_, err := app.StakingKeeper.Delegate(ctx, delAddr, delAmount, sdk.Unbonded, val, true)
+			require.NoError(t, err)
+		}
+	}
+	app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	countByStatus := func(status sdk.BondStatus) (count uint64) {
+		for _, val := range app.StakingKeeper.GetAllValidators(ctx) {
+			if val.Status == status {
+				count++
+			}
+		}
+		return count
+	}
+
+	_, err := app.StakingKeeper.Validators(c, nil)
+	require.Error(t, err)
+	_, err = app.StakingKeeper.Validators(c, &types.QueryValidatorsRequest{})
+	require.Error(t, err)
+
+	for _, status := range []sdk.BondStatus{sdk.Unbonded, sdk.Unbonding, sdk.Bonded} {
+		validators, err := app.StakingKeeper.Validators(c, &types.QueryValidatorsRequest{Status: status})
+		require.NoError(t, err)
+		require.Equal(t, countByStatus(status), validators.Total)
+		require.Len(t, validators.Validators, int(validators.Total))
+
+		for _, val := range validators.Validators {
+			require.Equal(t, status, val.Status)
+		}
+	}
+
+	validators, err := app.StakingKeeper.Validators(c, &types.QueryValidatorsRequest{Status: sdk.Bonded, Page: 2, Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, validators.Validators, 1)
+	require.Equal(t, countByStatus(sdk.Bonded), validators.Total)
+
+	_, err = app.StakingKeeper.ValidatorDelegations(c, &types.QueryValidatorDelegationsRequest{})
+	require.Error(t, err)
+
+	delegations, err := app.StakingKeeper.ValidatorDelegations(
+		c, &types.QueryValidatorDelegationsRequest{ValidatorAddress: valAddrs[0]},
+	)
+	require.NoError(t, err)
+	require.Equal(t, app.StakingKeeper.GetValidatorDelegations(ctx, valAddrs[0]), delegations.Delegations)
+	require.Equal(t, uint64(3), delegations.Total)
+
+	delegations, err = app.StakingKeeper.ValidatorDelegations(
+		c, &types.QueryValidatorDelegationsRequest{ValidatorAddress: valAddrs[0], Page: 2, Limit: 2},
+	)
+	require.NoError(t, err)
+	require.Len(t, delegations.Delegations, 1)
+	require.Equal(t, uint64(3), delegations.Total)
+
+	delegations, err = app.StakingKeeper.ValidatorDelegations(
+		c, &types.QueryValidatorDelegationsRequest{ValidatorAddress: valAddrs[2]},
+	)
+	require.NoError(t, err)
+	require.Empty(t, delegations.Delegations)
+	require.Zero(t, delegations.Total)
+}
+
+func TestGRPCQueryRoutes(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	// the service is registered with the app's router under the method names
+	handler := app.GRPCQueryRouter().Route(types.QueryRedelegationsMethod)
+	require.NotNil(t, handler)
+
+	reqBz, err := (&types.QueryRedelegationsRequest{}).Marshal()
+	require.NoError(t, err)
+
+	res, err := handler(ctx, abci.RequestQuery{Path: types.QueryRedelegationsMethod, Data: reqBz})
+	require.NoError(t, err)
+
+	var redels types.QueryRedelegationsResponse
+	require.NoError(t, redels.Unmarshal(res.Value))
+	require.Empty(t, redels.Redelegations)
+	require.Zero(t, redels.Total)
+
+	require.NotNil(t, app.GRPCQueryRouter().Route(types.QueryDelegatorDelegationsMethod))
+	require.NotNil(t, app.GRPCQueryRouter().Route(types.QueryDelegatorUnbondingDelegationsMethod))
+	require.NotNil(t, app.GRPCQueryRouter().Route(types.QueryValidatorsMethod))
+	require.NotNil(t, app.GRPCQueryRouter().Route(types.QueryValidatorDelegationsMethod))
+}
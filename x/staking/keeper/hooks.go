@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -8,72 +10,109 @@ import (
 // Implements StakingHooks interface
 var _ types.StakingHooks = Keeper{}
 
-// AfterValidatorCreated - call hook if registered
-func (k Keeper) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) {
-	if k.hooks != nil {
-		k.hooks.AfterValidatorCreated(ctx, valAddr)
+// callHook calls the registered hook in a cache context, whose state changes and
+// events are only written if the hook succeeds, and handles its error according to the hook error mode.
+func (k Keeper) callHook(ctx sdk.Context, name string, hook func(ctx sdk.Context) error) error {
+	if k.hooks == nil {
+		return nil
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if err := hook(cacheCtx); err != nil {
+		if k.hookErrorMode == types.HookErrorModeLog {
+			k.Logger(ctx).Error(fmt.Sprintf("%s hook failed: %s", name, err))
+			return nil
+		}
+
+		return err
 	}
+
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return nil
+}
+
+// AfterValidatorCreated - call hook if registered
+func (k Keeper) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) error {
+	return k.callHook(ctx, "AfterValidatorCreated", func(ctx sdk.Context) error {
+		return k.hooks.AfterValidatorCreated(ctx, valAddr)
+	})
 }
 
 // BeforeValidatorModified - call hook if registered
-func (k Keeper) BeforeValidatorModified(ctx sdk.Context, valAddr sdk.ValAddress) {
-	if k.hooks != nil {
-		k.hooks.BeforeValidatorModified(ctx, valAddr)
-	}
+func (k Keeper) BeforeValidatorModified(ctx sdk.Context, valAddr sdk.ValAddress) error {
+	return k.callHook(ctx, "BeforeValidatorModified", func(ctx sdk.Context) error {
+		return k.hooks.BeforeValidatorModified(ctx, valAddr)
+	})
 }
 
 // AfterValidatorRemoved - call hook if registered
-func (k Keeper) AfterValidatorRemoved(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
-	if k.hooks != nil {
-		k.hooks.AfterValidatorRemoved(ctx, consAddr, valAddr)
-	}
+func (k Keeper) AfterValidatorRemoved(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	return k.callHook(ctx, "AfterValidatorRemoved", func(ctx sdk.Context) error {
+		return k.hooks.AfterValidatorRemoved(ctx, consAddr, valAddr)
+	})
 }
 
 // AfterValidatorBonded - call hook if registered
-func (k Keeper) AfterValidatorBonded(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
-	if k.hooks != nil {
-		k.hooks.AfterValidatorBonded(ctx, consAddr, valAddr)
-	}
+func (k Keeper) AfterValidatorBonded(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	return k.callHook(ctx, "AfterValidatorBonded", func(ctx sdk.Context) error {
+		return k.hooks.AfterValidatorBonded(ctx, consAddr, valAddr)
+	})
 }
 
 // AfterValidatorBeginUnbonding - call hook if registered
-func (k Keeper) AfterValidatorBeginUnbonding(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
-	if k.hooks != nil {
-		k.hooks.AfterValidatorBeginUnbonding(ctx, consAddr, valAddr)
-	}
+func (k Keeper) AfterValidatorBeginUnbonding(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	return k.callHook(ctx, "AfterValidatorBeginUnbonding", func(ctx sdk.Context) error {
+		return k.hooks.AfterValidatorBeginUnbonding(ctx, consAddr, valAddr)
+	})
+}
+
+// AfterValidatorJailed - call hook if registered
+func (k Keeper) AfterValidatorJailed(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	return k.callHook(ctx, "AfterValidatorJailed", func(ctx sdk.Context) error {
+		return k.hooks.AfterValidatorJailed(ctx, consAddr, valAddr)
+	})
 }
 
 // BeforeDelegationCreated - call hook if registered
-func (k Keeper) BeforeDelegationCreated(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
-	if k.hooks != nil {
-		k.hooks.BeforeDelegationCreated(ctx, delAddr, valAddr)
-	}
+func (k Keeper) BeforeDelegationCreated(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return k.callHook(ctx, "BeforeDelegationCreated", func(ctx sdk.Context) error {
+		return k.hooks.BeforeDelegationCreated(ctx, delAddr, valAddr)
+	})
 }
 
 // BeforeDelegationSharesModified - call hook if registered
-func (k Keeper) BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
-	if k.hooks != nil {
-		k.hooks.BeforeDelegationSharesModified(ctx, delAddr, valAddr)
-	}
+func (k Keeper) BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return k.callHook(ctx, "BeforeDelegationSharesModified", func(ctx sdk.Context) error {
+		return k.hooks.BeforeDelegationSharesModified(ctx, delAddr, valAddr)
+	})
 }
 
 // BeforeDelegationRemoved - call hook if registered
-func (k Keeper) BeforeDelegationRemoved(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
-	if k.hooks != nil {
-		k.hooks.BeforeDelegationRemoved(ctx, delAddr, valAddr)
-	}
+func (k Keeper) BeforeDelegationRemoved(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return k.callHook(ctx, "BeforeDelegationRemoved", func(ctx sdk.Context) error {
+		return k.hooks.BeforeDelegationRemoved(ctx, delAddr, valAddr)
+	})
 }
 
 // AfterDelegationModified - call hook if registered
-func (k Keeper) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
-	if k.hooks != nil {
-		k.hooks.AfterDelegationModified(ctx, delAddr, valAddr)
-	}
+func (k Keeper) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return k.callHook(ctx, "AfterDelegationModified", func(ctx sdk.Context) error {
+		return k.hooks.AfterDelegationModified(ctx, delAddr, valAddr)
+	})
 }
 
 // BeforeValidatorSlashed - call hook if registered
-func (k Keeper) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) {
-	if k.hooks != nil {
-		k.hooks.BeforeValidatorSlashed(ctx, valAddr, fraction)
-	}
+func (k Keeper) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) error {
+	return k.callHook(ctx, "BeforeValidatorSlashed", func(ctx sdk.Context) error {
+		return k.hooks.BeforeValidatorSlashed(ctx, valAddr, fraction)
+	})
+}
+
+// AfterUnbondingInitiated - call hook if registered
+func (k Keeper) AfterUnbondingInitiated(ctx sdk.Context, id uint64) error {
+	return k.callHook(ctx, "AfterUnbondingInitiated", func(ctx sdk.Context) error {
+		return k.hooks.AfterUnbondingInitiated(ctx, id)
+	})
 }
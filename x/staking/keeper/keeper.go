@@ -27,15 +27,19 @@ type Keeper struct {
 	authKeeper         types.AccountKeeper
 	bankKeeper         types.BankKeeper
 	hooks              types.StakingHooks
+	hookErrorMode      types.HookErrorMode
 	paramstore         paramtypes.Subspace
+	powerReduction     sdk.Int
 	validatorCache     map[string]cachedValidator
 	validatorCacheList *list.List
 }
 
-// NewKeeper creates a new staking Keeper instance
+// NewKeeper creates a new staking Keeper instance. The powerReduction is the
+// amount of staking tokens required for 1 unit of consensus-engine power, which
+// is usually sdk.DefaultPowerReduction.
 func NewKeeper(
 	cdc codec.Marshaler, key sdk.StoreKey, ak types.AccountKeeper, bk types.BankKeeper,
-	ps paramtypes.Subspace,
+	ps paramtypes.Subspace, powerReduction sdk.Int,
 ) Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -51,12 +55,17 @@ func NewKeeper(
 		panic(fmt.Sprintf("%s module account has not been set", types.NotBondedPoolName))
 	}
 
+	if !powerReduction.IsPositive() {
+		panic(fmt.Sprintf("power reduction must be positive: %s", powerReduction))
+	}
+
 	return Keeper{
 		storeKey:           key,
 		cdc:                cdc,
 		authKeeper:         ak,
 		bankKeeper:         bk,
 		paramstore:         ps,
+		powerReduction:     powerReduction,
 		hooks:              nil,
 		validatorCache:     make(map[string]cachedValidator, aminoCacheSize),
 		validatorCacheList: list.New(),
@@ -79,6 +88,23 @@ func (k *Keeper) SetHooks(sh types.StakingHooks) *Keeper {
 	return k
 }
 
+// SetHookErrorMode sets how the errors returned by the validator hooks are
+// handled, which defaults to types.HookErrorModePropagate.
+func (k *Keeper) SetHookErrorMode(mode types.HookErrorMode) *Keeper {
+	k.hookErrorMode = mode
+
+	return k
+}
+
+// withHookErrorsLogged returns a copy of the keeper logging the errors returned
+// by the hooks instead of returning them, for the block-driven paths, such as
+// slashing, which can't be aborted.
+func (k Keeper) withHookErrorsLogged() Keeper {
+	k.hookErrorMode = types.HookErrorModeLog
+
+	return k
+}
+
 // Load the last total validator power.
 func (k Keeper) GetLastTotalPower(ctx sdk.Context) sdk.Int {
 	store := ctx.KVStore(k.storeKey)
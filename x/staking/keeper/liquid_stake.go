@@ -0,0 +1,354 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetLastTokenizeShareRecordID returns the ID of the last tokenize share record.
+func (k Keeper) GetLastTokenizeShareRecordID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.LastTokenizeShareRecordIDKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastTokenizeShareRecordID sets the ID of the last tokenize share record.
+func (k Keeper) SetLastTokenizeShareRecordID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastTokenizeShareRecordIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetTokenizeShareRecord returns the tokenize share record with the given ID.
+func (k Keeper) GetTokenizeShareRecord(ctx sdk.Context, id uint64) (record types.TokenizeShareRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetTokenizeShareRecordByIndexKey(id))
+	if bz == nil {
+		return record, false
+	}
+
+	return types.MustUnmarshalTokenizeShareRecord(k.cdc, bz), true
+}
+
+// GetTokenizeShareRecordByDenom returns the tokenize share record backing the
+// given share denom.
+func (k Keeper) GetTokenizeShareRecordByDenom(ctx sdk.Context, denom string) (record types.TokenizeShareRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetTokenizeShareRecordIDByDenomKey(denom))
+	if bz == nil {
+		return record, false
+	}
+
+	return k.GetTokenizeShareRecord(ctx, sdk.BigEndianToUint64(bz))
+}
+
+// GetTokenizeShareRecordsByOwner returns all the tokenize share records of an
+// owner.
+func (k Keeper) GetTokenizeShareRecordsByOwner(ctx sdk.Context, owner sdk.AccAddress) (records []types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetTokenizeShareRecordIDsByOwnerPrefix(owner)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		id := sdk.BigEndianToUint64(iterator.Key()[len(prefix):])
+		if record, found := k.GetTokenizeShareRecord(ctx, id); found {
+			records = append(records, record)
+		}
+	}
+
+	return records
+}
+
+// GetAllTokenizeShareRecords returns all the tokenize share records.
+func (k Keeper) GetAllTokenizeShareRecords(ctx sdk.Context) (records []types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.TokenizeShareRecordPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		records = append(records, types.MustUnmarshalTokenizeShareRecord(k.cdc, iterator.Value()))
+	}
+
+	return records
+}
+
+// SetTokenizeShareRecord sets a tokenize share record and its owner and denom
+// indexes.
+func (k Keeper) SetTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTokenizeShareRecordByIndexKey(record.Id), types.MustMarshalTokenizeShareRecord(k.cdc, record))
+	store.Set(types.GetTokenizeShareRecordIDByOwnerAndIDKey(record.Owner, record.Id), []byte{})
+	store.Set(types.GetTokenizeShareRecordIDByDenomKey(record.GetShareTokenDenom()), sdk.Uint64ToBigEndian(record.Id))
+}
+
+// DeleteTokenizeShareRecord removes a tokenize share record and its indexes.
+func (k Keeper) DeleteTokenizeShareRecord(ctx sdk.Context, id uint64) error {
+	record, found := k.GetTokenizeShareRecord(ctx, id)
+	if !found {
+		return types.ErrTokenizeShareRecordNotExists
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTokenizeShareRecordByIndexKey(record.Id))
+	store.Delete(types.GetTokenizeShareRecordIDByOwnerAndIDKey(record.Owner, record.Id))
+	store.Delete(types.GetTokenizeShareRecordIDByDenomKey(record.GetShareTokenDenom()))
+
+	return nil
+}
+
+// GetTotalLiquidStakedTokens returns the amount of tokens backing share tokens.
+func (k Keeper) GetTotalLiquidStakedTokens(ctx sdk.Context) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.TotalLiquidStakedTokensKey)
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	ip := sdk.IntProto{}
+	k.cdc.MustUnmarshalBinaryBare(bz, &ip)
+
+	return ip.Int
+}
+
+// SetTotalLiquidStakedTokens sets the amount of tokens backing share tokens.
+func (k Keeper) SetTotalLiquidStakedTokens(ctx sdk.Context, tokens sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&sdk.IntProto{Int: tokens})
+	store.Set(types.TotalLiquidStakedTokensKey, bz)
+}
+
+// GetValidatorLiquidShares returns the delegator shares of a validator backing
+// share tokens.
+func (k Keeper) GetValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetValidatorLiquidSharesKey(valAddr))
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	dp := sdk.DecProto{}
+	k.cdc.MustUnmarshalBinaryBare(bz, &dp)
+
+	return dp.Dec
+}
+
+// SetValidatorLiquidShares sets the delegator shares of a validator backing
+// share tokens, removing the entry once no shares are left.
+func (k Keeper) SetValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress, shares sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+
+	if !shares.IsPositive() {
+		store.Delete(types.GetValidatorLiquidSharesKey(valAddr))
+		return
+	}
+
+	bz := k.cdc.MustMarshalBinaryBare(&sdk.DecProto{Dec: shares})
+	store.Set(types.GetValidatorLiquidSharesKey(valAddr), bz)
+}
+
+// increaseLiquidStake accounts for newly tokenized shares of a validator.
+func (k Keeper) increaseLiquidStake(ctx sdk.Context, validator types.Validator, shares sdk.Dec) {
+	tokens := validator.TokensFromShares(shares).TruncateInt()
+
+	k.SetTotalLiquidStakedTokens(ctx, k.GetTotalLiquidStakedTokens(ctx).Add(tokens))
+	k.SetValidatorLiquidShares(ctx, validator.OperatorAddress, k.GetValidatorLiquidShares(ctx, validator.OperatorAddress).Add(shares))
+}
+
+// decreaseLiquidStake accounts for redeemed shares of a validator. The tokens
+// are rounded at the current exchange rate rather than the one the shares were
+// tokenized at, hence the totals are floored at zero.
+func (k Keeper) decreaseLiquidStake(ctx sdk.Context, validator types.Validator, shares sdk.Dec) {
+	tokens := validator.TokensFromShares(shares).TruncateInt()
+
+	totalTokens := k.GetTotalLiquidStakedTokens(ctx)
+	k.SetTotalLiquidStakedTokens(ctx, totalTokens.Sub(sdk.MinInt(tokens, totalTokens)))
+
+	validatorShares := k.GetValidatorLiquidShares(ctx, validator.OperatorAddress)
+	k.SetValidatorLiquidShares(ctx, validator.OperatorAddress, validatorShares.Sub(sdk.MinDec(shares, validatorShares)))
+}
+
+// slashLiquidStake accounts for the tokens slashed from the liquid staked
+// shares of a validator, given the validator before and after the slash.
+func (k Keeper) slashLiquidStake(ctx sdk.Context, validator, slashedValidator types.Validator) {
+	liquidShares := k.GetValidatorLiquidShares(ctx, validator.OperatorAddress)
+	if !liquidShares.IsPositive() {
+		return
+	}
+
+	slashedTokens := validator.TokensFromShares(liquidShares).TruncateInt().
+		Sub(slashedValidator.TokensFromShares(liquidShares).TruncateInt())
+
+	totalTokens := k.GetTotalLiquidStakedTokens(ctx)
+	k.SetTotalLiquidStakedTokens(ctx, totalTokens.Sub(sdk.MinInt(slashedTokens, totalTokens)))
+}
+
+// exceedsGlobalLiquidStakingCap returns true if tokenizing the given amount of
+// tokens would take the liquid staked share of the total bonded tokens above
+// the global liquid staking cap.
+func (k Keeper) exceedsGlobalLiquidStakingCap(ctx sdk.Context, tokens sdk.Int) bool {
+	liquidStakingCap := k.GlobalLiquidStakingCap(ctx)
+	if liquidStakingCap.GTE(sdk.OneDec()) {
+		return false
+	}
+
+	totalBonded := k.TotalBondedTokens(ctx)
+	if totalBonded.IsZero() {
+		return true
+	}
+
+	liquidStaked := k.GetTotalLiquidStakedTokens(ctx).Add(tokens)
+	return liquidStaked.ToDec().Quo(totalBonded.ToDec()).GT(liquidStakingCap)
+}
+
+// exceedsValidatorLiquidStakingCap returns true if tokenizing the given amount
+// of shares would take the liquid staked share of the validator's delegator
+// shares above the validator liquid staking cap.
+func (k Keeper) exceedsValidatorLiquidStakingCap(ctx sdk.Context, validator types.Validator, shares sdk.Dec) bool {
+	liquidStakingCap := k.ValidatorLiquidStakingCap(ctx)
+	if liquidStakingCap.GTE(sdk.OneDec()) {
+		return false
+	}
+
+	if validator.DelegatorShares.IsZero() {
+		return true
+	}
+
+	liquidShares := k.GetValidatorLiquidShares(ctx, validator.OperatorAddress).Add(shares)
+	return liquidShares.Quo(validator.DelegatorShares).GT(liquidStakingCap)
+}
+
+// TokenizeShares moves the delegator shares worth amt tokens of a delegation
+// to a new tokenize share record owned by the given owner, and mints to the
+// delegator one share token of the record's denom per whole delegator share.
+// It returns the minted share tokens.
+func (k Keeper) TokenizeShares(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amt sdk.Coin, owner sdk.AccAddress,
+) (sdk.Coin, error) {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoValidatorFound
+	}
+
+	if amt.Denom != k.BondDenom(ctx) {
+		return sdk.Coin{}, types.ErrBadDenom
+	}
+
+	// tokenizing the shares would release them from any pending redelegation
+	// slashing, as the redelegation would no longer be backed by the delegation
+	if k.HasReceivingRedelegation(ctx, delAddr, valAddr) {
+		return sdk.Coin{}, types.ErrRedelegationInProgress
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delAddr, valAddr, amt.Amount)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if !shares.TruncateInt().IsPositive() {
+		return sdk.Coin{}, sdkerrors.Wrap(types.ErrBadSharesAmount, "too few shares to tokenize")
+	}
+
+	// vested tokens a vesting account delegated must not become transferable
+	if acc, ok := k.authKeeper.GetAccount(ctx, delAddr).(vestexported.VestingAccount); ok {
+		if acc.GetDelegatedFree().AmountOf(amt.Denom).LT(amt.Amount) {
+			return sdk.Coin{}, types.ErrExceedingFreeVestingDelegations
+		}
+	}
+
+	if k.exceedsGlobalLiquidStakingCap(ctx, amt.Amount) {
+		return sdk.Coin{}, types.ErrGlobalLiquidStakingCapExceeded
+	}
+
+	if k.exceedsValidatorLiquidStakingCap(ctx, validator, shares) {
+		return sdk.Coin{}, types.ErrValidatorLiquidStakingCapExceeded
+	}
+
+	id := k.GetLastTokenizeShareRecordID(ctx) + 1
+	k.SetLastTokenizeShareRecordID(ctx, id)
+
+	record := types.NewTokenizeShareRecord(id, owner, valAddr)
+	k.SetTokenizeShareRecord(ctx, record)
+
+	shares, err = k.TransferDelegation(ctx, delAddr, record.GetModuleAddress(), valAddr, shares)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	shareToken := sdk.NewCoin(record.GetShareTokenDenom(), shares.TruncateInt())
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(shareToken)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delAddr, sdk.NewCoins(shareToken)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	k.increaseLiquidStake(ctx, validator, shares)
+
+	return shareToken, nil
+}
+
+// RedeemTokensForShares burns share tokens of a tokenize share record and
+// moves the delegator shares they represent from the record to the delegator.
+// Once all its share tokens are redeemed, the remaining shares of the record
+// are moved as well and the record is removed. It returns the amount of bond
+// denom tokens the redeemed shares are worth.
+func (k Keeper) RedeemTokensForShares(ctx sdk.Context, delAddr sdk.AccAddress, amt sdk.Coin) (sdk.Coin, error) {
+	record, found := k.GetTokenizeShareRecordByDenom(ctx, amt.Denom)
+	if !found {
+		return sdk.Coin{}, types.ErrTokenizeShareRecordNotExists
+	}
+
+	validator, found := k.GetValidator(ctx, record.Validator)
+	if !found {
+		return sdk.Coin{}, types.ErrNoValidatorFound
+	}
+
+	delegation, found := k.GetDelegation(ctx, record.GetModuleAddress(), record.Validator)
+	if !found {
+		return sdk.Coin{}, types.ErrNoDelegation
+	}
+
+	coins := sdk.NewCoins(amt)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delAddr, types.ModuleName, coins); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	shares := amt.Amount.ToDec()
+	if k.bankKeeper.GetSupplyOf(ctx, amt.Denom).IsZero() {
+		// the last share tokens also redeem the fractional shares left over
+		shares = delegation.Shares
+	}
+
+	shares, err := k.TransferDelegation(ctx, record.GetModuleAddress(), delAddr, record.Validator, shares)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	k.decreaseLiquidStake(ctx, validator, shares)
+
+	if _, found := k.GetDelegation(ctx, record.GetModuleAddress(), record.Validator); !found {
+		if err := k.DeleteTokenizeShareRecord(ctx, record.Id); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	return sdk.NewCoin(k.BondDenom(ctx), validator.TokensFromShares(shares).TruncateInt()), nil
+}
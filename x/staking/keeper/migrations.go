@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v040 "github.com/cosmos/cosmos-sdk/x/staking/legacy/v0_40"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// Migrator performs the in-place store migrations of the x/staking module.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the x/staking state from the consensus version 1, i.e.
+// v0.39, to the version 2. The MinCommissionRate parameter defaults to zero,
+// unless it was set by the upgrade handler before running the migrations.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	minCommissionRate := types.DefaultMinCommissionRate
+	if m.keeper.paramstore.Has(ctx, types.KeyMinCommissionRate) {
+		m.keeper.paramstore.Get(ctx, types.KeyMinCommissionRate, &minCommissionRate)
+	}
+
+	return v040.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc, m.keeper.paramstore, minCommissionRate)
+}
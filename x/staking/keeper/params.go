@@ -31,12 +31,19 @@ func (k Keeper) MaxValidators(ctx sdk.Context) (res uint32) {
 }
 
 // MaxEntries - Maximum number of simultaneous unbonding
-// delegations or redelegations (per pair/trio)
+// delegations (per pair)
 func (k Keeper) MaxEntries(ctx sdk.Context) (res uint32) {
 	k.paramstore.Get(ctx, types.KeyMaxEntries, &res)
 	return
 }
 
+// MaxRedelegationEntries - Maximum number of simultaneous
+// redelegations (per trio)
+func (k Keeper) MaxRedelegationEntries(ctx sdk.Context) (res uint32) {
+	k.paramstore.Get(ctx, types.KeyMaxRedelegationEntries, &res)
+	return
+}
+
 // HistoricalEntries = number of historical info entries
 // to persist in store
 func (k Keeper) HistoricalEntries(ctx sdk.Context) (res uint32) {
@@ -44,20 +51,51 @@ func (k Keeper) HistoricalEntries(ctx sdk.Context) (res uint32) {
 	return
 }
 
+// EpochLength - Number of blocks between two updates of the validator set
+func (k Keeper) EpochLength(ctx sdk.Context) (res uint32) {
+	k.paramstore.Get(ctx, types.KeyEpochLength, &res)
+	return
+}
+
 // BondDenom - Bondable coin denomination
 func (k Keeper) BondDenom(ctx sdk.Context) (res string) {
 	k.paramstore.Get(ctx, types.KeyBondDenom, &res)
 	return
 }
 
+// MinCommissionRate - Minimum commission rate validators may set
+func (k Keeper) MinCommissionRate(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyMinCommissionRate, &res)
+	return
+}
+
+// GlobalLiquidStakingCap - Maximum share of the total bonded tokens that may
+// be liquid staked
+func (k Keeper) GlobalLiquidStakingCap(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyGlobalLiquidStakingCap, &res)
+	return
+}
+
+// ValidatorLiquidStakingCap - Maximum share of a validator's delegator shares
+// that may be liquid staked
+func (k Keeper) ValidatorLiquidStakingCap(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyValidatorLiquidStakingCap, &res)
+	return
+}
+
 // Get all parameteras as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	return types.NewParams(
 		k.UnbondingTime(ctx),
 		k.MaxValidators(ctx),
 		k.MaxEntries(ctx),
+		k.MaxRedelegationEntries(ctx),
 		k.HistoricalEntries(ctx),
+		k.EpochLength(ctx),
 		k.BondDenom(ctx),
+		k.MinCommissionRate(ctx),
+		k.GlobalLiquidStakingCap(ctx),
+		k.ValidatorLiquidStakingCap(ctx),
 	)
 }
 
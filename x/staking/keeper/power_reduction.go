@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PowerReduction returns the amount of staking tokens required for 1 unit of
+// consensus-engine power, as configured when creating the keeper.
+func (k Keeper) PowerReduction(_ sdk.Context) sdk.Int {
+	return k.powerReduction
+}
+
+// TokensToConsensusPower converts input tokens to potential consensus-engine
+// power using the keeper's power reduction.
+func (k Keeper) TokensToConsensusPower(ctx sdk.Context, tokens sdk.Int) int64 {
+	return sdk.TokensToConsensusPower(tokens, k.PowerReduction(ctx))
+}
+
+// TokensFromConsensusPower converts input power to tokens using the keeper's
+// power reduction.
+func (k Keeper) TokensFromConsensusPower(ctx sdk.Context, power int64) sdk.Int {
+	return sdk.TokensFromConsensusPower(power, k.PowerReduction(ctx))
+}
@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestPowerReduction(t *testing.T) {
+	_, app, ctx := createTestInput()
+
+	require.Equal(t, sdk.DefaultPowerReduction, app.StakingKeeper.PowerReduction(ctx))
+	require.Equal(t, sdk.DefaultPowerReduction.MulRaw(2), app.StakingKeeper.TokensFromConsensusPower(ctx, 2))
+	require.Equal(t, int64(2), app.StakingKeeper.TokensToConsensusPower(ctx, sdk.DefaultPowerReduction.MulRaw(2)))
+
+	newKeeper := func(powerReduction sdk.Int) keeper.Keeper {
+		return keeper.NewKeeper(
+			std.NewAppCodec(codec.New()),
+			app.GetKey(staking.StoreKey),
+			app.AccountKeeper,
+			app.BankKeeper,
+			app.GetSubspace(staking.ModuleName),
+			powerReduction,
+		)
+	}
+
+	require.Panics(t, func() { newKeeper(sdk.ZeroInt()) })
+
+	// an 18 decimal staking token does not overflow the consensus power
+	powerReduction := sdk.NewIntWithDecimal(1, 18)
+	app.StakingKeeper = newKeeper(powerReduction)
+
+	tokens := sdk.NewIntWithDecimal(1000000, 18)
+	require.Equal(t, int64(1000000), app.StakingKeeper.TokensToConsensusPower(ctx, tokens))
+	require.Equal(t, tokens, app.StakingKeeper.TokensFromConsensusPower(ctx, 1000000))
+
+	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	require.NoError(t,
+		app.BankKeeper.SetBalances(ctx, notBondedPool.GetAddress(), sdk.NewCoins(sdk.NewCoin(bondDenom, tokens))),
+	)
+	app.AccountKeeper.SetModuleAccount(ctx, notBondedPool)
+
+	validator := types.NewValidator(sdk.ValAddress(PKs[0].Address()), PKs[0], types.Description{})
+	validator, _ = validator.AddTokensFromDel(tokens)
+	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
+	require.Equal(t, int64(1000000), validator.ConsensusPower(app.StakingKeeper.PowerReduction(ctx)))
+
+	updates := app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.Empty(t, updates)
+	require.Equal(t, int64(1000000), app.StakingKeeper.GetLastValidatorPower(ctx, validator.OperatorAddress))
+}
@@ -23,6 +23,9 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case types.QueryValidator:
 			return queryValidator(ctx, req, k)
 
+		case types.QueryValidatorSelfBond:
+			return queryValidatorSelfBond(ctx, req, k)
+
 		case types.QueryValidatorDelegations:
 			return queryValidatorDelegations(ctx, req, k)
 
@@ -35,6 +38,9 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case types.QueryUnbondingDelegation:
 			return queryUnbondingDelegation(ctx, req, k)
 
+		case types.QueryUnbondingDelegationEntryLimit:
+			return queryUnbondingDelegationEntryLimit(ctx, req, k)
+
 		case types.QueryDelegatorDelegations:
 			return queryDelegatorDelegations(ctx, req, k)
 
@@ -44,6 +50,9 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case types.QueryRedelegations:
 			return queryRedelegations(ctx, req, k)
 
+		case types.QueryRedelegationEntryLimit:
+			return queryRedelegationEntryLimit(ctx, req, k)
+
 		case types.QueryDelegatorValidators:
 			return queryDelegatorValidators(ctx, req, k)
 
@@ -56,6 +65,15 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case types.QueryPool:
 			return queryPool(ctx, k)
 
+		case types.QueryTokenizeShareRecordByDenom:
+			return queryTokenizeShareRecordByDenom(ctx, req, k)
+
+		case types.QueryTokenizeShareRecordsOwned:
+			return queryTokenizeShareRecordsOwned(ctx, req, k)
+
+		case types.QueryTotalLiquidStaked:
+			return queryTotalLiquidStaked(ctx, k)
+
 		case types.QueryParameters:
 			return queryParameters(ctx, k)
 
@@ -73,20 +91,21 @@ func queryValidators(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte,
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	validators := k.GetAllValidators(ctx)
-	filteredVals := make([]types.Validator, 0, len(validators))
+	page, limit := params.Page, params.Limit
+	if page < 1 {
+		page = 1
+	}
 
-	for _, val := range validators {
-		if strings.EqualFold(val.GetStatus().String(), params.Status) {
-			filteredVals = append(filteredVals, val)
-		}
+	if limit < 1 {
+		limit = int(k.MaxValidators(ctx))
 	}
 
-	start, end := client.Paginate(len(filteredVals), params.Page, params.Limit, int(k.GetParams(ctx).MaxValidators))
-	if start < 0 || end < 0 {
-		filteredVals = []types.Validator{}
-	} else {
-		filteredVals = filteredVals[start:end]
+	filteredVals := []types.Validator{}
+
+	for _, status := range []sdk.BondStatus{sdk.Unbonded, sdk.Unbonding, sdk.Bonded} {
+		if strings.EqualFold(status.String(), params.Status) {
+			filteredVals = k.GetPaginatedValidatorsByStatus(ctx, status, page, limit)
+		}
 	}
 
 	res, err := codec.MarshalJSONIndent(types.ModuleCdc, filteredVals)
@@ -118,6 +137,31 @@ func queryValidator(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, e
 	return res, nil
 }
 
+func queryValidatorSelfBond(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryValidatorParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	validator, found := k.GetValidator(ctx, params.ValidatorAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	selfBond := types.NewValidatorSelfBond(
+		validator.OperatorAddress, k.GetValidatorSelfBond(ctx, validator), validator.MinSelfDelegation,
+	)
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, selfBond)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
 func queryValidatorDelegations(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
 	var params types.QueryValidatorParams
 
@@ -126,7 +170,9 @@ func queryValidatorDelegations(ctx sdk.Context, req abci.RequestQuery, k Keeper)
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	delegations := k.GetValidatorDelegations(ctx, params.ValidatorAddr)
+	page, limit := pageAndLimit(params.Page, params.Limit)
+
+	delegations := k.GetPaginatedValidatorDelegations(ctx, params.ValidatorAddr, page, limit)
 
 	delegationResps, err := delegationsToDelegationResponses(ctx, k, delegations)
 	if err != nil {
@@ -137,7 +183,9 @@ func queryValidatorDelegations(ctx sdk.Context, req abci.RequestQuery, k Keeper)
 		delegationResps = types.DelegationResponses{}
 	}
 
-	res, err := codec.MarshalJSONIndent(types.ModuleCdc, delegationResps)
+	total := countKeys(ctx.KVStore(k.storeKey), types.GetDelegationsByValIndexKey(params.ValidatorAddr))
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, types.NewQueryDelegationsResult(delegationResps, total))
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
@@ -174,7 +222,9 @@ func queryDelegatorDelegations(ctx sdk.Context, req abci.RequestQuery, k Keeper)
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	delegations := k.GetAllDelegatorDelegations(ctx, params.DelegatorAddr)
+	page, limit := pageAndLimit(params.Page, params.Limit)
+
+	delegations := k.GetPaginatedDelegatorDelegations(ctx, params.DelegatorAddr, page, limit)
 	delegationResps, err := delegationsToDelegationResponses(ctx, k, delegations)
 
 	if err != nil {
@@ -185,7 +235,9 @@ func queryDelegatorDelegations(ctx sdk.Context, req abci.RequestQuery, k Keeper)
 		delegationResps = types.DelegationResponses{}
 	}
 
-	res, err := codec.MarshalJSONIndent(types.ModuleCdc, delegationResps)
+	total := countKeys(ctx.KVStore(k.storeKey), types.GetDelegationsKey(params.DelegatorAddr))
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, types.NewQueryDelegationsResult(delegationResps, total))
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
@@ -201,12 +253,14 @@ func queryDelegatorUnbondingDelegations(ctx sdk.Context, req abci.RequestQuery,
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	unbondingDelegations := k.GetAllUnbondingDelegations(ctx, params.DelegatorAddr)
-	if unbondingDelegations == nil {
-		unbondingDelegations = types.UnbondingDelegations{}
-	}
+	page, limit := pageAndLimit(params.Page, params.Limit)
 
-	res, err := codec.MarshalJSONIndent(types.ModuleCdc, unbondingDelegations)
+	unbondingDelegations := k.GetPaginatedDelegatorUnbondingDelegations(ctx, params.DelegatorAddr, page, limit)
+	total := countKeys(ctx.KVStore(k.storeKey), types.GetUBDsKey(params.DelegatorAddr))
+
+	res, err := codec.MarshalJSONIndent(
+		types.ModuleCdc, types.NewQueryUnbondingDelegationsResult(unbondingDelegations, total),
+	)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
@@ -305,6 +359,50 @@ func queryUnbondingDelegation(ctx sdk.Context, req abci.RequestQuery, k Keeper)
 	return res, nil
 }
 
+func queryUnbondingDelegationEntryLimit(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryBondsParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	// a missing unbonding delegation has no entries
+	ubd, _ := k.GetUnbondingDelegation(ctx, params.DelegatorAddr, params.ValidatorAddr)
+	limit := types.NewEntryLimit(uint32(len(ubd.Entries)), k.MaxEntries(ctx))
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, limit)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryRedelegationEntryLimit(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryRedelegationParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	if params.DelegatorAddr.Empty() || params.SrcValidatorAddr.Empty() || params.DstValidatorAddr.Empty() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "delegator, source and destination validator addresses are required")
+	}
+
+	// a missing redelegation has no entries
+	red, _ := k.GetRedelegation(ctx, params.DelegatorAddr, params.SrcValidatorAddr, params.DstValidatorAddr)
+	limit := types.NewEntryLimit(uint32(len(red.Entries)), k.MaxRedelegationEntries(ctx))
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, limit)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
 func queryRedelegations(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
 	var params types.QueryRedelegationParams
 
@@ -313,7 +411,12 @@ func queryRedelegations(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byt
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	var redels []types.Redelegation
+	var (
+		redels []types.Redelegation
+		total  int
+	)
+
+	page, limit := pageAndLimit(params.Page, params.Limit)
 
 	switch {
 	case !params.DelegatorAddr.Empty() && !params.SrcValidatorAddr.Empty() && !params.DstValidatorAddr.Empty():
@@ -322,11 +425,16 @@ func queryRedelegations(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byt
 			return nil, types.ErrNoRedelegation
 		}
 
-		redels = []types.Redelegation{redel}
+		redels, total = []types.Redelegation{redel}, 1
+	case params.SrcValidatorAddr.Empty() && params.DstValidatorAddr.Empty():
+		redels = k.GetPaginatedRedelegations(ctx, params.DelegatorAddr, page, limit)
+		total = countKeys(ctx.KVStore(k.storeKey), types.GetREDsKey(params.DelegatorAddr))
 	case params.DelegatorAddr.Empty() && !params.SrcValidatorAddr.Empty() && params.DstValidatorAddr.Empty():
-		redels = k.GetRedelegationsFromSrcValidator(ctx, params.SrcValidatorAddr)
+		srcRedels := k.GetRedelegationsFromSrcValidator(ctx, params.SrcValidatorAddr)
+		redels, total = paginateRedelegations(srcRedels, page, limit), len(srcRedels)
 	default:
-		redels = k.GetAllRedelegations(ctx, params.DelegatorAddr, params.SrcValidatorAddr, params.DstValidatorAddr)
+		allRedels := k.GetAllRedelegations(ctx, params.DelegatorAddr, params.SrcValidatorAddr, params.DstValidatorAddr)
+		redels, total = paginateRedelegations(allRedels, page, limit), len(allRedels)
 	}
 
 	redelResponses, err := redelegationsToRedelegationResponses(ctx, k, redels)
@@ -338,7 +446,7 @@ func queryRedelegations(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byt
 		redelResponses = types.RedelegationResponses{}
 	}
 
-	res, err := codec.MarshalJSONIndent(types.ModuleCdc, redelResponses)
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, types.NewQueryRedelegationsResult(redelResponses, total))
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
@@ -389,6 +497,57 @@ func queryPool(ctx sdk.Context, k Keeper) ([]byte, error) {
 	return res, nil
 }
 
+func queryTokenizeShareRecordByDenom(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryTokenizeShareRecordByDenomParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	record, found := k.GetTokenizeShareRecordByDenom(ctx, params.Denom)
+	if !found {
+		return nil, types.ErrTokenizeShareRecordNotExists
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, record)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryTokenizeShareRecordsOwned(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryTokenizeShareRecordsOwnedParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	records := k.GetTokenizeShareRecordsByOwner(ctx, params.Owner)
+	if records == nil {
+		records = []types.TokenizeShareRecord{}
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, records)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryTotalLiquidStaked(ctx sdk.Context, k Keeper) ([]byte, error) {
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, k.GetTotalLiquidStakedTokens(ctx))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
 func queryParameters(ctx sdk.Context, k Keeper) ([]byte, error) {
 	params := k.GetParams(ctx)
 
@@ -403,6 +562,34 @@ func queryParameters(ctx sdk.Context, k Keeper) ([]byte, error) {
 //______________________________________________________
 // util
 
+// defaultQueryLimit is the number of results returned per page by the paginated
+// queries when no limit is given.
+const defaultQueryLimit = 100
+
+// pageAndLimit returns the page and limit of a paginated query, defaulting to
+// the first page of defaultQueryLimit results.
+func pageAndLimit(page, limit int) (int, int) {
+	if page < 1 {
+		page = 1
+	}
+
+	if limit < 1 {
+		limit = defaultQueryLimit
+	}
+
+	return page, limit
+}
+
+// paginateRedelegations returns the redelegations in the selected page.
+func paginateRedelegations(redels []types.Redelegation, page, limit int) []types.Redelegation {
+	start, end := client.Paginate(len(redels), page, limit, defaultQueryLimit)
+	if start < 0 || end < 0 {
+		return []types.Redelegation{}
+	}
+
+	return redels[start:end]
+}
+
 func delegationToDelegationResponse(ctx sdk.Context, k Keeper, del types.Delegation) (types.DelegationResponse, error) {
 	val, found := k.GetValidator(ctx, del.ValidatorAddress)
 	if !found {
@@ -55,7 +55,7 @@ func TestNewQuerier(t *testing.T) {
 	_, err = querier(ctx, []string{"parameters"}, query)
 	require.NoError(t, err)
 
-	queryValParams := types.NewQueryValidatorParams(addrVal1)
+	queryValParams := types.NewQueryValidatorParams(addrVal1, 1, 100)
 	bz, errRes := cdc.MarshalJSON(queryValParams)
 	require.NoError(t, errRes)
 
@@ -65,13 +65,20 @@ func TestNewQuerier(t *testing.T) {
 	_, err = querier(ctx, []string{"validator"}, query)
 	require.NoError(t, err)
 
+	bz, err = querier(ctx, []string{"validatorSelfBond"}, query)
+	require.NoError(t, err)
+
+	var selfBond types.ValidatorSelfBond
+	require.NoError(t, cdc.UnmarshalJSON(bz, &selfBond))
+	require.Equal(t, types.NewValidatorSelfBond(addrVal1, sdk.ZeroInt(), sdk.OneInt()), selfBond)
+
 	_, err = querier(ctx, []string{"validatorDelegations"}, query)
 	require.NoError(t, err)
 
 	_, err = querier(ctx, []string{"validatorUnbondingDelegations"}, query)
 	require.NoError(t, err)
 
-	queryDelParams := types.NewQueryDelegatorParams(addrAcc2)
+	queryDelParams := types.NewQueryDelegatorParams(addrAcc2, 1, 100)
 	bz, errRes = cdc.MarshalJSON(queryDelParams)
 	require.NoError(t, errRes)
 
@@ -135,7 +142,7 @@ func TestQueryValidators(t *testing.T) {
 	params := app.StakingKeeper.GetParams(ctx)
 	querier := staking.NewQuerier(app.StakingKeeper)
 
-	addrs := simapp.AddTestAddrs(app, ctx, 500, sdk.TokensFromConsensusPower(10000))
+	addrs := simapp.AddTestAddrs(app, ctx, 500, sdk.TokensFromConsensusPower(10000, sdk.DefaultPowerReduction))
 
 	// Create Validators
 	amts := []sdk.Int{sdk.NewInt(9), sdk.NewInt(8), sdk.NewInt(7)}
@@ -178,7 +185,7 @@ func TestQueryValidators(t *testing.T) {
 
 	// Query each validator
 	for _, validator := range validators {
-		queryParams := types.NewQueryValidatorParams(validator.OperatorAddress)
+		queryParams := types.NewQueryValidatorParams(validator.OperatorAddress, 1, 100)
 		bz, err := cdc.MarshalJSON(queryParams)
 		require.NoError(t, err)
 
@@ -202,7 +209,7 @@ func TestQueryDelegation(t *testing.T) {
 	params := app.StakingKeeper.GetParams(ctx)
 	querier := staking.NewQuerier(app.StakingKeeper)
 
-	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.TokensFromConsensusPower(10000))
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.TokensFromConsensusPower(10000, sdk.DefaultPowerReduction))
 	addrAcc1, addrAcc2 := addrs[0], addrs[1]
 	addrVal1, addrVal2 := sdk.ValAddress(addrAcc1), sdk.ValAddress(addrAcc2)
 
@@ -218,7 +225,7 @@ func TestQueryDelegation(t *testing.T) {
 	app.StakingKeeper.SetValidator(ctx, val2)
 	app.StakingKeeper.SetValidatorByPowerIndex(ctx, val2)
 
-	delTokens := sdk.TokensFromConsensusPower(20)
+	delTokens := sdk.TokensFromConsensusPower(20, sdk.DefaultPowerReduction)
 	_, err := app.StakingKeeper.Delegate(ctx, addrAcc2, delTokens, sdk.Unbonded, val1, true)
 	require.NoError(t, err)
 
@@ -226,7 +233,7 @@ func TestQueryDelegation(t *testing.T) {
 	app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
 
 	// Query Delegator bonded validators
-	queryParams := types.NewQueryDelegatorParams(addrAcc2)
+	queryParams := types.NewQueryDelegatorParams(addrAcc2, 1, 100)
 	bz, errRes := cdc.MarshalJSON(queryParams)
 	require.NoError(t, errRes)
 
@@ -308,9 +315,12 @@ func TestQueryDelegation(t *testing.T) {
 	res, err = querier(ctx, []string{types.QueryDelegatorDelegations}, query)
 	require.NoError(t, err)
 
-	var delegatorDelegations types.DelegationResponses
-	errRes = cdc.UnmarshalJSON(res, &delegatorDelegations)
+	var delegatorDelegationsRes types.QueryDelegationsResult
+	errRes = cdc.UnmarshalJSON(res, &delegatorDelegationsRes)
 	require.NoError(t, errRes)
+	require.Equal(t, 1, delegatorDelegationsRes.Total)
+
+	delegatorDelegations := delegatorDelegationsRes.Delegations
 	require.Len(t, delegatorDelegations, 1)
 	require.Equal(t, delegation.ValidatorAddress, delegatorDelegations[0].ValidatorAddress)
 	require.Equal(t, delegation.DelegatorAddress, delegatorDelegations[0].DelegatorAddress)
@@ -323,7 +333,7 @@ func TestQueryDelegation(t *testing.T) {
 	require.Error(t, err)
 
 	// Query validator delegations
-	bz, errRes = cdc.MarshalJSON(types.NewQueryValidatorParams(addrVal1))
+	bz, errRes = cdc.MarshalJSON(types.NewQueryValidatorParams(addrVal1, 1, 100))
 	require.NoError(t, errRes)
 
 	query = abci.RequestQuery{
@@ -334,16 +344,19 @@ func TestQueryDelegation(t *testing.T) {
 	res, err = querier(ctx, []string{types.QueryValidatorDelegations}, query)
 	require.NoError(t, err)
 
-	var delegationsRes types.DelegationResponses
-	errRes = cdc.UnmarshalJSON(res, &delegationsRes)
+	var validatorDelegationsRes types.QueryDelegationsResult
+	errRes = cdc.UnmarshalJSON(res, &validatorDelegationsRes)
 	require.NoError(t, errRes)
-	require.Len(t, delegatorDelegations, 1)
+	require.Equal(t, 1, validatorDelegationsRes.Total)
+
+	delegationsRes := validatorDelegationsRes.Delegations
+	require.Len(t, delegationsRes, 1)
 	require.Equal(t, delegation.ValidatorAddress, delegationsRes[0].ValidatorAddress)
 	require.Equal(t, delegation.DelegatorAddress, delegationsRes[0].DelegatorAddress)
 	require.Equal(t, sdk.NewCoin(sdk.DefaultBondDenom, delegation.Shares.TruncateInt()), delegationsRes[0].Balance)
 
 	// Query unbonding delegation
-	unbondingTokens := sdk.TokensFromConsensusPower(10)
+	unbondingTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
 	_, err = app.StakingKeeper.Undelegate(ctx, addrAcc2, val1.OperatorAddress, unbondingTokens.ToDec())
 	require.NoError(t, err)
 
@@ -384,10 +397,11 @@ func TestQueryDelegation(t *testing.T) {
 	res, err = querier(ctx, []string{types.QueryDelegatorUnbondingDelegations}, query)
 	require.NoError(t, err)
 
-	var delegatorUbds []types.UnbondingDelegation
+	var delegatorUbds types.QueryUnbondingDelegationsResult
 	errRes = cdc.UnmarshalJSON(res, &delegatorUbds)
 	require.NoError(t, errRes)
-	require.Equal(t, unbond, delegatorUbds[0])
+	require.Equal(t, 1, delegatorUbds.Total)
+	require.Equal(t, unbond, delegatorUbds.UnbondingDelegations[0])
 
 	// error unknown request
 	query.Data = bz[:len(bz)-1]
@@ -396,7 +410,7 @@ func TestQueryDelegation(t *testing.T) {
 	require.Error(t, err)
 
 	// Query redelegation
-	redelegationTokens := sdk.TokensFromConsensusPower(10)
+	redelegationTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
 	_, err = app.StakingKeeper.BeginRedelegation(ctx, addrAcc2, val1.OperatorAddress,
 		val2.OperatorAddress, redelegationTokens.ToDec())
 	require.NoError(t, err)
@@ -414,9 +428,12 @@ func TestQueryDelegation(t *testing.T) {
 	res, err = querier(ctx, []string{types.QueryRedelegations}, query)
 	require.NoError(t, err)
 
-	var redelRes types.RedelegationResponses
-	errRes = cdc.UnmarshalJSON(res, &redelRes)
+	var redelsRes types.QueryRedelegationsResult
+	errRes = cdc.UnmarshalJSON(res, &redelsRes)
 	require.NoError(t, errRes)
+	require.Equal(t, 1, redelsRes.Total)
+
+	redelRes := redelsRes.Redelegations
 	require.Len(t, redelRes, 1)
 	require.Equal(t, redel.DelegatorAddress, redelRes[0].DelegatorAddress)
 	require.Equal(t, redel.ValidatorSrcAddress, redelRes[0].ValidatorSrcAddress)
@@ -428,7 +445,7 @@ func TestQueryRedelegations(t *testing.T) {
 	cdc, app, ctx := createTestInput()
 	querier := staking.NewQuerier(app.StakingKeeper)
 
-	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.TokensFromConsensusPower(10000))
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.TokensFromConsensusPower(10000, sdk.DefaultPowerReduction))
 	addrAcc1, addrAcc2 := addrs[0], addrs[1]
 	addrVal1, addrVal2 := sdk.ValAddress(addrAcc1), sdk.ValAddress(addrAcc2)
 
@@ -438,12 +455,12 @@ func TestQueryRedelegations(t *testing.T) {
 	app.StakingKeeper.SetValidator(ctx, val1)
 	app.StakingKeeper.SetValidator(ctx, val2)
 
-	delAmount := sdk.TokensFromConsensusPower(100)
+	delAmount := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
 	_, err := app.StakingKeeper.Delegate(ctx, addrAcc2, delAmount, sdk.Unbonded, val1, true)
 	require.NoError(t, err)
 	_ = app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
 
-	rdAmount := sdk.TokensFromConsensusPower(20)
+	rdAmount := sdk.TokensFromConsensusPower(20, sdk.DefaultPowerReduction)
 	_, err = app.StakingKeeper.BeginRedelegation(ctx, addrAcc2, val1.GetOperator(), val2.GetOperator(), rdAmount.ToDec())
 	require.NoError(t, err)
 	app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
@@ -452,7 +469,7 @@ func TestQueryRedelegations(t *testing.T) {
 	require.True(t, found)
 
 	// delegator redelegations
-	queryDelegatorParams := types.NewQueryDelegatorParams(addrAcc2)
+	queryDelegatorParams := types.NewQueryDelegatorParams(addrAcc2, 1, 100)
 	bz, errRes := cdc.MarshalJSON(queryDelegatorParams)
 	require.NoError(t, errRes)
 
@@ -464,9 +481,12 @@ func TestQueryRedelegations(t *testing.T) {
 	res, err := querier(ctx, []string{types.QueryRedelegations}, query)
 	require.NoError(t, err)
 
-	var redelRes types.RedelegationResponses
-	errRes = cdc.UnmarshalJSON(res, &redelRes)
+	var redelsRes types.QueryRedelegationsResult
+	errRes = cdc.UnmarshalJSON(res, &redelsRes)
 	require.NoError(t, errRes)
+	require.Equal(t, 1, redelsRes.Total)
+
+	redelRes := redelsRes.Redelegations
 	require.Len(t, redelRes, 1)
 	require.Equal(t, redel.DelegatorAddress, redelRes[0].DelegatorAddress)
 	require.Equal(t, redel.ValidatorSrcAddress, redelRes[0].ValidatorSrcAddress)
@@ -474,7 +494,7 @@ func TestQueryRedelegations(t *testing.T) {
 	require.Len(t, redel.Entries, len(redelRes[0].Entries))
 
 	// validator redelegations
-	queryValidatorParams := types.NewQueryValidatorParams(val1.GetOperator())
+	queryValidatorParams := types.NewQueryValidatorParams(val1.GetOperator(), 1, 100)
 	bz, errRes = cdc.MarshalJSON(queryValidatorParams)
 	require.NoError(t, errRes)
 
@@ -486,8 +506,11 @@ func TestQueryRedelegations(t *testing.T) {
 	res, err = querier(ctx, []string{types.QueryRedelegations}, query)
 	require.NoError(t, err)
 
-	errRes = cdc.UnmarshalJSON(res, &redelRes)
+	errRes = cdc.UnmarshalJSON(res, &redelsRes)
 	require.NoError(t, errRes)
+	require.Equal(t, 1, redelsRes.Total)
+
+	redelRes = redelsRes.Redelegations
 	require.Len(t, redelRes, 1)
 	require.Equal(t, redel.DelegatorAddress, redelRes[0].DelegatorAddress)
 	require.Equal(t, redel.ValidatorSrcAddress, redelRes[0].ValidatorSrcAddress)
@@ -495,11 +518,235 @@ func TestQueryRedelegations(t *testing.T) {
 	require.Len(t, redel.Entries, len(redelRes[0].Entries))
 }
 
+func TestQueryEntryLimits(t *testing.T) {
+	cdc, app, ctx := createTestInput()
+	querier := staking.NewQuerier(app.StakingKeeper)
+
+	addrs := simapp.AddTestAddrs(app, ctx, 3, sdk.TokensFromConsensusPower(10000, sdk.DefaultPowerReduction))
+	delAddr := addrs[0]
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs[1:])
+
+	for i, valAddr := range valAddrs {
+		validator := types.NewValidator(valAddr, PKs[i], types.Description{})
+		app.StakingKeeper.SetValidator(ctx, validator)
+
+		_, err := app.StakingKeeper.Delegate(ctx, delAddr, sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction), sdk.Unbonded, validator, true)
+		require.NoError(t, err)
+	}
+
+	app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MaxEntries = 3
+	params.MaxRedelegationEntries = 2
+	app.StakingKeeper.SetParams(ctx, params)
+
+	queryEntryLimit := func(path string, params interface{}) types.EntryLimit {
+		bz, err := cdc.MarshalJSON(params)
+		require.NoError(t, err)
+
+		res, err := querier(ctx, []string{path}, abci.RequestQuery{Data: bz})
+		require.NoError(t, err)
+
+		var limit types.EntryLimit
+		require.NoError(t, cdc.UnmarshalJSON(res, &limit))
+
+		return limit
+	}
+
+	bondsParams := types.NewQueryBondsParams(delAddr, valAddrs[0])
+	redParams := types.NewQueryRedelegationParams(delAddr, valAddrs[0], valAddrs[1])
+
+	require.Equal(t, types.NewEntryLimit(0, 3), queryEntryLimit(types.QueryUnbondingDelegationEntryLimit, bondsParams))
+	require.Equal(t, types.NewEntryLimit(0, 2), queryEntryLimit(types.QueryRedelegationEntryLimit, redParams))
+
+	// entries at the same height are merged
+	for i := 0; i < 2; i++ {
+		_, err := app.StakingKeeper.Undelegate(ctx, delAddr, valAddrs[0], sdk.TokensFromConsensusPower(1, sdk.DefaultPowerReduction).ToDec())
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, types.NewEntryLimit(1, 3), queryEntryLimit(types.QueryUnbondingDelegationEntryLimit, bondsParams))
+
+	for i := int64(1); i <= 2; i++ {
+		ctx = ctx.WithBlockHeight(i)
+		_, err := app.StakingKeeper.BeginRedelegation(ctx, delAddr, valAddrs[0], valAddrs[1], sdk.TokensFromConsensusPower(1, sdk.DefaultPowerReduction).ToDec())
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, types.NewEntryLimit(2, 2), queryEntryLimit(types.QueryRedelegationEntryLimit, redParams))
+
+	_, err := app.StakingKeeper.BeginRedelegation(ctx, delAddr, valAddrs[0], valAddrs[1], sdk.TokensFromConsensusPower(1, sdk.DefaultPowerReduction).ToDec())
+	require.True(t, types.ErrMaxRedelegationEntries.Is(err))
+
+	// the redelegation entry limit requires all the addresses
+	bz, err := cdc.MarshalJSON(types.NewQueryRedelegationParams(delAddr, valAddrs[0], nil))
+	require.NoError(t, err)
+
+	_, err = querier(ctx, []string{types.QueryRedelegationEntryLimit}, abci.RequestQuery{Data: bz})
+	require.Error(t, err)
+}
+
+func TestQueryDelegatorPagination(t *testing.T) {
+	cdc, app, ctx := createTestInput()
+	querier := staking.NewQuerier(app.StakingKeeper)
+
+	addrs := simapp.AddTestAddrs(app, ctx, 4, sdk.TokensFromConsensusPower(10000, sdk.DefaultPowerReduction))
+	delAddr := addrs[0]
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs[1:])
+
+	delAmount := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+	for i, valAddr := range valAddrs {
+		validator := types.NewValidator(valAddr, PKs[i], types.Description{})
+		app.StakingKeeper.SetValidator(ctx, validator)
+
+		_, err := app.StakingKeeper.Delegate(ctx, delAddr, delAmount, sdk.Unbonded, validator, true)
+		require.NoError(t, err)
+	}
+
+	app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	for _, valAddr := range valAddrs {
+		_, err := app.StakingKeeper.Undelegate(ctx, delAddr, valAddr, sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction).ToDec())
+		require.NoError(t, err)
+	}
+
+	for _, valDstAddr := range valAddrs[1:] {
+		_, err := app.StakingKeeper.BeginRedelegation(ctx, delAddr, valAddrs[0], valDstAddr, sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction).ToDec())
+		require.NoError(t, err)
+	}
+
+	for page, expLen := range []int{2, 1, 0} {
+		bz, err := cdc.MarshalJSON(types.NewQueryDelegatorParams(delAddr, page+1, 2))
+		require.NoError(t, err)
+
+		query := abci.RequestQuery{Data: bz}
+
+		res, err := querier(ctx, []string{types.QueryDelegatorDelegations}, query)
+		require.NoError(t, err)
+
+		var delegationsRes types.QueryDelegationsResult
+		require.NoError(t, cdc.UnmarshalJSON(res, &delegationsRes))
+		require.Len(t, delegationsRes.Delegations, expLen)
+		require.Equal(t, 3, delegationsRes.Total)
+
+		res, err = querier(ctx, []string{types.QueryDelegatorUnbondingDelegations}, query)
+		require.NoError(t, err)
+
+		var ubdsRes types.QueryUnbondingDelegationsResult
+		require.NoError(t, cdc.UnmarshalJSON(res, &ubdsRes))
+		require.Len(t, ubdsRes.UnbondingDelegations, expLen)
+		require.Equal(t, 3, ubdsRes.Total)
+	}
+
+	// the page defaults to the first one
+	bz, err := cdc.MarshalJSON(types.NewQueryDelegatorParams(delAddr, 0, 0))
+	require.NoError(t, err)
+
+	res, err := querier(ctx, []string{types.QueryDelegatorDelegations}, abci.RequestQuery{Data: bz})
+	require.NoError(t, err)
+
+	var delegationsRes types.QueryDelegationsResult
+	require.NoError(t, cdc.UnmarshalJSON(res, &delegationsRes))
+	require.Len(t, delegationsRes.Delegations, 3)
+	require.Equal(t, 3, delegationsRes.Total)
+
+	for page, expLen := range []int{1, 1, 0} {
+		for _, params := range []types.QueryRedelegationParams{
+			{DelegatorAddr: delAddr, Page: page + 1, Limit: 1},
+			{SrcValidatorAddr: valAddrs[0], Page: page + 1, Limit: 1},
+		} {
+			bz, err := cdc.MarshalJSON(params)
+			require.NoError(t, err)
+
+			res, err := querier(ctx, []string{types.QueryRedelegations}, abci.RequestQuery{Data: bz})
+			require.NoError(t, err)
+
+			var redelRes types.QueryRedelegationsResult
+			require.NoError(t, cdc.UnmarshalJSON(res, &redelRes))
+			require.Len(t, redelRes.Redelegations, expLen)
+			require.Equal(t, 2, redelRes.Total)
+		}
+	}
+}
+
+func TestQueryValidatorPagination(t *testing.T) {
+	cdc, app, ctx := createTestInput()
+	querier := staking.NewQuerier(app.StakingKeeper)
+
+	addrs := simapp.AddTestAddrs(app, ctx, 3, sdk.TokensFromConsensusPower(10000, sdk.DefaultPowerReduction))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+
+	delAmount := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+	for i, valAddr := range valAddrs {
+		validator := types.NewValidator(valAddr, PKs[i], types.Description{})
+		app.StakingKeeper.SetValidator(ctx, validator)
+	}
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	require.True(t, found)
+
+	for _, delAddr := range addrs {
+		_, err := app.StakingKeeper.Delegate(ctx, delAddr, delAmount, sdk.Unbonded, validator, true)
+		require.NoError(t, err)
+
+		validator, _ = app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	}
+
+	// only the first validator has tokens and gets bonded
+	app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+
+	for _, tc := range []struct {
+		status sdk.BondStatus
+		page   int
+		limit  int
+		expLen int
+	}{
+		{sdk.Bonded, 1, 10, 1},
+		{sdk.Unbonded, 1, 1, 1},
+		{sdk.Unbonded, 2, 1, 1},
+		{sdk.Unbonded, 3, 1, 0},
+		{sdk.Unbonded, 0, 0, 2},
+		{sdk.Unbonding, 1, 10, 0},
+	} {
+		bz, err := cdc.MarshalJSON(types.NewQueryValidatorsParams(tc.page, tc.limit, tc.status.String()))
+		require.NoError(t, err)
+
+		res, err := querier(ctx, []string{types.QueryValidators}, abci.RequestQuery{Data: bz})
+		require.NoError(t, err)
+
+		var validatorsRes []types.Validator
+		require.NoError(t, cdc.UnmarshalJSON(res, &validatorsRes))
+		require.Len(t, validatorsRes, tc.expLen)
+
+		for _, v := range validatorsRes {
+			require.Equal(t, tc.status, v.Status)
+		}
+	}
+
+	for page, expLen := range []int{2, 1, 0} {
+		bz, err := cdc.MarshalJSON(types.NewQueryValidatorParams(valAddrs[0], page+1, 2))
+		require.NoError(t, err)
+
+		res, err := querier(ctx, []string{types.QueryValidatorDelegations}, abci.RequestQuery{Data: bz})
+		require.NoError(t, err)
+
+		var delegationsRes types.QueryDelegationsResult
+		require.NoError(t, cdc.UnmarshalJSON(res, &delegationsRes))
+		require.Len(t, delegationsRes.Delegations, expLen)
+		require.Equal(t, 3, delegationsRes.Total)
+
+		for _, del := range delegationsRes.Delegations {
+			require.Equal(t, valAddrs[0], del.ValidatorAddress)
+		}
+	}
+}
+
 func TestQueryUnbondingDelegation(t *testing.T) {
 	cdc, app, ctx := createTestInput()
 	querier := staking.NewQuerier(app.StakingKeeper)
 
-	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.TokensFromConsensusPower(10000))
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.TokensFromConsensusPower(10000, sdk.DefaultPowerReduction))
 	addrAcc1, addrAcc2 := addrs[0], addrs[1]
 	addrVal1 := sdk.ValAddress(addrAcc1)
 
@@ -508,13 +755,13 @@ func TestQueryUnbondingDelegation(t *testing.T) {
 	app.StakingKeeper.SetValidator(ctx, val1)
 
 	// delegate
-	delAmount := sdk.TokensFromConsensusPower(100)
+	delAmount := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
 	_, err := app.StakingKeeper.Delegate(ctx, addrAcc1, delAmount, sdk.Unbonded, val1, true)
 	require.NoError(t, err)
 	_ = app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
 
 	// undelegate
-	undelAmount := sdk.TokensFromConsensusPower(20)
+	undelAmount := sdk.TokensFromConsensusPower(20, sdk.DefaultPowerReduction)
 	_, err = app.StakingKeeper.Undelegate(ctx, addrAcc1, val1.GetOperator(), undelAmount.ToDec())
 	require.NoError(t, err)
 	app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
@@ -557,7 +804,7 @@ func TestQueryUnbondingDelegation(t *testing.T) {
 	//
 	// found: query unbonding delegation by delegator and validator
 	//
-	queryDelegatorParams := types.NewQueryDelegatorParams(addrAcc1)
+	queryDelegatorParams := types.NewQueryDelegatorParams(addrAcc1, 1, 100)
 	bz, errRes = cdc.MarshalJSON(queryDelegatorParams)
 	require.NoError(t, errRes)
 	query = abci.RequestQuery{
@@ -567,16 +814,17 @@ func TestQueryUnbondingDelegation(t *testing.T) {
 	res, err = querier(ctx, []string{types.QueryDelegatorUnbondingDelegations}, query)
 	require.NoError(t, err)
 	require.NotNil(t, res)
-	var ubDels []types.UnbondingDelegation
+	var ubDels types.QueryUnbondingDelegationsResult
 	require.NoError(t, cdc.UnmarshalJSON(res, &ubDels))
-	require.Equal(t, 1, len(ubDels))
-	require.Equal(t, addrAcc1, ubDels[0].DelegatorAddress)
-	require.Equal(t, val1.OperatorAddress, ubDels[0].ValidatorAddress)
+	require.Equal(t, 1, ubDels.Total)
+	require.Equal(t, 1, len(ubDels.UnbondingDelegations))
+	require.Equal(t, addrAcc1, ubDels.UnbondingDelegations[0].DelegatorAddress)
+	require.Equal(t, val1.OperatorAddress, ubDels.UnbondingDelegations[0].ValidatorAddress)
 
 	//
 	// not found: query unbonding delegation by delegator and validator
 	//
-	queryDelegatorParams = types.NewQueryDelegatorParams(addrAcc2)
+	queryDelegatorParams = types.NewQueryDelegatorParams(addrAcc2, 1, 100)
 	bz, errRes = cdc.MarshalJSON(queryDelegatorParams)
 	require.NoError(t, errRes)
 	query = abci.RequestQuery{
@@ -587,14 +835,15 @@ func TestQueryUnbondingDelegation(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, res)
 	require.NoError(t, cdc.UnmarshalJSON(res, &ubDels))
-	require.Equal(t, 0, len(ubDels))
+	require.Equal(t, 0, ubDels.Total)
+	require.Equal(t, 0, len(ubDels.UnbondingDelegations))
 }
 
 func TestQueryHistoricalInfo(t *testing.T) {
 	cdc, app, ctx := createTestInput()
 	querier := staking.NewQuerier(app.StakingKeeper)
 
-	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.TokensFromConsensusPower(10000))
+	addrs := simapp.AddTestAddrs(app, ctx, 2, sdk.TokensFromConsensusPower(10000, sdk.DefaultPowerReduction))
 	addrAcc1, addrAcc2 := addrs[0], addrs[1]
 	addrVal1, addrVal2 := sdk.ValAddress(addrAcc1), sdk.ValAddress(addrAcc2)
 
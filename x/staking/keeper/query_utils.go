@@ -73,6 +73,24 @@ func (k Keeper) GetAllDelegatorDelegations(ctx sdk.Context, delegator sdk.AccAdd
 	return delegations
 }
 
+// GetPaginatedDelegatorDelegations returns the delegations of a delegator in
+// the selected page, ordered by validator address.
+func (k Keeper) GetPaginatedDelegatorDelegations(
+	ctx sdk.Context, delegator sdk.AccAddress, page, limit int,
+) []types.Delegation {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIteratorPaginated(store, types.GetDelegationsKey(delegator), uint(page), uint(limit))
+	defer iterator.Close()
+
+	delegations := []types.Delegation{}
+	for ; iterator.Valid(); iterator.Next() {
+		delegations = append(delegations, types.MustUnmarshalDelegation(k.cdc, iterator.Value()))
+	}
+
+	return delegations
+}
+
 // return all unbonding-delegations for a delegator
 func (k Keeper) GetAllUnbondingDelegations(ctx sdk.Context, delegator sdk.AccAddress) []types.UnbondingDelegation {
 	unbondingDelegations := make([]types.UnbondingDelegation, 0)
@@ -92,6 +110,43 @@ func (k Keeper) GetAllUnbondingDelegations(ctx sdk.Context, delegator sdk.AccAdd
 	return unbondingDelegations
 }
 
+// GetPaginatedDelegatorUnbondingDelegations returns the unbonding delegations
+// of a delegator in the selected page, ordered by validator address.
+func (k Keeper) GetPaginatedDelegatorUnbondingDelegations(
+	ctx sdk.Context, delegator sdk.AccAddress, page, limit int,
+) []types.UnbondingDelegation {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIteratorPaginated(store, types.GetUBDsKey(delegator), uint(page), uint(limit))
+	defer iterator.Close()
+
+	unbondingDelegations := []types.UnbondingDelegation{}
+	for ; iterator.Valid(); iterator.Next() {
+		unbondingDelegations = append(unbondingDelegations, types.MustUnmarshalUBD(k.cdc, iterator.Value()))
+	}
+
+	return unbondingDelegations
+}
+
+// GetPaginatedRedelegations returns the redelegations of a delegator, or of all
+// the delegators if it is empty, in the selected page, ordered by delegator,
+// source and destination validator addresses.
+func (k Keeper) GetPaginatedRedelegations(
+	ctx sdk.Context, delegator sdk.AccAddress, page, limit int,
+) []types.Redelegation {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIteratorPaginated(store, types.GetREDsKey(delegator), uint(page), uint(limit))
+	defer iterator.Close()
+
+	redelegations := []types.Redelegation{}
+	for ; iterator.Valid(); iterator.Next() {
+		redelegations = append(redelegations, types.MustUnmarshalRED(k.cdc, iterator.Value()))
+	}
+
+	return redelegations
+}
+
 // return all redelegations for a delegator
 func (k Keeper) GetAllRedelegations(
 	ctx sdk.Context, delegator sdk.AccAddress, srcValAddress, dstValAddress sdk.ValAddress,
@@ -122,3 +177,17 @@ func (k Keeper) GetAllRedelegations(
 
 	return redelegations
 }
+
+// countKeys returns the number of keys of the store with the given prefix, i.e.
+// the total number of results of a paginated query over that prefix.
+func countKeys(store sdk.KVStore, prefix []byte) int {
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	count := 0
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+	}
+
+	return count
+}
@@ -21,7 +21,10 @@ import (
 // CONTRACT:
 //    Infraction was committed at the current height or at a past height,
 //    not at a height in the future
-func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight int64, power int64, slashFactor sdk.Dec) {
+//
+// It returns the total amount of tokens burned from the validator, its
+// unbonding delegations and its redelegations.
+func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight int64, power int64, slashFactor sdk.Dec) sdk.Int {
 	logger := k.Logger(ctx)
 
 	if slashFactor.IsNegative() {
@@ -29,7 +32,7 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 	}
 
 	// Amount of slashing = slash slashFactor * power at time of infraction
-	amount := sdk.TokensFromConsensusPower(power)
+	amount := k.TokensFromConsensusPower(ctx, power)
 	slashAmountDec := amount.ToDec().Mul(slashFactor)
 	slashAmount := slashAmountDec.TruncateInt()
 
@@ -45,7 +48,7 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 			"WARNING: Ignored attempt to slash a nonexistent validator with address %s, we recommend you investigate immediately",
 			consAddr))
 
-		return
+		return sdk.ZeroInt()
 	}
 
 	// should not be slashing an unbonded validator
@@ -56,12 +59,15 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 	operatorAddress := validator.GetOperator()
 
 	// call the before-modification hook
-	k.BeforeValidatorModified(ctx, operatorAddress)
+	if err := k.BeforeValidatorModified(ctx, operatorAddress); err != nil {
+		logger.Error(fmt.Sprintf("BeforeValidatorModified hook failed: %s", err))
+	}
 
 	// Track remaining slash amount for the validator
 	// This will decrease when we slash unbondings and
 	// redelegations, as that stake has since unbonded
 	remainingSlashAmount := slashAmount
+	burnedAmount := sdk.ZeroInt()
 
 	switch {
 	case infractionHeight > ctx.BlockHeight():
@@ -80,22 +86,24 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 		// Iterate through unbonding delegations from slashed validator
 		unbondingDelegations := k.GetUnbondingDelegationsFromValidator(ctx, operatorAddress)
 		for _, unbondingDelegation := range unbondingDelegations {
-			amountSlashed := k.SlashUnbondingDelegation(ctx, unbondingDelegation, infractionHeight, slashFactor)
+			amountSlashed, amountBurned := k.SlashUnbondingDelegation(ctx, unbondingDelegation, infractionHeight, slashFactor)
 			if amountSlashed.IsZero() {
 				continue
 			}
 
+			burnedAmount = burnedAmount.Add(amountBurned)
 			remainingSlashAmount = remainingSlashAmount.Sub(amountSlashed)
 		}
 
 		// Iterate through redelegations from slashed source validator
 		redelegations := k.GetRedelegationsFromSrcValidator(ctx, operatorAddress)
 		for _, redelegation := range redelegations {
-			amountSlashed := k.SlashRedelegation(ctx, validator, redelegation, infractionHeight, slashFactor)
+			amountSlashed, amountBurned := k.SlashRedelegation(ctx, validator, redelegation, infractionHeight, slashFactor)
 			if amountSlashed.IsZero() {
 				continue
 			}
 
+			burnedAmount = burnedAmount.Add(amountBurned)
 			remainingSlashAmount = remainingSlashAmount.Sub(amountSlashed)
 		}
 	}
@@ -112,12 +120,16 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 			effectiveFraction = sdk.OneDec()
 		}
 		// call the before-slashed hook
-		k.BeforeValidatorSlashed(ctx, operatorAddress, effectiveFraction)
+		if err := k.BeforeValidatorSlashed(ctx, operatorAddress, effectiveFraction); err != nil {
+			logger.Error(fmt.Sprintf("BeforeValidatorSlashed hook failed: %s", err))
+		}
 	}
 
 	// Deduct from validator's bonded tokens and update the validator.
 	// Burn the slashed tokens from the pool account and decrease the total supply.
-	validator = k.RemoveValidatorTokens(ctx, validator, tokensToBurn)
+	slashedValidator := k.RemoveValidatorTokens(ctx, validator, tokensToBurn)
+	k.slashLiquidStake(ctx, validator, slashedValidator)
+	validator = slashedValidator
 
 	switch validator.GetStatus() {
 	case sdk.Bonded:
@@ -136,6 +148,8 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 	logger.Info(fmt.Sprintf(
 		"validator %s slashed by slash factor of %s; burned %v tokens",
 		validator.GetOperator(), slashFactor.String(), tokensToBurn))
+
+	return burnedAmount.Add(tokensToBurn)
 }
 
 // jail a validator
@@ -158,12 +172,12 @@ func (k Keeper) Unjail(ctx sdk.Context, consAddr sdk.ConsAddress) {
 // return the amount that would have been slashed assuming
 // the unbonding delegation had enough stake to slash
 // (the amount actually slashed may be less if there's
-// insufficient stake remaining)
+// insufficient stake remaining) along with the amount burned
 func (k Keeper) SlashUnbondingDelegation(ctx sdk.Context, unbondingDelegation types.UnbondingDelegation,
-	infractionHeight int64, slashFactor sdk.Dec) (totalSlashAmount sdk.Int) {
+	infractionHeight int64, slashFactor sdk.Dec) (totalSlashAmount, burnedAmount sdk.Int) {
 	now := ctx.BlockHeader().Time
 	totalSlashAmount = sdk.ZeroInt()
-	burnedAmount := sdk.ZeroInt()
+	burnedAmount = sdk.ZeroInt()
 
 	// perform slashing on all entries within the unbonding delegation
 	for i, entry := range unbondingDelegation.Entries {
@@ -203,17 +217,17 @@ func (k Keeper) SlashUnbondingDelegation(ctx sdk.Context, unbondingDelegation ty
 		panic(err)
 	}
 
-	return totalSlashAmount
+	return totalSlashAmount, burnedAmount
 }
 
 // slash a redelegation and update the pool
 // return the amount that would have been slashed assuming
 // the unbonding delegation had enough stake to slash
 // (the amount actually slashed may be less if there's
-// insufficient stake remaining)
+// insufficient stake remaining) along with the amount burned
 // NOTE this is only slashing for prior infractions from the source validator
 func (k Keeper) SlashRedelegation(ctx sdk.Context, srcValidator types.Validator, redelegation types.Redelegation,
-	infractionHeight int64, slashFactor sdk.Dec) (totalSlashAmount sdk.Int) {
+	infractionHeight int64, slashFactor sdk.Dec) (totalSlashAmount, burnedAmount sdk.Int) {
 	now := ctx.BlockHeader().Time
 	totalSlashAmount = sdk.ZeroInt()
 	bondedBurnedAmount, notBondedBurnedAmount := sdk.ZeroInt(), sdk.ZeroInt()
@@ -251,7 +265,11 @@ func (k Keeper) SlashRedelegation(ctx sdk.Context, srcValidator types.Validator,
 			sharesToUnbond = delegation.Shares
 		}
 
-		tokensToBurn, err := k.Unbond(ctx, redelegation.DelegatorAddress, redelegation.ValidatorDstAddress, sharesToUnbond)
+		// the errors of the hooks are logged, as the slash can't be aborted, so that
+		// the remaining errors are accounting errors
+		tokensToBurn, err := k.withHookErrorsLogged().Unbond(
+			ctx, redelegation.DelegatorAddress, redelegation.ValidatorDstAddress, sharesToUnbond,
+		)
 		if err != nil {
 			panic(fmt.Errorf("error unbonding delegator: %v", err))
 		}
@@ -281,5 +299,5 @@ func (k Keeper) SlashRedelegation(ctx sdk.Context, srcValidator types.Validator,
 		panic(err)
 	}
 
-	return totalSlashAmount
+	return totalSlashAmount, bondedBurnedAmount.Add(notBondedBurnedAmount)
 }
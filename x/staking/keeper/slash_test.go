@@ -21,7 +21,7 @@ func bootstrapSlashTest(t *testing.T, power int64) (*simapp.SimApp, sdk.Context,
 
 	addrDels, addrVals := generateAddresses(app, ctx, 100)
 
-	amt := sdk.TokensFromConsensusPower(power)
+	amt := sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)
 	totalSupply := sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), amt.MulRaw(int64(len(addrDels)))))
 
 	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
@@ -88,22 +88,25 @@ func TestSlashUnbondingDelegation(t *testing.T) {
 	app.StakingKeeper.SetUnbondingDelegation(ctx, ubd)
 
 	// unbonding started prior to the infraction height, stakw didn't contribute
-	slashAmount := app.StakingKeeper.SlashUnbondingDelegation(ctx, ubd, 1, fraction)
+	slashAmount, burnedAmount := app.StakingKeeper.SlashUnbondingDelegation(ctx, ubd, 1, fraction)
 	require.Equal(t, int64(0), slashAmount.Int64())
+	require.Equal(t, int64(0), burnedAmount.Int64())
 
 	// after the expiration time, no longer eligible for slashing
 	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(10, 0)})
 	app.StakingKeeper.SetUnbondingDelegation(ctx, ubd)
-	slashAmount = app.StakingKeeper.SlashUnbondingDelegation(ctx, ubd, 0, fraction)
+	slashAmount, burnedAmount = app.StakingKeeper.SlashUnbondingDelegation(ctx, ubd, 0, fraction)
 	require.Equal(t, int64(0), slashAmount.Int64())
+	require.Equal(t, int64(0), burnedAmount.Int64())
 
 	// test valid slash, before expiration timestamp and to which stake contributed
 	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
 	oldUnbondedPoolBalances := app.BankKeeper.GetAllBalances(ctx, notBondedPool.GetAddress())
 	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(0, 0)})
 	app.StakingKeeper.SetUnbondingDelegation(ctx, ubd)
-	slashAmount = app.StakingKeeper.SlashUnbondingDelegation(ctx, ubd, 0, fraction)
+	slashAmount, burnedAmount = app.StakingKeeper.SlashUnbondingDelegation(ctx, ubd, 0, fraction)
 	require.Equal(t, int64(5), slashAmount.Int64())
+	require.Equal(t, int64(5), burnedAmount.Int64())
 	ubd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
 	require.True(t, found)
 	require.Len(t, ubd.Entries, 1)
@@ -118,6 +121,43 @@ func TestSlashUnbondingDelegation(t *testing.T) {
 	require.Equal(t, int64(5), diffTokens.AmountOf(app.StakingKeeper.BondDenom(ctx)).Int64())
 }
 
+// tests slashUnbondingDelegation after part of an entry is transferred
+func TestSlashUnbondingDelegationAfterTransfer(t *testing.T) {
+	app, ctx, addrDels, addrVals := bootstrapSlashTest(t, 10)
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(0, 0)})
+
+	fraction := sdk.NewDecWithPrec(5, 1)
+
+	ubd := types.NewUnbondingDelegation(addrDels[0], addrVals[0], 0,
+		time.Unix(5, 0), sdk.NewInt(10))
+	app.StakingKeeper.SetUnbondingDelegation(ctx, ubd)
+
+	transferred, err := app.StakingKeeper.TransferUnbonding(ctx, addrDels[0], addrDels[1], addrVals[0], sdk.NewInt(4))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(4), transferred)
+
+	// the source entry is slashed on the stake it still holds
+	ubd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt(6), ubd.Entries[0].InitialBalance)
+
+	slashAmount, burnedAmount := app.StakingKeeper.SlashUnbondingDelegation(ctx, ubd, 0, fraction)
+	require.Equal(t, int64(3), slashAmount.Int64())
+	require.Equal(t, int64(3), burnedAmount.Int64())
+
+	ubd, found = app.StakingKeeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt(3), ubd.Entries[0].Balance)
+
+	// the transferred stake is slashed on the destination entry
+	ubdTo, found := app.StakingKeeper.GetUnbondingDelegation(ctx, addrDels[1], addrVals[0])
+	require.True(t, found)
+
+	slashAmount, burnedAmount = app.StakingKeeper.SlashUnbondingDelegation(ctx, ubdTo, 0, fraction)
+	require.Equal(t, int64(2), slashAmount.Int64())
+	require.Equal(t, int64(2), burnedAmount.Int64())
+}
+
 // tests slashRedelegation
 func TestSlashRedelegation(t *testing.T) {
 	app, ctx, addrDels, addrVals := bootstrapSlashTest(t, 10)
@@ -145,16 +185,18 @@ func TestSlashRedelegation(t *testing.T) {
 	// started redelegating prior to the current height, stake didn't contribute to infraction
 	validator, found := app.StakingKeeper.GetValidator(ctx, addrVals[1])
 	require.True(t, found)
-	slashAmount := app.StakingKeeper.SlashRedelegation(ctx, validator, rd, 1, fraction)
+	slashAmount, burnedAmount := app.StakingKeeper.SlashRedelegation(ctx, validator, rd, 1, fraction)
 	require.Equal(t, int64(0), slashAmount.Int64())
+	require.Equal(t, int64(0), burnedAmount.Int64())
 
 	// after the expiration time, no longer eligible for slashing
 	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(10, 0)})
 	app.StakingKeeper.SetRedelegation(ctx, rd)
 	validator, found = app.StakingKeeper.GetValidator(ctx, addrVals[1])
 	require.True(t, found)
-	slashAmount = app.StakingKeeper.SlashRedelegation(ctx, validator, rd, 0, fraction)
+	slashAmount, burnedAmount = app.StakingKeeper.SlashRedelegation(ctx, validator, rd, 0, fraction)
 	require.Equal(t, int64(0), slashAmount.Int64())
+	require.Equal(t, int64(0), burnedAmount.Int64())
 
 	balances = app.BankKeeper.GetAllBalances(ctx, bondedPool.GetAddress())
 
@@ -163,8 +205,9 @@ func TestSlashRedelegation(t *testing.T) {
 	app.StakingKeeper.SetRedelegation(ctx, rd)
 	validator, found = app.StakingKeeper.GetValidator(ctx, addrVals[1])
 	require.True(t, found)
-	slashAmount = app.StakingKeeper.SlashRedelegation(ctx, validator, rd, 0, fraction)
+	slashAmount, burnedAmount = app.StakingKeeper.SlashRedelegation(ctx, validator, rd, 0, fraction)
 	require.Equal(t, int64(5), slashAmount.Int64())
+	require.Equal(t, int64(5), burnedAmount.Int64())
 	rd, found = app.StakingKeeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
 	require.True(t, found)
 	require.Len(t, rd.Entries, 1)
@@ -186,6 +229,45 @@ func TestSlashRedelegation(t *testing.T) {
 	require.Equal(t, balances.Sub(burnedCoins), app.BankKeeper.GetAllBalances(ctx, bondedPool.GetAddress()))
 }
 
+// tests that the errors of the hooks called by the unbonding of a slashed
+// redelegation are logged instead of aborting the slash
+func TestSlashRedelegationHookError(t *testing.T) {
+	app, ctx, addrDels, addrVals := bootstrapSlashTest(t, 10)
+	app.StakingKeeper = *app.StakingKeeper.SetHooks(vetoHooks{})
+
+	startCoins := sdk.NewCoins(sdk.NewInt64Coin(app.StakingKeeper.BondDenom(ctx), 10))
+	bondedPool := app.StakingKeeper.GetBondedPool(ctx)
+	balances := app.BankKeeper.GetAllBalances(ctx, bondedPool.GetAddress())
+
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, bondedPool.GetAddress(), balances.Add(startCoins...)))
+	app.AccountKeeper.SetModuleAccount(ctx, bondedPool)
+
+	rd := types.NewRedelegation(addrDels[0], addrVals[0], addrVals[1], 0,
+		time.Unix(5, 0), sdk.NewInt(10), sdk.NewDec(10))
+	app.StakingKeeper.SetRedelegation(ctx, rd)
+
+	del := types.NewDelegation(addrDels[0], addrVals[1], sdk.NewDec(10))
+	app.StakingKeeper.SetDelegation(ctx, del)
+
+	// the whole delegation is unbonded, calling the failing BeforeDelegationRemoved hook
+	validator, found := app.StakingKeeper.GetValidator(ctx, addrVals[1])
+	require.True(t, found)
+
+	var slashAmount, burnedAmount sdk.Int
+	require.NotPanics(t, func() {
+		slashAmount, burnedAmount = app.StakingKeeper.SlashRedelegation(ctx, validator, rd, 0, sdk.OneDec())
+	})
+	require.Equal(t, int64(10), slashAmount.Int64())
+	require.Equal(t, int64(10), burnedAmount.Int64())
+
+	_, found = app.StakingKeeper.GetDelegation(ctx, addrDels[0], addrVals[1])
+	require.False(t, found)
+
+	// the mode of the keeper is unchanged
+	_, err := app.StakingKeeper.Delegate(ctx, addrDels[0], sdk.NewInt(10), sdk.Unbonded, validator, true)
+	require.Equal(t, errVetoed, err)
+}
+
 // tests Slash at a future height (must panic)
 func TestSlashAtFutureHeight(t *testing.T) {
 	app, ctx, _, _ := bootstrapSlashTest(t, 10)
@@ -220,12 +302,65 @@ func TestSlashAtNegativeHeight(t *testing.T) {
 	validator, found = app.StakingKeeper.GetValidator(ctx, validator.OperatorAddress)
 	require.True(t, found)
 	// power decreased
-	require.Equal(t, int64(5), validator.GetConsensusPower())
+	require.Equal(t, int64(5), validator.GetConsensusPower(sdk.DefaultPowerReduction))
 
 	// pool bonded shares decreased
 	newBondedPoolBalances := app.BankKeeper.GetAllBalances(ctx, bondedPool.GetAddress())
 	diffTokens := oldBondedPoolBalances.Sub(newBondedPoolBalances).AmountOf(app.StakingKeeper.BondDenom(ctx))
-	require.Equal(t, sdk.TokensFromConsensusPower(5).String(), diffTokens.String())
+	require.Equal(t, sdk.TokensFromConsensusPower(5, sdk.DefaultPowerReduction).String(), diffTokens.String())
+}
+
+// tests that a validator slashed below its minimum self delegation is jailed
+func TestJailValidatorsBelowMinSelfDelegation(t *testing.T) {
+	app, ctx, _, addrVals := bootstrapSlashTest(t, 10)
+	consAddr := sdk.ConsAddress(PKs[0].Address())
+
+	// self-delegate all the tokens of the first two validators, the first one
+	// requiring all of them as its minimum self delegation
+	for i, minSelfDelegation := range []sdk.Int{sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction), sdk.OneInt()} {
+		validator, found := app.StakingKeeper.GetValidator(ctx, addrVals[i])
+		require.True(t, found)
+
+		validator.MinSelfDelegation = minSelfDelegation
+		app.StakingKeeper.SetValidator(ctx, validator)
+		app.StakingKeeper.SetDelegation(ctx, types.NewDelegation(sdk.AccAddress(addrVals[i]), addrVals[i], validator.DelegatorShares))
+		require.Equal(t, validator.Tokens, app.StakingKeeper.GetValidatorSelfBond(ctx, validator))
+	}
+
+	// only the validator without a self-delegation is jailed
+	app.StakingKeeper.JailValidatorsBelowMinSelfDelegation(ctx)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	require.False(t, validator.IsJailed())
+
+	validator, found = app.StakingKeeper.GetValidator(ctx, addrVals[2])
+	require.True(t, found)
+	require.True(t, validator.IsJailed())
+
+	app.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), 10, sdk.NewDecWithPrec(1, 1))
+	app.StakingKeeper.Slash(ctx, sdk.ConsAddress(PKs[1].Address()), ctx.BlockHeight(), 10, sdk.NewDecWithPrec(1, 1))
+
+	validator, found = app.StakingKeeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	require.Equal(t, sdk.TokensFromConsensusPower(9, sdk.DefaultPowerReduction), app.StakingKeeper.GetValidatorSelfBond(ctx, validator))
+
+	app.StakingKeeper.JailValidatorsBelowMinSelfDelegation(ctx)
+
+	validator, found = app.StakingKeeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	require.True(t, validator.IsJailed())
+
+	validator, found = app.StakingKeeper.GetValidator(ctx, addrVals[1])
+	require.True(t, found)
+	require.False(t, validator.IsJailed())
+
+	// the jailed validators are removed from the validator set
+	updates := app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.Equal(t, 3, len(updates))
+	require.Equal(t, int64(0), app.StakingKeeper.GetLastValidatorPower(ctx, addrVals[0]))
+	require.Equal(t, int64(9), app.StakingKeeper.GetLastValidatorPower(ctx, addrVals[1]))
+	require.Equal(t, int64(0), app.StakingKeeper.GetLastValidatorPower(ctx, addrVals[2]))
 }
 
 // tests Slash at the current height
@@ -239,7 +374,8 @@ func TestSlashValidatorAtCurrentHeight(t *testing.T) {
 
 	validator, found := app.StakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
 	require.True(t, found)
-	app.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), 10, fraction)
+	burned := app.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), 10, fraction)
+	require.Equal(t, sdk.TokensFromConsensusPower(5, sdk.DefaultPowerReduction), burned)
 
 	// read updated state
 	validator, found = app.StakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
@@ -252,12 +388,12 @@ func TestSlashValidatorAtCurrentHeight(t *testing.T) {
 	validator, found = app.StakingKeeper.GetValidator(ctx, validator.OperatorAddress)
 	assert.True(t, found)
 	// power decreased
-	require.Equal(t, int64(5), validator.GetConsensusPower())
+	require.Equal(t, int64(5), validator.GetConsensusPower(sdk.DefaultPowerReduction))
 
 	// pool bonded shares decreased
 	newBondedPoolBalances := app.BankKeeper.GetAllBalances(ctx, bondedPool.GetAddress())
 	diffTokens := oldBondedPoolBalances.Sub(newBondedPoolBalances).AmountOf(app.StakingKeeper.BondDenom(ctx))
-	require.Equal(t, sdk.TokensFromConsensusPower(5).String(), diffTokens.String())
+	require.Equal(t, sdk.TokensFromConsensusPower(5, sdk.DefaultPowerReduction).String(), diffTokens.String())
 }
 
 // tests Slash at a previous height with an unbonding delegation
@@ -269,7 +405,7 @@ func TestSlashWithUnbondingDelegation(t *testing.T) {
 
 	// set an unbonding delegation with expiration timestamp beyond which the
 	// unbonding delegation shouldn't be slashed
-	ubdTokens := sdk.TokensFromConsensusPower(4)
+	ubdTokens := sdk.TokensFromConsensusPower(4, sdk.DefaultPowerReduction)
 	ubd := types.NewUnbondingDelegation(addrDels[0], addrVals[0], 11,
 		time.Unix(0, 0), ubdTokens)
 	app.StakingKeeper.SetUnbondingDelegation(ctx, ubd)
@@ -281,7 +417,10 @@ func TestSlashWithUnbondingDelegation(t *testing.T) {
 
 	validator, found := app.StakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
 	require.True(t, found)
-	app.StakingKeeper.Slash(ctx, consAddr, 10, 10, fraction)
+	burned := app.StakingKeeper.Slash(ctx, consAddr, 10, 10, fraction)
+
+	// the tokens burned from both the unbonding delegation and the validator
+	require.Equal(t, sdk.TokensFromConsensusPower(5, sdk.DefaultPowerReduction), burned)
 
 	// end block
 	updates := app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
@@ -293,12 +432,12 @@ func TestSlashWithUnbondingDelegation(t *testing.T) {
 	require.Len(t, ubd.Entries, 1)
 
 	// balance decreased
-	require.Equal(t, sdk.TokensFromConsensusPower(2), ubd.Entries[0].Balance)
+	require.Equal(t, sdk.TokensFromConsensusPower(2, sdk.DefaultPowerReduction), ubd.Entries[0].Balance)
 
 	// bonded tokens burned
 	newBondedPoolBalances := app.BankKeeper.GetAllBalances(ctx, bondedPool.GetAddress())
 	diffTokens := oldBondedPoolBalances.Sub(newBondedPoolBalances).AmountOf(app.StakingKeeper.BondDenom(ctx))
-	require.Equal(t, sdk.TokensFromConsensusPower(3), diffTokens)
+	require.Equal(t, sdk.TokensFromConsensusPower(3, sdk.DefaultPowerReduction), diffTokens)
 
 	// read updated validator
 	validator, found = app.StakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
@@ -308,7 +447,7 @@ func TestSlashWithUnbondingDelegation(t *testing.T) {
 	// was still bonded at the time of discovery and was slashed by half, 4 stake
 	// bonded at the time of discovery hadn't been bonded at the time of infraction
 	// and wasn't slashed
-	require.Equal(t, int64(7), validator.GetConsensusPower())
+	require.Equal(t, int64(7), validator.GetConsensusPower(sdk.DefaultPowerReduction))
 
 	// slash validator again
 	ctx = ctx.WithBlockHeight(13)
@@ -324,14 +463,14 @@ func TestSlashWithUnbondingDelegation(t *testing.T) {
 	// bonded tokens burned again
 	newBondedPoolBalances = app.BankKeeper.GetAllBalances(ctx, bondedPool.GetAddress())
 	diffTokens = oldBondedPoolBalances.Sub(newBondedPoolBalances).AmountOf(app.StakingKeeper.BondDenom(ctx))
-	require.Equal(t, sdk.TokensFromConsensusPower(6), diffTokens)
+	require.Equal(t, sdk.TokensFromConsensusPower(6, sdk.DefaultPowerReduction), diffTokens)
 
 	// read updated validator
 	validator, found = app.StakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
 	require.True(t, found)
 
 	// power decreased by 3 again
-	require.Equal(t, int64(4), validator.GetConsensusPower())
+	require.Equal(t, int64(4), validator.GetConsensusPower(sdk.DefaultPowerReduction))
 
 	// slash validator again
 	// all originally bonded stake has been slashed, so this will have no effect
@@ -350,14 +489,14 @@ func TestSlashWithUnbondingDelegation(t *testing.T) {
 	// bonded tokens burned again
 	newBondedPoolBalances = app.BankKeeper.GetAllBalances(ctx, bondedPool.GetAddress())
 	diffTokens = oldBondedPoolBalances.Sub(newBondedPoolBalances).AmountOf(app.StakingKeeper.BondDenom(ctx))
-	require.Equal(t, sdk.TokensFromConsensusPower(9), diffTokens)
+	require.Equal(t, sdk.TokensFromConsensusPower(9, sdk.DefaultPowerReduction), diffTokens)
 
 	// read updated validator
 	validator, found = app.StakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
 	require.True(t, found)
 
 	// power decreased by 3 again
-	require.Equal(t, int64(1), validator.GetConsensusPower())
+	require.Equal(t, int64(1), validator.GetConsensusPower(sdk.DefaultPowerReduction))
 
 	// slash validator again
 	// all originally bonded stake has been slashed, so this will have no effect
@@ -376,7 +515,7 @@ func TestSlashWithUnbondingDelegation(t *testing.T) {
 	// just 1 bonded token burned again since that's all the validator now has
 	newBondedPoolBalances = app.BankKeeper.GetAllBalances(ctx, bondedPool.GetAddress())
 	diffTokens = oldBondedPoolBalances.Sub(newBondedPoolBalances).AmountOf(app.StakingKeeper.BondDenom(ctx))
-	require.Equal(t, sdk.TokensFromConsensusPower(10), diffTokens)
+	require.Equal(t, sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction), diffTokens)
 
 	// apply TM updates
 	app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
@@ -397,7 +536,7 @@ func TestSlashWithRedelegation(t *testing.T) {
 	bondDenom := app.StakingKeeper.BondDenom(ctx)
 
 	// set a redelegation
-	rdTokens := sdk.TokensFromConsensusPower(6)
+	rdTokens := sdk.TokensFromConsensusPower(6, sdk.DefaultPowerReduction)
 	rd := types.NewRedelegation(addrDels[0], addrVals[0], addrVals[1], 11,
 		time.Unix(0, 0), rdTokens, rdTokens.ToDec())
 	app.StakingKeeper.SetRedelegation(ctx, rd)
@@ -427,7 +566,7 @@ func TestSlashWithRedelegation(t *testing.T) {
 	require.True(t, found)
 
 	require.NotPanics(t, func() { app.StakingKeeper.Slash(ctx, consAddr, 10, 10, fraction) })
-	burnAmount := sdk.TokensFromConsensusPower(10).ToDec().Mul(fraction).TruncateInt()
+	burnAmount := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction).ToDec().Mul(fraction).TruncateInt()
 
 	bondedPool = app.StakingKeeper.GetBondedPool(ctx)
 	notBondedPool = app.StakingKeeper.GetNotBondedPool(ctx)
@@ -451,14 +590,14 @@ func TestSlashWithRedelegation(t *testing.T) {
 	// was still bonded at the time of discovery and was slashed by half, 4 stake
 	// bonded at the time of discovery hadn't been bonded at the time of infraction
 	// and wasn't slashed
-	require.Equal(t, int64(8), validator.GetConsensusPower())
+	require.Equal(t, int64(8), validator.GetConsensusPower(sdk.DefaultPowerReduction))
 
 	// slash the validator again
 	validator, found = app.StakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
 	require.True(t, found)
 
 	require.NotPanics(t, func() { app.StakingKeeper.Slash(ctx, consAddr, 10, 10, sdk.OneDec()) })
-	burnAmount = sdk.TokensFromConsensusPower(7)
+	burnAmount = sdk.TokensFromConsensusPower(7, sdk.DefaultPowerReduction)
 
 	// read updated pool
 	bondedPool = app.StakingKeeper.GetBondedPool(ctx)
@@ -484,7 +623,7 @@ func TestSlashWithRedelegation(t *testing.T) {
 	validator, found = app.StakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
 	require.True(t, found)
 	// power decreased by 4
-	require.Equal(t, int64(4), validator.GetConsensusPower())
+	require.Equal(t, int64(4), validator.GetConsensusPower(sdk.DefaultPowerReduction))
 
 	// slash the validator again, by 100%
 	ctx = ctx.WithBlockHeight(12)
@@ -493,7 +632,7 @@ func TestSlashWithRedelegation(t *testing.T) {
 
 	require.NotPanics(t, func() { app.StakingKeeper.Slash(ctx, consAddr, 10, 10, sdk.OneDec()) })
 
-	burnAmount = sdk.TokensFromConsensusPower(10).ToDec().Mul(sdk.OneDec()).TruncateInt()
+	burnAmount = sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction).ToDec().Mul(sdk.OneDec()).TruncateInt()
 	burnAmount = burnAmount.Sub(sdk.OneDec().MulInt(rdTokens).TruncateInt())
 
 	// read updated pool
@@ -553,7 +692,7 @@ func TestSlashBoth(t *testing.T) {
 
 	// set a redelegation with expiration timestamp beyond which the
 	// redelegation shouldn't be slashed
-	rdATokens := sdk.TokensFromConsensusPower(6)
+	rdATokens := sdk.TokensFromConsensusPower(6, sdk.DefaultPowerReduction)
 	rdA := types.NewRedelegation(addrDels[0], addrVals[0], addrVals[1], 11,
 		time.Unix(0, 0), rdATokens,
 		rdATokens.ToDec())
@@ -565,7 +704,7 @@ func TestSlashBoth(t *testing.T) {
 
 	// set an unbonding delegation with expiration timestamp (beyond which the
 	// unbonding delegation shouldn't be slashed)
-	ubdATokens := sdk.TokensFromConsensusPower(4)
+	ubdATokens := sdk.TokensFromConsensusPower(4, sdk.DefaultPowerReduction)
 	ubdA := types.NewUnbondingDelegation(addrDels[0], addrVals[0], 11,
 		time.Unix(0, 0), ubdATokens)
 	app.StakingKeeper.SetUnbondingDelegation(ctx, ubdA)
@@ -596,7 +735,7 @@ func TestSlashBoth(t *testing.T) {
 	app.StakingKeeper.Slash(ctx, consAddr0, 10, 10, fraction)
 
 	burnedNotBondedAmount := fraction.MulInt(ubdATokens).TruncateInt()
-	burnedBondAmount := sdk.TokensFromConsensusPower(10).ToDec().Mul(fraction).TruncateInt()
+	burnedBondAmount := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction).ToDec().Mul(fraction).TruncateInt()
 	burnedBondAmount = burnedBondAmount.Sub(burnedNotBondedAmount)
 
 	// read updated pool
@@ -617,5 +756,5 @@ func TestSlashBoth(t *testing.T) {
 	validator, found = app.StakingKeeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(PKs[0]))
 	require.True(t, found)
 	// power not decreased, all stake was bonded since
-	require.Equal(t, int64(10), validator.GetConsensusPower())
+	require.Equal(t, int64(10), validator.GetConsensusPower(sdk.DefaultPowerReduction))
 }
@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetUnbondingID returns the last unbonding delegation entry ID.
+func (k Keeper) GetUnbondingID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.UnbondingIDKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetUnbondingID sets the last unbonding delegation entry ID.
+func (k Keeper) SetUnbondingID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.UnbondingIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// IncrementUnbondingID increments and returns a unique ID for an unbonding
+// delegation entry.
+func (k Keeper) IncrementUnbondingID(ctx sdk.Context) uint64 {
+	id := k.GetUnbondingID(ctx) + 1
+	k.SetUnbondingID(ctx, id)
+
+	return id
+}
+
+// SetUnbondingDelegationByUnbondingID indexes the unbonding delegation by the
+// ID of one of its entries.
+func (k Keeper) SetUnbondingDelegationByUnbondingID(ctx sdk.Context, ubd types.UnbondingDelegation, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetUnbondingIndexKey(id), types.GetUBDKey(ubd.DelegatorAddress, ubd.ValidatorAddress))
+}
+
+// DeleteUnbondingIndex removes the index of an unbonding delegation entry ID.
+func (k Keeper) DeleteUnbondingIndex(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetUnbondingIndexKey(id))
+}
+
+// GetUnbondingDelegationByUnbondingID returns the unbonding delegation holding
+// the entry with the given ID, along with the index of the entry.
+func (k Keeper) GetUnbondingDelegationByUnbondingID(
+	ctx sdk.Context, id uint64,
+) (ubd types.UnbondingDelegation, entryIndex int, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	ubdKey := store.Get(types.GetUnbondingIndexKey(id))
+	if ubdKey == nil {
+		return ubd, -1, false
+	}
+
+	value := store.Get(ubdKey)
+	if value == nil {
+		return ubd, -1, false
+	}
+
+	ubd = types.MustUnmarshalUBD(k.cdc, value)
+	for i, entry := range ubd.Entries {
+		if entry.UnbondingId == id {
+			return ubd, i, true
+		}
+	}
+
+	return ubd, -1, false
+}
+
+// PutUnbondingOnHold puts a hold on the unbonding delegation entry with the
+// given ID, so that it does not complete when it matures. Every hold must be
+// released with UnbondingCanComplete before the entry can complete.
+func (k Keeper) PutUnbondingOnHold(ctx sdk.Context, id uint64) error {
+	ubd, i, found := k.GetUnbondingDelegationByUnbondingID(ctx, id)
+	if !found {
+		return types.ErrUnbondingNotFound
+	}
+
+	ubd.Entries[i].UnbondingOnHoldRefCount++
+	k.SetUnbondingDelegation(ctx, ubd)
+
+	return nil
+}
+
+// UnbondingCanComplete releases a hold put on the unbonding delegation entry
+// with the given ID. Once all of its holds are released, an entry that already
+// matured completes immediately, while any other entry completes at maturity.
+func (k Keeper) UnbondingCanComplete(ctx sdk.Context, id uint64) error {
+	ubd, i, found := k.GetUnbondingDelegationByUnbondingID(ctx, id)
+	if !found {
+		return types.ErrUnbondingNotFound
+	}
+
+	entry := ubd.Entries[i]
+	if entry.UnbondingOnHoldRefCount <= 0 {
+		return types.ErrUnbondingOnHoldRefCountNegative
+	}
+
+	entry.UnbondingOnHoldRefCount--
+	ubd.Entries[i] = entry
+
+	if entry.OnHold() || !entry.IsMature(ctx.BlockHeader().Time) {
+		k.SetUnbondingDelegation(ctx, ubd)
+		return nil
+	}
+
+	ubd.RemoveEntry(int64(i))
+	k.DeleteUnbondingIndex(ctx, id)
+
+	balances := sdk.NewCoins()
+	if !entry.Balance.IsZero() {
+		amt := sdk.NewCoin(k.BondDenom(ctx), entry.Balance)
+		if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(
+			ctx, types.NotBondedPoolName, ubd.DelegatorAddress, sdk.NewCoins(amt),
+		); err != nil {
+			return err
+		}
+
+		balances = balances.Add(amt)
+	}
+
+	// set the unbonding delegation or remove it if there are no more entries
+	if len(ubd.Entries) == 0 {
+		k.RemoveUnbondingDelegation(ctx, ubd)
+	} else {
+		k.SetUnbondingDelegation(ctx, ubd)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCompleteUnbonding,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, balances.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, ubd.ValidatorAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, ubd.DelegatorAddress.String()),
+		),
+	)
+
+	return nil
+}
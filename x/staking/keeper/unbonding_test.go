@@ -0,0 +1,132 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// holdingHooks puts a hold on every unbonding delegation entry initiated while
+// hold is set.
+type holdingHooks struct {
+	types.MultiStakingHooks
+
+	k    keeper.Keeper
+	hold bool
+	ids  []uint64
+}
+
+func (h *holdingHooks) AfterUnbondingInitiated(ctx sdk.Context, id uint64) error {
+	h.ids = append(h.ids, id)
+
+	if h.hold {
+		return h.k.PutUnbondingOnHold(ctx, id)
+	}
+
+	return nil
+}
+
+func TestUnbondingOnHold(t *testing.T) {
+	_, app, ctx := createTestInput()
+
+	delAddrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(10000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(delAddrs)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	startTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
+
+	require.NoError(t,
+		app.BankKeeper.SetBalances(ctx, notBondedPool.GetAddress(), sdk.NewCoins(sdk.NewCoin(bondDenom, startTokens))),
+	)
+	app.AccountKeeper.SetModuleAccount(ctx, notBondedPool)
+
+	hooks := &holdingHooks{hold: true}
+	app.StakingKeeper = *app.StakingKeeper.SetHooks(hooks)
+	hooks.k = app.StakingKeeper
+
+	validator := types.NewValidator(valAddrs[0], PKs[0], types.Description{})
+	validator, issuedShares := validator.AddTokensFromDel(startTokens)
+	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
+
+	delegation := types.NewDelegation(delAddrs[0], valAddrs[0], issuedShares)
+	app.StakingKeeper.SetDelegation(ctx, delegation)
+
+	unbondTokens := sdk.TokensFromConsensusPower(1, sdk.DefaultPowerReduction)
+	initBalance := app.BankKeeper.GetBalance(ctx, delAddrs[0], bondDenom).Amount
+
+	// two entries put on hold and one which is not
+	completionTime, err := app.StakingKeeper.Undelegate(ctx, delAddrs[0], valAddrs[0], unbondTokens.ToDec())
+	require.NoError(t, err)
+	_, err = app.StakingKeeper.Undelegate(ctx, delAddrs[0], valAddrs[0], unbondTokens.ToDec())
+	require.NoError(t, err)
+
+	hooks.hold = false
+	_, err = app.StakingKeeper.Undelegate(ctx, delAddrs[0], valAddrs[0], unbondTokens.ToDec())
+	require.NoError(t, err)
+
+	require.Equal(t, []uint64{1, 2, 3}, hooks.ids)
+
+	ubd, i, found := app.StakingKeeper.GetUnbondingDelegationByUnbondingID(ctx, 2)
+	require.True(t, found)
+	require.Equal(t, 1, i)
+	require.Equal(t, uint64(2), ubd.Entries[i].UnbondingId)
+	require.True(t, ubd.Entries[i].OnHold())
+	require.False(t, ubd.Entries[2].OnHold())
+
+	_, _, found = app.StakingKeeper.GetUnbondingDelegationByUnbondingID(ctx, 4)
+	require.False(t, found)
+
+	// an entry which is not on hold cannot be released
+	require.True(t, types.ErrUnbondingOnHoldRefCountNegative.Is(app.StakingKeeper.UnbondingCanComplete(ctx, 3)))
+	require.True(t, types.ErrUnbondingNotFound.Is(app.StakingKeeper.UnbondingCanComplete(ctx, 4)))
+
+	// a hold released before maturity does not complete the entry
+	require.NoError(t, app.StakingKeeper.PutUnbondingOnHold(ctx, 3))
+	require.NoError(t, app.StakingKeeper.UnbondingCanComplete(ctx, 3))
+
+	ubd, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delAddrs[0], valAddrs[0])
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 3)
+
+	// only the entry which is not on hold completes at maturity
+	ctx = ctx.WithBlockTime(completionTime)
+	app.StakingKeeper.BlockValidatorUpdates(ctx)
+
+	ubd, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delAddrs[0], valAddrs[0])
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 2)
+	require.Equal(t, initBalance.Add(unbondTokens), app.BankKeeper.GetBalance(ctx, delAddrs[0], bondDenom).Amount)
+
+	_, _, found = app.StakingKeeper.GetUnbondingDelegationByUnbondingID(ctx, 3)
+	require.False(t, found)
+
+	// a mature entry completes once all of its holds are released
+	require.NoError(t, app.StakingKeeper.PutUnbondingOnHold(ctx, 1))
+	require.NoError(t, app.StakingKeeper.UnbondingCanComplete(ctx, 1))
+
+	ubd, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delAddrs[0], valAddrs[0])
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 2)
+
+	require.NoError(t, app.StakingKeeper.UnbondingCanComplete(ctx, 1))
+
+	ubd, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delAddrs[0], valAddrs[0])
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 1)
+	require.Equal(t, uint64(2), ubd.Entries[0].UnbondingId)
+	require.Equal(t, initBalance.Add(unbondTokens.MulRaw(2)), app.BankKeeper.GetBalance(ctx, delAddrs[0], bondDenom).Amount)
+	require.True(t, types.ErrUnbondingNotFound.Is(app.StakingKeeper.UnbondingCanComplete(ctx, 1)))
+
+	// the unbonding delegation is removed along with its last entry
+	require.NoError(t, app.StakingKeeper.UnbondingCanComplete(ctx, 2))
+
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delAddrs[0], valAddrs[0])
+	require.False(t, found)
+	require.Equal(t, initBalance.Add(unbondTokens.MulRaw(3)), app.BankKeeper.GetBalance(ctx, delAddrs[0], bondDenom).Amount)
+}
@@ -12,6 +12,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
+// IsEpochEnd returns whether the current block is the last block of an epoch,
+// i.e. whether the validator set is updated at the end of the block.
+func (k Keeper) IsEpochEnd(ctx sdk.Context) bool {
+	return ctx.BlockHeight()%int64(k.EpochLength(ctx)) == 0
+}
+
 // Calculate the ValidatorUpdates for the current block
 // Called in each EndBlock
 func (k Keeper) BlockValidatorUpdates(ctx sdk.Context) []abci.ValidatorUpdate {
@@ -24,7 +30,22 @@ func (k Keeper) BlockValidatorUpdates(ctx sdk.Context) []abci.ValidatorUpdate {
 	// unbonded after the Endblocker (go from Bonded -> Unbonding during
 	// ApplyAndReturnValidatorSetUpdates and then Unbonding -> Unbonded during
 	// UnbondAllMatureValidatorQueue).
-	validatorUpdates := k.ApplyAndReturnValidatorSetUpdates(ctx)
+	//
+	// Validators whose self-delegation dropped below their minimum self
+	// delegation are jailed first so that they are removed from the set.
+	//
+	// The validator set is only updated at the end of an epoch. Until then,
+	// the changes to the validators' tokens, e.g. from slashes, are only
+	// recorded in the power index, except for the jailed validators, e.g. the
+	// tombstoned ones, which are removed from the validator set right away.
+	k.JailValidatorsBelowMinSelfDelegation(ctx)
+
+	var validatorUpdates []abci.ValidatorUpdate
+	if k.IsEpochEnd(ctx) {
+		validatorUpdates = k.ApplyAndReturnValidatorSetUpdates(ctx)
+	} else {
+		validatorUpdates = k.ApplyAndReturnJailedValidatorUpdates(ctx)
+	}
 
 	// Unbond all mature validators from the unbonding queue.
 	k.UnbondAllMatureValidatorQueue(ctx)
@@ -112,7 +133,7 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 
 		// if we get to a zero-power validator (which we don't bond),
 		// there are no more possible bonded validators
-		if validator.PotentialConsensusPower() == 0 {
+		if validator.PotentialConsensusPower(k.PowerReduction(ctx)) == 0 {
 			break
 		}
 
@@ -135,12 +156,12 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 
 		copy(valAddrBytes[:], valAddr[:])
 		oldPowerBytes, found := last[valAddrBytes]
-		newPower := validator.ConsensusPower()
+		newPower := validator.ConsensusPower(k.PowerReduction(ctx))
 		newPowerBytes := k.cdc.MustMarshalBinaryBare(&gogotypes.Int64Value{Value: newPower})
 
 		// update the validator set if power has changed
 		if !found || !bytes.Equal(oldPowerBytes, newPowerBytes) {
-			updates = append(updates, validator.ABCIValidatorUpdate())
+			updates = append(updates, validator.ABCIValidatorUpdate(k.PowerReduction(ctx)))
 
 			k.SetLastValidatorPower(ctx, valAddr, newPower)
 		}
@@ -183,6 +204,44 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 	return updates
 }
 
+// ApplyAndReturnJailedValidatorUpdates removes the jailed validators from the
+// bonded validator set and returns their zero-power updates, leaving the rest of
+// the validator set unchanged. It gets called at every EndBlock within an epoch,
+// so that the jailed validators stop validating without waiting for its end.
+func (k Keeper) ApplyAndReturnJailedValidatorUpdates(ctx sdk.Context) (updates []abci.ValidatorUpdate) {
+	totalPower := k.GetLastTotalPower(ctx)
+	amtFromBondedToNotBonded := sdk.ZeroInt()
+
+	last := k.getLastValidatorsByAddr(ctx)
+	for _, valAddrBytes := range sortNoLongerBonded(last) {
+		validator := k.mustGetValidator(ctx, sdk.ValAddress(valAddrBytes))
+		if !validator.Jailed {
+			continue
+		}
+
+		var valAddr [sdk.AddrLen]byte
+
+		copy(valAddr[:], valAddrBytes)
+
+		var oldPower gogotypes.Int64Value
+		k.cdc.MustUnmarshalBinaryBare(last[valAddr], &oldPower)
+
+		validator = k.bondedToUnbonding(ctx, validator)
+		amtFromBondedToNotBonded = amtFromBondedToNotBonded.Add(validator.GetTokens())
+		k.DeleteLastValidatorPower(ctx, validator.GetOperator())
+		updates = append(updates, validator.ABCIValidatorUpdateZero())
+
+		totalPower = totalPower.Sub(sdk.NewInt(oldPower.Value))
+	}
+
+	if len(updates) > 0 {
+		k.bondedTokensToNotBonded(ctx, amtFromBondedToNotBonded)
+		k.SetLastTotalPower(ctx, totalPower)
+	}
+
+	return updates
+}
+
 // Validator state transitions
 
 func (k Keeper) bondedToUnbonding(ctx sdk.Context, validator types.Validator) types.Validator {
@@ -227,6 +286,39 @@ func (k Keeper) jailValidator(ctx sdk.Context, validator types.Validator) {
 	validator.Jailed = true
 	k.SetValidator(ctx, validator)
 	k.DeleteValidatorByPowerIndex(ctx, validator)
+
+	// trigger hook
+	if err := k.AfterValidatorJailed(ctx, validator.GetConsAddr(), validator.OperatorAddress); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("AfterValidatorJailed hook failed: %s", err))
+	}
+}
+
+// JailValidatorsBelowMinSelfDelegation jails the bonded validators whose
+// self-delegation is worth less than their minimum self delegation, e.g. after
+// the validator was slashed.
+func (k Keeper) JailValidatorsBelowMinSelfDelegation(ctx sdk.Context) {
+	var validators []types.Validator
+
+	k.IterateLastValidatorPowers(ctx, func(operator sdk.ValAddress, _ int64) bool {
+		validator := k.mustGetValidator(ctx, operator)
+		if !validator.Jailed && k.GetValidatorSelfBond(ctx, validator).LT(validator.MinSelfDelegation) {
+			validators = append(validators, validator)
+		}
+
+		return false
+	})
+
+	for _, validator := range validators {
+		k.jailValidator(ctx, validator)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeJailValidator,
+				sdk.NewAttribute(types.AttributeKeyValidator, validator.OperatorAddress.String()),
+				sdk.NewAttribute(types.AttributeKeyMinSelfDelegation, validator.MinSelfDelegation.String()),
+			),
+		)
+	}
 }
 
 // remove a validator from jail
@@ -255,7 +347,9 @@ func (k Keeper) bondValidator(ctx sdk.Context, validator types.Validator) types.
 	k.DeleteValidatorQueue(ctx, validator)
 
 	// trigger hook
-	k.AfterValidatorBonded(ctx, validator.GetConsAddr(), validator.OperatorAddress)
+	if err := k.AfterValidatorBonded(ctx, validator.GetConsAddr(), validator.OperatorAddress); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("AfterValidatorBonded hook failed: %s", err))
+	}
 
 	return validator
 }
@@ -286,7 +380,9 @@ func (k Keeper) beginUnbondingValidator(ctx sdk.Context, validator types.Validat
 	k.InsertValidatorQueue(ctx, validator)
 
 	// trigger hook
-	k.AfterValidatorBeginUnbonding(ctx, validator.GetConsAddr(), validator.OperatorAddress)
+	if err := k.AfterValidatorBeginUnbonding(ctx, validator.GetConsAddr(), validator.OperatorAddress); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("AfterValidatorBeginUnbonding hook failed: %s", err))
+	}
 
 	return validator
 }
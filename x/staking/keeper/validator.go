@@ -93,11 +93,22 @@ func (k Keeper) mustGetValidatorByConsAddr(ctx sdk.Context, consAddr sdk.ConsAdd
 	return validator
 }
 
-// set the main record holding validator details
+// set the main record holding validator details, along with its bond status index
 func (k Keeper) SetValidator(ctx sdk.Context, validator types.Validator) {
 	store := ctx.KVStore(k.storeKey)
+	key := types.GetValidatorKey(validator.OperatorAddress)
+
+	// move the validator in the bond status index if its status changed
+	if value := store.Get(key); value != nil {
+		oldStatus := types.MustUnmarshalValidator(k.cdc, value).Status
+		if oldStatus != validator.Status {
+			store.Delete(types.GetValidatorByStatusKey(oldStatus, validator.OperatorAddress))
+		}
+	}
+
 	bz := types.MustMarshalValidator(k.cdc, validator)
-	store.Set(types.GetValidatorKey(validator.OperatorAddress), bz)
+	store.Set(key, bz)
+	store.Set(types.GetValidatorByStatusKey(validator.Status, validator.OperatorAddress), []byte{})
 }
 
 // validator index
@@ -114,19 +125,19 @@ func (k Keeper) SetValidatorByPowerIndex(ctx sdk.Context, validator types.Valida
 	}
 
 	store := ctx.KVStore(k.storeKey)
-	store.Set(types.GetValidatorsByPowerIndexKey(validator), validator.OperatorAddress)
+	store.Set(types.GetValidatorsByPowerIndexKey(validator, k.PowerReduction(ctx)), validator.OperatorAddress)
 }
 
 // validator index
 func (k Keeper) DeleteValidatorByPowerIndex(ctx sdk.Context, validator types.Validator) {
 	store := ctx.KVStore(k.storeKey)
-	store.Delete(types.GetValidatorsByPowerIndexKey(validator))
+	store.Delete(types.GetValidatorsByPowerIndexKey(validator, k.PowerReduction(ctx)))
 }
 
 // validator index
 func (k Keeper) SetNewValidatorByPowerIndex(ctx sdk.Context, validator types.Validator) {
 	store := ctx.KVStore(k.storeKey)
-	store.Set(types.GetValidatorsByPowerIndexKey(validator), validator.OperatorAddress)
+	store.Set(types.GetValidatorsByPowerIndexKey(validator, k.PowerReduction(ctx)), validator.OperatorAddress)
 }
 
 // Update the tokens of an existing validator, update the validators power index key
@@ -205,11 +216,14 @@ func (k Keeper) RemoveValidator(ctx sdk.Context, address sdk.ValAddress) {
 	// delete the old validator record
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetValidatorKey(address))
+	store.Delete(types.GetValidatorByStatusKey(validator.Status, address))
 	store.Delete(types.GetValidatorByConsAddrKey(valConsAddr))
-	store.Delete(types.GetValidatorsByPowerIndexKey(validator))
+	store.Delete(types.GetValidatorsByPowerIndexKey(validator, k.PowerReduction(ctx)))
 
 	// call hooks
-	k.AfterValidatorRemoved(ctx, valConsAddr, validator.OperatorAddress)
+	if err := k.AfterValidatorRemoved(ctx, valConsAddr, validator.OperatorAddress); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("AfterValidatorRemoved hook failed: %s", err))
+	}
 }
 
 // get groups of validators
@@ -247,6 +261,25 @@ func (k Keeper) GetValidators(ctx sdk.Context, maxRetrieve uint32) (validators [
 	return validators[:i] // trim if the array length < maxRetrieve
 }
 
+// GetPaginatedValidatorsByStatus returns the validators with the given bond
+// status in the selected page, ordered by operator address.
+func (k Keeper) GetPaginatedValidatorsByStatus(
+	ctx sdk.Context, status sdk.BondStatus, page, limit int,
+) []types.Validator {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIteratorPaginated(store, types.GetValidatorsByStatusKey(status), uint(page), uint(limit))
+	defer iterator.Close()
+
+	validators := []types.Validator{}
+	for ; iterator.Valid(); iterator.Next() {
+		address := types.AddressFromValidatorByStatusKey(iterator.Key())
+		validators = append(validators, k.mustGetValidator(ctx, address))
+	}
+
+	return validators
+}
+
 // get the current group of bonded validators sorted by power-rank
 func (k Keeper) GetBondedValidatorsByPower(ctx sdk.Context) []types.Validator {
 	maxValidators := k.MaxValidators(ctx)
@@ -362,6 +395,17 @@ func (k Keeper) GetLastValidators(ctx sdk.Context) (validators []types.Validator
 	return validators[:i] // trim
 }
 
+// GetValidatorSelfBond returns the tokens worth of the validator operator's
+// self-delegation, which is zero if the operator has no self-delegation.
+func (k Keeper) GetValidatorSelfBond(ctx sdk.Context, validator types.Validator) sdk.Int {
+	delegation, found := k.GetDelegation(ctx, sdk.AccAddress(validator.OperatorAddress), validator.OperatorAddress)
+	if !found {
+		return sdk.ZeroInt()
+	}
+
+	return validator.TokensFromShares(delegation.Shares).TruncateInt()
+}
+
 //_______________________________________________________________________
 // Validator Queue
 
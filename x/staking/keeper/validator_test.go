@@ -21,7 +21,7 @@ func bootstrapValidatorTest(t *testing.T, power int64, numAddrs int) (*simapp.Si
 
 	addrDels, addrVals := generateAddresses(app, ctx, numAddrs)
 
-	amt := sdk.TokensFromConsensusPower(power)
+	amt := sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)
 	totalSupply := sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), amt.MulRaw(int64(len(addrDels)))))
 
 	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
@@ -39,7 +39,7 @@ func TestSetValidator(t *testing.T) {
 
 	valPubKey := PKs[0]
 	valAddr := sdk.ValAddress(valPubKey.Address().Bytes())
-	valTokens := sdk.TokensFromConsensusPower(10)
+	valTokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
 
 	// test how the validator is set from a purely unbonbed pool
 	validator := types.NewValidator(valAddr, valPubKey, types.Description{})
@@ -55,7 +55,7 @@ func TestSetValidator(t *testing.T) {
 	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
 	require.True(t, found)
 	require.Equal(t, 1, len(updates))
-	require.Equal(t, validator.ABCIValidatorUpdate(), updates[0])
+	require.Equal(t, validator.ABCIValidatorUpdate(sdk.DefaultPowerReduction), updates[0])
 
 	// after the save the validator should be bonded
 	require.Equal(t, sdk.Bonded, validator.Status)
@@ -94,10 +94,10 @@ func TestUpdateValidatorByPowerIndex(t *testing.T) {
 	bondedPool := app.StakingKeeper.GetBondedPool(ctx)
 	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
 
-	err := app.BankKeeper.SetBalances(ctx, bondedPool.GetAddress(), sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), sdk.TokensFromConsensusPower(1234))))
+	err := app.BankKeeper.SetBalances(ctx, bondedPool.GetAddress(), sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), sdk.TokensFromConsensusPower(1234, sdk.DefaultPowerReduction))))
 	require.NoError(t, err)
 
-	err = app.BankKeeper.SetBalances(ctx, notBondedPool.GetAddress(), sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), sdk.TokensFromConsensusPower(10000))))
+	err = app.BankKeeper.SetBalances(ctx, notBondedPool.GetAddress(), sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), sdk.TokensFromConsensusPower(10000, sdk.DefaultPowerReduction))))
 	require.NoError(t, err)
 
 	app.AccountKeeper.SetModuleAccount(ctx, bondedPool)
@@ -105,28 +105,28 @@ func TestUpdateValidatorByPowerIndex(t *testing.T) {
 
 	// add a validator
 	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
-	validator, delSharesCreated := validator.AddTokensFromDel(sdk.TokensFromConsensusPower(100))
+	validator, delSharesCreated := validator.AddTokensFromDel(sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction))
 	require.Equal(t, sdk.Unbonded, validator.Status)
-	require.Equal(t, sdk.TokensFromConsensusPower(100), validator.Tokens)
+	require.Equal(t, sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction), validator.Tokens)
 	keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
 	validator, found := app.StakingKeeper.GetValidator(ctx, addrVals[0])
 	require.True(t, found)
-	require.Equal(t, sdk.TokensFromConsensusPower(100), validator.Tokens)
+	require.Equal(t, sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction), validator.Tokens)
 
-	power := types.GetValidatorsByPowerIndexKey(validator)
+	power := types.GetValidatorsByPowerIndexKey(validator, sdk.DefaultPowerReduction)
 	require.True(t, keeper.ValidatorByPowerIndexExists(ctx, app.StakingKeeper, power))
 
 	// burn half the delegator shares
 	app.StakingKeeper.DeleteValidatorByPowerIndex(ctx, validator)
 	validator, burned := validator.RemoveDelShares(delSharesCreated.Quo(sdk.NewDec(2)))
-	require.Equal(t, sdk.TokensFromConsensusPower(50), burned)
+	require.Equal(t, sdk.TokensFromConsensusPower(50, sdk.DefaultPowerReduction), burned)
 	keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true) // update the validator, possibly kicking it out
 	require.False(t, keeper.ValidatorByPowerIndexExists(ctx, app.StakingKeeper, power))
 
 	validator, found = app.StakingKeeper.GetValidator(ctx, addrVals[0])
 	require.True(t, found)
 
-	power = types.GetValidatorsByPowerIndexKey(validator)
+	power = types.GetValidatorsByPowerIndexKey(validator, sdk.DefaultPowerReduction)
 	require.True(t, keeper.ValidatorByPowerIndexExists(ctx, app.StakingKeeper, power))
 }
 
@@ -146,10 +146,10 @@ func TestUpdateBondedValidatorsDecreaseCliff(t *testing.T) {
 	app.StakingKeeper.SetParams(ctx, params)
 
 	// create a random pool
-	err := app.BankKeeper.SetBalances(ctx, bondedPool.GetAddress(), sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), sdk.TokensFromConsensusPower(1234))))
+	err := app.BankKeeper.SetBalances(ctx, bondedPool.GetAddress(), sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), sdk.TokensFromConsensusPower(1234, sdk.DefaultPowerReduction))))
 	require.NoError(t, err)
 
-	err = app.BankKeeper.SetBalances(ctx, notBondedPool.GetAddress(), sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), sdk.TokensFromConsensusPower(10000))))
+	err = app.BankKeeper.SetBalances(ctx, notBondedPool.GetAddress(), sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), sdk.TokensFromConsensusPower(10000, sdk.DefaultPowerReduction))))
 	require.NoError(t, err)
 
 	app.AccountKeeper.SetModuleAccount(ctx, bondedPool)
@@ -159,7 +159,7 @@ func TestUpdateBondedValidatorsDecreaseCliff(t *testing.T) {
 	for i := 0; i < len(validators); i++ {
 		moniker := fmt.Sprintf("val#%d", int64(i))
 		val := types.NewValidator(valAddrs[i], PKs[i], types.Description{Moniker: moniker})
-		delTokens := sdk.TokensFromConsensusPower(int64((i + 1) * 10))
+		delTokens := sdk.TokensFromConsensusPower(int64((i+1)*10), sdk.DefaultPowerReduction)
 		val, _ = val.AddTokensFromDel(delTokens)
 
 		val = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, val, true)
@@ -171,7 +171,7 @@ func TestUpdateBondedValidatorsDecreaseCliff(t *testing.T) {
 	// remove enough tokens to kick out the validator below the current cliff
 	// validator and next in line cliff validator
 	app.StakingKeeper.DeleteValidatorByPowerIndex(ctx, nextCliffVal)
-	shares := sdk.TokensFromConsensusPower(21)
+	shares := sdk.TokensFromConsensusPower(21, sdk.DefaultPowerReduction)
 	nextCliffVal, _ = nextCliffVal.RemoveDelShares(shares.ToDec())
 	nextCliffVal = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, nextCliffVal, true)
 
@@ -198,7 +198,7 @@ func TestSlashToZeroPowerRemoved(t *testing.T) {
 
 	// add a validator
 	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
-	valTokens := sdk.TokensFromConsensusPower(100)
+	valTokens := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
 
 	bondedPool := app.StakingKeeper.GetBondedPool(ctx)
 
@@ -234,13 +234,13 @@ func TestValidatorBasics(t *testing.T) {
 		validators[i] = types.NewValidator(addrVals[i], PKs[i], types.Description{})
 		validators[i].Status = sdk.Unbonded
 		validators[i].Tokens = sdk.ZeroInt()
-		tokens := sdk.TokensFromConsensusPower(power)
+		tokens := sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)
 
 		validators[i], _ = validators[i].AddTokensFromDel(tokens)
 	}
-	assert.Equal(t, sdk.TokensFromConsensusPower(9), validators[0].Tokens)
-	assert.Equal(t, sdk.TokensFromConsensusPower(8), validators[1].Tokens)
-	assert.Equal(t, sdk.TokensFromConsensusPower(7), validators[2].Tokens)
+	assert.Equal(t, sdk.TokensFromConsensusPower(9, sdk.DefaultPowerReduction), validators[0].Tokens)
+	assert.Equal(t, sdk.TokensFromConsensusPower(8, sdk.DefaultPowerReduction), validators[1].Tokens)
+	assert.Equal(t, sdk.TokensFromConsensusPower(7, sdk.DefaultPowerReduction), validators[2].Tokens)
 
 	// check the empty keeper first
 	_, found := app.StakingKeeper.GetValidator(ctx, addrVals[0])
@@ -270,11 +270,11 @@ func TestValidatorBasics(t *testing.T) {
 	require.Equal(t, 1, len(resVals))
 	assert.True(ValEq(t, validators[0], resVals[0]))
 	assert.Equal(t, sdk.Bonded, validators[0].Status)
-	assert.True(sdk.IntEq(t, sdk.TokensFromConsensusPower(9), validators[0].BondedTokens()))
+	assert.True(sdk.IntEq(t, sdk.TokensFromConsensusPower(9, sdk.DefaultPowerReduction), validators[0].BondedTokens()))
 
 	// modify a records, save, and retrieve
 	validators[0].Status = sdk.Bonded
-	validators[0].Tokens = sdk.TokensFromConsensusPower(10)
+	validators[0].Tokens = sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
 	validators[0].DelegatorShares = validators[0].Tokens.ToDec()
 	validators[0] = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validators[0], true)
 	resVal, found = app.StakingKeeper.GetValidator(ctx, addrVals[0])
@@ -329,10 +329,10 @@ func TestGetValidatorSortingUnmixed(t *testing.T) {
 	// initialize some validators into the state
 	amts := []int64{
 		0,
-		100 * sdk.PowerReduction.Int64(),
-		1 * sdk.PowerReduction.Int64(),
-		400 * sdk.PowerReduction.Int64(),
-		200 * sdk.PowerReduction.Int64()}
+		100 * sdk.DefaultPowerReduction.Int64(),
+		1 * sdk.DefaultPowerReduction.Int64(),
+		400 * sdk.DefaultPowerReduction.Int64(),
+		200 * sdk.DefaultPowerReduction.Int64()}
 	n := len(amts)
 	var validators [5]types.Validator
 	for i, amt := range amts {
@@ -346,10 +346,10 @@ func TestGetValidatorSortingUnmixed(t *testing.T) {
 	// first make sure everything made it in to the gotValidator group
 	resValidators := app.StakingKeeper.GetBondedValidatorsByPower(ctx)
 	assert.Equal(t, n, len(resValidators))
-	assert.Equal(t, sdk.NewInt(400).Mul(sdk.PowerReduction), resValidators[0].BondedTokens(), "%v", resValidators)
-	assert.Equal(t, sdk.NewInt(200).Mul(sdk.PowerReduction), resValidators[1].BondedTokens(), "%v", resValidators)
-	assert.Equal(t, sdk.NewInt(100).Mul(sdk.PowerReduction), resValidators[2].BondedTokens(), "%v", resValidators)
-	assert.Equal(t, sdk.NewInt(1).Mul(sdk.PowerReduction), resValidators[3].BondedTokens(), "%v", resValidators)
+	assert.Equal(t, sdk.NewInt(400).Mul(sdk.DefaultPowerReduction), resValidators[0].BondedTokens(), "%v", resValidators)
+	assert.Equal(t, sdk.NewInt(200).Mul(sdk.DefaultPowerReduction), resValidators[1].BondedTokens(), "%v", resValidators)
+	assert.Equal(t, sdk.NewInt(100).Mul(sdk.DefaultPowerReduction), resValidators[2].BondedTokens(), "%v", resValidators)
+	assert.Equal(t, sdk.NewInt(1).Mul(sdk.DefaultPowerReduction), resValidators[3].BondedTokens(), "%v", resValidators)
 	assert.Equal(t, sdk.NewInt(0), resValidators[4].BondedTokens(), "%v", resValidators)
 	assert.Equal(t, validators[3].OperatorAddress, resValidators[0].OperatorAddress, "%v", resValidators)
 	assert.Equal(t, validators[4].OperatorAddress, resValidators[1].OperatorAddress, "%v", resValidators)
@@ -358,14 +358,14 @@ func TestGetValidatorSortingUnmixed(t *testing.T) {
 	assert.Equal(t, validators[0].OperatorAddress, resValidators[4].OperatorAddress, "%v", resValidators)
 
 	// test a basic increase in voting power
-	validators[3].Tokens = sdk.NewInt(500).Mul(sdk.PowerReduction)
+	validators[3].Tokens = sdk.NewInt(500).Mul(sdk.DefaultPowerReduction)
 	keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validators[3], true)
 	resValidators = app.StakingKeeper.GetBondedValidatorsByPower(ctx)
 	require.Equal(t, len(resValidators), n)
 	assert.True(ValEq(t, validators[3], resValidators[0]))
 
 	// test a decrease in voting power
-	validators[3].Tokens = sdk.NewInt(300).Mul(sdk.PowerReduction)
+	validators[3].Tokens = sdk.NewInt(300).Mul(sdk.DefaultPowerReduction)
 	keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validators[3], true)
 	resValidators = app.StakingKeeper.GetBondedValidatorsByPower(ctx)
 	require.Equal(t, len(resValidators), n)
@@ -373,7 +373,7 @@ func TestGetValidatorSortingUnmixed(t *testing.T) {
 	assert.True(ValEq(t, validators[4], resValidators[1]))
 
 	// test equal voting power, different age
-	validators[3].Tokens = sdk.NewInt(200).Mul(sdk.PowerReduction)
+	validators[3].Tokens = sdk.NewInt(200).Mul(sdk.DefaultPowerReduction)
 	ctx = ctx.WithBlockHeight(10)
 	keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validators[3], true)
 	resValidators = app.StakingKeeper.GetBondedValidatorsByPower(ctx)
@@ -390,8 +390,8 @@ func TestGetValidatorSortingUnmixed(t *testing.T) {
 	assert.True(ValEq(t, validators[4], resValidators[1]))
 
 	// change in voting power of both validators, both still in v-set, no age change
-	validators[3].Tokens = sdk.NewInt(300).Mul(sdk.PowerReduction)
-	validators[4].Tokens = sdk.NewInt(300).Mul(sdk.PowerReduction)
+	validators[3].Tokens = sdk.NewInt(300).Mul(sdk.DefaultPowerReduction)
+	validators[4].Tokens = sdk.NewInt(300).Mul(sdk.DefaultPowerReduction)
 	keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validators[3], true)
 	resValidators = app.StakingKeeper.GetBondedValidatorsByPower(ctx)
 	require.Equal(t, len(resValidators), n)
@@ -408,10 +408,10 @@ func TestGetValidatorSortingMixed(t *testing.T) {
 	bondedPool := app.StakingKeeper.GetBondedPool(ctx)
 	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
 
-	err := app.BankKeeper.SetBalances(ctx, bondedPool.GetAddress(), sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), sdk.TokensFromConsensusPower(501))))
+	err := app.BankKeeper.SetBalances(ctx, bondedPool.GetAddress(), sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), sdk.TokensFromConsensusPower(501, sdk.DefaultPowerReduction))))
 	require.NoError(t, err)
 
-	err = app.BankKeeper.SetBalances(ctx, notBondedPool.GetAddress(), sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), sdk.TokensFromConsensusPower(0))))
+	err = app.BankKeeper.SetBalances(ctx, notBondedPool.GetAddress(), sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), sdk.TokensFromConsensusPower(0, sdk.DefaultPowerReduction))))
 	require.NoError(t, err)
 
 	app.AccountKeeper.SetModuleAccount(ctx, notBondedPool)
@@ -425,10 +425,10 @@ func TestGetValidatorSortingMixed(t *testing.T) {
 	// initialize some validators into the state
 	amts := []int64{
 		0,
-		100 * sdk.PowerReduction.Int64(),
-		1 * sdk.PowerReduction.Int64(),
-		400 * sdk.PowerReduction.Int64(),
-		200 * sdk.PowerReduction.Int64()}
+		100 * sdk.DefaultPowerReduction.Int64(),
+		1 * sdk.DefaultPowerReduction.Int64(),
+		400 * sdk.DefaultPowerReduction.Int64(),
+		200 * sdk.DefaultPowerReduction.Int64()}
 
 	var validators [5]types.Validator
 	for i, amt := range amts {
@@ -459,8 +459,8 @@ func TestGetValidatorSortingMixed(t *testing.T) {
 	resValidators := app.StakingKeeper.GetBondedValidatorsByPower(ctx)
 	// The validators returned should match the max validators
 	assert.Equal(t, 2, len(resValidators))
-	assert.Equal(t, sdk.NewInt(400).Mul(sdk.PowerReduction), resValidators[0].BondedTokens(), "%v", resValidators)
-	assert.Equal(t, sdk.NewInt(200).Mul(sdk.PowerReduction), resValidators[1].BondedTokens(), "%v", resValidators)
+	assert.Equal(t, sdk.NewInt(400).Mul(sdk.DefaultPowerReduction), resValidators[0].BondedTokens(), "%v", resValidators)
+	assert.Equal(t, sdk.NewInt(200).Mul(sdk.DefaultPowerReduction), resValidators[1].BondedTokens(), "%v", resValidators)
 	assert.Equal(t, validators[3].OperatorAddress, resValidators[0].OperatorAddress, "%v", resValidators)
 	assert.Equal(t, validators[4].OperatorAddress, resValidators[1].OperatorAddress, "%v", resValidators)
 }
@@ -482,7 +482,7 @@ func TestGetValidatorsEdgeCases(t *testing.T) {
 		moniker := fmt.Sprintf("val#%d", int64(i))
 		validators[i] = types.NewValidator(sdk.ValAddress(addrs[i]), PKs[i], types.Description{Moniker: moniker})
 
-		tokens := sdk.TokensFromConsensusPower(power)
+		tokens := sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)
 		validators[i], _ = validators[i].AddTokensFromDel(tokens)
 
 		notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
@@ -501,7 +501,7 @@ func TestGetValidatorsEdgeCases(t *testing.T) {
 
 	// delegate 500 tokens to validator 0
 	app.StakingKeeper.DeleteValidatorByPowerIndex(ctx, validators[0])
-	delTokens := sdk.TokensFromConsensusPower(500)
+	delTokens := sdk.TokensFromConsensusPower(500, sdk.DefaultPowerReduction)
 	validators[0], _ = validators[0].AddTokensFromDel(delTokens)
 	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
 
@@ -536,10 +536,10 @@ func TestGetValidatorsEdgeCases(t *testing.T) {
 	validators[3], found = app.StakingKeeper.GetValidator(ctx, validators[3].OperatorAddress)
 	assert.True(t, found)
 	app.StakingKeeper.DeleteValidatorByPowerIndex(ctx, validators[3])
-	validators[3], _ = validators[3].AddTokensFromDel(sdk.TokensFromConsensusPower(1))
+	validators[3], _ = validators[3].AddTokensFromDel(sdk.TokensFromConsensusPower(1, sdk.DefaultPowerReduction))
 
 	notBondedPool = app.StakingKeeper.GetNotBondedPool(ctx)
-	newTokens = sdk.NewCoins(sdk.NewCoin(params.BondDenom, sdk.TokensFromConsensusPower(1)))
+	newTokens = sdk.NewCoins(sdk.NewCoin(params.BondDenom, sdk.TokensFromConsensusPower(1, sdk.DefaultPowerReduction)))
 	balances = app.BankKeeper.GetAllBalances(ctx, notBondedPool.GetAddress())
 	require.NoError(t, app.BankKeeper.SetBalances(ctx, notBondedPool.GetAddress(), balances.Add(newTokens...)))
 	app.AccountKeeper.SetModuleAccount(ctx, notBondedPool)
@@ -598,9 +598,9 @@ func TestValidatorBondHeight(t *testing.T) {
 	validators[1] = types.NewValidator(sdk.ValAddress(addrs[1]), PKs[1], types.Description{})
 	validators[2] = types.NewValidator(sdk.ValAddress(addrs[2]), PKs[2], types.Description{})
 
-	tokens0 := sdk.TokensFromConsensusPower(200)
-	tokens1 := sdk.TokensFromConsensusPower(100)
-	tokens2 := sdk.TokensFromConsensusPower(100)
+	tokens0 := sdk.TokensFromConsensusPower(200, sdk.DefaultPowerReduction)
+	tokens1 := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+	tokens2 := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
 	validators[0], _ = validators[0].AddTokensFromDel(tokens0)
 	validators[1], _ = validators[1].AddTokensFromDel(tokens1)
 	validators[2], _ = validators[2].AddTokensFromDel(tokens2)
@@ -620,7 +620,7 @@ func TestValidatorBondHeight(t *testing.T) {
 	assert.True(ValEq(t, validators[1], resValidators[1]))
 	app.StakingKeeper.DeleteValidatorByPowerIndex(ctx, validators[1])
 	app.StakingKeeper.DeleteValidatorByPowerIndex(ctx, validators[2])
-	delTokens := sdk.TokensFromConsensusPower(50)
+	delTokens := sdk.TokensFromConsensusPower(50, sdk.DefaultPowerReduction)
 	validators[1], _ = validators[1].AddTokensFromDel(delTokens)
 	validators[2], _ = validators[2].AddTokensFromDel(delTokens)
 	validators[2] = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validators[2], true)
@@ -643,7 +643,7 @@ func TestFullValidatorSetPowerChange(t *testing.T) {
 	var validators [5]types.Validator
 	for i, power := range powers {
 		validators[i] = types.NewValidator(sdk.ValAddress(addrs[i]), PKs[i], types.Description{})
-		tokens := sdk.TokensFromConsensusPower(power)
+		tokens := sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)
 		validators[i], _ = validators[i].AddTokensFromDel(tokens)
 		keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validators[i], true)
 	}
@@ -664,7 +664,7 @@ func TestFullValidatorSetPowerChange(t *testing.T) {
 
 	// test a swap in voting power
 
-	tokens := sdk.TokensFromConsensusPower(600)
+	tokens := sdk.TokensFromConsensusPower(600, sdk.DefaultPowerReduction)
 	validators[0], _ = validators[0].AddTokensFromDel(tokens)
 	validators[0] = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validators[0], true)
 	resValidators = app.StakingKeeper.GetBondedValidatorsByPower(ctx)
@@ -683,7 +683,7 @@ func TestApplyAndReturnValidatorSetUpdatesAllNone(t *testing.T) {
 		valAddr := sdk.ValAddress(valPubKey.Address().Bytes())
 
 		validators[i] = types.NewValidator(valAddr, valPubKey, types.Description{})
-		tokens := sdk.TokensFromConsensusPower(power)
+		tokens := sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)
 		validators[i], _ = validators[i].AddTokensFromDel(tokens)
 	}
 
@@ -699,8 +699,8 @@ func TestApplyAndReturnValidatorSetUpdatesAllNone(t *testing.T) {
 	assert.Equal(t, 2, len(updates))
 	validators[0], _ = app.StakingKeeper.GetValidator(ctx, validators[0].OperatorAddress)
 	validators[1], _ = app.StakingKeeper.GetValidator(ctx, validators[1].OperatorAddress)
-	assert.Equal(t, validators[0].ABCIValidatorUpdate(), updates[1])
-	assert.Equal(t, validators[1].ABCIValidatorUpdate(), updates[0])
+	assert.Equal(t, validators[0].ABCIValidatorUpdate(sdk.DefaultPowerReduction), updates[1])
+	assert.Equal(t, validators[1].ABCIValidatorUpdate(sdk.DefaultPowerReduction), updates[0])
 }
 
 func TestApplyAndReturnValidatorSetUpdatesIdentical(t *testing.T) {
@@ -711,7 +711,7 @@ func TestApplyAndReturnValidatorSetUpdatesIdentical(t *testing.T) {
 	for i, power := range powers {
 		validators[i] = types.NewValidator(sdk.ValAddress(addrs[i]), PKs[i], types.Description{})
 
-		tokens := sdk.TokensFromConsensusPower(power)
+		tokens := sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)
 		validators[i], _ = validators[i].AddTokensFromDel(tokens)
 
 	}
@@ -735,7 +735,7 @@ func TestApplyAndReturnValidatorSetUpdatesSingleValueChange(t *testing.T) {
 
 		validators[i] = types.NewValidator(sdk.ValAddress(addrs[i]), PKs[i], types.Description{})
 
-		tokens := sdk.TokensFromConsensusPower(power)
+		tokens := sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)
 		validators[i], _ = validators[i].AddTokensFromDel(tokens)
 
 	}
@@ -746,13 +746,13 @@ func TestApplyAndReturnValidatorSetUpdatesSingleValueChange(t *testing.T) {
 	// test single value change
 	//  tendermintUpdate set: {} -> {c1'}
 	validators[0].Status = sdk.Bonded
-	validators[0].Tokens = sdk.TokensFromConsensusPower(600)
+	validators[0].Tokens = sdk.TokensFromConsensusPower(600, sdk.DefaultPowerReduction)
 	validators[0] = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validators[0], false)
 
 	updates := app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
 
 	require.Equal(t, 1, len(updates))
-	require.Equal(t, validators[0].ABCIValidatorUpdate(), updates[0])
+	require.Equal(t, validators[0].ABCIValidatorUpdate(sdk.DefaultPowerReduction), updates[0])
 }
 
 func TestApplyAndReturnValidatorSetUpdatesMultipleValueChange(t *testing.T) {
@@ -764,7 +764,7 @@ func TestApplyAndReturnValidatorSetUpdatesMultipleValueChange(t *testing.T) {
 
 		validators[i] = types.NewValidator(sdk.ValAddress(addrs[i]), PKs[i], types.Description{})
 
-		tokens := sdk.TokensFromConsensusPower(power)
+		tokens := sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)
 		validators[i], _ = validators[i].AddTokensFromDel(tokens)
 
 	}
@@ -774,8 +774,8 @@ func TestApplyAndReturnValidatorSetUpdatesMultipleValueChange(t *testing.T) {
 
 	// test multiple value change
 	//  tendermintUpdate set: {c1, c3} -> {c1', c3'}
-	delTokens1 := sdk.TokensFromConsensusPower(190)
-	delTokens2 := sdk.TokensFromConsensusPower(80)
+	delTokens1 := sdk.TokensFromConsensusPower(190, sdk.DefaultPowerReduction)
+	delTokens2 := sdk.TokensFromConsensusPower(80, sdk.DefaultPowerReduction)
 	validators[0], _ = validators[0].AddTokensFromDel(delTokens1)
 	validators[1], _ = validators[1].AddTokensFromDel(delTokens2)
 	validators[0] = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validators[0], false)
@@ -783,8 +783,8 @@ func TestApplyAndReturnValidatorSetUpdatesMultipleValueChange(t *testing.T) {
 
 	updates := app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
 	require.Equal(t, 2, len(updates))
-	require.Equal(t, validators[0].ABCIValidatorUpdate(), updates[0])
-	require.Equal(t, validators[1].ABCIValidatorUpdate(), updates[1])
+	require.Equal(t, validators[0].ABCIValidatorUpdate(sdk.DefaultPowerReduction), updates[0])
+	require.Equal(t, validators[1].ABCIValidatorUpdate(sdk.DefaultPowerReduction), updates[1])
 }
 
 func TestApplyAndReturnValidatorSetUpdatesInserted(t *testing.T) {
@@ -796,7 +796,7 @@ func TestApplyAndReturnValidatorSetUpdatesInserted(t *testing.T) {
 
 		validators[i] = types.NewValidator(sdk.ValAddress(addrs[i]), PKs[i], types.Description{})
 
-		tokens := sdk.TokensFromConsensusPower(power)
+		tokens := sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)
 		validators[i], _ = validators[i].AddTokensFromDel(tokens)
 
 	}
@@ -812,7 +812,7 @@ func TestApplyAndReturnValidatorSetUpdatesInserted(t *testing.T) {
 	updates := app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
 	validators[2], _ = app.StakingKeeper.GetValidator(ctx, validators[2].OperatorAddress)
 	require.Equal(t, 1, len(updates))
-	require.Equal(t, validators[2].ABCIValidatorUpdate(), updates[0])
+	require.Equal(t, validators[2].ABCIValidatorUpdate(sdk.DefaultPowerReduction), updates[0])
 
 	// test validtor added at the beginning
 	//  tendermintUpdate set: {} -> {c0}
@@ -821,7 +821,7 @@ func TestApplyAndReturnValidatorSetUpdatesInserted(t *testing.T) {
 	updates = app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
 	validators[3], _ = app.StakingKeeper.GetValidator(ctx, validators[3].OperatorAddress)
 	require.Equal(t, 1, len(updates))
-	require.Equal(t, validators[3].ABCIValidatorUpdate(), updates[0])
+	require.Equal(t, validators[3].ABCIValidatorUpdate(sdk.DefaultPowerReduction), updates[0])
 
 	// test validtor added at the end
 	//  tendermintUpdate set: {} -> {c0}
@@ -830,7 +830,7 @@ func TestApplyAndReturnValidatorSetUpdatesInserted(t *testing.T) {
 	updates = app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
 	validators[4], _ = app.StakingKeeper.GetValidator(ctx, validators[4].OperatorAddress)
 	require.Equal(t, 1, len(updates))
-	require.Equal(t, validators[4].ABCIValidatorUpdate(), updates[0])
+	require.Equal(t, validators[4].ABCIValidatorUpdate(sdk.DefaultPowerReduction), updates[0])
 }
 
 func TestApplyAndReturnValidatorSetUpdatesWithCliffValidator(t *testing.T) {
@@ -845,7 +845,7 @@ func TestApplyAndReturnValidatorSetUpdatesWithCliffValidator(t *testing.T) {
 
 		validators[i] = types.NewValidator(sdk.ValAddress(addrs[i]), PKs[i], types.Description{})
 
-		tokens := sdk.TokensFromConsensusPower(power)
+		tokens := sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)
 		validators[i], _ = validators[i].AddTokensFromDel(tokens)
 
 	}
@@ -863,7 +863,7 @@ func TestApplyAndReturnValidatorSetUpdatesWithCliffValidator(t *testing.T) {
 	//  tendermintUpdate set: {}     -> {c0, c4}
 	require.Equal(t, 0, len(app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)))
 
-	tokens := sdk.TokensFromConsensusPower(10)
+	tokens := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
 	validators[2], _ = validators[2].AddTokensFromDel(tokens)
 	app.StakingKeeper.SetValidator(ctx, validators[2])
 	app.StakingKeeper.SetValidatorByPowerIndex(ctx, validators[2])
@@ -871,7 +871,7 @@ func TestApplyAndReturnValidatorSetUpdatesWithCliffValidator(t *testing.T) {
 	validators[2], _ = app.StakingKeeper.GetValidator(ctx, validators[2].OperatorAddress)
 	require.Equal(t, 2, len(updates), "%v", updates)
 	require.Equal(t, validators[0].ABCIValidatorUpdateZero(), updates[1])
-	require.Equal(t, validators[2].ABCIValidatorUpdate(), updates[0])
+	require.Equal(t, validators[2].ABCIValidatorUpdate(sdk.DefaultPowerReduction), updates[0])
 }
 
 func TestApplyAndReturnValidatorSetUpdatesPowerDecrease(t *testing.T) {
@@ -883,7 +883,7 @@ func TestApplyAndReturnValidatorSetUpdatesPowerDecrease(t *testing.T) {
 
 		validators[i] = types.NewValidator(sdk.ValAddress(addrs[i]), PKs[i], types.Description{})
 
-		tokens := sdk.TokensFromConsensusPower(power)
+		tokens := sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)
 		validators[i], _ = validators[i].AddTokensFromDel(tokens)
 
 	}
@@ -892,27 +892,27 @@ func TestApplyAndReturnValidatorSetUpdatesPowerDecrease(t *testing.T) {
 	require.Equal(t, 2, len(app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)))
 
 	// check initial power
-	require.Equal(t, int64(100), validators[0].GetConsensusPower())
-	require.Equal(t, int64(100), validators[1].GetConsensusPower())
+	require.Equal(t, int64(100), validators[0].GetConsensusPower(sdk.DefaultPowerReduction))
+	require.Equal(t, int64(100), validators[1].GetConsensusPower(sdk.DefaultPowerReduction))
 
 	// test multiple value change
 	//  tendermintUpdate set: {c1, c3} -> {c1', c3'}
-	delTokens1 := sdk.TokensFromConsensusPower(20)
-	delTokens2 := sdk.TokensFromConsensusPower(30)
+	delTokens1 := sdk.TokensFromConsensusPower(20, sdk.DefaultPowerReduction)
+	delTokens2 := sdk.TokensFromConsensusPower(30, sdk.DefaultPowerReduction)
 	validators[0], _ = validators[0].RemoveDelShares(delTokens1.ToDec())
 	validators[1], _ = validators[1].RemoveDelShares(delTokens2.ToDec())
 	validators[0] = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validators[0], false)
 	validators[1] = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validators[1], false)
 
 	// power has changed
-	require.Equal(t, int64(80), validators[0].GetConsensusPower())
-	require.Equal(t, int64(70), validators[1].GetConsensusPower())
+	require.Equal(t, int64(80), validators[0].GetConsensusPower(sdk.DefaultPowerReduction))
+	require.Equal(t, int64(70), validators[1].GetConsensusPower(sdk.DefaultPowerReduction))
 
 	// Tendermint updates should reflect power change
 	updates := app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
 	require.Equal(t, 2, len(updates))
-	require.Equal(t, validators[0].ABCIValidatorUpdate(), updates[0])
-	require.Equal(t, validators[1].ABCIValidatorUpdate(), updates[1])
+	require.Equal(t, validators[0].ABCIValidatorUpdate(sdk.DefaultPowerReduction), updates[0])
+	require.Equal(t, validators[1].ABCIValidatorUpdate(sdk.DefaultPowerReduction), updates[1])
 }
 
 func TestApplyAndReturnValidatorSetUpdatesNewValidator(t *testing.T) {
@@ -932,7 +932,7 @@ func TestApplyAndReturnValidatorSetUpdatesNewValidator(t *testing.T) {
 		valAddr := sdk.ValAddress(valPubKey.Address().Bytes())
 
 		validators[i] = types.NewValidator(valAddr, valPubKey, types.Description{})
-		tokens := sdk.TokensFromConsensusPower(power)
+		tokens := sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)
 		validators[i], _ = validators[i].AddTokensFromDel(tokens)
 
 		app.StakingKeeper.SetValidator(ctx, validators[i])
@@ -944,8 +944,8 @@ func TestApplyAndReturnValidatorSetUpdatesNewValidator(t *testing.T) {
 	require.Equal(t, len(validators), len(updates))
 	validators[0], _ = app.StakingKeeper.GetValidator(ctx, validators[0].OperatorAddress)
 	validators[1], _ = app.StakingKeeper.GetValidator(ctx, validators[1].OperatorAddress)
-	require.Equal(t, validators[0].ABCIValidatorUpdate(), updates[0])
-	require.Equal(t, validators[1].ABCIValidatorUpdate(), updates[1])
+	require.Equal(t, validators[0].ABCIValidatorUpdate(sdk.DefaultPowerReduction), updates[0])
+	require.Equal(t, validators[1].ABCIValidatorUpdate(sdk.DefaultPowerReduction), updates[1])
 
 	require.Equal(t, 0, len(app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)))
 
@@ -953,7 +953,7 @@ func TestApplyAndReturnValidatorSetUpdatesNewValidator(t *testing.T) {
 	for i, power := range powers {
 
 		app.StakingKeeper.DeleteValidatorByPowerIndex(ctx, validators[i])
-		tokens := sdk.TokensFromConsensusPower(power)
+		tokens := sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)
 		validators[i], _ = validators[i].AddTokensFromDel(tokens)
 
 		app.StakingKeeper.SetValidator(ctx, validators[i])
@@ -980,7 +980,7 @@ func TestApplyAndReturnValidatorSetUpdatesNewValidator(t *testing.T) {
 	valAddr = sdk.ValAddress(valPubKey.Address().Bytes())
 
 	validator = types.NewValidator(valAddr, valPubKey, types.Description{})
-	tokens := sdk.TokensFromConsensusPower(500)
+	tokens := sdk.TokensFromConsensusPower(500, sdk.DefaultPowerReduction)
 	validator, _ = validator.AddTokensFromDel(tokens)
 	app.StakingKeeper.SetValidator(ctx, validator)
 	app.StakingKeeper.SetValidatorByPowerIndex(ctx, validator)
@@ -991,9 +991,9 @@ func TestApplyAndReturnValidatorSetUpdatesNewValidator(t *testing.T) {
 	validators[0], _ = app.StakingKeeper.GetValidator(ctx, validators[0].OperatorAddress)
 	validators[1], _ = app.StakingKeeper.GetValidator(ctx, validators[1].OperatorAddress)
 	require.Equal(t, len(validators)+1, len(updates))
-	require.Equal(t, validator.ABCIValidatorUpdate(), updates[0])
-	require.Equal(t, validators[0].ABCIValidatorUpdate(), updates[1])
-	require.Equal(t, validators[1].ABCIValidatorUpdate(), updates[2])
+	require.Equal(t, validator.ABCIValidatorUpdate(sdk.DefaultPowerReduction), updates[0])
+	require.Equal(t, validators[0].ABCIValidatorUpdate(sdk.DefaultPowerReduction), updates[1])
+	require.Equal(t, validators[1].ABCIValidatorUpdate(sdk.DefaultPowerReduction), updates[2])
 }
 
 func TestApplyAndReturnValidatorSetUpdatesBondTransition(t *testing.T) {
@@ -1013,7 +1013,7 @@ func TestApplyAndReturnValidatorSetUpdatesBondTransition(t *testing.T) {
 		valAddr := sdk.ValAddress(valPubKey.Address().Bytes())
 
 		validators[i] = types.NewValidator(valAddr, valPubKey, types.Description{Moniker: moniker})
-		tokens := sdk.TokensFromConsensusPower(power)
+		tokens := sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)
 		validators[i], _ = validators[i].AddTokensFromDel(tokens)
 		app.StakingKeeper.SetValidator(ctx, validators[i])
 		app.StakingKeeper.SetValidatorByPowerIndex(ctx, validators[i])
@@ -1024,8 +1024,8 @@ func TestApplyAndReturnValidatorSetUpdatesBondTransition(t *testing.T) {
 	require.Equal(t, 2, len(updates))
 	validators[2], _ = app.StakingKeeper.GetValidator(ctx, validators[2].OperatorAddress)
 	validators[1], _ = app.StakingKeeper.GetValidator(ctx, validators[1].OperatorAddress)
-	require.Equal(t, validators[2].ABCIValidatorUpdate(), updates[0])
-	require.Equal(t, validators[1].ABCIValidatorUpdate(), updates[1])
+	require.Equal(t, validators[2].ABCIValidatorUpdate(sdk.DefaultPowerReduction), updates[0])
+	require.Equal(t, validators[1].ABCIValidatorUpdate(sdk.DefaultPowerReduction), updates[1])
 
 	require.Equal(t, 0, len(app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)))
 
@@ -1037,7 +1037,7 @@ func TestApplyAndReturnValidatorSetUpdatesBondTransition(t *testing.T) {
 	require.True(t, found)
 
 	app.StakingKeeper.DeleteValidatorByPowerIndex(ctx, validators[0])
-	tokens := sdk.TokensFromConsensusPower(1)
+	tokens := sdk.TokensFromConsensusPower(1, sdk.DefaultPowerReduction)
 	validators[0], _ = validators[0].AddTokensFromDel(tokens)
 	app.StakingKeeper.SetValidator(ctx, validators[0])
 	app.StakingKeeper.SetValidatorByPowerIndex(ctx, validators[0])
@@ -1060,7 +1060,7 @@ func TestApplyAndReturnValidatorSetUpdatesBondTransition(t *testing.T) {
 	require.Equal(t, 0, len(updates))
 
 	app.StakingKeeper.DeleteValidatorByPowerIndex(ctx, validators[1])
-	tokens = sdk.TokensFromConsensusPower(250)
+	tokens = sdk.TokensFromConsensusPower(250, sdk.DefaultPowerReduction)
 	validators[1], _ = validators[1].AddTokensFromDel(tokens)
 	app.StakingKeeper.SetValidator(ctx, validators[1])
 	app.StakingKeeper.SetValidatorByPowerIndex(ctx, validators[1])
@@ -1068,11 +1068,61 @@ func TestApplyAndReturnValidatorSetUpdatesBondTransition(t *testing.T) {
 	// verify initial Tendermint updates are correct
 	updates = app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
 	require.Equal(t, 1, len(updates))
-	require.Equal(t, validators[1].ABCIValidatorUpdate(), updates[0])
+	require.Equal(t, validators[1].ABCIValidatorUpdate(sdk.DefaultPowerReduction), updates[0])
 
 	require.Equal(t, 0, len(app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)))
 }
 
+func TestBlockValidatorUpdatesEpoch(t *testing.T) {
+	app, ctx, addrs, _ := bootstrapValidatorTest(t, 1000, 20)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.EpochLength = 3
+	app.StakingKeeper.SetParams(ctx, params)
+
+	validator := types.NewValidator(sdk.ValAddress(addrs[0]), PKs[0], types.Description{})
+	validator, _ = validator.AddTokensFromDel(sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction))
+	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, false)
+	app.StakingKeeper.SetValidatorByConsAddr(ctx, validator)
+
+	// the new validator is not bonded before the end of the epoch
+	for height := int64(1); height < 3; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		require.False(t, app.StakingKeeper.IsEpochEnd(ctx))
+		require.Empty(t, app.StakingKeeper.BlockValidatorUpdates(ctx))
+
+		validator, found := app.StakingKeeper.GetValidator(ctx, validator.OperatorAddress)
+		require.True(t, found)
+		require.Equal(t, sdk.Unbonded, validator.Status)
+	}
+
+	ctx = ctx.WithBlockHeight(3)
+	require.True(t, app.StakingKeeper.IsEpochEnd(ctx))
+
+	updates := app.StakingKeeper.BlockValidatorUpdates(ctx)
+	require.Len(t, updates, 1)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, validator.OperatorAddress)
+	require.True(t, found)
+	require.Equal(t, sdk.Bonded, validator.Status)
+	require.Equal(t, validator.ABCIValidatorUpdate(sdk.DefaultPowerReduction), updates[0])
+
+	// a validator jailed within an epoch leaves the set right away
+	ctx = ctx.WithBlockHeight(4)
+	app.StakingKeeper.Jail(ctx, validator.GetConsAddr())
+	updates = app.StakingKeeper.BlockValidatorUpdates(ctx)
+	require.Equal(t, []abci.ValidatorUpdate{validator.ABCIValidatorUpdateZero()}, updates)
+	require.Zero(t, app.StakingKeeper.GetLastValidatorPower(ctx, validator.OperatorAddress))
+	require.True(t, app.StakingKeeper.GetLastTotalPower(ctx).IsZero())
+
+	validator, found = app.StakingKeeper.GetValidator(ctx, validator.OperatorAddress)
+	require.True(t, found)
+	require.Equal(t, sdk.Unbonding, validator.Status)
+
+	ctx = ctx.WithBlockHeight(6)
+	require.Empty(t, app.StakingKeeper.BlockValidatorUpdates(ctx))
+}
+
 func TestUpdateValidatorCommission(t *testing.T) {
 	app, ctx, _, addrVals := bootstrapValidatorTest(t, 1000, 20)
 	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Now().UTC()})
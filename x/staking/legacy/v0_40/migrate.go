@@ -0,0 +1,139 @@
+package v040
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v034staking "github.com/cosmos/cosmos-sdk/x/staking/legacy/v0_34"
+	v038staking "github.com/cosmos/cosmos-sdk/x/staking/legacy/v0_38"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// Migrate accepts exported x/staking genesis state from v0.38 or v0.39 and
+// migrates it to v0.40 x/staking genesis state. The migration includes:
+//
+// - Setting the params which did not exist before to the values set by
+// MigrateStore, with the MinCommissionRate param at its default of zero.
+// - Setting the HistoricalEntries param to its default.
+// - Encoding the consensus public keys of the validators as bech32 strings.
+// - Setting no liquid staked tokens, and no tokenize share records.
+func Migrate(oldGenState v038staking.GenesisState) types.GenesisState {
+	maxEntries := uint32(oldGenState.Params.MaxEntries)
+
+	params := types.NewParams(
+		oldGenState.Params.UnbondingTime,
+		uint32(oldGenState.Params.MaxValidators),
+		maxEntries,
+		maxEntries,
+		types.DefaultHistoricalEntries,
+		types.DefaultEpochLength,
+		oldGenState.Params.BondDenom,
+		types.DefaultMinCommissionRate,
+		types.DefaultGlobalLiquidStakingCap,
+		types.DefaultValidatorLiquidStakingCap,
+	)
+
+	lastValidatorPowers := make([]types.LastValidatorPower, len(oldGenState.LastValidatorPowers))
+	for i, power := range oldGenState.LastValidatorPowers {
+		lastValidatorPowers[i] = types.LastValidatorPower{Address: power.Address, Power: power.Power}
+	}
+
+	delegations := make(types.Delegations, len(oldGenState.Delegations))
+	for i, del := range oldGenState.Delegations {
+		delegations[i] = types.NewDelegation(del.DelegatorAddress, del.ValidatorAddress, del.Shares)
+	}
+
+	return types.GenesisState{
+		Params:                  params,
+		LastTotalPower:          oldGenState.LastTotalPower,
+		LastValidatorPowers:     lastValidatorPowers,
+		Validators:              migrateValidators(oldGenState.Validators),
+		Delegations:             delegations,
+		UnbondingDelegations:    migrateUnbondingDelegations(oldGenState.UnbondingDelegations),
+		Redelegations:           migrateRedelegations(oldGenState.Redelegations),
+		Exported:                oldGenState.Exported,
+		TotalLiquidStakedTokens: sdk.ZeroInt(),
+	}
+}
+
+func migrateValidators(oldValidators v038staking.Validators) types.Validators {
+	validators := make(types.Validators, len(oldValidators))
+
+	for i, val := range oldValidators {
+		var consPubKey string
+		if val.ConsPubKey != nil {
+			consPubKey = sdk.MustBech32ifyPubKey(sdk.Bech32PubKeyTypeConsPub, val.ConsPubKey)
+		}
+
+		validators[i] = types.Validator{
+			OperatorAddress: val.OperatorAddress,
+			ConsensusPubkey: consPubKey,
+			Jailed:          val.Jailed,
+			Status:          val.Status,
+			Tokens:          val.Tokens,
+			DelegatorShares: val.DelegatorShares,
+			Description: types.NewDescription(
+				val.Description.Moniker,
+				val.Description.Identity,
+				val.Description.Website,
+				val.Description.SecurityContact,
+				val.Description.Details,
+			),
+			UnbondingHeight: val.UnbondingHeight,
+			UnbondingTime:   val.UnbondingCompletionTime,
+			Commission: types.NewCommissionWithTime(
+				val.Commission.Rate,
+				val.Commission.MaxRate,
+				val.Commission.MaxChangeRate,
+				val.Commission.UpdateTime,
+			),
+			MinSelfDelegation: val.MinSelfDelegation,
+		}
+	}
+
+	return validators
+}
+
+func migrateUnbondingDelegations(oldUBDs []v034staking.UnbondingDelegation) []types.UnbondingDelegation {
+	ubds := make([]types.UnbondingDelegation, len(oldUBDs))
+
+	for i, ubd := range oldUBDs {
+		entries := make([]types.UnbondingDelegationEntry, len(ubd.Entries))
+		for j, entry := range ubd.Entries {
+			entries[j] = types.UnbondingDelegationEntry{
+				CreationHeight: entry.CreationHeight,
+				CompletionTime: entry.CompletionTime,
+				InitialBalance: entry.InitialBalance,
+				Balance:        entry.Balance,
+			}
+		}
+
+		ubds[i] = types.UnbondingDelegation{
+			DelegatorAddress: ubd.DelegatorAddress,
+			ValidatorAddress: ubd.ValidatorAddress,
+			Entries:          entries,
+		}
+	}
+
+	return ubds
+}
+
+func migrateRedelegations(oldReds []v034staking.Redelegation) []types.Redelegation {
+	reds := make([]types.Redelegation, len(oldReds))
+
+	for i, red := range oldReds {
+		entries := make([]types.RedelegationEntry, len(red.Entries))
+		for j, entry := range red.Entries {
+			entries[j] = types.NewRedelegationEntry(
+				entry.CreationHeight, entry.CompletionTime, entry.InitialBalance, entry.SharesDst,
+			)
+		}
+
+		reds[i] = types.Redelegation{
+			DelegatorAddress:    red.DelegatorAddress,
+			ValidatorSrcAddress: red.ValidatorSrcAddress,
+			ValidatorDstAddress: red.ValidatorDstAddress,
+			Entries:             entries,
+		}
+	}
+
+	return reds
+}
@@ -0,0 +1,101 @@
+package v040
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MigrateStore performs an in-place store migration of the x/staking state of
+// a chain upgrading from v0.39. The migration includes:
+//
+// - Setting the MinCommissionRate parameter, which did not exist before.
+// - Raising the commission rate and max rate of the validators below it.
+// - Setting the liquid staking caps to their defaults, i.e. uncapped.
+// - Setting the MaxRedelegationEntries parameter to the MaxEntries parameter.
+// - Setting the EpochLength parameter to one, i.e. no epochs.
+// - Indexing the validators by bond status and the delegations by validator.
+//
+// It is meant to be called from an x/upgrade handler. The paramSpace must be
+// the staking module's subspace with its key table set.
+func MigrateStore(
+	ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.Marshaler,
+	paramSpace paramtypes.Subspace, minCommissionRate sdk.Dec,
+) error {
+	if err := paramSpace.Validate(ctx, types.KeyMinCommissionRate, minCommissionRate); err != nil {
+		return err
+	}
+
+	paramSpace.Set(ctx, types.KeyMinCommissionRate, minCommissionRate)
+	paramSpace.Set(ctx, types.KeyGlobalLiquidStakingCap, types.DefaultGlobalLiquidStakingCap)
+	paramSpace.Set(ctx, types.KeyValidatorLiquidStakingCap, types.DefaultValidatorLiquidStakingCap)
+
+	var maxEntries uint32
+	paramSpace.Get(ctx, types.KeyMaxEntries, &maxEntries)
+	paramSpace.Set(ctx, types.KeyMaxRedelegationEntries, maxEntries)
+	paramSpace.Set(ctx, types.KeyEpochLength, types.DefaultEpochLength)
+
+	validatorsStore := prefix.NewStore(ctx.KVStore(storeKey), types.ValidatorsKey)
+
+	iterator := validatorsStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var (
+		validators []types.Validator
+		statusKeys [][]byte
+	)
+
+	for ; iterator.Valid(); iterator.Next() {
+		validator, err := types.UnmarshalValidator(cdc, iterator.Value())
+		if err != nil {
+			return err
+		}
+
+		statusKeys = append(statusKeys, types.GetValidatorByStatusKey(validator.Status, validator.OperatorAddress))
+
+		if validator.Commission.Rate.LT(minCommissionRate) {
+			validators = append(validators, validator)
+		}
+	}
+
+	store := ctx.KVStore(storeKey)
+	for _, key := range statusKeys {
+		store.Set(key, []byte{})
+	}
+
+	for _, validator := range validators {
+		validator.Commission.Rate = minCommissionRate
+		if validator.Commission.MaxRate.LT(minCommissionRate) {
+			validator.Commission.MaxRate = minCommissionRate
+		}
+
+		validator.Commission.UpdateTime = ctx.BlockHeader().Time
+		validatorsStore.Set(validator.OperatorAddress, types.MustMarshalValidator(cdc, validator))
+	}
+
+	return migrateDelegationsByValIndex(store, cdc)
+}
+
+// migrateDelegationsByValIndex indexes all the delegations by validator.
+func migrateDelegationsByValIndex(store sdk.KVStore, cdc codec.Marshaler) error {
+	iterator := sdk.KVStorePrefixIterator(store, types.DelegationKey)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		delegation, err := types.UnmarshalDelegation(cdc, iterator.Value())
+		if err != nil {
+			return err
+		}
+
+		keys = append(keys, types.GetDelegationByValIndexKey(delegation.DelegatorAddress, delegation.ValidatorAddress))
+	}
+
+	for _, key := range keys {
+		store.Set(key, []byte{})
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package v040_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v040staking "github.com/cosmos/cosmos-sdk/x/staking/legacy/v0_40"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	cdc := std.NewAppCodec(app.Codec())
+
+	newValidator := func(addr string, rate, maxRate string) types.Validator {
+		validator := types.NewValidator(sdk.ValAddress(addr), ed25519.GenPrivKey().PubKey(), types.Description{})
+		validator.Commission = types.NewCommission(sdk.MustNewDecFromStr(rate), sdk.MustNewDecFromStr(maxRate), sdk.ZeroDec())
+		app.StakingKeeper.SetValidator(ctx, validator)
+
+		return validator
+	}
+
+	low := newValidator("val1________________", "0.01", "0.02")
+	mid := newValidator("val2________________", "0.01", "0.10")
+	high := newValidator("val3________________", "0.10", "0.20")
+
+	delegation := types.NewDelegation(sdk.AccAddress("del1________________"), low.OperatorAddress, sdk.OneDec())
+	app.StakingKeeper.SetDelegation(ctx, delegation)
+
+	// drop the indexes which did not exist before
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	for _, validator := range []types.Validator{low, mid, high} {
+		store.Delete(types.GetValidatorByStatusKey(validator.Status, validator.OperatorAddress))
+	}
+	store.Delete(types.GetDelegationByValIndexKey(delegation.DelegatorAddress, delegation.ValidatorAddress))
+	require.Empty(t, app.StakingKeeper.GetPaginatedValidatorsByStatus(ctx, sdk.Unbonded, 1, 10))
+	require.Empty(t, app.StakingKeeper.GetValidatorDelegations(ctx, low.OperatorAddress))
+
+	minRate := sdk.MustNewDecFromStr("0.05")
+	paramSpace := app.GetSubspace(types.ModuleName)
+	paramSpace.Set(ctx, types.KeyMaxEntries, uint32(5))
+
+	require.Error(t, v040staking.MigrateStore(ctx, app.GetKey(types.StoreKey), cdc, paramSpace, sdk.NewDec(2)))
+	require.NoError(t, v040staking.MigrateStore(ctx, app.GetKey(types.StoreKey), cdc, paramSpace, minRate))
+	require.Equal(t, minRate, app.StakingKeeper.MinCommissionRate(ctx))
+	require.Equal(t, types.DefaultGlobalLiquidStakingCap, app.StakingKeeper.GlobalLiquidStakingCap(ctx))
+	require.Equal(t, types.DefaultValidatorLiquidStakingCap, app.StakingKeeper.ValidatorLiquidStakingCap(ctx))
+	require.Equal(t, uint32(5), app.StakingKeeper.MaxRedelegationEntries(ctx))
+	require.Equal(t, types.DefaultEpochLength, app.StakingKeeper.EpochLength(ctx))
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, low.OperatorAddress)
+	require.True(t, found)
+	require.Equal(t, minRate, validator.Commission.Rate)
+	require.Equal(t, minRate, validator.Commission.MaxRate)
+
+	validator, found = app.StakingKeeper.GetValidator(ctx, mid.OperatorAddress)
+	require.True(t, found)
+	require.Equal(t, minRate, validator.Commission.Rate)
+	require.Equal(t, mid.Commission.MaxRate, validator.Commission.MaxRate)
+
+	validator, found = app.StakingKeeper.GetValidator(ctx, high.OperatorAddress)
+	require.True(t, found)
+	require.Equal(t, high.Commission, validator.Commission)
+
+	require.Len(t, app.StakingKeeper.GetPaginatedValidatorsByStatus(ctx, sdk.Unbonded, 1, 10), 3)
+	require.Equal(t, []types.Delegation{delegation}, app.StakingKeeper.GetValidatorDelegations(ctx, low.OperatorAddress))
+}
@@ -16,7 +16,8 @@ import (
 func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB tmkv.Pair) string {
 	return func(kvA, kvB tmkv.Pair) string {
 		switch {
-		case bytes.Equal(kvA.Key[:1], types.LastTotalPowerKey):
+		case bytes.Equal(kvA.Key[:1], types.LastTotalPowerKey),
+			bytes.Equal(kvA.Key[:1], types.TotalLiquidStakedTokensKey):
 			var powerA, powerB sdk.IntProto
 
 			cdc.MustUnmarshalBinaryBare(kvA.Value, &powerA)
@@ -42,22 +43,84 @@ func NewDecodeStore(cdc codec.Marshaler) func(kvA, kvB tmkv.Pair) string {
 			cdc.MustUnmarshalBinaryBare(kvB.Value, &delegationB)
 
 			return fmt.Sprintf("%v\n%v", delegationA, delegationB)
-		case bytes.Equal(kvA.Key[:1], types.UnbondingDelegationKey),
-			bytes.Equal(kvA.Key[:1], types.UnbondingDelegationByValIndexKey):
+		case bytes.Equal(kvA.Key[:1], types.UnbondingDelegationKey):
 			var ubdA, ubdB types.UnbondingDelegation
 
 			cdc.MustUnmarshalBinaryBare(kvA.Value, &ubdA)
 			cdc.MustUnmarshalBinaryBare(kvB.Value, &ubdB)
 
 			return fmt.Sprintf("%v\n%v", ubdA, ubdB)
-		case bytes.Equal(kvA.Key[:1], types.RedelegationKey),
-			bytes.Equal(kvA.Key[:1], types.RedelegationByValSrcIndexKey):
+		case bytes.Equal(kvA.Key[:1], types.UnbondingIDKey):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+		case bytes.Equal(kvA.Key[:1], types.UnbondingIndexKey):
+			return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+		case bytes.Equal(kvA.Key[:1], types.RedelegationKey):
 			var redA, redB types.Redelegation
 
 			cdc.MustUnmarshalBinaryBare(kvA.Value, &redA)
 			cdc.MustUnmarshalBinaryBare(kvB.Value, &redB)
 
 			return fmt.Sprintf("%v\n%v", redA, redB)
+		case bytes.Equal(kvA.Key[:1], types.UnbondingDelegationByValIndexKey),
+			bytes.Equal(kvA.Key[:1], types.RedelegationByValSrcIndexKey),
+			bytes.Equal(kvA.Key[:1], types.RedelegationByValDstIndexKey):
+			return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+		case bytes.Equal(kvA.Key[:1], types.UnbondingQueueKey):
+			var pairsA, pairsB types.DVPairs
+
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &pairsA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &pairsB)
+
+			return fmt.Sprintf("%v\n%v", pairsA, pairsB)
+		case bytes.Equal(kvA.Key[:1], types.RedelegationQueueKey):
+			var tripletsA, tripletsB types.DVVTriplets
+
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &tripletsA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &tripletsB)
+
+			return fmt.Sprintf("%v\n%v", tripletsA, tripletsB)
+		case bytes.Equal(kvA.Key[:1], types.ValidatorQueueKey):
+			var addrsA, addrsB sdk.ValAddresses
+
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &addrsA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &addrsB)
+
+			return fmt.Sprintf("%v\n%v", addrsA, addrsB)
+		case bytes.Equal(kvA.Key[:1], types.LastEpochMsgIDKey):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+		case bytes.Equal(kvA.Key[:1], types.EpochMsgQueueKey):
+			var msgA, msgB types.EpochMsg
+
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &msgA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &msgB)
+
+			return fmt.Sprintf("%v\n%v", msgA.GetMsg(), msgB.GetMsg())
+		case bytes.Equal(kvA.Key[:1], types.HistoricalInfoKey):
+			histInfoA := types.MustUnmarshalHistoricalInfo(cdc, kvA.Value)
+			histInfoB := types.MustUnmarshalHistoricalInfo(cdc, kvB.Value)
+
+			return fmt.Sprintf("%v\n%v", histInfoA, histInfoB)
+		case bytes.Equal(kvA.Key[:1], types.LastTokenizeShareRecordIDKey),
+			bytes.Equal(kvA.Key[:1], types.TokenizeShareRecordIDByDenomPrefix):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+		case bytes.Equal(kvA.Key[:1], types.TokenizeShareRecordPrefix):
+			var recordA, recordB types.TokenizeShareRecord
+
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &recordA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &recordB)
+
+			return fmt.Sprintf("%v\n%v", recordA, recordB)
+		case bytes.Equal(kvA.Key[:1], types.TokenizeShareRecordIDByOwnerPrefix),
+			bytes.Equal(kvA.Key[:1], types.ValidatorsByStatusKey),
+			bytes.Equal(kvA.Key[:1], types.DelegationByValIndexKey):
+			return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+		case bytes.Equal(kvA.Key[:1], types.ValidatorLiquidSharesPrefix):
+			var sharesA, sharesB sdk.DecProto
+
+			cdc.MustUnmarshalBinaryBare(kvA.Value, &sharesA)
+			cdc.MustUnmarshalBinaryBare(kvB.Value, &sharesB)
+
+			return fmt.Sprintf("%v\n%v", sharesA, sharesB)
 		default:
 			panic(fmt.Sprintf("invalid staking key prefix %X", kvA.Key[:1]))
 		}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	tmkv "github.com/tendermint/tendermint/libs/kv"
 
@@ -43,6 +44,17 @@ func TestDecodeStore(t *testing.T) {
 	del := types.NewDelegation(delAddr1, valAddr1, sdk.OneDec())
 	ubd := types.NewUnbondingDelegation(delAddr1, valAddr1, 15, bondTime, sdk.OneInt())
 	red := types.NewRedelegation(delAddr1, valAddr1, valAddr1, 12, bondTime, sdk.OneInt(), sdk.OneDec())
+	record := types.NewTokenizeShareRecord(3, delAddr1, valAddr1)
+	liquidShares := sdk.DecProto{Dec: sdk.OneDec()}
+	dvPairs := types.DVPairs{Pairs: []types.DVPair{{DelegatorAddress: delAddr1, ValidatorAddress: valAddr1}}}
+	dvvTriplets := types.DVVTriplets{Triplets: []types.DVVTriplet{
+		{DelegatorAddress: delAddr1, ValidatorSrcAddress: valAddr1, ValidatorDstAddress: valAddr1},
+	}}
+	valAddrs := sdk.ValAddresses{Addresses: []sdk.ValAddress{valAddr1}}
+	histInfo := types.NewHistoricalInfo(abci.Header{ChainID: "test", Height: 5}, types.Validators{val})
+	msgDelegate := types.NewMsgDelegate(delAddr1, valAddr1, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))
+	var epochMsg types.EpochMsg
+	require.NoError(t, epochMsg.SetMsg(msgDelegate))
 
 	kvPairs := tmkv.Pairs{
 		tmkv.Pair{Key: types.LastTotalPowerKey, Value: cdc.MustMarshalBinaryBare(&sdk.IntProto{Int: sdk.OneInt()})},
@@ -50,7 +62,26 @@ func TestDecodeStore(t *testing.T) {
 		tmkv.Pair{Key: types.LastValidatorPowerKey, Value: valAddr1.Bytes()},
 		tmkv.Pair{Key: types.GetDelegationKey(delAddr1, valAddr1), Value: cdc.MustMarshalBinaryBare(&del)},
 		tmkv.Pair{Key: types.GetUBDKey(delAddr1, valAddr1), Value: cdc.MustMarshalBinaryBare(&ubd)},
+		tmkv.Pair{Key: types.UnbondingIDKey, Value: sdk.Uint64ToBigEndian(7)},
+		tmkv.Pair{Key: types.GetUnbondingIndexKey(7), Value: types.GetUBDKey(delAddr1, valAddr1)},
 		tmkv.Pair{Key: types.GetREDKey(delAddr1, valAddr1, valAddr1), Value: cdc.MustMarshalBinaryBare(&red)},
+		tmkv.Pair{Key: types.GetUBDByValIndexKey(delAddr1, valAddr1), Value: []byte{}},
+		tmkv.Pair{Key: types.GetREDByValSrcIndexKey(delAddr1, valAddr1, valAddr1), Value: []byte{}},
+		tmkv.Pair{Key: types.GetREDByValDstIndexKey(delAddr1, valAddr1, valAddr1), Value: []byte{}},
+		tmkv.Pair{Key: types.GetUnbondingDelegationTimeKey(bondTime), Value: cdc.MustMarshalBinaryBare(&dvPairs)},
+		tmkv.Pair{Key: types.GetRedelegationTimeKey(bondTime), Value: cdc.MustMarshalBinaryBare(&dvvTriplets)},
+		tmkv.Pair{Key: types.GetValidatorQueueTimeKey(bondTime), Value: cdc.MustMarshalBinaryBare(&valAddrs)},
+		tmkv.Pair{Key: types.LastEpochMsgIDKey, Value: sdk.Uint64ToBigEndian(2)},
+		tmkv.Pair{Key: types.GetEpochMsgKey(2), Value: cdc.MustMarshalBinaryBare(&epochMsg)},
+		tmkv.Pair{Key: types.GetHistoricalInfoKey(5), Value: types.MustMarshalHistoricalInfo(cdc, histInfo)},
+		tmkv.Pair{Key: types.LastTokenizeShareRecordIDKey, Value: sdk.Uint64ToBigEndian(3)},
+		tmkv.Pair{Key: types.GetTokenizeShareRecordByIndexKey(3), Value: cdc.MustMarshalBinaryBare(&record)},
+		tmkv.Pair{Key: types.GetTokenizeShareRecordIDByOwnerAndIDKey(delAddr1, 3), Value: []byte{}},
+		tmkv.Pair{Key: types.GetTokenizeShareRecordIDByDenomKey(record.GetShareTokenDenom()), Value: sdk.Uint64ToBigEndian(3)},
+		tmkv.Pair{Key: types.TotalLiquidStakedTokensKey, Value: cdc.MustMarshalBinaryBare(&sdk.IntProto{Int: sdk.OneInt()})},
+		tmkv.Pair{Key: types.GetValidatorLiquidSharesKey(valAddr1), Value: cdc.MustMarshalBinaryBare(&liquidShares)},
+		tmkv.Pair{Key: types.GetValidatorByStatusKey(sdk.Bonded, valAddr1), Value: []byte{}},
+		tmkv.Pair{Key: types.GetDelegationByValIndexKey(delAddr1, valAddr1), Value: []byte{}},
 		tmkv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
 
@@ -63,7 +94,26 @@ func TestDecodeStore(t *testing.T) {
 		{"LastValidatorPower/ValidatorsByConsAddr/ValidatorsByPowerIndex", fmt.Sprintf("%v\n%v", valAddr1, valAddr1)},
 		{"Delegation", fmt.Sprintf("%v\n%v", del, del)},
 		{"UnbondingDelegation", fmt.Sprintf("%v\n%v", ubd, ubd)},
+		{"UnbondingID", "7\n7"},
+		{"UnbondingIndex", fmt.Sprintf("%X\n%X", types.GetUBDKey(delAddr1, valAddr1), types.GetUBDKey(delAddr1, valAddr1))},
 		{"Redelegation", fmt.Sprintf("%v\n%v", red, red)},
+		{"UnbondingDelegationByValIndex", "\n"},
+		{"RedelegationByValSrcIndex", "\n"},
+		{"RedelegationByValDstIndex", "\n"},
+		{"UnbondingQueue", fmt.Sprintf("%v\n%v", dvPairs, dvPairs)},
+		{"RedelegationQueue", fmt.Sprintf("%v\n%v", dvvTriplets, dvvTriplets)},
+		{"ValidatorQueue", fmt.Sprintf("%v\n%v", valAddrs, valAddrs)},
+		{"LastEpochMsgID", "2\n2"},
+		{"EpochMsgQueue", fmt.Sprintf("%v\n%v", epochMsg.GetMsg(), epochMsg.GetMsg())},
+		{"HistoricalInfo", fmt.Sprintf("%v\n%v", histInfo, histInfo)},
+		{"LastTokenizeShareRecordID", "3\n3"},
+		{"TokenizeShareRecord", fmt.Sprintf("%v\n%v", record, record)},
+		{"TokenizeShareRecordIDByOwner", "\n"},
+		{"TokenizeShareRecordIDByDenom", "3\n3"},
+		{"TotalLiquidStakedTokens", fmt.Sprintf("%v\n%v", sdk.OneInt(), sdk.OneInt())},
+		{"ValidatorLiquidShares", fmt.Sprintf("%v\n%v", liquidShares, liquidShares)},
+		{"ValidatorsByStatus", "\n"},
+		{"DelegationByValIndex", "\n"},
 		{"other", ""},
 	}
 	for i, tt := range tests {
@@ -20,6 +20,7 @@ const (
 	unbondingTime     = "unbonding_time"
 	maxValidators     = "max_validators"
 	historicalEntries = "historical_entries"
+	epochLength       = "epoch_length"
 )
 
 // GenUnbondingTime randomized UnbondingTime
@@ -37,6 +38,11 @@ func GetHistEntries(r *rand.Rand) uint32 {
 	return uint32(r.Intn(int(types.DefaultHistoricalEntries + 1)))
 }
 
+// GenEpochLength randomized EpochLength between 1-5.
+func GenEpochLength(r *rand.Rand) uint32 {
+	return uint32(r.Intn(5) + 1)
+}
+
 // RandomizedGenState generates a random GenesisState for staking
 func RandomizedGenState(simState *module.SimulationState) {
 	// params
@@ -44,6 +50,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 		unbondTime  time.Duration
 		maxVals     uint32
 		histEntries uint32
+		epochLen    uint32
 	)
 
 	simState.AppParams.GetOrGenerate(
@@ -61,10 +68,18 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { histEntries = GetHistEntries(r) },
 	)
 
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, epochLength, &epochLen, simState.Rand,
+		func(r *rand.Rand) { epochLen = GenEpochLength(r) },
+	)
+
 	// NOTE: the slashing module need to be defined after the staking module on the
 	// NewSimulationManager constructor for this to work
 	simState.UnbondTime = unbondTime
-	params := types.NewParams(simState.UnbondTime, maxVals, 7, histEntries, sdk.DefaultBondDenom)
+	params := types.NewParams(
+		simState.UnbondTime, maxVals, 7, 7, histEntries, epochLen, sdk.DefaultBondDenom, types.DefaultMinCommissionRate,
+		types.DefaultGlobalLiquidStakingCap, types.DefaultValidatorLiquidStakingCap,
+	)
 
 	// validators & delegations
 	var (
@@ -10,6 +10,7 @@ import (
 	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -17,11 +18,14 @@ import (
 
 // Simulation operation weights constants
 const (
-	OpWeightMsgCreateValidator = "op_weight_msg_create_validator"
-	OpWeightMsgEditValidator   = "op_weight_msg_edit_validator"
-	OpWeightMsgDelegate        = "op_weight_msg_delegate"
-	OpWeightMsgUndelegate      = "op_weight_msg_undelegate"
-	OpWeightMsgBeginRedelegate = "op_weight_msg_begin_redelegate"
+	OpWeightMsgCreateValidator           = "op_weight_msg_create_validator"
+	OpWeightMsgEditValidator             = "op_weight_msg_edit_validator"
+	OpWeightMsgDelegate                  = "op_weight_msg_delegate"
+	OpWeightMsgUndelegate                = "op_weight_msg_undelegate"
+	OpWeightMsgBeginRedelegate           = "op_weight_msg_begin_redelegate"
+	OpWeightMsgCancelUnbondingDelegation = "op_weight_msg_cancel_unbonding_delegation"
+	OpWeightMsgTokenizeShares            = "op_weight_msg_tokenize_shares"
+	OpWeightMsgRedeemTokensForShares     = "op_weight_msg_redeem_tokens_for_shares"
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -30,11 +34,14 @@ func WeightedOperations(
 	bk types.BankKeeper, k keeper.Keeper,
 ) simulation.WeightedOperations {
 	var (
-		weightMsgCreateValidator int
-		weightMsgEditValidator   int
-		weightMsgDelegate        int
-		weightMsgUndelegate      int
-		weightMsgBeginRedelegate int
+		weightMsgCreateValidator           int
+		weightMsgEditValidator             int
+		weightMsgDelegate                  int
+		weightMsgUndelegate                int
+		weightMsgBeginRedelegate           int
+		weightMsgCancelUnbondingDelegation int
+		weightMsgTokenizeShares            int
+		weightMsgRedeemTokensForShares     int
 	)
 
 	appParams.GetOrGenerate(cdc, OpWeightMsgCreateValidator, &weightMsgCreateValidator, nil,
@@ -67,6 +74,24 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(cdc, OpWeightMsgCancelUnbondingDelegation, &weightMsgCancelUnbondingDelegation, nil,
+		func(_ *rand.Rand) {
+			weightMsgCancelUnbondingDelegation = simappparams.DefaultWeightMsgCancelUnbondingDelegation
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgTokenizeShares, &weightMsgTokenizeShares, nil,
+		func(_ *rand.Rand) {
+			weightMsgTokenizeShares = simappparams.DefaultWeightMsgTokenizeShares
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgRedeemTokensForShares, &weightMsgRedeemTokensForShares, nil,
+		func(_ *rand.Rand) {
+			weightMsgRedeemTokensForShares = simappparams.DefaultWeightMsgRedeemTokensForShares
+		},
+	)
+
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgCreateValidator,
@@ -88,6 +113,18 @@ func WeightedOperations(
 			weightMsgBeginRedelegate,
 			SimulateMsgBeginRedelegate(ak, bk, k),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgCancelUnbondingDelegation,
+			SimulateMsgCancelUnbondingDelegation(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgTokenizeShares,
+			SimulateMsgTokenizeShares(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRedeemTokensForShares,
+			SimulateMsgRedeemTokensForShares(ak, bk, k),
+		),
 	}
 }
 
@@ -154,7 +191,7 @@ func SimulateMsgCreateValidator(ak types.AccountKeeper, bk types.BankKeeper, k k
 		tx := helpers.GenTx(
 			[]sdk.Msg{msg},
 			fees,
-			helpers.DefaultGenTxGas,
+			helpers.SimTxGas,
 			chainID,
 			[]uint64{account.GetAccountNumber()},
 			[]uint64{account.GetSequence()},
@@ -220,7 +257,7 @@ func SimulateMsgEditValidator(ak types.AccountKeeper, bk types.BankKeeper, k kee
 		tx := helpers.GenTx(
 			[]sdk.Msg{msg},
 			fees,
-			helpers.DefaultGenTxGas,
+			helpers.SimTxGas,
 			chainID,
 			[]uint64{account.GetAccountNumber()},
 			[]uint64{account.GetSequence()},
@@ -289,7 +326,7 @@ func SimulateMsgDelegate(ak types.AccountKeeper, bk types.BankKeeper, k keeper.K
 		tx := helpers.GenTx(
 			[]sdk.Msg{msg},
 			fees,
-			helpers.DefaultGenTxGas,
+			helpers.SimTxGas,
 			chainID,
 			[]uint64{account.GetAccountNumber()},
 			[]uint64{account.GetSequence()},
@@ -324,7 +361,7 @@ func SimulateMsgUndelegate(ak types.AccountKeeper, bk types.BankKeeper, k keeper
 		delegation := delegations[r.Intn(len(delegations))]
 		delAddr := delegation.GetDelegatorAddr()
 
-		if k.HasMaxUnbondingDelegationEntries(ctx, delAddr, valAddr) {
+		if k.HasMaxUnbondingDelegationEntries(ctx, delAddr, valAddr) || isTokenizeShareRecordAccount(ctx, k, delAddr) {
 			return simtypes.NoOpMsg(types.ModuleName), nil, nil
 		}
 
@@ -371,7 +408,83 @@ func SimulateMsgUndelegate(ak types.AccountKeeper, bk types.BankKeeper, k keeper
 		tx := helpers.GenTx(
 			[]sdk.Msg{msg},
 			fees,
-			helpers.DefaultGenTxGas,
+			helpers.SimTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgCancelUnbondingDelegation generates a MsgCancelUnbondingDelegation
+// with random values
+// nolint: interfacer
+func SimulateMsgCancelUnbondingDelegation(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		// get random validator
+		validator, ok := keeper.RandomValidator(r, k, ctx)
+		if !ok || validator.IsJailed() {
+			return simtypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		valAddr := validator.GetOperator()
+		ubds := k.GetUnbondingDelegationsFromValidator(ctx, valAddr)
+		if len(ubds) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		// get random unbonding delegation entry which is not yet mature
+		ubd := ubds[r.Intn(len(ubds))]
+		entry := ubd.Entries[r.Intn(len(ubd.Entries))]
+		if entry.IsMature(ctx.BlockHeader().Time) || !entry.Balance.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		cancelAmt, err := simtypes.RandPositiveInt(r, entry.Balance)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		msg := types.NewMsgCancelUnbondingDelegation(
+			ubd.DelegatorAddress, valAddr, entry.CreationHeight, sdk.NewCoin(k.BondDenom(ctx), cancelAmt),
+		)
+
+		// need to retrieve the simulation account associated with the unbonding delegation to retrieve PrivKey
+		var simAccount simtypes.Account
+
+		for _, simAcc := range accs {
+			if simAcc.Address.Equals(ubd.DelegatorAddress) {
+				simAccount = simAcc
+				break
+			}
+		}
+		// if simaccount.PrivKey == nil, delegation address does not exist in accs. Return error
+		if simAccount.PrivKey == nil {
+			return simtypes.NoOpMsg(types.ModuleName), nil, fmt.Errorf("delegation addr: %s does not exist in simulation accounts", ubd.DelegatorAddress)
+		}
+
+		account := ak.GetAccount(ctx, ubd.DelegatorAddress)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		fees, err := simtypes.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			helpers.SimTxGas,
 			chainID,
 			[]uint64{account.GetAccountNumber()},
 			[]uint64{account.GetSequence()},
@@ -406,7 +519,7 @@ func SimulateMsgBeginRedelegate(ak types.AccountKeeper, bk types.BankKeeper, k k
 		delegation := delegations[r.Intn(len(delegations))]
 		delAddr := delegation.GetDelegatorAddr()
 
-		if k.HasReceivingRedelegation(ctx, delAddr, srcAddr) {
+		if k.HasReceivingRedelegation(ctx, delAddr, srcAddr) || isTokenizeShareRecordAccount(ctx, k, delAddr) {
 			return simtypes.NoOpMsg(types.ModuleName), nil, nil // skip
 		}
 
@@ -476,7 +589,170 @@ func SimulateMsgBeginRedelegate(ak types.AccountKeeper, bk types.BankKeeper, k k
 		tx := helpers.GenTx(
 			[]sdk.Msg{msg},
 			fees,
-			helpers.DefaultGenTxGas,
+			helpers.SimTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgTokenizeShares generates a MsgTokenizeShares with random values
+// nolint: interfacer
+func SimulateMsgTokenizeShares(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		// get random validator
+		validator, ok := keeper.RandomValidator(r, k, ctx)
+		if !ok || validator.InvalidExRate() {
+			return simtypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		valAddr := validator.GetOperator()
+		delegations := k.GetValidatorDelegations(ctx, valAddr)
+		if len(delegations) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		// get random delegator from validator
+		delegation := delegations[r.Intn(len(delegations))]
+		delAddr := delegation.GetDelegatorAddr()
+
+		if k.HasReceivingRedelegation(ctx, delAddr, valAddr) || isTokenizeShareRecordAccount(ctx, k, delAddr) {
+			return simtypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		totalBond := validator.TokensFromShares(delegation.GetShares()).TruncateInt()
+		if !totalBond.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		tokenizeAmt, err := simtypes.RandPositiveInt(r, totalBond)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		// check if the shares truncate to zero
+		shares, err := validator.SharesFromTokens(tokenizeAmt)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		if shares.TruncateInt().IsZero() {
+			return simtypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		// need to retrieve the simulation account associated with delegation to retrieve PrivKey
+		var simAccount simtypes.Account
+
+		for _, simAcc := range accs {
+			if simAcc.Address.Equals(delAddr) {
+				simAccount = simAcc
+				break
+			}
+		}
+		// if simaccount.PrivKey == nil, delegation address does not exist in accs. Return error
+		if simAccount.PrivKey == nil {
+			return simtypes.NoOpMsg(types.ModuleName), nil, fmt.Errorf("delegation addr: %s does not exist in simulation accounts", delAddr)
+		}
+
+		account := ak.GetAccount(ctx, delAddr)
+		if vacc, ok := account.(vestexported.VestingAccount); ok {
+			if vacc.GetDelegatedFree().AmountOf(k.BondDenom(ctx)).LT(tokenizeAmt) {
+				return simtypes.NoOpMsg(types.ModuleName), nil, nil
+			}
+		}
+
+		owner, _ := simtypes.RandomAcc(r, accs)
+		msg := types.NewMsgTokenizeShares(delAddr, valAddr, sdk.NewCoin(k.BondDenom(ctx), tokenizeAmt), owner.Address)
+
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		fees, err := simtypes.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			helpers.SimTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgRedeemTokensForShares generates a MsgRedeemTokensForShares with random values
+// nolint: interfacer
+func SimulateMsgRedeemTokensForShares(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		// get random tokenize share record
+		records := k.GetAllTokenizeShareRecords(ctx)
+		if len(records) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		denom := records[r.Intn(len(records))].GetShareTokenDenom()
+
+		// get random holder of the share tokens of the record
+		var (
+			simAccount simtypes.Account
+			balance    sdk.Int
+		)
+
+		for _, i := range r.Perm(len(accs)) {
+			balance = bk.SpendableCoins(ctx, accs[i].Address).AmountOf(denom)
+			if balance.IsPositive() {
+				simAccount = accs[i]
+				break
+			}
+		}
+
+		if simAccount.PrivKey == nil {
+			return simtypes.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		redeemAmt, err := simtypes.RandPositiveInt(r, balance)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		msg := types.NewMsgRedeemTokensForShares(simAccount.Address, sdk.NewCoin(denom, redeemAmt))
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		// pay the fees in the bond denom so that the redeemed share tokens are left untouched
+		fees, err := simtypes.RandomFees(r, ctx, sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), spendable.AmountOf(k.BondDenom(ctx)))))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			helpers.SimTxGas,
 			chainID,
 			[]uint64{account.GetAccountNumber()},
 			[]uint64{account.GetSequence()},
@@ -491,3 +767,15 @@ func SimulateMsgBeginRedelegate(ak types.AccountKeeper, bk types.BankKeeper, k k
 		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
 	}
 }
+
+// isTokenizeShareRecordAccount returns true if the address holds the
+// delegation of a tokenize share record.
+func isTokenizeShareRecordAccount(ctx sdk.Context, k keeper.Keeper, addr sdk.AccAddress) bool {
+	for _, record := range k.GetAllTokenizeShareRecords(ctx) {
+		if record.GetModuleAddress().Equals(addr) {
+			return true
+		}
+	}
+
+	return false
+}
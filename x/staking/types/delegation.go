@@ -100,6 +100,11 @@ func (e UnbondingDelegationEntry) IsMature(currentTime time.Time) bool {
 	return !e.CompletionTime.After(currentTime)
 }
 
+// OnHold - is the current entry on hold, i.e. prevented from completing
+func (e UnbondingDelegationEntry) OnHold() bool {
+	return e.UnbondingOnHoldRefCount > 0
+}
+
 // NewUnbondingDelegation - create a new unbonding delegation object
 func NewUnbondingDelegation(
 	delegatorAddr sdk.AccAddress, validatorAddr sdk.ValAddress,
@@ -114,10 +119,24 @@ func NewUnbondingDelegation(
 	}
 }
 
-// AddEntry - append entry to the unbonding delegation
-func (ubd *UnbondingDelegation) AddEntry(creationHeight int64, minTime time.Time, balance sdk.Int) {
+// AddEntry - append entry to the unbonding delegation, or merge it into an
+// entry with the same creation height and completion time which is not on hold.
+// It returns true if a new entry was appended.
+func (ubd *UnbondingDelegation) AddEntry(creationHeight int64, minTime time.Time, balance sdk.Int) bool {
+	for i, entry := range ubd.Entries {
+		if entry.CreationHeight == creationHeight && entry.CompletionTime.Equal(minTime) && !entry.OnHold() {
+			entry.InitialBalance = entry.InitialBalance.Add(balance)
+			entry.Balance = entry.Balance.Add(balance)
+			ubd.Entries[i] = entry
+
+			return false
+		}
+	}
+
 	entry := NewUnbondingDelegationEntry(creationHeight, minTime, balance)
 	ubd.Entries = append(ubd.Entries, entry)
+
+	return true
 }
 
 // RemoveEntry - remove entry at index i to the unbonding delegation
@@ -208,10 +227,24 @@ func NewRedelegation(
 	}
 }
 
-// AddEntry - append entry to the unbonding delegation
-func (red *Redelegation) AddEntry(creationHeight int64, minTime time.Time, balance sdk.Int, sharesDst sdk.Dec) {
+// AddEntry - append entry to the redelegation, or merge it into an entry with
+// the same creation height and completion time. It returns true if a new entry
+// was appended.
+func (red *Redelegation) AddEntry(creationHeight int64, minTime time.Time, balance sdk.Int, sharesDst sdk.Dec) bool {
+	for i, entry := range red.Entries {
+		if entry.CreationHeight == creationHeight && entry.CompletionTime.Equal(minTime) {
+			entry.InitialBalance = entry.InitialBalance.Add(balance)
+			entry.SharesDst = entry.SharesDst.Add(sharesDst)
+			red.Entries[i] = entry
+
+			return false
+		}
+	}
+
 	entry := NewRedelegationEntry(creationHeight, minTime, balance, sharesDst)
 	red.Entries = append(red.Entries, entry)
+
+	return true
 }
 
 // RemoveEntry - remove entry at index i to the unbonding delegation
@@ -415,3 +448,26 @@ func (r RedelegationResponses) String() (out string) {
 
 	return strings.TrimSpace(out)
 }
+
+// EntryLimit contains the number of entries of an unbonding delegation or a
+// redelegation along with the maximum number of entries it may hold, which is
+// more suitable for client responses.
+type EntryLimit struct {
+	Entries    uint32 `json:"entries" yaml:"entries"`
+	MaxEntries uint32 `json:"max_entries" yaml:"max_entries"`
+}
+
+// NewEntryLimit creates a new EntryLimit instance
+func NewEntryLimit(entries, maxEntries uint32) EntryLimit {
+	return EntryLimit{
+		Entries:    entries,
+		MaxEntries: maxEntries,
+	}
+}
+
+// String implements the Stringer interface for EntryLimit.
+func (l EntryLimit) String() string {
+	return fmt.Sprintf(`Entry Limit:
+  Entries:     %d
+  Max Entries: %d`, l.Entries, l.MaxEntries)
+}
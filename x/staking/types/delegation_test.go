@@ -52,6 +52,27 @@ func TestUnbondingDelegationString(t *testing.T) {
 	require.NotEmpty(t, ubd.String())
 }
 
+func TestUnbondingDelegationAddEntry(t *testing.T) {
+	ubd := NewUnbondingDelegation(sdk.AccAddress(valAddr1), valAddr2, 1,
+		time.Unix(10, 0), sdk.NewInt(5))
+
+	// an entry with the same creation height and completion time is merged
+	require.False(t, ubd.AddEntry(1, time.Unix(10, 0), sdk.NewInt(3)))
+	require.Len(t, ubd.Entries, 1)
+	require.Equal(t, sdk.NewInt(8), ubd.Entries[0].InitialBalance)
+	require.Equal(t, sdk.NewInt(8), ubd.Entries[0].Balance)
+
+	require.True(t, ubd.AddEntry(2, time.Unix(10, 0), sdk.NewInt(3)))
+	require.True(t, ubd.AddEntry(1, time.Unix(20, 0), sdk.NewInt(3)))
+	require.Len(t, ubd.Entries, 3)
+
+	// an entry on hold is not merged into
+	ubd.Entries[0].UnbondingOnHoldRefCount = 1
+	require.True(t, ubd.AddEntry(1, time.Unix(10, 0), sdk.NewInt(3)))
+	require.Len(t, ubd.Entries, 4)
+	require.Equal(t, sdk.NewInt(8), ubd.Entries[0].Balance)
+}
+
 func TestRedelegationEqual(t *testing.T) {
 	r1 := NewRedelegation(sdk.AccAddress(valAddr1), valAddr2, valAddr3, 0,
 		time.Unix(0, 0), sdk.NewInt(0),
@@ -78,6 +99,21 @@ func TestRedelegationString(t *testing.T) {
 	require.NotEmpty(t, r.String())
 }
 
+func TestRedelegationAddEntry(t *testing.T) {
+	red := NewRedelegation(sdk.AccAddress(valAddr1), valAddr2, valAddr3, 1,
+		time.Unix(10, 0), sdk.NewInt(5), sdk.NewDec(5))
+
+	// an entry with the same creation height and completion time is merged
+	require.False(t, red.AddEntry(1, time.Unix(10, 0), sdk.NewInt(3), sdk.NewDec(3)))
+	require.Len(t, red.Entries, 1)
+	require.Equal(t, sdk.NewInt(8), red.Entries[0].InitialBalance)
+	require.Equal(t, sdk.NewDec(8), red.Entries[0].SharesDst)
+
+	require.True(t, red.AddEntry(2, time.Unix(10, 0), sdk.NewInt(3), sdk.NewDec(3)))
+	require.True(t, red.AddEntry(1, time.Unix(20, 0), sdk.NewInt(3), sdk.NewDec(3)))
+	require.Len(t, red.Entries, 3)
+}
+
 func TestDelegationResponses(t *testing.T) {
 	cdc := codec.New()
 	dr1 := NewDelegationResp(sdk.AccAddress(valAddr1), valAddr2, sdk.NewDec(5),
@@ -57,4 +57,14 @@ var (
 	ErrInvalidHistoricalInfo           = sdkerrors.Register(ModuleName, 45, "invalid historical info")
 	ErrNoHistoricalInfo                = sdkerrors.Register(ModuleName, 46, "no historical info found")
 	ErrEmptyValidatorPubKey            = sdkerrors.Register(ModuleName, 47, "empty validator public key")
+	ErrCommissionLTMinRate             = sdkerrors.Register(ModuleName, 48, "commission cannot be less than min rate")
+	ErrNoUnbondingDelegationEntry      = sdkerrors.Register(ModuleName, 49, "no unbonding delegation entry found")
+	ErrUnbondingNotFound               = sdkerrors.Register(ModuleName, 50, "unbonding delegation entry not found")
+	ErrUnbondingOnHoldRefCountNegative = sdkerrors.Register(ModuleName, 51, "unbonding delegation entry is not on hold")
+
+	ErrTokenizeShareRecordNotExists      = sdkerrors.Register(ModuleName, 52, "tokenize share record not found")
+	ErrGlobalLiquidStakingCapExceeded    = sdkerrors.Register(ModuleName, 53, "tokenization exceeds the global liquid staking cap")
+	ErrValidatorLiquidStakingCapExceeded = sdkerrors.Register(ModuleName, 54, "tokenization exceeds the validator liquid staking cap")
+	ErrRedelegationInProgress            = sdkerrors.Register(ModuleName, 55, "cannot tokenize shares of a validator with a redelegation to it in progress")
+	ErrExceedingFreeVestingDelegations   = sdkerrors.Register(ModuleName, 56, "cannot tokenize more than the free delegations of a vesting account")
 )
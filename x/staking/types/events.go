@@ -2,13 +2,19 @@ package types
 
 // staking module event types
 const (
-	EventTypeCompleteUnbonding    = "complete_unbonding"
-	EventTypeCompleteRedelegation = "complete_redelegation"
-	EventTypeCreateValidator      = "create_validator"
-	EventTypeEditValidator        = "edit_validator"
-	EventTypeDelegate             = "delegate"
-	EventTypeUnbond               = "unbond"
-	EventTypeRedelegate           = "redelegate"
+	EventTypeCompleteUnbonding         = "complete_unbonding"
+	EventTypeCompleteRedelegation      = "complete_redelegation"
+	EventTypeCreateValidator           = "create_validator"
+	EventTypeEditValidator             = "edit_validator"
+	EventTypeDelegate                  = "delegate"
+	EventTypeUnbond                    = "unbond"
+	EventTypeRedelegate                = "redelegate"
+	EventTypeCancelUnbondingDelegation = "cancel_unbonding_delegation"
+	EventTypeTokenizeShares            = "tokenize_shares"
+	EventTypeRedeemShares              = "redeem_shares"
+	EventTypeJailValidator             = "jail_validator"
+	EventTypeQueueEpochMsg             = "queue_epoch_msg"
+	EventTypeEpochMsgFailed            = "epoch_msg_failed"
 
 	AttributeKeyValidator         = "validator"
 	AttributeKeyCommissionRate    = "commission_rate"
@@ -17,5 +23,10 @@ const (
 	AttributeKeyDstValidator      = "destination_validator"
 	AttributeKeyDelegator         = "delegator"
 	AttributeKeyCompletionTime    = "completion_time"
+	AttributeKeyCreationHeight    = "creation_height"
+	AttributeKeyShareOwner        = "share_owner"
+	AttributeKeyShareRecordID     = "share_record_id"
+	AttributeKeyMsgType           = "msg_type"
+	AttributeKeyError             = "error"
 	AttributeValueCategory        = ModuleName
 )
@@ -17,6 +17,14 @@ type GenesisState struct {
 	UnbondingDelegations []UnbondingDelegation `json:"unbonding_delegations" yaml:"unbonding_delegations"`
 	Redelegations        []Redelegation        `json:"redelegations" yaml:"redelegations"`
 	Exported             bool                  `json:"exported" yaml:"exported"`
+
+	TokenizeShareRecords      []TokenizeShareRecord `json:"tokenize_share_records,omitempty" yaml:"tokenize_share_records,omitempty"`
+	LastTokenizeShareRecordID uint64                `json:"last_tokenize_share_record_id,omitempty" yaml:"last_tokenize_share_record_id,omitempty"`
+	TotalLiquidStakedTokens   sdk.Int               `json:"total_liquid_staked_tokens,omitempty" yaml:"total_liquid_staked_tokens,omitempty"`
+	LastUnbondingID           uint64                `json:"last_unbonding_id,omitempty" yaml:"last_unbonding_id,omitempty"`
+
+	// EpochMsgs are the delegation messages queued until the end of the epoch.
+	EpochMsgs []sdk.Msg `json:"epoch_msgs,omitempty" yaml:"epoch_msgs,omitempty"`
 }
 
 // LastValidatorPower required for validator set update logic
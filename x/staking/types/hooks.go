@@ -4,60 +4,121 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// combine multiple staking hooks, all hook functions are run in array sequence
+// HookErrorMode defines how the staking keeper handles the errors returned by
+// the staking hooks.
+type HookErrorMode uint8
+
+const (
+	// HookErrorModePropagate aborts the staking operation calling a failing hook
+	// and returns its error. The errors of the hooks called on slashing, jailing
+	// and validator set transitions, which are driven by the block and cannot
+	// be aborted, are logged instead.
+	HookErrorModePropagate HookErrorMode = iota
+
+	// HookErrorModeLog logs the errors returned by the hooks, and carries on with
+	// the staking operation without the state changes of the failing hooks.
+	HookErrorModeLog
+)
+
+// combine multiple staking hooks, all hook functions are run in array sequence.
+// Each hook is run in its own cache context, whose state changes and events are
+// only written if the hook succeeds, and the first error returned by a hook is returned once all of them
+// have run.
 type MultiStakingHooks []StakingHooks
 
 func NewMultiStakingHooks(hooks ...StakingHooks) MultiStakingHooks {
 	return hooks
 }
 
-func (h MultiStakingHooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) {
+// run calls the given function on every hook in its own cache context.
+func (h MultiStakingHooks) run(ctx sdk.Context, call func(ctx sdk.Context, hook StakingHooks) error) error {
+	var firstErr error
+
 	for i := range h {
-		h[i].AfterValidatorCreated(ctx, valAddr)
+		cacheCtx, write := ctx.CacheContext()
+		if err := call(cacheCtx, h[i]); err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+
+			continue
+		}
+
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
 	}
+
+	return firstErr
 }
-func (h MultiStakingHooks) BeforeValidatorModified(ctx sdk.Context, valAddr sdk.ValAddress) {
-	for i := range h {
-		h[i].BeforeValidatorModified(ctx, valAddr)
-	}
+
+func (h MultiStakingHooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) error {
+	return h.run(ctx, func(ctx sdk.Context, hook StakingHooks) error {
+		return hook.AfterValidatorCreated(ctx, valAddr)
+	})
 }
-func (h MultiStakingHooks) AfterValidatorRemoved(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
-	for i := range h {
-		h[i].AfterValidatorRemoved(ctx, consAddr, valAddr)
-	}
+
+func (h MultiStakingHooks) BeforeValidatorModified(ctx sdk.Context, valAddr sdk.ValAddress) error {
+	return h.run(ctx, func(ctx sdk.Context, hook StakingHooks) error {
+		return hook.BeforeValidatorModified(ctx, valAddr)
+	})
 }
-func (h MultiStakingHooks) AfterValidatorBonded(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
-	for i := range h {
-		h[i].AfterValidatorBonded(ctx, consAddr, valAddr)
-	}
+
+func (h MultiStakingHooks) AfterValidatorRemoved(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	return h.run(ctx, func(ctx sdk.Context, hook StakingHooks) error {
+		return hook.AfterValidatorRemoved(ctx, consAddr, valAddr)
+	})
 }
-func (h MultiStakingHooks) AfterValidatorBeginUnbonding(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
-	for i := range h {
-		h[i].AfterValidatorBeginUnbonding(ctx, consAddr, valAddr)
-	}
+
+func (h MultiStakingHooks) AfterValidatorBonded(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	return h.run(ctx, func(ctx sdk.Context, hook StakingHooks) error {
+		return hook.AfterValidatorBonded(ctx, consAddr, valAddr)
+	})
 }
-func (h MultiStakingHooks) BeforeDelegationCreated(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
-	for i := range h {
-		h[i].BeforeDelegationCreated(ctx, delAddr, valAddr)
-	}
+
+func (h MultiStakingHooks) AfterValidatorBeginUnbonding(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	return h.run(ctx, func(ctx sdk.Context, hook StakingHooks) error {
+		return hook.AfterValidatorBeginUnbonding(ctx, consAddr, valAddr)
+	})
 }
-func (h MultiStakingHooks) BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
-	for i := range h {
-		h[i].BeforeDelegationSharesModified(ctx, delAddr, valAddr)
-	}
+
+func (h MultiStakingHooks) AfterValidatorJailed(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	return h.run(ctx, func(ctx sdk.Context, hook StakingHooks) error {
+		return hook.AfterValidatorJailed(ctx, consAddr, valAddr)
+	})
 }
-func (h MultiStakingHooks) BeforeDelegationRemoved(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
-	for i := range h {
-		h[i].BeforeDelegationRemoved(ctx, delAddr, valAddr)
-	}
+
+func (h MultiStakingHooks) BeforeDelegationCreated(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return h.run(ctx, func(ctx sdk.Context, hook StakingHooks) error {
+		return hook.BeforeDelegationCreated(ctx, delAddr, valAddr)
+	})
 }
-func (h MultiStakingHooks) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
-	for i := range h {
-		h[i].AfterDelegationModified(ctx, delAddr, valAddr)
-	}
+
+func (h MultiStakingHooks) BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return h.run(ctx, func(ctx sdk.Context, hook StakingHooks) error {
+		return hook.BeforeDelegationSharesModified(ctx, delAddr, valAddr)
+	})
 }
-func (h MultiStakingHooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) {
-	for i := range h {
-		h[i].BeforeValidatorSlashed(ctx, valAddr, fraction)
-	}
+
+func (h MultiStakingHooks) BeforeDelegationRemoved(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return h.run(ctx, func(ctx sdk.Context, hook StakingHooks) error {
+		return hook.BeforeDelegationRemoved(ctx, delAddr, valAddr)
+	})
+}
+
+func (h MultiStakingHooks) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return h.run(ctx, func(ctx sdk.Context, hook StakingHooks) error {
+		return hook.AfterDelegationModified(ctx, delAddr, valAddr)
+	})
+}
+
+func (h MultiStakingHooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) error {
+	return h.run(ctx, func(ctx sdk.Context, hook StakingHooks) error {
+		return hook.BeforeValidatorSlashed(ctx, valAddr, fraction)
+	})
+}
+
+func (h MultiStakingHooks) AfterUnbondingInitiated(ctx sdk.Context, id uint64) error {
+	return h.run(ctx, func(ctx sdk.Context, hook StakingHooks) error {
+		return hook.AfterUnbondingInitiated(ctx, id)
+	})
 }
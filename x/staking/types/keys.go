@@ -31,6 +31,7 @@ var (
 	ValidatorsKey             = []byte{0x21} // prefix for each key to a validator
 	ValidatorsByConsAddrKey   = []byte{0x22} // prefix for each key to a validator index, by pubkey
 	ValidatorsByPowerIndexKey = []byte{0x23} // prefix for each key to a validator index, sorted by power
+	ValidatorsByStatusKey     = []byte{0x24} // prefix for each key to a validator index, by bond status
 
 	DelegationKey                    = []byte{0x31} // key for a delegation
 	UnbondingDelegationKey           = []byte{0x32} // key for an unbonding-delegation
@@ -38,12 +39,25 @@ var (
 	RedelegationKey                  = []byte{0x34} // key for a redelegation
 	RedelegationByValSrcIndexKey     = []byte{0x35} // prefix for each key for an redelegation, by source validator operator
 	RedelegationByValDstIndexKey     = []byte{0x36} // prefix for each key for an redelegation, by destination validator operator
+	UnbondingIDKey                   = []byte{0x37} // key for the last unbonding delegation entry ID
+	UnbondingIndexKey                = []byte{0x38} // prefix for each key for an unbonding-delegation, by unbonding delegation entry ID
+	DelegationByValIndexKey          = []byte{0x39} // prefix for each key for a delegation, by validator operator
 
 	UnbondingQueueKey    = []byte{0x41} // prefix for the timestamps in unbonding queue
 	RedelegationQueueKey = []byte{0x42} // prefix for the timestamps in redelegations queue
 	ValidatorQueueKey    = []byte{0x43} // prefix for the timestamps in validator queue
 
+	LastEpochMsgIDKey = []byte{0x44} // key for the last queued epoch message ID
+	EpochMsgQueueKey  = []byte{0x45} // prefix for the delegation messages queued until the end of the epoch
+
 	HistoricalInfoKey = []byte{0x50} // prefix for the historical info
+
+	LastTokenizeShareRecordIDKey       = []byte{0x61} // key for the last tokenize share record ID
+	TokenizeShareRecordPrefix          = []byte{0x62} // prefix for each key to a tokenize share record
+	TokenizeShareRecordIDByOwnerPrefix = []byte{0x63} // prefix for each key to a tokenize share record ID, by owner
+	TokenizeShareRecordIDByDenomPrefix = []byte{0x64} // prefix for each key to a tokenize share record ID, by share denom
+	TotalLiquidStakedTokensKey         = []byte{0x65} // key for the total liquid staked tokens
+	ValidatorLiquidSharesPrefix        = []byte{0x66} // prefix for each key to the liquid staked shares of a validator
 )
 
 // gets the key for the validator with address
@@ -58,6 +72,22 @@ func GetValidatorByConsAddrKey(addr sdk.ConsAddress) []byte {
 	return append(ValidatorsByConsAddrKey, addr.Bytes()...)
 }
 
+// gets the key for the validator with address, stored by bond status
+// VALUE: none (key rearrangement used)
+func GetValidatorByStatusKey(status sdk.BondStatus, operatorAddr sdk.ValAddress) []byte {
+	return append(GetValidatorsByStatusKey(status), operatorAddr.Bytes()...)
+}
+
+// gets the prefix for all the validators with a bond status
+func GetValidatorsByStatusKey(status sdk.BondStatus) []byte {
+	return append(ValidatorsByStatusKey, byte(status))
+}
+
+// Get the validator operator address from GetValidatorByStatusKey
+func AddressFromValidatorByStatusKey(key []byte) []byte {
+	return key[2:] // remove prefix and status bytes
+}
+
 // Get the validator operator address from LastValidatorPowerKey
 func AddressFromLastValidatorPowerKey(key []byte) []byte {
 	return key[1:] // remove prefix bytes
@@ -67,9 +97,9 @@ func AddressFromLastValidatorPowerKey(key []byte) []byte {
 // Power index is the key used in the power-store, and represents the relative
 // power ranking of the validator.
 // VALUE: validator operator address ([]byte)
-func GetValidatorsByPowerIndexKey(validator Validator) []byte {
+func GetValidatorsByPowerIndexKey(validator Validator, powerReduction sdk.Int) []byte {
 	// NOTE the address doesn't need to be stored because counter bytes must always be different
-	return getValidatorPowerRank(validator)
+	return getValidatorPowerRank(validator, powerReduction)
 }
 
 // get the bonded validator index key for an operator address
@@ -79,8 +109,8 @@ func GetLastValidatorPowerKey(operator sdk.ValAddress) []byte {
 
 // get the power ranking of a validator
 // NOTE the larger values are of higher value
-func getValidatorPowerRank(validator Validator) []byte {
-	consensusPower := sdk.TokensToConsensusPower(validator.Tokens)
+func getValidatorPowerRank(validator Validator, powerReduction sdk.Int) []byte {
+	consensusPower := sdk.TokensToConsensusPower(validator.Tokens, powerReduction)
 	consensusPowerBytes := make([]byte, 8)
 	binary.BigEndian.PutUint64(consensusPowerBytes, uint64(consensusPower))
 
@@ -126,6 +156,11 @@ func GetValidatorQueueTimeKey(timestamp time.Time) []byte {
 	return append(ValidatorQueueKey, bz...)
 }
 
+// GetEpochMsgKey gets the key of the epoch message queued with the given ID.
+func GetEpochMsgKey(id uint64) []byte {
+	return append(EpochMsgQueueKey, sdk.Uint64ToBigEndian(id)...)
+}
+
 //______________________________________________________________________________
 
 // gets the key for delegator bond with validator
@@ -139,6 +174,30 @@ func GetDelegationsKey(delAddr sdk.AccAddress) []byte {
 	return append(DelegationKey, delAddr.Bytes()...)
 }
 
+// gets the index-key for a delegation, stored by validator-index
+// VALUE: none (key rearrangement used)
+func GetDelegationByValIndexKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	return append(GetDelegationsByValIndexKey(valAddr), delAddr.Bytes()...)
+}
+
+// gets the prefix keyspace for the indexes of the delegations to a validator
+func GetDelegationsByValIndexKey(valAddr sdk.ValAddress) []byte {
+	return append(DelegationByValIndexKey, valAddr.Bytes()...)
+}
+
+// rearranges the ValIndexKey to get the DelegationKey
+func GetDelegationKeyFromValIndexKey(indexKey []byte) []byte {
+	addrs := indexKey[1:] // remove prefix bytes
+	if len(addrs) != 2*sdk.AddrLen {
+		panic("unexpected key length")
+	}
+
+	valAddr := addrs[:sdk.AddrLen]
+	delAddr := addrs[sdk.AddrLen:]
+
+	return GetDelegationKey(delAddr, valAddr)
+}
+
 //______________________________________________________________________________
 
 // gets the key for an unbonding delegation by delegator and validator addr
@@ -180,6 +239,12 @@ func GetUBDsByValIndexKey(valAddr sdk.ValAddress) []byte {
 	return append(UnbondingDelegationByValIndexKey, valAddr.Bytes()...)
 }
 
+// gets the index-key for an unbonding delegation, stored by the ID of one of its entries
+// VALUE: unbonding delegation key ([]byte)
+func GetUnbondingIndexKey(id uint64) []byte {
+	return append(UnbondingIndexKey, sdk.Uint64ToBigEndian(id)...)
+}
+
 // gets the prefix for all unbonding delegations from a delegator
 func GetUnbondingDelegationTimeKey(timestamp time.Time) []byte {
 	bz := sdk.FormatTimeBytes(timestamp)
@@ -293,3 +358,37 @@ func GetREDsByDelToValDstIndexKey(delAddr sdk.AccAddress, valDstAddr sdk.ValAddr
 func GetHistoricalInfoKey(height int64) []byte {
 	return append(HistoricalInfoKey, []byte(strconv.FormatInt(height, 10))...)
 }
+
+//________________________________________________________________________________
+
+// GetTokenizeShareRecordByIndexKey gets the key for a tokenize share record by ID
+// VALUE: staking/TokenizeShareRecord
+func GetTokenizeShareRecordByIndexKey(id uint64) []byte {
+	return append(TokenizeShareRecordPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordIDsByOwnerPrefix gets the prefix for all tokenize share
+// record IDs of an owner
+func GetTokenizeShareRecordIDsByOwnerPrefix(owner sdk.AccAddress) []byte {
+	return append(TokenizeShareRecordIDByOwnerPrefix, owner.Bytes()...)
+}
+
+// GetTokenizeShareRecordIDByOwnerAndIDKey gets the index-key for a tokenize
+// share record, stored by owner and ID
+// VALUE: none (key rearrangement used)
+func GetTokenizeShareRecordIDByOwnerAndIDKey(owner sdk.AccAddress, id uint64) []byte {
+	return append(GetTokenizeShareRecordIDsByOwnerPrefix(owner), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordIDByDenomKey gets the index-key for a tokenize share
+// record, stored by share denom
+// VALUE: tokenize share record ID (uint64)
+func GetTokenizeShareRecordIDByDenomKey(denom string) []byte {
+	return append(TokenizeShareRecordIDByDenomPrefix, []byte(denom)...)
+}
+
+// GetValidatorLiquidSharesKey gets the key for the liquid staked shares of a validator
+// VALUE: sdk.Dec
+func GetValidatorLiquidSharesKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorLiquidSharesPrefix, valAddr.Bytes()...)
+}
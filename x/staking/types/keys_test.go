@@ -26,10 +26,10 @@ func TestGetValidatorPowerRank(t *testing.T) {
 	val1 := NewValidator(valAddr1, keysPK1, emptyDesc)
 	val1.Tokens = sdk.ZeroInt()
 	val2, val3, val4 := val1, val1, val1
-	val2.Tokens = sdk.TokensFromConsensusPower(1)
-	val3.Tokens = sdk.TokensFromConsensusPower(10)
+	val2.Tokens = sdk.TokensFromConsensusPower(1, sdk.DefaultPowerReduction)
+	val3.Tokens = sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
 	x := new(big.Int).Exp(big.NewInt(2), big.NewInt(40), big.NewInt(0))
-	val4.Tokens = sdk.TokensFromConsensusPower(x.Int64())
+	val4.Tokens = sdk.TokensFromConsensusPower(x.Int64(), sdk.DefaultPowerReduction)
 
 	tests := []struct {
 		validator Validator
@@ -41,7 +41,7 @@ func TestGetValidatorPowerRank(t *testing.T) {
 		{val4, "2300000100000000009c288ede7df62742fc3b7d0962045a8cef0f79f6"},
 	}
 	for i, tt := range tests {
-		got := hex.EncodeToString(getValidatorPowerRank(tt.validator))
+		got := hex.EncodeToString(getValidatorPowerRank(tt.validator, sdk.DefaultPowerReduction))
 
 		assert.Equal(t, tt.wantHex, got, "Keys did not match on test case %d", i)
 	}
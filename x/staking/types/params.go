@@ -23,13 +23,31 @@ const (
 	// Default maximum number of bonded validators
 	DefaultMaxValidators uint32 = 100
 
-	// Default maximum entries in a UBD/RED pair
+	// Default maximum entries in a UBD pair
 	DefaultMaxEntries uint32 = 7
 
+	// Default maximum entries in a RED trio
+	DefaultMaxRedelegationEntries uint32 = 7
+
 	// DefaultHistorical entries is 100. Apps that don't use IBC can ignore this
 	// value by not adding the staking module to the application module manager's
 	// SetOrderBeginBlockers.
 	DefaultHistoricalEntries uint32 = 100
+
+	// DefaultEpochLength is 1, i.e. the validator set is updated at the end of
+	// every block.
+	DefaultEpochLength uint32 = 1
+)
+
+// DefaultMinCommissionRate is set to 0%, i.e. validators may set any
+// commission rate by default.
+var DefaultMinCommissionRate = sdk.ZeroDec()
+
+// DefaultGlobalLiquidStakingCap and DefaultValidatorLiquidStakingCap are set
+// to 100%, i.e. liquid staking is not capped by default.
+var (
+	DefaultGlobalLiquidStakingCap    = sdk.OneDec()
+	DefaultValidatorLiquidStakingCap = sdk.OneDec()
 )
 
 var (
@@ -38,18 +56,34 @@ var (
 	KeyMaxEntries        = []byte("KeyMaxEntries")
 	KeyBondDenom         = []byte("BondDenom")
 	KeyHistoricalEntries = []byte("HistoricalEntries")
+	KeyMinCommissionRate = []byte("MinCommissionRate")
+
+	KeyGlobalLiquidStakingCap    = []byte("GlobalLiquidStakingCap")
+	KeyValidatorLiquidStakingCap = []byte("ValidatorLiquidStakingCap")
+
+	KeyMaxRedelegationEntries = []byte("MaxRedelegationEntries")
+	KeyEpochLength            = []byte("EpochLength")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
 // NewParams creates a new Params instance
-func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string) Params {
+func NewParams(
+	unbondingTime time.Duration, maxValidators, maxEntries, maxRedelegationEntries, historicalEntries, epochLength uint32,
+	bondDenom string, minCommissionRate, globalLiquidStakingCap, validatorLiquidStakingCap sdk.Dec,
+) Params {
 	return Params{
 		UnbondingTime:     unbondingTime,
 		MaxValidators:     maxValidators,
 		MaxEntries:        maxEntries,
 		HistoricalEntries: historicalEntries,
 		BondDenom:         bondDenom,
+		MinCommissionRate: minCommissionRate,
+
+		GlobalLiquidStakingCap:    globalLiquidStakingCap,
+		ValidatorLiquidStakingCap: validatorLiquidStakingCap,
+		MaxRedelegationEntries:    maxRedelegationEntries,
+		EpochLength:               epochLength,
 	}
 }
 
@@ -61,6 +95,11 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyMaxEntries, &p.MaxEntries, validateMaxEntries),
 		paramtypes.NewParamSetPair(KeyHistoricalEntries, &p.HistoricalEntries, validateHistoricalEntries),
 		paramtypes.NewParamSetPair(KeyBondDenom, &p.BondDenom, validateBondDenom),
+		paramtypes.NewParamSetPair(KeyMinCommissionRate, &p.MinCommissionRate, validateMinCommissionRate),
+		paramtypes.NewParamSetPair(KeyGlobalLiquidStakingCap, &p.GlobalLiquidStakingCap, validateLiquidStakingCap),
+		paramtypes.NewParamSetPair(KeyValidatorLiquidStakingCap, &p.ValidatorLiquidStakingCap, validateLiquidStakingCap),
+		paramtypes.NewParamSetPair(KeyMaxRedelegationEntries, &p.MaxRedelegationEntries, validateMaxEntries),
+		paramtypes.NewParamSetPair(KeyEpochLength, &p.EpochLength, validateEpochLength),
 	}
 }
 
@@ -70,8 +109,13 @@ func DefaultParams() Params {
 		DefaultUnbondingTime,
 		DefaultMaxValidators,
 		DefaultMaxEntries,
+		DefaultMaxRedelegationEntries,
 		DefaultHistoricalEntries,
+		DefaultEpochLength,
 		sdk.DefaultBondDenom,
+		DefaultMinCommissionRate,
+		DefaultGlobalLiquidStakingCap,
+		DefaultValidatorLiquidStakingCap,
 	)
 }
 
@@ -115,10 +159,30 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateMaxEntries(p.MaxRedelegationEntries); err != nil {
+		return err
+	}
+
+	if err := validateEpochLength(p.EpochLength); err != nil {
+		return err
+	}
+
 	if err := validateBondDenom(p.BondDenom); err != nil {
 		return err
 	}
 
+	if err := validateMinCommissionRate(p.MinCommissionRate); err != nil {
+		return err
+	}
+
+	if err := validateLiquidStakingCap(p.GlobalLiquidStakingCap); err != nil {
+		return err
+	}
+
+	if err := validateLiquidStakingCap(p.ValidatorLiquidStakingCap); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -170,6 +234,19 @@ func validateHistoricalEntries(i interface{}) error {
 	return nil
 }
 
+func validateEpochLength(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("epoch length must be positive: %d", v)
+	}
+
+	return nil
+}
+
 func validateBondDenom(i interface{}) error {
 	v, ok := i.(string)
 	if !ok {
@@ -186,3 +263,41 @@ func validateBondDenom(i interface{}) error {
 
 	return nil
 }
+
+func validateMinCommissionRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("minimum commission rate cannot be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("minimum commission rate cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("minimum commission rate too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateLiquidStakingCap(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("liquid staking cap cannot be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("liquid staking cap cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("liquid staking cap too large: %s", v)
+	}
+
+	return nil
+}
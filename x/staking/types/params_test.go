@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestParamsEqual(t *testing.T) {
@@ -19,3 +21,61 @@ func TestParamsEqual(t *testing.T) {
 	ok = p1.Equal(p2)
 	require.False(t, ok)
 }
+
+func TestValidateMinCommissionRate(t *testing.T) {
+	p := DefaultParams()
+	require.NoError(t, p.Validate())
+
+	p.MinCommissionRate = sdk.OneDec()
+	require.NoError(t, p.Validate())
+
+	p.MinCommissionRate = sdk.NewDec(-1)
+	require.Error(t, p.Validate())
+
+	p.MinCommissionRate = sdk.NewDecWithPrec(11, 1)
+	require.Error(t, p.Validate())
+
+	p.MinCommissionRate = sdk.Dec{}
+	require.Error(t, p.Validate())
+}
+
+func TestValidateLiquidStakingCaps(t *testing.T) {
+	p := DefaultParams()
+	require.NoError(t, p.Validate())
+
+	p.GlobalLiquidStakingCap = sdk.ZeroDec()
+	p.ValidatorLiquidStakingCap = sdk.NewDecWithPrec(5, 1)
+	require.NoError(t, p.Validate())
+
+	p.GlobalLiquidStakingCap = sdk.NewDec(-1)
+	require.Error(t, p.Validate())
+
+	p.GlobalLiquidStakingCap = sdk.OneDec()
+	p.ValidatorLiquidStakingCap = sdk.NewDecWithPrec(11, 1)
+	require.Error(t, p.Validate())
+
+	p.ValidatorLiquidStakingCap = sdk.Dec{}
+	require.Error(t, p.Validate())
+}
+
+func TestValidateMaxRedelegationEntries(t *testing.T) {
+	p := DefaultParams()
+	require.Equal(t, DefaultMaxRedelegationEntries, p.MaxRedelegationEntries)
+
+	p.MaxRedelegationEntries = 1
+	require.NoError(t, p.Validate())
+
+	p.MaxRedelegationEntries = 0
+	require.Error(t, p.Validate())
+}
+
+func TestValidateEpochLength(t *testing.T) {
+	p := DefaultParams()
+	require.Equal(t, DefaultEpochLength, p.EpochLength)
+
+	p.EpochLength = 10
+	require.NoError(t, p.Validate())
+
+	p.EpochLength = 0
+	require.Error(t, p.Validate())
+}
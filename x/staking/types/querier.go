@@ -8,6 +8,7 @@ import (
 const (
 	QueryValidators                    = "validators"
 	QueryValidator                     = "validator"
+	QueryValidatorSelfBond             = "validatorSelfBond"
 	QueryDelegatorDelegations          = "delegatorDelegations"
 	QueryDelegatorUnbondingDelegations = "delegatorUnbondingDelegations"
 	QueryRedelegations                 = "redelegations"
@@ -16,11 +17,16 @@ const (
 	QueryValidatorUnbondingDelegations = "validatorUnbondingDelegations"
 	QueryDelegation                    = "delegation"
 	QueryUnbondingDelegation           = "unbondingDelegation"
+	QueryUnbondingDelegationEntryLimit = "unbondingDelegationEntryLimit"
+	QueryRedelegationEntryLimit        = "redelegationEntryLimit"
 	QueryDelegatorValidators           = "delegatorValidators"
 	QueryDelegatorValidator            = "delegatorValidator"
 	QueryPool                          = "pool"
 	QueryParameters                    = "parameters"
 	QueryHistoricalInfo                = "historicalInfo"
+	QueryTokenizeShareRecordByDenom    = "tokenizeShareRecordByDenom"
+	QueryTokenizeShareRecordsOwned     = "tokenizeShareRecordsOwned"
+	QueryTotalLiquidStaked             = "totalLiquidStaked"
 )
 
 // defines the params for the following queries:
@@ -28,34 +34,50 @@ const (
 // - 'custom/staking/delegatorUnbondingDelegations'
 // - 'custom/staking/delegatorRedelegations'
 // - 'custom/staking/delegatorValidators'
+//
+// Page and Limit only apply to the delegations and unbonding delegations
+// queries, which default to the first page of 100 results and return the total
+// number of results along with the page.
 type QueryDelegatorParams struct {
 	DelegatorAddr sdk.AccAddress
+	Page, Limit   int
 }
 
-func NewQueryDelegatorParams(delegatorAddr sdk.AccAddress) QueryDelegatorParams {
+func NewQueryDelegatorParams(delegatorAddr sdk.AccAddress, page, limit int) QueryDelegatorParams {
 	return QueryDelegatorParams{
 		DelegatorAddr: delegatorAddr,
+		Page:          page,
+		Limit:         limit,
 	}
 }
 
 // defines the params for the following queries:
 // - 'custom/staking/validator'
+// - 'custom/staking/validatorSelfBond'
 // - 'custom/staking/validatorDelegations'
 // - 'custom/staking/validatorUnbondingDelegations'
 // - 'custom/staking/validatorRedelegations'
+//
+// Page and Limit only apply to the validator delegations query, which defaults
+// to the first page of 100 results and returns the total number of results along
+// with the page.
 type QueryValidatorParams struct {
 	ValidatorAddr sdk.ValAddress
+	Page, Limit   int
 }
 
-func NewQueryValidatorParams(validatorAddr sdk.ValAddress) QueryValidatorParams {
+func NewQueryValidatorParams(validatorAddr sdk.ValAddress, page, limit int) QueryValidatorParams {
 	return QueryValidatorParams{
 		ValidatorAddr: validatorAddr,
+		Page:          page,
+		Limit:         limit,
 	}
 }
 
 // defines the params for the following queries:
 // - 'custom/staking/delegation'
 // - 'custom/staking/unbondingDelegation'
+// - 'custom/staking/unbondingDelegationEntryLimit'
 // - 'custom/staking/delegatorValidator'
 type QueryBondsParams struct {
 	DelegatorAddr sdk.AccAddress
@@ -71,10 +93,17 @@ func NewQueryBondsParams(delegatorAddr sdk.AccAddress, validatorAddr sdk.ValAddr
 
 // defines the params for the following queries:
 // - 'custom/staking/redelegation'
+// - 'custom/staking/redelegationEntryLimit'
+//
+// Page and Limit select a page of the redelegations matching the addresses,
+// defaulting to the first page of 100 results, which is returned along with the
+// total number of matching redelegations. The redelegation entry limit query
+// requires all three addresses.
 type QueryRedelegationParams struct {
 	DelegatorAddr    sdk.AccAddress
 	SrcValidatorAddr sdk.ValAddress
 	DstValidatorAddr sdk.ValAddress
+	Page, Limit      int
 }
 
 func NewQueryRedelegationParams(delegatorAddr sdk.AccAddress, srcValidatorAddr, dstValidatorAddr sdk.ValAddress) QueryRedelegationParams {
@@ -106,3 +135,66 @@ type QueryHistoricalInfoParams struct {
 func NewQueryHistoricalInfoParams(height int64) QueryHistoricalInfoParams {
 	return QueryHistoricalInfoParams{height}
 }
+
+// QueryTokenizeShareRecordByDenomParams defines the params for the following queries:
+// - 'custom/staking/tokenizeShareRecordByDenom'
+type QueryTokenizeShareRecordByDenomParams struct {
+	Denom string
+}
+
+// NewQueryTokenizeShareRecordByDenomParams creates a new QueryTokenizeShareRecordByDenomParams instance
+func NewQueryTokenizeShareRecordByDenomParams(denom string) QueryTokenizeShareRecordByDenomParams {
+	return QueryTokenizeShareRecordByDenomParams{denom}
+}
+
+// QueryTokenizeShareRecordsOwnedParams defines the params for the following queries:
+// - 'custom/staking/tokenizeShareRecordsOwned'
+type QueryTokenizeShareRecordsOwnedParams struct {
+	Owner sdk.AccAddress
+}
+
+// NewQueryTokenizeShareRecordsOwnedParams creates a new QueryTokenizeShareRecordsOwnedParams instance
+func NewQueryTokenizeShareRecordsOwnedParams(owner sdk.AccAddress) QueryTokenizeShareRecordsOwnedParams {
+	return QueryTokenizeShareRecordsOwnedParams{owner}
+}
+
+// QueryDelegationsResult is the result of the following paginated queries,
+// along with the total number of delegations matching the query:
+// - 'custom/staking/delegatorDelegations'
+// - 'custom/staking/validatorDelegations'
+type QueryDelegationsResult struct {
+	Delegations DelegationResponses `json:"delegations" yaml:"delegations"`
+	Total       int                 `json:"total" yaml:"total"`
+}
+
+// NewQueryDelegationsResult creates a new QueryDelegationsResult instance
+func NewQueryDelegationsResult(delegations DelegationResponses, total int) QueryDelegationsResult {
+	return QueryDelegationsResult{delegations, total}
+}
+
+// QueryUnbondingDelegationsResult is the result of the following paginated
+// queries, along with the total number of unbonding delegations matching the
+// query:
+// - 'custom/staking/delegatorUnbondingDelegations'
+type QueryUnbondingDelegationsResult struct {
+	UnbondingDelegations UnbondingDelegations `json:"unbonding_delegations" yaml:"unbonding_delegations"`
+	Total                int                  `json:"total" yaml:"total"`
+}
+
+// NewQueryUnbondingDelegationsResult creates a new QueryUnbondingDelegationsResult instance
+func NewQueryUnbondingDelegationsResult(ubds UnbondingDelegations, total int) QueryUnbondingDelegationsResult {
+	return QueryUnbondingDelegationsResult{ubds, total}
+}
+
+// QueryRedelegationsResult is the result of the following paginated
+// queries, along with the total number of redelegations matching the query:
+// - 'custom/staking/redelegations'
+type QueryRedelegationsResult struct {
+	Redelegations RedelegationResponses `json:"redelegations" yaml:"redelegations"`
+	Total         int                   `json:"total" yaml:"total"`
+}
+
+// NewQueryRedelegationsResult creates a new QueryRedelegationsResult instance
+func NewQueryRedelegationsResult(redels RedelegationResponses, total int) QueryRedelegationsResult {
+	return QueryRedelegationsResult{redels, total}
+}
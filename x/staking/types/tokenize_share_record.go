@@ -0,0 +1,58 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// TokenizeShareModuleAccountPrefix is the prefix of the names of the module
+// accounts holding the delegations of tokenize share records.
+const TokenizeShareModuleAccountPrefix = "tokenizeshare_"
+
+// NewTokenizeShareRecord creates a new TokenizeShareRecord instance. The
+// record's module account is derived from its ID.
+func NewTokenizeShareRecord(id uint64, owner sdk.AccAddress, validator sdk.ValAddress) TokenizeShareRecord {
+	return TokenizeShareRecord{
+		Id:            id,
+		Owner:         owner,
+		ModuleAccount: fmt.Sprintf("%s%d", TokenizeShareModuleAccountPrefix, id),
+		Validator:     validator,
+	}
+}
+
+// GetModuleAddress returns the address of the account holding the delegation
+// of the record.
+func (r TokenizeShareRecord) GetModuleAddress() sdk.AccAddress {
+	return sdk.AccAddress(address.Module(r.ModuleAccount))
+}
+
+// GetShareTokenDenom returns the denom of the share tokens of the record.
+func (r TokenizeShareRecord) GetShareTokenDenom() string {
+	return r.Validator.String() + "/" + strconv.FormatUint(r.Id, 10)
+}
+
+// MustMarshalTokenizeShareRecord returns the record bytes. Panics if fails
+func MustMarshalTokenizeShareRecord(cdc codec.Marshaler, record TokenizeShareRecord) []byte {
+	return cdc.MustMarshalBinaryBare(&record)
+}
+
+// MustUnmarshalTokenizeShareRecord return the unmarshaled record from bytes.
+// Panics if fails.
+func MustUnmarshalTokenizeShareRecord(cdc codec.Marshaler, value []byte) TokenizeShareRecord {
+	record, err := UnmarshalTokenizeShareRecord(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+
+	return record
+}
+
+// UnmarshalTokenizeShareRecord returns the record
+func UnmarshalTokenizeShareRecord(cdc codec.Marshaler, value []byte) (record TokenizeShareRecord, err error) {
+	err = cdc.UnmarshalBinaryBare(value, &record)
+	return record, err
+}
@@ -77,10 +77,10 @@ func (v Validators) ToSDKValidators() (validators []exported.ValidatorI) {
 }
 
 // ToTmValidators casts all validators to the corresponding tendermint type.
-func (v Validators) ToTmValidators() []*tmtypes.Validator {
+func (v Validators) ToTmValidators(r sdk.Int) []*tmtypes.Validator {
 	validators := make([]*tmtypes.Validator, len(v))
 	for i, val := range v {
-		validators[i] = val.ToTmValidator()
+		validators[i] = val.ToTmValidator(r)
 	}
 
 	return validators
@@ -222,10 +222,10 @@ func (d Description) EnsureLength() (Description, error) {
 
 // ABCIValidatorUpdate returns an abci.ValidatorUpdate from a staking validator type
 // with the full validator power
-func (v Validator) ABCIValidatorUpdate() abci.ValidatorUpdate {
+func (v Validator) ABCIValidatorUpdate(r sdk.Int) abci.ValidatorUpdate {
 	return abci.ValidatorUpdate{
 		PubKey: tmtypes.TM2PB.PubKey(v.GetConsPubKey()),
-		Power:  v.ConsensusPower(),
+		Power:  v.ConsensusPower(r),
 	}
 }
 
@@ -239,8 +239,8 @@ func (v Validator) ABCIValidatorUpdateZero() abci.ValidatorUpdate {
 }
 
 // ToTmValidator casts an SDK validator to a tendermint type Validator.
-func (v Validator) ToTmValidator() *tmtypes.Validator {
-	return tmtypes.NewValidator(v.GetConsPubKey(), v.ConsensusPower())
+func (v Validator) ToTmValidator(r sdk.Int) *tmtypes.Validator {
+	return tmtypes.NewValidator(v.GetConsPubKey(), v.ConsensusPower(r))
 }
 
 // SetInitialCommission attempts to set a validator's initial commission. An
@@ -308,18 +308,18 @@ func (v Validator) BondedTokens() sdk.Int {
 }
 
 // get the consensus-engine power
-// a reduction of 10^6 from validator tokens is applied
-func (v Validator) ConsensusPower() int64 {
+// a reduction of r from validator tokens is applied
+func (v Validator) ConsensusPower(r sdk.Int) int64 {
 	if v.IsBonded() {
-		return v.PotentialConsensusPower()
+		return v.PotentialConsensusPower(r)
 	}
 
 	return 0
 }
 
 // potential consensus-engine power
-func (v Validator) PotentialConsensusPower() int64 {
-	return sdk.TokensToConsensusPower(v.Tokens)
+func (v Validator) PotentialConsensusPower(r sdk.Int) int64 {
+	return sdk.TokensToConsensusPower(v.Tokens, r)
 }
 
 // UpdateStatus updates the location of the shares within a validator
@@ -412,10 +412,39 @@ func (v Validator) GetOperator() sdk.ValAddress { return v.OperatorAddress }
 func (v Validator) GetConsPubKey() crypto.PubKey {
 	return sdk.MustGetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, v.ConsensusPubkey)
 }
-func (v Validator) GetConsAddr() sdk.ConsAddress  { return sdk.ConsAddress(v.GetConsPubKey().Address()) }
-func (v Validator) GetTokens() sdk.Int            { return v.Tokens }
-func (v Validator) GetBondedTokens() sdk.Int      { return v.BondedTokens() }
-func (v Validator) GetConsensusPower() int64      { return v.ConsensusPower() }
-func (v Validator) GetCommission() sdk.Dec        { return v.Commission.Rate }
-func (v Validator) GetMinSelfDelegation() sdk.Int { return v.MinSelfDelegation }
-func (v Validator) GetDelegatorShares() sdk.Dec   { return v.DelegatorShares }
+func (v Validator) GetConsAddr() sdk.ConsAddress      { return sdk.ConsAddress(v.GetConsPubKey().Address()) }
+func (v Validator) GetTokens() sdk.Int                { return v.Tokens }
+func (v Validator) GetBondedTokens() sdk.Int          { return v.BondedTokens() }
+func (v Validator) GetConsensusPower(r sdk.Int) int64 { return v.ConsensusPower(r) }
+func (v Validator) GetCommission() sdk.Dec            { return v.Commission.Rate }
+func (v Validator) GetMinSelfDelegation() sdk.Int     { return v.MinSelfDelegation }
+func (v Validator) GetDelegatorShares() sdk.Dec       { return v.DelegatorShares }
+
+// ----------------------------------------------------------------------------
+// Client Types
+
+// ValidatorSelfBond contains the tokens worth of a validator operator's
+// self-delegation along with the validator's minimum self delegation, which is
+// more suitable for client responses.
+type ValidatorSelfBond struct {
+	ValidatorAddress  sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+	SelfBond          sdk.Int        `json:"self_bond" yaml:"self_bond"`
+	MinSelfDelegation sdk.Int        `json:"min_self_delegation" yaml:"min_self_delegation"`
+}
+
+// NewValidatorSelfBond creates a new ValidatorSelfBond instance
+func NewValidatorSelfBond(validatorAddr sdk.ValAddress, selfBond, minSelfDelegation sdk.Int) ValidatorSelfBond {
+	return ValidatorSelfBond{
+		ValidatorAddress:  validatorAddr,
+		SelfBond:          selfBond,
+		MinSelfDelegation: minSelfDelegation,
+	}
+}
+
+// String implements the Stringer interface for ValidatorSelfBond.
+func (v ValidatorSelfBond) String() string {
+	return fmt.Sprintf(`Validator Self Bond:
+  Validator:           %s
+  Self Bond:           %s
+  Min Self Delegation: %s`, v.ValidatorAddress, v.SelfBond, v.MinSelfDelegation)
+}
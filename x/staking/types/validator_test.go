@@ -60,7 +60,7 @@ func TestUpdateDescription(t *testing.T) {
 func TestABCIValidatorUpdate(t *testing.T) {
 	validator := NewValidator(valAddr1, pk1, Description{})
 
-	abciVal := validator.ABCIValidatorUpdate()
+	abciVal := validator.ABCIValidatorUpdate(sdk.DefaultPowerReduction)
 	require.Equal(t, tmtypes.TM2PB.PubKey(validator.GetConsPubKey()), abciVal.PubKey)
 	require.Equal(t, validator.BondedTokens().Int64(), abciVal.Power)
 }
@@ -312,8 +312,8 @@ func TestValidatorToTm(t *testing.T) {
 		val.Status = sdk.Bonded
 		val.Tokens = sdk.NewInt(rand.Int63())
 		vals[i] = val
-		expected[i] = tmtypes.NewValidator(pk, val.ConsensusPower())
+		expected[i] = tmtypes.NewValidator(pk, val.ConsensusPower(sdk.DefaultPowerReduction))
 	}
 
-	require.Equal(t, expected, vals.ToTmValidators())
+	require.Equal(t, expected, vals.ToTmValidators(sdk.DefaultPowerReduction))
 }
@@ -1,6 +1,7 @@
 package upgrade_test
 
 import (
+	"encoding/binary"
 	"encoding/json"
 	"errors"
 	"io/ioutil"
@@ -105,6 +106,72 @@ func TestDoHeightUpgrade(t *testing.T) {
 	VerifyDoUpgrade(t)
 }
 
+func TestQueryAppliedPlan(t *testing.T) {
+	s := setupTest(10, map[int64]bool{})
+	err := s.handler(s.ctx, &upgrade.SoftwareUpgradeProposal{Title: "prop", Plan: upgrade.Plan{Name: "test", Height: s.ctx.BlockHeight() + 1}})
+	require.NoError(t, err)
+
+	t.Log("Verify the scheduled plan is returned as the current plan")
+	bz, err := s.querier(s.ctx, []string{upgrade.QueryCurrent}, abci.RequestQuery{})
+	require.NoError(t, err)
+
+	cdc := codec.New()
+	upgrade.RegisterCodec(cdc)
+
+	var plan upgrade.Plan
+	require.NoError(t, cdc.UnmarshalJSON(bz, &plan))
+	require.Equal(t, "test", plan.Name)
+
+	query := abci.RequestQuery{Data: cdc.MustMarshalJSON(upgrade.NewQueryAppliedParams("test"))}
+	bz, err = s.querier(s.ctx, []string{upgrade.QueryApplied}, query)
+	require.NoError(t, err)
+	require.Nil(t, bz)
+
+	VerifyDoUpgrade(t)
+
+	t.Log("Verify the applied plan height is returned")
+	bz, err = s.querier(s.ctx, []string{upgrade.QueryApplied}, query)
+	require.NoError(t, err)
+	require.Equal(t, uint64(s.ctx.BlockHeight()+1), binary.BigEndian.Uint64(bz))
+}
+
+func TestUpgradeModuleVersionMap(t *testing.T) {
+	s := setupTest(10, map[int64]bool{})
+
+	t.Log("Verify the module versions are stored on InitChain")
+	vm := s.keeper.GetModuleVersionMap(s.ctx)
+	require.Equal(t, uint64(2), vm["bank"])
+	require.Equal(t, uint64(2), vm["gov"])
+
+	err := s.handler(s.ctx, &upgrade.SoftwareUpgradeProposal{Title: "prop", Plan: upgrade.Plan{Name: "test", Height: s.ctx.BlockHeight() + 1}})
+	require.NoError(t, err)
+
+	newCtx := s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1).WithBlockTime(time.Now())
+	req := abci.RequestBeginBlock{Header: newCtx.BlockHeader()}
+
+	t.Log("Verify a failing upgrade handler aborts the upgrade")
+	s.keeper.SetUpgradeHandler("test", func(ctx sdk.Context, plan upgrade.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		return nil, errors.New("migration failed")
+	})
+	require.Panics(t, func() {
+		s.module.BeginBlock(newCtx, req)
+	})
+
+	t.Log("Verify the handler receives and updates the module versions")
+	s.keeper.SetUpgradeHandler("test", func(ctx sdk.Context, plan upgrade.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		require.Equal(t, vm, fromVM)
+		return module.VersionMap{"bank": 3}, nil
+	})
+	require.NotPanics(t, func() {
+		s.module.BeginBlock(newCtx, req)
+	})
+
+	vm = s.keeper.GetModuleVersionMap(newCtx)
+	require.Equal(t, uint64(3), vm["bank"])
+	require.Equal(t, uint64(2), vm["gov"])
+	VerifyCleared(t, newCtx)
+}
+
 func TestCanOverwriteScheduleUpgrade(t *testing.T) {
 	s := setupTest(10, map[int64]bool{})
 	t.Log("Can overwrite plan")
@@ -116,6 +183,46 @@ func TestCanOverwriteScheduleUpgrade(t *testing.T) {
 	VerifyDoUpgrade(t)
 }
 
+func TestUpgradedClientState(t *testing.T) {
+	s := setupTest(10, map[int64]bool{})
+	clientState := []byte("upgraded client state")
+
+	t.Log("Can't set an upgraded client state with a time based plan")
+	err := s.handler(s.ctx, &upgrade.SoftwareUpgradeProposal{Title: "prop", Plan: upgrade.Plan{
+		Name: "test", Time: s.ctx.BlockHeader().Time.Add(time.Hour), UpgradedClientState: clientState,
+	}})
+	require.NotNil(t, err)
+	require.True(t, errors.Is(sdkerrors.ErrInvalidRequest, err), err)
+
+	t.Log("Scheduling the plan stores the upgraded client state at the plan height")
+	err = s.handler(s.ctx, &upgrade.SoftwareUpgradeProposal{Title: "prop", Plan: upgrade.Plan{
+		Name: "test", Height: 20, UpgradedClientState: clientState,
+	}})
+	require.Nil(t, err)
+	bz, found := s.keeper.GetUpgradedClient(s.ctx, 20)
+	require.True(t, found)
+	require.Equal(t, clientState, bz)
+
+	t.Log("Overwriting the plan clears the previous upgraded state")
+	s.keeper.SetUpgradedConsensusState(s.ctx, 20, []byte("upgraded consensus state"))
+	err = s.handler(s.ctx, &upgrade.SoftwareUpgradeProposal{Title: "prop", Plan: upgrade.Plan{
+		Name: "test", Height: 30, UpgradedClientState: clientState,
+	}})
+	require.Nil(t, err)
+	_, found = s.keeper.GetUpgradedClient(s.ctx, 20)
+	require.False(t, found)
+	_, found = s.keeper.GetUpgradedConsensusState(s.ctx, 20)
+	require.False(t, found)
+	_, found = s.keeper.GetUpgradedClient(s.ctx, 30)
+	require.True(t, found)
+
+	t.Log("Cancelling the plan clears the upgraded state")
+	err = s.handler(s.ctx, &upgrade.CancelSoftwareUpgradeProposal{Title: "cancel"})
+	require.Nil(t, err)
+	_, found = s.keeper.GetUpgradedClient(s.ctx, 30)
+	require.False(t, found)
+}
+
 func VerifyDoUpgrade(t *testing.T) {
 	t.Log("Verify that a panic happens at the upgrade time/height")
 	newCtx := s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1).WithBlockTime(time.Now())
@@ -126,7 +233,9 @@ func VerifyDoUpgrade(t *testing.T) {
 	})
 
 	t.Log("Verify that the upgrade can be successfully applied with a handler")
-	s.keeper.SetUpgradeHandler("test", func(ctx sdk.Context, plan upgrade.Plan) {})
+	s.keeper.SetUpgradeHandler("test", func(ctx sdk.Context, plan upgrade.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		return vm, nil
+	})
 	require.NotPanics(t, func() {
 		s.module.BeginBlock(newCtx, req)
 	})
@@ -142,7 +251,9 @@ func VerifyDoUpgradeWithCtx(t *testing.T, newCtx sdk.Context, proposalName strin
 	})
 
 	t.Log("Verify that the upgrade can be successfully applied with a handler")
-	s.keeper.SetUpgradeHandler(proposalName, func(ctx sdk.Context, plan upgrade.Plan) {})
+	s.keeper.SetUpgradeHandler(proposalName, func(ctx sdk.Context, plan upgrade.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		return vm, nil
+	})
 	require.NotPanics(t, func() {
 		s.module.BeginBlock(newCtx, req)
 	})
@@ -154,7 +265,10 @@ func TestHaltIfTooNew(t *testing.T) {
 	s := setupTest(10, map[int64]bool{})
 	t.Log("Verify that we don't panic with registered plan not in database at all")
 	var called int
-	s.keeper.SetUpgradeHandler("future", func(ctx sdk.Context, plan upgrade.Plan) { called++ })
+	s.keeper.SetUpgradeHandler("future", func(ctx sdk.Context, plan upgrade.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		called++
+		return vm, nil
+	})
 
 	newCtx := s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1).WithBlockTime(time.Now())
 	req := abci.RequestBeginBlock{Header: newCtx.BlockHeader()}
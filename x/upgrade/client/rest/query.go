@@ -32,7 +32,7 @@ func getCurrentPlanHandler(cliCtx context.CLIContext) func(http.ResponseWriter,
 		}
 
 		var plan types.Plan
-		err = cliCtx.Codec.UnmarshalBinaryBare(res, &plan)
+		err = cliCtx.Codec.UnmarshalJSON(res, &plan)
 		if rest.CheckInternalServerError(w, err) {
 			return
 		}
@@ -62,10 +62,10 @@ func getDonePlanHandler(cliCtx context.CLIContext) func(http.ResponseWriter, *ht
 		}
 		if len(res) != 8 {
 			rest.WriteErrorResponse(w, http.StatusInternalServerError, "unknown format for applied-upgrade")
+			return
 		}
 
 		applied := int64(binary.BigEndian.Uint64(res))
-		fmt.Println(applied)
 		rest.PostProcessResponse(w, cliCtx, applied)
 	}
 }
@@ -59,8 +59,9 @@ existing software will cease to process ABCI messages and a new version with cod
 All upgrades are coordinated by a unique upgrade name that cannot be reused on the same blockchain. In order for the upgrade
 module to know that the upgrade has been safely applied, a handler with the name of the upgrade must be installed.
 Here is an example handler for an upgrade named "my-fancy-upgrade":
-	app.upgradeKeeper.SetUpgradeHandler("my-fancy-upgrade", func(ctx sdk.Context, plan upgrade.Plan) {
+	app.upgradeKeeper.SetUpgradeHandler("my-fancy-upgrade", func(ctx sdk.Context, plan upgrade.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
 		// Perform any migrations of the state store needed for this upgrade
+		return app.mm.RunMigrations(ctx, app.configurator, fromVM)
 	})
 
 This upgrade handler performs the dual function of alerting the upgrade module that the named upgrade has been applied,
@@ -68,11 +69,31 @@ as well as providing the opportunity for the upgraded software to perform any ne
 (with the old binary) and applying the migration (with the new binary) are enforced in the state machine. Actually
 switching the binaries is an ops task and not handled inside the sdk / abci app.
 
+The handler receives the consensus version of each module before the upgrade, as stored by the upgrade module,
+and returns their versions after the upgrade, which are stored in turn. The module manager's RunMigrations runs
+the in-place store migrations registered by the modules through their RegisterMigrations method, once for each
+bump of their ConsensusVersion, and initializes the modules which didn't exist before the upgrade. The app
+stores the initial module versions with SetModuleVersionMap in its InitChainer. Returning an error from the
+handler aborts the upgrade.
+
+A chain started before the module versions were stored receives an empty version map, which RunMigrations
+rejects. Its first upgrade handler must provide the versions of the modules before the upgrade itself:
+
+	app.UpgradeKeeper.SetUpgradeHandler("my-fancy-upgrade", func(ctx sdk.Context, plan upgrade.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		if len(fromVM) == 0 {
+			// every module existing before the upgrade was at the consensus version 1
+			fromVM = module.VersionMap{"auth": 1, "bank": 1, "staking": 1, "gov": 1}
+		}
+
+		return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+	})
+
 Here is a sample code to set store migrations with an upgrade:
 
 	// this configures a no-op upgrade handler for the "my-fancy-upgrade" upgrade
-	app.UpgradeKeeper.SetUpgradeHandler("my-fancy-upgrade",  func(ctx sdk.Context, plan upgrade.Plan) {
+	app.UpgradeKeeper.SetUpgradeHandler("my-fancy-upgrade",  func(ctx sdk.Context, plan upgrade.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
 		// upgrade changes here
+		return fromVM, nil
 	})
 
 	upgradeInfo := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
@@ -19,6 +19,7 @@ import (
 	store "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/module"
 )
 
 // UpgradeInfoFileName file to store upgrade information
@@ -70,13 +71,94 @@ func (k Keeper) ScheduleUpgrade(ctx sdk.Context, plan types.Plan) error {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "upgrade with name %s has already been completed", plan.Name)
 	}
 
+	// clear the IBC states of the overwritten plan, if any
+	if oldPlan, found := k.GetUpgradePlan(ctx); found {
+		k.ClearIBCState(ctx, oldPlan.Height)
+	}
+
 	bz := k.cdc.MustMarshalBinaryBare(&plan)
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.PlanKey(), bz)
 
+	if len(plan.UpgradedClientState) > 0 {
+		k.SetUpgradedClient(ctx, plan.Height, plan.UpgradedClientState)
+	}
+
 	return nil
 }
 
+// SetUpgradedClient stores the encoded IBC client state of the chain after the
+// upgrade planned at the given height.
+func (k Keeper) SetUpgradedClient(ctx sdk.Context, planHeight int64, bz []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.UpgradedClientKey(planHeight), bz)
+}
+
+// GetUpgradedClient returns the encoded IBC client state of the chain after the
+// upgrade planned at the given height, if any.
+func (k Keeper) GetUpgradedClient(ctx sdk.Context, planHeight int64) ([]byte, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.UpgradedClientKey(planHeight))
+	if len(bz) == 0 {
+		return nil, false
+	}
+
+	return bz, true
+}
+
+// SetUpgradedConsensusState stores the encoded IBC consensus state of the chain
+// after the upgrade planned at the given height. It is set by the IBC client
+// module on the block before the upgrade.
+func (k Keeper) SetUpgradedConsensusState(ctx sdk.Context, planHeight int64, bz []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.UpgradedConsStateKey(planHeight), bz)
+}
+
+// GetUpgradedConsensusState returns the encoded IBC consensus state of the chain
+// after the upgrade planned at the given height, if any.
+func (k Keeper) GetUpgradedConsensusState(ctx sdk.Context, planHeight int64) ([]byte, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.UpgradedConsStateKey(planHeight))
+	if len(bz) == 0 {
+		return nil, false
+	}
+
+	return bz, true
+}
+
+// ClearIBCState clears the upgraded IBC states of the upgrade planned at the
+// given height.
+func (k Keeper) ClearIBCState(ctx sdk.Context, planHeight int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.UpgradedClientKey(planHeight))
+	store.Delete(types.UpgradedConsStateKey(planHeight))
+}
+
+// SetModuleVersionMap stores the consensus version of each module of the
+// VersionMap.
+func (k Keeper) SetModuleVersionMap(ctx sdk.Context, vm module.VersionMap) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.VersionMapByte})
+	for name, version := range vm {
+		bz := make([]byte, 8)
+		binary.BigEndian.PutUint64(bz, version)
+		store.Set([]byte(name), bz)
+	}
+}
+
+// GetModuleVersionMap returns the stored consensus version of each module.
+func (k Keeper) GetModuleVersionMap(ctx sdk.Context) module.VersionMap {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.VersionMapByte})
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	vm := make(module.VersionMap)
+	for ; iterator.Valid(); iterator.Next() {
+		vm[string(iterator.Key())] = binary.BigEndian.Uint64(iterator.Value())
+	}
+
+	return vm
+}
+
 // GetDoneHeight returns the height at which the given upgrade was executed
 func (k Keeper) GetDoneHeight(ctx sdk.Context, name string) int64 {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.DoneByte})
@@ -88,8 +170,12 @@ func (k Keeper) GetDoneHeight(ctx sdk.Context, name string) int64 {
 	return int64(binary.BigEndian.Uint64(bz))
 }
 
-// ClearUpgradePlan clears any schedule upgrade
+// ClearUpgradePlan clears any schedule upgrade along with its upgraded IBC states
 func (k Keeper) ClearUpgradePlan(ctx sdk.Context) {
+	if plan, found := k.GetUpgradePlan(ctx); found {
+		k.ClearIBCState(ctx, plan.Height)
+	}
+
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.PlanKey())
 }
@@ -126,14 +212,20 @@ func (k Keeper) HasHandler(name string) bool {
 	return ok
 }
 
-// ApplyUpgrade will execute the handler associated with the Plan and mark the plan as done.
+// ApplyUpgrade will execute the handler associated with the Plan, store the updated module
+// consensus versions and mark the plan as done. It panics if the handler fails.
 func (k Keeper) ApplyUpgrade(ctx sdk.Context, plan types.Plan) {
 	handler := k.upgradeHandlers[plan.Name]
 	if handler == nil {
 		panic("ApplyUpgrade should never be called without first checking HasHandler")
 	}
 
-	handler(ctx, plan)
+	updatedVM, err := handler(ctx, plan, k.GetModuleVersionMap(ctx))
+	if err != nil {
+		panic(fmt.Errorf("failed to apply upgrade %q: %w", plan.Name, err))
+	}
+
+	k.SetModuleVersionMap(ctx, updatedVM)
 
 	k.ClearUpgradePlan(ctx)
 	k.setDone(ctx, plan.Name)
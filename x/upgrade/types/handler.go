@@ -2,7 +2,11 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 )
 
-// UpgradeHandler specifies the type of function that is called when an upgrade is applied
-type UpgradeHandler func(ctx sdk.Context, plan Plan)
+// UpgradeHandler specifies the type of function that is called when an upgrade is applied.
+// It receives the consensus versions of the modules before the upgrade, typically passed to
+// the module manager's RunMigrations, and returns their versions after the upgrade, which are
+// persisted for the next upgrade. Returning an error aborts the upgrade.
+type UpgradeHandler func(ctx sdk.Context, plan Plan, fromVM module.VersionMap) (module.VersionMap, error)
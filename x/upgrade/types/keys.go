@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 const (
 	// ModuleName is the name of this module
 	ModuleName = "upgrade"
@@ -19,6 +21,8 @@ const (
 	PlanByte = 0x0
 	// DoneByte is a prefix for to look up completed upgrade plan by name
 	DoneByte = 0x1
+	// VersionMapByte is a prefix to look up the consensus version of a module by name
+	VersionMapByte = 0x2
 )
 
 // PlanKey is the key under which the current plan is saved
@@ -26,3 +30,27 @@ const (
 func PlanKey() []byte {
 	return []byte{PlanByte}
 }
+
+const (
+	// KeyUpgradedIBCState is the key under which the IBC states of the chain after a
+	// planned upgrade are stored
+	KeyUpgradedIBCState = "upgradedIBCState"
+
+	// KeyUpgradedClient is the sub-key of the upgraded IBC client state
+	KeyUpgradedClient = "upgradedClient"
+
+	// KeyUpgradedConsState is the sub-key of the upgraded IBC consensus state
+	KeyUpgradedConsState = "upgradedConsState"
+)
+
+// UpgradedClientKey is the key under which the upgraded IBC client state of the
+// upgrade planned at the given height is stored
+func UpgradedClientKey(height int64) []byte {
+	return []byte(fmt.Sprintf("%s/%d/%s", KeyUpgradedIBCState, height, KeyUpgradedClient))
+}
+
+// UpgradedConsStateKey is the key under which the upgraded IBC consensus state of
+// the upgrade planned at the given height is stored
+func UpgradedConsStateKey(height int64) []byte {
+	return []byte(fmt.Sprintf("%s/%d/%s", KeyUpgradedIBCState, height, KeyUpgradedConsState))
+}
@@ -32,6 +32,9 @@ func (p Plan) ValidateBasic() error {
 	if !p.Time.IsZero() && p.Height != 0 {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cannot set both time and height")
 	}
+	if !p.Time.IsZero() && len(p.UpgradedClientState) > 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "IBC chain upgrades must only set height")
+	}
 
 	return nil
 }
@@ -96,6 +96,21 @@ func TestPlanValid(t *testing.T) {
 				Height: -12345,
 			},
 		},
+		"upgraded client state by height": {
+			p: Plan{
+				Name:                "ibc",
+				Height:              123450000,
+				UpgradedClientState: []byte("client state"),
+			},
+			valid: true,
+		},
+		"upgraded client state by time": {
+			p: Plan{
+				Name:                "ibc",
+				Time:                mustParseTime("2019-07-08T11:33:55Z"),
+				UpgradedClientState: []byte("client state"),
+			},
+		},
 	}
 
 	for name, tc := range cases {
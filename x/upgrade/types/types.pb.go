@@ -4,6 +4,7 @@
 package types
 
 import (
+	bytes "bytes"
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
@@ -44,6 +45,10 @@ type Plan struct {
 	// Any application specific upgrade info to be included on-chain
 	// such as a git commit that validators could automatically upgrade to
 	Info string `protobuf:"bytes,4,opt,name=info,proto3" json:"info,omitempty"`
+	// The encoded IBC client state of the chain after the upgrade, if the upgrade breaks the IBC light clients of
+	// the counterparty chains. It is stored under the upgraded client path at the plan height so that they can
+	// verify and follow the upgrade. Only used when the upgrade is scheduled at a Height.
+	UpgradedClientState []byte `protobuf:"bytes,5,opt,name=upgraded_client_state,json=upgradedClientState,proto3" json:"upgraded_client_state,omitempty" yaml:"upgraded_client_state"`
 }
 
 func (m *Plan) Reset()      { *m = Plan{} }
@@ -164,31 +169,34 @@ func init() {
 func init() { proto.RegisterFile("x/upgrade/types/types.proto", fileDescriptor_2a308fd9dd71aff8) }
 
 var fileDescriptor_2a308fd9dd71aff8 = []byte{
-	// 379 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0x41, 0x6f, 0xda, 0x30,
-	0x14, 0xc7, 0xe3, 0x91, 0xa1, 0x61, 0x6e, 0xd6, 0x34, 0x22, 0x26, 0x9c, 0x88, 0xc3, 0xc4, 0x61,
-	0x73, 0x34, 0x76, 0xd8, 0xb4, 0x23, 0xbb, 0x4f, 0x28, 0x5b, 0x2f, 0x95, 0x2a, 0x64, 0x12, 0x93,
-	0x58, 0x24, 0xb1, 0x15, 0x9b, 0x16, 0xbe, 0x40, 0xcf, 0x7c, 0x84, 0x7e, 0x1c, 0x8e, 0x1c, 0x39,
-	0xb5, 0x05, 0x2e, 0xfd, 0x18, 0x55, 0xe2, 0xa0, 0x56, 0x95, 0x7a, 0xeb, 0x25, 0x79, 0xcf, 0xfa,
-	0xbf, 0xff, 0xfb, 0xbd, 0x67, 0xc3, 0xcf, 0x4b, 0x7f, 0x21, 0xe3, 0x82, 0x46, 0xcc, 0xd7, 0x2b,
-	0xc9, 0x94, 0xf9, 0x12, 0x59, 0x08, 0x2d, 0x50, 0x27, 0x14, 0x2a, 0x13, 0x6a, 0xa2, 0xa2, 0x39,
-	0x59, 0x92, 0x5a, 0x47, 0x2e, 0xbf, 0x77, 0xbf, 0xe8, 0x84, 0x17, 0xd1, 0x44, 0xd2, 0x42, 0xaf,
-	0xfc, 0x4a, 0xeb, 0xc7, 0x22, 0x16, 0x4f, 0x91, 0x31, 0xe8, 0xba, 0xb1, 0x10, 0x71, 0xca, 0x8c,
-	0x64, 0xba, 0x98, 0xf9, 0x9a, 0x67, 0x4c, 0x69, 0x9a, 0x49, 0x23, 0xe8, 0x5f, 0x03, 0x68, 0x8f,
-	0x53, 0x9a, 0x23, 0x04, 0xed, 0x9c, 0x66, 0xcc, 0x01, 0x1e, 0x18, 0xb4, 0x82, 0x2a, 0x46, 0xbf,
-	0xa0, 0x5d, 0xea, 0x9d, 0x77, 0x1e, 0x18, 0xb4, 0x87, 0x5d, 0x62, 0xcc, 0xc8, 0xc9, 0x8c, 0xfc,
-	0x3f, 0x99, 0x8d, 0x3e, 0x6c, 0x6e, 0x5d, 0x6b, 0x7d, 0xe7, 0x82, 0xa0, 0xaa, 0x40, 0x9f, 0x60,
-	0x33, 0x61, 0x3c, 0x4e, 0xb4, 0xd3, 0xf0, 0xc0, 0xa0, 0x11, 0xd4, 0x59, 0xd9, 0x85, 0xe7, 0x33,
-	0xe1, 0xd8, 0xa6, 0x4b, 0x19, 0xff, 0xb6, 0x1f, 0x6e, 0x5c, 0xd0, 0x5f, 0x03, 0xd8, 0xf9, 0x27,
-	0x66, 0xfa, 0x8a, 0x16, 0xec, 0xcc, 0x0c, 0x3a, 0x2e, 0x84, 0x14, 0x8a, 0xa6, 0xe8, 0x23, 0x7c,
-	0xaf, 0xb9, 0x4e, 0x4f, 0x70, 0x26, 0x41, 0x1e, 0x6c, 0x47, 0x4c, 0x85, 0x05, 0x97, 0x9a, 0x8b,
-	0xbc, 0x82, 0x6c, 0x05, 0xcf, 0x8f, 0xd0, 0x4f, 0x68, 0xcb, 0x94, 0xe6, 0x15, 0x43, 0x7b, 0xd8,
-	0x23, 0xaf, 0x6c, 0x93, 0x94, 0x0b, 0x18, 0xd9, 0xe5, 0x08, 0x41, 0x55, 0x50, 0x23, 0x5d, 0xc0,
-	0xde, 0x1f, 0x9a, 0x87, 0x2c, 0x7d, 0x63, 0x2e, 0x63, 0x3f, 0xfa, 0xbb, 0xd9, 0x63, 0x6b, 0xb7,
-	0xc7, 0xd6, 0xe6, 0x80, 0xc1, 0xf6, 0x80, 0xc1, 0xfd, 0x01, 0x83, 0xf5, 0x11, 0x5b, 0xdb, 0x23,
-	0xb6, 0x76, 0x47, 0x6c, 0x9d, 0x7f, 0x8d, 0xb9, 0x4e, 0x16, 0x53, 0x12, 0x8a, 0xcc, 0x37, 0xec,
-	0xf5, 0xef, 0x9b, 0x8a, 0xe6, 0xfe, 0x8b, 0x87, 0x33, 0x6d, 0x56, 0xf7, 0xf2, 0xe3, 0x31, 0x00,
-	0x00, 0xff, 0xff, 0x45, 0x05, 0x01, 0x90, 0x52, 0x02, 0x00, 0x00,
+	// 420 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0xcd, 0x6e, 0xd4, 0x30,
+	0x10, 0x8e, 0x69, 0x5a, 0x51, 0x2f, 0x27, 0xf3, 0xd3, 0x68, 0xa1, 0x4e, 0xb4, 0x07, 0xb4, 0x07,
+	0x70, 0x44, 0x39, 0x80, 0x7a, 0x4c, 0xef, 0xa8, 0x4a, 0xcb, 0x05, 0x09, 0x45, 0xde, 0xc4, 0x9b,
+	0x58, 0x75, 0x62, 0x2b, 0xf6, 0x42, 0xf7, 0x2d, 0xf6, 0x11, 0x78, 0x9c, 0x3d, 0xf6, 0xd8, 0x03,
+	0x2a, 0x74, 0xf7, 0xc2, 0x99, 0x27, 0x40, 0xb1, 0x13, 0x81, 0x10, 0xdc, 0x7a, 0x49, 0x66, 0x3e,
+	0x7d, 0xf3, 0xcd, 0x7c, 0x33, 0x86, 0x4f, 0x2f, 0xe3, 0x85, 0x2a, 0x5b, 0x5a, 0xb0, 0xd8, 0x2c,
+	0x15, 0xd3, 0xee, 0x4b, 0x54, 0x2b, 0x8d, 0x44, 0x07, 0xb9, 0xd4, 0xb5, 0xd4, 0x99, 0x2e, 0x2e,
+	0xc8, 0x25, 0xe9, 0x79, 0xe4, 0xd3, 0xab, 0xf1, 0x73, 0x53, 0xf1, 0xb6, 0xc8, 0x14, 0x6d, 0xcd,
+	0x32, 0xb6, 0xdc, 0xb8, 0x94, 0xa5, 0xfc, 0x1d, 0x39, 0x81, 0x71, 0x58, 0x4a, 0x59, 0x0a, 0xe6,
+	0x28, 0xb3, 0xc5, 0x3c, 0x36, 0xbc, 0x66, 0xda, 0xd0, 0x5a, 0x39, 0xc2, 0xe4, 0x2b, 0x80, 0xfe,
+	0xa9, 0xa0, 0x0d, 0x42, 0xd0, 0x6f, 0x68, 0xcd, 0x02, 0x10, 0x81, 0xe9, 0x7e, 0x6a, 0x63, 0xf4,
+	0x16, 0xfa, 0x1d, 0x3f, 0xb8, 0x17, 0x81, 0xe9, 0xe8, 0x68, 0x4c, 0x9c, 0x18, 0x19, 0xc4, 0xc8,
+	0xf9, 0x20, 0x96, 0xdc, 0x5f, 0xdf, 0x84, 0xde, 0xea, 0x5b, 0x08, 0x52, 0x5b, 0x81, 0x9e, 0xc0,
+	0xbd, 0x8a, 0xf1, 0xb2, 0x32, 0xc1, 0x4e, 0x04, 0xa6, 0x3b, 0x69, 0x9f, 0x75, 0x5d, 0x78, 0x33,
+	0x97, 0x81, 0xef, 0xba, 0x74, 0x31, 0x3a, 0x87, 0x8f, 0x7b, 0x67, 0x45, 0x96, 0x0b, 0xce, 0x1a,
+	0x93, 0x69, 0x43, 0x0d, 0x0b, 0x76, 0x23, 0x30, 0x7d, 0x90, 0x44, 0x3f, 0x6f, 0xc2, 0x67, 0x4b,
+	0x5a, 0x8b, 0xe3, 0xc9, 0x3f, 0x69, 0x93, 0xf4, 0xe1, 0x80, 0x9f, 0x58, 0xf8, 0xac, 0x43, 0x8f,
+	0xfd, 0x1f, 0x5f, 0x42, 0x30, 0x59, 0x01, 0x78, 0x70, 0x26, 0xe7, 0xe6, 0x33, 0x6d, 0xd9, 0x7b,
+	0xc7, 0x3a, 0x6d, 0xa5, 0x92, 0x9a, 0x0a, 0xf4, 0x08, 0xee, 0x1a, 0x6e, 0xc4, 0x60, 0xd9, 0x25,
+	0x28, 0x82, 0xa3, 0x82, 0xe9, 0xbc, 0xe5, 0xca, 0x70, 0xd9, 0x58, 0xeb, 0xfb, 0xe9, 0x9f, 0x10,
+	0x7a, 0x03, 0x7d, 0x25, 0x68, 0x63, 0x9d, 0x8d, 0x8e, 0x0e, 0xc9, 0x7f, 0x6e, 0x44, 0xba, 0xb5,
+	0x26, 0x7e, 0xb7, 0x98, 0xd4, 0x16, 0xf4, 0x23, 0x7d, 0x84, 0x87, 0x27, 0xb4, 0xc9, 0x99, 0xb8,
+	0xe3, 0xb9, 0x9c, 0x7c, 0xf2, 0x6e, 0x7d, 0x8b, 0xbd, 0xeb, 0x5b, 0xec, 0xad, 0x37, 0x18, 0x5c,
+	0x6d, 0x30, 0xf8, 0xbe, 0xc1, 0x60, 0xb5, 0xc5, 0xde, 0xd5, 0x16, 0x7b, 0xd7, 0x5b, 0xec, 0x7d,
+	0x78, 0x51, 0x72, 0x53, 0x2d, 0x66, 0x24, 0x97, 0x75, 0xec, 0x66, 0xef, 0x7f, 0x2f, 0x75, 0x71,
+	0x11, 0xff, 0xf5, 0x1c, 0x67, 0x7b, 0xf6, 0xda, 0xaf, 0x7f, 0x0d, 0x00, 0x10, 0x8a, 0x58, 0x12,
+	0xa8, 0x02, 0x00, 0x00,
 }
 
 func (this *Plan) Equal(that interface{}) bool {
@@ -222,6 +230,9 @@ func (this *Plan) Equal(that interface{}) bool {
 	if this.Info != that1.Info {
 		return false
 	}
+	if !bytes.Equal(this.UpgradedClientState, that1.UpgradedClientState) {
+		return false
+	}
 	return true
 }
 func (this *SoftwareUpgradeProposal) Equal(that interface{}) bool {
@@ -301,6 +312,13 @@ func (m *Plan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.UpgradedClientState) > 0 {
+		i -= len(m.UpgradedClientState)
+		copy(dAtA[i:], m.UpgradedClientState)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.UpgradedClientState)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Info) > 0 {
 		i -= len(m.Info)
 		copy(dAtA[i:], m.Info)
@@ -445,6 +463,10 @@ func (m *Plan) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = len(m.UpgradedClientState)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -635,6 +657,40 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 			}
 			m.Info = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradedClientState", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpgradedClientState = append(m.UpgradedClientState[:0], dAtA[iNdEx:postIndex]...)
+			if m.UpgradedClientState == nil {
+				m.UpgradedClientState = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])